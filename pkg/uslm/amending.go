@@ -0,0 +1,114 @@
+package uslm
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// AmendingActionKind is a normalized classification of an amending action.
+type AmendingActionKind string
+
+const (
+	AmendingActionStrike      AmendingActionKind = "strike"
+	AmendingActionInsert      AmendingActionKind = "insert"
+	AmendingActionRedesignate AmendingActionKind = "redesignate"
+	AmendingActionAddAtEnd    AmendingActionKind = "addAtEnd"
+	AmendingActionAmendToRead AmendingActionKind = "amendToRead"
+	AmendingActionRepeal      AmendingActionKind = "repeal"
+	AmendingActionAmend       AmendingActionKind = "amend"
+	AmendingActionUnknown     AmendingActionKind = "unknown"
+)
+
+// Prose patterns for instructions that convey the action only in text.
+var (
+	strikePattern      = regexp.MustCompile(`(?i)\bstrik(?:e|es|ing)\b`)
+	insertPattern      = regexp.MustCompile(`(?i)\binsert(?:s|ing)?\b`)
+	redesignatePattern = regexp.MustCompile(`(?i)\bredesignat(?:e|es|ed|ing)\b`)
+	addAtEndPattern    = regexp.MustCompile(`(?i)\badd(?:s|ing)?\b[^.;]*?\bat the end\b`)
+	amendToReadPattern = regexp.MustCompile(`(?i)\bamended to read\b|\bto read as follows\b`)
+	repealPattern      = regexp.MustCompile(`(?i)\b(?:is|are) (?:hereby )?repealed\b|\brepeal(?:s|ing)?\b`)
+	amendPattern       = regexp.MustCompile(`(?i)\b(?:is|are) (?:further )?amended\b`)
+)
+
+// Classify returns the normalized kind of the amending action. The type attribute
+// is used when present; context (typically the text of the enclosing content) is
+// used to refine it, for example to distinguish "adding at the end" from other
+// additions or "amended to read as follows" from a general "is amended".
+func (a *AmendingAction) Classify(context string) AmendingActionKind {
+	switch strings.ToLower(a.Type) {
+	case "delete":
+		return AmendingActionStrike
+	case "insert":
+		return AmendingActionInsert
+	case "redesignate", "renumber":
+		return AmendingActionRedesignate
+	case "repeal":
+		return AmendingActionRepeal
+	case "substitute":
+		return AmendingActionAmendToRead
+	case "add":
+		if addAtEndPattern.MatchString(a.Text + " " + context) {
+			return AmendingActionAddAtEnd
+		}
+		return AmendingActionInsert
+	case "amend":
+		if amendToReadPattern.MatchString(context) {
+			return AmendingActionAmendToRead
+		}
+		return AmendingActionAmend
+	}
+
+	if kinds := ClassifyAmendingText(a.Text + " " + context); len(kinds) > 0 {
+		return kinds[0]
+	}
+	return AmendingActionUnknown
+}
+
+// ClassifyAmendingText classifies an instruction that conveys its actions only in
+// prose (e.g., "by striking "2019" and inserting "2020""). The kinds are returned
+// in the order they appear in the text; a general "is amended" is only reported
+// when no more specific action is found.
+func ClassifyAmendingText(text string) []AmendingActionKind {
+	type match struct {
+		kind AmendingActionKind
+		pos  int
+	}
+	var matches []match
+	find := func(kind AmendingActionKind, pattern *regexp.Regexp) {
+		if loc := pattern.FindStringIndex(text); loc != nil {
+			matches = append(matches, match{kind, loc[0]})
+		}
+	}
+
+	find(AmendingActionStrike, strikePattern)
+	find(AmendingActionRedesignate, redesignatePattern)
+	find(AmendingActionRepeal, repealPattern)
+	find(AmendingActionAmendToRead, amendToReadPattern)
+	find(AmendingActionAddAtEnd, addAtEndPattern)
+	find(AmendingActionInsert, insertPattern)
+	if len(matches) == 0 {
+		find(AmendingActionAmend, amendPattern)
+	}
+
+	sort.SliceStable(matches, func(i, j int) bool { return matches[i].pos < matches[j].pos })
+	kinds := make([]AmendingActionKind, len(matches))
+	for i, m := range matches {
+		kinds[i] = m.kind
+	}
+	return kinds
+}
+
+// AmendingActionKinds returns the classified actions of the content. Marked-up
+// amendingAction elements are used when present; otherwise the content text is
+// classified as prose.
+func (c *Content) AmendingActionKinds() []AmendingActionKind {
+	if len(c.AmendingAction) == 0 {
+		return ClassifyAmendingText(c.Text)
+	}
+	kinds := make([]AmendingActionKind, len(c.AmendingAction))
+	for i := range c.AmendingAction {
+		kinds[i] = c.AmendingAction[i].Classify(c.Text)
+	}
+	return kinds
+}
@@ -0,0 +1,281 @@
+package uslm
+
+import (
+	"context"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"log/slog"
+	"reflect"
+	"strings"
+	"sync"
+)
+
+// ErrUnknownContent is returned by a strict parse (see ParseOptions.Strict)
+// when the document has an element or attribute the model would drop.
+var ErrUnknownContent = errors.New("unknown content")
+
+// UnknownContent lists the markup a parse dropped because the model has no
+// field for it. Attributes kept in an element's Attrs are not dropped and
+// are not listed, nor is anything inside an unknown element.
+type UnknownContent struct {
+	Elements   []UnknownItem `json:"elements,omitempty"`
+	Attributes []UnknownItem `json:"attributes,omitempty"`
+}
+
+// UnknownItem locates one dropped element or attribute.
+type UnknownItem struct {
+	// Name is the element or attribute name as written, with any prefix
+	// (e.g. "xsi:type").
+	Name string `json:"name"`
+
+	// Path is the slash-separated path of elements from the root to the
+	// element, or for an attribute to the element carrying it.
+	Path string `json:"path"`
+	Line int    `json:"line"`
+}
+
+// Len returns the number of dropped elements and attributes.
+func (u *UnknownContent) Len() int {
+	if u == nil {
+		return 0
+	}
+	return len(u.Elements) + len(u.Attributes)
+}
+
+// Counts returns the number of times each name was dropped, with attribute
+// names prefixed by "@" to tell them from elements.
+func (u *UnknownContent) Counts() map[string]int {
+	counts := make(map[string]int)
+	if u == nil {
+		return counts
+	}
+	for _, item := range u.Elements {
+		counts[item.Name]++
+	}
+	for _, item := range u.Attributes {
+		counts["@"+item.Name]++
+	}
+	return counts
+}
+
+// elementSchema lists the child elements and attributes a model type
+// decodes. Those missing from it are dropped by encoding/xml without notice.
+type elementSchema struct {
+	children map[string]*elementSchema
+	attrs    map[string]bool
+
+	// open schemas accept any children: non-struct types, which keep only
+	// character data, and types that capture raw markup.
+	open bool
+
+	// anyAttr schemas keep every attribute, as types with an Attrs field do.
+	anyAttr bool
+}
+
+// elementSchemas caches the schema built for each model type.
+var elementSchemas sync.Map
+
+// schemaFor returns the schema of t, building it on first use.
+func schemaFor(t reflect.Type) *elementSchema {
+	for t.Kind() == reflect.Pointer || t.Kind() == reflect.Slice {
+		t = t.Elem()
+	}
+	if s, ok := elementSchemas.Load(t); ok {
+		return s.(*elementSchema)
+	}
+	built := make(map[reflect.Type]*elementSchema)
+	s := buildSchema(t, built)
+	for typ, schema := range built {
+		elementSchemas.LoadOrStore(typ, schema)
+	}
+	return s
+}
+
+// buildSchema builds the schema of t, recording every schema it builds in
+// built so recursive types refer back to the same schema.
+func buildSchema(t reflect.Type, built map[reflect.Type]*elementSchema) *elementSchema {
+	for t.Kind() == reflect.Pointer || t.Kind() == reflect.Slice {
+		t = t.Elem()
+	}
+	if s, ok := built[t]; ok {
+		return s
+	}
+	s := &elementSchema{children: make(map[string]*elementSchema), attrs: make(map[string]bool)}
+	built[t] = s
+	if t.Kind() != reflect.Struct {
+		s.open = true
+		return s
+	}
+	addFields(s, t, built)
+	return s
+}
+
+// addFields adds the elements decoded by the fields of struct type t to s.
+func addFields(s *elementSchema, t reflect.Type, built map[reflect.Type]*elementSchema) {
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		tag := f.Tag.Get("xml")
+		if tag == "-" || f.Name == "XMLName" {
+			continue
+		}
+		name, flags, _ := strings.Cut(tag, ",")
+		if f.Anonymous && name == "" {
+			ft := f.Type
+			if ft.Kind() == reflect.Pointer {
+				ft = ft.Elem()
+			}
+			if ft.Kind() == reflect.Struct {
+				addFields(s, ft, built)
+				continue
+			}
+		}
+		if !f.IsExported() {
+			continue
+		}
+		switch {
+		case strings.Contains(flags, "attr"):
+			if strings.Contains(flags, "any") {
+				s.anyAttr = true
+			} else if name != "" {
+				s.attrs[name[strings.LastIndexByte(name, ' ')+1:]] = true
+			} else {
+				s.attrs[f.Name] = true
+			}
+			continue
+		case strings.Contains(flags, "chardata"), strings.Contains(flags, "comment"):
+			continue
+		case strings.Contains(flags, "innerxml"), strings.Contains(flags, "any"):
+			s.open = true
+			continue
+		}
+		if name == "" {
+			name = f.Name
+		}
+		// Drop a namespace ("http://... title"); elements match by local name.
+		if i := strings.LastIndexByte(name, ' '); i >= 0 {
+			name = name[i+1:]
+		}
+
+		// "a>b>c" nests c in elements a and b that have no type of their own.
+		parent := s
+		path := strings.Split(name, ">")
+		for _, step := range path[:len(path)-1] {
+			next, ok := parent.children[step]
+			if !ok {
+				next = &elementSchema{children: make(map[string]*elementSchema), attrs: make(map[string]bool)}
+				parent.children[step] = next
+			}
+			parent = next
+		}
+		parent.children[path[len(path)-1]] = buildSchema(f.Type, built)
+	}
+}
+
+// elementTracker follows decoding through the model's schema and reports
+// the elements and attributes the model has no place for: elements to
+// logger, and both to report. With strict set, the first one found is
+// returned as an error instead.
+type elementTracker struct {
+	logger *slog.Logger
+	report *UnknownContent
+	strict bool
+
+	// stack holds the schema of each open element; nil marks an element
+	// already reported, whose content is not reported again.
+	stack []*elementSchema
+	names []string
+}
+
+// start records a start element, reporting it if its parent cannot hold it
+// and any of its attributes it cannot hold.
+func (t *elementTracker) start(el xml.StartElement, line int) error {
+	name := el.Name.Local
+	var schema *elementSchema
+	known := true
+	switch {
+	case len(t.stack) == 0:
+		if doc := newDocument(DocumentType(name)); doc != nil {
+			schema = schemaFor(reflect.TypeOf(doc))
+		}
+	case t.stack[len(t.stack)-1] == nil:
+	case t.stack[len(t.stack)-1].open:
+		schema = &elementSchema{open: true, anyAttr: true}
+	default:
+		schema, known = t.stack[len(t.stack)-1].children[name]
+	}
+	t.stack = append(t.stack, schema)
+	t.names = append(t.names, name)
+	path := strings.Join(t.names, "/")
+
+	if !known {
+		if t.logger != nil {
+			t.logger.Warn("unknown element ignored",
+				slog.String("element", name),
+				slog.String("path", path),
+				slog.Int("line", line))
+		}
+		if err := t.unknown(false, qualifiedName(el.Name), path, line); err != nil {
+			return err
+		}
+	}
+	if schema == nil || schema.anyAttr {
+		return nil
+	}
+	for _, a := range el.Attr {
+		if a.Name.Space == "xmlns" || a.Name.Space == "" && a.Name.Local == "xmlns" || schema.attrs[a.Name.Local] {
+			continue
+		}
+		if err := t.unknown(true, qualifiedName(a.Name), path, line); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// unknown adds a dropped element or attribute to the report, or returns it
+// as an error in strict mode.
+func (t *elementTracker) unknown(attr bool, name, path string, line int) error {
+	kind := "element"
+	if attr {
+		kind = "attribute"
+	}
+	if t.strict {
+		return fmt.Errorf("%w: %s %s at %s (line %d)", ErrUnknownContent, kind, name, path, line)
+	}
+	if t.report == nil {
+		return nil
+	}
+	item := UnknownItem{Name: name, Path: path, Line: line}
+	if attr {
+		t.report.Attributes = append(t.report.Attributes, item)
+	} else {
+		t.report.Elements = append(t.report.Elements, item)
+	}
+	return nil
+}
+
+// end records an end element.
+func (t *elementTracker) end() {
+	if len(t.stack) > 0 {
+		t.stack = t.stack[:len(t.stack)-1]
+		t.names = t.names[:len(t.names)-1]
+	}
+}
+
+// logIssues logs the document checks that flag recoverable gaps.
+func logIssues(logger *slog.Logger, doc LegislativeDocument) {
+	for _, issue := range CheckRequiredFields(doc) {
+		attrs := []slog.Attr{slog.String("element", issue.Element), slog.String("detail", issue.Message)}
+		if issue.Identifier != "" {
+			attrs = append(attrs, slog.String("identifier", issue.Identifier))
+		}
+		logger.LogAttrs(context.Background(), slog.LevelWarn, "required field missing", attrs...)
+	}
+}
+
+// lineOf returns the line the raw decoder has reached.
+func lineOf(d *xml.Decoder) int {
+	line, _ := d.InputPos()
+	return line
+}
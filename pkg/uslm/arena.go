@@ -0,0 +1,95 @@
+package uslm
+
+import (
+	"reflect"
+)
+
+// Arena allocates decoded documents in large blocks, for batch jobs over
+// many documents where the garbage collector's cost of tracking millions of
+// small elements dominates. The elements of each type are carved from
+// shared slabs, and a document's strings all share one copy of its encoded
+// form, so a document is a few large allocations that are freed together.
+//
+// Arena decoding is experimental. It reads the binary form (see
+// EncodeBinary), since XML decoding allocates inside encoding/xml; batch
+// jobs convert their corpus once and decode the cached form. When the
+// program is built with GOEXPERIMENT=arenas the slabs come from an
+// arena.Arena and Free releases them at once; otherwise they are ordinary
+// heap allocations reclaimed when unreachable.
+//
+// An Arena is not safe for concurrent use: give each worker its own.
+type Arena struct {
+	mem   arenaMemory
+	slabs map[reflect.Type]*arenaSlab
+}
+
+// arenaSlab is the current slab of one element type, how much of it is
+// used, and the size of the next slab to allocate.
+type arenaSlab struct {
+	typ  reflect.Type // slice of the element type
+	slab reflect.Value
+	used int
+	next int
+}
+
+const (
+	minArenaSlab = 16
+	maxArenaSlab = 4096
+)
+
+// NewArena returns an empty arena.
+func NewArena() *Arena {
+	return &Arena{mem: newArenaMemory(), slabs: make(map[reflect.Type]*arenaSlab)}
+}
+
+// Free releases every document decoded in the arena, which must not be used
+// afterwards, and leaves the arena empty for reuse.
+func (a *Arena) Free() {
+	a.mem.free()
+	a.mem = newArenaMemory()
+	a.slabs = make(map[reflect.Type]*arenaSlab)
+}
+
+// DocumentFromBinaryInArena is DocumentFromBinary allocating the document
+// in a. The document remains valid until a is freed.
+func DocumentFromBinaryInArena(a *Arena, data []byte) (LegislativeDocument, error) {
+	return decodeBinaryDocument(data, a)
+}
+
+// new returns a pointer to a zero value of t.
+func (a *Arena) new(t reflect.Type) reflect.Value {
+	slab, i := a.alloc(t, 1)
+	// Addressing the element, unlike slicing the slab, allocates nothing.
+	return slab.Index(i).Addr()
+}
+
+// makeSlice returns a zero slice of type t with length and capacity n.
+func (a *Arena) makeSlice(t reflect.Type, n int) reflect.Value {
+	slab, i := a.alloc(t.Elem(), n)
+	s := slab.Slice3(i, i+n, i+n)
+	if s.Type() != t {
+		// A named slice type, such as Attributes.
+		s = s.Convert(t)
+	}
+	return s
+}
+
+// alloc reserves n elements of type elem, returning the slab holding them
+// and the index of the first. Runs too large to share a slab get one of
+// their own.
+func (a *Arena) alloc(elem reflect.Type, n int) (reflect.Value, int) {
+	s := a.slabs[elem]
+	if s == nil {
+		s = &arenaSlab{typ: reflect.SliceOf(elem), next: minArenaSlab}
+		a.slabs[elem] = s
+	}
+	if !s.slab.IsValid() || s.slab.Len()-s.used < n {
+		if n > s.next/2 {
+			return a.mem.makeSlice(s.typ, n), 0
+		}
+		s.slab, s.used = a.mem.makeSlice(s.typ, s.next), 0
+		s.next = min(s.next*2, maxArenaSlab)
+	}
+	s.used += n
+	return s.slab, s.used - n
+}
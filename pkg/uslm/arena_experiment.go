@@ -0,0 +1,22 @@
+//go:build goexperiment.arenas
+
+package uslm
+
+import (
+	"arena"
+	"reflect"
+)
+
+// arenaMemory allocates an Arena's slabs in an arena.Arena, which Free
+// releases at once.
+type arenaMemory struct {
+	a *arena.Arena
+}
+
+func newArenaMemory() arenaMemory { return arenaMemory{a: arena.NewArena()} }
+
+func (m arenaMemory) free() { m.a.Free() }
+
+func (m arenaMemory) makeSlice(t reflect.Type, n int) reflect.Value {
+	return reflect.ArenaNew(m.a, reflect.ArrayOf(n, t.Elem())).Elem().Slice3(0, n, n)
+}
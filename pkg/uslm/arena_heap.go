@@ -0,0 +1,17 @@
+//go:build !goexperiment.arenas
+
+package uslm
+
+import "reflect"
+
+// arenaMemory allocates an Arena's slabs on the heap. Free has nothing to
+// release: the slabs are collected once no document refers to them.
+type arenaMemory struct{}
+
+func newArenaMemory() arenaMemory { return arenaMemory{} }
+
+func (arenaMemory) free() {}
+
+func (arenaMemory) makeSlice(t reflect.Type, n int) reflect.Value {
+	return reflect.MakeSlice(t, n, n)
+}
@@ -0,0 +1,83 @@
+package uslm
+
+import (
+	"encoding/json"
+	"encoding/xml"
+)
+
+// Attributes holds attributes present on an element that are not modeled by
+// dedicated struct fields (e.g., style, display-inline, positional hints).
+// Capturing them lets print-fidelity tools rely on the parsed result and keeps
+// them intact through XML and JSON round-trips.
+//
+// Namespace declarations are not captured; they are handled by the encoder.
+type Attributes []xml.Attr
+
+// UnmarshalXMLAttr records a single unmodeled attribute.
+func (a *Attributes) UnmarshalXMLAttr(attr xml.Attr) error {
+	if attr.Name.Space == "xmlns" || (attr.Name.Space == "" && attr.Name.Local == "xmlns") {
+		return nil
+	}
+	*a = append(*a, attr)
+	return nil
+}
+
+// Get returns the value of the attribute with the given local name, or an empty string.
+func (a Attributes) Get(local string) string {
+	for _, attr := range a {
+		if attr.Name.Local == local {
+			return attr.Value
+		}
+	}
+	return ""
+}
+
+// Lang returns the xml:lang attribute, or an empty string.
+func (a Attributes) Lang() string {
+	for _, attr := range a {
+		if attr.Name.Local == "lang" && (attr.Name.Space == xmlNamespace || attr.Name.Space == "xml") {
+			return attr.Value
+		}
+	}
+	return ""
+}
+
+// Has reports whether an attribute with the given local name is present.
+func (a Attributes) Has(local string) bool {
+	for _, attr := range a {
+		if attr.Name.Local == local {
+			return true
+		}
+	}
+	return false
+}
+
+// attributeJSON is the JSON form of a single captured attribute.
+type attributeJSON struct {
+	Space string `json:"space,omitempty"`
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// MarshalJSON encodes the attributes as an ordered list of name/value objects.
+func (a Attributes) MarshalJSON() ([]byte, error) {
+	out := make([]attributeJSON, len(a))
+	for i, attr := range a {
+		out[i] = attributeJSON{Space: attr.Name.Space, Name: attr.Name.Local, Value: attr.Value}
+	}
+	return json.Marshal(out)
+}
+
+// UnmarshalJSON decodes attributes written by MarshalJSON.
+func (a *Attributes) UnmarshalJSON(data []byte) error {
+	var in []attributeJSON
+	if err := json.Unmarshal(data, &in); err != nil {
+		return err
+	}
+	attrs := make(Attributes, len(in))
+	for i, attr := range in {
+		attrs[i] = xml.Attr{Name: xml.Name{Space: attr.Space, Local: attr.Name}, Value: attr.Value}
+	}
+	*a = attrs
+	return nil
+}
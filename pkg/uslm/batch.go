@@ -0,0 +1,114 @@
+package uslm
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+)
+
+// ItemError attributes an error to one item of a batch, such as a file.
+type ItemError struct {
+	// Item names the item: a file path, or the name the caller gave it.
+	Item string
+	Err  error
+}
+
+func (e *ItemError) Error() string {
+	return e.Item + ": " + e.Err.Error()
+}
+
+func (e *ItemError) Unwrap() error {
+	return e.Err
+}
+
+// BatchError collects the errors of every item of a batch that failed, so a
+// batch reports all its problems at once rather than stopping at the first.
+// errors.Is and errors.As see through it to each item's error.
+type BatchError struct {
+	Errors []*ItemError
+}
+
+func (e *BatchError) Error() string {
+	if len(e.Errors) == 1 {
+		return e.Errors[0].Error()
+	}
+	lines := make([]string, len(e.Errors))
+	for i, err := range e.Errors {
+		lines[i] = err.Error()
+	}
+	return fmt.Sprintf("%d items failed:\n%s", len(e.Errors), strings.Join(lines, "\n"))
+}
+
+// Unwrap returns the item errors, for errors.Is and errors.As.
+func (e *BatchError) Unwrap() []error {
+	errs := make([]error, len(e.Errors))
+	for i, err := range e.Errors {
+		errs[i] = err
+	}
+	return errs
+}
+
+// Items returns the names of the failed items, in the order reported.
+func (e *BatchError) Items() []string {
+	items := make([]string, len(e.Errors))
+	for i, err := range e.Errors {
+		items[i] = err.Item
+	}
+	return items
+}
+
+// batchErrors accumulates item errors into a BatchError.
+type batchErrors []*ItemError
+
+func (b *batchErrors) add(item string, err error) {
+	*b = append(*b, &ItemError{Item: item, Err: err})
+}
+
+// err returns the accumulated errors as a *BatchError, or nil if there are
+// none.
+func (b batchErrors) err() error {
+	if len(b) == 0 {
+		return nil
+	}
+	return &BatchError{Errors: b}
+}
+
+// ParseFiles reads and parses each file in paths. Every file is attempted:
+// the documents are returned in the order of paths, with nil for each file
+// that could not be read or parsed, and the failures are returned together
+// as a *BatchError attributing each to its path.
+func ParseFiles(paths []string, opts ParseOptions) ([]LegislativeDocument, error) {
+	docs := make([]LegislativeDocument, len(paths))
+	var errs batchErrors
+	for i, path := range paths {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			errs.add(path, fmt.Errorf("failed to read file: %w", err))
+			continue
+		}
+		doc, err := ParseDocumentWithOptions(data, opts)
+		if err != nil {
+			errs.add(path, err)
+			continue
+		}
+		docs[i] = doc
+	}
+	return docs, errs.err()
+}
+
+// CollectScan drains the results of ScanCorpus, returning the documents
+// parsed by path and the failures together as a *BatchError sorted by path.
+func CollectScan(results <-chan ScanResult) (map[string]LegislativeDocument, error) {
+	docs := make(map[string]LegislativeDocument)
+	var errs batchErrors
+	for r := range results {
+		if r.Err != nil {
+			errs.add(r.Path, r.Err)
+			continue
+		}
+		docs[r.Path] = r.Document
+	}
+	sort.SliceStable(errs, func(i, j int) bool { return errs[i].Item < errs[j].Item })
+	return docs, errs.err()
+}
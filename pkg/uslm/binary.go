@@ -0,0 +1,481 @@
+package uslm
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+	"sync"
+)
+
+// The binary form is a compact, schema-less encoding of the model: strings
+// are length-prefixed, pointers and slices carry a presence byte or length,
+// and structs are their exported fields in declaration order, followed by
+// whatever state the type keeps in unexported fields (namespace
+// declarations, lexical forms, and the reading-order text and languages of
+// mixed content). The encoders and decoders for each type are built once per
+// process, so decoding a document costs little more than allocating it. The
+// header carries a fingerprint of the model's layout, and data written for a
+// different layout is rejected rather than misread.
+
+// binaryMagic opens the binary form.
+const binaryMagic = "USLMB1"
+
+// ErrBinaryVersion is returned by DecodeBinary for data written by a
+// version of the package whose model differs from this one. Callers caching
+// binary documents should treat it as a miss and re-parse the source.
+var ErrBinaryVersion = errors.New("binary document written by an incompatible version")
+
+// ToBinary encodes doc in the package's binary form (see EncodeBinary).
+func ToBinary(doc LegislativeDocument) ([]byte, error) {
+	docType := documentTypeOf(doc)
+	if docType == DocumentTypeUnknown {
+		return nil, fmt.Errorf("unsupported document type %T", doc)
+	}
+	v := reflect.ValueOf(doc)
+	if v.IsNil() {
+		return nil, fmt.Errorf("failed to encode document: nil %T", doc)
+	}
+	codec, err := binaryCodecFor(v.Type().Elem())
+	if err != nil {
+		return nil, err
+	}
+	fingerprint := binaryFingerprint()
+	w := &binaryWriter{buf: make([]byte, 0, 4096)}
+	w.buf = append(w.buf, binaryMagic...)
+	w.buf = append(w.buf, fingerprint[:]...)
+	w.string(string(docType))
+	codec.encode(w, v.Elem())
+	return w.buf, nil
+}
+
+// DocumentFromBinary decodes a document encoded by ToBinary or EncodeBinary.
+// It returns an error wrapping ErrBinaryVersion if the data was written by
+// an incompatible version of the package.
+func DocumentFromBinary(data []byte) (LegislativeDocument, error) {
+	return decodeBinaryDocument(data, nil)
+}
+
+// decodeBinaryDocument decodes a document, allocating it in a if a is not
+// nil.
+func decodeBinaryDocument(data []byte, a *Arena) (LegislativeDocument, error) {
+	fingerprint := binaryFingerprint()
+	if !bytes.HasPrefix(data, []byte(binaryMagic)) {
+		return nil, fmt.Errorf("failed to decode document: not a binary document")
+	}
+	data = data[len(binaryMagic):]
+	if len(data) < len(fingerprint) || !bytes.Equal(data[:len(fingerprint)], fingerprint[:]) {
+		return nil, ErrBinaryVersion
+	}
+	r := &binaryReader{data: data[len(fingerprint):], arena: a}
+	if a != nil {
+		r.text = string(r.data)
+	}
+	docType := DocumentType(r.string())
+	doc := newDocument(docType)
+	if doc == nil {
+		return nil, fmt.Errorf("failed to decode document: unknown document type %q", docType)
+	}
+	codec, err := binaryCodecFor(reflect.TypeOf(doc).Elem())
+	if err != nil {
+		return nil, err
+	}
+	p := reflect.ValueOf(doc)
+	if a != nil {
+		p = a.new(p.Type().Elem())
+		doc = p.Interface().(LegislativeDocument)
+	}
+	codec.decode(r, p.Elem())
+	if r.err == nil && len(r.data) > 0 {
+		r.err = fmt.Errorf("%d bytes of trailing data", len(r.data))
+	}
+	if r.err != nil {
+		return nil, fmt.Errorf("failed to decode document: %w", r.err)
+	}
+	return doc, nil
+}
+
+// EncodeBinary writes doc to w in the package's binary form. Unlike JSON,
+// the binary form keeps everything the parsed document holds, so a decoded
+// document marshals to the same XML and extracts the same text, and decoding
+// it is roughly an order of magnitude faster than parsing the XML again (see
+// BenchmarkBinaryDecode). The form is specific to the
+// version of the package that wrote it and is meant for caches, not for
+// interchange or archiving.
+func EncodeBinary(w io.Writer, doc LegislativeDocument) error {
+	data, err := ToBinary(doc)
+	if err != nil {
+		return err
+	}
+	if _, err := w.Write(data); err != nil {
+		return fmt.Errorf("failed to write document: %w", err)
+	}
+	return nil
+}
+
+// DecodeBinary reads a document written by EncodeBinary from r, to its end.
+func DecodeBinary(r io.Reader) (LegislativeDocument, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read document: %w", err)
+	}
+	return DocumentFromBinary(data)
+}
+
+// binaryWriter accumulates the binary form.
+type binaryWriter struct {
+	buf []byte
+}
+
+func (w *binaryWriter) uvarint(n uint64) {
+	w.buf = binary.AppendUvarint(w.buf, n)
+}
+
+func (w *binaryWriter) string(s string) {
+	w.uvarint(uint64(len(s)))
+	w.buf = append(w.buf, s...)
+}
+
+// binaryReader consumes the binary form. After the first error every read
+// returns a zero value, so callers check err once at the end.
+type binaryReader struct {
+	data []byte
+	err  error
+
+	// arena, if set, allocates the decoded values, and text holds the data
+	// as a string that decoded strings share.
+	arena *Arena
+	text  string
+}
+
+func (r *binaryReader) uvarint() uint64 {
+	if r.err != nil {
+		return 0
+	}
+	n, size := binary.Uvarint(r.data)
+	if size <= 0 {
+		r.err = io.ErrUnexpectedEOF
+		return 0
+	}
+	r.data = r.data[size:]
+	return n
+}
+
+// length reads a count of items that each take at least one byte.
+func (r *binaryReader) length() int {
+	n := r.uvarint()
+	if n > uint64(len(r.data)) {
+		if r.err == nil {
+			r.err = io.ErrUnexpectedEOF
+		}
+		return 0
+	}
+	return int(n)
+}
+
+func (r *binaryReader) string() string {
+	n := r.length()
+	if n == 0 {
+		return ""
+	}
+	var s string
+	if r.arena != nil {
+		off := len(r.text) - len(r.data)
+		s = r.text[off : off+n]
+	} else {
+		s = string(r.data[:n])
+	}
+	r.data = r.data[n:]
+	return s
+}
+
+// binaryCodec encodes and decodes values of one type.
+type binaryCodec struct {
+	encode func(w *binaryWriter, v reflect.Value)
+	decode func(r *binaryReader, v reflect.Value)
+}
+
+var (
+	binaryCodecsMu sync.Mutex
+	binaryCodecs   = make(map[reflect.Type]*binaryCodec)
+)
+
+// binaryCodecFor returns the codec for t, building it on first use.
+func binaryCodecFor(t reflect.Type) (*binaryCodec, error) {
+	binaryCodecsMu.Lock()
+	defer binaryCodecsMu.Unlock()
+	return buildBinaryCodec(t)
+}
+
+// buildBinaryCodec builds the codec for t. The codec is registered before
+// its fields are built, so recursive types refer to it.
+func buildBinaryCodec(t reflect.Type) (*binaryCodec, error) {
+	if c, ok := binaryCodecs[t]; ok {
+		return c, nil
+	}
+	c := &binaryCodec{}
+	binaryCodecs[t] = c
+
+	switch t.Kind() {
+	case reflect.String:
+		c.encode = func(w *binaryWriter, v reflect.Value) { w.string(v.String()) }
+		c.decode = func(r *binaryReader, v reflect.Value) { v.SetString(r.string()) }
+
+	case reflect.Pointer:
+		elem, err := buildBinaryCodec(t.Elem())
+		if err != nil {
+			delete(binaryCodecs, t)
+			return nil, err
+		}
+		c.encode = func(w *binaryWriter, v reflect.Value) {
+			if v.IsNil() {
+				w.uvarint(0)
+				return
+			}
+			w.uvarint(1)
+			elem.encode(w, v.Elem())
+		}
+		c.decode = func(r *binaryReader, v reflect.Value) {
+			if r.uvarint() == 0 {
+				return
+			}
+			var p reflect.Value
+			if r.arena != nil {
+				p = r.arena.new(t.Elem())
+			} else {
+				p = reflect.New(t.Elem())
+			}
+			elem.decode(r, p.Elem())
+			v.Set(p)
+		}
+
+	case reflect.Slice:
+		elem, err := buildBinaryCodec(t.Elem())
+		if err != nil {
+			delete(binaryCodecs, t)
+			return nil, err
+		}
+		// Lengths are stored plus one so nil and empty slices stay distinct.
+		c.encode = func(w *binaryWriter, v reflect.Value) {
+			if v.IsNil() {
+				w.uvarint(0)
+				return
+			}
+			w.uvarint(uint64(v.Len()) + 1)
+			for i := 0; i < v.Len(); i++ {
+				elem.encode(w, v.Index(i))
+			}
+		}
+		c.decode = func(r *binaryReader, v reflect.Value) {
+			n := r.length()
+			if n == 0 {
+				return
+			}
+			var s reflect.Value
+			if r.arena != nil {
+				s = r.arena.makeSlice(t, n-1)
+			} else {
+				s = reflect.MakeSlice(t, n-1, n-1)
+			}
+			for i := 0; i < n-1 && r.err == nil; i++ {
+				elem.decode(r, s.Index(i))
+			}
+			v.Set(s)
+		}
+
+	case reflect.Struct:
+		type field struct {
+			index int
+			codec *binaryCodec
+		}
+		var fields []field
+		for i := 0; i < t.NumField(); i++ {
+			if !t.Field(i).IsExported() {
+				continue
+			}
+			fc, err := buildBinaryCodec(t.Field(i).Type)
+			if err != nil {
+				delete(binaryCodecs, t)
+				return nil, err
+			}
+			fields = append(fields, field{i, fc})
+		}
+		hidden := hasHiddenState(t)
+		c.encode = func(w *binaryWriter, v reflect.Value) {
+			for _, f := range fields {
+				f.codec.encode(w, v.Field(f.index))
+			}
+			if hidden {
+				encodeHiddenState(w, v.Addr().Interface())
+			}
+		}
+		c.decode = func(r *binaryReader, v reflect.Value) {
+			for _, f := range fields {
+				f.codec.decode(r, v.Field(f.index))
+			}
+			if hidden {
+				decodeHiddenState(r, v.Addr().Interface())
+			}
+		}
+
+	default:
+		delete(binaryCodecs, t)
+		return nil, fmt.Errorf("failed to encode document: unsupported type %s", t)
+	}
+	return c, nil
+}
+
+// hasHiddenState reports whether values of t keep state in unexported
+// fields, which encodeHiddenState and decodeHiddenState handle.
+func hasHiddenState(t reflect.Type) bool {
+	switch reflect.New(t).Interface().(type) {
+	case *Bill, *Resolution, *EngrossedAmendment, *Amendment, *GenericDocument,
+		*Content, *Chapeau, *Heading, *ActionDate, *AddedText, *DeletedText, *Recital:
+		return true
+	}
+	return false
+}
+
+// encodeHiddenState writes the unexported state of p, a pointer to a type
+// for which hasHiddenState is true.
+func encodeHiddenState(w *binaryWriter, p interface{}) {
+	switch e := p.(type) {
+	case *Content:
+		encodeMixedText(w, e.text, e.langs)
+	case *Chapeau:
+		encodeMixedText(w, e.text, e.langs)
+	case *Heading:
+		encodeMixedText(w, e.text, e.langs)
+	case *ActionDate:
+		w.string(e.text)
+	case *AddedText:
+		w.string(e.text)
+	case *DeletedText:
+		w.string(e.text)
+	case *Recital:
+		w.string(e.text)
+	case LegislativeDocument:
+		decls := namespacesOf(e)
+		w.uvarint(uint64(len(decls)))
+		for _, d := range decls {
+			w.string(d.prefix)
+			w.string(d.uri)
+		}
+		forms := lexicalOf(e)
+		if forms == nil {
+			w.uvarint(0)
+			return
+		}
+		w.uvarint(1)
+		w.string(forms.doctype)
+		w.uvarint(uint64(len(forms.spellings)))
+		for _, s := range forms.spellings {
+			w.string(s.text)
+			w.string(s.source)
+		}
+	}
+}
+
+// decodeHiddenState reads the state written by encodeHiddenState into p.
+func decodeHiddenState(r *binaryReader, p interface{}) {
+	switch e := p.(type) {
+	case *Content:
+		e.text, e.langs = decodeMixedText(r)
+	case *Chapeau:
+		e.text, e.langs = decodeMixedText(r)
+	case *Heading:
+		e.text, e.langs = decodeMixedText(r)
+	case *ActionDate:
+		e.text = r.string()
+	case *AddedText:
+		e.text = r.string()
+	case *DeletedText:
+		e.text = r.string()
+	case *Recital:
+		e.text = r.string()
+	case LegislativeDocument:
+		var decls []namespaceDecl
+		for n := r.length(); n > 0 && r.err == nil; n-- {
+			decls = append(decls, namespaceDecl{prefix: r.string(), uri: r.string()})
+		}
+		setNamespaces(e, decls)
+		if r.uvarint() == 0 {
+			return
+		}
+		forms := &lexicalForms{doctype: r.string()}
+		for n := r.length(); n > 0 && r.err == nil; n-- {
+			forms.spellings = append(forms.spellings, spelling{text: r.string(), source: r.string()})
+		}
+		setLexical(e, forms)
+	}
+}
+
+func encodeMixedText(w *binaryWriter, text string, langs []langSpan) {
+	w.string(text)
+	w.uvarint(uint64(len(langs)))
+	for _, s := range langs {
+		w.uvarint(uint64(s.start))
+		w.uvarint(uint64(s.end))
+		w.string(s.lang)
+	}
+}
+
+func decodeMixedText(r *binaryReader) (string, []langSpan) {
+	text := r.string()
+	var langs []langSpan
+	for n := r.length(); n > 0 && r.err == nil; n-- {
+		langs = append(langs, langSpan{start: int(r.uvarint()), end: int(r.uvarint()), lang: r.string()})
+	}
+	return text, langs
+}
+
+var (
+	binaryFingerprintOnce  sync.Once
+	binaryFingerprintValue [8]byte
+)
+
+// binaryFingerprint returns a hash of the layout of the root document types:
+// the names, kinds, and order of every field the binary form encodes.
+func binaryFingerprint() [8]byte {
+	binaryFingerprintOnce.Do(func() {
+		var b strings.Builder
+		seen := make(map[reflect.Type]bool)
+		var describe func(t reflect.Type)
+		describe = func(t reflect.Type) {
+			b.WriteString(t.String())
+			if seen[t] {
+				return
+			}
+			seen[t] = true
+			switch t.Kind() {
+			case reflect.Pointer, reflect.Slice:
+				b.WriteString("(")
+				describe(t.Elem())
+				b.WriteString(")")
+			case reflect.Struct:
+				b.WriteString("{")
+				for i := 0; i < t.NumField(); i++ {
+					if f := t.Field(i); f.IsExported() {
+						b.WriteString(f.Name + " ")
+						describe(f.Type)
+						b.WriteString(";")
+					}
+				}
+				if hasHiddenState(t) {
+					b.WriteString("+")
+				}
+				b.WriteString("}")
+			}
+		}
+		for _, doc := range []LegislativeDocument{&Bill{}, &Resolution{}, &EngrossedAmendment{}, &Amendment{}, &GenericDocument{}} {
+			describe(reflect.TypeOf(doc).Elem())
+		}
+		sum := sha256.Sum256([]byte(b.String()))
+		copy(binaryFingerprintValue[:], sum[:])
+	})
+	return binaryFingerprintValue
+}
@@ -0,0 +1,313 @@
+package uslm
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Canonicalization algorithms supported for signature verification.
+const (
+	C14NMethod                = "http://www.w3.org/TR/2001/REC-xml-c14n-20010315"
+	C14NWithCommentsMethod    = C14NMethod + "#WithComments"
+	ExcC14NMethod             = "http://www.w3.org/2001/10/xml-exc-c14n#"
+	ExcC14NWithCommentsMethod = ExcC14NMethod + "WithComments"
+)
+
+// xmlNamespace is the namespace bound to the reserved "xml" prefix.
+const xmlNamespace = "http://www.w3.org/XML/1998/namespace"
+
+var (
+	c14nTextEscaper = strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;", "\r", "&#xD;")
+	c14nAttrEscaper = strings.NewReplacer("&", "&amp;", "<", "&lt;", `"`, "&quot;", "\t", "&#x9;", "\n", "&#xA;", "\r", "&#xD;")
+)
+
+// canonicalizer writes the canonical form of a Node tree under Canonical XML
+// 1.0 or Exclusive XML Canonicalization 1.0. The tree already has entities
+// expanded, CDATA sections merged into text, and line endings normalized by
+// the parser, which leaves namespace, attribute, and serialization rules.
+type canonicalizer struct {
+	exclusive bool
+	comments  bool
+
+	// prefixes is the exclusive InclusiveNamespaces PrefixList; "" stands
+	// for "#default".
+	prefixes map[string]bool
+
+	// omit is an element left out of the output, as by the
+	// enveloped-signature transform.
+	omit *Node
+
+	buf bytes.Buffer
+}
+
+// newCanonicalizer returns a canonicalizer for the algorithm URI. The prefix
+// list applies only to exclusive canonicalization.
+func newCanonicalizer(method, prefixList string) (*canonicalizer, error) {
+	c := &canonicalizer{}
+	switch method {
+	case C14NMethod:
+	case C14NWithCommentsMethod:
+		c.comments = true
+	case ExcC14NMethod:
+		c.exclusive = true
+	case ExcC14NWithCommentsMethod:
+		c.exclusive, c.comments = true, true
+	default:
+		return nil, fmt.Errorf("unsupported canonicalization method %q", method)
+	}
+	if c.exclusive {
+		c.prefixes = make(map[string]bool)
+		for _, p := range strings.Fields(prefixList) {
+			if p == "#default" {
+				p = ""
+			}
+			c.prefixes[p] = true
+		}
+	}
+	return c, nil
+}
+
+// canonicalize returns the canonical form of n, a DocumentNode or an element
+// whose ancestors are given outermost first. The ancestors supply the
+// namespaces (and, for inclusive canonicalization, the xml:* attributes) in
+// scope at n.
+func (c *canonicalizer) canonicalize(n *Node, ancestors []*Node) []byte {
+	c.buf.Reset()
+	if n.Type == DocumentNode {
+		c.document(n)
+	} else {
+		scope := map[string]string{}
+		for _, a := range ancestors {
+			scope = withDeclarations(scope, a)
+		}
+		var inherited []NodeAttr
+		if !c.exclusive {
+			inherited = inheritedXMLAttrs(ancestors)
+		}
+		c.element(n, scope, map[string]string{}, inherited)
+	}
+	return c.buf.Bytes()
+}
+
+// document writes the root element, separating comments and processing
+// instructions before and after it with newlines. The XML declaration and
+// DOCTYPE are dropped.
+func (c *canonicalizer) document(n *Node) {
+	afterRoot := false
+	for _, child := range n.Children {
+		switch child.Type {
+		case ElementNode:
+			c.element(child, map[string]string{}, map[string]string{}, nil)
+			afterRoot = true
+		case CommentNode, ProcInstNode:
+			if child.Type == CommentNode && !c.comments {
+				continue
+			}
+			if afterRoot {
+				c.buf.WriteByte('\n')
+			}
+			c.node(child)
+			if !afterRoot {
+				c.buf.WriteByte('\n')
+			}
+		}
+	}
+}
+
+// element writes n and its content. scope holds the namespaces in scope at
+// n's parent and rendered those declared by its nearest output ancestors.
+func (c *canonicalizer) element(n *Node, scope, rendered map[string]string, inherited []NodeAttr) {
+	scope = withDeclarations(scope, n)
+
+	var candidates []string
+	if c.exclusive {
+		candidates = append(candidates, namePrefix(n.Name))
+		for _, a := range n.Attrs {
+			if p := namePrefix(a.Name); p != "" && !isNamespaceDecl(a.Name) {
+				candidates = append(candidates, p)
+			}
+		}
+		for p := range c.prefixes {
+			if _, ok := scope[p]; ok || p == "" {
+				candidates = append(candidates, p)
+			}
+		}
+	} else {
+		candidates = append(candidates, "")
+		for p := range scope {
+			candidates = append(candidates, p)
+		}
+	}
+
+	var decls []string
+	seen := make(map[string]bool)
+	for _, p := range candidates {
+		if p == "xml" || seen[p] {
+			continue
+		}
+		seen[p] = true
+		uri := scope[p]
+		if have, ok := rendered[p]; ok && have == uri || !ok && uri == "" {
+			continue
+		}
+		decls = append(decls, p)
+	}
+	sort.Strings(decls)
+	if len(decls) > 0 {
+		next := make(map[string]string, len(rendered)+len(decls))
+		for p, uri := range rendered {
+			next[p] = uri
+		}
+		for _, p := range decls {
+			next[p] = scope[p]
+		}
+		rendered = next
+	}
+
+	attrs := make([]NodeAttr, 0, len(n.Attrs)+len(inherited))
+	for _, a := range n.Attrs {
+		if !isNamespaceDecl(a.Name) {
+			attrs = append(attrs, a)
+		}
+	}
+	for _, a := range inherited {
+		if !hasAttr(n, a.Name) {
+			attrs = append(attrs, a)
+		}
+	}
+	attrNamespace := func(name string) string {
+		switch p := namePrefix(name); p {
+		case "":
+			return ""
+		case "xml":
+			return xmlNamespace
+		default:
+			return scope[p]
+		}
+	}
+	sort.SliceStable(attrs, func(i, j int) bool {
+		si, sj := attrNamespace(attrs[i].Name), attrNamespace(attrs[j].Name)
+		if si != sj {
+			return si < sj
+		}
+		return localPart(attrs[i].Name) < localPart(attrs[j].Name)
+	})
+
+	c.buf.WriteString("<" + n.Name)
+	for _, p := range decls {
+		name := "xmlns"
+		if p != "" {
+			name += ":" + p
+		}
+		c.buf.WriteString(" " + name + `="` + c14nAttrEscaper.Replace(scope[p]) + `"`)
+	}
+	for _, a := range attrs {
+		c.buf.WriteString(" " + a.Name + `="` + c14nAttrEscaper.Replace(a.Value) + `"`)
+	}
+	c.buf.WriteByte('>')
+	for _, child := range n.Children {
+		if child.Type == ElementNode {
+			if child != c.omit {
+				c.element(child, scope, rendered, nil)
+			}
+			continue
+		}
+		c.node(child)
+	}
+	c.buf.WriteString("</" + n.Name + ">")
+}
+
+// node writes a text, comment, or processing instruction node.
+func (c *canonicalizer) node(n *Node) {
+	switch n.Type {
+	case TextNode:
+		c.buf.WriteString(c14nTextEscaper.Replace(n.Text))
+	case CommentNode:
+		if c.comments {
+			c.buf.WriteString("<!--" + n.Text + "-->")
+		}
+	case ProcInstNode:
+		c.buf.WriteString("<?" + n.Name)
+		if n.Text != "" {
+			c.buf.WriteString(" " + n.Text)
+		}
+		c.buf.WriteString("?>")
+	}
+}
+
+// withDeclarations returns scope extended by the namespace declarations on
+// n, copying it only when n declares any.
+func withDeclarations(scope map[string]string, n *Node) map[string]string {
+	copied := false
+	for _, a := range n.Attrs {
+		if !isNamespaceDecl(a.Name) {
+			continue
+		}
+		if !copied {
+			next := make(map[string]string, len(scope)+1)
+			for p, uri := range scope {
+				next[p] = uri
+			}
+			scope, copied = next, true
+		}
+		if a.Name == "xmlns" {
+			scope[""] = a.Value
+		} else {
+			scope[localPart(a.Name)] = a.Value
+		}
+	}
+	return scope
+}
+
+// inheritedXMLAttrs returns the xml:* attributes in effect from ancestors,
+// which inclusive canonicalization copies onto the apex of a subtree.
+func inheritedXMLAttrs(ancestors []*Node) []NodeAttr {
+	var names []string
+	values := make(map[string]string)
+	for _, a := range ancestors {
+		for _, attr := range a.Attrs {
+			if namePrefix(attr.Name) != "xml" {
+				continue
+			}
+			if _, ok := values[attr.Name]; !ok {
+				names = append(names, attr.Name)
+			}
+			values[attr.Name] = attr.Value
+		}
+	}
+	attrs := make([]NodeAttr, len(names))
+	for i, name := range names {
+		attrs[i] = NodeAttr{Name: name, Value: values[name]}
+	}
+	return attrs
+}
+
+// hasAttr reports whether n has an attribute with the qualified name.
+func hasAttr(n *Node, name string) bool {
+	for _, a := range n.Attrs {
+		if a.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// isNamespaceDecl reports whether an attribute name declares a namespace.
+func isNamespaceDecl(name string) bool {
+	return name == "xmlns" || strings.HasPrefix(name, "xmlns:")
+}
+
+// namePrefix returns the prefix of a qualified name, or an empty string.
+func namePrefix(name string) string {
+	if i := strings.IndexByte(name, ':'); i >= 0 {
+		return name[:i]
+	}
+	return ""
+}
+
+// localPart returns a qualified name without its prefix.
+func localPart(name string) string {
+	return name[strings.IndexByte(name, ':')+1:]
+}
@@ -0,0 +1,110 @@
+package uslm
+
+import (
+	"container/list"
+	"crypto/sha256"
+	"encoding/hex"
+	"sync"
+)
+
+// DocumentCache is a fixed-capacity, least-recently-used cache of parsed
+// documents keyed by package ID (e.g., "BILLS-114s32cds") or by ContentHash.
+// It is safe for concurrent use.
+//
+// Cached documents are shared between callers and must be treated as
+// read-only; functions that modify a document, such as AssignIdentifiers,
+// should be given a freshly parsed copy.
+type DocumentCache struct {
+	mu       sync.Mutex
+	capacity int
+	order    *list.List // front is most recently used
+	items    map[string]*list.Element
+}
+
+// cacheEntry is the value stored in DocumentCache.order.
+type cacheEntry struct {
+	key string
+	doc LegislativeDocument
+}
+
+// NewDocumentCache returns a cache holding at most capacity documents. A
+// capacity below 1 is treated as 1.
+func NewDocumentCache(capacity int) *DocumentCache {
+	if capacity < 1 {
+		capacity = 1
+	}
+	return &DocumentCache{
+		capacity: capacity,
+		order:    list.New(),
+		items:    make(map[string]*list.Element),
+	}
+}
+
+// ContentHash returns the hex-encoded SHA-256 of data, suitable as a cache key
+// when no package ID is known.
+func ContentHash(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+// Get returns the document cached under key and marks it recently used.
+func (c *DocumentCache) Get(key string) (LegislativeDocument, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if e, ok := c.items[key]; ok {
+		c.order.MoveToFront(e)
+		return e.Value.(*cacheEntry).doc, true
+	}
+	return nil, false
+}
+
+// Add caches doc under key, evicting the least recently used document if the
+// cache is full.
+func (c *DocumentCache) Add(key string, doc LegislativeDocument) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if e, ok := c.items[key]; ok {
+		e.Value.(*cacheEntry).doc = doc
+		c.order.MoveToFront(e)
+		return
+	}
+	c.items[key] = c.order.PushFront(&cacheEntry{key: key, doc: doc})
+	for c.order.Len() > c.capacity {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.items, oldest.Value.(*cacheEntry).key)
+	}
+}
+
+// Remove drops the document cached under key, if any.
+func (c *DocumentCache) Remove(key string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if e, ok := c.items[key]; ok {
+		c.order.Remove(e)
+		delete(c.items, key)
+	}
+}
+
+// Len returns the number of cached documents.
+func (c *DocumentCache) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.order.Len()
+}
+
+// Parse returns the cached document for data's ContentHash, parsing and
+// caching it on a miss. Concurrent misses for the same data may each parse
+// it; the last result is kept.
+func (c *DocumentCache) Parse(data []byte) (LegislativeDocument, error) {
+	key := ContentHash(data)
+	if doc, ok := c.Get(key); ok {
+		return doc, nil
+	}
+	doc, err := ParseDocument(data)
+	if err != nil {
+		return nil, err
+	}
+	c.Add(key, doc)
+	return doc, nil
+}
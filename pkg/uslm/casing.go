@@ -0,0 +1,134 @@
+package uslm
+
+import (
+	"regexp"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// minorWords are the articles, conjunctions, and short prepositions that
+// TitleCaseHeading leaves in lower case within a heading.
+var minorWords = map[string]bool{
+	"a": true, "an": true, "and": true, "as": true, "at": true, "but": true,
+	"by": true, "for": true, "from": true, "in": true, "into": true,
+	"nor": true, "of": true, "on": true, "or": true, "per": true,
+	"the": true, "to": true, "upon": true, "via": true, "with": true,
+}
+
+// headingAcronyms are acronyms common in legislative headings, which
+// TitleCaseHeading keeps in capitals even when the whole heading is in
+// capitals and they cannot otherwise be told from words.
+var headingAcronyms = map[string]bool{
+	"AIDS": true, "CDC": true, "COVID": true, "DHS": true, "DOD": true,
+	"DOE": true, "EPA": true, "FAA": true, "FBI": true, "FCC": true,
+	"FDA": true, "FEC": true, "FEMA": true, "GAO": true, "GSA": true,
+	"HHS": true, "HIV": true, "HUD": true, "IRS": true, "NASA": true,
+	"NATO": true, "NIH": true, "NOAA": true, "NSF": true, "OMB": true,
+	"OPM": true, "SNAP": true, "STEM": true, "TANF": true, "TSA": true,
+	"USA": true, "USDA": true, "VA": true,
+}
+
+// designators are the words that precede the number or letter of a level,
+// as in "Subtitle A" and "Part I".
+var designators = map[string]bool{
+	"title": true, "subtitle": true, "chapter": true, "subchapter": true,
+	"part": true, "subpart": true, "division": true, "subdivision": true,
+	"section": true, "sec": true, "article": true, "appendix": true,
+}
+
+var (
+	// dottedAbbreviation matches abbreviations such as "U.S." and "D.C.".
+	dottedAbbreviation = regexp.MustCompile(`^(\pL\.)+\pL?\.?$`)
+
+	// romanNumeral matches the numerals of titles, parts, and divisions.
+	romanNumeral = regexp.MustCompile(`^(?i)(XC|XL|L?X{0,3})(IX|IV|V?I{0,3})$`)
+)
+
+// TitleCaseHeading returns a heading in title case, however it was cased in
+// the source: "SHORT TITLE; TABLE OF CONTENTS." and a small-caps "Short
+// title" both read "Short Title; Table of Contents." and "Short Title".
+// Articles, conjunctions, and short prepositions are lower case except at
+// the start or end of the heading or of a clause, including within
+// hyphenated words ("Self-Determination", "Right-of-Way"), and the letter
+// of a designation stays a capital ("Subtitle A"). Abbreviations such as
+// "U.S.", roman numerals, words with digits, and acronyms are kept in
+// capitals: in a heading that is all capitals, the common acronyms of
+// federal law, and otherwise any word written in capitals.
+func TitleCaseHeading(s string) string {
+	s = normalizeSpace(s)
+	if s == "" {
+		return s
+	}
+	allCaps := strings.ToUpper(s) == s
+
+	words := strings.Split(s, " ")
+	first, designated := true, false
+	for i, word := range words {
+		var b strings.Builder
+		start := 0
+		for j, r := range word {
+			dash := r == '—' || r == '–'
+			if !dash && r != '-' && r != '/' {
+				continue
+			}
+			b.WriteString(titleCaseWord(word[start:j], allCaps, first, designated))
+			b.WriteRune(r)
+			start = j + utf8.RuneLen(r)
+			// A dash begins a new clause, while the parts of a hyphenated
+			// compound are cased as words within it.
+			first, designated = dash, false
+		}
+		last := word[start:]
+		b.WriteString(titleCaseWord(last, allCaps, first || i == len(words)-1, designated))
+		words[i] = b.String()
+
+		end, _ := utf8.DecodeLastRuneInString(word)
+		first = strings.ContainsRune(":;!?—–", end) || end == '.' && !dottedAbbreviation.MatchString(last)
+		designated = designators[strings.ToLower(strings.TrimFunc(last, func(r rune) bool { return !isWordRune(r) }))]
+	}
+	return strings.Join(words, " ")
+}
+
+// titleCaseWord title-cases a single word, which may carry leading and
+// trailing punctuation. A minor word is capitalized only if first is set,
+// and a single letter following a designator is kept in capitals.
+func titleCaseWord(word string, allCaps, first, designated bool) string {
+	begin := strings.IndexFunc(word, isWordRune)
+	if begin < 0 {
+		return word
+	}
+	end := strings.LastIndexFunc(word, isWordRune) + 1
+	for end < len(word) && word[end] == '.' && dottedAbbreviation.MatchString(word[begin:end+1]) {
+		end++
+	}
+	prefix, core, suffix := word[:begin], word[begin:end], word[end:]
+
+	upper := strings.ToUpper(core)
+	switch {
+	case designated && utf8.RuneCountInString(core) == 1:
+		return prefix + upper + suffix
+	case dottedAbbreviation.MatchString(core) && strings.Contains(core, "."),
+		romanNumeral.MatchString(core),
+		strings.IndexFunc(core, unicode.IsDigit) >= 0:
+		if allCaps || core == upper {
+			return prefix + upper + suffix
+		}
+		return word
+	case allCaps && headingAcronyms[upper]:
+		return word
+	case !allCaps && len(core) > 1 && core == upper:
+		return word
+	case !allCaps && strings.IndexFunc(core[1:], unicode.IsUpper) >= 0:
+		// Deliberately mixed case, as in "McDonald" or "eBay".
+		return word
+	}
+
+	lower := strings.ToLower(core)
+	if minorWords[lower] && !first {
+		return prefix + lower + suffix
+	}
+	r := []rune(lower)
+	r[0] = unicode.ToUpper(r[0])
+	return prefix + string(r) + suffix
+}
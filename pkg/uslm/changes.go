@@ -0,0 +1,172 @@
+package uslm
+
+import (
+	"strconv"
+	"strings"
+)
+
+// ChangeMarker is the changed attribute a reported or engrossed version sets
+// on a level or recital to mark an amendment proposed to it: added text is
+// printed in italic (or the reporting committee's addedDisplayStyle) and
+// deleted text struck through.
+type ChangeMarker string
+
+const (
+	ChangeAdded      ChangeMarker = "added"
+	ChangeDeleted    ChangeMarker = "deleted"
+	ChangeNotChanged ChangeMarker = "notChanged"
+)
+
+// ProposedChange is a level or recital that a proposed amendment strikes or
+// inserts, with the levels nested in it.
+type ProposedChange struct {
+	Change     ChangeMarker `json:"change"`
+	Element    string       `json:"element"`
+	ID         string       `json:"id,omitempty"`
+	Identifier string       `json:"identifier,omitempty"`
+
+	// Citation locates the change, e.g. "Section 701(a)" or "Recital 3".
+	Citation string `json:"citation"`
+
+	// Text is the struck or inserted text, one level per line.
+	Text string `json:"text"`
+}
+
+// ProposedChanges separates the text of a document as it stands from the
+// text proposed for it.
+type ProposedChanges struct {
+	// Struck lists the deleted levels and recitals, and Inserted the added
+	// ones, in document order.
+	Struck   []ProposedChange `json:"struck,omitempty"`
+	Inserted []ProposedChange `json:"inserted,omitempty"`
+
+	// Original is the text without the insertions, and Proposed the text
+	// with the insertions made and the struck text removed, one level or
+	// recital per line.
+	Original string `json:"original"`
+	Proposed string `json:"proposed"`
+}
+
+// GetProposedChanges reads the changed markers of doc's recitals and levels.
+// A level takes the marker of the nearest enclosing level that has one, so a
+// struck section strikes all of its subsections; "notChanged" marks text
+// common to both versions. Only the outermost level of each struck or
+// inserted run is listed, with the text of the levels nested in it.
+//
+// Words struck or inserted within the content of an unchanged level, marked
+// by <deletedText> and <addedText>, are listed as changes of element
+// "deletedText" or "addedText" cited to that level.
+func GetProposedChanges(doc LegislativeDocument) *ProposedChanges {
+	changes := &ProposedChanges{}
+	var original, proposed []string
+	add := func(marker ChangeMarker, orig, prop string) {
+		if marker != ChangeAdded {
+			original = append(original, orig)
+		}
+		if marker != ChangeDeleted {
+			proposed = append(proposed, prop)
+		}
+	}
+	record := func(change ProposedChange) {
+		switch change.Change {
+		case ChangeDeleted:
+			changes.Struck = append(changes.Struck, change)
+		case ChangeAdded:
+			changes.Inserted = append(changes.Inserted, change)
+		}
+	}
+
+	if r, ok := doc.(*Resolution); ok && r.Main != nil && r.Main.Preamble != nil {
+		for i := range r.Main.Preamble.Recitals {
+			rec := &r.Main.Preamble.Recitals[i]
+			text := rec.PlainText()
+			change := ProposedChange{Change: rec.Changed, Element: "recital", Citation: "Recital " + strconv.Itoa(i+1), Text: text}
+			record(change)
+			add(change.Change, text, text)
+		}
+	}
+
+	for _, top := range Provisions(doc) {
+		changeProvision(top, "", record, add)
+	}
+	changes.Original = strings.Join(original, "\n")
+	changes.Proposed = strings.Join(proposed, "\n")
+	return changes
+}
+
+// changeProvision adds p and its children to the running texts, under the
+// marker inherited from p's parent, and records each run of struck or
+// inserted levels where it starts.
+func changeProvision(p *Provision, inherited ChangeMarker, record func(ProposedChange), add func(ChangeMarker, string, string)) {
+	marker := inherited
+	if p.Changed != "" {
+		marker = p.Changed
+	}
+	line := changeLine(p)
+	orig, prop := line, line
+	if p.Content != nil {
+		for i := range p.Content.AddedText {
+			text := p.Content.AddedText[i].PlainText()
+			orig = removeRun(orig, text)
+			if marker == "" || marker == ChangeNotChanged {
+				record(inlineChange(p, ChangeAdded, "addedText", text))
+			}
+		}
+		for i := range p.Content.DeletedText {
+			text := p.Content.DeletedText[i].PlainText()
+			prop = removeRun(prop, text)
+			if marker == "" || marker == ChangeNotChanged {
+				record(inlineChange(p, ChangeDeleted, "deletedText", text))
+			}
+		}
+	}
+	add(marker, orig, prop)
+	if marker != inherited {
+		var lines []string
+		p.Walk(func(q *Provision) bool {
+			if q != p && q.Changed != "" && q.Changed != marker {
+				return false
+			}
+			lines = append(lines, changeLine(q))
+			return true
+		})
+		record(ProposedChange{
+			Change:     marker,
+			Element:    p.Element,
+			ID:         p.ID,
+			Identifier: p.Identifier,
+			Citation:   p.PathString(),
+			Text:       strings.Join(lines, "\n"),
+		})
+	}
+	for _, c := range p.Children {
+		changeProvision(c, marker, record, add)
+	}
+}
+
+// changeLine returns the number, heading, and text of p on one line.
+func changeLine(p *Provision) string {
+	return joinPrintParts(joinPrintParts(normalizeSpace(p.GetNum()), p.GetHeading()), p.GetText())
+}
+
+// inlineChange returns the change for a run of words struck or inserted
+// within p.
+func inlineChange(p *Provision, marker ChangeMarker, element, text string) ProposedChange {
+	return ProposedChange{
+		Change:     marker,
+		Element:    element,
+		ID:         p.ID,
+		Identifier: p.Identifier,
+		Citation:   p.PathString(),
+		Text:       text,
+	}
+}
+
+// removeRun removes the first occurrence of run from line.
+func removeRun(line, run string) string {
+	run = normalizeSpace(run)
+	if run == "" {
+		return line
+	}
+	return normalizeSpace(strings.Replace(line, run, "", 1))
+}
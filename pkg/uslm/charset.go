@@ -0,0 +1,172 @@
+package uslm
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+)
+
+// Some older bill files declare encoding="ISO-8859-1", and others declare
+// nothing yet contain Windows-1252 bytes (typically curly quotes and dashes
+// pasted from word processors). encoding/xml rejects both: the first because
+// it has no CharsetReader, the second as invalid UTF-8. The readers here
+// convert such input to UTF-8 before it is decoded.
+//
+// As browsers do, ISO-8859-1 and US-ASCII are decoded as Windows-1252, which
+// agrees with them on every byte they define and gives the bytes 0x80-0x9F
+// the punctuation the files actually mean by them.
+
+// xmlEncodingPattern matches the encoding declared by an XML declaration.
+var xmlEncodingPattern = regexp.MustCompile(`^\s*<\?xml\s[^>]*?\bencoding\s*=\s*["']([A-Za-z0-9._:-]+)["']`)
+
+// windows1252Labels are the encoding names converted by charsetReader.
+var windows1252Labels = map[string]bool{
+	"windows-1252": true, "cp1252": true, "x-cp1252": true,
+	"iso-8859-1": true, "iso8859-1": true, "iso_8859-1": true, "latin1": true, "l1": true,
+	"us-ascii": true, "ascii": true,
+}
+
+// charsetReader is the xml.Decoder CharsetReader. It converts the encodings
+// in windows1252Labels to UTF-8 and rejects any other.
+func charsetReader(label string, input io.Reader) (io.Reader, error) {
+	if !windows1252Labels[strings.ToLower(label)] {
+		return nil, fmt.Errorf("unsupported encoding %q", label)
+	}
+	return &transcoder{r: input, convert: decodeWindows1252}, nil
+}
+
+// utf8Input prepares r for decoding. Input that declares an encoding other
+// than UTF-8 is returned unchanged for charsetReader to convert once the
+// decoder reads the declaration. Otherwise the input is read as UTF-8 with
+// any byte that is not part of a valid UTF-8 sequence decoded as
+// Windows-1252, so that stray legacy bytes do not fail the parse.
+func utf8Input(r io.Reader) io.Reader {
+	br := bufio.NewReader(r)
+	head, _ := br.Peek(512)
+	head = bytes.TrimPrefix(head, []byte("\xef\xbb\xbf"))
+	if m := xmlEncodingPattern.FindSubmatch(head); m != nil {
+		if label := strings.ToLower(string(m[1])); label != "utf-8" && label != "utf8" {
+			return br
+		}
+	}
+	return &transcoder{r: br, convert: repairUTF8}
+}
+
+// utf8Text returns data as a decoder reading it through utf8Input sees it
+// once charsetReader has converted any encoding it declares.
+func utf8Text(data []byte) ([]byte, error) {
+	head := bytes.TrimPrefix(data[:min(len(data), 512)], []byte("\xef\xbb\xbf"))
+	if m := xmlEncodingPattern.FindSubmatch(head); m != nil {
+		if label := strings.ToLower(string(m[1])); label != "utf-8" && label != "utf8" {
+			r, err := charsetReader(label, bytes.NewReader(data))
+			if err != nil {
+				return nil, err
+			}
+			return io.ReadAll(r)
+		}
+	}
+	if utf8.Valid(data) {
+		return data, nil
+	}
+	return io.ReadAll(utf8Input(bytes.NewReader(data)))
+}
+
+// transcoder is a reader converting the bytes of r with convert.
+type transcoder struct {
+	r io.Reader
+
+	// convert appends the conversion of src to dst. Unless eof is set, it may
+	// leave a trailing incomplete sequence unconverted, returning it as rest
+	// to be prefixed to the next read.
+	convert func(dst, src []byte, eof bool) (out, rest []byte)
+
+	buf  []byte // input read from r
+	rest []byte // unconverted tail of the last input
+	conv []byte // converted output
+	out  []byte // part of conv not yet returned
+	err  error
+}
+
+func (t *transcoder) Read(p []byte) (int, error) {
+	for len(t.out) == 0 {
+		if t.err != nil {
+			return 0, t.err
+		}
+		if t.buf == nil {
+			t.buf = make([]byte, 4096)
+		}
+		n, err := t.r.Read(t.buf)
+		src := t.buf[:n]
+		if len(t.rest) > 0 {
+			src = append(t.rest, src...)
+		}
+		var rest []byte
+		t.conv, rest = t.convert(t.conv[:0], src, err != nil)
+		t.rest = append(t.rest[:0], rest...)
+		t.out = t.conv
+		t.err = err
+	}
+	n := copy(p, t.out)
+	t.out = t.out[n:]
+	return n, nil
+}
+
+// decodeWindows1252 appends the UTF-8 encoding of the Windows-1252 text src
+// to dst.
+func decodeWindows1252(dst, src []byte, _ bool) ([]byte, []byte) {
+	for _, c := range src {
+		dst = appendWindows1252(dst, c)
+	}
+	return dst, nil
+}
+
+// repairUTF8 appends src to dst, decoding each byte that does not begin a
+// valid UTF-8 sequence as Windows-1252.
+func repairUTF8(dst, src []byte, eof bool) ([]byte, []byte) {
+	if utf8.Valid(src) {
+		return append(dst, src...), nil
+	}
+	for i := 0; i < len(src); {
+		c := src[i]
+		if c < utf8.RuneSelf {
+			dst = append(dst, c)
+			i++
+			continue
+		}
+		if !eof && !utf8.FullRune(src[i:]) {
+			return dst, src[i:]
+		}
+		r, size := utf8.DecodeRune(src[i:])
+		if r == utf8.RuneError && size <= 1 {
+			dst = appendWindows1252(dst, c)
+			i++
+			continue
+		}
+		dst = append(dst, src[i:i+size]...)
+		i += size
+	}
+	return dst, nil
+}
+
+// appendWindows1252 appends the UTF-8 encoding of the Windows-1252 byte c.
+// The five bytes Windows-1252 leaves undefined map to the C1 controls of the
+// same value.
+func appendWindows1252(dst []byte, c byte) []byte {
+	r := rune(c)
+	if c >= 0x80 && c < 0xA0 {
+		r = windows1252High[c-0x80]
+	}
+	return utf8.AppendRune(dst, r)
+}
+
+// windows1252High maps the bytes 0x80-0x9F of Windows-1252 to Unicode.
+var windows1252High = [32]rune{
+	'€', '\u0081', '‚', 'ƒ', '„', '…', '†', '‡',
+	'ˆ', '‰', 'Š', '‹', 'Œ', '\u008D', 'Ž', '\u008F',
+	'\u0090', '‘', '’', '“', '”', '•', '–', '—',
+	'˜', '™', 'š', '›', 'œ', '\u009D', 'ž', 'Ÿ',
+}
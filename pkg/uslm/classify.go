@@ -0,0 +1,182 @@
+package uslm
+
+import (
+	"regexp"
+	"strings"
+)
+
+// MeasureClass labels a kind of measure recognized by Classify.
+type MeasureClass string
+
+const (
+	// ClassAppropriations is a measure providing budget authority, such as
+	// a regular or supplemental appropriations bill.
+	ClassAppropriations MeasureClass = "appropriations"
+
+	// ClassContinuingResolution is a measure continuing appropriations at
+	// existing rates pending the regular bills. It is also classed as
+	// appropriations.
+	ClassContinuingResolution MeasureClass = "continuing-resolution"
+
+	// ClassAuthorization is a measure authorizing or reauthorizing
+	// appropriations for programs and agencies.
+	ClassAuthorization MeasureClass = "authorization"
+
+	// ClassCommemorative is a simple or concurrent resolution recognizing,
+	// honoring, or designating a day, event, or person.
+	ClassCommemorative MeasureClass = "commemorative"
+
+	// ClassTax is a measure amending the Internal Revenue Code.
+	ClassTax MeasureClass = "tax"
+)
+
+// Classification is a label assigned by Classify with the evidence for it.
+type Classification struct {
+	Class MeasureClass `json:"class"`
+
+	// Reason describes the evidence, e.g. `official title "Making
+	// appropriations for ..."`.
+	Reason string `json:"reason"`
+}
+
+var (
+	appropriationsTitlePattern = regexp.MustCompile(`(?i)^making\b.*\bappropriations\b`)
+	continuingPattern          = regexp.MustCompile(`(?i)\bcontinuing appropriations\b`)
+	appropriationsActPattern   = regexp.MustCompile(`(?i)\bappropriations act\b`)
+	authorizationTitlePattern  = regexp.MustCompile(`(?i)\b(to|and to|that) (re)?authorize\b|\breauthoriz`)
+	authorizationActPattern    = regexp.MustCompile(`(?i)\b(re)?authorization act\b`)
+	commemorativeTitlePattern  = regexp.MustCompile(`(?i)^(recognizing|honoring|commemorating|celebrating|congratulating|remembering|designating|supporting the designation|expressing support for the designation)\b`)
+	observancePattern          = regexp.MustCompile(`(?i)\bas\s+["“]?[^"”]*\b(day|week|month|year)["”]?`)
+	taxTitlePattern            = regexp.MustCompile(`(?i)\binternal revenue code\b`)
+)
+
+// Classify labels the kinds of measure doc is, from the wording of its
+// official and short titles, the appropriations style of its main body, and
+// the provisions of the Internal Revenue Code (title 26) it amends. A measure
+// may carry several labels, such as an authorization that also amends the
+// tax code, or none. Labels are returned in the order of the MeasureClass
+// constants, each with the first evidence found for it.
+func Classify(doc LegislativeDocument) []Classification {
+	title := normalizeSpace(doc.GetTitle())
+	if t, ok := doc.(TitledDocument); ok && normalizeSpace(t.GetOfficialTitle()) != "" {
+		title = normalizeSpace(t.GetOfficialTitle())
+	}
+	var style string
+	switch d := doc.(type) {
+	case *Bill:
+		if d.Main != nil {
+			style = d.Main.StyleType
+		}
+	case *Resolution:
+		if d.Main != nil {
+			style = d.Main.StyleType
+		}
+	}
+	shortTitles, taxRef := classifyProvisions(doc)
+
+	found := map[MeasureClass]string{}
+	note := func(class MeasureClass, reason string) {
+		if _, ok := found[class]; !ok {
+			found[class] = reason
+		}
+	}
+	quotedTitle := `official title "` + title + `"`
+
+	switch {
+	case continuingPattern.MatchString(title):
+		note(ClassContinuingResolution, quotedTitle)
+	case matchAny(continuingPattern, shortTitles) != "":
+		note(ClassContinuingResolution, `short title "`+matchAny(continuingPattern, shortTitles)+`"`)
+	}
+	switch {
+	case style == "appropriations":
+		note(ClassAppropriations, `main styleType "appropriations"`)
+	case appropriationsTitlePattern.MatchString(title):
+		note(ClassAppropriations, quotedTitle)
+	case matchAny(appropriationsActPattern, shortTitles) != "":
+		note(ClassAppropriations, `short title "`+matchAny(appropriationsActPattern, shortTitles)+`"`)
+	}
+	if reason, ok := found[ClassContinuingResolution]; ok {
+		note(ClassAppropriations, reason)
+	}
+
+	switch {
+	case authorizationTitlePattern.MatchString(title):
+		note(ClassAuthorization, quotedTitle)
+	case matchAny(authorizationActPattern, shortTitles) != "":
+		note(ClassAuthorization, `short title "`+matchAny(authorizationActPattern, shortTitles)+`"`)
+	}
+
+	docType := strings.ToLower(doc.GetDocumentType())
+	if _, ok := doc.(*Resolution); ok && !strings.Contains(docType, "joint") {
+		// "Recognizing that ..." introduces a statement of policy rather
+		// than an observance.
+		lower := strings.ToLower(title)
+		if commemorativeTitlePattern.MatchString(title) && !strings.HasPrefix(lower, "recognizing that ") ||
+			observancePattern.MatchString(title) && strings.Contains(lower, "designat") {
+			note(ClassCommemorative, quotedTitle)
+		}
+	}
+
+	switch {
+	case taxTitlePattern.MatchString(title):
+		note(ClassTax, quotedTitle)
+	case taxRef != "":
+		note(ClassTax, `reference to "`+taxRef+`"`)
+	}
+
+	var out []Classification
+	for _, class := range []MeasureClass{ClassAppropriations, ClassContinuingResolution, ClassAuthorization, ClassCommemorative, ClassTax} {
+		if reason, ok := found[class]; ok {
+			out = append(out, Classification{Class: class, Reason: reason})
+		}
+	}
+	return out
+}
+
+// HasClass reports whether Classify labels doc with class.
+func HasClass(doc LegislativeDocument, class MeasureClass) bool {
+	for _, c := range Classify(doc) {
+		if c.Class == class {
+			return true
+		}
+	}
+	return false
+}
+
+// classifyProvisions returns the short titles the document's sections
+// enact and the citation of the first reference into title 26 made by an
+// amending provision, one whose text says what "is amended".
+func classifyProvisions(doc LegislativeDocument) (shortTitles []string, taxRef string) {
+	for _, top := range Provisions(doc) {
+		top.Walk(func(p *Provision) bool {
+			if p.Content != nil {
+				for _, s := range p.Content.ShortTitle {
+					if text := normalizeSpace(s.Text); text != "" {
+						shortTitles = append(shortTitles, text)
+					}
+				}
+			}
+			if taxRef == "" && strings.Contains(p.GetText(), "amended") {
+				for _, r := range p.GetRefs() {
+					if strings.HasPrefix(normalizeUSCHref(r.Href)+"/", "/us/usc/t26/") {
+						taxRef = refCitation(r.Href, r.Text)
+						break
+					}
+				}
+			}
+			return true
+		})
+	}
+	return shortTitles, taxRef
+}
+
+// matchAny returns the first of texts matched by pattern, or "".
+func matchAny(pattern *regexp.Regexp, texts []string) string {
+	for _, t := range texts {
+		if pattern.MatchString(t) {
+			return t
+		}
+	}
+	return ""
+}
@@ -0,0 +1,367 @@
+// Command uslm-search is a reference search service over USLM documents. It
+// indexes the provisions of every document ingested, in memory, and answers
+// queries by text, sponsor, and committee.
+//
+// Usage:
+//
+//	uslm-search -addr :8080 [-dir path/to/xml]
+//
+// Endpoints:
+//
+//	POST /ingest?dir=path      scan a directory on the server and index its XML
+//	                           files, whatever the case of their extension
+//	POST /documents?id=name    index the XML document in the request body
+//	GET  /search?q=&sponsor=&committee=&limit=
+//	                           list matching provisions; q matches every word
+//	                           case-insensitively, sponsor and committee match a
+//	                           member or committee ID, or part of a name
+//	GET  /documents            list the indexed documents
+//
+// Example:
+//
+//	curl -X POST 'localhost:8080/ingest?dir=bill-version-samples-september-2024'
+//	curl 'localhost:8080/search?q=veterans+health&committee=SSVA00'
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/usgpo/uslm/pkg/uslm"
+)
+
+// maxDocumentBytes bounds the body of POST /documents.
+const maxDocumentBytes = 64 << 20
+
+// defaultLimit is the number of results returned when no limit is given.
+const defaultLimit = 50
+
+func main() {
+	addr := flag.String("addr", ":8080", "address to listen on")
+	dir := flag.String("dir", "", "directory of XML documents to index at startup")
+	flag.Parse()
+
+	idx := newIndex()
+	if *dir != "" {
+		summary := idx.ingestDir(context.Background(), *dir)
+		log.Printf("indexed %d documents (%d provisions) from %s, %d errors", summary.Documents, summary.Provisions, *dir, len(summary.Errors))
+	}
+
+	server := &http.Server{
+		Addr:              *addr,
+		Handler:           idx.handler(),
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+	log.Printf("uslm-search listening on %s", *addr)
+	log.Fatal(server.ListenAndServe())
+}
+
+// document is an indexed document.
+type document struct {
+	ID         string   `json:"id"`
+	Title      string   `json:"title"`
+	Congress   string   `json:"congress,omitempty"`
+	Stage      string   `json:"stage,omitempty"`
+	Sponsors   []string `json:"sponsors,omitempty"`
+	Committees []string `json:"committees,omitempty"`
+	Provisions int      `json:"provisions"`
+
+	// sponsorKeys and committeeKeys hold the lower-cased IDs and names
+	// queries are matched against.
+	sponsorKeys, committeeKeys []string
+}
+
+// provision is an indexed provision.
+type provision struct {
+	doc *document
+
+	Citation   string `json:"citation"`
+	Identifier string `json:"identifier,omitempty"`
+	Heading    string `json:"heading,omitempty"`
+	Text       string `json:"text"`
+
+	// words is the lower-cased heading and text.
+	words string
+}
+
+// result is one provision returned by /search.
+type result struct {
+	Document string `json:"document"`
+	Title    string `json:"title"`
+	*provision
+}
+
+// ingestSummary is the response to an ingest.
+type ingestSummary struct {
+	Documents  int      `json:"documents"`
+	Provisions int      `json:"provisions"`
+	Errors     []string `json:"errors,omitempty"`
+}
+
+// index is the in-memory index. Re-ingesting a document replaces it.
+type index struct {
+	mu         sync.RWMutex
+	docs       map[string]*document
+	provisions map[string][]*provision
+}
+
+func newIndex() *index {
+	return &index{docs: make(map[string]*document), provisions: make(map[string][]*provision)}
+}
+
+// add indexes doc under id and returns the number of provisions indexed.
+func (idx *index) add(id string, doc uslm.LegislativeDocument) int {
+	d := &document{
+		ID:       id,
+		Title:    doc.GetTitle(),
+		Congress: doc.GetCongress(),
+		Stage:    doc.GetStage(),
+	}
+	seen := make(map[string]bool)
+	if s, ok := doc.(uslm.SponsoredDocument); ok {
+		for _, sp := range s.GetSponsors() {
+			name := memberName(sp.Text, sp.Inline)
+			if !seen[sp.GetID()+name] {
+				seen[sp.GetID()+name] = true
+				d.Sponsors = append(d.Sponsors, name)
+			}
+			d.sponsorKeys = append(d.sponsorKeys, keys(sp.GetID(), name)...)
+		}
+		for _, co := range s.GetCosponsors() {
+			d.sponsorKeys = append(d.sponsorKeys, keys(co.GetID(), memberName(co.Text, co.Inline))...)
+		}
+	}
+	if c, ok := doc.(uslm.CommitteeDocument); ok {
+		for _, cm := range c.GetCommittees() {
+			if !seen[cm.GetID()+cm.GetName()] {
+				seen[cm.GetID()+cm.GetName()] = true
+				d.Committees = append(d.Committees, cm.GetName())
+			}
+			d.committeeKeys = append(d.committeeKeys, keys(cm.GetID(), cm.GetName())...)
+		}
+	}
+
+	var provisions []*provision
+	for _, top := range uslm.Provisions(doc) {
+		top.Walk(func(p *uslm.Provision) bool {
+			text := strings.TrimSpace(p.GetText())
+			heading := strings.TrimSpace(p.GetHeading())
+			if text == "" && heading == "" {
+				return true
+			}
+			provisions = append(provisions, &provision{
+				doc:        d,
+				Citation:   p.PathString(),
+				Identifier: p.Identifier,
+				Heading:    heading,
+				Text:       text,
+				words:      strings.ToLower(heading + " " + text),
+			})
+			return true
+		})
+	}
+	d.Provisions = len(provisions)
+
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+	idx.docs[id] = d
+	idx.provisions[id] = provisions
+	return len(provisions)
+}
+
+// memberName returns a sponsor's name as printed, e.g. "Mr. Dorgan", where
+// the surname is set in small caps within an inline.
+func memberName(text string, inline []uslm.Inline) string {
+	for _, in := range inline {
+		text += in.Text
+	}
+	return strings.Join(strings.Fields(text), " ")
+}
+
+// keys returns the non-empty values, lower-cased.
+func keys(values ...string) []string {
+	var out []string
+	for _, v := range values {
+		if v = strings.ToLower(strings.TrimSpace(v)); v != "" {
+			out = append(out, v)
+		}
+	}
+	return out
+}
+
+// ingestDir indexes every XML file under dir (.xml or .XML), by its path
+// within dir.
+func (idx *index) ingestDir(ctx context.Context, dir string) ingestSummary {
+	var summary ingestSummary
+	if info, err := os.Stat(dir); err != nil || !info.IsDir() {
+		summary.Errors = append(summary.Errors, fmt.Sprintf("%s: not a directory", dir))
+		return summary
+	}
+	for res := range uslm.ScanCorpus(ctx, os.DirFS(dir), uslm.ScanOptions{}) {
+		if res.Err != nil {
+			summary.Errors = append(summary.Errors, res.Err.Error())
+			continue
+		}
+		summary.Documents++
+		summary.Provisions += idx.add(res.Path, res.Document)
+	}
+	return summary
+}
+
+// search returns up to limit provisions matching every word of q and the
+// sponsor and committee filters, in document and reading order.
+func (idx *index) search(q, sponsor, committee string, limit int) []result {
+	words := strings.Fields(strings.ToLower(q))
+	sponsor = strings.ToLower(strings.TrimSpace(sponsor))
+	committee = strings.ToLower(strings.TrimSpace(committee))
+
+	idx.mu.RLock()
+	defer idx.mu.RUnlock()
+	ids := make([]string, 0, len(idx.docs))
+	for id := range idx.docs {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	var results []result
+	for _, id := range ids {
+		d := idx.docs[id]
+		if !matchesKey(d.sponsorKeys, sponsor) || !matchesKey(d.committeeKeys, committee) {
+			continue
+		}
+		for _, p := range idx.provisions[id] {
+			if !containsAll(p.words, words) {
+				continue
+			}
+			results = append(results, result{Document: d.ID, Title: d.Title, provision: p})
+			if len(results) == limit {
+				return results
+			}
+		}
+	}
+	return results
+}
+
+// matchesKey reports whether want is empty, equals one of keys (an ID), or
+// is contained in one (part of a name).
+func matchesKey(keys []string, want string) bool {
+	if want == "" {
+		return true
+	}
+	for _, k := range keys {
+		if strings.Contains(k, want) {
+			return true
+		}
+	}
+	return false
+}
+
+// containsAll reports whether text contains every word.
+func containsAll(text string, words []string) bool {
+	for _, w := range words {
+		if !strings.Contains(text, w) {
+			return false
+		}
+	}
+	return true
+}
+
+// handler returns the service's endpoints.
+func (idx *index) handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/ingest", idx.handleIngest)
+	mux.HandleFunc("/documents", idx.handleDocuments)
+	mux.HandleFunc("/search", idx.handleSearch)
+	return mux
+}
+
+// handleIngest serves POST /ingest?dir=path.
+func (idx *index) handleIngest(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	dir := r.URL.Query().Get("dir")
+	if dir == "" {
+		http.Error(w, "missing dir parameter", http.StatusBadRequest)
+		return
+	}
+	writeJSON(w, idx.ingestDir(r.Context(), dir))
+}
+
+// handleDocuments serves POST /documents?id=name, indexing the body, and
+// GET /documents.
+func (idx *index) handleDocuments(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodGet:
+		idx.mu.RLock()
+		docs := make([]*document, 0, len(idx.docs))
+		for _, d := range idx.docs {
+			docs = append(docs, d)
+		}
+		idx.mu.RUnlock()
+		sort.Slice(docs, func(i, j int) bool { return docs[i].ID < docs[j].ID })
+		writeJSON(w, docs)
+	case http.MethodPost:
+		data, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxDocumentBytes))
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusRequestEntityTooLarge)
+			return
+		}
+		doc, err := uslm.ParseDocument(data)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		id := r.URL.Query().Get("id")
+		if id == "" {
+			id = uslm.ContentHash(data)
+		}
+		writeJSON(w, ingestSummary{Documents: 1, Provisions: idx.add(id, doc)})
+	default:
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+	}
+}
+
+// handleSearch serves GET /search.
+func (idx *index) handleSearch(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	query := r.URL.Query()
+	limit := defaultLimit
+	if s := query.Get("limit"); s != "" {
+		n, err := strconv.Atoi(s)
+		if err != nil || n < 1 {
+			http.Error(w, "invalid limit", http.StatusBadRequest)
+			return
+		}
+		limit = n
+	}
+	results := idx.search(query.Get("q"), query.Get("sponsor"), query.Get("committee"), limit)
+	if results == nil {
+		results = []result{}
+	}
+	writeJSON(w, results)
+}
+
+func writeJSON(w http.ResponseWriter, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(v); err != nil {
+		log.Printf("failed to write response: %v", err)
+	}
+}
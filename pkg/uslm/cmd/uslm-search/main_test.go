@@ -0,0 +1,172 @@
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/usgpo/uslm/pkg/uslm"
+)
+
+var samples = filepath.Join("..", "..", "..", "..", "bill-version-samples-september-2024")
+
+// searchResult is a result as /search returns it.
+type searchResult struct {
+	Document string `json:"document"`
+	Citation string `json:"citation"`
+	Heading  string `json:"heading"`
+	Text     string `json:"text"`
+}
+
+// do sends a request to server and decodes a JSON response into v.
+func do(t *testing.T, server *httptest.Server, method, target, body string, v interface{}) int {
+	t.Helper()
+	req, err := http.NewRequest(method, server.URL+target, strings.NewReader(body))
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := server.Client().Do(req)
+	if err != nil {
+		t.Fatalf("%s %s failed: %v", method, target, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode == http.StatusOK && v != nil {
+		if err := json.NewDecoder(resp.Body).Decode(v); err != nil {
+			t.Fatalf("failed to decode %s %s: %v", method, target, err)
+		}
+	}
+	return resp.StatusCode
+}
+
+func TestIngestAndSearch(t *testing.T) {
+	server := httptest.NewServer(newIndex().handler())
+	defer server.Close()
+
+	var summary ingestSummary
+	if status := do(t, server, http.MethodPost, "/ingest?dir="+url.QueryEscape(samples), "", &summary); status != http.StatusOK {
+		t.Fatalf("ingest status %d", status)
+	}
+	// Every sample is indexed, .xml and .XML alike, and the schema and
+	// stylesheets beside them are skipped.
+	if summary.Documents != 75 || len(summary.Errors) != 0 || summary.Provisions == 0 {
+		t.Errorf("unexpected ingest summary %+v", summary)
+	}
+
+	var docs []struct {
+		ID         string   `json:"id"`
+		Sponsors   []string `json:"sponsors"`
+		Committees []string `json:"committees"`
+	}
+	if status := do(t, server, http.MethodGet, "/documents", "", &docs); status != http.StatusOK || len(docs) != 75 {
+		t.Fatalf("documents status %d, %d documents", status, len(docs))
+	}
+	var upper bool
+	for i, d := range docs {
+		if i > 0 && docs[i-1].ID >= d.ID {
+			t.Errorf("documents out of order: %s before %s", docs[i-1].ID, d.ID)
+		}
+		upper = upper || d.ID == "H1000_IH.XML"
+		if d.ID == "BILLS-114s32cds.xml" && (strings.Join(d.Sponsors, ",") != "Mrs. Feinstein" || len(d.Committees) != 2) {
+			t.Errorf("unexpected sponsors %v and committees %v", d.Sponsors, d.Committees)
+		}
+	}
+	if !upper {
+		t.Error("expected H1000_IH.XML to be indexed")
+	}
+
+	for _, tc := range []struct {
+		query, word string
+		want        int
+	}{
+		{"q=TRAFFICKING", "trafficking", 6},
+		{"q=trafficking&sponsor=feinstein", "trafficking", 2},
+		{"q=counterfeit&sponsor=S221&committee=ssju00", "counterfeit", 3},
+		{"q=counterfeit&sponsor=udall", "counterfeit", 3},
+		{"q=counterfeit&sponsor=grassley&committee=SSVA00", "counterfeit", 0},
+		{"q=counterfeit&limit=1", "counterfeit", 1},
+	} {
+		var results []searchResult
+		if status := do(t, server, http.MethodGet, "/search?"+tc.query, "", &results); status != http.StatusOK {
+			t.Errorf("search %s status %d", tc.query, status)
+			continue
+		}
+		if len(results) != tc.want {
+			t.Errorf("search %s found %d results, want %d", tc.query, len(results), tc.want)
+		}
+		for _, r := range results {
+			if !strings.Contains(strings.ToLower(r.Heading+" "+r.Text), tc.word) || r.Citation == "" {
+				t.Errorf("search %s returned %+v", tc.query, r)
+			}
+			if strings.Contains(tc.query, "sponsor") && r.Document != "BILLS-114s32cds.xml" {
+				t.Errorf("search %s returned a provision of %s", tc.query, r.Document)
+			}
+		}
+	}
+}
+
+func TestDocumentsPost(t *testing.T) {
+	server := httptest.NewServer(newIndex().handler())
+	defer server.Close()
+	data, err := os.ReadFile(filepath.Join(samples, "BILLS-114s32cds.xml"))
+	if err != nil {
+		t.Fatalf("failed to read sample: %v", err)
+	}
+
+	var summary ingestSummary
+	if status := do(t, server, http.MethodPost, "/documents?id=s32", string(data), &summary); status != http.StatusOK || summary.Documents != 1 || summary.Provisions == 0 {
+		t.Fatalf("status %d, summary %+v", status, summary)
+	}
+	// Without an id the document is indexed under its content hash.
+	if status := do(t, server, http.MethodPost, "/documents", string(data), &summary); status != http.StatusOK {
+		t.Fatalf("status %d", status)
+	}
+	var results []searchResult
+	if status := do(t, server, http.MethodGet, "/search?q=transnational", "", &results); status != http.StatusOK || len(results) != 2 {
+		t.Fatalf("status %d, %d results", status, len(results))
+	}
+	if hash := uslm.ContentHash(data); results[0].Document != hash || results[1].Document != "s32" {
+		t.Errorf("unexpected documents %s and %s", results[0].Document, results[1].Document)
+	}
+
+	// Re-ingesting replaces the document.
+	do(t, server, http.MethodPost, "/documents?id=s32", string(data), &summary)
+	if do(t, server, http.MethodGet, "/search?q=transnational", "", &results); len(results) != 2 {
+		t.Errorf("expected re-ingesting to replace the document, got %d results", len(results))
+	}
+}
+
+func TestErrors(t *testing.T) {
+	server := httptest.NewServer(newIndex().handler())
+	defer server.Close()
+
+	for _, tc := range []struct {
+		method, target, body string
+		want                 int
+	}{
+		{http.MethodGet, "/ingest?dir=.", "", http.StatusMethodNotAllowed},
+		{http.MethodPost, "/ingest", "", http.StatusBadRequest},
+		{http.MethodPost, "/documents", "<bill>", http.StatusBadRequest},
+		{http.MethodDelete, "/documents", "", http.StatusMethodNotAllowed},
+		{http.MethodPost, "/search", "", http.StatusMethodNotAllowed},
+		{http.MethodGet, "/search?limit=0", "", http.StatusBadRequest},
+		{http.MethodGet, "/search?limit=x", "", http.StatusBadRequest},
+	} {
+		if status := do(t, server, tc.method, tc.target, tc.body, nil); status != tc.want {
+			t.Errorf("%s %s status %d, want %d", tc.method, tc.target, status, tc.want)
+		}
+	}
+
+	var summary ingestSummary
+	if do(t, server, http.MethodPost, "/ingest?dir=no-such-dir", "", &summary); summary.Documents != 0 || len(summary.Errors) != 1 {
+		t.Errorf("expected an error for a missing directory, got %+v", summary)
+	}
+	var results []searchResult
+	if do(t, server, http.MethodGet, "/search?q=anything", "", &results); results == nil || len(results) != 0 {
+		t.Errorf("expected an empty list from an empty index, got %v", results)
+	}
+}
@@ -0,0 +1,68 @@
+// Command uslmcoverage reports which elements and attributes declared by the
+// USLM XML Schema the Go model decodes, so schema gaps can be tracked as the
+// model grows.
+//
+// Usage:
+//
+//	uslmcoverage [-json] [-min percent] schema.xsd...
+//
+// Example:
+//
+//	uslmcoverage uslm-components-2.1.0.xsd uslm-table-module-2.1.0.xsd
+//
+// The report is written to standard output as Markdown, or as JSON with
+// -json. With -min, the command exits with status 1 when element coverage
+// is below the given percentage, for use in CI.
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+
+	"github.com/usgpo/uslm/pkg/uslm"
+)
+
+func main() {
+	asJSON := flag.Bool("json", false, "write the report as JSON")
+	minCoverage := flag.Float64("min", 0, "minimum element coverage, in percent")
+	flag.Parse()
+	if flag.NArg() == 0 {
+		fmt.Fprintln(os.Stderr, "usage: uslmcoverage [-json] [-min percent] schema.xsd...")
+		os.Exit(2)
+	}
+
+	var schemas []io.Reader
+	for _, name := range flag.Args() {
+		f, err := os.Open(name)
+		if err != nil {
+			log.Fatal(err)
+		}
+		defer f.Close()
+		schemas = append(schemas, f)
+	}
+	report, err := uslm.SchemaCoverage(schemas...)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if *asJSON {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		err = enc.Encode(report)
+	} else {
+		err = report.WriteMarkdown(os.Stdout)
+	}
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	covered, total := report.ElementCoverage()
+	if total > 0 && 100*float64(covered)/float64(total) < *minCoverage {
+		fmt.Fprintf(os.Stderr, "element coverage %d/%d is below %.1f%%\n", covered, total, *minCoverage)
+		os.Exit(1)
+	}
+}
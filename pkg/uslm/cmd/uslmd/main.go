@@ -0,0 +1,55 @@
+// Command uslmd serves the uslmapi methods (Parse, Convert, Validate, Diff)
+// both as the JSON API and as the gRPC service uslm.v1.USLM defined in
+// uslmapi/uslm.proto.
+//
+// Usage:
+//
+//	uslmd -addr :8080 [-cert server.crt -key server.key]
+//
+// Without a certificate, gRPC clients connect over unencrypted HTTP/2 (when
+// built with Go 1.24 or later). Examples:
+//
+//	curl -d '{"xml": "<bill ...>"}' localhost:8080/v1/Parse
+//	grpcurl -plaintext -proto uslmapi/uslm.proto -d '{"xml": "<bill ...>"}' localhost:8080 uslm.v1.USLM/Parse
+package main
+
+import (
+	"flag"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/usgpo/uslm/pkg/uslm/uslmapi"
+)
+
+// enableUnencryptedHTTP2 lets server accept HTTP/2 without TLS, where the
+// standard library supports it (see h2c.go).
+var enableUnencryptedHTTP2 func(server *http.Server)
+
+func main() {
+	addr := flag.String("addr", ":8080", "address to listen on")
+	cert := flag.String("cert", "", "TLS certificate file")
+	key := flag.String("key", "", "TLS key file")
+	flag.Parse()
+
+	svc := &uslmapi.Service{}
+	mux := http.NewServeMux()
+	mux.Handle(uslmapi.PathPrefix, uslmapi.NewHandler(svc))
+	mux.Handle(uslmapi.GRPCPathPrefix, uslmapi.NewGRPCHandler(svc))
+
+	server := &http.Server{
+		Addr:              *addr,
+		Handler:           mux,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+	log.Printf("uslmd listening on %s", *addr)
+	if *cert != "" || *key != "" {
+		log.Fatal(server.ListenAndServeTLS(*cert, *key))
+	}
+	if enableUnencryptedHTTP2 != nil {
+		enableUnencryptedHTTP2(server)
+	} else {
+		log.Print("gRPC needs HTTP/2: serve with -cert and -key, or build with Go 1.24 or later")
+	}
+	log.Fatal(server.ListenAndServe())
+}
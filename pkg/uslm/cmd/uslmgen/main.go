@@ -0,0 +1,708 @@
+// Command uslmgen generates Go struct definitions for the elements of an XML
+// Schema, so that a new release of the USLM schema can be brought into Go
+// mechanically. It is run by go generate in the schema package.
+//
+// Usage:
+//
+//	uslmgen [-pkg name] [-o file] schema.xsd
+//
+// Example:
+//
+//	uslmgen -pkg schema -o types_gen.go uslm-components-2.1.0.xsd
+//
+// Each named complex type becomes a struct of the same name, and each element
+// declared with an anonymous complex type a struct named after the element
+// with an "Element" suffix. Child elements become pointer fields, or slice
+// fields when they may repeat; references to the head of a substitution group
+// expand to a field for every member of the group. Attributes and elements of
+// simple type become strings, mixed and simple content a Text field, and
+// wildcards AnyElement values. Elements from other namespaces, such as XHTML
+// tables and MathML, are kept as AnyElement. Element order within mixed
+// content is not preserved.
+package main
+
+import (
+	"bytes"
+	"encoding/xml"
+	"flag"
+	"fmt"
+	"go/format"
+	"io"
+	"log"
+	"os"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+const (
+	xsdNamespace = "http://www.w3.org/2001/XMLSchema"
+	xmlNamespace = "http://www.w3.org/XML/1998/namespace"
+)
+
+func main() {
+	pkg := flag.String("pkg", "schema", "package name of the generated file")
+	out := flag.String("o", "", "output file (default standard output)")
+	flag.Parse()
+	if flag.NArg() != 1 {
+		fmt.Fprintln(os.Stderr, "usage: uslmgen [-pkg name] [-o file] schema.xsd")
+		os.Exit(2)
+	}
+
+	f, err := os.Open(flag.Arg(0))
+	if err != nil {
+		log.Fatal(err)
+	}
+	root, err := parseTree(f)
+	f.Close()
+	if err != nil {
+		log.Fatalf("failed to read schema: %v", err)
+	}
+	s, err := loadSchema(root)
+	if err != nil {
+		log.Fatal(err)
+	}
+	src, err := s.generate(*pkg, flag.Arg(0))
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if *out == "" {
+		os.Stdout.Write(src)
+		return
+	}
+	if err := os.WriteFile(*out, src, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// node is an element of the schema document.
+type node struct {
+	name     xml.Name
+	attrs    map[string]string
+	children []*node
+
+	// ns maps the prefixes in scope at the element to namespaces.
+	ns map[string]string
+}
+
+// attr returns the value of the unqualified attribute name.
+func (n *node) attr(name string) string {
+	return n.attrs[name]
+}
+
+// first returns the first XML Schema child of n with one of the given local
+// names, or nil.
+func (n *node) first(locals ...string) *node {
+	for _, c := range n.children {
+		if c.name.Space != xsdNamespace {
+			continue
+		}
+		for _, l := range locals {
+			if c.name.Local == l {
+				return c
+			}
+		}
+	}
+	return nil
+}
+
+// resolve splits a QName attribute value into its namespace and local name.
+func (n *node) resolve(qname string) (space, local string) {
+	prefix, local, ok := strings.Cut(qname, ":")
+	switch {
+	case !ok:
+		return n.ns[""], prefix
+	case prefix == "xml":
+		// The xml prefix is bound without being declared.
+		return xmlNamespace, local
+	}
+	return n.ns[prefix], local
+}
+
+// parseTree reads an XML document into a tree of nodes, recording the
+// namespace prefixes in scope at each element.
+func parseTree(r io.Reader) (*node, error) {
+	d := xml.NewDecoder(r)
+	var stack []*node
+	var root *node
+	for {
+		tok, err := d.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		switch t := tok.(type) {
+		case xml.StartElement:
+			n := &node{name: t.Name, attrs: make(map[string]string), ns: make(map[string]string)}
+			if len(stack) > 0 {
+				parent := stack[len(stack)-1]
+				for k, v := range parent.ns {
+					n.ns[k] = v
+				}
+				parent.children = append(parent.children, n)
+			} else {
+				root = n
+			}
+			for _, a := range t.Attr {
+				switch {
+				case a.Name.Space == "xmlns":
+					n.ns[a.Name.Local] = a.Value
+				case a.Name.Space == "" && a.Name.Local == "xmlns":
+					n.ns[""] = a.Value
+				case a.Name.Space == "":
+					n.attrs[a.Name.Local] = a.Value
+				}
+			}
+			stack = append(stack, n)
+		case xml.EndElement:
+			stack = stack[:len(stack)-1]
+		case xml.CharData:
+			// Only documentation has text, which is read through its node.
+			if len(stack) > 0 {
+				top := stack[len(stack)-1]
+				if top.name.Local == "documentation" {
+					top.attrs["#text"] += string(t)
+				}
+			}
+		}
+	}
+	if root == nil {
+		return nil, fmt.Errorf("empty schema document")
+	}
+	return root, nil
+}
+
+// schema holds the global declarations of one schema document.
+type schema struct {
+	target      string
+	elements    map[string]*node
+	types       map[string]*node
+	simpleTypes map[string]bool
+	groups      map[string]*node
+	attrGroups  map[string]*node
+	attributes  map[string]*node
+
+	// members maps the head of each substitution group to the elements
+	// that may substitute for it directly.
+	members map[string][]string
+
+	// structs holds the generated types by Go name, and anonymous the
+	// anonymous complex types still to be generated.
+	structs   map[string]*goStruct
+	anonymous []anonymousType
+}
+
+// anonymousType is an anonymous complex type and the Go name chosen for it.
+type anonymousType struct {
+	name   string
+	origin string
+	def    *node
+	doc    string
+}
+
+func loadSchema(root *node) (*schema, error) {
+	if root.name.Space != xsdNamespace || root.name.Local != "schema" {
+		return nil, fmt.Errorf("not an XML Schema document: root element %s", root.name.Local)
+	}
+	s := &schema{
+		target:      root.attr("targetNamespace"),
+		elements:    make(map[string]*node),
+		types:       make(map[string]*node),
+		simpleTypes: make(map[string]bool),
+		groups:      make(map[string]*node),
+		attrGroups:  make(map[string]*node),
+		attributes:  make(map[string]*node),
+		members:     make(map[string][]string),
+		structs:     make(map[string]*goStruct),
+	}
+	for _, c := range root.children {
+		if c.name.Space != xsdNamespace {
+			continue
+		}
+		name := c.attr("name")
+		switch c.name.Local {
+		case "element":
+			s.elements[name] = c
+			if head := c.attr("substitutionGroup"); head != "" {
+				_, local := c.resolve(head)
+				s.members[local] = append(s.members[local], name)
+			}
+		case "complexType":
+			s.types[name] = c
+		case "simpleType":
+			s.simpleTypes[name] = true
+		case "group":
+			s.groups[name] = c
+		case "attributeGroup":
+			s.attrGroups[name] = c
+		case "attribute":
+			s.attributes[name] = c
+		}
+	}
+	return s, nil
+}
+
+// goStruct is a generated struct type.
+type goStruct struct {
+	name string
+
+	// origin names the schema type the struct is generated from, and doc is
+	// the first sentence of its documentation.
+	origin string
+	doc    string
+	fields []*goField
+}
+
+// goField is a field of a generated struct.
+type goField struct {
+	name string
+	typ  string
+	tag  string
+
+	// key identifies the XML the field decodes, so a repeated particle
+	// reuses its field. Attribute keys begin with "@".
+	key string
+}
+
+// field returns the field decoding key, adding one if there is none. Field
+// names are made unique by assignNames once all fields are known.
+func (g *goStruct) field(key, name, typ, tag string) *goField {
+	for _, f := range g.fields {
+		if f.key == key {
+			return f
+		}
+	}
+	f := &goField{name: name, typ: typ, tag: tag, key: key}
+	g.fields = append(g.fields, f)
+	return f
+}
+
+// assignNames makes the field names unique. Elements and content keep their
+// names; an attribute whose name is taken gets an "Attr" suffix, and any
+// remaining clash a numeric one.
+func (g *goStruct) assignNames() {
+	taken := map[string]bool{"XMLName": true}
+	claim := func(f *goField, suffix string) {
+		name := f.name
+		if taken[name] && suffix != "" {
+			name += suffix
+		}
+		candidate := name
+		for i := 2; taken[candidate]; i++ {
+			candidate = fmt.Sprintf("%s%d", name, i)
+		}
+		taken[candidate] = true
+		f.name = candidate
+	}
+	for _, f := range g.fields {
+		if !strings.HasPrefix(f.key, "@") {
+			claim(f, "")
+		}
+	}
+	for _, f := range g.fields {
+		if strings.HasPrefix(f.key, "@") {
+			claim(f, "Attr")
+		}
+	}
+}
+
+// generate returns the formatted source of the generated file.
+func (s *schema) generate(pkg, source string) ([]byte, error) {
+	var names []string
+	for name := range s.types {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		def := s.types[name]
+		s.build(goName(name), "the "+name+" complex type", def, documentation(def))
+	}
+	for len(s.anonymous) > 0 {
+		a := s.anonymous[0]
+		s.anonymous = s.anonymous[1:]
+		if _, ok := s.structs[a.name]; !ok {
+			s.build(a.name, a.origin, a.def, a.doc)
+		}
+	}
+
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "// Code generated by uslmgen from %s. DO NOT EDIT.\n\n", baseName(source))
+	fmt.Fprintf(&b, "package %s\n\nimport \"encoding/xml\"\n\n", pkg)
+	fmt.Fprintf(&b, "// Namespace is the target namespace of the schema.\nconst Namespace = %q\n\n", s.target)
+	b.WriteString(`// AnyElement holds an element the schema leaves open, or one from another
+// namespace, with its attributes and markup.
+type AnyElement struct {
+	XMLName  xml.Name
+	Attrs    []xml.Attr ` + "`xml:\",any,attr\"`" + `
+	InnerXML string     ` + "`xml:\",innerxml\"`" + `
+}
+
+`)
+
+	names = names[:0]
+	for name := range s.structs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		g := s.structs[name]
+		g.assignNames()
+		for _, line := range wrap(g.name+" is generated from "+g.origin+".", 76) {
+			fmt.Fprintf(&b, "// %s\n", line)
+		}
+		if g.doc != "" {
+			b.WriteString("//\n")
+			for _, line := range wrap(g.doc, 76) {
+				fmt.Fprintf(&b, "// %s\n", line)
+			}
+		}
+		fmt.Fprintf(&b, "type %s struct {\n\tXMLName xml.Name\n", g.name)
+		for _, f := range g.fields {
+			fmt.Fprintf(&b, "\t%s %s `xml:%q`\n", f.name, f.typ, f.tag)
+		}
+		b.WriteString("}\n\n")
+	}
+
+	src, err := format.Source(b.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("failed to format generated source: %w", err)
+	}
+	return src, nil
+}
+
+// build generates the struct name from the complex type def.
+func (s *schema) build(name, origin string, def *node, doc string) {
+	g := &goStruct{name: name, origin: origin, doc: doc}
+	s.structs[name] = g
+	s.addType(g, def, make(map[*node]bool))
+}
+
+// addType adds the fields of complex type def, including those it inherits,
+// to g.
+func (s *schema) addType(g *goStruct, def *node, seen map[*node]bool) {
+	if seen[def] {
+		return
+	}
+	seen[def] = true
+	if def.attr("mixed") == "true" {
+		g.field("#text", "Text", "string", ",chardata")
+	}
+
+	if content := def.first("complexContent", "simpleContent"); content != nil {
+		if content.attr("mixed") == "true" {
+			g.field("#text", "Text", "string", ",chardata")
+		}
+		derivation := content.first("extension", "restriction")
+		if derivation == nil {
+			return
+		}
+		space, base := derivation.resolve(derivation.attr("base"))
+		baseDef := s.types[base]
+		if space != s.target {
+			baseDef = nil
+		}
+		switch {
+		case baseDef != nil && (derivation.name.Local == "extension" || content.name.Local == "simpleContent"):
+			s.addType(g, baseDef, seen)
+		case baseDef != nil:
+			// A restriction restates the content it keeps but inherits the
+			// attributes.
+			s.addAttributes(g, baseDef, seen)
+		}
+		if content.name.Local == "simpleContent" {
+			g.field("#text", "Text", "string", ",chardata")
+		}
+		s.addParticles(g, derivation, false, def)
+		s.addAttributes(g, derivation, make(map[*node]bool))
+		return
+	}
+
+	s.addParticles(g, def, false, def)
+	s.addAttributes(g, def, make(map[*node]bool))
+}
+
+// addAttributes adds the attributes declared directly in def, through its
+// attribute groups, and (for complex types given through seen) by its base
+// types.
+func (s *schema) addAttributes(g *goStruct, def *node, seen map[*node]bool) {
+	if seen[def] {
+		return
+	}
+	seen[def] = true
+	if content := def.first("complexContent", "simpleContent"); content != nil {
+		if derivation := content.first("extension", "restriction"); derivation != nil {
+			if space, base := derivation.resolve(derivation.attr("base")); space == s.target && s.types[base] != nil {
+				s.addAttributes(g, s.types[base], seen)
+			}
+			s.addAttributes(g, derivation, seen)
+		}
+		return
+	}
+	for _, c := range def.children {
+		if c.name.Space != xsdNamespace {
+			continue
+		}
+		switch c.name.Local {
+		case "attribute":
+			if c.attr("use") == "prohibited" {
+				continue
+			}
+			if ref := c.attr("ref"); ref != "" {
+				space, local := c.resolve(ref)
+				if space == s.target || space == "" {
+					g.field("@"+local, goName(local), "string", local+",attr,omitempty")
+				} else {
+					g.field("@"+space+" "+local, prefixedName(c, ref), "string", space+" "+local+",attr,omitempty")
+				}
+				continue
+			}
+			name := c.attr("name")
+			g.field("@"+name, goName(name), "string", name+",attr,omitempty")
+		case "attributeGroup":
+			_, local := c.resolve(c.attr("ref"))
+			if group := s.attrGroups[local]; group != nil {
+				s.addAttributes(g, group, seen)
+			}
+		case "anyAttribute":
+			g.field("@*", "AnyAttrs", "[]xml.Attr", ",any,attr")
+		}
+	}
+}
+
+// addParticles adds a field for every element that may appear in the
+// content model under def. repeated is set when an enclosing particle may
+// occur more than once. owner is the complex type being generated, for
+// naming anonymous local types.
+func (s *schema) addParticles(g *goStruct, def *node, repeated bool, owner *node) {
+	for _, c := range def.children {
+		if c.name.Space != xsdNamespace {
+			continue
+		}
+		rep := repeated || repeats(c)
+		switch c.name.Local {
+		case "sequence", "choice", "all":
+			s.addParticles(g, c, rep, owner)
+		case "group":
+			_, local := c.resolve(c.attr("ref"))
+			if group := s.groups[local]; group != nil {
+				s.addParticles(g, group, rep, owner)
+			}
+		case "any":
+			g.field("*", "Any", "[]AnyElement", ",any")
+		case "element":
+			s.addElement(g, c, rep)
+		}
+	}
+}
+
+// addElement adds the field for element particle c.
+func (s *schema) addElement(g *goStruct, c *node, repeated bool) {
+	if ref := c.attr("ref"); ref != "" {
+		space, local := c.resolve(ref)
+		if space != s.target {
+			f := g.field(space+" "+local, prefixedName(c, ref), "*AnyElement", space+" "+local)
+			if repeated {
+				f.typ = "[]AnyElement"
+			}
+			return
+		}
+		for _, name := range s.substitutes(local) {
+			s.elementField(g, name, s.elementType(s.elements[name], name), repeated)
+		}
+		return
+	}
+	name := c.attr("name")
+	typ := s.elementType(c, g.name+goName(name))
+	s.elementField(g, name, typ, repeated)
+}
+
+// elementField adds or updates the field for child element name of Go type
+// typ ("" for a string).
+func (s *schema) elementField(g *goStruct, name, typ string, repeated bool) {
+	key := "<" + name
+	for _, f := range g.fields {
+		if f.key == key {
+			// The element appears again in the content model, so it repeats.
+			if !strings.HasPrefix(f.typ, "[]") {
+				f.typ = "[]" + strings.TrimPrefix(f.typ, "*")
+			}
+			return
+		}
+	}
+	goType := typ
+	switch {
+	case typ == "" && repeated:
+		goType = "[]string"
+	case typ == "":
+		goType = "string"
+	case repeated:
+		goType = "[]" + typ
+	default:
+		goType = "*" + typ
+	}
+	g.field(key, goName(name), goType, name)
+}
+
+// substitutes returns the non-abstract elements that may appear where the
+// global element name is referenced: the element itself and every member of
+// its substitution group, transitively, in schema order of discovery.
+func (s *schema) substitutes(name string) []string {
+	var names []string
+	seen := make(map[string]bool)
+	var visit func(string)
+	visit = func(n string) {
+		if seen[n] {
+			return
+		}
+		seen[n] = true
+		if decl := s.elements[n]; decl != nil && decl.attr("abstract") != "true" {
+			names = append(names, n)
+		}
+		for _, m := range s.members[n] {
+			visit(m)
+		}
+	}
+	visit(name)
+	return names
+}
+
+// elementType returns the Go type of element declaration decl: the struct
+// for its complex type, "AnyElement" for an untyped element, or "" for a
+// simple type. An anonymous complex type is queued for generation as anon.
+func (s *schema) elementType(decl *node, anon string) string {
+	if decl == nil {
+		return "AnyElement"
+	}
+	if t := decl.attr("type"); t != "" {
+		space, local := decl.resolve(t)
+		if space == s.target && s.types[local] != nil {
+			return goName(local)
+		}
+		if space == xsdNamespace && local == "anyType" {
+			return "AnyElement"
+		}
+		return ""
+	}
+	if def := decl.first("complexType"); def != nil {
+		origin := "the anonymous type of a local " + decl.attr("name") + " element"
+		if decl.attr("name") != "" && s.elements[decl.attr("name")] == decl {
+			anon = goName(decl.attr("name")) + "Element"
+			origin = "the anonymous type of the " + decl.attr("name") + " element"
+		}
+		s.anonymous = append(s.anonymous, anonymousType{name: anon, origin: origin, def: def, doc: documentation(decl)})
+		return anon
+	}
+	if decl.first("simpleType") != nil {
+		return ""
+	}
+	if head := decl.attr("substitutionGroup"); head != "" {
+		// An untyped member takes the type of its group's head.
+		_, local := decl.resolve(head)
+		if headDecl := s.elements[local]; headDecl != nil && headDecl != decl {
+			return s.elementType(headDecl, goName(local)+"Element")
+		}
+	}
+	return "AnyElement"
+}
+
+// repeats reports whether particle n may occur more than once.
+func repeats(n *node) bool {
+	max := n.attr("maxOccurs")
+	return max != "" && max != "0" && max != "1"
+}
+
+// documentation returns the first sentence of the documentation of n, with
+// whitespace normalized.
+func documentation(n *node) string {
+	ann := n.first("annotation")
+	if ann == nil {
+		return ""
+	}
+	doc := ann.first("documentation")
+	if doc == nil {
+		return ""
+	}
+	text := strings.Join(strings.Fields(doc.attrs["#text"]), " ")
+	if i := strings.Index(text, ". "); i >= 0 {
+		text = text[:i+1]
+	}
+	// The schema names elements in angle brackets, as in "A <level> is".
+	return strings.NewReplacer("<", "", ">", "").Replace(text)
+}
+
+// goName returns the exported Go identifier for an XML name, spelling ID
+// and XML as initialisms.
+func goName(name string) string {
+	var b strings.Builder
+	upper := true
+	for _, r := range name {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			upper = true
+			continue
+		}
+		if upper {
+			r = unicode.ToUpper(r)
+			upper = false
+		}
+		b.WriteRune(r)
+	}
+	s := b.String()
+	switch {
+	case s == "Id":
+		return "ID"
+	case strings.HasSuffix(s, "Id"):
+		return strings.TrimSuffix(s, "Id") + "ID"
+	}
+	if s == "" || unicode.IsDigit(rune(s[0])) {
+		s = "X" + s
+	}
+	return s
+}
+
+// prefixedName returns the Go field name for a QName from another
+// namespace, such as "XMLLang" for xml:lang or "XhtmlTable" for
+// xhtml:table.
+func prefixedName(n *node, qname string) string {
+	prefix, local, ok := strings.Cut(qname, ":")
+	if !ok {
+		return goName(qname)
+	}
+	switch prefix {
+	case "xml", "dc":
+		return strings.ToUpper(prefix) + goName(local)
+	}
+	return goName(prefix) + goName(local)
+}
+
+// baseName returns the last element of a slash- or backslash-separated
+// path.
+func baseName(path string) string {
+	if i := strings.LastIndexAny(path, `/\`); i >= 0 {
+		return path[i+1:]
+	}
+	return path
+}
+
+// wrap breaks text into lines of at most width characters.
+func wrap(text string, width int) []string {
+	var lines []string
+	line := ""
+	for _, word := range strings.Fields(text) {
+		switch {
+		case line == "":
+			line = word
+		case len(line)+1+len(word) <= width:
+			line += " " + word
+		default:
+			lines = append(lines, line)
+			line = word
+		}
+	}
+	if line != "" {
+		lines = append(lines, line)
+	}
+	return lines
+}
@@ -0,0 +1,33 @@
+//go:build js && wasm
+
+package main
+
+import (
+	"strings"
+	"syscall/js"
+	"testing"
+)
+
+// TestBindings calls the functions as JavaScript does. It runs under Node.js
+// through go_js_wasm_exec; see the Makefile's wasm target.
+func TestBindings(t *testing.T) {
+	xml := js.ValueOf(readSample(t))
+
+	out := parse(js.Undefined(), []js.Value{xml, js.ValueOf(map[string]interface{}{"naming": "snake"})})
+	if s, ok := out.(string); !ok || !strings.Contains(s, `"dc_title"`) {
+		t.Errorf("parse with snake naming returned %v", out)
+	}
+	if out := detectType(js.Undefined(), []js.Value{xml}); out != "bill" {
+		t.Errorf("detectType returned %v", out)
+	}
+	if out, ok := toMarkdown(js.Undefined(), []js.Value{xml}).(string); !ok || !strings.HasPrefix(out, "# ") {
+		t.Errorf("toMarkdown returned %v", out)
+	}
+
+	for _, args := range [][]js.Value{nil, {js.ValueOf(1)}, {js.ValueOf("<bill>")}} {
+		err, ok := parse(js.Undefined(), args).(js.Value)
+		if !ok || !err.InstanceOf(js.Global().Get("Error")) {
+			t.Errorf("parse(%v) returned %v, want an Error", args, err)
+		}
+	}
+}
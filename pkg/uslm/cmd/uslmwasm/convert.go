@@ -0,0 +1,34 @@
+package main
+
+import "github.com/usgpo/uslm/pkg/uslm"
+
+// The conversions behind the JavaScript functions are kept free of
+// syscall/js, so they are built and tested on every platform.
+
+// parseXML parses a document passed from JavaScript, under
+// uslm.DefaultLimits.
+func parseXML(xml string) (uslm.LegislativeDocument, error) {
+	return uslm.ParseDocumentWithOptions([]byte(xml), uslm.ParseOptions{Limits: uslm.DefaultLimits})
+}
+
+// toJSON parses xml and returns the document as JSON, for uslm.parse.
+func toJSON(xml string, opts uslm.JSONOptions) (string, error) {
+	doc, err := parseXML(xml)
+	if err != nil {
+		return "", err
+	}
+	out, err := uslm.ToJSONWithOptions(doc, opts)
+	if err != nil {
+		return "", err
+	}
+	return string(out), nil
+}
+
+// fieldNaming returns the key naming selected by the naming option: "snake"
+// for snake_case, anything else for the camelCase of the schema.
+func fieldNaming(naming string) uslm.FieldNaming {
+	if naming == "snake" {
+		return uslm.FieldNamingSnake
+	}
+	return uslm.FieldNamingCamel
+}
@@ -0,0 +1,70 @@
+package main
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/usgpo/uslm/pkg/uslm"
+)
+
+func readSample(t *testing.T) string {
+	t.Helper()
+	data, err := os.ReadFile(filepath.Join("..", "..", "..", "..", "bill-version-samples-september-2024", "BILLS-114s32cds.xml"))
+	if err != nil {
+		t.Fatalf("failed to read sample: %v", err)
+	}
+	return string(data)
+}
+
+func TestToJSON(t *testing.T) {
+	xml := readSample(t)
+	for _, tc := range []struct {
+		name string
+		opts uslm.JSONOptions
+		want string
+	}{
+		{"camel", uslm.JSONOptions{}, `"dcTitle"`},
+		{"snake", uslm.JSONOptions{Naming: fieldNaming("snake")}, `"dc_title"`},
+		{"indented", uslm.JSONOptions{Indent: true}, "\n  "},
+	} {
+		out, err := toJSON(xml, tc.opts)
+		if err != nil {
+			t.Fatalf("%s: failed to convert: %v", tc.name, err)
+		}
+		if !json.Valid([]byte(out)) || !strings.Contains(out, tc.want) {
+			t.Errorf("%s: expected valid JSON containing %q", tc.name, tc.want)
+		}
+	}
+
+	// The JSON is the document's, as on the server.
+	doc, err := uslm.ParseDocument([]byte(xml))
+	if err != nil {
+		t.Fatalf("failed to parse sample: %v", err)
+	}
+	want, err := uslm.ToJSONWithOptions(doc, uslm.JSONOptions{Canonical: true})
+	if err != nil {
+		t.Fatalf("failed to convert sample: %v", err)
+	}
+	if got, err := toJSON(xml, uslm.JSONOptions{Canonical: true}); err != nil || got != string(want) {
+		t.Errorf("toJSON differs from ToJSONWithOptions (error %v)", err)
+	}
+
+	if _, err := toJSON("<bill>", uslm.JSONOptions{}); err == nil {
+		t.Error("expected an error for malformed XML")
+	}
+	deep := strings.Repeat("<level>", uslm.DefaultLimits.MaxDepth+1)
+	if _, err := toJSON(`<bill xmlns="http://schemas.gpo.gov/xml/uslm"><main>`+deep, uslm.JSONOptions{}); err == nil || !strings.Contains(err.Error(), "limit") {
+		t.Errorf("expected DefaultLimits to apply, got %v", err)
+	}
+}
+
+func TestFieldNaming(t *testing.T) {
+	for naming, want := range map[string]uslm.FieldNaming{"snake": uslm.FieldNamingSnake, "camel": uslm.FieldNamingCamel, "": uslm.FieldNamingCamel, "kebab": uslm.FieldNamingCamel} {
+		if got := fieldNaming(naming); got != want {
+			t.Errorf("fieldNaming(%q) = %v, want %v", naming, got, want)
+		}
+	}
+}
@@ -0,0 +1,5 @@
+package main
+
+import "errors"
+
+var errXMLArgument = errors.New("uslm: the first argument must be the document XML as a string")
@@ -0,0 +1,118 @@
+//go:build js && wasm
+
+// Command uslmwasm exposes the parser to JavaScript when compiled to
+// WebAssembly, so browser-based bill viewers parse documents with exactly the
+// logic used on the server.
+//
+// Build it with:
+//
+//	GOOS=js GOARCH=wasm go build -o uslm.wasm ./cmd/uslmwasm
+//
+// and serve uslm.wasm with uslm.js from this directory and the wasm_exec.js
+// that ships with Go (in $(go env GOROOT)/lib/wasm, or misc/wasm before Go
+// 1.24). Running the module defines a global uslm object:
+//
+//	uslm.parse(xml, options)   the document as JSON; options may set naming
+//	                           ("camel" or "snake"), sortKeys, indent, and
+//	                           canonical, as uslm.JSONOptions
+//	uslm.toMarkdown(xml)       the document rendered as Markdown
+//	uslm.toHTML(xml, options)  the document rendered as an HTML page; options
+//	                           may set accessible
+//	uslm.detectType(xml)       the document type ("bill", "resolution", ...)
+//
+// Each function takes the XML as a string and returns a string, or an Error
+// if the document cannot be parsed. Documents are parsed under
+// uslm.DefaultLimits. uslm.js wraps the functions to throw those errors.
+package main
+
+import (
+	"syscall/js"
+
+	"github.com/usgpo/uslm/pkg/uslm"
+)
+
+func main() {
+	js.Global().Set("uslm", js.ValueOf(map[string]interface{}{
+		"parse":      js.FuncOf(parse),
+		"toMarkdown": js.FuncOf(toMarkdown),
+		"toHTML":     js.FuncOf(toHTML),
+		"detectType": js.FuncOf(detectType),
+	}))
+	// Keep the functions alive for the life of the page.
+	select {}
+}
+
+func parse(this js.Value, args []js.Value) interface{} {
+	xml, err := xmlArg(args)
+	if err != nil {
+		return jsError(err)
+	}
+	opts := uslm.JSONOptions{
+		SortKeys:  option(args, "sortKeys").Truthy(),
+		Indent:    option(args, "indent").Truthy(),
+		Canonical: option(args, "canonical").Truthy(),
+	}
+	if naming := option(args, "naming"); naming.Type() == js.TypeString {
+		opts.Naming = fieldNaming(naming.String())
+	}
+	out, err := toJSON(xml, opts)
+	if err != nil {
+		return jsError(err)
+	}
+	return out
+}
+
+func toMarkdown(this js.Value, args []js.Value) interface{} {
+	doc, err := parseArg(args)
+	if err != nil {
+		return jsError(err)
+	}
+	return uslm.ToMarkdown(doc)
+}
+
+func toHTML(this js.Value, args []js.Value) interface{} {
+	doc, err := parseArg(args)
+	if err != nil {
+		return jsError(err)
+	}
+	return uslm.ToHTMLWithOptions(doc, uslm.HTMLOptions{Accessible: option(args, "accessible").Truthy()})
+}
+
+func detectType(this js.Value, args []js.Value) interface{} {
+	xml, err := xmlArg(args)
+	if err != nil {
+		return jsError(err)
+	}
+	return string(uslm.DetectDocumentType([]byte(xml)))
+}
+
+// xmlArg returns the XML string passed as the first argument.
+func xmlArg(args []js.Value) (string, error) {
+	if len(args) == 0 || args[0].Type() != js.TypeString {
+		return "", errXMLArgument
+	}
+	return args[0].String(), nil
+}
+
+// parseArg parses the XML string passed as the first argument.
+func parseArg(args []js.Value) (uslm.LegislativeDocument, error) {
+	xml, err := xmlArg(args)
+	if err != nil {
+		return nil, err
+	}
+	return parseXML(xml)
+}
+
+// option returns the named property of the options object passed as the
+// second argument, or undefined.
+func option(args []js.Value, name string) js.Value {
+	if len(args) < 2 || args[1].Type() != js.TypeObject {
+		return js.Undefined()
+	}
+	return args[1].Get(name)
+}
+
+// jsError returns err as a JavaScript Error.
+func jsError(err error) js.Value {
+	return js.Global().Get("Error").New(err.Error())
+}
@@ -0,0 +1,15 @@
+//go:build !(js && wasm)
+
+package main
+
+import (
+	"fmt"
+	"os"
+)
+
+// main explains how to build the command, as its bindings exist only in
+// WebAssembly.
+func main() {
+	fmt.Fprintln(os.Stderr, "uslmwasm: build with GOOS=js GOARCH=wasm")
+	os.Exit(2)
+}
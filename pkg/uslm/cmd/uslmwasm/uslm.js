@@ -0,0 +1,30 @@
+// uslm.js loads uslm.wasm (built from cmd/uslmwasm) and returns its
+// functions, throwing the Errors they return. wasm_exec.js from the Go
+// distribution must be loaded first, as it defines Go.
+//
+//   import { load } from "./uslm.js";
+//   const uslm = await load("uslm.wasm");
+//   const bill = JSON.parse(uslm.parse(xml));
+//   document.body.innerHTML = uslm.toHTML(xml, { accessible: true });
+
+export async function load(url = "uslm.wasm") {
+  const go = new Go();
+  const { instance } = await WebAssembly.instantiateStreaming(fetch(url), go.importObject);
+  // The module runs until the page unloads; its functions are ready once
+  // run has set the global.
+  go.run(instance);
+  const api = globalThis.uslm;
+  const wrap = (fn) => (...args) => {
+    const result = fn(...args);
+    if (result instanceof Error) {
+      throw result;
+    }
+    return result;
+  };
+  return {
+    parse: wrap(api.parse),
+    toMarkdown: wrap(api.toMarkdown),
+    toHTML: wrap(api.toHTML),
+    detectType: wrap(api.detectType),
+  };
+}
@@ -0,0 +1,321 @@
+package uslm
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// Designation names a provision by its level and number as amending
+// instructions write it, e.g. {Unit: "paragraph", Num: "(3)"}.
+type Designation struct {
+	Unit string `json:"unit,omitempty"`
+	Num  string `json:"num"`
+}
+
+// String returns the designation as written, e.g. "paragraph (3)".
+func (d Designation) String() string {
+	return strings.TrimSpace(d.Unit + " " + d.Num)
+}
+
+// CodificationChange is one provision repealed or redesignated by an
+// amending instruction, in a form suitable for maintaining a codification.
+type CodificationChange struct {
+	// Kind is AmendingActionRepeal or AmendingActionRedesignate.
+	Kind AmendingActionKind `json:"kind"`
+
+	// Citation and Identifier locate the instruction in the document.
+	Citation   string `json:"citation"`
+	Identifier string `json:"identifier,omitempty"`
+
+	// Target is the href of the law or Code provision being amended, from
+	// the instruction's own references or those of the nearest enclosing
+	// chapeau or content (e.g., "/us/usc/t42/s280i").
+	Target string `json:"target,omitempty"`
+
+	// From is the provision repealed or redesignated, within Target, and
+	// To its new designation. A repeal that names no provision, such as
+	// of a whole Act, gives the subject as written in From.Num, with no
+	// Unit.
+	From Designation  `json:"from"`
+	To   *Designation `json:"to,omitempty"`
+
+	// Text is the text of the instruction.
+	Text string `json:"text"`
+}
+
+// codificationUnit matches the level names used in designations.
+const codificationUnit = `(?:sub)?(?:sections?|chapters?|parts?|titles?|divisions?|paragraphs?|clauses?|items?)|subparagraphs?|subtitles?|subchapters?`
+
+// codificationList matches a list or range of designations, such as
+// "(3)", "(b) and (c)", "(8) through (21)", and "101, 102, and 103".
+const codificationList = `(?:\(\w+\)|\w+)(?:\(\w+\))*(?:(?:,\s*|\s+)(?:and\s+|or\s+|through\s+)?(?:\(\w+\)|\d\w*)(?:\(\w+\))*)*`
+
+var (
+	// redesignatingPattern matches "redesignating paragraphs (2) and (3)
+	// as paragraphs (3) and (4)" and "section 5 of title 10 is redesignated
+	// as section 6", with an optional parenthetical after the old
+	// designation.
+	redesignatingPattern = regexp.MustCompile(`(?i)\b(?:redesignating\s+(?:the\s+(?:first|second|third)\s+)?(` + codificationUnit + `)\s+(` + codificationList + `)(?:\s+\([^()]*\))?|(` + codificationUnit + `)\s+(` + codificationList + `)(?:\s+\([^()]*\))?(?:\s+of\s+[^;:—]*?)?\s+(?:is|are)\s+(?:hereby\s+)?redesignated)\s+as\s+(` + codificationUnit + `)\s+(` + codificationList + `)`)
+
+	// repealingPattern matches "repealing paragraph (3)".
+	repealingPattern = regexp.MustCompile(`(?i)\brepealing\s+(` + codificationUnit + `)\s+(` + codificationList + `)`)
+
+	// repealedPattern matches "is repealed" and "are hereby repealed".
+	repealedPattern = regexp.MustCompile(`(?i)\b(?:is|are)\s+(?:hereby\s+)?repealed\b`)
+
+	// repealedSubjectPattern matches the first designation in the subject
+	// of "is repealed", as in "section 214(g)(10) of the Immigration and
+	// Nationality Act".
+	repealedSubjectPattern = regexp.MustCompile(`(?i)\b(` + codificationUnit + `)\s+(` + codificationList + `)`)
+
+	// listSeparator splits a designation list into designations and
+	// "through" markers.
+	listSeparator = regexp.MustCompile(`(?i),?\s*(?:\band\b|\bor\b)?\s+|,\s*`)
+)
+
+// FindCodificationChanges returns the repeals and redesignations made by
+// the document's amending instructions, one change per provision, in
+// document order. Lists and ranges are expanded, so "redesignating
+// paragraphs (8) through (21) as paragraphs (9) through (22),
+// respectively" yields fourteen changes, from paragraph (8) to paragraph
+// (9) onward. A range that cannot be expanded, or whose two sides differ in
+// length, is reported as a single change between the designations as
+// written. Instructions are recognized in their prose; the Target is the
+// first reference found, so it names the amended provision only as
+// precisely as the instruction's chapeau does.
+func FindCodificationChanges(doc LegislativeDocument) []CodificationChange {
+	var changes []CodificationChange
+	for _, top := range Provisions(doc) {
+		top.Walk(func(p *Provision) bool {
+			text := p.GetText()
+			if !redesignatePattern.MatchString(text) && !repealPattern.MatchString(text) {
+				return true
+			}
+			base := CodificationChange{
+				Citation:   p.PathString(),
+				Identifier: p.Identifier,
+				Target:     codificationTarget(p),
+				Text:       text,
+			}
+			changes = append(changes, redesignations(base, text)...)
+			changes = append(changes, repeals(base, text)...)
+			return true
+		})
+	}
+	return changes
+}
+
+// codificationTarget returns the href of the first reference in the
+// provision's own text or, failing that, in the nearest ancestor with one.
+func codificationTarget(p *Provision) string {
+	for q := p; q != nil; q = q.Parent {
+		for _, ref := range q.GetRefs() {
+			if ref.Href != "" {
+				return ref.Href
+			}
+		}
+	}
+	return ""
+}
+
+// redesignations returns the redesignations in text.
+func redesignations(base CodificationChange, text string) []CodificationChange {
+	var changes []CodificationChange
+	for _, m := range redesignatingPattern.FindAllStringSubmatch(text, -1) {
+		fromUnit, fromList := m[1], m[2]
+		if fromUnit == "" {
+			fromUnit, fromList = m[3], m[4]
+		}
+		toUnit, toList := singularUnit(m[5]), m[6]
+		fromUnit = singularUnit(fromUnit)
+
+		from, to := expandDesignations(fromUnit, fromList), expandDesignations(toUnit, toList)
+		if from == nil || to == nil || len(from) != len(to) {
+			from = []string{normalizeSpace(fromList)}
+			to = []string{normalizeSpace(toList)}
+		}
+		for i := range from {
+			c := base
+			c.Kind = AmendingActionRedesignate
+			c.From = Designation{Unit: fromUnit, Num: from[i]}
+			c.To = &Designation{Unit: toUnit, Num: to[i]}
+			changes = append(changes, c)
+		}
+	}
+	return changes
+}
+
+// repeals returns the repeals in text: "repealing paragraph (3)", and
+// "section 5 of the Act is repealed", whose subject is taken from the start
+// of the clause.
+func repeals(base CodificationChange, text string) []CodificationChange {
+	var changes []CodificationChange
+	add := func(unit, list string) {
+		unit = singularUnit(unit)
+		nums := expandDesignations(unit, list)
+		if nums == nil {
+			nums = []string{normalizeSpace(list)}
+		}
+		for _, num := range nums {
+			c := base
+			c.Kind = AmendingActionRepeal
+			c.From = Designation{Unit: unit, Num: num}
+			changes = append(changes, c)
+		}
+	}
+
+	for _, m := range repealingPattern.FindAllStringSubmatch(text, -1) {
+		add(m[1], m[2])
+	}
+	for _, loc := range repealedPattern.FindAllStringIndex(text, -1) {
+		subject := text[:loc[0]]
+		if i := strings.LastIndexAny(subject, ";—:"); i >= 0 {
+			_, size := utf8.DecodeRuneInString(subject[i:])
+			subject = subject[i+size:]
+		}
+		// The subject may open with other words, as in "Effective October
+		// 1, 2020, section 214(g)(10) of ..."; its first designation is
+		// the provision repealed.
+		if m := repealedSubjectPattern.FindStringSubmatch(subject); m != nil {
+			add(m[1], m[2])
+			continue
+		}
+		c := base
+		c.Kind = AmendingActionRepeal
+		c.From = Designation{Num: strings.TrimSpace(subject)}
+		changes = append(changes, c)
+	}
+	return changes
+}
+
+// singularUnit returns a designation unit in the singular and lower case.
+func singularUnit(unit string) string {
+	unit = strings.ToLower(unit)
+	if strings.HasSuffix(unit, "s") {
+		unit = unit[:len(unit)-1]
+	}
+	return unit
+}
+
+// expandDesignations returns the designations of a list such as "(b),
+// (c), and (d)" or "(8) through (21)", with ranges expanded, or nil if a
+// range cannot be expanded.
+func expandDesignations(unit, list string) []string {
+	var out []string
+	through := false
+	for _, tok := range listSeparator.Split(strings.TrimSpace(list), -1) {
+		switch {
+		case tok == "":
+			continue
+		case strings.EqualFold(tok, "through"):
+			through = true
+			continue
+		}
+		if !through || len(out) == 0 {
+			out = append(out, tok)
+			continue
+		}
+		between, ok := designationRange(unit, out[len(out)-1], tok)
+		if !ok {
+			return nil
+		}
+		out = append(out, between...)
+		through = false
+	}
+	if through {
+		return nil
+	}
+	return out
+}
+
+// designationRange returns the designations after first through last:
+// numbers, single letters, or, for clauses and subclauses, roman numerals,
+// each in the form of first (parenthesized or not).
+func designationRange(unit, first, last string) ([]string, bool) {
+	paren := strings.HasPrefix(first, "(") && strings.HasSuffix(first, ")")
+	if paren != (strings.HasPrefix(last, "(") && strings.HasSuffix(last, ")")) {
+		return nil, false
+	}
+	a, b := strings.Trim(first, "()"), strings.Trim(last, "()")
+	format := func(s string) string {
+		if paren {
+			return "(" + s + ")"
+		}
+		return s
+	}
+
+	var out []string
+	if x, err := strconv.Atoi(a); err == nil {
+		y, err := strconv.Atoi(b)
+		if err != nil || y <= x || y-x > 1000 {
+			return nil, false
+		}
+		for n := x + 1; n <= y; n++ {
+			out = append(out, format(strconv.Itoa(n)))
+		}
+		return out, true
+	}
+	if (unit == "clause" || unit == "subclause") && romanNumeral.MatchString(a) && romanNumeral.MatchString(b) {
+		x, y := romanValue(a), romanValue(b)
+		if x == 0 || y <= x {
+			return nil, false
+		}
+		upper := strings.ToUpper(a) == a
+		for n := x + 1; n <= y; n++ {
+			r := toRoman(n)
+			if !upper {
+				r = strings.ToLower(r)
+			}
+			out = append(out, format(r))
+		}
+		return out, true
+	}
+	if len(a) == 1 && len(b) == 1 && isLetter(a[0]) && isLetter(b[0]) && (a[0] < 'a') == (b[0] < 'a') && b[0] > a[0] {
+		for c := a[0] + 1; c <= b[0]; c++ {
+			out = append(out, format(string(rune(c))))
+		}
+		return out, true
+	}
+	return nil, false
+}
+
+// isLetter reports whether c is an ASCII letter.
+func isLetter(c byte) bool {
+	return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z'
+}
+
+// romanValue returns the value of a roman numeral, or 0.
+func romanValue(s string) int {
+	values := map[byte]int{'i': 1, 'v': 5, 'x': 10, 'l': 50, 'c': 100}
+	s = strings.ToLower(s)
+	total := 0
+	for i := 0; i < len(s); i++ {
+		v := values[s[i]]
+		if v == 0 {
+			return 0
+		}
+		if i+1 < len(s) && values[s[i+1]] > v {
+			total -= v
+		} else {
+			total += v
+		}
+	}
+	return total
+}
+
+// toRoman returns n, from 1 to 399, as an upper-case roman numeral.
+func toRoman(n int) string {
+	var b strings.Builder
+	for _, r := range []struct {
+		value   int
+		numeral string
+	}{{100, "C"}, {90, "XC"}, {50, "L"}, {40, "XL"}, {10, "X"}, {9, "IX"}, {5, "V"}, {4, "IV"}, {1, "I"}} {
+		for n >= r.value {
+			b.WriteString(r.numeral)
+			n -= r.value
+		}
+	}
+	return b.String()
+}
@@ -32,36 +32,42 @@ type Inline struct {
 	Class   string   `xml:"class,attr,omitempty" json:"class,omitempty"`
 	Role    string   `xml:"role,attr,omitempty" json:"role,omitempty"`
 	Text    string   `xml:",chardata" json:"text,omitempty"`
+	Attrs   Attributes `xml:",any,attr" json:"attrs,omitempty"`
 }
 
 // Italic represents italic text (<i> element).
 type Italic struct {
 	XMLName xml.Name `xml:"i" json:"-"`
 	Text    string   `xml:",chardata" json:"text,omitempty"`
+	Attrs   Attributes `xml:",any,attr" json:"attrs,omitempty"`
 }
 
 // Bold represents bold text (<b> element).
 type Bold struct {
 	XMLName xml.Name `xml:"b" json:"-"`
 	Text    string   `xml:",chardata" json:"text,omitempty"`
+	Attrs   Attributes `xml:",any,attr" json:"attrs,omitempty"`
 }
 
 // Sup represents superscript text.
 type Sup struct {
 	XMLName xml.Name `xml:"sup" json:"-"`
 	Text    string   `xml:",chardata" json:"text,omitempty"`
+	Attrs   Attributes `xml:",any,attr" json:"attrs,omitempty"`
 }
 
 // Sub represents subscript text.
 type Sub struct {
 	XMLName xml.Name `xml:"sub" json:"-"`
 	Text    string   `xml:",chardata" json:"text,omitempty"`
+	Attrs   Attributes `xml:",any,attr" json:"attrs,omitempty"`
 }
 
 // Term represents a defined term.
 type Term struct {
 	XMLName xml.Name `xml:"term" json:"-"`
 	Text    string   `xml:",chardata" json:"text,omitempty"`
+	Attrs   Attributes `xml:",any,attr" json:"attrs,omitempty"`
 }
 
 // Ref represents a reference/hyperlink to other content.
@@ -70,6 +76,7 @@ type Ref struct {
 	Href    string   `xml:"href,attr,omitempty" json:"href,omitempty"`
 	Text    string   `xml:",chardata" json:"text,omitempty"`
 	InnerRef *Ref    `xml:"ref" json:"innerRef,omitempty"` // Nested refs can occur
+	Attrs    Attributes `xml:",any,attr" json:"attrs,omitempty"`
 }
 
 // P represents a paragraph element within mixed content.
@@ -77,6 +84,7 @@ type P struct {
 	XMLName xml.Name `xml:"p" json:"-"`
 	Class   string   `xml:"class,attr,omitempty" json:"class,omitempty"`
 	Text    string   `xml:",chardata" json:"text,omitempty"`
+	Attrs   Attributes `xml:",any,attr" json:"attrs,omitempty"`
 }
 
 // ShortTitle represents a short title citation within content.
@@ -84,12 +92,38 @@ type ShortTitle struct {
 	XMLName xml.Name `xml:"shortTitle" json:"-"`
 	Role    string   `xml:"role,attr,omitempty" json:"role,omitempty"`
 	Text    string   `xml:",chardata" json:"text,omitempty"`
+	Attrs   Attributes `xml:",any,attr" json:"attrs,omitempty"`
 }
 
 // QuotedText represents quoted text in content.
 type QuotedText struct {
 	XMLName xml.Name `xml:"quotedText" json:"-"`
 	Text    string   `xml:",chardata" json:"text,omitempty"`
+	Attrs   Attributes `xml:",any,attr" json:"attrs,omitempty"`
+}
+
+// AddedText represents text a proposed amendment inserts within a level
+// (<addedText> element); origin points at the committee or chamber proposing it.
+type AddedText struct {
+	XMLName xml.Name `xml:"addedText" json:"-"`
+	Origin  string   `xml:"origin,attr,omitempty" json:"origin,omitempty"`
+	Class   string   `xml:"class,attr,omitempty" json:"class,omitempty"`
+	Text    string   `xml:",chardata" json:"text,omitempty"`
+	Ref     []Ref    `xml:"ref" json:"ref,omitempty"`
+	Attrs   Attributes `xml:",any,attr" json:"attrs,omitempty"`
+	text    string   `xml:"-" json:"-"`
+}
+
+// DeletedText represents text a proposed amendment strikes within a level
+// (<deletedText> element).
+type DeletedText struct {
+	XMLName xml.Name `xml:"deletedText" json:"-"`
+	Origin  string   `xml:"origin,attr,omitempty" json:"origin,omitempty"`
+	Class   string   `xml:"class,attr,omitempty" json:"class,omitempty"`
+	Text    string   `xml:",chardata" json:"text,omitempty"`
+	Ref     []Ref    `xml:"ref" json:"ref,omitempty"`
+	Attrs   Attributes `xml:",any,attr" json:"attrs,omitempty"`
+	text    string   `xml:"-" json:"-"`
 }
 
 // AmendingAction represents an amendment action type (delete, insert, amend, etc.).
@@ -97,6 +131,7 @@ type AmendingAction struct {
 	XMLName xml.Name `xml:"amendingAction" json:"-"`
 	Type    string   `xml:"type,attr,omitempty" json:"type,omitempty"`
 	Text    string   `xml:",chardata" json:"text,omitempty"`
+	Attrs   Attributes `xml:",any,attr" json:"attrs,omitempty"`
 }
 
 // Num represents a designation number (e.g., "SECTION 1.", "(a)", "(1)").
@@ -104,6 +139,7 @@ type Num struct {
 	XMLName xml.Name `xml:"num" json:"-"`
 	Value   string   `xml:"value,attr,omitempty" json:"value,omitempty"`
 	Text    string   `xml:",chardata" json:"text,omitempty"`
+	Attrs   Attributes `xml:",any,attr" json:"attrs,omitempty"`
 }
 
 // Heading represents a heading for a section or other structural element.
@@ -112,6 +148,9 @@ type Heading struct {
 	Class   string   `xml:"class,attr,omitempty" json:"class,omitempty"`
 	Text    string   `xml:",chardata" json:"text,omitempty"`
 	Inline  []Inline `xml:"inline" json:"inline,omitempty"`
+	Attrs   Attributes `xml:",any,attr" json:"attrs,omitempty"`
+	text    string     `xml:"-" json:"-"`
+	langs   []langSpan `xml:"-" json:"-"`
 }
 
 // GetText returns the text content of the heading.
@@ -132,6 +171,11 @@ type Content struct {
 	AmendingAction []AmendingAction  `xml:"amendingAction" json:"amendingAction,omitempty"`
 	QuotedContent  []QuotedContent   `xml:"quotedContent" json:"quotedContent,omitempty"`
 	AmendmentContent []AmendmentContent `xml:"amendmentContent" json:"amendmentContent,omitempty"`
+	AddedText        []AddedText        `xml:"addedText" json:"addedText,omitempty"`
+	DeletedText      []DeletedText      `xml:"deletedText" json:"deletedText,omitempty"`
+	Attrs            Attributes         `xml:",any,attr" json:"attrs,omitempty"`
+	text             string             `xml:"-" json:"-"`
+	langs            []langSpan         `xml:"-" json:"-"`
 }
 
 // Chapeau represents introductory text (lead-in) before nested elements.
@@ -142,6 +186,9 @@ type Chapeau struct {
 	Inline         []Inline         `xml:"inline" json:"inline,omitempty"`
 	Ref            []Ref            `xml:"ref" json:"ref,omitempty"`
 	AmendingAction []AmendingAction `xml:"amendingAction" json:"amendingAction,omitempty"`
+	Attrs          Attributes       `xml:",any,attr" json:"attrs,omitempty"`
+	text           string           `xml:"-" json:"-"`
+	langs          []langSpan       `xml:"-" json:"-"`
 }
 
 // QuotedContent represents quoted legislative content (for amending existing law).
@@ -152,6 +199,7 @@ type QuotedContent struct {
 	Paragraph  []Paragraph `xml:"paragraph" json:"paragraph,omitempty"`
 	Subsection []Subsection `xml:"subsection" json:"subsection,omitempty"`
 	Section    []Section   `xml:"section" json:"section,omitempty"`
+	Attrs      Attributes  `xml:",any,attr" json:"attrs,omitempty"`
 }
 
 // AmendmentContent represents content being added or modified by an amendment.
@@ -161,4 +209,5 @@ type AmendmentContent struct {
 	Changed   string    `xml:"changed,attr,omitempty" json:"changed,omitempty"`
 	StyleType string    `xml:"styleType,attr,omitempty" json:"styleType,omitempty"`
 	Section   []Section `xml:"section" json:"section,omitempty"`
+	Attrs     Attributes `xml:",any,attr" json:"attrs,omitempty"`
 }
@@ -0,0 +1,110 @@
+package uslm
+
+import (
+	"regexp"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// concordanceContext is the number of words of context Concordance gives on
+// each side of an occurrence.
+const concordanceContext = 8
+
+// ConcordanceEntry is one occurrence of a term found by Concordance, in
+// keyword-in-context form: Before, Match, and After read as a continuous
+// excerpt of the provision's text.
+type ConcordanceEntry struct {
+	// Provision is the level whose own heading, chapeau, or content holds
+	// the occurrence. It points into the provision tree of the document.
+	Provision *Provision `json:"-"`
+
+	// Citation is the provision's PathString (e.g. "Section 301(a)(2)"),
+	// and Identifier its identifier, if it has one.
+	Citation   string `json:"citation"`
+	Identifier string `json:"identifier,omitempty"`
+
+	// Match is the occurrence as written. Before and After hold up to eight
+	// words of the provision's text on either side.
+	Before string `json:"before,omitempty"`
+	Match  string `json:"match"`
+	After  string `json:"after,omitempty"`
+}
+
+// String returns the entry on one line, with the match set off by brackets.
+func (e ConcordanceEntry) String() string {
+	return strings.TrimSpace(e.Before + " [" + e.Match + "] " + e.After)
+}
+
+// Concordance returns every occurrence of term, a word or phrase, in the
+// headings, chapeaus, and content of the document's provisions, in document
+// order. Matching ignores case, differences in whitespace, and the
+// typographic differences NormalizeText removes, and matches whole words
+// only: "tribe" does not match "tribes".
+func Concordance(doc LegislativeDocument, term string) []ConcordanceEntry {
+	opts := DefaultNormalizeOptions()
+	words := strings.Fields(NormalizeText(term, opts))
+	if len(words) == 0 {
+		return nil
+	}
+	for i, w := range words {
+		words[i] = regexp.QuoteMeta(w)
+	}
+	re := regexp.MustCompile(`(?i)` + strings.Join(words, `\s+`))
+
+	var entries []ConcordanceEntry
+	for _, top := range Provisions(doc) {
+		top.Walk(func(p *Provision) bool {
+			text := NormalizeText(p.GetHeading()+" "+p.GetText(), opts)
+			for _, loc := range re.FindAllStringIndex(text, -1) {
+				if !wordBoundary(text, loc[0], loc[1]) {
+					continue
+				}
+				entries = append(entries, ConcordanceEntry{
+					Provision:  p,
+					Citation:   p.PathString(),
+					Identifier: p.Identifier,
+					Before:     lastWords(text[:loc[0]], concordanceContext),
+					Match:      text[loc[0]:loc[1]],
+					After:      firstWords(text[loc[1]:], concordanceContext),
+				})
+			}
+			return true
+		})
+	}
+	return entries
+}
+
+// wordBoundary reports whether text[start:end] neither begins nor ends in
+// the middle of a word.
+func wordBoundary(text string, start, end int) bool {
+	first, _ := utf8.DecodeRuneInString(text[start:end])
+	before, _ := utf8.DecodeLastRuneInString(text[:start])
+	last, _ := utf8.DecodeLastRuneInString(text[start:end])
+	after, _ := utf8.DecodeRuneInString(text[end:])
+	return !(isWordRune(first) && start > 0 && isWordRune(before)) &&
+		!(isWordRune(last) && end < len(text) && isWordRune(after))
+}
+
+// isWordRune reports whether r is part of a word.
+func isWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r)
+}
+
+// lastWords returns the last n words of s.
+func lastWords(s string, n int) string {
+	words := strings.Fields(s)
+	if len(words) > n {
+		words = words[len(words)-n:]
+	}
+	return strings.Join(words, " ")
+}
+
+// firstWords returns the first n words of s.
+func firstWords(s string, n int) string {
+	words := strings.Fields(s)
+	if len(words) > n {
+		words = words[:n]
+	}
+	return strings.Join(words, " ")
+}
@@ -0,0 +1,43 @@
+package uslm
+
+import "time"
+
+// CongressDates returns the term of the nth Congress as the half-open range
+// [start, end): start is the day the Congress convened and end the day its
+// successor did. Dates are midnight UTC.
+//
+// The 1st through 72nd Congresses ran from March 4 of an odd year, beginning
+// with March 4, 1789. The Twentieth Amendment moved the start of each term to
+// January 3, so the 73rd Congress ran from March 4, 1933, to January 3, 1935,
+// and each Congress since has begun on January 3 of an odd year. Zero times
+// are returned for n < 1.
+func CongressDates(n int) (start, end time.Time) {
+	if n < 1 {
+		return time.Time{}, time.Time{}
+	}
+	return congressStart(n), congressStart(n + 1)
+}
+
+// congressStart returns the day the nth Congress convened.
+func congressStart(n int) time.Time {
+	year := 1789 + 2*(n-1)
+	if n <= 73 {
+		return time.Date(year, time.March, 4, 0, 0, 0, 0, time.UTC)
+	}
+	return time.Date(year, time.January, 3, 0, 0, 0, 0, time.UTC)
+}
+
+// CongressForDate returns the number of the Congress sitting on the calendar
+// day of t, in t's location, or 0 if t is before March 4, 1789. On the day
+// one Congress gives way to the next, the new Congress is returned.
+func CongressForDate(t time.Time) int {
+	day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+	if day.Before(congressStart(1)) {
+		return 0
+	}
+	n := (day.Year()-1789)/2 + 1
+	if day.Before(congressStart(n)) {
+		n--
+	}
+	return n
+}
@@ -0,0 +1,224 @@
+// Package congressgov reconciles parsed documents with the congress.gov API.
+// It fetches the sponsor, cosponsor, committee, and action data congress.gov
+// holds for a measure and reports where a document version disagrees with
+// it, for monitoring the quality of bill data over time:
+//
+//	client := congressgov.NewClient(os.Getenv("CONGRESS_API_KEY"))
+//	report, err := client.Reconcile(ctx, doc)
+//	for _, d := range report.Discrepancies {
+//		log.Println(d)
+//	}
+//
+// API keys are issued at https://api.congress.gov/sign-up/.
+package congressgov
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/usgpo/uslm/pkg/uslm"
+)
+
+// DefaultBaseURL is the congress.gov API endpoint.
+const DefaultBaseURL = "https://api.congress.gov/v3"
+
+// Client fetches measure data from the congress.gov API.
+type Client struct {
+	// APIKey is sent with every request in the X-Api-Key header.
+	APIKey string
+
+	// BaseURL is the API endpoint; DefaultBaseURL if empty.
+	BaseURL string
+
+	// HTTPClient is used for requests; http.DefaultClient if nil.
+	HTTPClient *http.Client
+}
+
+// NewClient returns a client for the public API using apiKey.
+func NewClient(apiKey string) *Client {
+	return &Client{APIKey: apiKey}
+}
+
+// Measure identifies a bill or resolution, e.g. {118, "hr", 1}. Type is the
+// congress.gov code: hr, s, hjres, sjres, hconres, sconres, hres, or sres.
+type Measure struct {
+	Congress int
+	Type     string
+	Number   int
+}
+
+// String returns the measure's path in the API, e.g. "118/hr/1".
+func (m Measure) String() string {
+	return fmt.Sprintf("%d/%s/%d", m.Congress, m.Type, m.Number)
+}
+
+// MeasureOf returns the measure doc is a version of, from its compact
+// citation (see uslm.MeasureIdentifier).
+func MeasureOf(doc uslm.LegislativeDocument) (Measure, error) {
+	id := uslm.MeasureIdentifier(doc)
+	parts := strings.Split(strings.TrimPrefix(id, "/us/"), "/")
+	if len(parts) != 4 {
+		return Measure{}, fmt.Errorf("document has no measure citation")
+	}
+	congress, err := strconv.Atoi(parts[1])
+	if err != nil {
+		return Measure{}, fmt.Errorf("invalid congress in %s: %w", id, err)
+	}
+	number, err := strconv.Atoi(parts[3])
+	if err != nil {
+		return Measure{}, fmt.Errorf("invalid number in %s: %w", id, err)
+	}
+	return Measure{Congress: congress, Type: parts[2], Number: number}, nil
+}
+
+// Member is a sponsor or cosponsor as congress.gov records it.
+type Member struct {
+	BioguideID string `json:"bioguideId"`
+	FullName   string `json:"fullName"`
+	FirstName  string `json:"firstName"`
+	LastName   string `json:"lastName"`
+	Party      string `json:"party"`
+	State      string `json:"state"`
+
+	// SponsorshipDate and SponsorshipWithdrawnDate are set for cosponsors.
+	SponsorshipDate          string `json:"sponsorshipDate,omitempty"`
+	SponsorshipWithdrawnDate string `json:"sponsorshipWithdrawnDate,omitempty"`
+	IsOriginalCosponsor      bool   `json:"isOriginalCosponsor,omitempty"`
+}
+
+// Committee is a committee the measure was referred to or reported by.
+type Committee struct {
+	Name       string     `json:"name"`
+	SystemCode string     `json:"systemCode"`
+	Chamber    string     `json:"chamber"`
+	Activities []Activity `json:"activities"`
+}
+
+// Activity is something a committee did with the measure, such as
+// "Referred To" or "Reported By".
+type Activity struct {
+	Name string `json:"name"`
+	Date string `json:"date"`
+}
+
+// Action is an entry in the measure's legislative history.
+type Action struct {
+	ActionDate string `json:"actionDate"`
+	Text       string `json:"text"`
+	Type       string `json:"type"`
+}
+
+// MeasureData is what congress.gov records for a measure.
+type MeasureData struct {
+	Measure    Measure
+	Sponsors   []Member
+	Cosponsors []Member
+	Committees []Committee
+	Actions    []Action
+}
+
+// Fetch retrieves the sponsors, cosponsors, committees, and actions of m,
+// following pagination.
+func (c *Client) Fetch(ctx context.Context, m Measure) (*MeasureData, error) {
+	data := &MeasureData{Measure: m}
+
+	var bill struct {
+		Bill struct {
+			Sponsors []Member `json:"sponsors"`
+		} `json:"bill"`
+	}
+	if err := c.get(ctx, c.url("bill/"+m.String()), &bill); err != nil {
+		return nil, err
+	}
+	data.Sponsors = bill.Bill.Sponsors
+
+	var page struct {
+		Cosponsors []Member    `json:"cosponsors"`
+		Committees []Committee `json:"committees"`
+		Actions    []Action    `json:"actions"`
+		Pagination struct {
+			Next string `json:"next"`
+		} `json:"pagination"`
+	}
+	for _, list := range []string{"cosponsors", "committees", "actions"} {
+		next := c.url("bill/" + m.String() + "/" + list)
+		for next != "" {
+			page.Pagination.Next = ""
+			page.Cosponsors, page.Committees, page.Actions = nil, nil, nil
+			if err := c.get(ctx, next, &page); err != nil {
+				return nil, err
+			}
+			data.Cosponsors = append(data.Cosponsors, page.Cosponsors...)
+			data.Committees = append(data.Committees, page.Committees...)
+			data.Actions = append(data.Actions, page.Actions...)
+			next = page.Pagination.Next
+		}
+	}
+	return data, nil
+}
+
+// Reconcile fetches the congress.gov data for the measure doc is a version
+// of and compares the two with Compare.
+func (c *Client) Reconcile(ctx context.Context, doc uslm.LegislativeDocument) (*Report, error) {
+	m, err := MeasureOf(doc)
+	if err != nil {
+		return nil, err
+	}
+	data, err := c.Fetch(ctx, m)
+	if err != nil {
+		return nil, err
+	}
+	return Compare(doc, data), nil
+}
+
+// url returns the URL of an API path, asking for JSON and the largest page.
+func (c *Client) url(path string) string {
+	base := c.BaseURL
+	if base == "" {
+		base = DefaultBaseURL
+	}
+	return strings.TrimRight(base, "/") + "/" + path + "?format=json&limit=250"
+}
+
+// get fetches u and decodes the JSON response into v.
+func (c *Client) get(ctx context.Context, u string, v interface{}) error {
+	parsed, err := url.Parse(u)
+	if err != nil {
+		return fmt.Errorf("invalid URL %s: %w", u, err)
+	}
+	// Pagination links omit the format.
+	q := parsed.Query()
+	q.Set("format", "json")
+	parsed.RawQuery = q.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, parsed.String(), nil)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Accept", "application/json")
+	if c.APIKey != "" {
+		req.Header.Set("X-Api-Key", c.APIKey)
+	}
+
+	client := c.HTTPClient
+	if client == nil {
+		client = http.DefaultClient
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to fetch %s: %w", parsed.Path, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("failed to fetch %s: %s", parsed.Path, resp.Status)
+	}
+	if err := json.NewDecoder(resp.Body).Decode(v); err != nil {
+		return fmt.Errorf("failed to decode %s: %w", parsed.Path, err)
+	}
+	return nil
+}
@@ -0,0 +1,120 @@
+package congressgov
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// fakeAPI serves data as congress.gov would, two cosponsors a page, and
+// records the paths and queries requested. Requests need the API key "KEY".
+func fakeAPI(t *testing.T, data *MeasureData) (*httptest.Server, *[]string) {
+	t.Helper()
+	var requests []string
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.URL.RequestURI())
+		if r.Header.Get("X-Api-Key") != "KEY" || r.URL.Query().Get("format") != "json" {
+			http.Error(w, "forbidden", http.StatusForbidden)
+			return
+		}
+		base := "/v3/bill/" + data.Measure.String()
+		var body interface{}
+		switch r.URL.Path {
+		case base:
+			body = map[string]interface{}{"bill": map[string]interface{}{"sponsors": data.Sponsors}}
+		case base + "/cosponsors":
+			offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+			end := min(offset+2, len(data.Cosponsors))
+			page := map[string]interface{}{"cosponsors": data.Cosponsors[offset:end]}
+			if end < len(data.Cosponsors) {
+				// Pagination links give no format, as congress.gov's don't.
+				page["pagination"] = map[string]string{"next": server.URL + base + "/cosponsors?offset=" + strconv.Itoa(end) + "&limit=250"}
+			}
+			body = page
+		case base + "/committees":
+			body = map[string]interface{}{"committees": data.Committees}
+		case base + "/actions":
+			body = map[string]interface{}{"actions": data.Actions}
+		default:
+			http.NotFound(w, r)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(body)
+	}))
+	t.Cleanup(server.Close)
+	return server, &requests
+}
+
+func TestFetch(t *testing.T) {
+	want := sampleData()
+	server, requests := fakeAPI(t, want)
+	c := &Client{APIKey: "KEY", BaseURL: server.URL + "/v3/"}
+
+	got, err := c.Fetch(context.Background(), want.Measure)
+	if err != nil {
+		t.Fatalf("failed to fetch: %v", err)
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Fetch =\n%+v\nwant\n%+v", got, want)
+	}
+	wantRequests := []string{
+		"/v3/bill/114/s/32?format=json&limit=250",
+		"/v3/bill/114/s/32/cosponsors?format=json&limit=250",
+		"/v3/bill/114/s/32/cosponsors?format=json&limit=250&offset=2",
+		"/v3/bill/114/s/32/cosponsors?format=json&limit=250&offset=4",
+		"/v3/bill/114/s/32/committees?format=json&limit=250",
+		"/v3/bill/114/s/32/actions?format=json&limit=250",
+	}
+	if !reflect.DeepEqual(*requests, wantRequests) {
+		t.Errorf("requested\n%s\nwant\n%s", strings.Join(*requests, "\n"), strings.Join(wantRequests, "\n"))
+	}
+}
+
+func TestReconcile(t *testing.T) {
+	data := sampleData()
+	data.Cosponsors = data.Cosponsors[1:]
+	server, _ := fakeAPI(t, data)
+	c := &Client{APIKey: "KEY", BaseURL: server.URL + "/v3"}
+
+	r, err := c.Reconcile(context.Background(), readSample(t))
+	if err != nil {
+		t.Fatalf("failed to reconcile: %v", err)
+	}
+	if r.Measure != data.Measure || r.AsOf != "2015-01-13" {
+		t.Errorf("measure %s as of %s", r.Measure, r.AsOf)
+	}
+	want := []Discrepancy{{DiscrepancyUnexpected, "cosponsor", "senate:S326", "Mr. Udall is not a cosponsor on congress.gov"}}
+	if !reflect.DeepEqual(r.Discrepancies, want) {
+		t.Errorf("discrepancies %v, want %v", r.Discrepancies, want)
+	}
+}
+
+func TestFetchErrors(t *testing.T) {
+	server, _ := fakeAPI(t, sampleData())
+	ctx := context.Background()
+
+	c := &Client{APIKey: "WRONG", BaseURL: server.URL + "/v3"}
+	if _, err := c.Fetch(ctx, Measure{114, "s", 32}); err == nil || err.Error() != "failed to fetch /v3/bill/114/s/32: 403 Forbidden" {
+		t.Errorf("expected a status error, got %v", err)
+	}
+	c.APIKey = "KEY"
+	if _, err := c.Fetch(ctx, Measure{114, "s", 33}); err == nil || !strings.Contains(err.Error(), "404 Not Found") {
+		t.Errorf("expected a not-found error, got %v", err)
+	}
+
+	garbage := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("<html>"))
+	}))
+	defer garbage.Close()
+	c.BaseURL = garbage.URL
+	if _, err := c.Fetch(ctx, Measure{114, "s", 32}); err == nil || !strings.HasPrefix(err.Error(), "failed to decode /bill/114/s/32") {
+		t.Errorf("expected a decode error, got %v", err)
+	}
+}
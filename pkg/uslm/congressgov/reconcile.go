@@ -0,0 +1,278 @@
+package congressgov
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/usgpo/uslm/pkg/uslm"
+)
+
+// DiscrepancyKind classifies a Discrepancy.
+type DiscrepancyKind string
+
+const (
+	// DiscrepancyMissing marks an entry congress.gov has, dated on or
+	// before the document version, that the document lacks.
+	DiscrepancyMissing DiscrepancyKind = "missing"
+
+	// DiscrepancyUnexpected marks an entry in the document that
+	// congress.gov does not have.
+	DiscrepancyUnexpected DiscrepancyKind = "unexpected"
+
+	// DiscrepancyMismatch marks an entry both have with differing details,
+	// such as the date a cosponsor joined.
+	DiscrepancyMismatch DiscrepancyKind = "mismatch"
+)
+
+// Discrepancy is one difference between a document and congress.gov.
+type Discrepancy struct {
+	Kind DiscrepancyKind `json:"kind"`
+
+	// Field is "sponsor", "cosponsor", "committee", or "action".
+	Field string `json:"field"`
+
+	// Key identifies the entry: a member or committee ID, a member's name
+	// when the document gives no ID, or an action date.
+	Key     string `json:"key"`
+	Message string `json:"message"`
+}
+
+func (d Discrepancy) String() string {
+	return fmt.Sprintf("%s %s: %s", d.Kind, d.Field, d.Message)
+}
+
+// Report lists the discrepancies between a document and congress.gov.
+type Report struct {
+	Measure Measure `json:"measure"`
+
+	// AsOf is the date of the document's latest action. Cosponsors and
+	// committees congress.gov records after it are not expected in the
+	// document. It is empty when the document has no dated actions, in
+	// which case only the entries in the document are checked.
+	AsOf string `json:"asOf,omitempty"`
+
+	Discrepancies []Discrepancy `json:"discrepancies"`
+}
+
+// OK reports whether no discrepancies were found.
+func (r *Report) OK() bool {
+	return len(r.Discrepancies) == 0
+}
+
+// Compare reports where the sponsors, cosponsors, committees, and actions
+// of doc disagree with data. A document version is a snapshot, so entries
+// congress.gov dates after the document's latest action are not expected
+// in it. Sponsors and cosponsors are compared only when the document names a
+// sponsor, as versions without a preface (such as enrolled bills) name none.
+//
+// Members are matched by Bioguide ID when the document gives one and
+// otherwise by last name; committees by system code, with the House codes
+// USLM writes without the "s" ("HWM00") matched to congress.gov's
+// ("hswm00").
+func Compare(doc uslm.LegislativeDocument, data *MeasureData) *Report {
+	r := &Report{Measure: data.Measure, Discrepancies: []Discrepancy{}}
+	var actions []uslm.Action
+	if a, ok := doc.(uslm.ActionDocument); ok {
+		actions = a.GetActions()
+	}
+	for _, a := range actions {
+		if date := a.Date.ISODate(); date > r.AsOf {
+			r.AsOf = date
+		}
+	}
+
+	if sponsored, ok := doc.(uslm.SponsoredDocument); ok && len(sponsored.GetSponsors()) > 0 {
+		r.compareSponsors(sponsored.GetSponsors(), data.Sponsors)
+		r.compareCosponsors(sponsored.GetCosponsorships(), data.Cosponsors)
+	}
+	if committees, ok := doc.(uslm.CommitteeDocument); ok && len(actions) > 0 {
+		r.compareCommittees(committees.GetCommittees(), data.Committees)
+	}
+	r.compareActions(actions, data.Actions)
+	return r
+}
+
+func (r *Report) add(kind DiscrepancyKind, field, key, format string, args ...interface{}) {
+	r.Discrepancies = append(r.Discrepancies, Discrepancy{
+		Kind:    kind,
+		Field:   field,
+		Key:     key,
+		Message: fmt.Sprintf(format, args...),
+	})
+}
+
+func (r *Report) compareSponsors(sponsors []uslm.Sponsor, members []Member) {
+	used := make([]bool, len(members))
+	for _, s := range sponsors {
+		name := memberName(s.Text, s.Inline)
+		key := memberKey(s.GetMemberID(), name)
+		if i := findMember(s.GetMemberID(), name, members, used); i >= 0 {
+			used[i] = true
+			continue
+		}
+		r.add(DiscrepancyUnexpected, "sponsor", key, "%s is not a sponsor on congress.gov", name)
+	}
+	for i, m := range members {
+		if !used[i] {
+			r.add(DiscrepancyMissing, "sponsor", m.BioguideID, "%s is not named as sponsor", m.FullName)
+		}
+	}
+}
+
+func (r *Report) compareCosponsors(cosponsorships []uslm.Cosponsorship, members []Member) {
+	used := make([]bool, len(members))
+	for _, c := range cosponsorships {
+		id, name := c.Cosponsor.GetMemberID(), memberName(c.Cosponsor.Text, c.Cosponsor.Inline)
+		key := memberKey(id, name)
+		i := findMember(id, name, members, used)
+		if i < 0 {
+			r.add(DiscrepancyUnexpected, "cosponsor", key, "%s is not a cosponsor on congress.gov", name)
+			continue
+		}
+		used[i] = true
+		if joined := day(members[i].SponsorshipDate); c.Joined != "" && joined != "" && c.Joined != joined {
+			r.add(DiscrepancyMismatch, "cosponsor", key, "%s joined on %s, but congress.gov records %s", name, c.Joined, joined)
+		}
+	}
+	if r.AsOf == "" {
+		return
+	}
+	for i, m := range members {
+		withdrawn := day(m.SponsorshipWithdrawnDate)
+		if used[i] || day(m.SponsorshipDate) > r.AsOf || withdrawn != "" && withdrawn <= r.AsOf {
+			continue
+		}
+		r.add(DiscrepancyMissing, "cosponsor", m.BioguideID, "%s cosponsored on %s but is not listed", m.FullName, day(m.SponsorshipDate))
+	}
+}
+
+func (r *Report) compareCommittees(committees []uslm.Committee, records []Committee) {
+	codes := make(map[string]bool)
+	for _, c := range records {
+		codes[committeeCode(c.SystemCode)] = true
+	}
+	seen := make(map[string]bool)
+	for _, c := range committees {
+		code := committeeCode(c.CommitteeID)
+		if code == "" || seen[code] {
+			continue
+		}
+		seen[code] = true
+		if !codes[code] {
+			r.add(DiscrepancyUnexpected, "committee", c.CommitteeID, "%s (%s) has no activity on congress.gov", strings.TrimSpace(c.Text), c.CommitteeID)
+		}
+	}
+	if r.AsOf == "" {
+		return
+	}
+	for _, c := range records {
+		code := committeeCode(c.SystemCode)
+		if seen[code] {
+			continue
+		}
+		for _, a := range c.Activities {
+			if date := day(a.Date); date != "" && date <= r.AsOf {
+				r.add(DiscrepancyMissing, "committee", c.SystemCode, "%s (%s) on %s is not named", c.Name, a.Name, date)
+				seen[code] = true
+				break
+			}
+		}
+	}
+}
+
+func (r *Report) compareActions(actions []uslm.Action, records []Action) {
+	dates := make(map[string]bool)
+	for _, a := range records {
+		dates[day(a.ActionDate)] = true
+	}
+	reported := make(map[string]bool)
+	for _, a := range actions {
+		date := a.Date.ISODate()
+		if date == "" || dates[date] || reported[date] {
+			continue
+		}
+		reported[date] = true
+		r.add(DiscrepancyUnexpected, "action", date, "no congress.gov action is dated %s", date)
+	}
+}
+
+// findMember returns the index of the first unused member matching the
+// document's member, or -1.
+func findMember(id uslm.MemberID, name string, members []Member, used []bool) int {
+	for i, m := range members {
+		if used[i] {
+			continue
+		}
+		if id.Scheme == uslm.MemberIDBioGuide {
+			if strings.EqualFold(id.Value, m.BioguideID) {
+				return i
+			}
+			continue
+		}
+		if m.LastName != "" && containsWord(strings.ToLower(name), strings.ToLower(m.LastName)) {
+			return i
+		}
+	}
+	return -1
+}
+
+// memberName returns a member's name as the document prints it. The
+// surname is usually a small-caps inline between the title and any state,
+// as in "Mr. <inline>Smith</inline> of Washington", so it is put back after
+// the first word of the text.
+func memberName(text string, inline []uslm.Inline) string {
+	words := strings.Fields(text)
+	var parts []string
+	if len(words) > 0 {
+		parts, words = append(parts, words[0]), words[1:]
+	}
+	for _, in := range inline {
+		parts = append(parts, strings.Fields(in.Text)...)
+	}
+	return strings.Join(append(parts, words...), " ")
+}
+
+// memberKey returns the key of a document member: its ID, or its name when
+// it has none.
+func memberKey(id uslm.MemberID, name string) string {
+	if id.IsZero() {
+		return name
+	}
+	return id.String()
+}
+
+// containsWord reports whether word appears in s bounded by non-letters.
+func containsWord(s, word string) bool {
+	for i := 0; ; {
+		j := strings.Index(s[i:], word)
+		if j < 0 {
+			return false
+		}
+		start, end := i+j, i+j+len(word)
+		if (start == 0 || !isLetter(s[start-1])) && (end == len(s) || !isLetter(s[end])) {
+			return true
+		}
+		i = start + 1
+	}
+}
+
+func isLetter(b byte) bool {
+	return b >= 'a' && b <= 'z' || b >= 'A' && b <= 'Z'
+}
+
+// committeeCode normalizes a committee ID to congress.gov's system code.
+func committeeCode(id string) string {
+	code := strings.ToLower(strings.TrimSpace(id))
+	if strings.HasPrefix(code, "h") && !strings.HasPrefix(code, "hs") {
+		code = "hs" + code[1:]
+	}
+	return code
+}
+
+// day returns the date part of an ISO 8601 date or timestamp.
+func day(s string) string {
+	if len(s) > 10 {
+		return s[:10]
+	}
+	return s
+}
@@ -0,0 +1,210 @@
+package congressgov
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/usgpo/uslm/pkg/uslm"
+)
+
+// readSample parses BILLS-114s32cds, a Senate bill introduced on January 6,
+// 2015 with five cosponsors and discharged from Finance to the Judiciary
+// committee on January 13.
+func readSample(t *testing.T) uslm.LegislativeDocument {
+	t.Helper()
+	data, err := os.ReadFile(filepath.Join("..", "..", "..", "bill-version-samples-september-2024", "BILLS-114s32cds.xml"))
+	if err != nil {
+		t.Fatalf("failed to read sample: %v", err)
+	}
+	doc, err := uslm.ParseDocument(data)
+	if err != nil {
+		t.Fatalf("failed to parse sample: %v", err)
+	}
+	return doc
+}
+
+// sampleData returns what congress.gov records for the sample, agreeing
+// with it in every respect.
+func sampleData() *MeasureData {
+	cosponsor := func(id, last, date string) Member {
+		return Member{BioguideID: id, LastName: last, FullName: "Sen. " + last, SponsorshipDate: date, IsOriginalCosponsor: true}
+	}
+	return &MeasureData{
+		Measure:  Measure{Congress: 114, Type: "s", Number: 32},
+		Sponsors: []Member{{BioguideID: "F000062", FirstName: "Dianne", LastName: "Feinstein", FullName: "Sen. Feinstein, Dianne [D-CA]"}},
+		Cosponsors: []Member{
+			cosponsor("U000039", "Udall", "2015-01-06"),
+			cosponsor("B001277", "Blumenthal", "2015-01-06"),
+			cosponsor("K000367", "Klobuchar", "2015-01-06"),
+			cosponsor("G000386", "Grassley", "2015-01-06"),
+			cosponsor("H001069", "Heitkamp", "2015-01-06T00:00:00Z"),
+		},
+		Committees: []Committee{
+			{Name: "Finance Committee", SystemCode: "ssfi00", Activities: []Activity{{Name: "Referred To", Date: "2015-01-06T19:21:34Z"}, {Name: "Discharged From", Date: "2015-01-13T19:30:00Z"}}},
+			{Name: "Judiciary Committee", SystemCode: "ssju00", Activities: []Activity{{Name: "Referred To", Date: "2015-01-13T19:30:00Z"}}},
+		},
+		Actions: []Action{
+			{ActionDate: "2015-01-06", Text: "Read twice and referred to the Committee on Finance."},
+			{ActionDate: "2015-01-13", Text: "Senate Committee on Finance discharged by Unanimous Consent."},
+			{ActionDate: "2015-01-13", Text: "Referred to the Committee on the Judiciary."},
+		},
+	}
+}
+
+func TestMeasureOf(t *testing.T) {
+	m, err := MeasureOf(readSample(t))
+	if err != nil {
+		t.Fatalf("failed to get measure: %v", err)
+	}
+	if m != (Measure{Congress: 114, Type: "s", Number: 32}) || m.String() != "114/s/32" {
+		t.Errorf("MeasureOf = %+v (%s)", m, m)
+	}
+
+	doc, err := uslm.ParseDocument([]byte(`<bill xmlns="http://schemas.gpo.gov/xml/uslm"><main/></bill>`))
+	if err != nil {
+		t.Fatalf("failed to parse bill: %v", err)
+	}
+	if _, err := MeasureOf(doc); err == nil {
+		t.Error("expected an error for a document without a citation")
+	}
+}
+
+func TestCompareAgreeing(t *testing.T) {
+	r := Compare(readSample(t), sampleData())
+	if !r.OK() {
+		t.Errorf("expected no discrepancies, got %v", r.Discrepancies)
+	}
+	if r.AsOf != "2015-01-13" || r.Measure.String() != "114/s/32" {
+		t.Errorf("AsOf %q, measure %s", r.AsOf, r.Measure)
+	}
+}
+
+func TestCompareDiscrepancies(t *testing.T) {
+	for _, tc := range []struct {
+		name   string
+		modify func(*MeasureData)
+		want   []Discrepancy
+	}{
+		{
+			name: "different sponsor",
+			modify: func(d *MeasureData) {
+				d.Sponsors[0] = Member{BioguideID: "B000944", LastName: "Brown", FullName: "Sen. Brown, Sherrod [D-OH]"}
+			},
+			want: []Discrepancy{
+				{DiscrepancyUnexpected, "sponsor", "senate:S221", "Mrs. Feinstein is not a sponsor on congress.gov"},
+				{DiscrepancyMissing, "sponsor", "B000944", "Sen. Brown, Sherrod [D-OH] is not named as sponsor"},
+			},
+		},
+		{
+			name: "cosponsor joined later",
+			modify: func(d *MeasureData) {
+				d.Cosponsors[0].SponsorshipDate = "2015-01-07"
+			},
+			want: []Discrepancy{
+				{DiscrepancyMismatch, "cosponsor", "senate:S326", "Mr. Udall joined on 2015-01-06, but congress.gov records 2015-01-07"},
+			},
+		},
+		{
+			name: "cosponsors not in the document",
+			modify: func(d *MeasureData) {
+				d.Cosponsors = append(d.Cosponsors,
+					Member{BioguideID: "C001035", LastName: "Collins", FullName: "Sen. Collins, Susan M. [R-ME]", SponsorshipDate: "2015-01-08"},
+					Member{BioguideID: "K000383", LastName: "King", FullName: "Sen. King, Angus S., Jr. [I-ME]", SponsorshipDate: "2015-02-02"},
+					Member{BioguideID: "W000437", LastName: "Wicker", FullName: "Sen. Wicker, Roger F. [R-MS]", SponsorshipDate: "2015-01-07", SponsorshipWithdrawnDate: "2015-01-09"},
+				)
+			},
+			want: []Discrepancy{
+				{DiscrepancyMissing, "cosponsor", "C001035", "Sen. Collins, Susan M. [R-ME] cosponsored on 2015-01-08 but is not listed"},
+			},
+		},
+		{
+			name: "cosponsor not on congress.gov",
+			modify: func(d *MeasureData) {
+				d.Cosponsors = d.Cosponsors[:4]
+			},
+			want: []Discrepancy{
+				{DiscrepancyUnexpected, "cosponsor", "senate:S360", "Ms. Heitkamp is not a cosponsor on congress.gov"},
+			},
+		},
+		{
+			name: "committees",
+			modify: func(d *MeasureData) {
+				d.Committees[1].SystemCode = "sshr00"
+				d.Committees = append(d.Committees, Committee{Name: "Appropriations Committee", SystemCode: "ssap00", Activities: []Activity{{Name: "Referred To", Date: "2015-03-01"}}})
+			},
+			want: []Discrepancy{
+				{DiscrepancyUnexpected, "committee", "SSJU00", "Committee on the Judiciary (SSJU00) has no activity on congress.gov"},
+				{DiscrepancyMissing, "committee", "sshr00", "Judiciary Committee (Referred To) on 2015-01-13 is not named"},
+			},
+		},
+		{
+			name: "action",
+			modify: func(d *MeasureData) {
+				d.Actions = d.Actions[1:]
+			},
+			want: []Discrepancy{
+				{DiscrepancyUnexpected, "action", "2015-01-06", "no congress.gov action is dated 2015-01-06"},
+			},
+		},
+	} {
+		data := sampleData()
+		tc.modify(data)
+		r := Compare(readSample(t), data)
+		if !reflect.DeepEqual(r.Discrepancies, tc.want) {
+			t.Errorf("%s:\n got %v\nwant %v", tc.name, r.Discrepancies, tc.want)
+		}
+	}
+}
+
+func TestCompareWithoutActions(t *testing.T) {
+	// A Bioguide ID is matched exactly; with no dated actions, congress.gov
+	// entries the document lacks are not reported.
+	doc, err := uslm.ParseDocument([]byte(`<bill xmlns="http://schemas.gpo.gov/xml/uslm"><preface><action><actionDescription><sponsor bioGuideId="B001303">Mr. <inline>Smith</inline> of Washington</sponsor> (for himself and <cosponsor bioGuideId="J000032">Ms. <inline>Jackson Lee</inline></cosponsor>) introduced the following bill</actionDescription></action></preface><main/></bill>`))
+	if err != nil {
+		t.Fatalf("failed to parse bill: %v", err)
+	}
+	data := &MeasureData{
+		Sponsors:   []Member{{BioguideID: "S000510", LastName: "Smith", FullName: "Rep. Smith, Adam [D-WA-9]"}},
+		Cosponsors: []Member{{BioguideID: "J000032", LastName: "Jackson Lee", SponsorshipDate: "2015-01-06"}, {BioguideID: "L000551", LastName: "Lee", SponsorshipDate: "2015-01-06"}},
+		Committees: []Committee{{Name: "Ways and Means Committee", SystemCode: "hswm00", Activities: []Activity{{Name: "Referred To", Date: "2015-01-06"}}}},
+		Actions:    []Action{{ActionDate: "2015-01-06"}},
+	}
+	r := Compare(doc, data)
+	want := []Discrepancy{
+		{DiscrepancyUnexpected, "sponsor", "bioguide:B001303", "Mr. Smith of Washington is not a sponsor on congress.gov"},
+		{DiscrepancyMissing, "sponsor", "S000510", "Rep. Smith, Adam [D-WA-9] is not named as sponsor"},
+	}
+	if r.AsOf != "" || !reflect.DeepEqual(r.Discrepancies, want) {
+		t.Errorf("AsOf %q, discrepancies:\n got %v\nwant %v", r.AsOf, r.Discrepancies, want)
+	}
+}
+
+func TestHelpers(t *testing.T) {
+	for id, want := range map[string]string{"HWM00": "hswm00", "hswm00": "hswm00", " SSFI00 ": "ssfi00", "JSEC00": "jsec00"} {
+		if got := committeeCode(id); got != want {
+			t.Errorf("committeeCode(%q) = %q, want %q", id, got, want)
+		}
+	}
+	for _, tc := range []struct {
+		s, word string
+		want    bool
+	}{
+		{"mr. lee of california", "lee", true},
+		{"ms. jackson lee", "lee", true},
+		{"mr. mclee", "lee", false},
+		{"mr. leeds", "lee", false},
+		{"mr. leeds and mr. lee", "lee", true},
+	} {
+		if got := containsWord(tc.s, tc.word); got != tc.want {
+			t.Errorf("containsWord(%q, %q) = %v", tc.s, tc.word, got)
+		}
+	}
+	if got := memberName("Mr.  of Washington", []uslm.Inline{{Text: "Smith"}}); got != "Mr. Smith of Washington" {
+		t.Errorf("memberName = %q", got)
+	}
+	if got := day("2015-01-06T19:21:34Z"); got != "2015-01-06" {
+		t.Errorf("day = %q", got)
+	}
+}
@@ -6,13 +6,37 @@ import "encoding/xml"
 type Main struct {
 	XMLName   xml.Name   `xml:"main" json:"-"`
 	StyleType string     `xml:"styleType,attr,omitempty" json:"styleType,omitempty"`
+	Changed   ChangeMarker `xml:"changed,attr,omitempty" json:"changed,omitempty"`
+	Origin    string     `xml:"origin,attr,omitempty" json:"origin,omitempty"`
 	LongTitle *LongTitle `xml:"longTitle" json:"longTitle,omitempty"`
 	EnactingFormula *EnactingFormula `xml:"enactingFormula" json:"enactingFormula,omitempty"`
 	TOC       *TOC       `xml:"toc" json:"toc,omitempty"`
 	Preamble  *Preamble  `xml:"preamble" json:"preamble,omitempty"`
 	Sections  []Section  `xml:"section" json:"sections,omitempty"`
 	Titles    []Title    `xml:"title" json:"titles,omitempty"`
+	Collection *Collection `xml:"collection" json:"collection,omitempty"`
 	EndMarker string     `xml:"endMarker,omitempty" json:"endMarker,omitempty"`
+	LeftRunningHead   *RunningHead `xml:"leftRunningHead" json:"leftRunningHead,omitempty"`
+	CenterRunningHead *RunningHead `xml:"centerRunningHead" json:"centerRunningHead,omitempty"`
+	RightRunningHead  *RunningHead `xml:"rightRunningHead" json:"rightRunningHead,omitempty"`
+	Attrs     Attributes `xml:",any,attr" json:"attrs,omitempty"`
+}
+
+// Collection groups the components of a main.
+type Collection struct {
+	XMLName    xml.Name    `xml:"collection" json:"-"`
+	Components []Component `xml:"component" json:"components,omitempty"`
+	Attrs      Attributes  `xml:",any,attr" json:"attrs,omitempty"`
+}
+
+// Component represents a separately versioned body of text within main, such
+// as a committee's amendment in the nature of a substitute.
+type Component struct {
+	XMLName xml.Name     `xml:"component" json:"-"`
+	Origin  string       `xml:"origin,attr,omitempty" json:"origin,omitempty"`
+	Changed ChangeMarker `xml:"changed,attr,omitempty" json:"changed,omitempty"`
+	Main    *Main        `xml:"main" json:"main,omitempty"`
+	Attrs   Attributes   `xml:",any,attr" json:"attrs,omitempty"`
 }
 
 // AmendMain represents the main content section of an amendment document.
@@ -25,6 +49,7 @@ type AmendMain struct {
 	AmendmentInstructions         []AmendmentInstruction `xml:"amendmentInstruction" json:"amendmentInstructions,omitempty"`
 	Signatures                    *Signatures            `xml:"signatures" json:"signatures,omitempty"`
 	Endorsement                   *Endorsement           `xml:"endorsement" json:"endorsement,omitempty"`
+	Attrs                         Attributes             `xml:",any,attr" json:"attrs,omitempty"`
 }
 
 // LongTitle represents the long title section containing doc title and official title.
@@ -32,6 +57,7 @@ type LongTitle struct {
 	XMLName       xml.Name `xml:"longTitle" json:"-"`
 	DocTitle      string   `xml:"docTitle" json:"docTitle,omitempty"`
 	OfficialTitle string   `xml:"officialTitle" json:"officialTitle,omitempty"`
+	Attrs         Attributes `xml:",any,attr" json:"attrs,omitempty"`
 }
 
 // EnactingFormula represents the enacting formula (e.g., "Be it enacted...").
@@ -39,20 +65,66 @@ type EnactingFormula struct {
 	XMLName xml.Name `xml:"enactingFormula" json:"-"`
 	Text    string   `xml:",chardata" json:"text,omitempty"`
 	I       []Italic `xml:"i" json:"i,omitempty"`
+	Attrs   Attributes `xml:",any,attr" json:"attrs,omitempty"`
 }
 
 // TOC represents the table of contents.
 type TOC struct {
 	XMLName       xml.Name        `xml:"toc" json:"-"`
 	ReferenceItem []ReferenceItem `xml:"referenceItem" json:"referenceItems,omitempty"`
+	Attrs         Attributes      `xml:",any,attr" json:"attrs,omitempty"`
+}
+
+// Walk visits every reference item in the table of contents in document order.
+// Top-level items have depth 0; nested items are visited after their parent with
+// depth increased by one. Returning false from fn skips the item's children.
+func (t *TOC) Walk(fn func(item *ReferenceItem, depth int) bool) {
+	for i := range t.ReferenceItem {
+		t.ReferenceItem[i].walk(fn, 0)
+	}
+}
+
+// Flatten returns all reference items in document order, including nested items.
+func (t *TOC) Flatten() []*ReferenceItem {
+	var items []*ReferenceItem
+	t.Walk(func(item *ReferenceItem, depth int) bool {
+		items = append(items, item)
+		return true
+	})
+	return items
+}
+
+// MaxDepth returns the deepest nesting level in the table of contents, or -1 if it is empty.
+func (t *TOC) MaxDepth() int {
+	maxDepth := -1
+	t.Walk(func(item *ReferenceItem, depth int) bool {
+		if depth > maxDepth {
+			maxDepth = depth
+		}
+		return true
+	})
+	return maxDepth
 }
 
 // ReferenceItem represents an item in the table of contents.
+// Reference items nest to mirror the document hierarchy (e.g., title → subtitle → section).
 type ReferenceItem struct {
-	XMLName    xml.Name `xml:"referenceItem" json:"-"`
-	Role       string   `xml:"role,attr,omitempty" json:"role,omitempty"`
-	Designator string   `xml:"designator,omitempty" json:"designator,omitempty"`
-	Label      string   `xml:"label,omitempty" json:"label,omitempty"`
+	XMLName        xml.Name        `xml:"referenceItem" json:"-"`
+	IDRef          string          `xml:"idref,attr,omitempty" json:"idref,omitempty"`
+	Role           string          `xml:"role,attr,omitempty" json:"role,omitempty"`
+	Designator     string          `xml:"designator,omitempty" json:"designator,omitempty"`
+	Label          string          `xml:"label,omitempty" json:"label,omitempty"`
+	ReferenceItems []ReferenceItem `xml:"referenceItem" json:"referenceItems,omitempty"`
+	Attrs          Attributes      `xml:",any,attr" json:"attrs,omitempty"`
+}
+
+func (r *ReferenceItem) walk(fn func(item *ReferenceItem, depth int) bool, depth int) {
+	if !fn(r, depth) {
+		return
+	}
+	for i := range r.ReferenceItems {
+		r.ReferenceItems[i].walk(fn, depth+1)
+	}
 }
 
 // Preamble represents the preamble section (for resolutions with recitals).
@@ -60,14 +132,18 @@ type Preamble struct {
 	XMLName         xml.Name         `xml:"preamble" json:"-"`
 	Recitals        []Recital        `xml:"recital" json:"recitals,omitempty"`
 	ResolvingClause *ResolvingClause `xml:"resolvingClause" json:"resolvingClause,omitempty"`
+	Attrs           Attributes       `xml:",any,attr" json:"attrs,omitempty"`
 }
 
 // Recital represents a "whereas" clause in a resolution preamble.
 type Recital struct {
 	XMLName    xml.Name    `xml:"recital" json:"-"`
+	Changed    ChangeMarker `xml:"changed,attr,omitempty" json:"changed,omitempty"`
 	Text       string      `xml:",chardata" json:"text,omitempty"`
 	P          []P         `xml:"p" json:"p,omitempty"`
 	Paragraphs []Paragraph `xml:"paragraph" json:"paragraphs,omitempty"`
+	Attrs      Attributes  `xml:",any,attr" json:"attrs,omitempty"`
+	text       string      `xml:"-" json:"-"`
 }
 
 // ResolvingClause represents the resolving clause (e.g., "Resolved, ").
@@ -76,6 +152,7 @@ type ResolvingClause struct {
 	Class   string   `xml:"class,attr,omitempty" json:"class,omitempty"`
 	Text    string   `xml:",chardata" json:"text,omitempty"`
 	I       []Italic `xml:"i" json:"i,omitempty"`
+	Attrs   Attributes `xml:",any,attr" json:"attrs,omitempty"`
 }
 
 // Section represents a section of legislative content.
@@ -83,6 +160,7 @@ type Section struct {
 	XMLName       xml.Name       `xml:"section" json:"-"`
 	ID            string         `xml:"id,attr,omitempty" json:"id,omitempty"`
 	Identifier    string         `xml:"identifier,attr,omitempty" json:"identifier,omitempty"`
+	Changed       ChangeMarker   `xml:"changed,attr,omitempty" json:"changed,omitempty"`
 	Role          string         `xml:"role,attr,omitempty" json:"role,omitempty"`
 	Class         string         `xml:"class,attr,omitempty" json:"class,omitempty"`
 	Num           *Num           `xml:"num" json:"num,omitempty"`
@@ -91,6 +169,7 @@ type Section struct {
 	Content       *Content       `xml:"content" json:"content,omitempty"`
 	Paragraphs    []Paragraph    `xml:"paragraph" json:"paragraphs,omitempty"`
 	Subsections   []Subsection   `xml:"subsection" json:"subsections,omitempty"`
+	Attrs         Attributes     `xml:",any,attr" json:"attrs,omitempty"`
 }
 
 // GetID returns the section's unique ID.
@@ -145,11 +224,14 @@ func (s *Section) GetChapeau() string {
 
 // Title represents a title division (in large bills).
 type Title struct {
-	XMLName  xml.Name  `xml:"title" json:"-"`
-	ID       string    `xml:"id,attr,omitempty" json:"id,omitempty"`
-	Num      *Num      `xml:"num" json:"num,omitempty"`
-	Heading  *Heading  `xml:"heading" json:"heading,omitempty"`
-	Sections []Section `xml:"section" json:"sections,omitempty"`
+	XMLName    xml.Name   `xml:"title" json:"-"`
+	ID         string     `xml:"id,attr,omitempty" json:"id,omitempty"`
+	Identifier string     `xml:"identifier,attr,omitempty" json:"identifier,omitempty"`
+	Changed    ChangeMarker `xml:"changed,attr,omitempty" json:"changed,omitempty"`
+	Num        *Num       `xml:"num" json:"num,omitempty"`
+	Heading    *Heading   `xml:"heading" json:"heading,omitempty"`
+	Sections   []Section  `xml:"section" json:"sections,omitempty"`
+	Attrs      Attributes `xml:",any,attr" json:"attrs,omitempty"`
 }
 
 // Subsection represents a subsection (e.g., (a), (b), (c)).
@@ -157,12 +239,14 @@ type Subsection struct {
 	XMLName    xml.Name    `xml:"subsection" json:"-"`
 	ID         string      `xml:"id,attr,omitempty" json:"id,omitempty"`
 	Identifier string      `xml:"identifier,attr,omitempty" json:"identifier,omitempty"`
+	Changed    ChangeMarker `xml:"changed,attr,omitempty" json:"changed,omitempty"`
 	Class      string      `xml:"class,attr,omitempty" json:"class,omitempty"`
 	Num        *Num        `xml:"num" json:"num,omitempty"`
 	Heading    *Heading    `xml:"heading" json:"heading,omitempty"`
 	Chapeau    *Chapeau    `xml:"chapeau" json:"chapeau,omitempty"`
 	Content    *Content    `xml:"content" json:"content,omitempty"`
 	Paragraphs []Paragraph `xml:"paragraph" json:"paragraphs,omitempty"`
+	Attrs      Attributes  `xml:",any,attr" json:"attrs,omitempty"`
 }
 
 // Paragraph represents a paragraph (e.g., (1), (2), (3)).
@@ -170,6 +254,7 @@ type Paragraph struct {
 	XMLName       xml.Name       `xml:"paragraph" json:"-"`
 	ID            string         `xml:"id,attr,omitempty" json:"id,omitempty"`
 	Identifier    string         `xml:"identifier,attr,omitempty" json:"identifier,omitempty"`
+	Changed       ChangeMarker   `xml:"changed,attr,omitempty" json:"changed,omitempty"`
 	Class         string         `xml:"class,attr,omitempty" json:"class,omitempty"`
 	Role          string         `xml:"role,attr,omitempty" json:"role,omitempty"`
 	Num           *Num           `xml:"num" json:"num,omitempty"`
@@ -177,6 +262,7 @@ type Paragraph struct {
 	Chapeau       *Chapeau       `xml:"chapeau" json:"chapeau,omitempty"`
 	Content       *Content       `xml:"content" json:"content,omitempty"`
 	Subparagraphs []Subparagraph `xml:"subparagraph" json:"subparagraphs,omitempty"`
+	Attrs         Attributes     `xml:",any,attr" json:"attrs,omitempty"`
 }
 
 // Subparagraph represents a subparagraph (e.g., (A), (B), (C)).
@@ -184,11 +270,13 @@ type Subparagraph struct {
 	XMLName    xml.Name `xml:"subparagraph" json:"-"`
 	ID         string   `xml:"id,attr,omitempty" json:"id,omitempty"`
 	Identifier string   `xml:"identifier,attr,omitempty" json:"identifier,omitempty"`
+	Changed    ChangeMarker `xml:"changed,attr,omitempty" json:"changed,omitempty"`
 	Class      string   `xml:"class,attr,omitempty" json:"class,omitempty"`
 	Num        *Num     `xml:"num" json:"num,omitempty"`
 	Chapeau    *Chapeau `xml:"chapeau" json:"chapeau,omitempty"`
 	Content    *Content `xml:"content" json:"content,omitempty"`
 	Clauses    []Clause `xml:"clause" json:"clauses,omitempty"`
+	Attrs      Attributes `xml:",any,attr" json:"attrs,omitempty"`
 }
 
 // Clause represents a clause (e.g., (i), (ii), (iii)).
@@ -196,10 +284,12 @@ type Clause struct {
 	XMLName    xml.Name    `xml:"clause" json:"-"`
 	ID         string      `xml:"id,attr,omitempty" json:"id,omitempty"`
 	Identifier string      `xml:"identifier,attr,omitempty" json:"identifier,omitempty"`
+	Changed    ChangeMarker `xml:"changed,attr,omitempty" json:"changed,omitempty"`
 	Class      string      `xml:"class,attr,omitempty" json:"class,omitempty"`
 	Num        *Num        `xml:"num" json:"num,omitempty"`
 	Content    *Content    `xml:"content" json:"content,omitempty"`
 	Subclauses []Subclause `xml:"subclause" json:"subclauses,omitempty"`
+	Attrs      Attributes  `xml:",any,attr" json:"attrs,omitempty"`
 }
 
 // Subclause represents a subclause (e.g., (I), (II), (III)).
@@ -207,9 +297,11 @@ type Subclause struct {
 	XMLName    xml.Name `xml:"subclause" json:"-"`
 	ID         string   `xml:"id,attr,omitempty" json:"id,omitempty"`
 	Identifier string   `xml:"identifier,attr,omitempty" json:"identifier,omitempty"`
+	Changed    ChangeMarker `xml:"changed,attr,omitempty" json:"changed,omitempty"`
 	Class      string   `xml:"class,attr,omitempty" json:"class,omitempty"`
 	Num        *Num     `xml:"num" json:"num,omitempty"`
 	Content    *Content `xml:"content" json:"content,omitempty"`
+	Attrs      Attributes `xml:",any,attr" json:"attrs,omitempty"`
 }
 
 // AmendmentInstruction represents an instruction for how to amend existing law.
@@ -217,12 +309,14 @@ type AmendmentInstruction struct {
 	XMLName xml.Name `xml:"amendmentInstruction" json:"-"`
 	Num     *Num     `xml:"num" json:"num,omitempty"`
 	Content *Content `xml:"content" json:"content,omitempty"`
+	Attrs   Attributes `xml:",any,attr" json:"attrs,omitempty"`
 }
 
 // Signatures represents the signatures block in amendment documents.
 type Signatures struct {
 	XMLName   xml.Name    `xml:"signatures" json:"-"`
 	Signature []Signature `xml:"signature" json:"signatures,omitempty"`
+	Attrs     Attributes  `xml:",any,attr" json:"attrs,omitempty"`
 }
 
 // Signature represents an individual signature.
@@ -231,6 +325,7 @@ type Signature struct {
 	Notation *Notation `xml:"notation" json:"notation,omitempty"`
 	Role     string   `xml:"role,omitempty" json:"role,omitempty"`
 	Text     string   `xml:",chardata" json:"text,omitempty"`
+	Attrs    Attributes `xml:",any,attr" json:"attrs,omitempty"`
 }
 
 // Notation represents a notation within a signature (e.g., "Attest:").
@@ -238,6 +333,7 @@ type Notation struct {
 	XMLName xml.Name `xml:"notation" json:"-"`
 	Type    string   `xml:"type,attr,omitempty" json:"type,omitempty"`
 	Text    string   `xml:",chardata" json:"text,omitempty"`
+	Attrs   Attributes `xml:",any,attr" json:"attrs,omitempty"`
 }
 
 // Endorsement represents the endorsement block at the end of amendment documents.
@@ -249,4 +345,5 @@ type Endorsement struct {
 	DCType      string           `xml:"http://purl.org/dc/elements/1.1/ type" json:"dcType,omitempty"`
 	DocNumber   string           `xml:"docNumber,omitempty" json:"docNumber,omitempty"`
 	DocTitle    string           `xml:"docTitle,omitempty" json:"docTitle,omitempty"`
+	Attrs       Attributes       `xml:",any,attr" json:"attrs,omitempty"`
 }
@@ -0,0 +1,281 @@
+package uslm
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// xsdNamespace is the namespace of XML Schema declarations.
+const xsdNamespace = "http://www.w3.org/2001/XMLSchema"
+
+// CoverageReport lists the elements and attributes declared by a USLM
+// schema and whether the Go model decodes each, so that gaps in the model
+// (tables, notes, levels, and so on) can be tracked as the schema evolves.
+//
+// Coverage is by local name: a declared element counts as covered if any
+// model type decodes a child element of that name, wherever it appears.
+// Attributes kept only in a catch-all Attributes field are not covered.
+type CoverageReport struct {
+	Elements   []CoverageItem `json:"elements"`
+	Attributes []CoverageItem `json:"attributes"`
+
+	// Undeclared lists the element names the model decodes that no schema
+	// declares, such as misspellings or elements from other vocabularies.
+	Undeclared []string `json:"undeclared,omitempty"`
+}
+
+// CoverageItem is one declared element or attribute in a CoverageReport.
+type CoverageItem struct {
+	Name string `json:"name"`
+
+	// Namespace is the target namespace of the schema declaring the name;
+	// it is empty for attributes, which USLM declares unqualified.
+	Namespace string `json:"namespace,omitempty"`
+	Covered   bool   `json:"covered"`
+}
+
+// SchemaCoverage reads the given XML Schema documents, such as
+// uslm-components-2.1.0.xsd and uslm-table-module-2.1.0.xsd, and reports
+// which of the elements and attributes they declare the model covers.
+// Includes and imports are not followed; pass each schema to be measured.
+func SchemaCoverage(schemas ...io.Reader) (*CoverageReport, error) {
+	if len(schemas) == 0 {
+		return nil, errors.New("no schemas given")
+	}
+	elements, attributes := modelVocabulary()
+
+	report := &CoverageReport{}
+	declared := make(map[string]bool)
+	seen := make(map[CoverageItem]bool)
+	for i, r := range schemas {
+		decls, err := schemaDeclarations(r)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read schema %d: %w", i+1, err)
+		}
+		for _, d := range decls {
+			if d.attribute {
+				item := CoverageItem{Name: d.name, Covered: attributes[d.name]}
+				if !seen[item] {
+					seen[item] = true
+					report.Attributes = append(report.Attributes, item)
+				}
+				continue
+			}
+			declared[d.name] = true
+			item := CoverageItem{Name: d.name, Namespace: d.namespace, Covered: elements[d.name]}
+			if !seen[item] {
+				seen[item] = true
+				report.Elements = append(report.Elements, item)
+			}
+		}
+	}
+	for name := range elements {
+		if !declared[name] {
+			report.Undeclared = append(report.Undeclared, name)
+		}
+	}
+
+	sortItems := func(items []CoverageItem) {
+		sort.Slice(items, func(i, j int) bool {
+			if items[i].Namespace != items[j].Namespace {
+				return items[i].Namespace < items[j].Namespace
+			}
+			return items[i].Name < items[j].Name
+		})
+	}
+	sortItems(report.Elements)
+	sortItems(report.Attributes)
+	sort.Strings(report.Undeclared)
+	return report, nil
+}
+
+// ElementCoverage returns the number of declared elements the model covers
+// and the number declared.
+func (r *CoverageReport) ElementCoverage() (covered, total int) {
+	return countCovered(r.Elements), len(r.Elements)
+}
+
+// AttributeCoverage returns the number of declared attributes the model
+// covers and the number declared.
+func (r *CoverageReport) AttributeCoverage() (covered, total int) {
+	return countCovered(r.Attributes), len(r.Attributes)
+}
+
+func countCovered(items []CoverageItem) int {
+	n := 0
+	for _, item := range items {
+		if item.Covered {
+			n++
+		}
+	}
+	return n
+}
+
+// Missing returns the names of the declared elements the model does not
+// cover, in report order.
+func (r *CoverageReport) Missing() []string {
+	var names []string
+	for _, item := range r.Elements {
+		if !item.Covered {
+			names = append(names, item.Name)
+		}
+	}
+	return names
+}
+
+// WriteMarkdown writes the report as Markdown: a summary of the coverage
+// followed by the uncovered elements and attributes and the undeclared
+// elements.
+func (r *CoverageReport) WriteMarkdown(w io.Writer) error {
+	var b strings.Builder
+	ec, et := r.ElementCoverage()
+	ac, at := r.AttributeCoverage()
+	b.WriteString("# USLM schema coverage\n\n")
+	b.WriteString("| | Covered | Declared | Coverage |\n|---|---|---|---|\n")
+	fmt.Fprintf(&b, "| Elements | %d | %d | %s |\n", ec, et, percent(ec, et))
+	fmt.Fprintf(&b, "| Attributes | %d | %d | %s |\n", ac, at, percent(ac, at))
+
+	list := func(heading string, names []string) {
+		if len(names) == 0 {
+			return
+		}
+		fmt.Fprintf(&b, "\n## %s\n\n", heading)
+		for _, name := range names {
+			fmt.Fprintf(&b, "- `%s`\n", name)
+		}
+	}
+	list("Uncovered elements", r.Missing())
+	var attrs []string
+	for _, item := range r.Attributes {
+		if !item.Covered {
+			attrs = append(attrs, item.Name)
+		}
+	}
+	list("Uncovered attributes", attrs)
+	list("Undeclared elements", r.Undeclared)
+
+	if _, err := io.WriteString(w, b.String()); err != nil {
+		return fmt.Errorf("failed to write coverage report: %w", err)
+	}
+	return nil
+}
+
+func percent(n, total int) string {
+	if total == 0 {
+		return "n/a"
+	}
+	return fmt.Sprintf("%.1f%%", 100*float64(n)/float64(total))
+}
+
+// schemaDeclaration is an element or attribute declared by a schema.
+type schemaDeclaration struct {
+	name      string
+	namespace string
+	attribute bool
+}
+
+// schemaDeclarations returns the named element and attribute declarations
+// of the schema read from r, global and local alike, in document order.
+func schemaDeclarations(r io.Reader) ([]schemaDeclaration, error) {
+	d := xml.NewDecoder(r)
+	var decls []schemaDeclaration
+	var target string
+	for {
+		tok, err := d.Token()
+		if err == io.EOF {
+			return decls, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		start, ok := tok.(xml.StartElement)
+		if !ok || start.Name.Space != xsdNamespace {
+			continue
+		}
+		var name string
+		for _, a := range start.Attr {
+			switch {
+			case a.Name.Local == "targetNamespace" && start.Name.Local == "schema":
+				target = a.Value
+			case a.Name.Local == "name" && a.Name.Space == "":
+				name = a.Value
+			}
+		}
+		if name == "" {
+			continue
+		}
+		switch start.Name.Local {
+		case "element":
+			decls = append(decls, schemaDeclaration{name: name, namespace: target})
+		case "attribute":
+			decls = append(decls, schemaDeclaration{name: name, attribute: true})
+		}
+	}
+}
+
+// modelVocabulary returns the local names of the elements and attributes
+// decoded by the model types reachable from the document roots.
+func modelVocabulary() (elements, attributes map[string]bool) {
+	elements = make(map[string]bool)
+	attributes = make(map[string]bool)
+	visited := make(map[reflect.Type]bool)
+	var visit func(t reflect.Type)
+	visit = func(t reflect.Type) {
+		for t.Kind() == reflect.Pointer || t.Kind() == reflect.Slice {
+			t = t.Elem()
+		}
+		if t.Kind() != reflect.Struct || visited[t] {
+			return
+		}
+		visited[t] = true
+		for i := 0; i < t.NumField(); i++ {
+			f := t.Field(i)
+			tag := f.Tag.Get("xml")
+			if tag == "-" || f.Name == "XMLName" {
+				continue
+			}
+			name, flags, _ := strings.Cut(tag, ",")
+			if f.Anonymous && name == "" {
+				visit(f.Type)
+				continue
+			}
+			if !f.IsExported() {
+				continue
+			}
+			space := ""
+			if i := strings.LastIndexByte(name, ' '); i >= 0 {
+				space, name = name[:i], name[i+1:]
+			}
+			switch {
+			case strings.Contains(flags, "attr"):
+				if name != "" && space != "xmlns" {
+					attributes[name] = true
+				}
+				continue
+			case strings.Contains(flags, "chardata"), strings.Contains(flags, "comment"),
+				strings.Contains(flags, "innerxml"), strings.Contains(flags, "any"):
+				continue
+			}
+			if name == "" {
+				name = f.Name
+			}
+			for _, step := range strings.Split(name, ">") {
+				elements[step] = true
+			}
+			visit(f.Type)
+		}
+	}
+	for _, docType := range []DocumentType{DocumentTypeBill, DocumentTypeResolution,
+		DocumentTypeAmendment, DocumentTypeEngrossedAmendment, DocumentTypeGeneric} {
+		if doc := newDocument(docType); doc != nil {
+			elements[string(docType)] = true
+			visit(reflect.TypeOf(doc))
+		}
+	}
+	return elements, attributes
+}
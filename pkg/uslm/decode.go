@@ -0,0 +1,214 @@
+package uslm
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// DecodeDocument parses a document from r without buffering the whole input,
+// choosing the document type from the root element as it is read.
+func DecodeDocument(r io.Reader) (LegislativeDocument, error) {
+	return DecodeDocumentWithLimits(r, Limits{})
+}
+
+// DecodeDocumentWithLimits is like DecodeDocument but stops with an error
+// wrapping ErrLimitExceeded as soon as the input exceeds limits.
+func DecodeDocumentWithLimits(r io.Reader, limits Limits) (LegislativeDocument, error) {
+	return DecodeDocumentWithOptions(r, ParseOptions{Limits: limits})
+}
+
+// decodeDocument decodes a whole document from r, reporting its phases to run
+// and its anomalies to opts.Logger.
+func decodeDocument(r io.Reader, opts ParseOptions, run *parseRun) (LegislativeDocument, error) {
+	opts.lexical = &lexicalForms{}
+	opts.extensions = newExtensionRecorder()
+	d := newDecoder(r, opts, run)
+	start, doc, err := decodeRoot(d)
+	if err != nil {
+		return nil, err
+	}
+	run.phase(PhaseProlog)
+	if err := d.DecodeElement(doc, &start); err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", start.Name.Local, err)
+	}
+	setLexical(doc, opts.lexical)
+	setExtensions(doc, opts.extensions)
+	if opts.Whitespace == WhitespaceCollapse {
+		collapseWhitespace(doc)
+	}
+	if opts.Interner != nil {
+		InternReferences(doc, opts.Interner)
+	}
+	run.phase(PhaseDecode)
+	if opts.Logger != nil {
+		logIssues(opts.Logger, doc)
+	}
+	return doc, nil
+}
+
+// ParseDocumentMeta reads r only as far as the end of the document's <meta>
+// (or <amendMeta>) block and returns a document with just that block set.
+// It is much cheaper than a full parse for cataloging, and when r is a
+// network stream the rest of the body is never read.
+func ParseDocumentMeta(r io.Reader) (LegislativeDocument, error) {
+	run := beginParse("ParseDocumentMeta")
+	doc, err := decodeHead(r, false, run)
+	run.end(err)
+	return doc, err
+}
+
+// ReadHeader reads r only as far as the end of the document's <preface> (or
+// <amendPreface>) and returns a document with just its metadata and preface
+// set. The metadata, sponsor, action, and committee accessors work as on a
+// fully parsed document, without the cost of decoding the body.
+func ReadHeader(r io.Reader) (LegislativeDocument, error) {
+	run := beginParse("ReadHeader")
+	doc, err := decodeHead(r, true, run)
+	run.end(err)
+	return doc, err
+}
+
+// decodeHead decodes the metadata block that opens a document and, when
+// withPreface is set, the preface that follows it. It stops at the first
+// element past them, so the body is never read.
+func decodeHead(r io.Reader, withPreface bool, run *parseRun) (LegislativeDocument, error) {
+	d := newDecoder(r, ParseOptions{}, run)
+	_, doc, err := decodeRoot(d)
+	if err != nil {
+		return nil, err
+	}
+	run.phase(PhaseProlog)
+	if err := decodeHeadElements(d, doc, withPreface); err != nil {
+		return nil, err
+	}
+	run.phase(PhaseDecode)
+	return doc, nil
+}
+
+// decodeHeadElements decodes the root's leading metadata and preface
+// elements into doc.
+func decodeHeadElements(d *xml.Decoder, doc LegislativeDocument, withPreface bool) error {
+	for {
+		tok, err := d.Token()
+		if err != nil {
+			return fmt.Errorf("failed to read metadata: %w", err)
+		}
+		switch t := tok.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "meta":
+				meta := &Meta{}
+				if err := d.DecodeElement(meta, &t); err != nil {
+					return fmt.Errorf("failed to parse meta: %w", err)
+				}
+				setDocumentMeta(doc, meta, nil)
+			case "amendMeta":
+				meta := &AmendMeta{}
+				if err := d.DecodeElement(meta, &t); err != nil {
+					return fmt.Errorf("failed to parse amendMeta: %w", err)
+				}
+				setDocumentMeta(doc, nil, meta)
+			case "preface":
+				if withPreface {
+					preface := &Preface{}
+					if err := d.DecodeElement(preface, &t); err != nil {
+						return fmt.Errorf("failed to parse preface: %w", err)
+					}
+					setDocumentPreface(doc, preface, nil)
+				}
+				return nil
+			case "amendPreface":
+				if withPreface {
+					preface := &AmendPreface{}
+					if err := d.DecodeElement(preface, &t); err != nil {
+						return fmt.Errorf("failed to parse amendPreface: %w", err)
+					}
+					setDocumentPreface(doc, nil, preface)
+				}
+				return nil
+			default:
+				// The body has begun; there is no preface to read.
+				return nil
+			}
+			if !withPreface {
+				// The metadata block is the root's first child; stop here
+				// rather than look further.
+				return nil
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// decodeRoot reads up to the root start element and returns it along with an
+// empty document of the matching type.
+func decodeRoot(d *xml.Decoder) (xml.StartElement, LegislativeDocument, error) {
+	for {
+		tok, err := d.Token()
+		if errors.Is(err, io.EOF) {
+			return xml.StartElement{}, nil, fmt.Errorf("no root element found")
+		}
+		if err != nil {
+			return xml.StartElement{}, nil, fmt.Errorf("failed to read document: %w", err)
+		}
+		start, ok := tok.(xml.StartElement)
+		if !ok {
+			continue
+		}
+		doc := newDocument(DocumentType(start.Name.Local))
+		if doc == nil {
+			return start, nil, fmt.Errorf("unknown document type %q", start.Name.Local)
+		}
+		return start, doc, nil
+	}
+}
+
+// newDocument returns an empty document of the given type, or nil.
+func newDocument(docType DocumentType) LegislativeDocument {
+	switch docType {
+	case DocumentTypeBill:
+		return &Bill{}
+	case DocumentTypeResolution:
+		return &Resolution{}
+	case DocumentTypeEngrossedAmendment:
+		return &EngrossedAmendment{}
+	case DocumentTypeAmendment:
+		return &Amendment{}
+	case DocumentTypeGeneric:
+		return &GenericDocument{}
+	}
+	return nil
+}
+
+// setDocumentMeta stores whichever metadata block fits the document type.
+func setDocumentMeta(doc LegislativeDocument, meta *Meta, amendMeta *AmendMeta) {
+	switch d := doc.(type) {
+	case *Bill:
+		d.Meta = meta
+	case *Resolution:
+		d.Meta = meta
+	case *GenericDocument:
+		d.Meta = meta
+	case *EngrossedAmendment:
+		d.AmendMeta = amendMeta
+	case *Amendment:
+		d.AmendMeta = amendMeta
+	}
+}
+
+// setDocumentPreface stores whichever preface fits the document type.
+func setDocumentPreface(doc LegislativeDocument, preface *Preface, amendPreface *AmendPreface) {
+	switch d := doc.(type) {
+	case *Bill:
+		d.Preface = preface
+	case *Resolution:
+		d.Preface = preface
+	case *EngrossedAmendment:
+		d.AmendPreface = amendPreface
+	case *Amendment:
+		d.AmendPreface = amendPreface
+	}
+}
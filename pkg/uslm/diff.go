@@ -0,0 +1,133 @@
+package uslm
+
+// DiffOptions controls DiffProvisions.
+type DiffOptions struct {
+	// Structure compares only the hierarchy: levels, numbers, and headings.
+	// Edits to the body text are ignored, so a reorganization can be told
+	// apart from substantive edits.
+	Structure bool
+}
+
+// ProvisionChangeKind classifies a ProvisionChange.
+type ProvisionChangeKind string
+
+const (
+	ProvisionAdded    ProvisionChangeKind = "added"
+	ProvisionRemoved  ProvisionChangeKind = "removed"
+	ProvisionModified ProvisionChangeKind = "modified"
+
+	// ProvisionRenumbered is a provision whose number changed but which is
+	// otherwise as it was.
+	ProvisionRenumbered ProvisionChangeKind = "renumbered"
+)
+
+// ProvisionChange is one provision that differs between two versions of a
+// document.
+type ProvisionChange struct {
+	Kind ProvisionChangeKind `json:"kind"`
+
+	// Element is the provision's element name, and Identifier its
+	// identifier, or its path in the hierarchy when it has none (e.g.,
+	// "section:3/subsection:a").
+	Element    string `json:"element"`
+	Identifier string `json:"identifier"`
+
+	// OldText and NewText are the provision's text in each version; with
+	// DiffOptions.Structure, its number and heading. For a renumbering they
+	// are the old and new numbers.
+	OldText string `json:"oldText,omitempty"`
+	NewText string `json:"newText,omitempty"`
+
+	// Old and New are the provision in each version, nil where it is absent.
+	Old *Provision `json:"-"`
+	New *Provision `json:"-"`
+}
+
+// DiffProvisions compares the provisions of two versions of a document.
+// Provisions are matched by identifier, or by their position and number in
+// the hierarchy when they have none, so renumbering a provision without an
+// identifier reads as a removal and an addition. Changes are reported in the
+// order they occur in newDoc, followed by removals in the order they occurred
+// in oldDoc.
+func DiffProvisions(oldDoc, newDoc LegislativeDocument, opts DiffOptions) []ProvisionChange {
+	oldKeys, oldByKey := diffIndex(oldDoc)
+	newKeys, newByKey := diffIndex(newDoc)
+	describe := (*Provision).GetText
+	if opts.Structure {
+		describe = diffStructure
+	}
+
+	changes := []ProvisionChange{}
+	for _, key := range newKeys {
+		n := newByKey[key]
+		o, ok := oldByKey[key]
+		change := ProvisionChange{Element: n.Element, Identifier: key, Old: o, New: n}
+		switch {
+		case !ok:
+			change.Kind, change.NewText = ProvisionAdded, describe(n)
+		case diffBody(o, opts) != diffBody(n, opts):
+			change.Kind, change.OldText, change.NewText = ProvisionModified, describe(o), describe(n)
+		case diffNum(o, opts) != diffNum(n, opts):
+			change.Kind, change.OldText, change.NewText = ProvisionRenumbered, normalizeSpace(o.GetNum()), normalizeSpace(n.GetNum())
+		default:
+			continue
+		}
+		changes = append(changes, change)
+	}
+	for _, key := range oldKeys {
+		if _, ok := newByKey[key]; !ok {
+			o := oldByKey[key]
+			changes = append(changes, ProvisionChange{Kind: ProvisionRemoved, Element: o.Element, Identifier: key, OldText: describe(o), Old: o})
+		}
+	}
+	return changes
+}
+
+// diffIndex returns the keys DiffProvisions matches the provisions of doc by,
+// in document order, and a map from key to provision.
+func diffIndex(doc LegislativeDocument) ([]string, map[string]*Provision) {
+	var keys []string
+	byKey := make(map[string]*Provision)
+	var visit func(p *Provision, path string)
+	visit = func(p *Provision, path string) {
+		key := p.Identifier
+		if key == "" {
+			key = path
+		}
+		if _, dup := byKey[key]; !dup {
+			keys = append(keys, key)
+			byKey[key] = p
+		}
+		for _, c := range p.Children {
+			visit(c, path+"/"+c.Element+":"+c.GetNumValue())
+		}
+	}
+	for _, p := range Provisions(doc) {
+		visit(p, p.Element+":"+p.GetNumValue())
+	}
+	return keys, byKey
+}
+
+// diffNum returns the number DiffProvisions compares, with whitespace
+// collapsed in structure mode.
+func diffNum(p *Provision, opts DiffOptions) string {
+	if opts.Structure {
+		return normalizeSpace(p.GetNum())
+	}
+	return p.GetNum()
+}
+
+// diffBody returns what DiffProvisions compares besides the number: the
+// heading and own text, or in structure mode the heading alone.
+func diffBody(p *Provision, opts DiffOptions) string {
+	if opts.Structure {
+		return normalizeSpace(p.GetHeading())
+	}
+	return p.GetHeading() + " " + p.GetText()
+}
+
+// diffStructure describes a provision in structure mode by its number and
+// heading.
+func diffStructure(p *Provision) string {
+	return normalizeSpace(p.GetNum() + " " + p.GetHeading())
+}
@@ -0,0 +1,330 @@
+package uslm
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// DistCode is a GPO distribution code, printed at the head of a bill or
+// resolution to route its print run. Codes in the I series (and IV) are used
+// while a measure is before the House, and those in the II series (and III)
+// while it is before the Senate; the letter variants distinguish kinds of
+// measure.
+type DistCode string
+
+const (
+	DistCodeI   DistCode = "I"   // bills before the House
+	DistCodeIA  DistCode = "IA"  // joint resolutions before the House
+	DistCodeIB  DistCode = "IB"  // measures reported to the Union Calendar
+	DistCodeIC  DistCode = "IC"  // Senate measures referred in the House
+	DistCodeII  DistCode = "II"  // bills before the Senate
+	DistCodeIIA DistCode = "IIA" // joint resolutions before the Senate
+	DistCodeIIB DistCode = "IIB" // House measures referred in the Senate
+	DistCodeIII DistCode = "III" // simple and concurrent resolutions before the Senate
+	DistCodeIV  DistCode = "IV"  // simple and concurrent resolutions before the House
+)
+
+// distCodeChambers maps each known distribution code to its chamber.
+var distCodeChambers = map[DistCode]string{
+	DistCodeI: "HOUSE", DistCodeIA: "HOUSE", DistCodeIB: "HOUSE", DistCodeIC: "HOUSE", DistCodeIV: "HOUSE",
+	DistCodeII: "SENATE", DistCodeIIA: "SENATE", DistCodeIIB: "SENATE", DistCodeIII: "SENATE",
+}
+
+// Known reports whether c is one of the DistCode constants.
+func (c DistCode) Known() bool {
+	_, ok := distCodeChambers[c]
+	return ok
+}
+
+// Chamber returns the chamber the code is used in ("HOUSE" or "SENATE"), or
+// an empty string for an unknown code.
+func (c DistCode) Chamber() string {
+	return distCodeChambers[c]
+}
+
+// Code returns the distribution code, trimmed and in upper case.
+func (d *DistributionCode) Code() DistCode {
+	if d == nil {
+		return ""
+	}
+	return DistCode(strings.ToUpper(strings.TrimSpace(d.Text)))
+}
+
+// Displayed reports whether the code is printed, which it is unless its
+// display attribute is "no".
+func (d *DistributionCode) Displayed() bool {
+	return d != nil && d.Display != "no"
+}
+
+// slugLinePattern matches a slug line such as "•HR 1 RH" or "† S 1014 ES".
+var slugLinePattern = regexp.MustCompile(`^([•†])?\s*(HR|HRES|HJ|HCON|S|SRES|SJ|SCON)\s+(\d+)\s+([A-Z]+\d*)$`)
+
+// slugLineTypes maps the measure abbreviations of slug lines to the measure
+// types of compact citations.
+var slugLineTypes = map[string]string{
+	"HR": "hr", "HRES": "hres", "HJ": "hjres", "HCON": "hconres",
+	"S": "s", "SRES": "sres", "SJ": "sjres", "SCON": "sconres",
+}
+
+// SlugLine is the parsed form of the slug line printed at the top of each
+// page, e.g. "•HR 1 RH": a print marker, the measure abbreviation and
+// number, and the version code.
+type SlugLine struct {
+	// Marker is the leading print marker ("•" or "†"), if any.
+	Marker string `json:"marker,omitempty"`
+
+	// Type is the measure abbreviation: HR, HRES, HJ, HCON, S, SRES, SJ, or
+	// SCON.
+	Type string `json:"type"`
+
+	Number  string `json:"number"`
+	Version string `json:"version"`
+}
+
+// ParseSlugLine parses a slug line such as "•HR 1 RH" or "SCON 13 ATS".
+func ParseSlugLine(s string) (SlugLine, error) {
+	m := slugLinePattern.FindStringSubmatch(normalizeSpace(s))
+	if m == nil {
+		return SlugLine{}, fmt.Errorf("malformed slug line %q", s)
+	}
+	return SlugLine{Marker: m[1], Type: m[2], Number: m[3], Version: m[4]}, nil
+}
+
+// String returns the slug line as printed, e.g. "•HR 1 RH".
+func (s SlugLine) String() string {
+	return s.Marker + s.Type + " " + s.Number + " " + s.Version
+}
+
+// MeasureType returns the measure type in the form used by compact
+// citations, e.g. "hjres" for HJ.
+func (s SlugLine) MeasureType() string {
+	return slugLineTypes[s.Type]
+}
+
+// Chamber returns the chamber the measure originated in ("HOUSE" or
+// "SENATE").
+func (s SlugLine) Chamber() string {
+	if strings.HasPrefix(s.Type, "H") {
+		return "HOUSE"
+	}
+	return "SENATE"
+}
+
+// calendarHrefPattern matches calendar references such as
+// "/us/116/hcal/Union/5" and "/us/116/scal/9".
+var calendarHrefPattern = regexp.MustCompile(`^/us/(\d+)/(h|s)cal/(?:([A-Za-z]+)/)?(\d+)$`)
+
+// Calendar identifies the calendar a reported measure was placed on.
+type Calendar struct {
+	// Chamber is "HOUSE" or "SENATE".
+	Chamber string `json:"chamber"`
+
+	// Name is the House calendar, "Union" or "House"; it is empty for the
+	// Senate's single legislative calendar.
+	Name string `json:"name,omitempty"`
+
+	Number string `json:"number"`
+	Href   string `json:"href"`
+}
+
+// Calendar returns the calendar recorded in the preface, or nil if the
+// measure has not been placed on one.
+func (p *Preface) Calendar() *Calendar {
+	if p == nil {
+		return nil
+	}
+	return calendarOf(flattenRelated(p.RelatedDocuments, p.RelatedDocumentGroups))
+}
+
+// Reports returns the committee reports recorded in the preface, in order.
+func (p *Preface) Reports() []CommitteeReport {
+	if p == nil {
+		return nil
+	}
+	return reportsOf(flattenRelated(p.RelatedDocuments, p.RelatedDocumentGroups))
+}
+
+// calendarOf returns the first calendar among related documents.
+func calendarOf(related []RelatedDocument) *Calendar {
+	for _, rd := range related {
+		if rd.Role != "calendar" {
+			continue
+		}
+		m := calendarHrefPattern.FindStringSubmatch(strings.TrimSpace(rd.Href))
+		if m == nil {
+			continue
+		}
+		chamber := "SENATE"
+		if m[2] == "h" {
+			chamber = "HOUSE"
+		}
+		return &Calendar{Chamber: chamber, Name: m[3], Number: m[4], Href: rd.Href}
+	}
+	return nil
+}
+
+// reportHrefPattern matches committee report references such as
+// "/us/hrpt/116/15/pt1" and "/us/srpt/110/238".
+var reportHrefPattern = regexp.MustCompile(`^/us/(h|s)rpt/(\d+)/(\d+)(?:/pt(\d+))?$`)
+
+// CommitteeReport identifies a committee report accompanying a reported
+// measure.
+type CommitteeReport struct {
+	// Chamber is "HOUSE" or "SENATE".
+	Chamber  string `json:"chamber"`
+	Congress string `json:"congress"`
+	Number   string `json:"number"`
+
+	// Part is the part number of a report issued in parts, or empty.
+	Part string `json:"part,omitempty"`
+
+	Href string `json:"href"`
+
+	// Value is the GPO package identifier, e.g. "CRPT-116hrpt15-pt1".
+	Value string `json:"value,omitempty"`
+}
+
+// Citation returns the report's citation, e.g. "H. Rept. 116-15, Part 1"
+// or "S. Rept. 110-238".
+func (r CommitteeReport) Citation() string {
+	prefix := "S. Rept."
+	if r.Chamber == "HOUSE" {
+		prefix = "H. Rept."
+	}
+	citation := prefix + " " + r.Congress + "-" + r.Number
+	if r.Part != "" {
+		citation += ", Part " + r.Part
+	}
+	return citation
+}
+
+// reportsOf returns the committee reports among related documents, each
+// listed once.
+func reportsOf(related []RelatedDocument) []CommitteeReport {
+	var reports []CommitteeReport
+	seen := map[string]bool{}
+	for _, rd := range related {
+		if rd.Role != "report" {
+			continue
+		}
+		href := strings.TrimSpace(rd.Href)
+		m := reportHrefPattern.FindStringSubmatch(href)
+		if m == nil || seen[href] {
+			continue
+		}
+		seen[href] = true
+		chamber := "SENATE"
+		if m[1] == "h" {
+			chamber = "HOUSE"
+		}
+		reports = append(reports, CommitteeReport{Chamber: chamber, Congress: m[2], Number: m[3], Part: m[4], Href: href, Value: rd.Value})
+	}
+	return reports
+}
+
+// reportedRelated returns the related documents of a bill or resolution's
+// preface, or of its metadata when the preface records none.
+func reportedRelated(preface *Preface, meta *Meta) []RelatedDocument {
+	if preface != nil {
+		if related := flattenRelated(preface.RelatedDocuments, preface.RelatedDocumentGroups); len(related) > 0 {
+			return related
+		}
+	}
+	if meta != nil {
+		return flattenRelated(meta.RelatedDocuments, meta.RelatedDocumentGroups)
+	}
+	return nil
+}
+
+// flattenRelated returns related documents followed by the members of
+// groups.
+func flattenRelated(related []RelatedDocument, groups []RelatedDocuments) []RelatedDocument {
+	if len(groups) == 0 {
+		return related
+	}
+	all := append([]RelatedDocument(nil), related...)
+	for _, g := range groups {
+		all = append(all, g.RelatedDocuments...)
+	}
+	return all
+}
+
+// documentSlugLine returns the slug line in doc's preface.
+func documentSlugLine(doc LegislativeDocument) string {
+	switch d := doc.(type) {
+	case *Bill:
+		if d.Preface != nil {
+			return d.Preface.SlugLine
+		}
+	case *Resolution:
+		if d.Preface != nil {
+			return d.Preface.SlugLine
+		}
+	case *EngrossedAmendment:
+		if d.AmendPreface != nil {
+			return d.AmendPreface.SlugLine
+		}
+	case *Amendment:
+		if d.AmendPreface != nil {
+			return d.AmendPreface.SlugLine
+		}
+	}
+	return ""
+}
+
+// CheckPreface reports unknown distribution codes and malformed slug lines,
+// and checks that they agree with the rest of the document: the slug line
+// with the measure and version of the compact citation, and the chambers of
+// the distribution code and calendar with the current chamber.
+func CheckPreface(doc LegislativeDocument) []Issue {
+	var issues []Issue
+	inconsistent := func(element, format string, args ...interface{}) {
+		issues = append(issues, Issue{Kind: IssueInconsistentPreface, Element: element, Message: fmt.Sprintf(format, args...)})
+	}
+	chamber := strings.ToUpper(strings.TrimSpace(doc.GetChamber()))
+
+	if text := strings.TrimSpace(documentSlugLine(doc)); text != "" {
+		slug, err := ParseSlugLine(text)
+		if err != nil {
+			issues = append(issues, Issue{Kind: IssueInvalidPreface, Element: "slugLine", Message: err.Error()})
+		} else {
+			for _, citation := range doc.GetCitations() {
+				m := compactCitationPattern.FindStringSubmatch(strings.TrimSpace(citation))
+				if m == nil {
+					continue
+				}
+				if m[2] != slug.MeasureType() || m[3] != slug.Number {
+					inconsistent("slugLine", "slug line %q names a different measure than citation %q", text, citation)
+				} else if m[4] != "" && m[4] != strings.ToLower(slug.Version) {
+					inconsistent("slugLine", "slug line %q names a different version than citation %q", text, citation)
+				}
+				break
+			}
+		}
+	}
+
+	var preface *Preface
+	switch d := doc.(type) {
+	case *Bill:
+		preface = d.Preface
+	case *Resolution:
+		preface = d.Preface
+	}
+	if preface == nil {
+		return issues
+	}
+	if preface.DistributionCode != nil {
+		code := preface.DistributionCode.Code()
+		switch {
+		case !code.Known():
+			issues = append(issues, Issue{Kind: IssueInvalidPreface, Element: "distributionCode", Message: fmt.Sprintf("unknown distribution code %q", code)})
+		case chamber != "" && code.Chamber() != chamber:
+			inconsistent("distributionCode", "distribution code %s is used in the %s, but the current chamber is the %s", code, code.Chamber(), chamber)
+		}
+	}
+	if cal := preface.Calendar(); cal != nil && chamber != "" && cal.Chamber != chamber {
+		inconsistent("relatedDocument", "calendar %s is a %s calendar, but the current chamber is the %s", cal.Href, cal.Chamber, chamber)
+	}
+	return issues
+}
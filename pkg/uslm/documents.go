@@ -1,6 +1,9 @@
 package uslm
 
-import "encoding/xml"
+import (
+	"encoding/xml"
+	"strings"
+)
 
 // Bill represents a bill document (Senate or House bill).
 type Bill struct {
@@ -12,8 +15,20 @@ type Bill struct {
 	XMLNSHTML       string `xml:"xmlns html,attr" json:"xmlnsHTML,omitempty"`
 	XMLNSUSLM       string `xml:"xmlns uslm,attr" json:"xmlnsUSLM,omitempty"`
 	XMLNSXSI        string `xml:"xmlns xsi,attr" json:"xmlnsXSI,omitempty"`
-	XSISchemaLocation string `xml:"xsi schemaLocation,attr" json:"xsiSchemaLocation,omitempty"`
-	XMLLang         string `xml:"xml lang,attr" json:"xmlLang,omitempty"`
+	XSISchemaLocation string `xml:"http://www.w3.org/2001/XMLSchema-instance schemaLocation,attr" json:"xsiSchemaLocation,omitempty"`
+	XMLLang         string `xml:"http://www.w3.org/XML/1998/namespace lang,attr" json:"xmlLang,omitempty"`
+	XMLBase         string `xml:"http://www.w3.org/XML/1998/namespace base,attr" json:"xmlBase,omitempty"`
+	Attrs           Attributes `xml:",any,attr" json:"attrs,omitempty"`
+
+	// Namespace declarations in source order, recorded when parsed from XML
+	namespaces []namespaceDecl
+
+	// CDATA sections, entity references, and DOCTYPE of the source, recorded
+	// when parsed from XML (see XMLOptions.PreserveLexical)
+	lexical *lexicalForms
+
+	// Elements decoded by registered element handlers (see Extensions)
+	extensions []Extension
 
 	// Document sections
 	Meta    *Meta    `xml:"meta" json:"meta"`
@@ -22,6 +37,9 @@ type Bill struct {
 
 	// End marker
 	EndMarker string `xml:"endMarker,omitempty" json:"endMarker,omitempty"`
+
+	// Enveloped XML Signature of authenticated documents (see VerifySignature)
+	DigitalSignature *XMLSignature `xml:"http://www.w3.org/2000/09/xmldsig# Signature" json:"digitalSignature,omitempty"`
 }
 
 // Ensure Bill implements all relevant interfaces
@@ -32,6 +50,8 @@ var (
 	_ CommitteeDocument   = (*Bill)(nil)
 	_ HierarchicalDocument = (*Bill)(nil)
 	_ MetadataDocument    = (*Bill)(nil)
+	_ TitledDocument      = (*Bill)(nil)
+	_ ReportedDocument    = (*Bill)(nil)
 )
 
 // GetDocumentNumber returns the bill number.
@@ -106,6 +126,11 @@ func (b *Bill) GetCitations() []string {
 	return nil
 }
 
+// GetMeta returns a common view of the metadata block, or nil if there is none.
+func (b *Bill) GetMeta() *DocumentMeta {
+	return b.Meta.View()
+}
+
 // GetSponsors returns all primary sponsors.
 func (b *Bill) GetSponsors() []Sponsor {
 	var sponsors []Sponsor
@@ -119,19 +144,24 @@ func (b *Bill) GetSponsors() []Sponsor {
 	return sponsors
 }
 
-// GetCosponsors returns all cosponsors.
+// GetCosponsors returns all cosponsors, each once, in the order they are
+// first listed. See GetCosponsorships.
 func (b *Bill) GetCosponsors() []Cosponsor {
 	var cosponsors []Cosponsor
-	if b.Preface != nil {
-		for _, action := range b.Preface.Actions {
-			if action.ActionDescription != nil {
-				cosponsors = append(cosponsors, action.ActionDescription.Cosponsors...)
-			}
-		}
+	for _, c := range b.GetCosponsorships() {
+		cosponsors = append(cosponsors, c.Cosponsor)
 	}
 	return cosponsors
 }
 
+// GetCosponsorships returns all cosponsors with the date each joined.
+func (b *Bill) GetCosponsorships() []Cosponsorship {
+	if b.Preface != nil {
+		return cosponsorships(b.Preface.Actions)
+	}
+	return nil
+}
+
 // GetActions returns all legislative actions.
 func (b *Bill) GetActions() []Action {
 	if b.Preface != nil {
@@ -161,6 +191,59 @@ func (b *Bill) GetSections() []Section {
 	return nil
 }
 
+// GetLongTitle returns the long title block, or nil if there is none.
+func (b *Bill) GetLongTitle() *LongTitle {
+	if b.Main != nil {
+		return b.Main.LongTitle
+	}
+	return nil
+}
+
+// GetDocTitle returns the document title from the long title (e.g., "AN ACT").
+func (b *Bill) GetDocTitle() string {
+	if lt := b.GetLongTitle(); lt != nil {
+		return lt.DocTitle
+	}
+	return ""
+}
+
+// GetOfficialTitle returns the official title from the long title.
+func (b *Bill) GetOfficialTitle() string {
+	if lt := b.GetLongTitle(); lt != nil {
+		return lt.OfficialTitle
+	}
+	return ""
+}
+
+// GetCalendar returns the calendar the measure was placed on when reported,
+// or nil.
+func (b *Bill) GetCalendar() *Calendar {
+	return calendarOf(reportedRelated(b.Preface, b.Meta))
+}
+
+// GetCalendarNumber returns the number of the calendar the measure was
+// placed on (e.g., "5" for Union Calendar No. 5), or an empty string.
+func (b *Bill) GetCalendarNumber() string {
+	if cal := b.GetCalendar(); cal != nil {
+		return cal.Number
+	}
+	return ""
+}
+
+// GetReports returns the committee reports accompanying the measure.
+func (b *Bill) GetReports() []CommitteeReport {
+	return reportsOf(reportedRelated(b.Preface, b.Meta))
+}
+
+// GetReportCitation returns the citation of the first committee report
+// (e.g., "H. Rept. 116-15, Part 1"), or an empty string.
+func (b *Bill) GetReportCitation() string {
+	if reports := b.GetReports(); len(reports) > 0 {
+		return reports[0].Citation()
+	}
+	return ""
+}
+
 // GetCreator returns the document creator.
 func (b *Bill) GetCreator() string {
 	if b.Meta != nil {
@@ -209,6 +292,46 @@ func (b *Bill) GetProcessedDate() string {
 	return ""
 }
 
+// GetProvenance returns the processing steps in the order they were recorded.
+func (b *Bill) GetProvenance() []ProcessingStep {
+	return b.Meta.GetProvenance()
+}
+
+// GetPublicationName returns the name of the publication the document is
+// part of, from the preface or else the metadata, or an empty string.
+func (b *Bill) GetPublicationName() string {
+	if b.Preface != nil && b.Preface.DocPublicationName != nil {
+		return b.Preface.DocPublicationName.GetValue()
+	}
+	if b.Meta != nil {
+		return b.Meta.DocPublicationName.GetValue()
+	}
+	return ""
+}
+
+// GetDateline returns the dateline printed on an enrolled measure (e.g.,
+// "Begun and held at the City of Washington on Thursday, the third day of
+// January, two thousand and nineteen"), or an empty string.
+func (b *Bill) GetDateline() string {
+	if b.Preface != nil {
+		return b.Preface.EnrolledDateline.GetValue()
+	}
+	return ""
+}
+
+// GetRunningHeads returns the running heads of the preface, then those of
+// the main body.
+func (b *Bill) GetRunningHeads() []RunningHead {
+	var heads []RunningHead
+	if p := b.Preface; p != nil {
+		heads = append(heads, runningHeads(p.LeftRunningHead, p.CenterRunningHead, p.RightRunningHead)...)
+	}
+	if m := b.Main; m != nil {
+		heads = append(heads, runningHeads(m.LeftRunningHead, m.CenterRunningHead, m.RightRunningHead)...)
+	}
+	return heads
+}
+
 // Resolution represents a resolution document (simple, joint, or concurrent).
 type Resolution struct {
 	XMLName xml.Name `xml:"resolution" json:"-"`
@@ -219,8 +342,20 @@ type Resolution struct {
 	XMLNSHTML       string `xml:"xmlns html,attr" json:"xmlnsHTML,omitempty"`
 	XMLNSUSLM       string `xml:"xmlns uslm,attr" json:"xmlnsUSLM,omitempty"`
 	XMLNSXSI        string `xml:"xmlns xsi,attr" json:"xmlnsXSI,omitempty"`
-	XSISchemaLocation string `xml:"xsi schemaLocation,attr" json:"xsiSchemaLocation,omitempty"`
-	XMLLang         string `xml:"xml lang,attr" json:"xmlLang,omitempty"`
+	XSISchemaLocation string `xml:"http://www.w3.org/2001/XMLSchema-instance schemaLocation,attr" json:"xsiSchemaLocation,omitempty"`
+	XMLLang         string `xml:"http://www.w3.org/XML/1998/namespace lang,attr" json:"xmlLang,omitempty"`
+	XMLBase         string `xml:"http://www.w3.org/XML/1998/namespace base,attr" json:"xmlBase,omitempty"`
+	Attrs           Attributes `xml:",any,attr" json:"attrs,omitempty"`
+
+	// Namespace declarations in source order, recorded when parsed from XML
+	namespaces []namespaceDecl
+
+	// CDATA sections, entity references, and DOCTYPE of the source, recorded
+	// when parsed from XML (see XMLOptions.PreserveLexical)
+	lexical *lexicalForms
+
+	// Elements decoded by registered element handlers (see Extensions)
+	extensions []Extension
 
 	// Document sections
 	Meta    *Meta    `xml:"meta" json:"meta"`
@@ -229,6 +364,9 @@ type Resolution struct {
 
 	// End marker
 	EndMarker string `xml:"endMarker,omitempty" json:"endMarker,omitempty"`
+
+	// Enveloped XML Signature of authenticated documents (see VerifySignature)
+	DigitalSignature *XMLSignature `xml:"http://www.w3.org/2000/09/xmldsig# Signature" json:"digitalSignature,omitempty"`
 }
 
 // Ensure Resolution implements all relevant interfaces
@@ -239,6 +377,8 @@ var (
 	_ CommitteeDocument   = (*Resolution)(nil)
 	_ HierarchicalDocument = (*Resolution)(nil)
 	_ MetadataDocument    = (*Resolution)(nil)
+	_ TitledDocument      = (*Resolution)(nil)
+	_ ReportedDocument    = (*Resolution)(nil)
 )
 
 // GetDocumentNumber returns the resolution number.
@@ -313,6 +453,11 @@ func (r *Resolution) GetCitations() []string {
 	return nil
 }
 
+// GetMeta returns a common view of the metadata block, or nil if there is none.
+func (r *Resolution) GetMeta() *DocumentMeta {
+	return r.Meta.View()
+}
+
 // GetSponsors returns all primary sponsors.
 func (r *Resolution) GetSponsors() []Sponsor {
 	var sponsors []Sponsor
@@ -326,19 +471,24 @@ func (r *Resolution) GetSponsors() []Sponsor {
 	return sponsors
 }
 
-// GetCosponsors returns all cosponsors.
+// GetCosponsors returns all cosponsors, each once, in the order they are
+// first listed. See GetCosponsorships.
 func (r *Resolution) GetCosponsors() []Cosponsor {
 	var cosponsors []Cosponsor
-	if r.Preface != nil {
-		for _, action := range r.Preface.Actions {
-			if action.ActionDescription != nil {
-				cosponsors = append(cosponsors, action.ActionDescription.Cosponsors...)
-			}
-		}
+	for _, c := range r.GetCosponsorships() {
+		cosponsors = append(cosponsors, c.Cosponsor)
 	}
 	return cosponsors
 }
 
+// GetCosponsorships returns all cosponsors with the date each joined.
+func (r *Resolution) GetCosponsorships() []Cosponsorship {
+	if r.Preface != nil {
+		return cosponsorships(r.Preface.Actions)
+	}
+	return nil
+}
+
 // GetActions returns all legislative actions.
 func (r *Resolution) GetActions() []Action {
 	if r.Preface != nil {
@@ -368,6 +518,74 @@ func (r *Resolution) GetSections() []Section {
 	return nil
 }
 
+// GetLongTitle returns the long title block, or nil if there is none.
+func (r *Resolution) GetLongTitle() *LongTitle {
+	if r.Main != nil {
+		return r.Main.LongTitle
+	}
+	return nil
+}
+
+// GetDocTitle returns the document title from the long title (e.g., "AN ACT").
+func (r *Resolution) GetDocTitle() string {
+	if lt := r.GetLongTitle(); lt != nil {
+		return lt.DocTitle
+	}
+	return ""
+}
+
+// GetOfficialTitle returns the official title from the long title.
+func (r *Resolution) GetOfficialTitle() string {
+	if lt := r.GetLongTitle(); lt != nil {
+		return lt.OfficialTitle
+	}
+	return ""
+}
+
+// GetCalendar returns the calendar the measure was placed on when reported,
+// or nil.
+func (r *Resolution) GetCalendar() *Calendar {
+	return calendarOf(reportedRelated(r.Preface, r.Meta))
+}
+
+// GetCalendarNumber returns the number of the calendar the measure was
+// placed on (e.g., "5" for Union Calendar No. 5), or an empty string.
+func (r *Resolution) GetCalendarNumber() string {
+	if cal := r.GetCalendar(); cal != nil {
+		return cal.Number
+	}
+	return ""
+}
+
+// GetReports returns the committee reports accompanying the measure.
+func (r *Resolution) GetReports() []CommitteeReport {
+	return reportsOf(reportedRelated(r.Preface, r.Meta))
+}
+
+// GetReportCitation returns the citation of the first committee report
+// (e.g., "H. Rept. 116-15, Part 1"), or an empty string.
+func (r *Resolution) GetReportCitation() string {
+	if reports := r.GetReports(); len(reports) > 0 {
+		return reports[0].Citation()
+	}
+	return ""
+}
+
+// GetRecitals returns the text of each "Whereas" clause in the preamble, in
+// order, or nil if the resolution has no preamble.
+func (r *Resolution) GetRecitals() []string {
+	if r.Main == nil || r.Main.Preamble == nil {
+		return nil
+	}
+	var recitals []string
+	for i := range r.Main.Preamble.Recitals {
+		if text := r.Main.Preamble.Recitals[i].PlainText(); text != "" {
+			recitals = append(recitals, text)
+		}
+	}
+	return recitals
+}
+
 // GetCreator returns the document creator.
 func (r *Resolution) GetCreator() string {
 	if r.Meta != nil {
@@ -416,6 +634,46 @@ func (r *Resolution) GetProcessedDate() string {
 	return ""
 }
 
+// GetProvenance returns the processing steps in the order they were recorded.
+func (r *Resolution) GetProvenance() []ProcessingStep {
+	return r.Meta.GetProvenance()
+}
+
+// GetPublicationName returns the name of the publication the document is
+// part of, from the preface or else the metadata, or an empty string.
+func (r *Resolution) GetPublicationName() string {
+	if r.Preface != nil && r.Preface.DocPublicationName != nil {
+		return r.Preface.DocPublicationName.GetValue()
+	}
+	if r.Meta != nil {
+		return r.Meta.DocPublicationName.GetValue()
+	}
+	return ""
+}
+
+// GetDateline returns the dateline printed on an enrolled measure (e.g.,
+// "Begun and held at the City of Washington on Thursday, the third day of
+// January, two thousand and nineteen"), or an empty string.
+func (r *Resolution) GetDateline() string {
+	if r.Preface != nil {
+		return r.Preface.EnrolledDateline.GetValue()
+	}
+	return ""
+}
+
+// GetRunningHeads returns the running heads of the preface, then those of
+// the main body.
+func (r *Resolution) GetRunningHeads() []RunningHead {
+	var heads []RunningHead
+	if p := r.Preface; p != nil {
+		heads = append(heads, runningHeads(p.LeftRunningHead, p.CenterRunningHead, p.RightRunningHead)...)
+	}
+	if m := r.Main; m != nil {
+		heads = append(heads, runningHeads(m.LeftRunningHead, m.CenterRunningHead, m.RightRunningHead)...)
+	}
+	return heads
+}
+
 // EngrossedAmendment represents an engrossed amendment document.
 type EngrossedAmendment struct {
 	XMLName xml.Name `xml:"engrossedAmendment" json:"-"`
@@ -427,8 +685,20 @@ type EngrossedAmendment struct {
 	XMLNSUSLM       string `xml:"xmlns uslm,attr" json:"xmlnsUSLM,omitempty"`
 	XMLNSXSI        string `xml:"xmlns xsi,attr" json:"xmlnsXSI,omitempty"`
 	StyleType       string `xml:"styleType,attr,omitempty" json:"styleType,omitempty"`
-	XSISchemaLocation string `xml:"xsi schemaLocation,attr" json:"xsiSchemaLocation,omitempty"`
-	XMLLang         string `xml:"xml lang,attr" json:"xmlLang,omitempty"`
+	XSISchemaLocation string `xml:"http://www.w3.org/2001/XMLSchema-instance schemaLocation,attr" json:"xsiSchemaLocation,omitempty"`
+	XMLLang         string `xml:"http://www.w3.org/XML/1998/namespace lang,attr" json:"xmlLang,omitempty"`
+	XMLBase         string `xml:"http://www.w3.org/XML/1998/namespace base,attr" json:"xmlBase,omitempty"`
+	Attrs           Attributes `xml:",any,attr" json:"attrs,omitempty"`
+
+	// Namespace declarations in source order, recorded when parsed from XML
+	namespaces []namespaceDecl
+
+	// CDATA sections, entity references, and DOCTYPE of the source, recorded
+	// when parsed from XML (see XMLOptions.PreserveLexical)
+	lexical *lexicalForms
+
+	// Elements decoded by registered element handlers (see Extensions)
+	extensions []Extension
 
 	// Document sections
 	AmendMeta    *AmendMeta    `xml:"amendMeta" json:"amendMeta"`
@@ -440,6 +710,9 @@ type EngrossedAmendment struct {
 
 	// Endorsement (can appear after signatures)
 	Endorsement *Endorsement `xml:"endorsement" json:"endorsement,omitempty"`
+
+	// Enveloped XML Signature of authenticated documents (see VerifySignature)
+	DigitalSignature *XMLSignature `xml:"http://www.w3.org/2000/09/xmldsig# Signature" json:"digitalSignature,omitempty"`
 }
 
 // Ensure EngrossedAmendment implements all relevant interfaces
@@ -447,6 +720,7 @@ var (
 	_ LegislativeDocument = (*EngrossedAmendment)(nil)
 	_ AmendmentDocument   = (*EngrossedAmendment)(nil)
 	_ ActionDocument      = (*EngrossedAmendment)(nil)
+	_ CommitteeDocument   = (*EngrossedAmendment)(nil)
 	_ MetadataDocument    = (*EngrossedAmendment)(nil)
 )
 
@@ -522,6 +796,11 @@ func (e *EngrossedAmendment) GetCitations() []string {
 	return nil
 }
 
+// GetMeta returns a common view of the metadata block, or nil if there is none.
+func (e *EngrossedAmendment) GetMeta() *DocumentMeta {
+	return e.AmendMeta.View()
+}
+
 // GetAmendmentDegree returns the degree of amendment.
 func (e *EngrossedAmendment) GetAmendmentDegree() string {
 	if e.AmendMeta != nil {
@@ -530,6 +809,12 @@ func (e *EngrossedAmendment) GetAmendmentDegree() string {
 	return ""
 }
 
+// GetTargetMeasure returns the bill or resolution being amended, or nil if
+// it cannot be determined.
+func (e *EngrossedAmendment) GetTargetMeasure() *TargetMeasure {
+	return targetMeasure(e.AmendMeta, e.AmendPreface, e.AmendMain)
+}
+
 // GetActions returns all legislative actions.
 func (e *EngrossedAmendment) GetActions() []Action {
 	if e.AmendPreface != nil {
@@ -538,6 +823,19 @@ func (e *EngrossedAmendment) GetActions() []Action {
 	return nil
 }
 
+// GetCommittees returns all committees referenced in the amendment's actions.
+func (e *EngrossedAmendment) GetCommittees() []Committee {
+	var committees []Committee
+	if e.AmendPreface != nil {
+		for _, action := range e.AmendPreface.Actions {
+			if action.ActionDescription != nil {
+				committees = append(committees, action.ActionDescription.Committees...)
+			}
+		}
+	}
+	return committees
+}
+
 // GetCreator returns the document creator.
 func (e *EngrossedAmendment) GetCreator() string {
 	if e.AmendMeta != nil {
@@ -586,6 +884,28 @@ func (e *EngrossedAmendment) GetProcessedDate() string {
 	return ""
 }
 
+// GetProvenance returns the processing steps in the order they were recorded.
+func (e *EngrossedAmendment) GetProvenance() []ProcessingStep {
+	return e.AmendMeta.GetProvenance()
+}
+
+// GetPublicationName returns the name of the publication the document is
+// part of, or an empty string.
+func (e *EngrossedAmendment) GetPublicationName() string {
+	if e.AmendMeta != nil {
+		return e.AmendMeta.DocPublicationName.GetValue()
+	}
+	return ""
+}
+
+// GetRunningHeads returns the running heads of the preface.
+func (e *EngrossedAmendment) GetRunningHeads() []RunningHead {
+	if p := e.AmendPreface; p != nil {
+		return runningHeads(p.LeftRunningHead, p.CenterRunningHead, p.RightRunningHead)
+	}
+	return nil
+}
+
 // Amendment represents a generic amendment document.
 type Amendment struct {
 	XMLName xml.Name `xml:"amendment" json:"-"`
@@ -596,13 +916,28 @@ type Amendment struct {
 	XMLNSHTML       string `xml:"xmlns html,attr" json:"xmlnsHTML,omitempty"`
 	XMLNSUSLM       string `xml:"xmlns uslm,attr" json:"xmlnsUSLM,omitempty"`
 	XMLNSXSI        string `xml:"xmlns xsi,attr" json:"xmlnsXSI,omitempty"`
-	XSISchemaLocation string `xml:"xsi schemaLocation,attr" json:"xsiSchemaLocation,omitempty"`
-	XMLLang         string `xml:"xml lang,attr" json:"xmlLang,omitempty"`
+	XSISchemaLocation string `xml:"http://www.w3.org/2001/XMLSchema-instance schemaLocation,attr" json:"xsiSchemaLocation,omitempty"`
+	XMLLang         string `xml:"http://www.w3.org/XML/1998/namespace lang,attr" json:"xmlLang,omitempty"`
+	XMLBase         string `xml:"http://www.w3.org/XML/1998/namespace base,attr" json:"xmlBase,omitempty"`
+	Attrs           Attributes `xml:",any,attr" json:"attrs,omitempty"`
+
+	// Namespace declarations in source order, recorded when parsed from XML
+	namespaces []namespaceDecl
+
+	// CDATA sections, entity references, and DOCTYPE of the source, recorded
+	// when parsed from XML (see XMLOptions.PreserveLexical)
+	lexical *lexicalForms
+
+	// Elements decoded by registered element handlers (see Extensions)
+	extensions []Extension
 
 	// Document sections
 	AmendMeta    *AmendMeta    `xml:"amendMeta" json:"amendMeta"`
 	AmendPreface *AmendPreface `xml:"amendPreface" json:"amendPreface,omitempty"`
 	AmendMain    *AmendMain    `xml:"amendMain" json:"amendMain,omitempty"`
+
+	// Enveloped XML Signature of authenticated documents (see VerifySignature)
+	DigitalSignature *XMLSignature `xml:"http://www.w3.org/2000/09/xmldsig# Signature" json:"digitalSignature,omitempty"`
 }
 
 // Ensure Amendment implements all relevant interfaces
@@ -610,6 +945,7 @@ var (
 	_ LegislativeDocument = (*Amendment)(nil)
 	_ AmendmentDocument   = (*Amendment)(nil)
 	_ ActionDocument      = (*Amendment)(nil)
+	_ CommitteeDocument   = (*Amendment)(nil)
 	_ MetadataDocument    = (*Amendment)(nil)
 )
 
@@ -685,6 +1021,11 @@ func (a *Amendment) GetCitations() []string {
 	return nil
 }
 
+// GetMeta returns a common view of the metadata block, or nil if there is none.
+func (a *Amendment) GetMeta() *DocumentMeta {
+	return a.AmendMeta.View()
+}
+
 // GetAmendmentDegree returns the degree of amendment.
 func (a *Amendment) GetAmendmentDegree() string {
 	if a.AmendMeta != nil {
@@ -693,6 +1034,12 @@ func (a *Amendment) GetAmendmentDegree() string {
 	return ""
 }
 
+// GetTargetMeasure returns the bill or resolution being amended, or nil if
+// it cannot be determined.
+func (a *Amendment) GetTargetMeasure() *TargetMeasure {
+	return targetMeasure(a.AmendMeta, a.AmendPreface, a.AmendMain)
+}
+
 // GetActions returns all legislative actions.
 func (a *Amendment) GetActions() []Action {
 	if a.AmendPreface != nil {
@@ -701,6 +1048,19 @@ func (a *Amendment) GetActions() []Action {
 	return nil
 }
 
+// GetCommittees returns all committees referenced in the amendment's actions.
+func (a *Amendment) GetCommittees() []Committee {
+	var committees []Committee
+	if a.AmendPreface != nil {
+		for _, action := range a.AmendPreface.Actions {
+			if action.ActionDescription != nil {
+				committees = append(committees, action.ActionDescription.Committees...)
+			}
+		}
+	}
+	return committees
+}
+
 // GetCreator returns the document creator.
 func (a *Amendment) GetCreator() string {
 	if a.AmendMeta != nil {
@@ -748,3 +1108,260 @@ func (a *Amendment) GetProcessedDate() string {
 	}
 	return ""
 }
+
+// GetProvenance returns the processing steps in the order they were recorded.
+func (a *Amendment) GetProvenance() []ProcessingStep {
+	return a.AmendMeta.GetProvenance()
+}
+
+// GetPublicationName returns the name of the publication the document is
+// part of, or an empty string.
+func (a *Amendment) GetPublicationName() string {
+	if a.AmendMeta != nil {
+		return a.AmendMeta.DocPublicationName.GetValue()
+	}
+	return ""
+}
+
+// GetRunningHeads returns the running heads of the preface.
+func (a *Amendment) GetRunningHeads() []RunningHead {
+	if p := a.AmendPreface; p != nil {
+		return runningHeads(p.LeftRunningHead, p.CenterRunningHead, p.RightRunningHead)
+	}
+	return nil
+}
+
+// GenericDocument represents a loosely structured <document>, the root used for
+// committee prints, congressional documents, and other BILLS-adjacent publications
+// that are not subject to amendment.
+type GenericDocument struct {
+	XMLName xml.Name `xml:"document" json:"-"`
+
+	// XML namespace declarations
+	XMLNS             string `xml:"xmlns,attr" json:"xmlns"`
+	XMLNSDC           string `xml:"xmlns dc,attr" json:"xmlnsDC,omitempty"`
+	XMLNSHTML         string `xml:"xmlns html,attr" json:"xmlnsHTML,omitempty"`
+	XMLNSUSLM         string `xml:"xmlns uslm,attr" json:"xmlnsUSLM,omitempty"`
+	XMLNSXSI          string `xml:"xmlns xsi,attr" json:"xmlnsXSI,omitempty"`
+	XSISchemaLocation string `xml:"http://www.w3.org/2001/XMLSchema-instance schemaLocation,attr" json:"xsiSchemaLocation,omitempty"`
+	XMLLang           string `xml:"http://www.w3.org/XML/1998/namespace lang,attr" json:"xmlLang,omitempty"`
+	XMLBase           string `xml:"http://www.w3.org/XML/1998/namespace base,attr" json:"xmlBase,omitempty"`
+	Attrs             Attributes `xml:",any,attr" json:"attrs,omitempty"`
+
+	// Namespace declarations in source order, recorded when parsed from XML
+	namespaces []namespaceDecl
+
+	// CDATA sections, entity references, and DOCTYPE of the source, recorded
+	// when parsed from XML (see XMLOptions.PreserveLexical)
+	lexical *lexicalForms
+
+	// Elements decoded by registered element handlers (see Extensions)
+	extensions []Extension
+
+	// Document sections
+	Meta       *Meta            `xml:"meta" json:"meta"`
+	Content    *DocumentContent `xml:"content" json:"content,omitempty"`
+	Appendices []Appendix       `xml:"appendix" json:"appendices,omitempty"`
+
+	// Enveloped XML Signature of authenticated documents (see VerifySignature)
+	DigitalSignature *XMLSignature `xml:"http://www.w3.org/2000/09/xmldsig# Signature" json:"digitalSignature,omitempty"`
+}
+
+// DocumentContent represents the content area of a generic document.
+type DocumentContent struct {
+	XMLName  xml.Name  `xml:"content" json:"-"`
+	Class    string    `xml:"class,attr,omitempty" json:"class,omitempty"`
+	Text     string    `xml:",chardata" json:"text,omitempty"`
+	P        []P       `xml:"p" json:"p,omitempty"`
+	Sections []Section `xml:"section" json:"sections,omitempty"`
+	Titles   []Title   `xml:"title" json:"titles,omitempty"`
+	Attrs    Attributes `xml:",any,attr" json:"attrs,omitempty"`
+}
+
+// Appendix represents an appendix to a generic document.
+type Appendix struct {
+	XMLName  xml.Name  `xml:"appendix" json:"-"`
+	ID       string    `xml:"id,attr,omitempty" json:"id,omitempty"`
+	Num      *Num      `xml:"num" json:"num,omitempty"`
+	Heading  *Heading  `xml:"heading" json:"heading,omitempty"`
+	P        []P       `xml:"p" json:"p,omitempty"`
+	Sections []Section `xml:"section" json:"sections,omitempty"`
+	Attrs    Attributes `xml:",any,attr" json:"attrs,omitempty"`
+}
+
+// Ensure GenericDocument implements all relevant interfaces
+var (
+	_ LegislativeDocument  = (*GenericDocument)(nil)
+	_ HierarchicalDocument = (*GenericDocument)(nil)
+	_ MetadataDocument     = (*GenericDocument)(nil)
+)
+
+// GetDocumentNumber returns the document number.
+func (g *GenericDocument) GetDocumentNumber() string {
+	if g.Meta != nil {
+		return g.Meta.DocNumber
+	}
+	return ""
+}
+
+// GetDocumentType returns the document type.
+func (g *GenericDocument) GetDocumentType() string {
+	if g.Meta != nil {
+		return g.Meta.DCType
+	}
+	return ""
+}
+
+// GetCongress returns the congress number.
+func (g *GenericDocument) GetCongress() string {
+	if g.Meta != nil {
+		return g.Meta.Congress
+	}
+	return ""
+}
+
+// GetSession returns the session number.
+func (g *GenericDocument) GetSession() string {
+	if g.Meta != nil {
+		return g.Meta.Session
+	}
+	return ""
+}
+
+// GetTitle returns the document title.
+func (g *GenericDocument) GetTitle() string {
+	if g.Meta != nil {
+		return g.Meta.DCTitle
+	}
+	return ""
+}
+
+// GetStage returns the document stage.
+func (g *GenericDocument) GetStage() string {
+	if g.Meta != nil {
+		return g.Meta.DocStage
+	}
+	return ""
+}
+
+// GetChamber returns the current chamber.
+func (g *GenericDocument) GetChamber() string {
+	if g.Meta != nil {
+		return g.Meta.CurrentChamber
+	}
+	return ""
+}
+
+// IsPublic returns true if this is a public document.
+func (g *GenericDocument) IsPublic() bool {
+	if g.Meta != nil {
+		return g.Meta.PublicPrivate == "public"
+	}
+	return false
+}
+
+// GetCitations returns all citable forms.
+func (g *GenericDocument) GetCitations() []string {
+	if g.Meta != nil {
+		return g.Meta.CitableAs
+	}
+	return nil
+}
+
+// GetMeta returns a common view of the metadata block, or nil if there is none.
+func (g *GenericDocument) GetMeta() *DocumentMeta {
+	return g.Meta.View()
+}
+
+// GetSections returns all top-level sections.
+func (g *GenericDocument) GetSections() []Section {
+	if g.Content != nil {
+		return g.Content.Sections
+	}
+	return nil
+}
+
+// GetCreator returns the document creator.
+func (g *GenericDocument) GetCreator() string {
+	if g.Meta != nil {
+		return g.Meta.DCCreator
+	}
+	return ""
+}
+
+// GetPublisher returns the publisher.
+func (g *GenericDocument) GetPublisher() string {
+	if g.Meta != nil {
+		return g.Meta.DCPublisher
+	}
+	return ""
+}
+
+// GetLanguage returns the language code.
+func (g *GenericDocument) GetLanguage() string {
+	if g.Meta != nil {
+		return g.Meta.DCLanguage
+	}
+	return ""
+}
+
+// GetRights returns the rights statement.
+func (g *GenericDocument) GetRights() string {
+	if g.Meta != nil {
+		return g.Meta.DCRights
+	}
+	return ""
+}
+
+// GetProcessedBy returns the processing tool.
+func (g *GenericDocument) GetProcessedBy() string {
+	if g.Meta != nil {
+		return g.Meta.ProcessedBy
+	}
+	return ""
+}
+
+// GetProcessedDate returns the processing date.
+func (g *GenericDocument) GetProcessedDate() string {
+	if g.Meta != nil {
+		return g.Meta.ProcessedDate
+	}
+	return ""
+}
+
+// GetProvenance returns the processing steps in the order they were recorded.
+func (g *GenericDocument) GetProvenance() []ProcessingStep {
+	return g.Meta.GetProvenance()
+}
+
+// GetPublicationName returns the name of the publication the document is
+// part of, or an empty string.
+func (g *GenericDocument) GetPublicationName() string {
+	if g.Meta != nil {
+		return g.Meta.DocPublicationName.GetValue()
+	}
+	return ""
+}
+
+// IsCommitteePrint reports whether the document is a committee print (e.g., a
+// Rules Committee Print), based on its stage, type, and title metadata.
+func IsCommitteePrint(doc LegislativeDocument) bool {
+	return metadataContains(doc, "committee print")
+}
+
+// IsCongressionalDocument reports whether the document is a House or Senate
+// Document (govinfo CDOC collection), based on its type and title metadata.
+func IsCongressionalDocument(doc LegislativeDocument) bool {
+	return metadataContains(doc, "house document") || metadataContains(doc, "senate document")
+}
+
+// metadataContains reports whether the document's stage, type, or title contains
+// the lowercase phrase.
+func metadataContains(doc LegislativeDocument, phrase string) bool {
+	for _, value := range []string{doc.GetStage(), doc.GetDocumentType(), doc.GetTitle()} {
+		if strings.Contains(strings.ToLower(value), phrase) {
+			return true
+		}
+	}
+	return false
+}
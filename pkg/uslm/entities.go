@@ -0,0 +1,215 @@
+package uslm
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// EntityKind classifies an entity found by FindEntities.
+type EntityKind string
+
+const (
+	// EntityAcronym is a short form defined in parentheses, either an
+	// acronym ("Rural Housing Service (RHS)") or a term introduced with
+	// "referred to as" ("(in this Act referred to as the 'Secretary')").
+	EntityAcronym EntityKind = "acronym"
+
+	// EntityAgency is a mention of a federal department, agency, or office
+	// (e.g., "Department of Agriculture", "Environmental Protection Agency").
+	EntityAgency EntityKind = "agency"
+
+	// EntityProgram is a mention of a named program
+	// (e.g., "Supplemental Nutrition Assistance Program").
+	EntityProgram EntityKind = "program"
+)
+
+// EntityMention is an entity found in document text.
+type EntityMention struct {
+	Kind EntityKind `json:"kind"`
+
+	// Text is the entity as written: the short form for an acronym, or the
+	// full name for an agency or program.
+	Text string `json:"text"`
+
+	// Expansion is the long form an acronym stands for, when it could be
+	// found in the preceding text. It is empty for other kinds.
+	Expansion string `json:"expansion,omitempty"`
+
+	// Offset is the byte offset of the mention within the searched text.
+	Offset int `json:"offset"`
+
+	// Element and Identifier describe the hierarchical level containing the
+	// mention; they are empty for mentions found by FindEntities.
+	Element    string `json:"element,omitempty"`
+	Identifier string `json:"identifier,omitempty"`
+}
+
+var (
+	// referredToAsPattern matches "(in this Act referred to as the 'Secretary')"
+	// and its variants; the term is submatch 1.
+	referredToAsPattern = regexp.MustCompile(`\((?:in this [a-z]+ |hereinafter |hereafter )?(?:referred to|cited) as (?:the )?[“"‘']([^”"’']+)[”"’']\)`)
+
+	// acronymPattern matches a parenthesized acronym such as "(SNAP)".
+	acronymPattern = regexp.MustCompile(`\(([A-Z][A-Z0-9&]*[A-Z0-9])\)`)
+
+	// agencyPattern matches "Department of ..."-style names and names ending
+	// in an agency noun.
+	agencyPattern = regexp.MustCompile(
+		`\b(?:Department|Office|Bureau|Administration|Agency|Commission|Service)s? of (?:the )?` + capitalizedPhrase +
+			`|` + capitalizedPhrase + ` (?:Agency|Administration|Commission|Service|Bureau|Board|Corporation|Council|Institute|Authority|Foundation)\b`)
+
+	// programPattern matches names ending in "Program".
+	programPattern = regexp.MustCompile(capitalizedPhrase + ` Program\b`)
+)
+
+// capitalizedPhrase matches a run of capitalized words, optionally joined by
+// "and", "of", "for", or "the".
+const capitalizedPhrase = `[A-Z][A-Za-z'\-]*(?: (?:(?:and|of|for|the) )*[A-Z][A-Za-z'\-]*)*`
+
+// nameConnectors are the lowercase words allowed within a proper name.
+var nameConnectors = map[string]bool{"and": true, "of": true, "for": true, "the": true, "on": true, "to": true, "in": true}
+
+// FindEntities returns the acronyms, agencies, and programs mentioned in
+// text, in order of appearance. Agency and program names that overlap are
+// reported once, as the longer name.
+func FindEntities(text string) []EntityMention {
+	var mentions []EntityMention
+
+	for _, m := range referredToAsPattern.FindAllStringSubmatchIndex(text, -1) {
+		mentions = append(mentions, EntityMention{
+			Kind:      EntityAcronym,
+			Text:      text[m[2]:m[3]],
+			Expansion: precedingName(text[:m[0]], ""),
+			Offset:    m[2],
+		})
+	}
+	for _, m := range acronymPattern.FindAllStringSubmatchIndex(text, -1) {
+		acronym := text[m[2]:m[3]]
+		if strings.Trim(acronym, "IVXLC") == "" {
+			continue // a roman-numeral reference such as "(II)"
+		}
+		mentions = append(mentions, EntityMention{
+			Kind:      EntityAcronym,
+			Text:      acronym,
+			Expansion: precedingName(text[:m[0]], acronym),
+			Offset:    m[2],
+		})
+	}
+
+	var names []EntityMention
+	for _, m := range agencyPattern.FindAllStringIndex(text, -1) {
+		if n, ok := nameMention(EntityAgency, text, m[0], m[1]); ok {
+			names = append(names, n)
+		}
+	}
+	for _, m := range programPattern.FindAllStringIndex(text, -1) {
+		if n, ok := nameMention(EntityProgram, text, m[0], m[1]); ok {
+			names = append(names, n)
+		}
+	}
+	sort.SliceStable(names, func(i, j int) bool { return len(names[i].Text) > len(names[j].Text) })
+	var kept []EntityMention
+	for _, n := range names {
+		overlaps := false
+		for _, k := range kept {
+			if n.Offset < k.Offset+len(k.Text) && k.Offset < n.Offset+len(n.Text) {
+				overlaps = true
+				break
+			}
+		}
+		if !overlaps {
+			kept = append(kept, n)
+		}
+	}
+	mentions = append(mentions, kept...)
+
+	sort.SliceStable(mentions, func(i, j int) bool { return mentions[i].Offset < mentions[j].Offset })
+	return mentions
+}
+
+// ExtractEntities finds entities in the chapeau and content text of every
+// hierarchical level of the document. Offsets are relative to the
+// reading-order text of the chapeau or content they occur in. Headings are
+// skipped: being in title case, they read as one long proper name.
+func ExtractEntities(doc LegislativeDocument) []EntityMention {
+	var mentions []EntityMention
+	walkDocumentLevels(doc, func(l *level) bool {
+		var texts []string
+		if l.chapeau != nil {
+			texts = append(texts, l.chapeau.PlainText())
+		}
+		if l.content != nil {
+			texts = append(texts, l.content.PlainText())
+		}
+		for _, text := range texts {
+			for _, m := range FindEntities(text) {
+				m.Element = l.element
+				m.Identifier = *l.identifier
+				mentions = append(mentions, m)
+			}
+		}
+		return true
+	})
+	return mentions
+}
+
+// nameMention returns an agency or program mention for text[start:end],
+// dropping a leading "The". It reports false when what remains is a single
+// word, such as "Commission" in "The Commission shall".
+func nameMention(kind EntityKind, text string, start, end int) (EntityMention, bool) {
+	if strings.HasPrefix(text[start:end], "The ") {
+		start += len("The ")
+	}
+	if !strings.Contains(text[start:end], " ") {
+		return EntityMention{}, false
+	}
+	return EntityMention{Kind: kind, Text: text[start:end], Offset: start}, true
+}
+
+// precedingName returns the proper name ending just before a parenthetical
+// definition: the run of capitalized words (and connectors) at the end of
+// text. For an acronym it takes only as many capitalized words as the acronym
+// has letters, and returns an empty string if their initials do not start the
+// same way.
+func precedingName(text, acronym string) string {
+	words := strings.Fields(text)
+	var name []string
+	capitals := 0
+	for i := len(words) - 1; i >= 0; i-- {
+		w := strings.Trim(words[i], ",;:")
+		if w == "" || w != words[i] && len(name) > 0 {
+			break
+		}
+		first := []rune(w)[0]
+		if unicode.IsUpper(first) {
+			name = append(name, w)
+			capitals++
+			if acronym != "" && capitals == len(acronym) {
+				break
+			}
+			continue
+		}
+		if !nameConnectors[w] || len(name) == 0 {
+			break
+		}
+		name = append(name, w)
+	}
+
+	// Drop connectors (and a sentence-initial "The") from the front and
+	// reverse into reading order.
+	for len(name) > 0 && (nameConnectors[name[len(name)-1]] || name[len(name)-1] == "The") {
+		name = name[:len(name)-1]
+	}
+	for i, j := 0, len(name)-1; i < j; i, j = i+1, j-1 {
+		name[i], name[j] = name[j], name[i]
+	}
+	if len(name) == 0 {
+		return ""
+	}
+	if acronym != "" && name[0][0] != acronym[0] {
+		return ""
+	}
+	return strings.Join(name, " ")
+}
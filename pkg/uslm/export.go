@@ -0,0 +1,241 @@
+package uslm
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"time"
+)
+
+// ExportFormat names an output format of ExportDir.
+type ExportFormat string
+
+const (
+	ExportJSON     ExportFormat = "json"
+	ExportMarkdown ExportFormat = "markdown"
+	ExportHTML     ExportFormat = "html"
+)
+
+// exportExtensions maps each ExportFormat to the extension of its files.
+var exportExtensions = map[ExportFormat]string{
+	ExportJSON:     ".json",
+	ExportMarkdown: ".md",
+	ExportHTML:     ".html",
+}
+
+// ExportManifestName is the name of the manifest ExportDir writes to the
+// destination directory.
+const ExportManifestName = "manifest.json"
+
+// ExportOptions configures ExportDir.
+type ExportOptions struct {
+	// Formats to write for each document. Defaults to ExportJSON.
+	Formats []ExportFormat
+
+	// Workers is the number of documents converted at once. Defaults to
+	// runtime.GOMAXPROCS(0).
+	Workers int
+
+	// Pattern selects files by base name using filepath.Match syntax. If
+	// empty, files with an ".xml" extension in any case are selected.
+	Pattern string
+
+	// Recursive includes subdirectories, whose layout is mirrored in the
+	// destination.
+	Recursive bool
+
+	// HTML configures ExportHTML output.
+	HTML HTMLOptions
+
+	// Progress, if set, is called as each file finishes. Calls are made one
+	// at a time, in completion order, from the goroutine running ExportDir.
+	Progress func(ExportProgress)
+}
+
+// ExportProgress reports a finished file to ExportOptions.Progress.
+type ExportProgress struct {
+	// Done is the number of files finished so far, out of Total.
+	Done, Total int
+
+	Result ExportResult
+}
+
+// ExportResult records the outcome of exporting one file. Paths are
+// slash-separated and relative to the source and destination directories.
+type ExportResult struct {
+	Source  string   `json:"source"`
+	Outputs []string `json:"outputs,omitempty"`
+
+	// Error describes why the file failed to parse or be written; it is
+	// empty on success.
+	Error string `json:"error,omitempty"`
+}
+
+// ExportManifest summarizes a run of ExportDir.
+type ExportManifest struct {
+	Started   time.Time `json:"started"`
+	Finished  time.Time `json:"finished"`
+	Succeeded int       `json:"succeeded"`
+	Failed    int       `json:"failed"`
+
+	// Files holds a result for every file exported, in source path order.
+	Files []ExportResult `json:"files"`
+}
+
+// Err returns the files that failed as a *BatchError attributing each
+// error to its source path, or nil if every file succeeded.
+func (m *ExportManifest) Err() error {
+	var errs batchErrors
+	for _, f := range m.Files {
+		if f.Error != "" {
+			errs.add(f.Source, errors.New(f.Error))
+		}
+	}
+	return errs.err()
+}
+
+// ExportDir converts every matching USLM XML file under src into each of the
+// requested formats, writing the outputs to dst under the file's base name
+// (BILLS-114s32cds.xml becomes BILLS-114s32cds.json, .md, or .html) and a
+// manifest of successes and failures to dst/manifest.json. Files are
+// converted concurrently by opts.Workers goroutines.
+//
+// A file that fails to parse or convert is recorded in the manifest and does
+// not stop the run. An error is returned only when src cannot be read, an
+// option is invalid, the manifest cannot be written, or ctx is cancelled; in
+// the last case the manifest covers the files finished before cancellation.
+func ExportDir(ctx context.Context, src, dst string, opts ExportOptions) (*ExportManifest, error) {
+	if len(opts.Formats) == 0 {
+		opts.Formats = []ExportFormat{ExportJSON}
+	}
+	for _, f := range opts.Formats {
+		if _, ok := exportExtensions[f]; !ok {
+			return nil, fmt.Errorf("unsupported export format %q", f)
+		}
+	}
+	if opts.Workers <= 0 {
+		opts.Workers = runtime.GOMAXPROCS(0)
+	}
+	if _, err := os.Stat(src); err != nil {
+		return nil, fmt.Errorf("failed to read source directory: %w", err)
+	}
+	if err := os.MkdirAll(dst, 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create destination directory: %w", err)
+	}
+
+	manifest := &ExportManifest{Started: time.Now().UTC(), Files: []ExportResult{}}
+	paths := matchingFiles(src, opts.Pattern, opts.Recursive)
+
+	jobs := make(chan int)
+	done := make(chan int)
+	results := make([]ExportResult, len(paths))
+	for w := 0; w < opts.Workers && w < len(paths); w++ {
+		go func() {
+			for i := range jobs {
+				results[i] = exportFile(src, dst, paths[i], opts)
+				done <- i
+			}
+		}()
+	}
+
+	finished := make([]bool, len(paths))
+	next, count := 0, 0
+	for count < next || next < len(paths) && ctx.Err() == nil {
+		// Once ctx is done, stop handing out files and wait for those in
+		// flight.
+		var send chan int
+		var cancelled <-chan struct{}
+		if next < len(paths) && ctx.Err() == nil {
+			send, cancelled = jobs, ctx.Done()
+		}
+		select {
+		case send <- next:
+			next++
+		case i := <-done:
+			finished[i] = true
+			count++
+			if opts.Progress != nil {
+				opts.Progress(ExportProgress{Done: count, Total: len(paths), Result: results[i]})
+			}
+		case <-cancelled:
+		}
+	}
+	close(jobs)
+
+	for i, ok := range finished {
+		if !ok {
+			continue
+		}
+		if results[i].Error == "" {
+			manifest.Succeeded++
+		} else {
+			manifest.Failed++
+		}
+		manifest.Files = append(manifest.Files, results[i])
+	}
+	manifest.Finished = time.Now().UTC()
+
+	data, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode manifest: %w", err)
+	}
+	if err := os.WriteFile(filepath.Join(dst, ExportManifestName), data, 0o644); err != nil {
+		return nil, fmt.Errorf("failed to write manifest: %w", err)
+	}
+	return manifest, ctx.Err()
+}
+
+// exportFile converts the file at path, under src, into each format.
+func exportFile(src, dst, path string, opts ExportOptions) ExportResult {
+	rel, err := filepath.Rel(src, path)
+	if err != nil {
+		rel = filepath.Base(path)
+	}
+	result := ExportResult{Source: filepath.ToSlash(rel)}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		result.Error = fmt.Sprintf("failed to read file: %v", err)
+		return result
+	}
+	doc, err := ParseDocument(data)
+	if err != nil {
+		result.Error = err.Error()
+		return result
+	}
+
+	base := strings.TrimSuffix(rel, filepath.Ext(rel))
+	for _, format := range opts.Formats {
+		var out []byte
+		switch format {
+		case ExportJSON:
+			out, err = ToJSON(doc)
+		case ExportMarkdown:
+			out = []byte(ToMarkdown(doc))
+		case ExportHTML:
+			out = []byte(ToHTMLWithOptions(doc, opts.HTML))
+		}
+		if err != nil {
+			result.Error = fmt.Sprintf("failed to convert to %s: %v", format, err)
+			return result
+		}
+
+		name := base + exportExtensions[format]
+		target := filepath.Join(dst, name)
+		if err := os.MkdirAll(filepath.Dir(target), 0o755); err != nil {
+			result.Error = fmt.Sprintf("failed to create directory: %v", err)
+			return result
+		}
+		if err := os.WriteFile(target, out, 0o644); err != nil {
+			result.Error = fmt.Sprintf("failed to write %s: %v", format, err)
+			return result
+		}
+		result.Outputs = append(result.Outputs, filepath.ToSlash(name))
+	}
+	return result
+}
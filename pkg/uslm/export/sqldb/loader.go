@@ -0,0 +1,166 @@
+package sqldb
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/usgpo/uslm/pkg/uslm"
+)
+
+// Loader writes documents into a database using Schema.
+type Loader struct {
+	db      *sql.DB
+	dialect Dialect
+}
+
+// NewLoader returns a Loader for db using the given dialect's placeholders.
+func NewLoader(db *sql.DB, dialect Dialect) *Loader {
+	return &Loader{db: db, dialect: dialect}
+}
+
+// CreateSchema creates the tables and indexes if they do not already exist.
+func (l *Loader) CreateSchema(ctx context.Context) error {
+	for _, stmt := range Schema {
+		if _, err := l.db.ExecContext(ctx, stmt); err != nil {
+			return fmt.Errorf("failed to create schema: %w", err)
+		}
+	}
+	return nil
+}
+
+// Load writes doc under id in a single transaction, replacing any rows
+// previously loaded under the same id. Sponsors, actions, and provisions are
+// written when doc implements the corresponding interface.
+func (l *Loader) Load(ctx context.Context, id string, doc uslm.LegislativeDocument) (err error) {
+	tx, err := l.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			tx.Rollback()
+		}
+	}()
+
+	w := &writer{ctx: ctx, tx: tx, dialect: l.dialect, id: id}
+	for _, table := range tables {
+		column := "document_id"
+		if table == "documents" {
+			column = "id"
+		}
+		if err = w.exec("DELETE FROM "+table+" WHERE "+column+" = ?", id); err != nil {
+			return fmt.Errorf("failed to delete existing rows for %s: %w", id, err)
+		}
+	}
+
+	if err = w.document(doc); err != nil {
+		return fmt.Errorf("failed to load document %s: %w", id, err)
+	}
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit document %s: %w", id, err)
+	}
+	return nil
+}
+
+// writer inserts the rows for one document within a transaction.
+type writer struct {
+	ctx     context.Context
+	tx      *sql.Tx
+	dialect Dialect
+	id      string
+	ordinal int
+}
+
+// exec runs query, rewriting its "?" placeholders for the dialect.
+func (w *writer) exec(query string, args ...interface{}) error {
+	if w.dialect == DialectPostgres {
+		var b strings.Builder
+		n := 0
+		for _, r := range query {
+			if r == '?' {
+				n++
+				b.WriteString("$" + strconv.Itoa(n))
+				continue
+			}
+			b.WriteRune(r)
+		}
+		query = b.String()
+	}
+	_, err := w.tx.ExecContext(w.ctx, query, args...)
+	return err
+}
+
+func (w *writer) document(doc uslm.LegislativeDocument) error {
+	public := 0
+	if doc.IsPublic() {
+		public = 1
+	}
+	err := w.exec(`INSERT INTO documents (id, doc_type, doc_number, congress, session, stage, chamber, title, is_public, citations)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+		w.id, doc.GetDocumentType(), doc.GetDocumentNumber(), doc.GetCongress(), doc.GetSession(),
+		doc.GetStage(), doc.GetChamber(), doc.GetTitle(), public, strings.Join(doc.GetCitations(), "; "))
+	if err != nil {
+		return err
+	}
+
+	if sponsored, ok := doc.(uslm.SponsoredDocument); ok {
+		if err := w.sponsors(sponsored); err != nil {
+			return err
+		}
+	}
+	if acted, ok := doc.(uslm.ActionDocument); ok {
+		if err := w.actions(acted); err != nil {
+			return err
+		}
+	}
+	return w.provisions(doc)
+}
+
+func (w *writer) sponsors(doc uslm.SponsoredDocument) error {
+	const query = `INSERT INTO sponsors (document_id, ordinal, role, member_id, name) VALUES (?, ?, ?, ?, ?)`
+	n := 0
+	for _, s := range doc.GetSponsors() {
+		n++
+		if err := w.exec(query, w.id, n, "sponsor", s.GetID(), memberName(s.Text, s.Inline)); err != nil {
+			return err
+		}
+	}
+	for _, c := range doc.GetCosponsors() {
+		n++
+		if err := w.exec(query, w.id, n, "cosponsor", c.GetID(), memberName(c.Text, c.Inline)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// memberName joins a sponsor's text with its inline runs, which is where GPO
+// puts the small-caps surname (e.g., "Mrs. <inline>Feinstein</inline>").
+func memberName(text string, inline []uslm.Inline) string {
+	parts := []string{strings.TrimSpace(text)}
+	for _, i := range inline {
+		parts = append(parts, strings.TrimSpace(i.Text))
+	}
+	return strings.Join(strings.Fields(strings.Join(parts, " ")), " ")
+}
+
+func (w *writer) actions(doc uslm.ActionDocument) error {
+	for i, a := range doc.GetActions() {
+		var date, description string
+		if a.Date != nil {
+			date = a.Date.Date
+		}
+		if a.ActionDescription != nil {
+			description = strings.TrimSpace(a.ActionDescription.Text)
+		}
+		err := w.exec(`INSERT INTO actions (document_id, ordinal, stage, action_date, description) VALUES (?, ?, ?, ?, ?)`,
+			w.id, i+1, a.ActionStage, date, description)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,231 @@
+package sqldb
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/usgpo/uslm/pkg/uslm"
+)
+
+// statement is an Exec recorded by the fake driver.
+type statement struct {
+	query string
+	args  []driver.Value
+}
+
+// recorder is a database/sql driver that records the statements executed
+// through it. Statements containing failOn fail.
+type recorder struct {
+	mu         sync.Mutex
+	statements []statement
+	commits    int
+	rollbacks  int
+	failOn     string
+}
+
+func (r *recorder) Connect(context.Context) (driver.Conn, error) { return &conn{r}, nil }
+func (r *recorder) Driver() driver.Driver                        { return r }
+func (r *recorder) Open(string) (driver.Conn, error)             { return &conn{r}, nil }
+
+// inserts returns the statements inserting into table.
+func (r *recorder) inserts(table string) []statement {
+	var matched []statement
+	for _, s := range r.statements {
+		if strings.HasPrefix(s.query, "INSERT INTO "+table+" ") {
+			matched = append(matched, s)
+		}
+	}
+	return matched
+}
+
+type conn struct{ r *recorder }
+
+func (c *conn) Prepare(query string) (driver.Stmt, error) { return &stmt{c.r, query}, nil }
+func (c *conn) Close() error                              { return nil }
+func (c *conn) Begin() (driver.Tx, error)                 { return &tx{c.r}, nil }
+
+type tx struct{ r *recorder }
+
+func (t *tx) Commit() error {
+	t.r.mu.Lock()
+	defer t.r.mu.Unlock()
+	t.r.commits++
+	return nil
+}
+
+func (t *tx) Rollback() error {
+	t.r.mu.Lock()
+	defer t.r.mu.Unlock()
+	t.r.rollbacks++
+	return nil
+}
+
+type stmt struct {
+	r     *recorder
+	query string
+}
+
+func (s *stmt) Close() error  { return nil }
+func (s *stmt) NumInput() int { return -1 }
+
+func (s *stmt) Exec(args []driver.Value) (driver.Result, error) {
+	s.r.mu.Lock()
+	defer s.r.mu.Unlock()
+	if s.r.failOn != "" && strings.Contains(s.query, s.r.failOn) {
+		return nil, errors.New("disk full")
+	}
+	s.r.statements = append(s.r.statements, statement{s.query, args})
+	return driver.RowsAffected(1), nil
+}
+
+func (s *stmt) Query([]driver.Value) (driver.Rows, error) {
+	return nil, errors.New("queries are not supported")
+}
+
+func readSample(t *testing.T, name string) uslm.LegislativeDocument {
+	t.Helper()
+	data, err := os.ReadFile(filepath.Join("..", "..", "..", "..", "bill-version-samples-september-2024", name))
+	if err != nil {
+		t.Fatalf("failed to read sample: %v", err)
+	}
+	doc, err := uslm.ParseDocument(data)
+	if err != nil {
+		t.Fatalf("failed to parse sample: %v", err)
+	}
+	return doc
+}
+
+func TestCreateSchema(t *testing.T) {
+	r := &recorder{}
+	if err := NewLoader(sql.OpenDB(r), DialectSQLite).CreateSchema(context.Background()); err != nil {
+		t.Fatalf("failed to create schema: %v", err)
+	}
+	if len(r.statements) != len(Schema) {
+		t.Fatalf("executed %d statements, want %d", len(r.statements), len(Schema))
+	}
+	for i, s := range r.statements {
+		if s.query != Schema[i] {
+			t.Errorf("statement %d = %q, want %q", i, s.query, Schema[i])
+		}
+	}
+
+	r = &recorder{failOn: "CREATE INDEX"}
+	if err := NewLoader(sql.OpenDB(r), DialectSQLite).CreateSchema(context.Background()); err == nil || !strings.Contains(err.Error(), "failed to create schema") {
+		t.Errorf("expected a schema error, got %v", err)
+	}
+}
+
+func TestLoad(t *testing.T) {
+	doc := readSample(t, "BILLS-114s32cds.xml")
+	r := &recorder{}
+	if err := NewLoader(sql.OpenDB(r), DialectSQLite).Load(context.Background(), "BILLS-114s32cds", doc); err != nil {
+		t.Fatalf("failed to load: %v", err)
+	}
+	if r.commits != 1 || r.rollbacks != 0 {
+		t.Errorf("commits %d, rollbacks %d, want 1 and 0", r.commits, r.rollbacks)
+	}
+
+	// Existing rows are deleted first, children before parents.
+	for i, table := range tables {
+		column := "document_id"
+		if table == "documents" {
+			column = "id"
+		}
+		s := r.statements[i]
+		if want := "DELETE FROM " + table + " WHERE " + column + " = ?"; s.query != want || len(s.args) != 1 || s.args[0] != "BILLS-114s32cds" {
+			t.Errorf("statement %d = %q %v, want %q", i, s.query, s.args, want)
+		}
+	}
+
+	documents := r.inserts("documents")
+	if len(documents) != 1 {
+		t.Fatalf("inserted %d documents, want 1", len(documents))
+	}
+	want := []driver.Value{"BILLS-114s32cds", doc.GetDocumentType(), "32", "114", doc.GetSession(), doc.GetStage(), doc.GetChamber(), doc.GetTitle(), int64(0), strings.Join(doc.GetCitations(), "; ")}
+	if doc.IsPublic() {
+		want[8] = int64(1)
+	}
+	for i, v := range want {
+		if documents[0].args[i] != v {
+			t.Errorf("documents column %d = %#v, want %#v", i, documents[0].args[i], v)
+		}
+	}
+
+	sponsored := doc.(uslm.SponsoredDocument)
+	sponsors := r.inserts("sponsors")
+	if len(sponsors) != len(sponsored.GetSponsors())+len(sponsored.GetCosponsors()) || len(sponsors) == 0 {
+		t.Fatalf("inserted %d sponsors", len(sponsors))
+	}
+	if s := sponsors[0].args; s[1] != int64(1) || s[2] != "sponsor" || s[3] != sponsored.GetSponsors()[0].GetID() || s[4] == "" {
+		t.Errorf("unexpected sponsor row %v", s)
+	}
+	if n := len(r.inserts("actions")); n != len(doc.(uslm.ActionDocument).GetActions()) {
+		t.Errorf("inserted %d actions", n)
+	}
+
+	// Provisions are numbered in document order, each child after its
+	// parent; top-level provisions have no parent. The sample has ten
+	// outside its quoted text.
+	provisions := r.inserts("provisions")
+	if len(provisions) != 10 {
+		t.Fatalf("inserted %d provisions, want 10", len(provisions))
+	}
+	for i, p := range provisions {
+		ordinal := p.args[1].(int64)
+		if ordinal != int64(i+1) {
+			t.Errorf("provision %d has ordinal %d", i, ordinal)
+		}
+		if parent, ok := p.args[2].(int64); ok && parent >= ordinal {
+			t.Errorf("provision %d has parent %d after it", ordinal, parent)
+		}
+	}
+	if first := provisions[0].args; first[2] != nil || first[4] != "section" || first[6] != "/us/bill/114/s/32/s1" {
+		t.Errorf("unexpected first provision %v", first)
+	}
+
+	references := r.inserts("doc_references")
+	if len(references) == 0 {
+		t.Fatal("expected references to be inserted")
+	}
+	for _, ref := range references {
+		if ref.args[2] == "" {
+			t.Errorf("reference without an href: %v", ref.args)
+		}
+	}
+}
+
+func TestLoadPostgres(t *testing.T) {
+	r := &recorder{}
+	if err := NewLoader(sql.OpenDB(r), DialectPostgres).Load(context.Background(), "BILLS-114s32cds", readSample(t, "BILLS-114s32cds.xml")); err != nil {
+		t.Fatalf("failed to load: %v", err)
+	}
+	if got := r.statements[0].query; got != "DELETE FROM doc_references WHERE document_id = $1" {
+		t.Errorf("unexpected delete %q", got)
+	}
+	for _, s := range r.statements {
+		if strings.Contains(s.query, "?") {
+			t.Errorf("placeholder not rewritten in %q", s.query)
+		}
+	}
+	if got := r.inserts("sponsors")[0].query; !strings.HasSuffix(got, "VALUES ($1, $2, $3, $4, $5)") {
+		t.Errorf("unexpected sponsor insert %q", got)
+	}
+}
+
+func TestLoadRollback(t *testing.T) {
+	r := &recorder{failOn: "INSERT INTO provisions"}
+	err := NewLoader(sql.OpenDB(r), DialectSQLite).Load(context.Background(), "BILLS-114s32cds", readSample(t, "BILLS-114s32cds.xml"))
+	if err == nil || !strings.Contains(err.Error(), "failed to load document BILLS-114s32cds") {
+		t.Fatalf("expected a load error, got %v", err)
+	}
+	if r.commits != 0 || r.rollbacks != 1 {
+		t.Errorf("commits %d, rollbacks %d, want 0 and 1", r.commits, r.rollbacks)
+	}
+}
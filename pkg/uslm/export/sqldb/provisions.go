@@ -0,0 +1,49 @@
+package sqldb
+
+import (
+	"database/sql"
+	"strings"
+
+	"github.com/usgpo/uslm/pkg/uslm"
+)
+
+// provisions writes the document's provisions in document order.
+func (w *writer) provisions(doc uslm.LegislativeDocument) error {
+	for _, p := range uslm.Provisions(doc) {
+		if err := w.provision(p, sql.NullInt64{}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// provision inserts p, its references, and its descendants.
+func (w *writer) provision(p *uslm.Provision, parent sql.NullInt64) error {
+	w.ordinal++
+	ord := w.ordinal
+
+	err := w.exec(`INSERT INTO provisions (document_id, ordinal, parent_ordinal, depth, element, xml_id, identifier, num, heading, text)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+		w.id, ord, parent, p.Depth, p.Element, p.ID, p.Identifier, p.GetNum(), p.GetHeading(), p.GetText())
+	if err != nil {
+		return err
+	}
+
+	for _, r := range p.GetRefs() {
+		if r.Href == "" {
+			continue
+		}
+		err := w.exec(`INSERT INTO doc_references (document_id, provision_ordinal, href, text) VALUES (?, ?, ?, ?)`,
+			w.id, ord, r.Href, strings.TrimSpace(r.Text))
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, c := range p.Children {
+		if err := w.provision(c, sql.NullInt64{Int64: int64(ord), Valid: true}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
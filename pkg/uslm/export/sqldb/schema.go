@@ -0,0 +1,82 @@
+// Package sqldb loads parsed USLM documents into a normalized relational
+// schema through database/sql, so a corpus can be queried with SQL.
+//
+// The package does not import a driver; register one (e.g., a SQLite or
+// PostgreSQL driver) in the calling program and pass the opened *sql.DB to
+// NewLoader.
+package sqldb
+
+// Dialect selects the SQL flavor used for placeholders.
+type Dialect string
+
+const (
+	// DialectSQLite uses "?" placeholders (also suitable for MySQL).
+	DialectSQLite Dialect = "sqlite"
+
+	// DialectPostgres uses "$1"-style placeholders.
+	DialectPostgres Dialect = "postgres"
+)
+
+// Schema holds the statements that create the tables, one statement per
+// entry since not every driver accepts several statements in one Exec.
+//
+// Every table is keyed by document_id, the caller-supplied identifier passed
+// to Loader.Load (typically the package ID or file name, e.g.,
+// "BILLS-114s32cds"). Provisions are numbered in document order by ordinal;
+// parent_ordinal links each provision to its enclosing one and is NULL for
+// top-level provisions.
+var Schema = []string{
+	`CREATE TABLE IF NOT EXISTS documents (
+	id TEXT PRIMARY KEY,
+	doc_type TEXT NOT NULL,
+	doc_number TEXT NOT NULL,
+	congress TEXT,
+	session TEXT,
+	stage TEXT,
+	chamber TEXT,
+	title TEXT,
+	is_public INTEGER NOT NULL,
+	citations TEXT
+)`,
+	`CREATE TABLE IF NOT EXISTS provisions (
+	document_id TEXT NOT NULL REFERENCES documents(id),
+	ordinal INTEGER NOT NULL,
+	parent_ordinal INTEGER,
+	depth INTEGER NOT NULL,
+	element TEXT NOT NULL,
+	xml_id TEXT,
+	identifier TEXT,
+	num TEXT,
+	heading TEXT,
+	text TEXT,
+	PRIMARY KEY (document_id, ordinal)
+)`,
+	`CREATE TABLE IF NOT EXISTS sponsors (
+	document_id TEXT NOT NULL REFERENCES documents(id),
+	ordinal INTEGER NOT NULL,
+	role TEXT NOT NULL,
+	member_id TEXT,
+	name TEXT,
+	PRIMARY KEY (document_id, ordinal)
+)`,
+	`CREATE TABLE IF NOT EXISTS actions (
+	document_id TEXT NOT NULL REFERENCES documents(id),
+	ordinal INTEGER NOT NULL,
+	stage TEXT,
+	action_date TEXT,
+	description TEXT,
+	PRIMARY KEY (document_id, ordinal)
+)`,
+	`CREATE TABLE IF NOT EXISTS doc_references (
+	document_id TEXT NOT NULL REFERENCES documents(id),
+	provision_ordinal INTEGER NOT NULL,
+	href TEXT NOT NULL,
+	text TEXT
+)`,
+	`CREATE INDEX IF NOT EXISTS doc_references_href ON doc_references (href)`,
+	`CREATE INDEX IF NOT EXISTS provisions_identifier ON provisions (identifier)`,
+}
+
+// tables lists the tables holding per-document rows, children first, so that
+// a document can be replaced without violating foreign keys.
+var tables = []string{"doc_references", "actions", "sponsors", "provisions", "documents"}
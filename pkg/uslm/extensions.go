@@ -0,0 +1,219 @@
+package uslm
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"sync/atomic"
+)
+
+// ElementHandler decodes an element the model does not represent, such as a
+// local extension like <budgetAuthority>. It is called with a decoder
+// positioned after start, as for xml.Unmarshaler, and must consume the
+// element through its end, for example with d.DecodeElement(&v, &start).
+// Namespace prefixes declared on enclosing elements are in scope. The value
+// it returns is kept with the document (see Extensions); an error fails the
+// parse.
+type ElementHandler func(d *xml.Decoder, start xml.StartElement) (interface{}, error)
+
+// Extension is an element decoded by a registered ElementHandler.
+type Extension struct {
+	// Name is the element's resolved name.
+	Name xml.Name
+
+	// Path is the slash-separated path of local names from the root to the
+	// element, e.g. "bill/main/section/budgetAuthority".
+	Path string
+
+	// Identifier is the identifier of the nearest enclosing element that has
+	// one, e.g. "/us/bill/118/hr/1/s2", so extensions can be matched to
+	// provisions.
+	Identifier string
+
+	// Value is what the handler returned.
+	Value interface{}
+}
+
+var (
+	elementHandlersMu sync.Mutex
+	elementHandlers   atomic.Pointer[map[string]ElementHandler]
+)
+
+// RegisterElementHandler registers h to decode every element with the local
+// name name, or removes the handler for name when h is nil. Handlers apply
+// to every document parsed in full, by ParseDocument, DecodeDocument, the
+// type-specific parsers, and their With-options variants, in every
+// goroutine; they are typically registered from an init function. A handled element is given
+// to its handler instead of the model, so it is not reported as unknown
+// content, and it is not written back out when the document is marshaled.
+// The root element is never handled.
+func RegisterElementHandler(name string, h ElementHandler) {
+	elementHandlersMu.Lock()
+	defer elementHandlersMu.Unlock()
+	handlers := make(map[string]ElementHandler)
+	if current := elementHandlers.Load(); current != nil {
+		for k, v := range *current {
+			handlers[k] = v
+		}
+	}
+	if h == nil {
+		delete(handlers, name)
+	} else {
+		handlers[name] = h
+	}
+	if len(handlers) == 0 {
+		elementHandlers.Store(nil)
+		return
+	}
+	elementHandlers.Store(&handlers)
+}
+
+// Extensions returns the elements of doc decoded by registered handlers, in
+// document order. Extensions are recorded when a document is parsed from
+// XML; they are not kept by ToJSON or ToBinary.
+func Extensions(doc LegislativeDocument) []Extension {
+	switch d := doc.(type) {
+	case *Bill:
+		return d.extensions
+	case *Resolution:
+		return d.extensions
+	case *EngrossedAmendment:
+		return d.extensions
+	case *Amendment:
+		return d.extensions
+	case *GenericDocument:
+		return d.extensions
+	}
+	return nil
+}
+
+// setExtensions stores the decoded extensions on doc.
+func setExtensions(doc interface{}, ext *extensionRecorder) {
+	if ext == nil || len(ext.found) == 0 {
+		return
+	}
+	switch d := doc.(type) {
+	case *Bill:
+		d.extensions = ext.found
+	case *Resolution:
+		d.extensions = ext.found
+	case *EngrossedAmendment:
+		d.extensions = ext.found
+	case *Amendment:
+		d.extensions = ext.found
+	case *GenericDocument:
+		d.extensions = ext.found
+	}
+}
+
+// extensionRecorder collects the extensions of one parse.
+type extensionRecorder struct {
+	handlers map[string]ElementHandler
+	found    []Extension
+}
+
+// newExtensionRecorder returns a recorder for the handlers registered now,
+// or nil if there are none.
+func newExtensionRecorder() *extensionRecorder {
+	handlers := elementHandlers.Load()
+	if handlers == nil {
+		return nil
+	}
+	return &extensionRecorder{handlers: *handlers}
+}
+
+// elementScope is what guardedTokens tracks of an open element for
+// handlers: its name, the namespaces it declares, and the identifier in
+// effect within it.
+type elementScope struct {
+	name       string
+	decls      []xml.Attr
+	identifier string
+}
+
+// pushScope records a start element for handlers.
+func (g *guardedTokens) pushScope(t xml.StartElement) {
+	s := elementScope{name: t.Name.Local}
+	if n := len(g.scopes); n > 0 {
+		s.identifier = g.scopes[n-1].identifier
+	}
+	for _, a := range t.Attr {
+		switch {
+		case a.Name.Space == "xmlns", a.Name.Space == "" && a.Name.Local == "xmlns":
+			s.decls = append(s.decls, a)
+		case a.Name.Space == "" && a.Name.Local == "identifier":
+			s.identifier = a.Value
+		}
+	}
+	g.scopes = append(g.scopes, s)
+}
+
+// handle reads the element opened by start, with the tokens of its content,
+// and passes it to h, recording the value returned.
+func (g *guardedTokens) handle(h ElementHandler, start xml.StartElement) error {
+	// Declarations in scope are copied onto the start element, so the
+	// handler's decoder resolves the prefixes declared by its ancestors.
+	var attrs []xml.Attr
+	for _, s := range g.scopes[:len(g.scopes)-1] {
+		attrs = append(attrs, s.decls...)
+	}
+	first := start.Copy()
+	first.Attr = append(attrs, first.Attr...)
+	toks := []xml.Token{first}
+	scope := g.scopes[len(g.scopes)-1]
+	path := make([]string, len(g.scopes))
+	for i, s := range g.scopes {
+		path[i] = s.name
+	}
+
+	g.capturing = true
+	for depth := 1; depth > 0; {
+		tok, err := g.next()
+		if err != nil {
+			g.capturing = false
+			return err
+		}
+		switch tok.(type) {
+		case xml.StartElement:
+			depth++
+		case xml.EndElement:
+			depth--
+		}
+		toks = append(toks, xml.CopyToken(tok))
+	}
+	g.capturing = false
+
+	d := xml.NewTokenDecoder(&tokenSlice{toks: toks})
+	tok, err := d.Token()
+	if err != nil {
+		return fmt.Errorf("failed to handle <%s>: %w", start.Name.Local, err)
+	}
+	resolved := tok.(xml.StartElement)
+	value, err := h(d, resolved)
+	if err != nil {
+		return fmt.Errorf("failed to handle <%s>: %w", start.Name.Local, err)
+	}
+	g.extensions.found = append(g.extensions.found, Extension{
+		Name:       resolved.Name,
+		Path:       strings.Join(path, "/"),
+		Identifier: scope.identifier,
+		Value:      value,
+	})
+	return nil
+}
+
+// tokenSlice is an xml.TokenReader over buffered tokens.
+type tokenSlice struct {
+	toks []xml.Token
+}
+
+func (t *tokenSlice) Token() (xml.Token, error) {
+	if len(t.toks) == 0 {
+		return nil, io.EOF
+	}
+	tok := t.toks[0]
+	t.toks = t.toks[1:]
+	return tok, nil
+}
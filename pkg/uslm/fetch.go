@@ -0,0 +1,94 @@
+package uslm
+
+import (
+	"bufio"
+	"compress/flate"
+	"compress/gzip"
+	"compress/zlib"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+// DefaultMaxDownloadBytes is the largest decompressed document
+// ParseDocumentFromURL accepts. The largest omnibus bills are well under it.
+const DefaultMaxDownloadBytes = DefaultMaxBytes
+
+// ParseDocumentFromURL fetches url with client (http.DefaultClient if nil)
+// and parses the response as it streams in. Bodies compressed with gzip or
+// deflate are decompressed whether the server signals it with
+// Content-Encoding or simply serves a .gz file. The decompressed document is
+// parsed under DefaultLimits, so one larger than DefaultMaxDownloadBytes is
+// rejected with ErrLimitExceeded, and a 404 response is reported with an
+// error wrapping ErrNotFound.
+func ParseDocumentFromURL(ctx context.Context, url string, client *http.Client) (LegislativeDocument, error) {
+	ctx, span := StartSpan(ctx, "uslm.ParseDocumentFromURL", SpanAttribute{Key: "url.full", Value: url})
+	run := beginParse("ParseDocumentFromURL")
+	doc, err := fetchDocument(ctx, url, client, run)
+	run.end(err)
+	EndSpan(span, doc, err)
+	return doc, err
+}
+
+// fetchDocument implements ParseDocumentFromURL, reporting its phases to run.
+func fetchDocument(ctx context.Context, url string, client *http.Client, run *parseRun) (LegislativeDocument, error) {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	// Setting Accept-Encoding ourselves disables the transport's own gzip
+	// handling, so both encodings are handled uniformly below.
+	req.Header.Set("Accept-Encoding", "gzip, deflate")
+	req.Header.Set("Accept", "application/xml, text/xml;q=0.9, */*;q=0.1")
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch %s: %w", url, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, fmt.Errorf("failed to fetch %s: %s: %w", url, resp.Status, ErrNotFound)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to fetch %s: %s", url, resp.Status)
+	}
+
+	body, err := decompressBody(resp.Body, resp.Header.Get("Content-Encoding"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decompress %s: %w", url, err)
+	}
+	defer body.Close()
+	run.phase(PhaseFetch)
+
+	doc, err := decodeDocument(body, ParseOptions{Limits: DefaultLimits}, run)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", url, err)
+	}
+	return doc, nil
+}
+
+// decompressBody wraps r according to the Content-Encoding, falling back to
+// sniffing the gzip magic number for compressed files served as-is.
+func decompressBody(r io.Reader, encoding string) (io.ReadCloser, error) {
+	br := bufio.NewReader(r)
+	switch strings.ToLower(strings.TrimSpace(encoding)) {
+	case "gzip", "x-gzip":
+		return gzip.NewReader(br)
+	case "deflate":
+		// "deflate" is meant to be zlib-wrapped, but some servers send raw
+		// DEFLATE data.
+		if header, err := br.Peek(2); err == nil && header[0]&0x0f == 8 && (uint16(header[0])<<8|uint16(header[1]))%31 == 0 {
+			return zlib.NewReader(br)
+		}
+		return flate.NewReader(br), nil
+	}
+	if magic, err := br.Peek(2); err == nil && magic[0] == 0x1f && magic[1] == 0x8b {
+		return gzip.NewReader(br)
+	}
+	return io.NopCloser(br), nil
+}
@@ -0,0 +1,62 @@
+package uslm
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"hash"
+)
+
+// Fingerprint returns a stable hex-encoded SHA-256 over the document's
+// identifying metadata (type, number, congress, session, stage, chamber, and
+// title) and the structure and normalized text of its hierarchical levels.
+//
+// Processing metadata (processedBy, processedDate), generated element ids,
+// and typographic variants are ignored, so two renderings of the same version
+// of a bill fingerprint identically while any change to its text, numbering,
+// or nesting does not. Use ContentHash to compare raw bytes instead.
+func Fingerprint(doc LegislativeDocument) string {
+	h := sha256.New()
+	opts := DefaultNormalizeOptions()
+
+	writeField(h, doc.GetDocumentType())
+	writeField(h, doc.GetDocumentNumber())
+	writeField(h, doc.GetCongress())
+	writeField(h, doc.GetSession())
+	writeField(h, doc.GetStage())
+	writeField(h, doc.GetChamber())
+	writeField(h, NormalizeText(doc.GetTitle(), opts))
+
+	walkDocumentLevels(doc, func(l *level) bool {
+		var num, heading, chapeau, content string
+		if l.num != nil {
+			num = l.num.Value
+		}
+		if l.heading != nil {
+			heading = l.heading.PlainText()
+		}
+		if l.chapeau != nil {
+			chapeau = l.chapeau.PlainText()
+		}
+		if l.content != nil {
+			content = l.content.PlainText()
+		}
+		writeField(h, l.element)
+		binary.Write(h, binary.BigEndian, int32(l.depth))
+		writeField(h, num)
+		writeField(h, *l.identifier)
+		writeField(h, NormalizeText(heading, opts))
+		writeField(h, NormalizeText(chapeau, opts))
+		writeField(h, NormalizeText(content, opts))
+		return true
+	})
+
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// writeField writes s length-prefixed so that adjacent fields cannot run
+// together ambiguously.
+func writeField(h hash.Hash, s string) {
+	binary.Write(h, binary.BigEndian, uint32(len(s)))
+	h.Write([]byte(s))
+}
@@ -0,0 +1,283 @@
+package gql
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/usgpo/uslm/pkg/uslm"
+)
+
+// Resolver is the root resolver for the Query type.
+type Resolver struct {
+	store uslm.Store
+}
+
+// NewResolver returns a root resolver serving documents from store.
+func NewResolver(store uslm.Store) *Resolver {
+	return &Resolver{store: store}
+}
+
+// Document resolves Query.document.
+func (r *Resolver) Document(ctx context.Context, args struct{ ID string }) (*DocumentResolver, error) {
+	doc, err := r.store.Get(ctx, args.ID)
+	if errors.Is(err, uslm.ErrNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to load document %s: %w", args.ID, err)
+	}
+	return &DocumentResolver{id: args.ID, doc: doc}, nil
+}
+
+// Documents resolves Query.documents.
+func (r *Resolver) Documents(ctx context.Context, args struct {
+	Congress *string
+	Type     *string
+}) ([]*DocumentResolver, error) {
+	ids, err := r.store.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list documents: %w", err)
+	}
+	var docs []*DocumentResolver
+	for _, id := range ids {
+		doc, err := r.store.Get(ctx, id)
+		if errors.Is(err, uslm.ErrNotFound) {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to load document %s: %w", id, err)
+		}
+		if args.Congress != nil && doc.GetCongress() != *args.Congress {
+			continue
+		}
+		if args.Type != nil && doc.GetDocumentType() != *args.Type {
+			continue
+		}
+		docs = append(docs, &DocumentResolver{id: id, doc: doc})
+	}
+	return docs, nil
+}
+
+// DocumentResolver resolves the Document type.
+type DocumentResolver struct {
+	id  string
+	doc uslm.LegislativeDocument
+}
+
+// ID resolves Document.id.
+func (d *DocumentResolver) ID() string { return d.id }
+
+// Type resolves Document.type.
+func (d *DocumentResolver) Type() string { return d.doc.GetDocumentType() }
+
+// Number resolves Document.number.
+func (d *DocumentResolver) Number() string { return d.doc.GetDocumentNumber() }
+
+// Congress resolves Document.congress.
+func (d *DocumentResolver) Congress() string { return d.doc.GetCongress() }
+
+// Session resolves Document.session.
+func (d *DocumentResolver) Session() string { return d.doc.GetSession() }
+
+// Title resolves Document.title.
+func (d *DocumentResolver) Title() string { return d.doc.GetTitle() }
+
+// Stage resolves Document.stage.
+func (d *DocumentResolver) Stage() string { return d.doc.GetStage() }
+
+// Chamber resolves Document.chamber.
+func (d *DocumentResolver) Chamber() string { return d.doc.GetChamber() }
+
+// IsPublic resolves Document.isPublic.
+func (d *DocumentResolver) IsPublic() bool { return d.doc.IsPublic() }
+
+// Citations resolves Document.citations.
+func (d *DocumentResolver) Citations() []string {
+	if c := d.doc.GetCitations(); c != nil {
+		return c
+	}
+	return []string{}
+}
+
+// Sponsors resolves Document.sponsors.
+func (d *DocumentResolver) Sponsors() []*MemberResolver {
+	members := []*MemberResolver{}
+	if s, ok := d.doc.(uslm.SponsoredDocument); ok {
+		for _, sp := range s.GetSponsors() {
+			members = append(members, &MemberResolver{id: sp.GetID(), name: memberName(sp.Text, sp.Inline)})
+		}
+	}
+	return members
+}
+
+// Cosponsors resolves Document.cosponsors.
+func (d *DocumentResolver) Cosponsors() []*MemberResolver {
+	members := []*MemberResolver{}
+	if s, ok := d.doc.(uslm.SponsoredDocument); ok {
+		for _, c := range s.GetCosponsors() {
+			members = append(members, &MemberResolver{id: c.GetID(), name: memberName(c.Text, c.Inline)})
+		}
+	}
+	return members
+}
+
+// Committees resolves Document.committees.
+func (d *DocumentResolver) Committees() []*CommitteeResolver {
+	committees := []*CommitteeResolver{}
+	if c, ok := d.doc.(uslm.CommitteeDocument); ok {
+		for _, cm := range c.GetCommittees() {
+			committees = append(committees, &CommitteeResolver{id: cm.GetID(), name: strings.TrimSpace(cm.GetName())})
+		}
+	}
+	return committees
+}
+
+// Actions resolves Document.actions.
+func (d *DocumentResolver) Actions() []*ActionResolver {
+	actions := []*ActionResolver{}
+	if a, ok := d.doc.(uslm.ActionDocument); ok {
+		for _, action := range a.GetActions() {
+			actions = append(actions, &ActionResolver{action: action})
+		}
+	}
+	return actions
+}
+
+// Provisions resolves Document.provisions.
+func (d *DocumentResolver) Provisions() []*ProvisionResolver {
+	return provisionResolvers(uslm.Provisions(d.doc))
+}
+
+// Provision resolves Document.provision.
+func (d *DocumentResolver) Provision(args struct{ Identifier string }) *ProvisionResolver {
+	var found *uslm.Provision
+	for _, top := range uslm.Provisions(d.doc) {
+		top.Walk(func(p *uslm.Provision) bool {
+			if found == nil && p.Identifier == args.Identifier {
+				found = p
+			}
+			return found == nil
+		})
+	}
+	if found == nil {
+		return nil
+	}
+	return &ProvisionResolver{p: found}
+}
+
+// MemberResolver resolves the Member type.
+type MemberResolver struct {
+	id, name string
+}
+
+// ID resolves Member.id.
+func (m *MemberResolver) ID() string { return m.id }
+
+// Name resolves Member.name.
+func (m *MemberResolver) Name() string { return m.name }
+
+// CommitteeResolver resolves the Committee type.
+type CommitteeResolver struct {
+	id, name string
+}
+
+// ID resolves Committee.id.
+func (c *CommitteeResolver) ID() string { return c.id }
+
+// Name resolves Committee.name.
+func (c *CommitteeResolver) Name() string { return c.name }
+
+// ActionResolver resolves the Action type.
+type ActionResolver struct {
+	action uslm.Action
+}
+
+// Date resolves Action.date.
+func (a *ActionResolver) Date() string {
+	if a.action.Date != nil {
+		return a.action.Date.Date
+	}
+	return ""
+}
+
+// Stage resolves Action.stage.
+func (a *ActionResolver) Stage() string { return a.action.ActionStage }
+
+// Description resolves Action.description.
+func (a *ActionResolver) Description() string {
+	if a.action.ActionDescription != nil {
+		return strings.TrimSpace(a.action.ActionDescription.Text)
+	}
+	return ""
+}
+
+// ProvisionResolver resolves the Provision type.
+type ProvisionResolver struct {
+	p *uslm.Provision
+}
+
+// Element resolves Provision.element.
+func (r *ProvisionResolver) Element() string { return r.p.Element }
+
+// ID resolves Provision.id.
+func (r *ProvisionResolver) ID() string { return r.p.ID }
+
+// Identifier resolves Provision.identifier.
+func (r *ProvisionResolver) Identifier() string { return r.p.Identifier }
+
+// Num resolves Provision.num.
+func (r *ProvisionResolver) Num() string { return r.p.GetNum() }
+
+// Heading resolves Provision.heading.
+func (r *ProvisionResolver) Heading() string { return r.p.GetHeading() }
+
+// Text resolves Provision.text.
+func (r *ProvisionResolver) Text() string { return r.p.GetText() }
+
+// Depth resolves Provision.depth.
+func (r *ProvisionResolver) Depth() int32 { return int32(r.p.Depth) }
+
+// Refs resolves Provision.refs.
+func (r *ProvisionResolver) Refs() []*RefResolver {
+	refs := []*RefResolver{}
+	for _, ref := range r.p.GetRefs() {
+		refs = append(refs, &RefResolver{href: ref.Href, text: strings.TrimSpace(ref.Text)})
+	}
+	return refs
+}
+
+// Children resolves Provision.children.
+func (r *ProvisionResolver) Children() []*ProvisionResolver {
+	return provisionResolvers(r.p.Children)
+}
+
+// RefResolver resolves the Ref type.
+type RefResolver struct {
+	href, text string
+}
+
+// Href resolves Ref.href.
+func (r *RefResolver) Href() string { return r.href }
+
+// Text resolves Ref.text.
+func (r *RefResolver) Text() string { return r.text }
+
+func provisionResolvers(provisions []*uslm.Provision) []*ProvisionResolver {
+	resolvers := make([]*ProvisionResolver, 0, len(provisions))
+	for _, p := range provisions {
+		resolvers = append(resolvers, &ProvisionResolver{p: p})
+	}
+	return resolvers
+}
+
+// memberName joins a sponsor's text with its inline runs, which is where GPO
+// puts the small-caps surname.
+func memberName(text string, inline []uslm.Inline) string {
+	parts := []string{text}
+	for _, i := range inline {
+		parts = append(parts, i.Text)
+	}
+	return strings.Join(strings.Fields(strings.Join(parts, " ")), " ")
+}
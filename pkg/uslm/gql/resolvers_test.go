@@ -0,0 +1,219 @@
+package gql
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/usgpo/uslm/pkg/uslm"
+)
+
+// sampleResolver resolves over every sample, each stored under its file
+// name without the extension.
+func sampleResolver(t *testing.T) *Resolver {
+	t.Helper()
+	dir := filepath.Join("..", "..", "..", "bill-version-samples-september-2024")
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		t.Fatalf("failed to read samples: %v", err)
+	}
+	store := uslm.NewMemoryStore()
+	for _, e := range entries {
+		ext := filepath.Ext(e.Name())
+		if !strings.EqualFold(ext, ".xml") {
+			continue
+		}
+		data, err := os.ReadFile(filepath.Join(dir, e.Name()))
+		if err != nil {
+			t.Fatalf("failed to read %s: %v", e.Name(), err)
+		}
+		doc, err := uslm.ParseDocument(data)
+		if err != nil {
+			t.Fatalf("failed to parse %s: %v", e.Name(), err)
+		}
+		store.Put(context.Background(), strings.TrimSuffix(e.Name(), ext), doc)
+	}
+	return NewResolver(store)
+}
+
+func TestDocumentResolver(t *testing.T) {
+	ctx := context.Background()
+	r := sampleResolver(t)
+
+	d, err := r.Document(ctx, struct{ ID string }{"BILLS-114s32cds"})
+	if err != nil || d == nil {
+		t.Fatalf("failed to resolve document: %v", err)
+	}
+	for _, f := range []struct{ field, got, want string }{
+		{"id", d.ID(), "BILLS-114s32cds"},
+		{"type", d.Type(), "Senate Bill"},
+		{"number", d.Number(), "32"},
+		{"congress", d.Congress(), "114"},
+		{"session", d.Session(), "1"},
+		{"stage", d.Stage(), "Committee Discharged Senate"},
+		{"chamber", d.Chamber(), "SENATE"},
+	} {
+		if f.got != f.want {
+			t.Errorf("Document.%s = %q, want %q", f.field, f.got, f.want)
+		}
+	}
+	if !strings.HasPrefix(d.Title(), "114 S 32 CDS: To provide the Department of Justice") {
+		t.Errorf("unexpected title %q", d.Title())
+	}
+	if !d.IsPublic() || len(d.Citations()) == 0 {
+		t.Errorf("isPublic %v, citations %v", d.IsPublic(), d.Citations())
+	}
+
+	sponsors := d.Sponsors()
+	if len(sponsors) != 1 || sponsors[0].ID() != "S221" || sponsors[0].Name() != "Mrs. Feinstein" {
+		t.Errorf("unexpected sponsors %+v", sponsors)
+	}
+	if n := len(d.Cosponsors()); n != 5 {
+		t.Errorf("resolved %d cosponsors, want 5", n)
+	}
+	committees := d.Committees()
+	if len(committees) != 2 || committees[0].ID() != "SSFI00" || committees[0].Name() != "Committee on Finance" || committees[1].Name() != "Committee on the Judiciary" {
+		t.Errorf("unexpected committees %+v", committees)
+	}
+	actions := d.Actions()
+	if len(actions) != 2 || actions[0].Date() != "2015-01-06" || actions[1].Date() != "2015-01-13" || !strings.HasPrefix(actions[1].Description(), "Committee discharged") {
+		t.Errorf("unexpected actions %+v", actions)
+	}
+
+	provisions := d.Provisions()
+	if len(provisions) != 3 {
+		t.Fatalf("resolved %d top-level provisions, want 3", len(provisions))
+	}
+	s2 := provisions[1]
+	if s2.Element() != "section" || s2.Identifier() != "/us/bill/114/s/32/s2" || strings.TrimSpace(s2.Num()) != "SEC. 2." || s2.Depth() != 0 || len(s2.Children()) != 2 {
+		t.Errorf("unexpected section 2: %s %s %q depth %d, %d children", s2.Element(), s2.Identifier(), s2.Num(), s2.Depth(), len(s2.Children()))
+	}
+	if !strings.Contains(s2.Heading(), "POSSESSION, MANUFACTURE OR DISTRIBUTION") {
+		t.Errorf("unexpected heading %q", s2.Heading())
+	}
+	if child := s2.Children()[0]; child.Depth() != 1 || child.Identifier() != "/us/bill/114/s/32/s2/1" {
+		t.Errorf("unexpected first child %s depth %d", child.Identifier(), child.Depth())
+	}
+
+	p := d.Provision(struct{ Identifier string }{"/us/bill/114/s/32/s2/1"})
+	if p == nil {
+		t.Fatal("expected to find paragraph (1) of section 2")
+	}
+	if p.Element() != "paragraph" || strings.TrimSpace(p.Num()) != "(1)" || !strings.Contains(p.Text(), "by redesignating subsections (b) and (c)") {
+		t.Errorf("unexpected paragraph %s %q %q", p.Element(), p.Num(), p.Text())
+	}
+	refs := s2.Refs()
+	if len(refs) == 0 {
+		t.Fatal("expected section 2 to have references")
+	}
+	for _, ref := range refs {
+		if ref.Href() == "" || ref.Text() != strings.TrimSpace(ref.Text()) {
+			t.Errorf("unexpected ref %q %q", ref.Href(), ref.Text())
+		}
+	}
+	if d.Provision(struct{ Identifier string }{"/us/bill/114/s/32/s9"}) != nil {
+		t.Error("expected no provision for a missing identifier")
+	}
+
+	if missing, err := r.Document(ctx, struct{ ID string }{"BILLS-missing"}); missing != nil || err != nil {
+		t.Errorf("expected null for a missing document, got %v, %v", missing, err)
+	}
+}
+
+func TestDocumentsResolver(t *testing.T) {
+	ctx := context.Background()
+	r := sampleResolver(t)
+	type args = struct {
+		Congress *string
+		Type     *string
+	}
+	congress, docType := "114", "Senate Bill"
+
+	all, err := r.Documents(ctx, args{})
+	if err != nil {
+		t.Fatalf("failed to resolve documents: %v", err)
+	}
+	if len(all) != 75 {
+		t.Errorf("resolved %d documents, want all 75 samples", len(all))
+	}
+	in114, err := r.Documents(ctx, args{Congress: &congress})
+	if err != nil {
+		t.Fatalf("failed to resolve documents: %v", err)
+	}
+	var ids []string
+	for _, d := range in114 {
+		ids = append(ids, d.ID())
+	}
+	if got := strings.Join(ids, ","); got != "BILLS-114hres99eh,BILLS-114s32cds" {
+		t.Errorf("documents(congress: 114) = %s", got)
+	}
+	bills, err := r.Documents(ctx, args{Congress: &congress, Type: &docType})
+	if err != nil || len(bills) != 1 || bills[0].ID() != "BILLS-114s32cds" {
+		t.Errorf("documents(congress: 114, type: Senate Bill) = %v, %v", bills, err)
+	}
+}
+
+// failingStore is a Store whose reads fail.
+type failingStore struct{ uslm.Store }
+
+func (failingStore) Get(context.Context, string) (uslm.LegislativeDocument, error) {
+	return nil, errors.New("disk on fire")
+}
+
+func (failingStore) List(context.Context) ([]string, error) {
+	return []string{"BILLS-114s32cds"}, nil
+}
+
+func TestResolverErrors(t *testing.T) {
+	r := NewResolver(failingStore{})
+	if _, err := r.Document(context.Background(), struct{ ID string }{"BILLS-114s32cds"}); err == nil {
+		t.Error("expected a store error from document")
+	}
+	if _, err := r.Documents(context.Background(), struct {
+		Congress *string
+		Type     *string
+	}{}); err == nil {
+		t.Error("expected a store error from documents")
+	}
+}
+
+// TestSchemaResolvers checks that every field of every type in Schema has a
+// resolver method, as graphql-go requires when parsing the schema.
+func TestSchemaResolvers(t *testing.T) {
+	resolvers := map[string]reflect.Type{
+		"Query":     reflect.TypeOf(&Resolver{}),
+		"Document":  reflect.TypeOf(&DocumentResolver{}),
+		"Member":    reflect.TypeOf(&MemberResolver{}),
+		"Committee": reflect.TypeOf(&CommitteeResolver{}),
+		"Action":    reflect.TypeOf(&ActionResolver{}),
+		"Provision": reflect.TypeOf(&ProvisionResolver{}),
+		"Ref":       reflect.TypeOf(&RefResolver{}),
+	}
+	typePattern := regexp.MustCompile(`(?s)type (\w+) \{(.*?)\}`)
+	fieldPattern := regexp.MustCompile(`(?m)^\s*(\w+)[(:]`)
+	types := typePattern.FindAllStringSubmatch(Schema, -1)
+	if len(types) != len(resolvers) {
+		t.Fatalf("schema has %d types, want %d", len(types), len(resolvers))
+	}
+	for _, m := range types {
+		rt, ok := resolvers[m[1]]
+		if !ok {
+			t.Errorf("no resolver for type %s", m[1])
+			continue
+		}
+		for _, f := range fieldPattern.FindAllStringSubmatch(m[2], -1) {
+			method := strings.ToUpper(f[1][:1]) + f[1][1:]
+			if f[1] == "id" {
+				method = "ID"
+			}
+			if _, ok := rt.MethodByName(method); !ok {
+				t.Errorf("%s.%s has no resolver method %s", m[1], f[1], method)
+			}
+		}
+	}
+}
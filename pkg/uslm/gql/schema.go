@@ -0,0 +1,78 @@
+// Package gql provides a GraphQL schema for the USLM document model and the
+// resolvers that serve it from parsed documents.
+//
+// The package does not depend on a GraphQL server library. Resolver methods
+// follow the conventions of github.com/graph-gophers/graphql-go (one method
+// per field, arguments passed as a struct), so a server can be stood up with:
+//
+//	schema := graphql.MustParseSchema(gql.Schema, gql.NewResolver(uslm.NewDirStore(dir)))
+//	http.Handle("/graphql", &relay.Handler{Schema: schema})
+package gql
+
+// Schema is the GraphQL schema definition served by Resolver.
+const Schema = `
+schema {
+	query: Query
+}
+
+type Query {
+	# Returns the document loaded under id, or null if there is none.
+	document(id: String!): Document
+	# Lists documents, optionally filtered by congress and document type.
+	documents(congress: String, type: String): [Document!]!
+}
+
+type Document {
+	id: String!
+	type: String!
+	number: String!
+	congress: String!
+	session: String!
+	title: String!
+	stage: String!
+	chamber: String!
+	isPublic: Boolean!
+	citations: [String!]!
+	sponsors: [Member!]!
+	cosponsors: [Member!]!
+	committees: [Committee!]!
+	actions: [Action!]!
+	# Top-level provisions (titles and sections) in document order.
+	provisions: [Provision!]!
+	# Finds a provision anywhere in the document by its identifier.
+	provision(identifier: String!): Provision
+}
+
+type Member {
+	id: String!
+	name: String!
+}
+
+type Committee {
+	id: String!
+	name: String!
+}
+
+type Action {
+	date: String!
+	stage: String!
+	description: String!
+}
+
+type Provision {
+	element: String!
+	id: String!
+	identifier: String!
+	num: String!
+	heading: String!
+	text: String!
+	depth: Int!
+	refs: [Ref!]!
+	children: [Provision!]!
+}
+
+type Ref {
+	href: String!
+	text: String!
+}
+`
@@ -0,0 +1,270 @@
+package uslm
+
+import (
+	"bufio"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// GraphNodeKind classifies a node of a ReferenceGraph.
+type GraphNodeKind string
+
+const (
+	// GraphNodeProvision is a provision of the document itself.
+	GraphNodeProvision GraphNodeKind = "provision"
+
+	// GraphNodeUSC is a U.S. Code location referenced by the document.
+	GraphNodeUSC GraphNodeKind = "usc"
+)
+
+// GraphNode is a provision or U.S. Code location in a ReferenceGraph.
+type GraphNode struct {
+	// ID is the provision's identifier (or "#" followed by its id when it
+	// has none), or the normalized U.S. Code href (e.g., "/us/usc/t42/s1395w").
+	ID    string        `json:"id"`
+	Label string        `json:"label"`
+	Kind  GraphNodeKind `json:"kind"`
+}
+
+// GraphEdge records that the From provision references To.
+type GraphEdge struct {
+	From string `json:"from"`
+	To   string `json:"to"`
+
+	// Count is the number of references from From to To.
+	Count int `json:"count"`
+}
+
+// ReferenceGraph is a directed graph of the references between a document's
+// provisions and from its provisions to the U.S. Code. Only provisions that
+// reference or are referenced appear.
+type ReferenceGraph struct {
+	Nodes []GraphNode `json:"nodes"`
+	Edges []GraphEdge `json:"edges"`
+}
+
+var (
+	// legacyUSCPattern matches the older "usc/26/4192" and
+	// "usc-chapter/26/32" href forms.
+	legacyUSCPattern = regexp.MustCompile(`^usc(-chapter)?/(\w+)/([\w.\-]+)$`)
+
+	// uscPathPattern splits "/us/usc/t42/s1395w/a" into title, kind, and number.
+	uscPathPattern = regexp.MustCompile(`^/us/usc/t(\w+)(?:/(s|ch)([\w.\-]+))?`)
+)
+
+// BuildReferenceGraph returns the graph of references made by the document's
+// provisions. Each reference is attributed to the lowest level containing it.
+// A reference to the document itself is linked to the provision with the
+// longest identifier that is a prefix of the href; references to anything
+// other than the document and the U.S. Code are left out.
+func BuildReferenceGraph(doc LegislativeDocument) *ReferenceGraph {
+	var all []*Provision
+	byIdentifier := make(map[string]*Provision)
+	for _, top := range Provisions(doc) {
+		top.Walk(func(p *Provision) bool {
+			all = append(all, p)
+			if p.Identifier != "" {
+				byIdentifier[p.Identifier] = p
+			}
+			return true
+		})
+	}
+
+	g := &ReferenceGraph{}
+	nodes := make(map[string]bool)
+	edges := make(map[[2]string]int)
+	addNode := func(n GraphNode) {
+		if !nodes[n.ID] {
+			nodes[n.ID] = true
+			g.Nodes = append(g.Nodes, n)
+		}
+	}
+	addEdge := func(from, to string) {
+		key := [2]string{from, to}
+		if i, ok := edges[key]; ok {
+			g.Edges[i].Count++
+			return
+		}
+		edges[key] = len(g.Edges)
+		g.Edges = append(g.Edges, GraphEdge{From: from, To: to, Count: 1})
+	}
+
+	for _, p := range all {
+		for _, ref := range p.GetRefs() {
+			var target GraphNode
+			if href := normalizeUSCHref(ref.Href); href != "" {
+				target = GraphNode{ID: href, Label: uscLabel(href), Kind: GraphNodeUSC}
+			} else if q := provisionForHref(byIdentifier, ref.Href); q != nil {
+				target = provisionNode(q)
+			} else {
+				continue
+			}
+			source := provisionNode(p)
+			addNode(source)
+			addNode(target)
+			addEdge(source.ID, target.ID)
+		}
+	}
+	return g
+}
+
+// provisionNode returns the graph node for p.
+func provisionNode(p *Provision) GraphNode {
+	id := p.Identifier
+	if id == "" {
+		id = "#" + p.ID
+	}
+	return GraphNode{ID: id, Label: p.PathString(), Kind: GraphNodeProvision}
+}
+
+// provisionForHref returns the provision whose identifier is the longest
+// prefix of href, on segment boundaries.
+func provisionForHref(byIdentifier map[string]*Provision, href string) *Provision {
+	href = strings.TrimSuffix(href, "/")
+	for href != "" {
+		if p, ok := byIdentifier[href]; ok {
+			return p
+		}
+		i := strings.LastIndex(href, "/")
+		if i <= 0 {
+			return nil
+		}
+		href = href[:i]
+	}
+	return nil
+}
+
+// normalizeUSCHref returns href in "/us/usc/..." form, or an empty string if
+// it is not a U.S. Code reference.
+func normalizeUSCHref(href string) string {
+	href = strings.TrimSpace(href)
+	if strings.HasPrefix(href, "/us/usc/") {
+		return strings.TrimSuffix(href, "/")
+	}
+	m := legacyUSCPattern.FindStringSubmatch(href)
+	if m == nil {
+		return ""
+	}
+	if m[1] != "" {
+		return "/us/usc/t" + m[2] + "/ch" + m[3]
+	}
+	return "/us/usc/t" + m[2] + "/s" + m[3]
+}
+
+// uscLabel returns a citation-style label for a normalized U.S. Code href
+// (e.g., "42 U.S.C. 1395w", "26 U.S.C. ch. 32", or "Title 44, U.S.C.").
+func uscLabel(href string) string {
+	m := uscPathPattern.FindStringSubmatch(href)
+	switch {
+	case m == nil:
+		return href
+	case m[2] == "s":
+		return m[1] + " U.S.C. " + m[3]
+	case m[2] == "ch":
+		return m[1] + " U.S.C. ch. " + m[3]
+	default:
+		return "Title " + m[1] + ", U.S.C."
+	}
+}
+
+// WriteDOT writes the graph in Graphviz DOT format. Provisions are drawn as
+// boxes and U.S. Code locations as ellipses.
+func (g *ReferenceGraph) WriteDOT(w io.Writer) error {
+	bw := bufio.NewWriter(w)
+	bw.WriteString("digraph references {\n\trankdir=LR;\n")
+	for _, n := range g.Nodes {
+		shape := "box"
+		if n.Kind == GraphNodeUSC {
+			shape = "ellipse"
+		}
+		fmt.Fprintf(bw, "\t%s [label=%s, shape=%s];\n", strconv.Quote(n.ID), strconv.Quote(n.Label), shape)
+	}
+	for _, e := range g.Edges {
+		if e.Count > 1 {
+			fmt.Fprintf(bw, "\t%s -> %s [label=%d];\n", strconv.Quote(e.From), strconv.Quote(e.To), e.Count)
+		} else {
+			fmt.Fprintf(bw, "\t%s -> %s;\n", strconv.Quote(e.From), strconv.Quote(e.To))
+		}
+	}
+	bw.WriteString("}\n")
+	if err := bw.Flush(); err != nil {
+		return fmt.Errorf("failed to write DOT: %w", err)
+	}
+	return nil
+}
+
+// graphML is the GraphML document written by WriteGraphML.
+type graphML struct {
+	XMLName xml.Name     `xml:"http://graphml.graphdrawing.org/xmlns graphml"`
+	Keys    []graphMLKey `xml:"key"`
+	Graph   struct {
+		ID          string        `xml:"id,attr"`
+		EdgeDefault string        `xml:"edgedefault,attr"`
+		Nodes       []graphMLNode `xml:"node"`
+		Edges       []graphMLEdge `xml:"edge"`
+	} `xml:"graph"`
+}
+
+type graphMLKey struct {
+	ID   string `xml:"id,attr"`
+	For  string `xml:"for,attr"`
+	Name string `xml:"attr.name,attr"`
+	Type string `xml:"attr.type,attr"`
+}
+
+type graphMLData struct {
+	Key   string `xml:"key,attr"`
+	Value string `xml:",chardata"`
+}
+
+type graphMLNode struct {
+	ID   string        `xml:"id,attr"`
+	Data []graphMLData `xml:"data"`
+}
+
+type graphMLEdge struct {
+	Source string        `xml:"source,attr"`
+	Target string        `xml:"target,attr"`
+	Data   []graphMLData `xml:"data"`
+}
+
+// WriteGraphML writes the graph as GraphML, with each node's label and kind
+// and each edge's count as data attributes.
+func (g *ReferenceGraph) WriteGraphML(w io.Writer) error {
+	var doc graphML
+	doc.Keys = []graphMLKey{
+		{ID: "label", For: "node", Name: "label", Type: "string"},
+		{ID: "kind", For: "node", Name: "kind", Type: "string"},
+		{ID: "count", For: "edge", Name: "count", Type: "int"},
+	}
+	doc.Graph.ID = "references"
+	doc.Graph.EdgeDefault = "directed"
+	for _, n := range g.Nodes {
+		doc.Graph.Nodes = append(doc.Graph.Nodes, graphMLNode{ID: n.ID, Data: []graphMLData{
+			{Key: "label", Value: n.Label},
+			{Key: "kind", Value: string(n.Kind)},
+		}})
+	}
+	for _, e := range g.Edges {
+		doc.Graph.Edges = append(doc.Graph.Edges, graphMLEdge{Source: e.From, Target: e.To, Data: []graphMLData{
+			{Key: "count", Value: strconv.Itoa(e.Count)},
+		}})
+	}
+
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return fmt.Errorf("failed to write GraphML: %w", err)
+	}
+	enc := xml.NewEncoder(w)
+	enc.Indent("", "  ")
+	if err := enc.Encode(doc); err != nil {
+		return fmt.Errorf("failed to write GraphML: %w", err)
+	}
+	if _, err := io.WriteString(w, "\n"); err != nil {
+		return fmt.Errorf("failed to write GraphML: %w", err)
+	}
+	return nil
+}
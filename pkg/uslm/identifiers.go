@@ -0,0 +1,150 @@
+package uslm
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// IDStyle selects how AssignIdentifiersWithStyle generates missing id attributes.
+type IDStyle int
+
+const (
+	// IDStyleGUID generates ids of the form "id" followed by 32 uppercase hex digits,
+	// matching the ids produced by the House and Senate drafting systems.
+	IDStyleGUID IDStyle = iota
+
+	// IDStylePositional derives ids from the element's position in the hierarchy
+	// (e.g., "tI_s101_b_1"), so repeated runs produce the same ids.
+	IDStylePositional
+)
+
+// compactCitationPattern matches the compact citable form (e.g., "114s32cds", "116hconres100ih").
+var compactCitationPattern = regexp.MustCompile(`^(\d+)([a-z]+?)(\d+)([a-z]+\d*)?$`)
+
+// AssignIdentifiers fills in missing id and identifier attributes on every
+// hierarchical level of the document (titles, sections, subsections, paragraphs,
+// subparagraphs, clauses, and subclauses) using GUID-style ids.
+// See AssignIdentifiersWithStyle for details.
+func AssignIdentifiers(doc LegislativeDocument) error {
+	return AssignIdentifiersWithStyle(doc, IDStyleGUID)
+}
+
+// AssignIdentifiersWithStyle fills in missing id and identifier attributes on every
+// hierarchical level of the document. Existing values are never overwritten.
+//
+// Identifiers follow the USLM convention of extending the parent's identifier
+// with the element's number value: "/us/bill/{congress}/{type}/{number}" (or
+// "/us/resolution/...") for the document, then "s{num}" for sections, "t{num}" for titles, and the bare number
+// for lower levels (e.g., "/us/bill/116/hr/3/tI/s101/b/1"). An identifier is only
+// assigned when the element has a num value and its parent's identifier is known.
+// Amendment and generic documents receive ids only, since their sections do not
+// address an enacted measure.
+func AssignIdentifiersWithStyle(doc LegislativeDocument, style IDStyle) error {
+	var base string
+	switch doc.(type) {
+	case *Bill, *Resolution:
+		base = documentIdentifier(doc.GetCitations())
+	case *EngrossedAmendment, *Amendment, *GenericDocument:
+	default:
+		return fmt.Errorf("unsupported document type %T", doc)
+	}
+
+	var err error
+	walkDocumentLevels(doc, func(l *level) bool {
+		err = assignLevel(l, base, style)
+		return err == nil
+	})
+	return err
+}
+
+// MeasureIdentifier returns the identifier of the measure doc is a version
+// of, e.g. "/us/bill/114/s/32" or "/us/resolution/116/sres/100", built from
+// its compact citation. It returns an empty string if the document has none.
+func MeasureIdentifier(doc LegislativeDocument) string {
+	return documentIdentifier(doc.GetCitations())
+}
+
+// documentIdentifier builds the document-level identifier (e.g., "/us/bill/114/s/32"
+// or "/us/resolution/116/sres/100") from the compact citable form. Returns an empty string if none is present.
+func documentIdentifier(citations []string) string {
+	for _, citation := range citations {
+		m := compactCitationPattern.FindStringSubmatch(strings.TrimSpace(citation))
+		if m == nil {
+			continue
+		}
+		kind := "bill"
+		if strings.HasSuffix(m[2], "res") {
+			kind = "resolution"
+		}
+		return fmt.Sprintf("/us/%s/%s/%s/%s", kind, m[1], m[2], m[3])
+	}
+	return ""
+}
+
+// assignLevel sets the id and identifier of a single level if they are empty.
+func assignLevel(l *level, base string, style IDStyle) error {
+	parentIdentifier := base
+	if l.parent != nil {
+		parentIdentifier = *l.parent.identifier
+	}
+	if *l.identifier == "" && parentIdentifier != "" && l.numValue() != "" {
+		*l.identifier = parentIdentifier + "/" + identifierPrefix(l.element) + l.numValue()
+	}
+
+	if *l.id != "" {
+		return nil
+	}
+	if style == IDStylePositional {
+		*l.id = positionalID(l)
+		return nil
+	}
+	guid, err := newGUID()
+	if err != nil {
+		return err
+	}
+	*l.id = guid
+	return nil
+}
+
+// identifierPrefix returns the prefix USLM uses for the level's identifier segment.
+func identifierPrefix(element string) string {
+	switch element {
+	case "title":
+		return "t"
+	case "section":
+		return "s"
+	}
+	return ""
+}
+
+// positionalID derives an id from the level's designation and those of its
+// ancestors, falling back to its 1-based position when it has no num value.
+func positionalID(l *level) string {
+	prefix := identifierPrefix(l.element)
+	var segment string
+	switch {
+	case l.numValue() != "":
+		segment = prefix + l.numValue()
+	case prefix != "":
+		segment = fmt.Sprintf("%s%d", prefix, l.index+1)
+	default:
+		segment = fmt.Sprintf("p%d", l.index+1)
+	}
+	if l.parent == nil {
+		return segment
+	}
+	return positionalID(l.parent) + "_" + segment
+}
+
+// newGUID returns a random id in the form used by the drafting systems
+// (e.g., "id73D4FB988B1E439DBE5256DD5BC8AB48").
+func newGUID() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("failed to generate id: %w", err)
+	}
+	return "id" + strings.ToUpper(hex.EncodeToString(b)), nil
+}
@@ -0,0 +1,77 @@
+package uslm
+
+// DocumentIndex provides upward traversal over a parsed document, whose
+// element structs only link downward. Build one with Index after parsing (and
+// again after modifying the hierarchy) and use it to find the parent,
+// enclosing section, or document of an element located by other means.
+//
+// Elements are looked up by pointer: a level struct (*Section, *Paragraph,
+// and so on) or its *Num, *Heading, *Chapeau, or *Content.
+type DocumentIndex struct {
+	doc        LegislativeDocument
+	provisions map[interface{}]*Provision
+}
+
+// Index walks doc and records the provision enclosing every hierarchical
+// level and its num, heading, chapeau, and content.
+func Index(doc LegislativeDocument) *DocumentIndex {
+	idx := &DocumentIndex{doc: doc, provisions: make(map[interface{}]*Provision)}
+	for _, top := range Provisions(doc) {
+		top.Walk(func(p *Provision) bool {
+			idx.provisions[p.Node] = p
+			if p.Num != nil {
+				idx.provisions[p.Num] = p
+			}
+			if p.Heading != nil {
+				idx.provisions[p.Heading] = p
+			}
+			if p.Chapeau != nil {
+				idx.provisions[p.Chapeau] = p
+			}
+			if p.Content != nil {
+				idx.provisions[p.Content] = p
+			}
+			return true
+		})
+	}
+	return idx
+}
+
+// Document returns the indexed document.
+func (idx *DocumentIndex) Document() LegislativeDocument {
+	return idx.doc
+}
+
+// Provision returns the provision for node, or for the level that node
+// belongs to, or nil if node is not part of the indexed document.
+func (idx *DocumentIndex) Provision(node interface{}) *Provision {
+	return idx.provisions[node]
+}
+
+// Parent returns the level struct enclosing node (e.g., the *Subsection
+// holding a *Paragraph), or nil if node is top-level or not indexed. For a
+// num, heading, chapeau, or content, the parent is the level it belongs to.
+func (idx *DocumentIndex) Parent(node interface{}) interface{} {
+	p := idx.provisions[node]
+	if p == nil {
+		return nil
+	}
+	if p.Node != node {
+		return p.Node
+	}
+	if p.Parent == nil {
+		return nil
+	}
+	return p.Parent.Node
+}
+
+// Section returns the section enclosing node, which is node itself when it
+// is a *Section, or nil if there is none (e.g., for a title).
+func (idx *DocumentIndex) Section(node interface{}) *Section {
+	for p := idx.provisions[node]; p != nil; p = p.Parent {
+		if s, ok := p.Node.(*Section); ok {
+			return s
+		}
+	}
+	return nil
+}
@@ -31,6 +31,9 @@ type LegislativeDocument interface {
 
 	// GetCitations returns all citable forms of this document
 	GetCitations() []string
+
+	// GetMeta returns the document's metadata block (<meta> or <amendMeta>)
+	GetMeta() *DocumentMeta
 }
 
 // SponsoredDocument represents documents that can have sponsors and cosponsors.
@@ -39,8 +42,11 @@ type SponsoredDocument interface {
 	// GetSponsors returns all primary sponsors of the document
 	GetSponsors() []Sponsor
 
-	// GetCosponsors returns all cosponsors of the document
+	// GetCosponsors returns all cosponsors of the document, each once
 	GetCosponsors() []Cosponsor
+
+	// GetCosponsorships returns all cosponsors with the date each joined
+	GetCosponsorships() []Cosponsorship
 }
 
 // ActionDocument represents documents that have legislative actions.
@@ -62,6 +68,35 @@ type HierarchicalDocument interface {
 	GetSections() []Section
 }
 
+// TitledDocument represents documents with a long title in their body. The
+// official title there is the one enacted, whereas GetTitle returns the
+// catalog title from the metadata, which may carry a citation prefix or
+// differ from the printed text.
+type TitledDocument interface {
+	// GetDocTitle returns the document title (e.g., "A BILL", "AN ACT", "RESOLUTION")
+	GetDocTitle() string
+
+	// GetOfficialTitle returns the official title (e.g., "To establish ...")
+	GetOfficialTitle() string
+}
+
+// ReportedDocument represents bills and resolutions that may have been
+// reported from committee, with the calendar and committee reports recorded
+// in their preface.
+type ReportedDocument interface {
+	// GetCalendar returns the calendar the measure was placed on, or nil
+	GetCalendar() *Calendar
+
+	// GetCalendarNumber returns the calendar number (e.g., "5")
+	GetCalendarNumber() string
+
+	// GetReports returns the accompanying committee reports
+	GetReports() []CommitteeReport
+
+	// GetReportCitation returns the first report's citation (e.g., "H. Rept. 116-15, Part 1")
+	GetReportCitation() string
+}
+
 // MetadataDocument provides access to Dublin Core and processing metadata.
 type MetadataDocument interface {
 	// GetCreator returns the document creator (e.g., "United States Senate")
@@ -81,6 +116,9 @@ type MetadataDocument interface {
 
 	// GetProcessedDate returns when the document was processed
 	GetProcessedDate() string
+
+	// GetProvenance returns every processing step, in the order recorded
+	GetProvenance() []ProcessingStep
 }
 
 // AmendmentDocument represents amendment-specific functionality.
@@ -89,6 +127,10 @@ type AmendmentDocument interface {
 
 	// GetAmendmentDegree returns the degree of amendment (e.g., "first", "second")
 	GetAmendmentDegree() string
+
+	// GetTargetMeasure returns the bill or resolution being amended, or nil
+	// if it cannot be determined
+	GetTargetMeasure() *TargetMeasure
 }
 
 // Identifiable represents elements that have identifiers.
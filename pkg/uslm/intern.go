@@ -0,0 +1,127 @@
+package uslm
+
+import (
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// Interner shares the storage of identifier and href attributes among the
+// documents interned with it, to cut memory when holding many documents.
+// Equal strings share one copy, and since identifiers extend the
+// identifiers of the levels above them, every "/"-prefix of an interned
+// string is kept as a slice of it: a section's identifier is stored once,
+// in its deepest subdivision's. An Interner is safe for concurrent use, and
+// holds every string it has interned for as long as it is reachable.
+type Interner struct {
+	mu      sync.Mutex
+	strings map[string]string
+}
+
+// NewInterner returns an empty interner.
+func NewInterner() *Interner {
+	return &Interner{strings: make(map[string]string)}
+}
+
+// Intern returns the interned copy of s.
+func (in *Interner) Intern(s string) string {
+	in.mu.Lock()
+	defer in.mu.Unlock()
+	return in.intern(s)
+}
+
+func (in *Interner) intern(s string) string {
+	if interned, ok := in.strings[s]; ok {
+		return interned
+	}
+	s = strings.Clone(s)
+	in.strings[s] = s
+	for i := 1; i < len(s); i++ {
+		if s[i] != '/' {
+			continue
+		}
+		if _, ok := in.strings[s[:i]]; !ok {
+			in.strings[s[:i]] = s[:i]
+		}
+	}
+	return s
+}
+
+// Len returns the number of strings the interner holds, including the
+// prefixes of those interned.
+func (in *Interner) Len() int {
+	in.mu.Lock()
+	defer in.mu.Unlock()
+	return len(in.strings)
+}
+
+// InternReferences replaces the identifier and href attributes of every
+// element of doc with their interned copies. ParseOptions.Interner does
+// this as each document is parsed.
+func InternReferences(doc LegislativeDocument, in *Interner) {
+	w := &referenceWalker{seen: map[uintptr]bool{}}
+	w.value(reflect.ValueOf(doc))
+
+	// Interning the longest strings first lets the shorter identifiers of
+	// enclosing levels become slices of them.
+	sort.SliceStable(w.fields, func(i, j int) bool {
+		return w.fields[i].Len() > w.fields[j].Len()
+	})
+	in.mu.Lock()
+	defer in.mu.Unlock()
+	for _, f := range w.fields {
+		f.SetString(in.intern(f.String()))
+	}
+}
+
+// referenceWalker collects the identifier and href fields of a reflected
+// document.
+type referenceWalker struct {
+	seen   map[uintptr]bool
+	fields []reflect.Value
+}
+
+func (w *referenceWalker) value(v reflect.Value) {
+	switch v.Kind() {
+	case reflect.Pointer:
+		if v.IsNil() || w.seen[v.Pointer()] {
+			return
+		}
+		w.seen[v.Pointer()] = true
+		w.value(v.Elem())
+	case reflect.Interface:
+		if !v.IsNil() {
+			w.value(v.Elem())
+		}
+	case reflect.Slice:
+		for i := 0; i < v.Len(); i++ {
+			w.value(v.Index(i))
+		}
+	case reflect.Struct:
+		t := v.Type()
+		for i := 0; i < t.NumField(); i++ {
+			f, field := v.Field(i), t.Field(i)
+			if !field.IsExported() {
+				continue
+			}
+			if isReferenceField(field) {
+				if f.Len() > 0 && f.CanSet() {
+					w.fields = append(w.fields, f)
+				}
+				continue
+			}
+			w.value(f)
+		}
+	}
+}
+
+// isReferenceField reports whether f is a string decoded from an
+// identifier or href attribute.
+func isReferenceField(f reflect.StructField) bool {
+	if f.Type.Kind() != reflect.String {
+		return false
+	}
+	name, flags, _ := strings.Cut(f.Tag.Get("xml"), ",")
+	return (name == "identifier" || name == "href") && strings.Contains(flags, "attr")
+}
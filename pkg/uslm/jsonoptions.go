@@ -0,0 +1,579 @@
+package uslm
+
+import (
+	"bufio"
+	"bytes"
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// FieldNaming selects the naming convention for JSON object keys.
+type FieldNaming int
+
+const (
+	// FieldNamingCamel keeps the struct tag names (e.g., "docNumber", "xmlnsDC").
+	FieldNamingCamel FieldNaming = iota
+
+	// FieldNamingSnake converts keys to snake_case (e.g., "doc_number", "xmlns_dc").
+	FieldNamingSnake
+)
+
+// JSONOptions controls how documents are encoded to and decoded from JSON.
+type JSONOptions struct {
+	// Naming selects the convention for object keys.
+	Naming FieldNaming
+
+	// SortKeys orders the keys of every object lexically rather than in struct
+	// field order, so output is stable regardless of how the types evolve.
+	SortKeys bool
+
+	// Indent pretty-prints the output with two-space indentation.
+	Indent bool
+
+	// Canonical produces a form that is byte-for-byte identical for equal
+	// documents across runs and versions of this package, for hashing and
+	// diffing: keys are sorted (as with SortKeys), members whose value is
+	// null, "", [], or {} are omitted (an object left empty this way is
+	// omitted in turn), there is no insignificant whitespace, and <, >, and
+	// & are not escaped. Indent is ignored.
+	Canonical bool
+}
+
+// ToJSONWithOptions converts any USLM document (or element) to JSON using the given options.
+func ToJSONWithOptions(doc interface{}, opts JSONOptions) ([]byte, error) {
+	var out bytes.Buffer
+	if err := EncodeJSONWithOptions(&out, doc, opts); err != nil {
+		return nil, err
+	}
+	return bytes.TrimSuffix(out.Bytes(), []byte("\n")), nil
+}
+
+// EncodeJSONWithOptions writes doc to w as JSON using the given options,
+// followed by a newline. The output is written as it is produced: structs,
+// slices, and pointers are walked one member at a time, and only values
+// encoding/json must marshal whole (maps, scalars, and types with their own
+// MarshalJSON, such as Attributes) are held in memory, so memory use does not
+// grow with the size of the document. If encoding fails part way, w may
+// already hold part of the output.
+func EncodeJSONWithOptions(w io.Writer, doc interface{}, opts JSONOptions) error {
+	if opts.Canonical {
+		opts.SortKeys, opts.Indent = true, false
+	}
+	indent := ""
+	if opts.Indent {
+		indent = "  "
+	}
+	e := newJSONEncoder(opts.Naming, opts.SortKeys, indent)
+	e.canonical = opts.Canonical
+
+	bw := bufio.NewWriter(w)
+	if err := e.value(bw, reflect.ValueOf(doc), 0); err != nil {
+		return fmt.Errorf("failed to encode JSON: %w", err)
+	}
+	bw.WriteByte('\n')
+	if err := bw.Flush(); err != nil {
+		return fmt.Errorf("failed to write JSON: %w", err)
+	}
+	return nil
+}
+
+// FromJSONWithOptions parses JSON written by ToJSONWithOptions into v, which
+// should be a pointer to a document or element struct.
+func FromJSONWithOptions(data []byte, v interface{}, opts JSONOptions) error {
+	if opts.Naming == FieldNamingSnake {
+		// Struct field matching is case-insensitive, so camelCasing the keys
+		// is enough to recover the original names.
+		var err error
+		if data, err = rewriteJSONKeys(data, camelCase); err != nil {
+			return err
+		}
+	}
+	if err := json.Unmarshal(data, v); err != nil {
+		return fmt.Errorf("failed to parse JSON: %w", err)
+	}
+	return nil
+}
+
+// rewriteJSONKeys re-encodes data compactly with every object key passed
+// through rename.
+func rewriteJSONKeys(data []byte, rename func(string) string) ([]byte, error) {
+	r := &jsonRewriter{dec: json.NewDecoder(bytes.NewReader(data)), rename: rename}
+	r.dec.UseNumber()
+	var out bytes.Buffer
+	if err := r.value(&out, 0); err != nil {
+		return nil, fmt.Errorf("failed to rewrite JSON: %w", err)
+	}
+	return out.Bytes(), nil
+}
+
+// jsonWriter is satisfied by both *bytes.Buffer and *bufio.Writer.
+type jsonWriter interface {
+	io.Writer
+	io.ByteWriter
+	io.StringWriter
+}
+
+// jsonRewriter copies a JSON token stream, renaming object keys, optionally
+// sorting them, and indenting the output. In canonical mode it also drops
+// empty members and leaves HTML characters unescaped.
+type jsonRewriter struct {
+	dec       *json.Decoder
+	rename    func(string) string
+	sortKeys  bool
+	indent    string
+	canonical bool
+}
+
+func newJSONRewriter(r io.Reader, naming FieldNaming, sortKeys bool, indent string) *jsonRewriter {
+	rw := &jsonRewriter{dec: json.NewDecoder(r), rename: func(key string) string { return key }, sortKeys: sortKeys, indent: indent}
+	rw.dec.UseNumber()
+	if naming == FieldNamingSnake {
+		rw.rename = snakeCase
+	}
+	return rw
+}
+
+func (r *jsonRewriter) newline(out jsonWriter, depth int) {
+	if r.indent == "" {
+		return
+	}
+	out.WriteByte('\n')
+	for i := 0; i < depth; i++ {
+		out.WriteString(r.indent)
+	}
+}
+
+func (r *jsonRewriter) value(out jsonWriter, depth int) error {
+	tok, err := r.dec.Token()
+	if err != nil {
+		return err
+	}
+
+	switch t := tok.(type) {
+	case json.Delim:
+		var err error
+		switch t {
+		case '[':
+			err = r.array(out, depth)
+		case '{':
+			err = r.object(out, depth)
+		}
+		if err != nil {
+			return err
+		}
+		// Consume the closing delimiter.
+		_, err = r.dec.Token()
+		return err
+	case json.Number:
+		out.WriteString(t.String())
+	default:
+		value, err := r.marshal(t)
+		if err != nil {
+			return err
+		}
+		out.Write(value)
+	}
+	return nil
+}
+
+// marshal encodes a scalar token or key, escaping HTML characters except in
+// canonical mode.
+func (r *jsonRewriter) marshal(v interface{}) ([]byte, error) {
+	if !r.canonical {
+		return json.Marshal(v)
+	}
+	var b bytes.Buffer
+	enc := json.NewEncoder(&b)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(v); err != nil {
+		return nil, err
+	}
+	return bytes.TrimSuffix(b.Bytes(), []byte("\n")), nil
+}
+
+// isEmptyJSON reports whether an encoded value is null, "", [], or {}.
+func isEmptyJSON(value []byte) bool {
+	switch string(value) {
+	case "null", `""`, "[]", "{}":
+		return true
+	}
+	return false
+}
+
+func (r *jsonRewriter) array(out jsonWriter, depth int) error {
+	out.WriteByte('[')
+	n := 0
+	for ; r.dec.More(); n++ {
+		if n > 0 {
+			out.WriteByte(',')
+		}
+		r.newline(out, depth+1)
+		if err := r.value(out, depth+1); err != nil {
+			return err
+		}
+	}
+	if n > 0 {
+		r.newline(out, depth)
+	}
+	out.WriteByte(']')
+	return nil
+}
+
+func (r *jsonRewriter) object(out jsonWriter, depth int) error {
+	type member struct {
+		key   string
+		value []byte
+	}
+	var members []member
+
+	sep := ":"
+	if r.indent != "" {
+		sep = ": "
+	}
+	writeKey := func(key string) {
+		encoded, _ := r.marshal(key)
+		out.Write(encoded)
+		out.WriteString(sep)
+	}
+
+	out.WriteByte('{')
+	n := 0
+	for ; r.dec.More(); n++ {
+		keyTok, err := r.dec.Token()
+		if err != nil {
+			return err
+		}
+		key := r.rename(keyTok.(string))
+		if r.sortKeys {
+			var value bytes.Buffer
+			if err := r.value(&value, depth+1); err != nil {
+				return err
+			}
+			if r.canonical && isEmptyJSON(value.Bytes()) {
+				continue
+			}
+			members = append(members, member{key, value.Bytes()})
+			continue
+		}
+		if n > 0 {
+			out.WriteByte(',')
+		}
+		r.newline(out, depth+1)
+		writeKey(key)
+		if err := r.value(out, depth+1); err != nil {
+			return err
+		}
+	}
+	if r.sortKeys {
+		sort.SliceStable(members, func(i, j int) bool { return members[i].key < members[j].key })
+		for i, m := range members {
+			if i > 0 {
+				out.WriteByte(',')
+			}
+			r.newline(out, depth+1)
+			writeKey(m.key)
+			out.Write(m.value)
+		}
+	}
+	if n > 0 {
+		r.newline(out, depth)
+	}
+	out.WriteByte('}')
+	return nil
+}
+
+// jsonEncoder writes Go values as JSON with the options of a jsonRewriter.
+// It walks structs, slices, arrays, and pointers itself, following the field
+// rules of encoding/json, so that output reaches the writer as it is
+// produced. Any other value is marshaled whole by encoding/json and copied
+// through the rewriter; so is a struct whose fields encoding/json treats
+// specially (embedded structs, or tags with options other than omitempty).
+type jsonEncoder struct {
+	jsonRewriter
+	plain  bool // write marshaled values as they are
+	fields map[reflect.Type][]jsonField
+}
+
+// jsonField is a struct field as encoding/json encodes it.
+type jsonField struct {
+	index     int
+	key       string // renamed
+	omitEmpty bool
+}
+
+func newJSONEncoder(naming FieldNaming, sortKeys bool, indent string) *jsonEncoder {
+	r := jsonRewriter{rename: func(key string) string { return key }, sortKeys: sortKeys, indent: indent}
+	if naming == FieldNamingSnake {
+		r.rename = snakeCase
+	}
+	return &jsonEncoder{
+		jsonRewriter: r,
+		plain:        naming == FieldNamingCamel && !sortKeys && indent == "",
+		fields:       make(map[reflect.Type][]jsonField),
+	}
+}
+
+var (
+	jsonMarshalerType = reflect.TypeOf((*json.Marshaler)(nil)).Elem()
+	textMarshalerType = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
+)
+
+// marshals reports whether encoding/json would use v's own marshaling.
+func marshals(v reflect.Value) bool {
+	t := v.Type()
+	if t.Implements(jsonMarshalerType) || t.Implements(textMarshalerType) {
+		return true
+	}
+	if v.CanAddr() {
+		pt := reflect.PointerTo(t)
+		return pt.Implements(jsonMarshalerType) || pt.Implements(textMarshalerType)
+	}
+	return false
+}
+
+func (e *jsonEncoder) value(out jsonWriter, v reflect.Value, depth int) error {
+	if !v.IsValid() || (v.Kind() == reflect.Pointer || v.Kind() == reflect.Interface) && v.IsNil() {
+		out.WriteString("null")
+		return nil
+	}
+	if marshals(v) {
+		return e.marshalValue(out, v, depth)
+	}
+	switch v.Kind() {
+	case reflect.Pointer, reflect.Interface:
+		return e.value(out, v.Elem(), depth)
+	case reflect.Struct:
+		if fields, ok := e.structFields(v.Type()); ok {
+			return e.object(out, v, fields, depth)
+		}
+	case reflect.Slice:
+		if v.IsNil() {
+			out.WriteString("null")
+			return nil
+		}
+		if v.Type().Elem().Kind() != reflect.Uint8 {
+			return e.array(out, v, depth)
+		}
+	case reflect.Array:
+		return e.array(out, v, depth)
+	}
+	return e.marshalValue(out, v, depth)
+}
+
+// marshalValue writes v as encoding/json marshals it, rewritten by the
+// encoder's options.
+func (e *jsonEncoder) marshalValue(out jsonWriter, v reflect.Value, depth int) error {
+	if v.CanAddr() {
+		// encoding/json uses pointer methods of addressable values.
+		v = v.Addr()
+	}
+	data, err := json.Marshal(v.Interface())
+	if err != nil {
+		return err
+	}
+	if e.plain {
+		out.Write(data)
+		return nil
+	}
+	r := e.jsonRewriter
+	r.dec = json.NewDecoder(bytes.NewReader(data))
+	r.dec.UseNumber()
+	return r.value(out, depth)
+}
+
+func (e *jsonEncoder) array(out jsonWriter, v reflect.Value, depth int) error {
+	out.WriteByte('[')
+	for i := 0; i < v.Len(); i++ {
+		if i > 0 {
+			out.WriteByte(',')
+		}
+		e.newline(out, depth+1)
+		if err := e.value(out, v.Index(i), depth+1); err != nil {
+			return err
+		}
+	}
+	if v.Len() > 0 {
+		e.newline(out, depth)
+	}
+	out.WriteByte(']')
+	return nil
+}
+
+func (e *jsonEncoder) object(out jsonWriter, v reflect.Value, fields []jsonField, depth int) error {
+	sep := ":"
+	if e.indent != "" {
+		sep = ": "
+	}
+
+	out.WriteByte('{')
+	n := 0
+	for _, f := range fields {
+		fv := v.Field(f.index)
+		if f.omitEmpty && isEmptyValue(fv) {
+			continue
+		}
+		if e.canonical {
+			empty, err := e.empty(fv)
+			if err != nil {
+				return err
+			}
+			if empty {
+				continue
+			}
+		}
+		if n > 0 {
+			out.WriteByte(',')
+		}
+		e.newline(out, depth+1)
+		key, _ := e.marshal(f.key)
+		out.Write(key)
+		out.WriteString(sep)
+		if err := e.value(out, fv, depth+1); err != nil {
+			return err
+		}
+		n++
+	}
+	if n > 0 {
+		e.newline(out, depth)
+	}
+	out.WriteByte('}')
+	return nil
+}
+
+// empty reports whether v encodes in canonical mode as null, "", [], or {},
+// without encoding more of v than it must.
+func (e *jsonEncoder) empty(v reflect.Value) (bool, error) {
+	if !v.IsValid() || (v.Kind() == reflect.Pointer || v.Kind() == reflect.Interface) && v.IsNil() {
+		return true, nil
+	}
+	if !marshals(v) {
+		switch v.Kind() {
+		case reflect.Pointer, reflect.Interface:
+			return e.empty(v.Elem())
+		case reflect.Struct:
+			if fields, ok := e.structFields(v.Type()); ok {
+				for _, f := range fields {
+					fv := v.Field(f.index)
+					if f.omitEmpty && isEmptyValue(fv) {
+						continue
+					}
+					if empty, err := e.empty(fv); !empty || err != nil {
+						return empty, err
+					}
+				}
+				return true, nil
+			}
+		case reflect.Slice, reflect.Array, reflect.String:
+			return v.Len() == 0, nil
+		case reflect.Bool, reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+			reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+			reflect.Float32, reflect.Float64:
+			return false, nil
+		}
+	}
+	var value bytes.Buffer
+	if err := e.marshalValue(&value, v, 0); err != nil {
+		return false, err
+	}
+	return isEmptyJSON(value.Bytes()), nil
+}
+
+// structFields returns the fields of struct type t in the order the encoder
+// writes them, or false if t must be marshaled whole.
+func (e *jsonEncoder) structFields(t reflect.Type) ([]jsonField, bool) {
+	if fields, ok := e.fields[t]; ok {
+		return fields, fields != nil
+	}
+	fields := []jsonField{}
+	seen := make(map[string]bool)
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+		if sf.Anonymous {
+			fields = nil
+			break
+		}
+		tag := sf.Tag.Get("json")
+		if !sf.IsExported() || tag == "-" {
+			continue
+		}
+		name, opts, _ := strings.Cut(tag, ",")
+		if name == "" {
+			name = sf.Name
+		}
+		if (opts != "" && opts != "omitempty") || !validJSONTag(name) || seen[name] {
+			fields = nil
+			break
+		}
+		seen[name] = true
+		fields = append(fields, jsonField{index: i, key: e.rename(name), omitEmpty: opts == "omitempty"})
+	}
+	if e.sortKeys {
+		sort.SliceStable(fields, func(i, j int) bool { return fields[i].key < fields[j].key })
+	}
+	e.fields[t] = fields
+	return fields, fields != nil
+}
+
+// validJSONTag reports whether encoding/json accepts name from a struct tag.
+func validJSONTag(name string) bool {
+	for _, c := range name {
+		if !strings.ContainsRune("!#$%&()*+-./:;<=>?@[]^_{|}~ ", c) && !unicode.IsLetter(c) && !unicode.IsDigit(c) {
+			return false
+		}
+	}
+	return true
+}
+
+// isEmptyValue reports whether encoding/json omits v from an omitempty field.
+func isEmptyValue(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Array, reflect.Map, reflect.Slice, reflect.String:
+		return v.Len() == 0
+	case reflect.Bool:
+		return !v.Bool()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return v.Int() == 0
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return v.Uint() == 0
+	case reflect.Float32, reflect.Float64:
+		return v.Float() == 0
+	case reflect.Interface, reflect.Pointer:
+		return v.IsNil()
+	}
+	return false
+}
+
+// snakeCase converts a camelCase key to snake_case, treating runs of capitals
+// as a single word (e.g., "xmlnsHTML" → "xmlns_html", "usCodeHref" → "us_code_href").
+func snakeCase(s string) string {
+	runes := []rune(s)
+	var b strings.Builder
+	for i, r := range runes {
+		if unicode.IsUpper(r) && i > 0 {
+			prev := runes[i-1]
+			nextLower := i+1 < len(runes) && unicode.IsLower(runes[i+1])
+			if unicode.IsLower(prev) || unicode.IsDigit(prev) || (unicode.IsUpper(prev) && nextLower) {
+				b.WriteByte('_')
+			}
+		}
+		b.WriteRune(unicode.ToLower(r))
+	}
+	return b.String()
+}
+
+// camelCase converts a snake_case key to camelCase.
+func camelCase(s string) string {
+	parts := strings.Split(s, "_")
+	for i := 1; i < len(parts); i++ {
+		if parts[i] != "" {
+			parts[i] = strings.ToUpper(parts[i][:1]) + parts[i][1:]
+		}
+	}
+	return strings.Join(parts, "")
+}
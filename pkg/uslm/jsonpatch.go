@@ -0,0 +1,204 @@
+package uslm
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// PatchOperation is one operation of an RFC 6902 JSON Patch.
+type PatchOperation struct {
+	// Op is "add", "remove", or "replace".
+	Op string `json:"op"`
+
+	// Path is the RFC 6901 JSON Pointer the operation applies to.
+	Path string `json:"path"`
+
+	// Value is the value added or substituted; it is empty for "remove".
+	Value json.RawMessage `json:"value,omitempty"`
+}
+
+// JSONPatch returns the RFC 6902 patch that transforms the JSON form of
+// oldDoc (as written by ToJSON) into that of newDoc.
+func JSONPatch(oldDoc, newDoc interface{}) ([]PatchOperation, error) {
+	oldJSON, err := json.Marshal(oldDoc)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode old document: %w", err)
+	}
+	newJSON, err := json.Marshal(newDoc)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode new document: %w", err)
+	}
+	return DiffJSON(oldJSON, newJSON)
+}
+
+// DiffJSON returns the RFC 6902 patch that transforms the JSON value oldJSON
+// into newJSON. It is useful for documents stored with other JSONOptions.
+//
+// Objects are compared key by key, in sorted key order. Arrays are compared
+// after trimming their common leading and trailing elements, so inserting or
+// deleting a section yields a single add or remove rather than a replacement
+// of every section after it. Operations are ordered so that applying them in
+// sequence is valid: removals within an array run from the highest index down.
+func DiffJSON(oldJSON, newJSON []byte) ([]PatchOperation, error) {
+	oldValue, err := decodeJSONValue(oldJSON)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse old JSON: %w", err)
+	}
+	newValue, err := decodeJSONValue(newJSON)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse new JSON: %w", err)
+	}
+	ops := []PatchOperation{}
+	if err := diffJSONValues("", oldValue, newValue, &ops); err != nil {
+		return nil, err
+	}
+	return ops, nil
+}
+
+// decodeJSONValue decodes data keeping numbers in their original form.
+func decodeJSONValue(data []byte) (interface{}, error) {
+	d := json.NewDecoder(bytes.NewReader(data))
+	d.UseNumber()
+	var v interface{}
+	if err := d.Decode(&v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// diffJSONValues appends the operations turning a into b at path.
+func diffJSONValues(path string, a, b interface{}, ops *[]PatchOperation) error {
+	switch av := a.(type) {
+	case map[string]interface{}:
+		if bv, ok := b.(map[string]interface{}); ok {
+			return diffJSONObjects(path, av, bv, ops)
+		}
+	case []interface{}:
+		if bv, ok := b.([]interface{}); ok {
+			return diffJSONArrays(path, av, bv, ops)
+		}
+	}
+	if jsonEqual(a, b) {
+		return nil
+	}
+	return appendPatch(ops, "replace", path, b)
+}
+
+func diffJSONObjects(path string, a, b map[string]interface{}, ops *[]PatchOperation) error {
+	keys := make([]string, 0, len(a)+len(b))
+	for k := range a {
+		keys = append(keys, k)
+	}
+	for k := range b {
+		if _, ok := a[k]; !ok {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		child := path + "/" + escapeJSONPointer(k)
+		av, inA := a[k]
+		bv, inB := b[k]
+		var err error
+		switch {
+		case !inB:
+			err = appendPatch(ops, "remove", child, nil)
+		case !inA:
+			err = appendPatch(ops, "add", child, bv)
+		default:
+			err = diffJSONValues(child, av, bv, ops)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func diffJSONArrays(path string, a, b []interface{}, ops *[]PatchOperation) error {
+	prefix := 0
+	for prefix < len(a) && prefix < len(b) && jsonEqual(a[prefix], b[prefix]) {
+		prefix++
+	}
+	suffix := 0
+	for suffix < len(a)-prefix && suffix < len(b)-prefix && jsonEqual(a[len(a)-1-suffix], b[len(b)-1-suffix]) {
+		suffix++
+	}
+	a, b = a[prefix:len(a)-suffix], b[prefix:len(b)-suffix]
+
+	common := len(a)
+	if len(b) < common {
+		common = len(b)
+	}
+	for i := 0; i < common; i++ {
+		if err := diffJSONValues(path+"/"+strconv.Itoa(prefix+i), a[i], b[i], ops); err != nil {
+			return err
+		}
+	}
+	for i := len(a) - 1; i >= common; i-- {
+		if err := appendPatch(ops, "remove", path+"/"+strconv.Itoa(prefix+i), nil); err != nil {
+			return err
+		}
+	}
+	for i := common; i < len(b); i++ {
+		if err := appendPatch(ops, "add", path+"/"+strconv.Itoa(prefix+i), b[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// appendPatch appends an operation, encoding value unless op is "remove".
+func appendPatch(ops *[]PatchOperation, op, path string, value interface{}) error {
+	p := PatchOperation{Op: op, Path: path}
+	if op != "remove" {
+		data, err := json.Marshal(value)
+		if err != nil {
+			return fmt.Errorf("failed to encode patch value at %s: %w", path, err)
+		}
+		p.Value = data
+	}
+	*ops = append(*ops, p)
+	return nil
+}
+
+// jsonEqual reports whether two decoded JSON values are equal.
+func jsonEqual(a, b interface{}) bool {
+	switch av := a.(type) {
+	case map[string]interface{}:
+		bv, ok := b.(map[string]interface{})
+		if !ok || len(av) != len(bv) {
+			return false
+		}
+		for k, v := range av {
+			w, ok := bv[k]
+			if !ok || !jsonEqual(v, w) {
+				return false
+			}
+		}
+		return true
+	case []interface{}:
+		bv, ok := b.([]interface{})
+		if !ok || len(av) != len(bv) {
+			return false
+		}
+		for i := range av {
+			if !jsonEqual(av[i], bv[i]) {
+				return false
+			}
+		}
+		return true
+	default:
+		return a == b
+	}
+}
+
+// escapeJSONPointer escapes a reference token per RFC 6901.
+func escapeJSONPointer(s string) string {
+	return strings.NewReplacer("~", "~0", "/", "~1").Replace(s)
+}
@@ -0,0 +1,164 @@
+package uslm
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// RoundTripDifferenceKind classifies a JSONRoundTripDifference.
+type RoundTripDifferenceKind string
+
+const (
+	// DifferenceMissingElement is an element lost in the JSON round trip.
+	DifferenceMissingElement RoundTripDifferenceKind = "missingElement"
+
+	// DifferenceExtraElement is an element present only after the round
+	// trip.
+	DifferenceExtraElement RoundTripDifferenceKind = "extraElement"
+
+	// DifferenceAttribute is an attribute lost, added, or changed.
+	DifferenceAttribute RoundTripDifferenceKind = "attribute"
+
+	// DifferenceText is character data that changed.
+	DifferenceText RoundTripDifferenceKind = "text"
+)
+
+// JSONRoundTripDifference is one semantic difference between a document
+// marshaled to XML directly and after a round trip through JSON.
+type JSONRoundTripDifference struct {
+	Kind RoundTripDifferenceKind `json:"kind"`
+
+	// Path locates the element, XPath style, with the position of the
+	// element among its siblings of the same name (e.g.,
+	// "/bill/main/section[2]/num"). For an attribute the attribute name
+	// is appended with "/@".
+	Path string `json:"path"`
+
+	// Want is the value before the round trip and Got the value after; an
+	// element is given by its name.
+	Want string `json:"want,omitempty"`
+	Got  string `json:"got,omitempty"`
+}
+
+// String describes the difference, e.g. `attribute /bill/@xml:lang: "en" became ""`.
+func (d JSONRoundTripDifference) String() string {
+	return fmt.Sprintf("%s %s: %q became %q", d.Kind, d.Path, d.Want, d.Got)
+}
+
+// JSONRoundTripReport is the result of VerifyJSONRoundTrip.
+type JSONRoundTripReport struct {
+	DocumentType DocumentType              `json:"documentType"`
+	Differences  []JSONRoundTripDifference `json:"differences,omitempty"`
+}
+
+// OK reports whether the round trip lost or changed nothing.
+func (r *JSONRoundTripReport) OK() bool {
+	return len(r.Differences) == 0
+}
+
+// VerifyJSONRoundTrip parses data, converts the document to JSON and back,
+// and reports every difference between the XML marshaled from the parsed
+// document and the XML marshaled from the document read back from JSON.
+// Content the model drops when parsing XML (see UnknownContent) is the same
+// on both sides and so not reported; only what the JSON encoding loses or
+// changes is. Elements are compared by name, attribute set, and character
+// data with whitespace collapsed; comments and formatting are ignored.
+func VerifyJSONRoundTrip(data []byte) (*JSONRoundTripReport, error) {
+	doc, err := ParseDocument(data)
+	if err != nil {
+		return nil, err
+	}
+	docType := documentTypeOf(doc)
+	js, err := ToJSON(doc)
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert document to JSON: %w", err)
+	}
+	back, err := DocumentFromJSON(js, docType)
+	if err != nil {
+		return nil, err
+	}
+
+	want, err := NodeFromElement(doc)
+	if err != nil {
+		return nil, err
+	}
+	got, err := NodeFromElement(back)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &JSONRoundTripReport{DocumentType: docType}
+	wantRoot, gotRoot := want.Root(), got.Root()
+	compareNodes(&report.Differences, "/"+wantRoot.Name, wantRoot, gotRoot)
+	return report, nil
+}
+
+// compareNodes appends the differences between the elements want and got,
+// found at path, and between their descendants.
+func compareNodes(diffs *[]JSONRoundTripDifference, path string, want, got *Node) {
+	add := func(kind RoundTripDifferenceKind, path, w, g string) {
+		*diffs = append(*diffs, JSONRoundTripDifference{Kind: kind, Path: path, Want: w, Got: g})
+	}
+
+	gotAttrs := make(map[string]string, len(got.Attrs))
+	for _, a := range got.Attrs {
+		gotAttrs[a.Name] = a.Value
+	}
+	for _, a := range want.Attrs {
+		g, ok := gotAttrs[a.Name]
+		if !ok || g != a.Value {
+			add(DifferenceAttribute, path+"/@"+a.Name, a.Value, g)
+		}
+		delete(gotAttrs, a.Name)
+	}
+	for _, a := range got.Attrs {
+		if _, ok := gotAttrs[a.Name]; ok {
+			add(DifferenceAttribute, path+"/@"+a.Name, "", a.Value)
+		}
+	}
+
+	if w, g := directText(want), directText(got); w != g {
+		add(DifferenceText, path, w, g)
+	}
+
+	// Children are matched in order by name, so a lost element is reported
+	// once rather than shifting every sibling after it.
+	wantKids, gotKids := want.Elements(), got.Elements()
+	counts := make(map[string]int)
+	childPath := func(n *Node) string {
+		counts[n.Name]++
+		return path + "/" + n.Name + "[" + strconv.Itoa(counts[n.Name]) + "]"
+	}
+	j := 0
+	for _, w := range wantKids {
+		k := j
+		for k < len(gotKids) && gotKids[k].Name != w.Name {
+			k++
+		}
+		p := childPath(w)
+		if k == len(gotKids) {
+			add(DifferenceMissingElement, p, w.Name, "")
+			continue
+		}
+		for _, extra := range gotKids[j:k] {
+			add(DifferenceExtraElement, path+"/"+extra.Name, "", extra.Name)
+		}
+		compareNodes(diffs, p, w, gotKids[k])
+		j = k + 1
+	}
+	for _, extra := range gotKids[j:] {
+		add(DifferenceExtraElement, path+"/"+extra.Name, "", extra.Name)
+	}
+}
+
+// directText returns the character data directly within n, with
+// whitespace collapsed.
+func directText(n *Node) string {
+	var text string
+	for _, c := range n.Children {
+		if c.Type == TextNode {
+			text += " " + c.Text
+		}
+	}
+	return normalizeSpace(text)
+}
@@ -0,0 +1,127 @@
+package uslm
+
+import "strings"
+
+// TextRun is a stretch of a provision's text in one language, such as a
+// Spanish place name or the text of a treaty within English content.
+type TextRun struct {
+	// Identifier is the identifier of the provision the text belongs to.
+	Identifier string `json:"identifier,omitempty"`
+
+	// Lang is the effective xml:lang of the text, or an empty string if
+	// neither the text, its provision, nor the document declares one.
+	Lang string `json:"lang,omitempty"`
+
+	Text string `json:"text"`
+}
+
+// langSpan marks the byte range of the text recorded by decodeOrderedLang
+// that an element declares, or inherits, an xml:lang for.
+type langSpan struct {
+	start, end int
+	lang       string
+}
+
+// addLangSpan appends span to spans, extending the last span instead when
+// the two are adjacent and in the same language.
+func addLangSpan(spans []langSpan, span langSpan) []langSpan {
+	if n := len(spans); n > 0 && spans[n-1].end == span.start && spans[n-1].lang == span.lang {
+		spans[n-1].end = span.end
+		return spans
+	}
+	return append(spans, span)
+}
+
+// DocumentLanguage returns the xml:lang of the document's root element, or
+// an empty string.
+func DocumentLanguage(doc LegislativeDocument) string {
+	switch d := doc.(type) {
+	case *Bill:
+		if d != nil {
+			return d.XMLLang
+		}
+	case *Resolution:
+		if d != nil {
+			return d.XMLLang
+		}
+	case *EngrossedAmendment:
+		if d != nil {
+			return d.XMLLang
+		}
+	case *Amendment:
+		if d != nil {
+			return d.XMLLang
+		}
+	case *GenericDocument:
+		if d != nil {
+			return d.XMLLang
+		}
+	}
+	return ""
+}
+
+// TextRuns returns the provision's number, heading, chapeau, and content
+// text, without the text of its children, split into runs by effective
+// language. Text not marked otherwise is in the provision's Lang, and
+// adjacent runs in the same language are merged. Languages within a
+// heading, chapeau, or content are only known for text parsed from XML.
+func (p *Provision) TextRuns() []TextRun {
+	var runs []TextRun
+	add := func(lang, text string) {
+		text = normalizeSpace(text)
+		if text == "" {
+			return
+		}
+		if n := len(runs); n > 0 && runs[n-1].Lang == lang {
+			runs[n-1].Text += " " + text
+			return
+		}
+		runs = append(runs, TextRun{Identifier: p.Identifier, Lang: lang, Text: text})
+	}
+	addSpans := func(text string, spans []langSpan, plain string) {
+		if text == "" {
+			add(p.Lang, plain)
+			return
+		}
+		pos := 0
+		for _, s := range spans {
+			add(p.Lang, text[pos:s.start])
+			add(s.lang, text[s.start:s.end])
+			pos = s.end
+		}
+		add(p.Lang, text[pos:])
+	}
+
+	if p.Num != nil {
+		add(p.Lang, p.Num.Text)
+	}
+	if h := p.Heading; h != nil {
+		addSpans(h.text, h.langs, h.PlainText())
+	}
+	if c := p.Chapeau; c != nil {
+		addSpans(c.text, c.langs, c.PlainText())
+	}
+	if c := p.Content; c != nil {
+		addSpans(c.text, c.langs, c.PlainText())
+	}
+	return runs
+}
+
+// ExtractTextRuns returns the text of every hierarchical level of the
+// document in reading order, as ExtractText does, but split into runs by
+// effective language (see Provision.TextRuns) and normalized with opts,
+// so that multilingual text can be indexed or spell-checked by language.
+func ExtractTextRuns(doc LegislativeDocument, opts NormalizeOptions) []TextRun {
+	var runs []TextRun
+	for _, top := range Provisions(doc) {
+		top.Walk(func(p *Provision) bool {
+			for _, r := range p.TextRuns() {
+				if r.Text = NormalizeText(r.Text, opts); strings.TrimSpace(r.Text) != "" {
+					runs = append(runs, r)
+				}
+			}
+			return true
+		})
+	}
+	return runs
+}
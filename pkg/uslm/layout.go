@@ -0,0 +1,162 @@
+package uslm
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+	"unicode/utf8"
+)
+
+// LayoutOptions configures LayoutDocument.
+type LayoutOptions struct {
+	// Print sets the line width and indentation, as for ToPrintText.
+	Print PrintOptions
+
+	// LinesPerPage is the number of numbered lines on a page. Defaults to
+	// 25, as in GPO prints of bills and resolutions.
+	LinesPerPage int
+}
+
+// Layout is a document laid out in numbered lines on pages, approximating
+// the official print. A LayoutRenderer writes it in an output format, such
+// as PDF.
+type Layout struct {
+	// Title is the document's official title, for the output's metadata.
+	Title string `json:"title,omitempty"`
+
+	// RunningHead is printed at the foot of each page, e.g. "•S 2062 RIS".
+	RunningHead string `json:"runningHead,omitempty"`
+
+	// Width is the width of the widest line in columns, and LinesPerPage
+	// the number of numbered lines on a full page.
+	Width        int `json:"width"`
+	LinesPerPage int `json:"linesPerPage"`
+
+	Pages []LayoutPage `json:"pages"`
+}
+
+// LayoutPage is one page of a Layout.
+type LayoutPage struct {
+	// Number is the page number, starting at 1. It is printed at the top of
+	// every page but the first.
+	Number int          `json:"number"`
+	Lines  []LayoutLine `json:"lines"`
+}
+
+// LayoutLine is one line of a page.
+type LayoutLine struct {
+	// Number is the line number printed in the margin, from 1 to
+	// LinesPerPage, or 0 for the unnumbered lines of the header block and
+	// the blank lines between paragraphs.
+	Number int `json:"number,omitempty"`
+
+	// Text is the text of the line, indented with spaces.
+	Text string `json:"text,omitempty"`
+}
+
+// LayoutRenderer writes a Layout in an output format. PDFRenderer and
+// TextLayoutRenderer are provided; other backends, such as one driving a
+// typesetting library, can be plugged in by implementing it.
+type LayoutRenderer interface {
+	RenderLayout(w io.Writer, layout *Layout) error
+}
+
+// LayoutDocument lays the document out in pages as ToPrintText lays it out
+// in lines. The header block (Congress, session, document number, and
+// titles) opens the first page unnumbered; the lines below it are numbered
+// in the margin, restarting on each page, as on GPO prints. Blank lines
+// separate paragraphs but are not numbered, and none begins or ends a page.
+func LayoutDocument(doc LegislativeDocument, opts LayoutOptions) *Layout {
+	if opts.LinesPerPage <= 0 {
+		opts.LinesPerPage = 25
+	}
+	lines, header := printLines(doc, opts.Print)
+
+	title := doc.GetTitle()
+	layout := &Layout{
+		Title:        normalizeSpace(strings.TrimPrefix(title, titleCitationPrefix.FindString(title))),
+		RunningHead:  runningHead(doc),
+		LinesPerPage: opts.LinesPerPage,
+	}
+	page := &LayoutPage{Number: 1}
+	numbered := 0
+	for i, text := range lines {
+		text = strings.TrimRight(text, " ")
+		if n := utf8.RuneCountInString(text); n > layout.Width {
+			layout.Width = n
+		}
+		switch {
+		case i < header:
+			page.Lines = append(page.Lines, LayoutLine{Text: text})
+		case text == "":
+			if len(page.Lines) > 0 {
+				page.Lines = append(page.Lines, LayoutLine{})
+			}
+		default:
+			if numbered == opts.LinesPerPage {
+				layout.Pages = append(layout.Pages, *trimLayoutPage(page))
+				page = &LayoutPage{Number: page.Number + 1}
+				numbered = 0
+			}
+			numbered++
+			page.Lines = append(page.Lines, LayoutLine{Number: numbered, Text: text})
+		}
+	}
+	if len(page.Lines) > 0 || len(layout.Pages) == 0 {
+		layout.Pages = append(layout.Pages, *trimLayoutPage(page))
+	}
+	return layout
+}
+
+// trimLayoutPage removes the blank lines at the end of page.
+func trimLayoutPage(page *LayoutPage) *LayoutPage {
+	for n := len(page.Lines); n > 0 && page.Lines[n-1] == (LayoutLine{}); n-- {
+		page.Lines = page.Lines[:n-1]
+	}
+	return page
+}
+
+// runningHead returns the "•S 2062 RIS" running head of the document, from
+// the citation prefix of its title, or an empty string.
+func runningHead(doc LegislativeDocument) string {
+	prefix := titleCitationPrefix.FindString(doc.GetTitle())
+	if prefix == "" {
+		return ""
+	}
+	citation := strings.TrimSuffix(prefix, ": ")
+	if i := strings.IndexByte(citation, ' '); i >= 0 {
+		citation = citation[i+1:]
+	}
+	return "•" + citation
+}
+
+// TextLayoutRenderer writes a Layout as plain text, with the line numbers in
+// a margin, the page number at the top of each page after the first, the
+// running head at its foot, and a form feed between pages.
+type TextLayoutRenderer struct{}
+
+// RenderLayout writes layout to w.
+func (TextLayoutRenderer) RenderLayout(w io.Writer, layout *Layout) error {
+	bw := bufio.NewWriter(w)
+	const margin = "    "
+	for i, page := range layout.Pages {
+		if i > 0 {
+			bw.WriteString("\f")
+			fmt.Fprintf(bw, "%s%*d\n\n", margin, layout.Width, page.Number)
+		}
+		for _, line := range page.Lines {
+			if line.Number > 0 {
+				fmt.Fprintf(bw, "%2d  %s\n", line.Number, line.Text)
+			} else if line.Text != "" {
+				bw.WriteString(margin + line.Text + "\n")
+			} else {
+				bw.WriteString("\n")
+			}
+		}
+		if layout.RunningHead != "" {
+			bw.WriteString("\n" + margin + layout.RunningHead + "\n")
+		}
+	}
+	return bw.Flush()
+}
@@ -0,0 +1,261 @@
+package uslm
+
+import (
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+)
+
+// ErrSectionNotFound is returned by LazyDocument.Section for an identifier
+// that names none of the document's sections.
+var ErrSectionNotFound = errors.New("section not found")
+
+// LazyDocument is a document whose sections are kept as raw XML and decoded
+// only when first asked for, for servers that answer most requests from the
+// metadata and a single provision. It is safe for concurrent use: each
+// section is decoded once, by whichever caller reaches it first.
+type LazyDocument struct {
+	data     []byte
+	head     LegislativeDocument
+	sections []*LazySection
+
+	// entities holds the internal entities declared in the DOCTYPE, which
+	// the sections may refer to.
+	entities map[string]string
+
+	once sync.Once
+	doc  LegislativeDocument
+	err  error
+}
+
+// LazySection is a section of a LazyDocument. Its id and identifier are read
+// from the start tag without decoding the section.
+type LazySection struct {
+	ID         string
+	Identifier string
+
+	// raw is the section's markup, wrapped in an element declaring the
+	// namespaces in scope where it appeared; entities are those of the
+	// document.
+	raw      []byte
+	entities map[string]string
+
+	once    sync.Once
+	section *Section
+	err     error
+}
+
+// ParseLazy reads the metadata and preface of data, as ReadHeader does, and
+// locates its sections without decoding them: every section of the body not
+// nested in another section, whether top-level, within a title or other
+// grouping level, or proposed by an amendment instruction. Sections quoted
+// by a section belong to it.
+func ParseLazy(data []byte) (*LazyDocument, error) {
+	run := beginParse("ParseLazy")
+	l, err := parseLazy(data, run)
+	run.end(err)
+	return l, err
+}
+
+func parseLazy(data []byte, run *parseRun) (*LazyDocument, error) {
+	head, err := decodeHead(bytes.NewReader(data), true, run)
+	if err != nil {
+		return nil, err
+	}
+	l := &LazyDocument{data: data, head: head}
+
+	// The decoder's offsets count UTF-8 text, so the sections are cut from
+	// the document as newDecoder converts it.
+	text, err := utf8Text(data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read document: %w", err)
+	}
+	if err := l.index(text); err != nil {
+		return nil, err
+	}
+	run.phase(PhaseDecode)
+	return l, nil
+}
+
+// index records the byte range of every section of the body, scanning the
+// raw tokens without resolving namespaces or building elements. The prolog
+// is vetted and its entities recorded as newDecoder does.
+func (l *LazyDocument) index(text []byte) error {
+	d := xml.NewDecoder(bytes.NewReader(text))
+	d.Strict = true
+	d.Entity = map[string]string{}
+	d.CharsetReader = func(label string, r io.Reader) (io.Reader, error) { return r, nil }
+	l.entities = d.Entity
+	// The prefixes are kept as written, since the sections are cut from the
+	// source text.
+	g := &guardedTokens{d: d, keepPrefixes: true}
+
+	// decls holds, for each open element, the namespace declarations in
+	// scope within it.
+	var decls [][]xml.Attr
+	for {
+		offset := d.InputOffset()
+		tok, err := g.next()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("failed to index sections: %w", err)
+		}
+		switch t := tok.(type) {
+		case xml.StartElement:
+			var scope []xml.Attr
+			if len(decls) > 0 {
+				scope = decls[len(decls)-1]
+			}
+			for _, a := range t.Attr {
+				if a.Name.Space == "xmlns" || a.Name.Space == "" && a.Name.Local == "xmlns" {
+					scope = append(scope[:len(scope):len(scope)], a)
+				}
+			}
+			if t.Name.Local != "section" || len(decls) < 2 {
+				decls = append(decls, scope)
+				continue
+			}
+			if err := skipRaw(g); err != nil {
+				return fmt.Errorf("failed to index sections: %w", err)
+			}
+			s := &LazySection{raw: wrapFragment(text[offset:d.InputOffset()], scope), entities: l.entities}
+			for _, a := range t.Attr {
+				switch {
+				case a.Name.Space != "":
+				case a.Name.Local == "id":
+					s.ID = a.Value
+				case a.Name.Local == "identifier":
+					s.Identifier = a.Value
+				}
+			}
+			l.sections = append(l.sections, s)
+		case xml.EndElement:
+			if len(decls) > 0 {
+				decls = decls[:len(decls)-1]
+			}
+		}
+	}
+}
+
+// skipRaw reads raw tokens up to the end of the element just started.
+func skipRaw(g *guardedTokens) error {
+	for depth := 1; depth > 0; {
+		tok, err := g.next()
+		if err != nil {
+			return err
+		}
+		switch tok.(type) {
+		case xml.StartElement:
+			depth++
+		case xml.EndElement:
+			depth--
+		}
+	}
+	return nil
+}
+
+// wrapFragment returns fragment inside an element carrying decls, so that
+// it decodes on its own with the namespaces it had in the document.
+func wrapFragment(fragment []byte, decls []xml.Attr) []byte {
+	var b bytes.Buffer
+	b.WriteString("<fragment")
+	for _, a := range decls {
+		b.WriteByte(' ')
+		if a.Name.Space != "" {
+			b.WriteString(a.Name.Space + ":")
+		}
+		b.WriteString(a.Name.Local + `="`)
+		xml.EscapeText(&b, []byte(a.Value))
+		b.WriteByte('"')
+	}
+	b.WriteByte('>')
+	b.Write(fragment)
+	b.WriteString("</fragment>")
+	return b.Bytes()
+}
+
+// Head returns the document with just its metadata and preface set, as
+// returned by ReadHeader.
+func (l *LazyDocument) Head() LegislativeDocument {
+	return l.head
+}
+
+// Sections returns the document's sections in document order.
+func (l *LazyDocument) Sections() []*LazySection {
+	return append([]*LazySection(nil), l.sections...)
+}
+
+// Section returns the section with the given identifier or, failing that,
+// id, decoding it if no caller has yet. It returns an error wrapping
+// ErrSectionNotFound if there is none.
+func (l *LazyDocument) Section(identifier string) (*Section, error) {
+	for _, s := range l.sections {
+		if s.Identifier == identifier {
+			return s.Section()
+		}
+	}
+	for _, s := range l.sections {
+		if s.ID == identifier {
+			return s.Section()
+		}
+	}
+	return nil, fmt.Errorf("%w: %q", ErrSectionNotFound, identifier)
+}
+
+// Document parses the whole document, once, and returns it. The sections
+// already decoded are not reused, as the full parse also records the
+// document's lexical forms and extensions.
+func (l *LazyDocument) Document() (LegislativeDocument, error) {
+	l.once.Do(func() {
+		l.doc, l.err = ParseDocument(l.data)
+	})
+	return l.doc, l.err
+}
+
+// Section decodes the section on first use and returns it. Every caller
+// gets the same *Section, which must not be modified.
+func (s *LazySection) Section() (*Section, error) {
+	s.once.Do(func() {
+		s.section, s.err = decodeFragmentSection(s.raw, s.entities)
+		s.raw, s.entities = nil, nil
+	})
+	return s.section, s.err
+}
+
+// decodeFragmentSection decodes the section wrapped by wrapFragment, behind a
+// DOCTYPE declaring the document's entities.
+func decodeFragmentSection(raw []byte, entities map[string]string) (*Section, error) {
+	var prolog bytes.Buffer
+	if len(entities) > 0 {
+		prolog.WriteString("<!DOCTYPE fragment [")
+		for name, value := range entities {
+			// checkDirective admits no value containing both quotes.
+			quote := `"`
+			if strings.Contains(value, quote) {
+				quote = "'"
+			}
+			prolog.WriteString("<!ENTITY " + name + " " + quote + value + quote + ">")
+		}
+		prolog.WriteString("]>")
+	}
+	d := newDecoder(io.MultiReader(&prolog, bytes.NewReader(raw)), ParseOptions{}, nil)
+	for {
+		tok, err := d.Token()
+		if err != nil {
+			return nil, fmt.Errorf("failed to read section: %w", err)
+		}
+		if start, ok := tok.(xml.StartElement); ok && start.Name.Local == "section" {
+			section := &Section{}
+			if err := d.DecodeElement(section, &start); err != nil {
+				return nil, fmt.Errorf("failed to parse section: %w", err)
+			}
+			return section, nil
+		}
+	}
+}
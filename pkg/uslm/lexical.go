@@ -0,0 +1,256 @@
+package uslm
+
+import (
+	"bytes"
+	"encoding/xml"
+	"io"
+	"regexp"
+	"strings"
+)
+
+// The parsed model holds text with CDATA sections unwrapped and entity
+// references replaced, and encoding/xml writes it back escaped its own way.
+// For republication, the spellings the source used are recorded as the
+// document is decoded and restored by MarshalDocumentToXMLWithOptions.
+
+// lexicalForms records how the source of a document spelled its text.
+type lexicalForms struct {
+	// doctype is the DOCTYPE directive, without its "<!" and ">".
+	doctype string
+
+	// spellings lists the CDATA sections and entity references in the
+	// order they occurred.
+	spellings []spelling
+}
+
+// spelling is text that the source wrote as source rather than as plain
+// escaped character data.
+type spelling struct {
+	text, source string
+}
+
+// entityRefPattern matches a named entity reference.
+var entityRefPattern = regexp.MustCompile(`&([A-Za-z_:][A-Za-z0-9_.:-]*);`)
+
+// predefinedEntities are the entities XML predefines whose replacement
+// encoding/xml writes differently (it writes &#34; and &#39;). It writes
+// &amp;, &lt;, and &gt; as the source would, so they need no recording.
+var predefinedEntities = map[string]string{"quot": `"`, "apos": "'"}
+
+// record notes the lexical forms of a token whose source bytes are src.
+// Entities declared by the DOCTYPE are looked up in entities.
+func (f *lexicalForms) record(tok xml.Token, src []byte, entities map[string]string) {
+	switch t := tok.(type) {
+	case xml.Directive:
+		if bytes.HasPrefix(t, []byte("DOCTYPE")) {
+			f.doctype = string(t)
+		}
+	case xml.CharData:
+		if bytes.HasPrefix(src, []byte("<![CDATA[")) {
+			if len(t) > 0 {
+				f.spellings = append(f.spellings, spelling{text: string(t), source: string(src)})
+			}
+			return
+		}
+		if bytes.IndexByte(src, '&') < 0 {
+			return
+		}
+		for _, m := range entityRefPattern.FindAllSubmatch(src, -1) {
+			name := string(m[1])
+			text, ok := predefinedEntities[name]
+			if !ok {
+				text, ok = entities[name]
+			}
+			if ok && text != "" {
+				f.spellings = append(f.spellings, spelling{text: text, source: string(m[0])})
+			}
+		}
+	}
+}
+
+// empty reports whether nothing was recorded worth restoring.
+func (f *lexicalForms) empty() bool {
+	return f == nil || (f.doctype == "" && len(f.spellings) == 0)
+}
+
+// restore respells the character data of data, the output of
+// MarshalDocumentToXML, with the recorded forms, and inserts the DOCTYPE
+// after the XML declaration.
+func (f *lexicalForms) restore(data []byte) ([]byte, error) {
+	var out bytes.Buffer
+	body := data
+	if bytes.HasPrefix(body, []byte(xml.Header)) {
+		out.WriteString(xml.Header)
+		body = body[len(xml.Header):]
+	}
+	if f.doctype != "" {
+		out.WriteString("<!" + f.doctype + ">\n")
+	}
+
+	pending := make([]spelling, len(f.spellings))
+	copy(pending, f.spellings)
+	d := xml.NewDecoder(bytes.NewReader(body))
+	var offset int64
+	for {
+		tok, err := d.RawToken()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		end := d.InputOffset()
+		raw := body[offset:end]
+		offset = end
+		if _, ok := tok.(xml.CharData); ok && len(pending) > 0 && !bytes.HasPrefix(raw, []byte("<![CDATA[")) {
+			var s string
+			s, pending = respell(string(raw), pending)
+			out.WriteString(s)
+			continue
+		}
+		out.Write(raw)
+	}
+	out.Write(body[offset:])
+	return out.Bytes(), nil
+}
+
+// respell replaces the escaped text of pending spellings in raw, escaped
+// character data, earliest first, and returns the spellings not yet used.
+func respell(raw string, pending []spelling) (string, []spelling) {
+	var b strings.Builder
+	for {
+		best, at := -1, -1
+		for i, s := range pending {
+			pos := indexEscaped(raw, escapeCharData(s.text))
+			if pos >= 0 && (at < 0 || pos < at || pos == at && len(s.text) > len(pending[best].text)) {
+				best, at = i, pos
+			}
+		}
+		if best < 0 {
+			b.WriteString(raw)
+			return b.String(), pending
+		}
+		b.WriteString(raw[:at])
+		b.WriteString(pending[best].source)
+		raw = raw[at+len(escapeCharData(pending[best].text)):]
+		pending = append(pending[:best:best], pending[best+1:]...)
+	}
+}
+
+// indexEscaped returns the index of the first occurrence of sub in the
+// escaped text s that does not begin inside a character reference.
+func indexEscaped(s, sub string) int {
+	for from := 0; from <= len(s); {
+		i := strings.Index(s[from:], sub)
+		if i < 0 {
+			return -1
+		}
+		i += from
+		if amp := strings.LastIndexByte(s[:i], '&'); amp < 0 || strings.IndexByte(s[amp:i], ';') >= 0 {
+			return i
+		}
+		from = i + 1
+	}
+	return -1
+}
+
+// escapeCharData escapes text as encoding/xml escapes character data, which
+// unlike xml.EscapeText leaves newlines as they are.
+func escapeCharData(text string) string {
+	var b strings.Builder
+	xml.EscapeText(&b, []byte(text))
+	return strings.ReplaceAll(b.String(), "&#xA;", "\n")
+}
+
+// sourceTap is a reader that keeps the bytes read through it from the end
+// of the last token onward, so the source of each token can be examined.
+// Offsets are those of the decoder, which counts the input after any
+// charset conversion; see convert.
+type sourceTap struct {
+	r    io.Reader
+	buf  []byte
+	base int64 // input offset of buf[0]
+
+	// converted is set once the decoder reads through a charset reader,
+	// whose output is kept in place of the bytes read here.
+	converted bool
+}
+
+func (t *sourceTap) Read(p []byte) (int, error) {
+	n, err := t.r.Read(p)
+	if !t.converted {
+		t.buf = append(t.buf, p[:n]...)
+	}
+	return n, err
+}
+
+// convert returns a reader over r, the charset conversion of the input from
+// offset on, that keeps the bytes read through it in place of those t has
+// read past offset.
+func (t *sourceTap) convert(r io.Reader, offset int64) io.Reader {
+	if keep := offset - t.base; keep >= 0 && keep <= int64(len(t.buf)) {
+		t.buf = t.buf[:keep]
+	}
+	t.converted = true
+	return &convertedTap{r: r, t: t}
+}
+
+// convertedTap is the reader returned by sourceTap.convert.
+type convertedTap struct {
+	r io.Reader
+	t *sourceTap
+}
+
+func (c *convertedTap) Read(p []byte) (int, error) {
+	n, err := c.r.Read(p)
+	c.t.buf = append(c.t.buf, p[:n]...)
+	return n, err
+}
+
+// token returns the source bytes from offset from to offset to and discards
+// those before to.
+func (t *sourceTap) token(from, to int64) []byte {
+	if from < t.base || to > t.base+int64(len(t.buf)) || from > to {
+		return nil
+	}
+	src := t.buf[from-t.base : to-t.base]
+	t.buf = t.buf[to-t.base:]
+	t.base = to
+	return src
+}
+
+// setLexical stores the recorded forms on doc, unless there are none.
+func setLexical(doc interface{}, forms *lexicalForms) {
+	if forms.empty() {
+		return
+	}
+	switch d := doc.(type) {
+	case *Bill:
+		d.lexical = forms
+	case *Resolution:
+		d.lexical = forms
+	case *EngrossedAmendment:
+		d.lexical = forms
+	case *Amendment:
+		d.lexical = forms
+	case *GenericDocument:
+		d.lexical = forms
+	}
+}
+
+// lexicalOf returns the forms recorded for doc, or nil.
+func lexicalOf(doc LegislativeDocument) *lexicalForms {
+	switch d := doc.(type) {
+	case *Bill:
+		return d.lexical
+	case *Resolution:
+		return d.lexical
+	case *EngrossedAmendment:
+		return d.lexical
+	case *Amendment:
+		return d.lexical
+	case *GenericDocument:
+		return d.lexical
+	}
+	return nil
+}
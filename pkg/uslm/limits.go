@@ -0,0 +1,183 @@
+package uslm
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// ErrLimitExceeded is returned when a document exceeds a decoding limit.
+var ErrLimitExceeded = errors.New("limit exceeded")
+
+// DefaultMaxBytes is the input size allowed by DefaultLimits.
+const DefaultMaxBytes = 256 << 20
+
+// Limits bounds the resources a single parse may consume. They are enforced
+// while decoding, so an oversized or hostile input fails early instead of
+// being read in full. Zero values mean no limit.
+type Limits struct {
+	// MaxBytes is the largest input accepted, in bytes.
+	MaxBytes int64
+
+	// MaxDepth is the deepest element nesting accepted; the root element is
+	// at depth 1.
+	MaxDepth int
+
+	// MaxAttrLength is the longest attribute value accepted, in bytes.
+	MaxAttrLength int
+}
+
+// DefaultLimits are generous for real legislation (the deepest bills nest a
+// few dozen elements) while bounding what a service parsing public uploads
+// will spend on one request.
+var DefaultLimits = Limits{
+	MaxBytes:      DefaultMaxBytes,
+	MaxDepth:      256,
+	MaxAttrLength: 64 << 10,
+}
+
+// ParseDocumentWithLimits is like ParseDocument but enforces limits,
+// returning an error wrapping ErrLimitExceeded when data exceeds them.
+func ParseDocumentWithLimits(data []byte, limits Limits) (LegislativeDocument, error) {
+	return ParseDocumentWithOptions(data, ParseOptions{Limits: limits})
+}
+
+// guardedTokens supplies raw tokens to the decoder returned by newDecoder,
+// enforcing depth and attribute limits and vetting directives in the prolog.
+type guardedTokens struct {
+	d        *xml.Decoder
+	limits   Limits
+	run      *parseRun
+	tracker  *elementTracker
+	progress *progressTracker
+	depth    int
+	seenRoot bool
+
+	// lexical, if set, records the lexical forms of the tokens, read from
+	// source.
+	lexical *lexicalForms
+	source  *sourceTap
+	offset  int64
+
+	// extensions, if set, receives the elements decoded by registered
+	// handlers; scopes tracks the open elements for them, and capturing is
+	// set while a handled element is read.
+	extensions *extensionRecorder
+	scopes     []elementScope
+	capturing  bool
+
+	// senatePrefixes holds the prefixes bound to NamespaceSenate, which are
+	// rebound to senatePrefix unless keepPrefixes is set.
+	senatePrefixes map[string]bool
+	keepPrefixes   bool
+}
+
+func (g *guardedTokens) Token() (xml.Token, error) {
+	for {
+		tok, err := g.next()
+		if err != nil {
+			return nil, err
+		}
+		switch t := tok.(type) {
+		case xml.StartElement:
+			if g.extensions != nil && g.depth > 1 {
+				if h := g.extensions.handlers[t.Name.Local]; h != nil {
+					if err := g.handle(h, t); err != nil {
+						return nil, err
+					}
+					continue
+				}
+			}
+			if g.tracker != nil {
+				if err := g.tracker.start(t, lineOf(g.d)); err != nil {
+					return nil, err
+				}
+			}
+		case xml.EndElement:
+			if g.tracker != nil {
+				g.tracker.end()
+			}
+		}
+		return tok, nil
+	}
+}
+
+// next reads the next raw token, enforcing the limits and recording it for
+// the lexical forms, progress, and observer.
+func (g *guardedTokens) next() (xml.Token, error) {
+	tok, err := g.d.RawToken()
+	if err != nil {
+		return nil, err
+	}
+	if g.lexical != nil {
+		end := g.d.InputOffset()
+		src := g.source.token(g.offset, end)
+		if !g.capturing {
+			g.lexical.record(tok, src, g.d.Entity)
+		}
+		g.offset = end
+	}
+	if !g.keepPrefixes {
+		tok = g.bindSenatePrefix(tok)
+	}
+
+	switch t := tok.(type) {
+	case xml.StartElement:
+		g.seenRoot = true
+		g.depth++
+		g.run.element(t.Name.Local)
+		g.progress.element()
+		if g.extensions != nil {
+			g.pushScope(t)
+		}
+		if max := g.limits.MaxDepth; max > 0 && g.depth > max {
+			return nil, fmt.Errorf("%w: elements nested deeper than %d", ErrLimitExceeded, max)
+		}
+		if max := g.limits.MaxAttrLength; max > 0 {
+			for _, a := range t.Attr {
+				if len(a.Value) > max {
+					return nil, fmt.Errorf("%w: attribute %s on <%s> exceeds %d bytes", ErrLimitExceeded, a.Name.Local, t.Name.Local, max)
+				}
+			}
+		}
+	case xml.EndElement:
+		g.depth--
+		if g.extensions != nil && len(g.scopes) > 0 {
+			g.scopes = g.scopes[:len(g.scopes)-1]
+		}
+		if g.depth == 0 {
+			g.progress.done()
+		}
+	case xml.Directive:
+		if !g.seenRoot {
+			if err := checkDirective(g.d, t); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return tok, nil
+}
+
+// sizeLimitReader reads from r, failing once more than limit bytes have been
+// read.
+type sizeLimitReader struct {
+	r     io.Reader
+	limit int64
+	n     int64
+}
+
+func (l *sizeLimitReader) Read(p []byte) (int, error) {
+	if l.n > l.limit {
+		return 0, fmt.Errorf("%w: document exceeds %d bytes", ErrLimitExceeded, l.limit)
+	}
+	if max := l.limit - l.n + 1; int64(len(p)) > max {
+		p = p[:max]
+	}
+	n, err := l.r.Read(p)
+	l.n += int64(n)
+	if l.n > l.limit {
+		return n, fmt.Errorf("%w: document exceeds %d bytes", ErrLimitExceeded, l.limit)
+	}
+	return n, err
+}
@@ -0,0 +1,177 @@
+package uslm
+
+import (
+	"fmt"
+	"strings"
+)
+
+// IssueKind classifies a problem reported by a document check.
+type IssueKind string
+
+const (
+	IssueDuplicateID            IssueKind = "duplicateId"
+	IssueDuplicateIdentifier    IssueKind = "duplicateIdentifier"
+	IssueInconsistentIdentifier IssueKind = "inconsistentIdentifier"
+	IssueMissingField           IssueKind = "missingField"
+	IssueInvalidPreface         IssueKind = "invalidPreface"
+	IssueInconsistentPreface    IssueKind = "inconsistentPreface"
+)
+
+// Issue describes a single problem found in a document.
+type Issue struct {
+	Kind       IssueKind `json:"kind"`
+	Element    string    `json:"element"`
+	ID         string    `json:"id,omitempty"`
+	Identifier string    `json:"identifier,omitempty"`
+	Message    string    `json:"message"`
+}
+
+// String returns a human-readable description of the issue.
+func (i Issue) String() string {
+	return fmt.Sprintf("%s: %s", i.Kind, i.Message)
+}
+
+// Error implements error, so an Issue can be found with errors.As in the
+// error returned by Validate.
+func (i Issue) Error() string {
+	return i.String()
+}
+
+// ValidationError reports every issue Validate found in a document.
+type ValidationError struct {
+	Issues []Issue
+}
+
+func (e *ValidationError) Error() string {
+	if len(e.Issues) == 1 {
+		return e.Issues[0].Error()
+	}
+	lines := make([]string, len(e.Issues))
+	for i, issue := range e.Issues {
+		lines[i] = issue.Error()
+	}
+	return fmt.Sprintf("%d issues:\n%s", len(e.Issues), strings.Join(lines, "\n"))
+}
+
+// Unwrap returns the issues as errors, for errors.Is and errors.As.
+func (e *ValidationError) Unwrap() []error {
+	errs := make([]error, len(e.Issues))
+	for i, issue := range e.Issues {
+		errs[i] = issue
+	}
+	return errs
+}
+
+// Validate runs every document check (CheckRequiredFields, CheckIdentifiers,
+// then CheckPreface) and returns all the issues found as a *ValidationError,
+// or nil if there are none.
+func Validate(doc LegislativeDocument) error {
+	issues := append(CheckRequiredFields(doc), CheckIdentifiers(doc)...)
+	issues = append(issues, CheckPreface(doc)...)
+	if len(issues) == 0 {
+		return nil
+	}
+	return &ValidationError{Issues: issues}
+}
+
+// CheckIdentifiers scans the id and identifier attributes of every hierarchical
+// level in the document and reports duplicates, as well as identifiers that are
+// inconsistent with the element's position: an identifier must extend its
+// parent's identifier (or the document identifier, for top-level elements).
+func CheckIdentifiers(doc LegislativeDocument) []Issue {
+	var issues []Issue
+	seenIDs := make(map[string]string)
+	seenIdentifiers := make(map[string]string)
+	base := documentIdentifier(doc.GetCitations())
+
+	walkDocumentLevels(doc, func(l *level) bool {
+		id, identifier := *l.id, *l.identifier
+
+		if id != "" {
+			if first, ok := seenIDs[id]; ok {
+				issues = append(issues, Issue{
+					Kind:    IssueDuplicateID,
+					Element: l.element,
+					ID:      id,
+					Message: fmt.Sprintf("%s id %q already used by a %s", l.element, id, first),
+				})
+			} else {
+				seenIDs[id] = l.element
+			}
+		}
+
+		if identifier == "" {
+			return true
+		}
+		if first, ok := seenIdentifiers[identifier]; ok {
+			issues = append(issues, Issue{
+				Kind:       IssueDuplicateIdentifier,
+				Element:    l.element,
+				ID:         id,
+				Identifier: identifier,
+				Message:    fmt.Sprintf("%s identifier %q already used by a %s", l.element, identifier, first),
+			})
+		} else {
+			seenIdentifiers[identifier] = l.element
+		}
+
+		expected := base
+		if l.parent != nil {
+			expected = *l.parent.identifier
+		}
+		if expected != "" && !strings.HasPrefix(identifier, expected+"/") {
+			issues = append(issues, Issue{
+				Kind:       IssueInconsistentIdentifier,
+				Element:    l.element,
+				ID:         id,
+				Identifier: identifier,
+				Message:    fmt.Sprintf("%s identifier %q does not extend %q", l.element, identifier, expected),
+			})
+		}
+		return true
+	})
+
+	return issues
+}
+
+// CheckRequiredFields reports fields every document is expected to carry
+// that are missing or empty: the metadata block and its type, number,
+// congress, and title, and the value attribute of every <num>. Documents
+// with such gaps still parse, but lookups and identifiers built from them
+// come out blank.
+func CheckRequiredFields(doc LegislativeDocument) []Issue {
+	meta := doc.GetMeta()
+	if meta == nil {
+		return []Issue{{Kind: IssueMissingField, Element: "meta", Message: "document has no metadata block"}}
+	}
+
+	var issues []Issue
+	for _, field := range []struct{ name, value string }{
+		{"dc:type", meta.DCType},
+		{"docNumber", meta.DocNumber},
+		{"congress", meta.Congress},
+		{"dc:title", meta.DCTitle},
+	} {
+		if strings.TrimSpace(field.value) == "" {
+			issues = append(issues, Issue{
+				Kind:    IssueMissingField,
+				Element: field.name,
+				Message: fmt.Sprintf("metadata %s is empty", field.name),
+			})
+		}
+	}
+
+	walkDocumentLevels(doc, func(l *level) bool {
+		if l.num != nil && l.num.Value == "" {
+			issues = append(issues, Issue{
+				Kind:       IssueMissingField,
+				Element:    l.element,
+				ID:         *l.id,
+				Identifier: *l.identifier,
+				Message:    fmt.Sprintf("%s num %q has no value attribute", l.element, strings.TrimSpace(l.num.Text)),
+			})
+		}
+		return true
+	})
+	return issues
+}
@@ -0,0 +1,153 @@
+// Package lint checks USLM documents against drafting rules. Each check is
+// a Rule; rules are collected in a Registry and run together, reporting their
+// findings as uslm.Issue values whose Kind is the name of the rule.
+//
+// The package ships built-in rules for common drafting errors (see Builtin)
+// and a default registry holding them. Offices with their own drafting
+// standards add rules with Register:
+//
+//	lint.Register(lint.NewRule("shortTitleFirst", func(doc uslm.LegislativeDocument) []uslm.Issue {
+//		...
+//	}))
+//	issues := lint.Check(doc)
+package lint
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/usgpo/uslm/pkg/uslm"
+)
+
+// Rule is a single lint check.
+type Rule interface {
+	// Name identifies the rule. It is unique within a registry and is used
+	// as the Kind of the issues the rule reports.
+	Name() string
+
+	// Check returns the problems the rule finds in doc.
+	Check(doc uslm.LegislativeDocument) []uslm.Issue
+}
+
+// NewRule returns a Rule with the given name that runs check.
+func NewRule(name string, check func(doc uslm.LegislativeDocument) []uslm.Issue) Rule {
+	return funcRule{name: name, check: check}
+}
+
+type funcRule struct {
+	name  string
+	check func(doc uslm.LegislativeDocument) []uslm.Issue
+}
+
+func (r funcRule) Name() string { return r.name }
+
+func (r funcRule) Check(doc uslm.LegislativeDocument) []uslm.Issue { return r.check(doc) }
+
+// Registry holds an ordered set of rules. It is safe for concurrent use.
+type Registry struct {
+	mu    sync.RWMutex
+	rules []Rule
+}
+
+// NewRegistry returns a registry holding rules, which must have distinct
+// names. It panics otherwise, as the rules are fixed by the caller.
+func NewRegistry(rules ...Rule) *Registry {
+	r := &Registry{}
+	for _, rule := range rules {
+		if err := r.Register(rule); err != nil {
+			panic(err)
+		}
+	}
+	return r
+}
+
+// Register adds rule to the registry. It fails if a rule with the same name
+// is already registered.
+func (r *Registry) Register(rule Rule) error {
+	name := rule.Name()
+	if name == "" {
+		return fmt.Errorf("rule has no name")
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, existing := range r.rules {
+		if existing.Name() == name {
+			return fmt.Errorf("rule %q already registered", name)
+		}
+	}
+	r.rules = append(r.rules, rule)
+	return nil
+}
+
+// Unregister removes the rule with the given name, reporting whether there
+// was one.
+func (r *Registry) Unregister(name string) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for i, rule := range r.rules {
+		if rule.Name() == name {
+			r.rules = append(r.rules[:i:i], r.rules[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+// Lookup returns the rule with the given name.
+func (r *Registry) Lookup(name string) (Rule, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	for _, rule := range r.rules {
+		if rule.Name() == name {
+			return rule, true
+		}
+	}
+	return nil, false
+}
+
+// Rules returns the registered rules in registration order.
+func (r *Registry) Rules() []Rule {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return append([]Rule(nil), r.rules...)
+}
+
+// Check runs every registered rule over doc, in registration order, and
+// returns their issues. An issue whose Kind the rule left empty is given the
+// rule's name.
+func (r *Registry) Check(doc uslm.LegislativeDocument) []uslm.Issue {
+	var issues []uslm.Issue
+	for _, rule := range r.Rules() {
+		for _, issue := range rule.Check(doc) {
+			if issue.Kind == "" {
+				issue.Kind = uslm.IssueKind(rule.Name())
+			}
+			issues = append(issues, issue)
+		}
+	}
+	return issues
+}
+
+// defaultRegistry holds the built-in rules and those added with Register.
+var defaultRegistry = NewRegistry(Builtin()...)
+
+// Register adds rule to the default registry.
+func Register(rule Rule) error {
+	return defaultRegistry.Register(rule)
+}
+
+// Unregister removes the named rule from the default registry, for example
+// to turn off a built-in rule.
+func Unregister(name string) bool {
+	return defaultRegistry.Unregister(name)
+}
+
+// Rules returns the rules of the default registry.
+func Rules() []Rule {
+	return defaultRegistry.Rules()
+}
+
+// Check runs the rules of the default registry over doc.
+func Check(doc uslm.LegislativeDocument) []uslm.Issue {
+	return defaultRegistry.Check(doc)
+}
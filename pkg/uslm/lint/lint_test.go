@@ -0,0 +1,211 @@
+package lint
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/usgpo/uslm/pkg/uslm"
+)
+
+// parseBill parses a bill whose <main> holds body.
+func parseBill(t *testing.T, body string) uslm.LegislativeDocument {
+	t.Helper()
+	doc, err := uslm.ParseDocument([]byte(`<bill xmlns="http://schemas.gpo.gov/xml/uslm"><main>` + body + `</main></bill>`))
+	if err != nil {
+		t.Fatalf("failed to parse bill: %v", err)
+	}
+	return doc
+}
+
+// messages returns the messages of issues, checking that each is of kind.
+func messages(t *testing.T, issues []uslm.Issue, kind string) []string {
+	t.Helper()
+	var msgs []string
+	for _, issue := range issues {
+		if string(issue.Kind) != kind {
+			t.Errorf("issue %q has kind %q, want %q", issue.Message, issue.Kind, kind)
+		}
+		msgs = append(msgs, issue.Message)
+	}
+	return msgs
+}
+
+// corpusCounts runs rules over every sample document and counts the issues
+// of each kind.
+func corpusCounts(t *testing.T, rules ...Rule) map[uslm.IssueKind]int {
+	t.Helper()
+	dir := filepath.Join("..", "..", "..", "bill-version-samples-september-2024")
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		t.Fatalf("failed to read samples: %v", err)
+	}
+	r := NewRegistry(rules...)
+	counts := make(map[uslm.IssueKind]int)
+	for _, e := range entries {
+		if !strings.EqualFold(filepath.Ext(e.Name()), ".xml") {
+			continue
+		}
+		data, err := os.ReadFile(filepath.Join(dir, e.Name()))
+		if err != nil {
+			t.Fatalf("failed to read %s: %v", e.Name(), err)
+		}
+		doc, err := uslm.ParseDocument(data)
+		if err != nil {
+			t.Fatalf("failed to parse %s: %v", e.Name(), err)
+		}
+		for _, issue := range r.Check(doc) {
+			counts[issue.Kind]++
+		}
+	}
+	return counts
+}
+
+func ruleNames(rules []Rule) []string {
+	names := make([]string, len(rules))
+	for i, r := range rules {
+		names[i] = r.Name()
+	}
+	return names
+}
+
+func TestRegistry(t *testing.T) {
+	first := NewRule("first", func(uslm.LegislativeDocument) []uslm.Issue {
+		return []uslm.Issue{{Message: "from first"}}
+	})
+	second := NewRule("second", func(uslm.LegislativeDocument) []uslm.Issue {
+		return []uslm.Issue{{Kind: "custom", Message: "from second"}}
+	})
+	r := NewRegistry(second)
+	if err := r.Register(first); err != nil {
+		t.Fatalf("failed to register: %v", err)
+	}
+	if got := ruleNames(r.Rules()); !reflect.DeepEqual(got, []string{"second", "first"}) {
+		t.Errorf("rules in order %v, want registration order", got)
+	}
+	if err := r.Register(NewRule("first", nil)); err == nil || !strings.Contains(err.Error(), "already registered") {
+		t.Errorf("expected a duplicate name to be refused, got %v", err)
+	}
+	if err := r.Register(NewRule("", nil)); err == nil {
+		t.Error("expected a rule without a name to be refused")
+	}
+
+	// Issues come back in registration order, with empty kinds filled in
+	// from the rule's name.
+	issues := r.Check(parseBill(t, ""))
+	if len(issues) != 2 || issues[0].Kind != "custom" || issues[0].Message != "from second" || issues[1].Kind != "first" {
+		t.Errorf("unexpected issues %+v", issues)
+	}
+
+	if rule, ok := r.Lookup("first"); !ok || rule.Name() != "first" {
+		t.Errorf("Lookup(first) = %v, %v", rule, ok)
+	}
+	if !r.Unregister("second") || r.Unregister("second") {
+		t.Error("expected Unregister to remove the rule once")
+	}
+	if _, ok := r.Lookup("second"); ok {
+		t.Error("expected second to be gone")
+	}
+	if got := ruleNames(r.Rules()); !reflect.DeepEqual(got, []string{"first"}) {
+		t.Errorf("rules after Unregister %v", got)
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("expected NewRegistry to panic on duplicate names")
+		}
+	}()
+	NewRegistry(first, first)
+}
+
+func TestDefaultRegistry(t *testing.T) {
+	want := []string{RuleMissingEnactingFormula, RuleEmptyHeading, RuleSectionWithoutIdentifier, RuleChapeauWithoutChildren}
+	if got := ruleNames(Rules()); !reflect.DeepEqual(got, want) {
+		t.Fatalf("default rules %v, want %v", got, want)
+	}
+	if got := ruleNames(Builtin()); !reflect.DeepEqual(got, want) {
+		t.Errorf("Builtin() = %v, want %v", got, want)
+	}
+
+	doc := parseBill(t, `<section><num value="1">SEC. 1.</num><heading>SHORT TITLE.</heading></section>`)
+	if issues := Check(doc); len(issues) != 2 {
+		t.Errorf("expected a missing formula and identifier, got %v", issues)
+	}
+
+	custom := NewRule("shortTitleFirst", func(uslm.LegislativeDocument) []uslm.Issue {
+		return []uslm.Issue{{Message: "custom"}}
+	})
+	if err := Register(custom); err != nil {
+		t.Fatalf("failed to register: %v", err)
+	}
+	defer Unregister(custom.Name())
+	if !Unregister(RuleMissingEnactingFormula) {
+		t.Fatal("expected to unregister a built-in rule")
+	}
+	defer Register(NewRule(RuleMissingEnactingFormula, checkEnactingFormula))
+
+	issues := Check(doc)
+	if len(issues) != 2 || issues[0].Kind != RuleSectionWithoutIdentifier || issues[1].Kind != "shortTitleFirst" {
+		t.Errorf("unexpected issues %v", issues)
+	}
+}
+
+func TestBuiltinRules(t *testing.T) {
+	const formula = `<enactingFormula>Be it enacted by the Senate and House of Representatives</enactingFormula>`
+	for _, tc := range []struct {
+		rule string
+		body string
+		want []string
+	}{
+		{RuleMissingEnactingFormula, formula, nil},
+		{RuleMissingEnactingFormula, ``, []string{"bill has no enacting formula"}},
+		{RuleMissingEnactingFormula, `<enactingFormula> </enactingFormula>`, []string{"bill has no enacting formula"}},
+		{RuleMissingEnactingFormula, `<enactingFormula><i>Be it enacted</i></enactingFormula>`, nil},
+
+		{RuleEmptyHeading, `<section identifier="/us/bill/1/hr/1/s1"><num value="1">SEC. 1.</num><heading>SHORT TITLE.</heading></section>`, nil},
+		{RuleEmptyHeading, `<section identifier="/us/bill/1/hr/1/s1"><num value="1">SEC. 1.</num></section>`, nil},
+		{RuleEmptyHeading, `<section identifier="/us/bill/1/hr/1/s1"><num value="1">SEC. 1.</num><heading> </heading></section>`, []string{"Section 1 has an empty heading"}},
+		{RuleEmptyHeading, `<section identifier="/us/bill/1/hr/1/s1"><num value="1">SEC. 1.</num><heading>A</heading><subsection identifier="/us/bill/1/hr/1/s1/a"><num value="a">(a)</num><heading/><content>Text.</content></subsection></section>`, []string{"Section 1(a) has an empty heading"}},
+
+		{RuleSectionWithoutIdentifier, `<section identifier="/us/bill/1/hr/1/s1"><num value="1">SEC. 1.</num></section>`, nil},
+		{RuleSectionWithoutIdentifier, `<section><num value="1">SEC. 1.</num></section>`, []string{`section "SEC. 1." has no identifier`}},
+		{RuleSectionWithoutIdentifier, `<section id="S1"><content>Text.</content></section>`, []string{`section with id "S1" has no identifier`}},
+		{RuleSectionWithoutIdentifier, `<section><content>Text.</content></section>`, []string{"unnumbered section has no identifier"}},
+		{RuleSectionWithoutIdentifier, `<section identifier="/us/bill/1/hr/1/s1"><subsection><num value="a">(a)</num></subsection></section>`, nil},
+
+		{RuleChapeauWithoutChildren, `<section identifier="/us/bill/1/hr/1/s1"><num value="1">SEC. 1.</num><chapeau>The following—</chapeau><paragraph identifier="/us/bill/1/hr/1/s1/1"><num value="1">(1)</num><content>One.</content></paragraph></section>`, nil},
+		{RuleChapeauWithoutChildren, `<section identifier="/us/bill/1/hr/1/s1"><num value="1">SEC. 1.</num><chapeau>The following—</chapeau></section>`, []string{"Section 1 has a chapeau but no sublevels"}},
+	} {
+		rule, ok := defaultRegistry.Lookup(tc.rule)
+		if !ok {
+			t.Fatalf("no rule %s", tc.rule)
+		}
+		got := messages(t, rule.Check(parseBill(t, tc.body)), tc.rule)
+		if !reflect.DeepEqual(got, tc.want) {
+			t.Errorf("%s on %s:\n got %q\nwant %q", tc.rule, tc.body, got, tc.want)
+		}
+	}
+
+	// Only bills need an enacting formula.
+	resolution, err := uslm.ParseDocument([]byte(`<resolution xmlns="http://schemas.gpo.gov/xml/uslm"><main><section><num value="1">1.</num></section></main></resolution>`))
+	if err != nil {
+		t.Fatalf("failed to parse resolution: %v", err)
+	}
+	if issues := checkEnactingFormula(resolution); len(issues) != 0 {
+		t.Errorf("expected no formula issue for a resolution, got %v", issues)
+	}
+}
+
+// TestBuiltinRulesCorpus pins what the built-in rules find in the samples.
+func TestBuiltinRulesCorpus(t *testing.T) {
+	counts := corpusCounts(t, Builtin()...)
+	want := map[uslm.IssueKind]int{
+		RuleSectionWithoutIdentifier: 73,
+		RuleChapeauWithoutChildren:   18,
+	}
+	if !reflect.DeepEqual(counts, want) {
+		t.Errorf("issues over the samples %v, want %v", counts, want)
+	}
+}
@@ -0,0 +1,130 @@
+package lint
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/usgpo/uslm/pkg/uslm"
+)
+
+// Names of the built-in rules.
+const (
+	RuleMissingEnactingFormula   = "missingEnactingFormula"
+	RuleEmptyHeading             = "emptyHeading"
+	RuleSectionWithoutIdentifier = "sectionWithoutIdentifier"
+	RuleChapeauWithoutChildren   = "chapeauWithoutChildren"
+)
+
+// Builtin returns the built-in rules, in the order the default registry
+// runs them:
+//
+//	missingEnactingFormula    a bill with no "Be it enacted" formula
+//	emptyHeading              a level with a <heading> that has no text
+//	sectionWithoutIdentifier  a section with no identifier attribute
+//	chapeauWithoutChildren    a level whose chapeau introduces no sublevels
+func Builtin() []Rule {
+	return []Rule{
+		NewRule(RuleMissingEnactingFormula, checkEnactingFormula),
+		NewRule(RuleEmptyHeading, checkEmptyHeadings),
+		NewRule(RuleSectionWithoutIdentifier, checkSectionIdentifiers),
+		NewRule(RuleChapeauWithoutChildren, checkChapeaus),
+	}
+}
+
+// checkEnactingFormula reports a bill whose main body has no enacting
+// formula. Other document types are enacted or adopted by other clauses.
+func checkEnactingFormula(doc uslm.LegislativeDocument) []uslm.Issue {
+	bill, ok := doc.(*uslm.Bill)
+	if !ok || bill.Main == nil {
+		return nil
+	}
+	if f := bill.Main.EnactingFormula; f != nil {
+		text := f.Text
+		for _, i := range f.I {
+			text += i.Text
+		}
+		if strings.TrimSpace(text) != "" {
+			return nil
+		}
+	}
+	return []uslm.Issue{{
+		Kind:    RuleMissingEnactingFormula,
+		Element: "enactingFormula",
+		Message: "bill has no enacting formula",
+	}}
+}
+
+func checkEmptyHeadings(doc uslm.LegislativeDocument) []uslm.Issue {
+	return walkIssues(doc, func(p *uslm.Provision) (string, bool) {
+		if p.Heading == nil || strings.TrimSpace(p.Heading.PlainText()) != "" {
+			return "", false
+		}
+		return label(p) + " has an empty heading", true
+	}, RuleEmptyHeading)
+}
+
+func checkSectionIdentifiers(doc uslm.LegislativeDocument) []uslm.Issue {
+	return walkIssues(doc, func(p *uslm.Provision) (string, bool) {
+		if p.Element != "section" || p.Identifier != "" {
+			return "", false
+		}
+		return label(p) + " has no identifier", true
+	}, RuleSectionWithoutIdentifier)
+}
+
+func checkChapeaus(doc uslm.LegislativeDocument) []uslm.Issue {
+	return walkIssues(doc, func(p *uslm.Provision) (string, bool) {
+		if p.Chapeau == nil || len(p.Children) > 0 {
+			return "", false
+		}
+		return label(p) + " has a chapeau but no sublevels", true
+	}, RuleChapeauWithoutChildren)
+}
+
+// walkIssues visits every provision of doc and reports an issue of the given
+// kind, with the message from check, for each one check flags.
+func walkIssues(doc uslm.LegislativeDocument, check func(p *uslm.Provision) (string, bool), kind string) []uslm.Issue {
+	return walkMessages(doc, func(p *uslm.Provision) []string {
+		if msg, ok := check(p); ok {
+			return []string{msg}
+		}
+		return nil
+	}, kind)
+}
+
+// walkMessages is walkIssues for checks that can find several problems in
+// one provision: it reports an issue for each message check returns.
+func walkMessages(doc uslm.LegislativeDocument, check func(p *uslm.Provision) []string, kind string) []uslm.Issue {
+	var issues []uslm.Issue
+	for _, top := range uslm.Provisions(doc) {
+		top.Walk(func(p *uslm.Provision) bool {
+			for _, msg := range check(p) {
+				issues = append(issues, uslm.Issue{
+					Kind:       uslm.IssueKind(kind),
+					Element:    p.Element,
+					ID:         p.ID,
+					Identifier: p.Identifier,
+					Message:    msg,
+				})
+			}
+			return true
+		})
+	}
+	return issues
+}
+
+// label returns how an issue message names p: its citation, such as
+// "Section 3(a)", when it has an identifier, and otherwise its element with
+// its number or, for an unnumbered level, its id.
+func label(p *uslm.Provision) string {
+	switch num := strings.TrimSpace(p.GetNum()); {
+	case p.Identifier != "":
+		return p.PathString()
+	case num != "":
+		return fmt.Sprintf("%s %q", p.Element, num)
+	case p.ID != "":
+		return fmt.Sprintf("%s with id %q", p.Element, p.ID)
+	default:
+		return "unnumbered " + p.Element
+	}
+}
@@ -0,0 +1,136 @@
+package lint
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/usgpo/uslm/pkg/uslm"
+)
+
+// Names of the drafting-style rules.
+const (
+	RuleHeadingCase    = "headingCase"
+	RuleQuotationMarks = "quotationMarks"
+	RuleDashUsage      = "dashUsage"
+)
+
+// DraftingStyle returns rules codifying the drafting conventions of the House
+// and Senate Offices of Legislative Counsel. They are not in the default
+// registry, since documents converted from other sources often follow other
+// conventions; offices generating USLM add them to a registry of their own:
+//
+//	r := lint.NewRegistry(append(lint.Builtin(), lint.DraftingStyle()...)...)
+//
+// The rules are:
+//
+//	headingCase     title and section headings not in capitals, or lower
+//	                level headings not in sentence case
+//	quotationMarks  straight quotation marks, quoted text not set off by
+//	                curly quotation marks, and amendatory text whose
+//	                punctuation falls inside the closing quotation mark
+//	dashUsage       hyphens standing in for an em dash, chapeaus not ending
+//	                in an em dash, and lower level headings not ending ".—"
+func DraftingStyle() []Rule {
+	return []Rule{
+		NewRule(RuleHeadingCase, checkHeadingCase),
+		NewRule(RuleQuotationMarks, checkQuotationMarks),
+		NewRule(RuleDashUsage, checkDashUsage),
+	}
+}
+
+// capitalHeadings are the levels whose headings are set in capitals
+// ("SEC. 2. SHORT TITLE."). Headings of the levels below a section are set in
+// small caps from sentence-case text ("In general.—").
+var capitalHeadings = map[string]bool{"title": true, "section": true}
+
+func checkHeadingCase(doc uslm.LegislativeDocument) []uslm.Issue {
+	return walkMessages(doc, func(p *uslm.Provision) []string {
+		if p.Heading == nil {
+			return nil
+		}
+		heading := p.Heading.PlainText()
+		first := strings.IndexFunc(heading, unicode.IsLetter)
+		if first < 0 {
+			return nil
+		}
+		upper := strings.ToUpper(heading) == heading
+		switch {
+		case capitalHeadings[p.Element] && !upper:
+			return []string{fmt.Sprintf("%s heading %q is not in capitals", label(p), heading)}
+		case capitalHeadings[p.Element]:
+			return nil
+		case upper && len(strings.Fields(heading)) > 1:
+			return []string{fmt.Sprintf("%s heading %q is in capitals, not sentence case", label(p), heading)}
+		case !unicode.IsUpper([]rune(heading[first:])[0]):
+			return []string{fmt.Sprintf("%s heading %q does not begin with a capital", label(p), heading)}
+		}
+		return nil
+	}, RuleHeadingCase)
+}
+
+func checkQuotationMarks(doc uslm.LegislativeDocument) []uslm.Issue {
+	return walkMessages(doc, func(p *uslm.Provision) []string {
+		var msgs []string
+		for _, part := range textParts(p) {
+			if strings.Contains(part.text, `"`) || strings.Contains(part.text, "``") || strings.Contains(part.text, "''") {
+				msgs = append(msgs, label(p)+" "+part.name+" uses straight quotation marks")
+			}
+		}
+		c := p.Content
+		if c == nil || len(c.QuotedText)+len(c.QuotedContent) == 0 {
+			return msgs
+		}
+		text := c.PlainText()
+		for _, q := range c.QuotedText {
+			quoted := strings.Join(strings.Fields(q.Text), " ")
+			if !strings.Contains(text, "“"+quoted+"”") {
+				msgs = append(msgs, fmt.Sprintf("%s quotes %q without curly quotation marks around it", label(p), quoted))
+			}
+		}
+		if strings.HasSuffix(text, "”") {
+			msgs = append(msgs, label(p)+" content ends with a closing quotation mark; its punctuation belongs after the mark")
+		}
+		return msgs
+	}, RuleQuotationMarks)
+}
+
+func checkDashUsage(doc uslm.LegislativeDocument) []uslm.Issue {
+	return walkMessages(doc, func(p *uslm.Provision) []string {
+		var msgs []string
+		for _, part := range textParts(p) {
+			if strings.Contains(part.text, "--") || strings.Contains(part.text, " - ") {
+				msgs = append(msgs, label(p)+" "+part.name+" uses hyphens for an em dash")
+			}
+		}
+		if p.Heading != nil && !capitalHeadings[p.Element] {
+			if heading := p.Heading.PlainText(); heading != "" && !strings.HasSuffix(heading, ".—") {
+				msgs = append(msgs, fmt.Sprintf("%s heading %q does not end in \".—\"", label(p), heading))
+			}
+		}
+		if p.Chapeau != nil && len(p.Children) > 0 && !strings.HasSuffix(p.Chapeau.PlainText(), "—") {
+			msgs = append(msgs, label(p)+" chapeau does not end in an em dash")
+		}
+		return msgs
+	}, RuleDashUsage)
+}
+
+// textPart is a block of a provision's text, named for issue messages.
+type textPart struct {
+	name, text string
+}
+
+// textParts returns the heading, chapeau, and content text of p.
+func textParts(p *uslm.Provision) []textPart {
+	var parts []textPart
+	if p.Heading != nil {
+		parts = append(parts, textPart{"heading", p.Heading.PlainText()})
+	}
+	if p.Chapeau != nil {
+		parts = append(parts, textPart{"chapeau", p.Chapeau.PlainText()})
+	}
+	if p.Content != nil {
+		parts = append(parts, textPart{"content", p.Content.PlainText()})
+	}
+	return parts
+}
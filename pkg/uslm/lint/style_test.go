@@ -0,0 +1,80 @@
+package lint
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/usgpo/uslm/pkg/uslm"
+)
+
+func TestDraftingStyleRules(t *testing.T) {
+	const id = ` identifier="/us/bill/1/hr/1/s1"`
+	for _, tc := range []struct {
+		name  string
+		check func(uslm.LegislativeDocument) []uslm.Issue
+		kind  string
+		body  string
+		want  []string
+	}{
+		{"capital section heading", checkHeadingCase, RuleHeadingCase,
+			`<section` + id + `><num value="1">SEC. 1.</num><heading>SHORT TITLE.</heading></section>`, nil},
+		{"lower-case section heading", checkHeadingCase, RuleHeadingCase,
+			`<section` + id + `><num value="1">SEC. 1.</num><heading>Short title.</heading></section>`,
+			[]string{`Section 1 heading "Short title." is not in capitals`}},
+		{"sentence-case subsection heading", checkHeadingCase, RuleHeadingCase,
+			`<section` + id + `><num value="1">SEC. 1.</num><heading>A.</heading><subsection identifier="/us/bill/1/hr/1/s1/a"><num value="a">(a)</num><heading>In general.—</heading></subsection></section>`, nil},
+		{"one-word capital subsection heading", checkHeadingCase, RuleHeadingCase,
+			`<section` + id + `><num value="1">SEC. 1.</num><heading>A.</heading><subsection identifier="/us/bill/1/hr/1/s1/a"><num value="a">(a)</num><heading>FINDINGS.—</heading></subsection></section>`, nil},
+		{"capital subsection heading", checkHeadingCase, RuleHeadingCase,
+			`<section` + id + `><num value="1">SEC. 1.</num><heading>A.</heading><subsection identifier="/us/bill/1/hr/1/s1/a"><num value="a">(a)</num><heading>IN GENERAL.—</heading></subsection></section>`,
+			[]string{`Section 1(a) heading "IN GENERAL.—" is in capitals, not sentence case`}},
+		{"lower-case subsection heading", checkHeadingCase, RuleHeadingCase,
+			`<section` + id + `><num value="1">SEC. 1.</num><heading>A.</heading><subsection identifier="/us/bill/1/hr/1/s1/a"><num value="a">(a)</num><heading>in general.—</heading></subsection></section>`,
+			[]string{`Section 1(a) heading "in general.—" does not begin with a capital`}},
+
+		{"curly quotation marks", checkQuotationMarks, RuleQuotationMarks,
+			`<section` + id + `><content>The term “agency” means <quotedText>any agency</quotedText> as “<quotedText>any agency</quotedText>”.</content></section>`, nil},
+		{"straight quotation marks", checkQuotationMarks, RuleQuotationMarks,
+			`<section` + id + `><num value="1">SEC. 1.</num><heading>THE "ACT".</heading><content>The ''Act'' means this Act.</content></section>`,
+			[]string{"Section 1 heading uses straight quotation marks", "Section 1 content uses straight quotation marks"}},
+		{"quoted text without marks", checkQuotationMarks, RuleQuotationMarks,
+			`<section` + id + `><num value="1">SEC. 1.</num><content>Strike <quotedText>any  agency</quotedText> and insert.</content></section>`,
+			[]string{`Section 1 quotes "any agency" without curly quotation marks around it`}},
+		{"punctuation inside the closing mark", checkQuotationMarks, RuleQuotationMarks,
+			`<section` + id + `><num value="1">SEC. 1.</num><content>Insert “<quotedText>each agency.</quotedText>”</content></section>`,
+			[]string{"Section 1 content ends with a closing quotation mark; its punctuation belongs after the mark"}},
+
+		{"em dashes", checkDashUsage, RuleDashUsage,
+			`<section` + id + `><num value="1">SEC. 1.</num><heading>SHORT-TERM LOANS.</heading><chapeau>The Secretary shall—</chapeau><paragraph><num value="1">(1)</num><heading>In general.—</heading><content>Lend—to farmers.</content></paragraph></section>`, nil},
+		{"hyphens for an em dash", checkDashUsage, RuleDashUsage,
+			`<section` + id + `><num value="1">SEC. 1.</num><heading>LOANS -- GENERALLY.</heading><content>Lend - to farmers.</content></section>`,
+			[]string{"Section 1 heading uses hyphens for an em dash", "Section 1 content uses hyphens for an em dash"}},
+		{"heading without a dash", checkDashUsage, RuleDashUsage,
+			`<section` + id + `><num value="1">SEC. 1.</num><heading>A.</heading><subsection identifier="/us/bill/1/hr/1/s1/a"><num value="a">(a)</num><heading>In general.</heading><content>Text.</content></subsection></section>`,
+			[]string{`Section 1(a) heading "In general." does not end in ".—"`}},
+		{"chapeau without a dash", checkDashUsage, RuleDashUsage,
+			`<section` + id + `><num value="1">SEC. 1.</num><chapeau>The Secretary shall:</chapeau><paragraph><num value="1">(1)</num><content>Lend.</content></paragraph></section>`,
+			[]string{"Section 1 chapeau does not end in an em dash"}},
+		{"chapeau without sublevels", checkDashUsage, RuleDashUsage,
+			`<section` + id + `><num value="1">SEC. 1.</num><chapeau>The Secretary shall:</chapeau></section>`, nil},
+	} {
+		got := messages(t, tc.check(parseBill(t, tc.body)), tc.kind)
+		if !reflect.DeepEqual(got, tc.want) {
+			t.Errorf("%s:\n got %q\nwant %q", tc.name, got, tc.want)
+		}
+	}
+}
+
+// TestDraftingStyleCorpus pins what the drafting-style rules find in the
+// samples, so that a change to a rule's reach shows up here.
+func TestDraftingStyleCorpus(t *testing.T) {
+	counts := corpusCounts(t, DraftingStyle()...)
+	want := map[uslm.IssueKind]int{
+		RuleHeadingCase:    12,
+		RuleQuotationMarks: 2,
+		RuleDashUsage:      61,
+	}
+	if !reflect.DeepEqual(counts, want) {
+		t.Errorf("issues over the samples %v, want %v", counts, want)
+	}
+}
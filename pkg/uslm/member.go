@@ -0,0 +1,170 @@
+package uslm
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// MemberIDScheme names the identifier system a MemberID is drawn from.
+type MemberIDScheme string
+
+const (
+	// MemberIDSenate is a Senate LIS member ID (e.g., "S221"), recorded in
+	// the senateId attribute of Senate documents.
+	MemberIDSenate MemberIDScheme = "senate"
+
+	// MemberIDHouse is a House member ID, recorded in the houseId attribute.
+	MemberIDHouse MemberIDScheme = "house"
+
+	// MemberIDBioGuide is a Biographical Directory of the United States
+	// Congress ID (e.g., "B001303"), recorded in the bioGuideId attribute of
+	// House documents. The same ID is used whichever chamber the member sits
+	// in.
+	MemberIDBioGuide MemberIDScheme = "bioguide"
+)
+
+var (
+	// senateLISPattern matches a Senate LIS member ID.
+	senateLISPattern = regexp.MustCompile(`^S\d{3}$`)
+
+	// bioGuidePattern matches a Bioguide ID.
+	bioGuidePattern = regexp.MustCompile(`^[A-Z]\d{6}$`)
+)
+
+// MemberID identifies a Member of Congress named as a sponsor or cosponsor.
+// The zero value identifies no one.
+type MemberID struct {
+	Scheme MemberIDScheme
+	Value  string
+}
+
+// newMemberID returns the ID recorded by a sponsor's senateId, houseId, and
+// bioGuideId attributes. A chamber-specific ID is preferred to a Bioguide
+// ID. A Bioguide ID found in the senateId attribute is recognized by its
+// form and reported under MemberIDBioGuide.
+func newMemberID(senateID, houseID, bioGuideID string) MemberID {
+	senateID = strings.ToUpper(strings.TrimSpace(senateID))
+	houseID = strings.ToUpper(strings.TrimSpace(houseID))
+	bioGuideID = strings.ToUpper(strings.TrimSpace(bioGuideID))
+	switch {
+	case senateID != "" && !bioGuidePattern.MatchString(senateID):
+		return MemberID{Scheme: MemberIDSenate, Value: senateID}
+	case houseID != "":
+		return MemberID{Scheme: MemberIDHouse, Value: houseID}
+	case bioGuideID != "":
+		return MemberID{Scheme: MemberIDBioGuide, Value: bioGuideID}
+	case senateID != "":
+		return MemberID{Scheme: MemberIDBioGuide, Value: senateID}
+	}
+	return MemberID{}
+}
+
+// ParseMemberID parses the "scheme:value" form returned by MemberID.String
+// (e.g., "senate:S221"). A bare value is accepted when its form identifies
+// the scheme: a Senate LIS ID or a Bioguide ID.
+func ParseMemberID(s string) (MemberID, error) {
+	s = strings.TrimSpace(s)
+	scheme, value, ok := strings.Cut(s, ":")
+	if !ok {
+		value = strings.ToUpper(s)
+		switch {
+		case senateLISPattern.MatchString(value):
+			return MemberID{Scheme: MemberIDSenate, Value: value}, nil
+		case bioGuidePattern.MatchString(value):
+			return MemberID{Scheme: MemberIDBioGuide, Value: value}, nil
+		}
+		return MemberID{}, fmt.Errorf("invalid member ID %q: unknown scheme", s)
+	}
+	value = strings.ToUpper(strings.TrimSpace(value))
+	if value == "" {
+		return MemberID{}, fmt.Errorf("invalid member ID %q: empty value", s)
+	}
+	switch MemberIDScheme(strings.ToLower(scheme)) {
+	case MemberIDSenate:
+		return MemberID{Scheme: MemberIDSenate, Value: value}, nil
+	case MemberIDHouse:
+		return MemberID{Scheme: MemberIDHouse, Value: value}, nil
+	case MemberIDBioGuide:
+		return MemberID{Scheme: MemberIDBioGuide, Value: value}, nil
+	}
+	return MemberID{}, fmt.Errorf("invalid member ID %q: unknown scheme %q", s, scheme)
+}
+
+// IsZero reports whether the ID is empty.
+func (m MemberID) IsZero() bool {
+	return m.Value == ""
+}
+
+// Chamber returns the chamber that issued the ID ("SENATE" or "HOUSE", as
+// returned by LegislativeDocument.GetChamber), or "" for a Bioguide ID,
+// which does not name a chamber.
+func (m MemberID) Chamber() string {
+	switch m.Scheme {
+	case MemberIDSenate:
+		return "SENATE"
+	case MemberIDHouse:
+		return "HOUSE"
+	}
+	return ""
+}
+
+// String returns the ID qualified by its scheme (e.g., "senate:S221"), or ""
+// for the zero MemberID.
+func (m MemberID) String() string {
+	if m.IsZero() {
+		return ""
+	}
+	return string(m.Scheme) + ":" + m.Value
+}
+
+// Cosponsorship records a member's cosponsorship of a document.
+type Cosponsorship struct {
+	Cosponsor Cosponsor
+
+	// Joined is the date (YYYY-MM-DD) of the first action listing the
+	// member, or "" if that action has no date. See ActionDate.ISODate.
+	Joined string
+}
+
+// cosponsorships collects the cosponsors of every action. A member listed by
+// several actions, as when an "Additional sponsors" action repeats a
+// member already listed, appears once, dated by the first
+// action that lists them. Members are matched by MemberID, or by name when
+// they have none; a cosponsor with neither is always kept.
+func cosponsorships(actions []Action) []Cosponsorship {
+	var result []Cosponsorship
+	seen := make(map[string]bool)
+	for _, action := range actions {
+		if action.ActionDescription == nil {
+			continue
+		}
+		joined := action.Date.ISODate()
+		for _, c := range action.ActionDescription.Cosponsors {
+			key := c.GetMemberID().String()
+			if key == "" {
+				if name := memberName(c.Text, c.Inline); name != "" {
+					key = "name:" + name
+				}
+			}
+			if key != "" {
+				if seen[key] {
+					continue
+				}
+				seen[key] = true
+			}
+			result = append(result, Cosponsorship{Cosponsor: c, Joined: joined})
+		}
+	}
+	return result
+}
+
+// memberName returns a sponsor's or cosponsor's name text, including the
+// small-caps surname held in its inline elements, with whitespace normalized.
+func memberName(text string, inline []Inline) string {
+	parts := []string{text}
+	for _, in := range inline {
+		parts = append(parts, in.Text)
+	}
+	return normalizeSpace(strings.Join(parts, " "))
+}
@@ -1,6 +1,9 @@
 package uslm
 
-import "encoding/xml"
+import (
+	"encoding/xml"
+	"strings"
+)
 
 // Meta represents the metadata section for bills and resolutions.
 // This contains machine-processable information that is not published with the document.
@@ -27,15 +30,36 @@ type Meta struct {
 	Session       string `xml:"session" json:"session"`
 	PublicPrivate string `xml:"publicPrivate" json:"publicPrivate"`
 
-	// Processing info
-	ProcessedBy   string `xml:"processedBy,omitempty" json:"processedBy,omitempty"`
-	ProcessedDate string `xml:"processedDate,omitempty" json:"processedDate,omitempty"`
+	// Processing info: the first processing step, and every step when the
+	// document records more than one (see GetProvenance)
+	ProcessedBy   string           `xml:"processedBy,omitempty" json:"processedBy,omitempty"`
+	ProcessedDate string           `xml:"processedDate,omitempty" json:"processedDate,omitempty"`
+	Processing    []ProcessingStep `xml:"-" json:"processing,omitempty"`
 
 	// Related documents
-	RelatedDocuments []RelatedDocument `xml:"relatedDocument" json:"relatedDocuments,omitempty"`
+	RelatedDocuments      []RelatedDocument  `xml:"relatedDocument" json:"relatedDocuments,omitempty"`
+	RelatedDocumentGroups []RelatedDocuments `xml:"relatedDocuments" json:"relatedDocumentGroups,omitempty"`
 
 	// Optional fields
-	PopularName string `xml:"popularName,omitempty" json:"popularName,omitempty"`
+	PopularName        string           `xml:"popularName,omitempty" json:"popularName,omitempty"`
+	DocPublicationName *PrintedProperty `xml:"docPublicationName" json:"docPublicationName,omitempty"`
+
+	// Senate metadata (uslm-meta-senate.xsd)
+	MetaSenate *MetaSenate `xml:"https://www.senate.gov/schemas metaSenate" json:"metaSenate,omitempty"`
+
+	// Generic name/value metadata (USLM 2.x)
+	Properties []Property `xml:"property" json:"properties,omitempty"`
+	Sets       []Set      `xml:"set" json:"sets,omitempty"`
+	Attrs      Attributes `xml:",any,attr" json:"attrs,omitempty"`
+}
+
+// GetProperty returns the value of the named property, searching nested sets.
+// Returns an empty string if no such property exists.
+func (m *Meta) GetProperty(name string) string {
+	if p := findProperty(m.Properties, m.Sets, name); p != nil {
+		return p.GetValue()
+	}
+	return ""
 }
 
 // AmendMeta represents the metadata section for amendment documents.
@@ -66,15 +90,221 @@ type AmendMeta struct {
 	Session       string `xml:"session" json:"session"`
 	PublicPrivate string `xml:"publicPrivate" json:"publicPrivate"`
 
+	// Processing info: the first processing step, and every step when the
+	// document records more than one (see GetProvenance)
+	ProcessedBy   string           `xml:"processedBy,omitempty" json:"processedBy,omitempty"`
+	ProcessedDate string           `xml:"processedDate,omitempty" json:"processedDate,omitempty"`
+	Processing    []ProcessingStep `xml:"-" json:"processing,omitempty"`
+
+	// Optional fields
+	DocPublicationName *PrintedProperty `xml:"docPublicationName" json:"docPublicationName,omitempty"`
+
+	// Senate metadata (uslm-meta-senate.xsd)
+	MetaSenate *MetaSenate `xml:"https://www.senate.gov/schemas metaSenate" json:"metaSenate,omitempty"`
+
+	// Generic name/value metadata (USLM 2.x)
+	Properties []Property `xml:"property" json:"properties,omitempty"`
+	Sets       []Set      `xml:"set" json:"sets,omitempty"`
+	Attrs      Attributes `xml:",any,attr" json:"attrs,omitempty"`
+}
+
+// GetProperty returns the value of the named property, searching nested sets.
+// Returns an empty string if no such property exists.
+func (m *AmendMeta) GetProperty(name string) string {
+	if p := findProperty(m.Properties, m.Sets, name); p != nil {
+		return p.GetValue()
+	}
+	return ""
+}
+
+// DocumentMeta is a common view of a document's metadata block. Bills,
+// resolutions, and generic documents carry <meta> while amendments carry
+// <amendMeta>; GetMeta returns either as a DocumentMeta. Fields that exist in
+// only one of the two blocks are empty for the other.
+type DocumentMeta struct {
+	// Dublin Core metadata
+	DCTitle     string `json:"dcTitle"`
+	DCType      string `json:"dcType"`
+	DCCreator   string `json:"dcCreator,omitempty"`
+	DCPublisher string `json:"dcPublisher,omitempty"`
+	DCFormat    string `json:"dcFormat,omitempty"`
+	DCLanguage  string `json:"dcLanguage,omitempty"`
+	DCRights    string `json:"dcRights,omitempty"`
+
+	// Document identifiers
+	DocNumber      string   `json:"docNumber"`
+	CitableAs      []string `json:"citableAs"`
+	DocStage       string   `json:"docStage"`
+	CurrentChamber string   `json:"currentChamber,omitempty"`
+
+	// Congressional session info
+	Congress      string `json:"congress"`
+	Session       string `json:"session"`
+	PublicPrivate string `json:"publicPrivate"`
+
 	// Processing info
-	ProcessedBy   string `xml:"processedBy,omitempty" json:"processedBy,omitempty"`
-	ProcessedDate string `xml:"processedDate,omitempty" json:"processedDate,omitempty"`
+	ProcessedBy   string           `json:"processedBy,omitempty"`
+	ProcessedDate string           `json:"processedDate,omitempty"`
+	Processing    []ProcessingStep `json:"processing,omitempty"`
+
+	// Bill and resolution fields (<meta> only)
+	RelatedDocuments []RelatedDocument `json:"relatedDocuments,omitempty"`
+	PopularName      string            `json:"popularName,omitempty"`
+
+	// Amendment fields (<amendMeta> only)
+	AmendDegree string `json:"amendDegree,omitempty"`
+
+	// Generic name/value metadata (USLM 2.x)
+	Properties []Property `json:"properties,omitempty"`
+	Sets       []Set      `json:"sets,omitempty"`
+}
+
+// GetProperty returns the value of the named property, searching nested sets.
+// Returns an empty string if no such property exists.
+func (m *DocumentMeta) GetProperty(name string) string {
+	if p := findProperty(m.Properties, m.Sets, name); p != nil {
+		return p.GetValue()
+	}
+	return ""
+}
+
+// View returns the metadata as a DocumentMeta, or nil if m is nil. Slices are
+// shared with m.
+func (m *Meta) View() *DocumentMeta {
+	if m == nil {
+		return nil
+	}
+	return &DocumentMeta{
+		DCTitle:          m.DCTitle,
+		DCType:           m.DCType,
+		DCCreator:        m.DCCreator,
+		DCPublisher:      m.DCPublisher,
+		DCFormat:         m.DCFormat,
+		DCLanguage:       m.DCLanguage,
+		DCRights:         m.DCRights,
+		DocNumber:        m.DocNumber,
+		CitableAs:        m.CitableAs,
+		DocStage:         m.DocStage,
+		CurrentChamber:   m.CurrentChamber,
+		Congress:         m.Congress,
+		Session:          m.Session,
+		PublicPrivate:    m.PublicPrivate,
+		ProcessedBy:      m.ProcessedBy,
+		ProcessedDate:    m.ProcessedDate,
+		Processing:       m.GetProvenance(),
+		RelatedDocuments: m.RelatedDocuments,
+		PopularName:      m.PopularName,
+		Properties:       m.Properties,
+		Sets:             m.Sets,
+	}
+}
+
+// View returns the metadata as a DocumentMeta, or nil if m is nil. Slices are
+// shared with m.
+func (m *AmendMeta) View() *DocumentMeta {
+	if m == nil {
+		return nil
+	}
+	return &DocumentMeta{
+		DCTitle:        m.DCTitle,
+		DCType:         m.DCType,
+		DCCreator:      m.DCCreator,
+		DCPublisher:    m.DCPublisher,
+		DCFormat:       m.DCFormat,
+		DCLanguage:     m.DCLanguage,
+		DCRights:       m.DCRights,
+		DocNumber:      m.DocNumber,
+		CitableAs:      m.CitableAs,
+		DocStage:       m.DocStage,
+		CurrentChamber: m.CurrentChamber,
+		Congress:       m.Congress,
+		Session:        m.Session,
+		PublicPrivate:  m.PublicPrivate,
+		ProcessedBy:    m.ProcessedBy,
+		ProcessedDate:  m.ProcessedDate,
+		Processing:     m.GetProvenance(),
+		AmendDegree:    m.AmendDegree,
+		Properties:     m.Properties,
+		Sets:           m.Sets,
+	}
+}
+
+// Property represents a generic <property> metadata element.
+// The normalized value is carried in an attribute (value, date, or href depending on type)
+// and the text content, if any, is the human-readable form.
+type Property struct {
+	XMLName xml.Name   `xml:"property" json:"-"`
+	Name    string     `xml:"name,attr,omitempty" json:"name,omitempty"`
+	Type    string     `xml:"type,attr,omitempty" json:"type,omitempty"`
+	Value   string     `xml:"value,attr,omitempty" json:"value,omitempty"`
+	Date    string     `xml:"date,attr,omitempty" json:"date,omitempty"`
+	Href    string     `xml:"href,attr,omitempty" json:"href,omitempty"`
+	IDRef   string     `xml:"idref,attr,omitempty" json:"idref,omitempty"`
+	Text    string     `xml:",chardata" json:"text,omitempty"`
+	Attrs   Attributes `xml:",any,attr" json:"attrs,omitempty"`
+}
+
+// GetValue returns the property's normalized value, falling back to its text content.
+func (p *Property) GetValue() string {
+	switch {
+	case p.Value != "":
+		return p.Value
+	case p.Date != "":
+		return p.Date
+	case p.Href != "":
+		return p.Href
+	}
+	return strings.TrimSpace(p.Text)
+}
+
+// Set represents a <set> grouping of properties. Sets may nest.
+type Set struct {
+	XMLName    xml.Name   `xml:"set" json:"-"`
+	Name       string     `xml:"name,attr,omitempty" json:"name,omitempty"`
+	Type       string     `xml:"type,attr,omitempty" json:"type,omitempty"`
+	Properties []Property `xml:"property" json:"properties,omitempty"`
+	Sets       []Set      `xml:"set" json:"sets,omitempty"`
+	Attrs      Attributes `xml:",any,attr" json:"attrs,omitempty"`
+}
+
+// GetProperty returns the value of the named property within this set or its nested sets.
+func (s *Set) GetProperty(name string) string {
+	if p := findProperty(s.Properties, s.Sets, name); p != nil {
+		return p.GetValue()
+	}
+	return ""
+}
+
+// findProperty performs a depth-first search for the first property with the given name.
+func findProperty(properties []Property, sets []Set, name string) *Property {
+	for i := range properties {
+		if properties[i].Name == name {
+			return &properties[i]
+		}
+	}
+	for i := range sets {
+		if p := findProperty(sets[i].Properties, sets[i].Sets, name); p != nil {
+			return p
+		}
+	}
+	return nil
 }
 
 // RelatedDocument represents a reference to another related document (e.g., committee report).
 type RelatedDocument struct {
-	XMLName xml.Name `xml:"relatedDocument" json:"-"`
-	Role    string   `xml:"role,attr,omitempty" json:"role,omitempty"`
-	Href    string   `xml:"href,attr,omitempty" json:"href,omitempty"`
-	Text    string   `xml:",chardata" json:"text,omitempty"`
+	XMLName xml.Name   `xml:"relatedDocument" json:"-"`
+	Role    string     `xml:"role,attr,omitempty" json:"role,omitempty"`
+	Href    string     `xml:"href,attr,omitempty" json:"href,omitempty"`
+	Value   string     `xml:"value,attr,omitempty" json:"value,omitempty"`
+	Text    string     `xml:",chardata" json:"text,omitempty"`
+	Attrs   Attributes `xml:",any,attr" json:"attrs,omitempty"`
+}
+
+// RelatedDocuments groups related documents printed together, such as the
+// parts of a committee report ("[Report No. 116–324, Parts I, II and III]").
+type RelatedDocuments struct {
+	XMLName          xml.Name          `xml:"relatedDocuments" json:"-"`
+	RelatedDocuments []RelatedDocument `xml:"relatedDocument" json:"relatedDocuments,omitempty"`
+	Text             string            `xml:",chardata" json:"text,omitempty"`
+	Attrs            Attributes        `xml:",any,attr" json:"attrs,omitempty"`
 }
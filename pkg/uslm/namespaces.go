@@ -0,0 +1,398 @@
+package uslm
+
+import (
+	"encoding/xml"
+	"reflect"
+	"strings"
+	"sync"
+)
+
+// encoding/xml resolves prefixes to namespace URIs when decoding but invents
+// its own prefixes (and repeats declarations) when encoding. The root types
+// and the elements holding Dublin Core fields therefore implement MarshalXML
+// to write the declarations and prefixes the source document used.
+
+// namespaceDecl is a single namespace declaration; prefix is empty for the
+// default namespace.
+type namespaceDecl struct {
+	prefix string
+	uri    string
+}
+
+// recordNamespaces returns the namespace declarations among attrs, in order.
+func recordNamespaces(attrs []xml.Attr) []namespaceDecl {
+	var decls []namespaceDecl
+	for _, a := range attrs {
+		switch {
+		case a.Name.Space == "xmlns":
+			decls = append(decls, namespaceDecl{a.Name.Local, a.Value})
+		case a.Name.Space == "" && a.Name.Local == "xmlns":
+			decls = append(decls, namespaceDecl{"", a.Value})
+		}
+	}
+	return decls
+}
+
+// namespacesOf returns the declarations recorded for doc, or nil.
+func namespacesOf(doc LegislativeDocument) []namespaceDecl {
+	switch d := doc.(type) {
+	case *Bill:
+		return d.namespaces
+	case *Resolution:
+		return d.namespaces
+	case *EngrossedAmendment:
+		return d.namespaces
+	case *Amendment:
+		return d.namespaces
+	case *GenericDocument:
+		return d.namespaces
+	}
+	return nil
+}
+
+// setNamespaces replaces the declarations recorded for doc.
+func setNamespaces(doc LegislativeDocument, decls []namespaceDecl) {
+	switch d := doc.(type) {
+	case *Bill:
+		d.namespaces = decls
+	case *Resolution:
+		d.namespaces = decls
+	case *EngrossedAmendment:
+		d.namespaces = decls
+	case *Amendment:
+		d.namespaces = decls
+	case *GenericDocument:
+		d.namespaces = decls
+	}
+}
+
+// rootStart builds the start element of a root document. Declarations
+// recorded at parse time keep their original order; the modeled prefixes take
+// their values from fields so edits are honored, and those missing from the
+// source are appended in field order. The dc prefix (and xsi, when a schema
+// location is set) is always declared because the marshaled output uses it,
+// as is msenate when the metadata holds Senate elements.
+// attrs follow the declarations; xsi:schemaLocation and xml:lang come last.
+// Empty attributes are omitted.
+func rootStart(name string, recorded []namespaceDecl, fields []namespaceDecl, schemaLocation, lang string, attrs ...xml.Attr) xml.StartElement {
+	values := make(map[string]string, len(fields))
+	for _, f := range fields {
+		values[f.prefix] = f.uri
+	}
+	if values["dc"] == "" {
+		values["dc"] = NamespaceDC
+	}
+	if schemaLocation != "" && values["xsi"] == "" {
+		values["xsi"] = NamespaceXSI
+	}
+
+	var decls []namespaceDecl
+	seen := make(map[string]bool)
+	for _, d := range recorded {
+		if v, ok := values[d.prefix]; ok {
+			d.uri = v
+		}
+		if d.uri != "" && !seen[d.prefix] {
+			decls = append(decls, d)
+			seen[d.prefix] = true
+		}
+	}
+	for _, prefix := range []string{"", "dc", "html", "uslm", "xsi", senatePrefix} {
+		if values[prefix] != "" && !seen[prefix] {
+			decls = append(decls, namespaceDecl{prefix, values[prefix]})
+			seen[prefix] = true
+		}
+	}
+
+	start := xml.StartElement{Name: xml.Name{Local: name}}
+	for _, d := range decls {
+		local := "xmlns"
+		if d.prefix != "" {
+			local += ":" + d.prefix
+		}
+		start.Attr = append(start.Attr, xml.Attr{Name: xml.Name{Local: local}, Value: d.uri})
+	}
+	for _, a := range attrs {
+		if a.Value != "" {
+			start.Attr = append(start.Attr, a)
+		}
+	}
+	if schemaLocation != "" {
+		start.Attr = append(start.Attr, xml.Attr{Name: xml.Name{Local: "xsi:schemaLocation"}, Value: schemaLocation})
+	}
+	if lang != "" {
+		start.Attr = append(start.Attr, xml.Attr{Name: xml.Name{Local: "xml:lang"}, Value: lang})
+	}
+	return start
+}
+
+// rootChild is an element written by encodeRoot.
+type rootChild struct {
+	name  string
+	value interface{}
+}
+
+// encodeRoot writes start, each child, and the matching end element. Nil
+// pointers and empty strings are skipped, and slices are written as one
+// element per item.
+func encodeRoot(e *xml.Encoder, start xml.StartElement, children []rootChild) error {
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, child := range children {
+		if s, ok := child.value.(string); ok && s == "" {
+			continue
+		}
+		if err := e.EncodeElement(child.value, xml.StartElement{Name: xml.Name{Local: child.name}}); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// prefixedTypes caches, per struct type and set of replaced fields, a copy of
+// the type whose Dublin Core and Senate field tags name the element
+// "dc:<local>" or "msenate:<local>" rather than by namespace URI.
+var prefixedTypes sync.Map
+
+// prefixedKey identifies a type in prefixedTypes.
+type prefixedKey struct {
+	typ      reflect.Type
+	replaced string
+}
+
+// fieldReplacement encodes the named field of a struct as value, which may
+// be of another type, instead of the field's own value.
+type fieldReplacement struct {
+	name  string
+	value interface{}
+}
+
+// encodePrefixed encodes v, a pointer to a struct, with its Dublin Core fields
+// written using the dc prefix and its Senate fields using the msenate prefix. The element is named by v's XMLName tag, since
+// the start element passed to MarshalXML carries the Go type name when v is
+// marshaled directly. The copy of the type has no methods, so this is safe to
+// call from v's own MarshalXML.
+func encodePrefixed(e *xml.Encoder, v interface{}, replacements ...fieldReplacement) error {
+	value := reflect.ValueOf(v).Elem()
+	key := prefixedKey{typ: value.Type()}
+	replaced := make(map[string]interface{}, len(replacements))
+	for _, r := range replacements {
+		key.replaced += r.name + " "
+		replaced[r.name] = r.value
+	}
+	typ, ok := prefixedTypes.Load(key)
+	if !ok {
+		fields := make([]reflect.StructField, value.NumField())
+		for i := range fields {
+			f := value.Type().Field(i)
+			if tag, ok := f.Tag.Lookup("xml"); ok {
+				for ns, prefix := range map[string]string{NamespaceDC: "dc", NamespaceSenate: senatePrefix} {
+					if !strings.HasPrefix(tag, ns+" ") {
+						continue
+					}
+					prefixed := prefix + ":" + strings.TrimPrefix(tag, ns+" ")
+					if f.Name != "XMLName" && hasXMLName(f.Type) {
+						// The field's type names and prefixes its own
+						// element; a prefixed tag would conflict with it.
+						prefixed = ""
+					}
+					f.Tag = reflect.StructTag(strings.Replace(string(f.Tag), `xml:"`+tag+`"`, `xml:"`+prefixed+`"`, 1))
+				}
+			}
+			if r, ok := replaced[f.Name]; ok {
+				f.Type = reflect.TypeOf(r)
+			}
+			fields[i] = f
+		}
+		typ, _ = prefixedTypes.LoadOrStore(key, reflect.StructOf(fields))
+	}
+	t := typ.(reflect.Type)
+	if len(replacements) == 0 {
+		return e.Encode(value.Convert(t).Interface())
+	}
+	out := reflect.New(t).Elem()
+	for i := 0; i < t.NumField(); i++ {
+		if r, ok := replaced[t.Field(i).Name]; ok {
+			out.Field(i).Set(reflect.ValueOf(r))
+		} else {
+			out.Field(i).Set(value.Field(i))
+		}
+	}
+	return e.Encode(out.Interface())
+}
+
+// hasXMLName reports whether t, or the type t points to or holds a slice
+// of, is a struct with an XMLName field.
+func hasXMLName(t reflect.Type) bool {
+	for t.Kind() == reflect.Pointer || t.Kind() == reflect.Slice {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct {
+		return false
+	}
+	_, ok := t.FieldByName("XMLName")
+	return ok
+}
+
+// MarshalXML encodes the metadata with Dublin Core elements as dc:*, and
+// with every processing step when there is more than one.
+func (m *Meta) MarshalXML(e *xml.Encoder, _ xml.StartElement) error {
+	if len(m.Processing) > 1 {
+		return encodePrefixed(e, m, processingReplacements(m.GetProvenance())...)
+	}
+	return encodePrefixed(e, m)
+}
+
+// MarshalXML encodes the metadata with Dublin Core elements as dc:*, and
+// with every processing step when there is more than one.
+func (m *AmendMeta) MarshalXML(e *xml.Encoder, _ xml.StartElement) error {
+	if len(m.Processing) > 1 {
+		return encodePrefixed(e, m, processingReplacements(m.GetProvenance())...)
+	}
+	return encodePrefixed(e, m)
+}
+
+// processingReplacements writes steps in place of the processedBy element
+// and omits the processedDate element, whose values steps include.
+func processingReplacements(steps []ProcessingStep) []fieldReplacement {
+	return []fieldReplacement{
+		{"ProcessedBy", processingSteps(steps)},
+		{"ProcessedDate", processingSteps(nil)},
+	}
+}
+
+// MarshalXML encodes the preface with Dublin Core elements as dc:*.
+func (p *Preface) MarshalXML(e *xml.Encoder, _ xml.StartElement) error {
+	return encodePrefixed(e, p)
+}
+
+// MarshalXML encodes the endorsement with Dublin Core elements as dc:*.
+func (en *Endorsement) MarshalXML(e *xml.Encoder, _ xml.StartElement) error {
+	return encodePrefixed(e, en)
+}
+
+// UnmarshalXML decodes the bill while recording its namespace declarations.
+func (b *Bill) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	type plain Bill
+	if err := d.DecodeElement((*plain)(b), &start); err != nil {
+		return err
+	}
+	b.namespaces = recordNamespaces(start.Attr)
+	return nil
+}
+
+// MarshalXML encodes the bill with its original namespace declarations.
+func (b *Bill) MarshalXML(e *xml.Encoder, _ xml.StartElement) error {
+	start := rootStart("bill", b.namespaces, append([]namespaceDecl{
+		{"", b.XMLNS}, {"dc", b.XMLNSDC}, {"html", b.XMLNSHTML}, {"uslm", b.XMLNSUSLM}, {"xsi", b.XMLNSXSI},
+	}, senateDecls(b.Meta != nil && b.Meta.MetaSenate != nil)...), b.XSISchemaLocation, b.XMLLang, xml.Attr{Name: xml.Name{Local: "xml:base"}, Value: b.XMLBase})
+	start.Attr = append(start.Attr, b.Attrs...)
+	return encodeRoot(e, start, []rootChild{
+		{"meta", b.Meta},
+		{"preface", b.Preface},
+		{"main", b.Main},
+		{"endMarker", b.EndMarker},
+		{"Signature", b.DigitalSignature},
+	})
+}
+
+// UnmarshalXML decodes the resolution while recording its namespace declarations.
+func (r *Resolution) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	type plain Resolution
+	if err := d.DecodeElement((*plain)(r), &start); err != nil {
+		return err
+	}
+	r.namespaces = recordNamespaces(start.Attr)
+	return nil
+}
+
+// MarshalXML encodes the resolution with its original namespace declarations.
+func (r *Resolution) MarshalXML(e *xml.Encoder, _ xml.StartElement) error {
+	start := rootStart("resolution", r.namespaces, append([]namespaceDecl{
+		{"", r.XMLNS}, {"dc", r.XMLNSDC}, {"html", r.XMLNSHTML}, {"uslm", r.XMLNSUSLM}, {"xsi", r.XMLNSXSI},
+	}, senateDecls(r.Meta != nil && r.Meta.MetaSenate != nil)...), r.XSISchemaLocation, r.XMLLang, xml.Attr{Name: xml.Name{Local: "xml:base"}, Value: r.XMLBase})
+	start.Attr = append(start.Attr, r.Attrs...)
+	return encodeRoot(e, start, []rootChild{
+		{"meta", r.Meta},
+		{"preface", r.Preface},
+		{"main", r.Main},
+		{"endMarker", r.EndMarker},
+		{"Signature", r.DigitalSignature},
+	})
+}
+
+// UnmarshalXML decodes the engrossed amendment while recording its namespace declarations.
+func (a *EngrossedAmendment) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	type plain EngrossedAmendment
+	if err := d.DecodeElement((*plain)(a), &start); err != nil {
+		return err
+	}
+	a.namespaces = recordNamespaces(start.Attr)
+	return nil
+}
+
+// MarshalXML encodes the engrossed amendment with its original namespace declarations.
+func (a *EngrossedAmendment) MarshalXML(e *xml.Encoder, _ xml.StartElement) error {
+	start := rootStart("engrossedAmendment", a.namespaces, append([]namespaceDecl{
+		{"", a.XMLNS}, {"dc", a.XMLNSDC}, {"html", a.XMLNSHTML}, {"uslm", a.XMLNSUSLM}, {"xsi", a.XMLNSXSI},
+	}, senateDecls(a.AmendMeta != nil && a.AmendMeta.MetaSenate != nil)...), a.XSISchemaLocation, a.XMLLang,
+		xml.Attr{Name: xml.Name{Local: "styleType"}, Value: a.StyleType}, xml.Attr{Name: xml.Name{Local: "xml:base"}, Value: a.XMLBase})
+	start.Attr = append(start.Attr, a.Attrs...)
+	return encodeRoot(e, start, []rootChild{
+		{"amendMeta", a.AmendMeta},
+		{"amendPreface", a.AmendPreface},
+		{"amendMain", a.AmendMain},
+		{"signatures", a.Signatures},
+		{"endorsement", a.Endorsement},
+		{"Signature", a.DigitalSignature},
+	})
+}
+
+// UnmarshalXML decodes the amendment while recording its namespace declarations.
+func (a *Amendment) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	type plain Amendment
+	if err := d.DecodeElement((*plain)(a), &start); err != nil {
+		return err
+	}
+	a.namespaces = recordNamespaces(start.Attr)
+	return nil
+}
+
+// MarshalXML encodes the amendment with its original namespace declarations.
+func (a *Amendment) MarshalXML(e *xml.Encoder, _ xml.StartElement) error {
+	start := rootStart("amendment", a.namespaces, append([]namespaceDecl{
+		{"", a.XMLNS}, {"dc", a.XMLNSDC}, {"html", a.XMLNSHTML}, {"uslm", a.XMLNSUSLM}, {"xsi", a.XMLNSXSI},
+	}, senateDecls(a.AmendMeta != nil && a.AmendMeta.MetaSenate != nil)...), a.XSISchemaLocation, a.XMLLang, xml.Attr{Name: xml.Name{Local: "xml:base"}, Value: a.XMLBase})
+	start.Attr = append(start.Attr, a.Attrs...)
+	return encodeRoot(e, start, []rootChild{
+		{"amendMeta", a.AmendMeta},
+		{"amendPreface", a.AmendPreface},
+		{"amendMain", a.AmendMain},
+		{"Signature", a.DigitalSignature},
+	})
+}
+
+// UnmarshalXML decodes the document while recording its namespace declarations.
+func (g *GenericDocument) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	type plain GenericDocument
+	if err := d.DecodeElement((*plain)(g), &start); err != nil {
+		return err
+	}
+	g.namespaces = recordNamespaces(start.Attr)
+	return nil
+}
+
+// MarshalXML encodes the document with its original namespace declarations.
+func (g *GenericDocument) MarshalXML(e *xml.Encoder, _ xml.StartElement) error {
+	start := rootStart("document", g.namespaces, append([]namespaceDecl{
+		{"", g.XMLNS}, {"dc", g.XMLNSDC}, {"html", g.XMLNSHTML}, {"uslm", g.XMLNSUSLM}, {"xsi", g.XMLNSXSI},
+	}, senateDecls(g.Meta != nil && g.Meta.MetaSenate != nil)...), g.XSISchemaLocation, g.XMLLang, xml.Attr{Name: xml.Name{Local: "xml:base"}, Value: g.XMLBase})
+	start.Attr = append(start.Attr, g.Attrs...)
+	return encodeRoot(e, start, []rootChild{
+		{"meta", g.Meta},
+		{"content", g.Content},
+		{"appendix", g.Appendices},
+		{"Signature", g.DigitalSignature},
+	})
+}
@@ -0,0 +1,109 @@
+package uslm
+
+import "strings"
+
+// NormalizeOptions selects the typographic normalizations applied by NormalizeText.
+// GPO text uses typographic quotes, dashes, and special spaces inconsistently,
+// which defeats naive search and diffing.
+type NormalizeOptions struct {
+	// Quotes replaces curly and low-9 quotation marks and primes with ASCII ' and ".
+	Quotes bool
+
+	// Dashes replaces hyphen, figure, en, em, and horizontal-bar dashes and the
+	// minus sign with an ASCII hyphen-minus.
+	Dashes bool
+
+	// Spaces replaces no-break, thin, and other fixed-width spaces with an ASCII
+	// space and removes zero-width characters and soft hyphens.
+	Spaces bool
+
+	// Ellipsis replaces the horizontal ellipsis character with three periods.
+	Ellipsis bool
+
+	// CollapseWhitespace collapses runs of whitespace to a single space and trims
+	// the result. It is applied after the other replacements.
+	CollapseWhitespace bool
+}
+
+// DefaultNormalizeOptions returns options with every normalization enabled.
+func DefaultNormalizeOptions() NormalizeOptions {
+	return NormalizeOptions{
+		Quotes:             true,
+		Dashes:             true,
+		Spaces:             true,
+		Ellipsis:           true,
+		CollapseWhitespace: true,
+	}
+}
+
+var (
+	quoteReplacer = strings.NewReplacer(
+		"‘", "'", "’", "'", "‚", "'", "‛", "'", "′", "'",
+		"“", `"`, "”", `"`, "„", `"`, "‟", `"`, "″", `"`,
+	)
+	dashReplacer = strings.NewReplacer(
+		"‐", "-", "‑", "-", "‒", "-", "–", "-", "—", "-", "―", "-", "−", "-",
+	)
+	spaceReplacer = strings.NewReplacer(
+		"\u00a0", " ", // no-break space
+		"\u2002", " ", "\u2003", " ", "\u2004", " ", "\u2005", " ", "\u2006", " ", // en, em, and fractional spaces
+		"\u2007", " ", "\u2008", " ", "\u2009", " ", "\u200a", " ", // figure, punctuation, thin, and hair spaces
+		"\u202f", " ", "\u3000", " ", // narrow no-break and ideographic spaces
+		"\u200b", "", "\u200c", "", "\u200d", "", "\u2060", "", "\ufeff", "", // zero-width characters
+		"\u00ad", "", // soft hyphen
+	)
+	ellipsisReplacer = strings.NewReplacer("…", "...")
+)
+
+// NormalizeText applies the selected typographic normalizations to s.
+func NormalizeText(s string, opts NormalizeOptions) string {
+	if opts.Spaces {
+		s = spaceReplacer.Replace(s)
+	}
+	if opts.Quotes {
+		s = quoteReplacer.Replace(s)
+	}
+	if opts.Dashes {
+		s = dashReplacer.Replace(s)
+	}
+	if opts.Ellipsis {
+		s = ellipsisReplacer.Replace(s)
+	}
+	if opts.CollapseWhitespace {
+		s = normalizeSpace(s)
+	}
+	return s
+}
+
+// ExtractText returns the text of every hierarchical level of the document in
+// reading order (number, heading, chapeau, and content, one level per line),
+// normalized with opts. It is intended for indexing and diffing rather than display.
+func ExtractText(doc LegislativeDocument, opts NormalizeOptions) string {
+	var lines []string
+	walkDocumentLevels(doc, func(l *level) bool {
+		if line := NormalizeText(levelLine(l), opts); strings.TrimSpace(line) != "" {
+			lines = append(lines, line)
+		}
+		return true
+	})
+	return strings.Join(lines, "\n")
+}
+
+// levelLine returns the level's own number, heading, chapeau, and content
+// text joined by spaces.
+func levelLine(l *level) string {
+	var parts []string
+	if l.num != nil {
+		parts = append(parts, l.num.Text)
+	}
+	if l.heading != nil {
+		parts = append(parts, l.heading.PlainText())
+	}
+	if l.chapeau != nil {
+		parts = append(parts, l.chapeau.PlainText())
+	}
+	if l.content != nil {
+		parts = append(parts, l.content.PlainText())
+	}
+	return strings.Join(parts, " ")
+}
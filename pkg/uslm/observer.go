@@ -0,0 +1,149 @@
+package uslm
+
+import (
+	"io"
+	"sync/atomic"
+	"time"
+)
+
+// ParsePhase names a stage of a parse reported to a ParserObserver.
+type ParsePhase string
+
+const (
+	// PhaseFetch is the HTTP request made by ParseDocumentFromURL, up to the
+	// start of the response body.
+	PhaseFetch ParsePhase = "fetch"
+
+	// PhaseProlog is reading up to the root element, including vetting any
+	// DOCTYPE, in the streaming decoders.
+	PhaseProlog ParsePhase = "prolog"
+
+	// PhaseDecode is decoding elements into the model.
+	PhaseDecode ParsePhase = "decode"
+)
+
+// ParseStats summarizes one parse for ParserObserver.ParseCompleted.
+type ParseStats struct {
+	// Operation is the exported function that decoded the input, such as
+	// "ParseBill" (also when reached through ParseDocument), "DecodeDocument",
+	// "ReadHeader", "ParseRaw", or "ParseDocumentFromURL".
+	Operation string
+
+	// Bytes is the number of bytes read from the input. Streaming decoders
+	// read ahead in blocks, so it can exceed what was needed.
+	Bytes int64
+
+	// Elements is the number of elements read.
+	Elements int64
+
+	// Duration is the wall time of the whole parse.
+	Duration time.Duration
+}
+
+// ParserObserver receives instrumentation events from the parser, for
+// example to export metrics about ingestion. Register one with
+// SetParserObserver. Methods are called synchronously from the parsing
+// goroutine, so implementations must be fast and safe for concurrent use.
+type ParserObserver interface {
+	// ElementDecoded is called for each element read, with its local name.
+	ElementDecoded(name string)
+
+	// PhaseCompleted is called as each phase of a parse completes
+	// successfully, with the time spent in it.
+	PhaseCompleted(operation string, phase ParsePhase, elapsed time.Duration)
+
+	// ParseCompleted is called once at the end of every parse, with the
+	// parse's error, if any.
+	ParseCompleted(stats ParseStats, err error)
+}
+
+// observerHolder lets an interface value be stored atomically.
+type observerHolder struct {
+	observer ParserObserver
+}
+
+var parserObserver atomic.Pointer[observerHolder]
+
+// SetParserObserver registers o to observe every parse in the process, or
+// removes the current observer when o is nil. Parsing is not instrumented
+// while no observer is registered.
+func SetParserObserver(o ParserObserver) {
+	if o == nil {
+		parserObserver.Store(nil)
+		return
+	}
+	parserObserver.Store(&observerHolder{observer: o})
+}
+
+// parseRun tracks one parse for the registered observer. A nil *parseRun,
+// returned when no observer is registered, ignores every call.
+type parseRun struct {
+	observer  ParserObserver
+	operation string
+	start     time.Time
+	mark      time.Time
+	bytes     int64
+	elements  int64
+}
+
+// beginParse starts tracking a parse by the named operation.
+func beginParse(operation string) *parseRun {
+	h := parserObserver.Load()
+	if h == nil {
+		return nil
+	}
+	now := time.Now()
+	return &parseRun{observer: h.observer, operation: operation, start: now, mark: now}
+}
+
+// reader returns r counting the bytes read into the run.
+func (p *parseRun) reader(r io.Reader) io.Reader {
+	if p == nil {
+		return r
+	}
+	return &observedReader{r: r, run: p}
+}
+
+// element records an element read.
+func (p *parseRun) element(name string) {
+	if p == nil {
+		return
+	}
+	p.elements++
+	p.observer.ElementDecoded(name)
+}
+
+// phase reports that a phase ended now, timed from the end of the previous one.
+func (p *parseRun) phase(phase ParsePhase) {
+	if p == nil {
+		return
+	}
+	now := time.Now()
+	p.observer.PhaseCompleted(p.operation, phase, now.Sub(p.mark))
+	p.mark = now
+}
+
+// end reports the completed parse.
+func (p *parseRun) end(err error) {
+	if p == nil {
+		return
+	}
+	p.observer.ParseCompleted(ParseStats{
+		Operation: p.operation,
+		Bytes:     p.bytes,
+		Elements:  p.elements,
+		Duration:  time.Since(p.start),
+	}, err)
+}
+
+// observedReader counts the bytes read through it into a parseRun.
+type observedReader struct {
+	r   io.Reader
+	run *parseRun
+}
+
+func (o *observedReader) Read(p []byte) (int, error) {
+	n, err := o.r.Read(p)
+	o.run.bytes += int64(n)
+	return n, err
+}
@@ -0,0 +1,85 @@
+package uslm
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+)
+
+// ParseOptions configures ParseDocumentWithOptions and
+// DecodeDocumentWithOptions.
+type ParseOptions struct {
+	// Limits bounds the resources the parse may consume.
+	Limits Limits
+
+	// Logger, if set, receives a warning for each recoverable anomaly the
+	// parser would otherwise pass over silently: elements the model has no
+	// field for, which are dropped, and required fields that are missing or
+	// empty (see CheckRequiredFields). Anomalies never fail the parse.
+	Logger *slog.Logger
+
+	// Unknown, if set, is reset and then filled with every element and
+	// attribute the model has no field for, so data loss can be measured
+	// per file. It must not be shared by concurrent parses.
+	Unknown *UnknownContent
+
+	// Strict fails the parse, with an error wrapping ErrUnknownContent, at
+	// the first element or attribute the model would drop.
+	Strict bool
+
+	// Progress, if set, is called every 64 KiB of input read and once more
+	// when the document has been read in full, so long parses can show
+	// progress. Calls are made from the goroutine running the parse.
+	Progress func(ParseProgress)
+
+	// Context, if set, carries the parent of the span traced for the parse
+	// (see SetTracer).
+	Context context.Context
+
+	// Whitespace selects how whitespace in element text is kept. The zero
+	// value, WhitespacePreserve, keeps the source's text unchanged.
+	Whitespace WhitespaceMode
+
+	// Interner, if set, interns the document's identifier and href
+	// attributes (see InternReferences), so that documents parsed with the
+	// same Interner share their storage.
+	Interner *Interner
+
+	// lexical, if set, receives the lexical forms of the source.
+	lexical *lexicalForms
+
+	// extensions, if set, receives the elements decoded by registered
+	// element handlers.
+	extensions *extensionRecorder
+
+	// keepPrefixes leaves the prefixes of the source as written, for
+	// ParseRaw, rather than binding Senate names to the msenate prefix.
+	keepPrefixes bool
+}
+
+// ParseDocumentWithOptions is like ParseDocument but applies opts.
+func ParseDocumentWithOptions(data []byte, opts ParseOptions) (LegislativeDocument, error) {
+	if max := opts.Limits.MaxBytes; max > 0 && int64(len(data)) > max {
+		return nil, fmt.Errorf("%w: document exceeds %d bytes", ErrLimitExceeded, max)
+	}
+	_, span := StartSpan(opts.Context, "uslm.ParseDocument", SpanAttribute{Key: AttrDocumentSize, Value: int64(len(data))})
+	run := beginParse("ParseDocument")
+	doc, err := decodeDocument(bytes.NewReader(data), opts, run)
+	run.end(err)
+	EndSpan(span, doc, err)
+	return doc, err
+}
+
+// DecodeDocumentWithOptions is like DecodeDocument but applies opts.
+func DecodeDocumentWithOptions(r io.Reader, opts ParseOptions) (LegislativeDocument, error) {
+	_, span := StartSpan(opts.Context, "uslm.DecodeDocument")
+	counter := &byteCounter{r: r}
+	run := beginParse("DecodeDocument")
+	doc, err := decodeDocument(counter, opts, run)
+	run.end(err)
+	span.SetAttributes(SpanAttribute{Key: AttrDocumentSize, Value: counter.n})
+	EndSpan(span, doc, err)
+	return doc, err
+}
@@ -0,0 +1,98 @@
+package uslm
+
+// OutlineEntry is one hierarchical level of a document outline: its
+// designation and heading without any body text, with its nested levels as
+// children. An outline is much smaller than the document it describes, which
+// makes it suitable for navigation and tables of contents.
+type OutlineEntry struct {
+	Element string `json:"element"`
+	Num     string `json:"num,omitempty"`
+	Heading string `json:"heading,omitempty"`
+
+	// Identifier addresses the level. It is the level's identifier, or the
+	// one AssignIdentifiers would give it (e.g. "/us/bill/116/hr/3/tI/s101"),
+	// so entries of unmodified documents keep the same address across
+	// versions. A level that cannot be given one is addressed by "#" and the
+	// id IDStylePositional would give it (e.g. "#tI_s101_b_1").
+	Identifier string          `json:"identifier"`
+	Children   []*OutlineEntry `json:"children,omitempty"`
+}
+
+// Outline returns the outline of the document's hierarchical levels, from
+// titles down to subclauses, in document order.
+func Outline(doc LegislativeDocument) []*OutlineEntry {
+	var base string
+	switch doc.(type) {
+	case *Bill, *Resolution:
+		base = documentIdentifier(doc.GetCitations())
+	}
+
+	var top []*OutlineEntry
+	byLevel := make(map[*level]*OutlineEntry)
+	// identifiers holds the USLM identifier of each level, which positional
+	// addresses are not, for deriving those of its children.
+	identifiers := make(map[*level]string)
+	walkDocumentLevels(doc, func(l *level) bool {
+		entry := &OutlineEntry{Element: l.element}
+		if l.num != nil {
+			entry.Num = normalizeSpace(l.num.Text)
+		}
+		if l.heading != nil {
+			entry.Heading = l.heading.PlainText()
+		}
+
+		identifier := *l.identifier
+		if identifier == "" {
+			parentIdentifier := base
+			if l.parent != nil {
+				parentIdentifier = identifiers[l.parent]
+			}
+			if parentIdentifier != "" && l.numValue() != "" {
+				identifier = parentIdentifier + "/" + identifierPrefix(l.element) + l.numValue()
+			}
+		}
+		identifiers[l] = identifier
+		entry.Identifier = identifier
+		if identifier == "" {
+			entry.Identifier = "#" + positionalID(l)
+		}
+
+		byLevel[l] = entry
+		if parent, ok := byLevel[l.parent]; ok {
+			parent.Children = append(parent.Children, entry)
+		} else {
+			top = append(top, entry)
+		}
+		return true
+	})
+	return top
+}
+
+// Walk visits e and its descendants in document order. Returning false from
+// fn skips the entry's children.
+func (e *OutlineEntry) Walk(fn func(e *OutlineEntry) bool) {
+	if !fn(e) {
+		return
+	}
+	for _, c := range e.Children {
+		c.Walk(fn)
+	}
+}
+
+// FindOutlineEntry returns the entry of outline with the given identifier,
+// or nil.
+func FindOutlineEntry(outline []*OutlineEntry, identifier string) *OutlineEntry {
+	var found *OutlineEntry
+	for _, e := range outline {
+		e.Walk(func(e *OutlineEntry) bool {
+			if found == nil && e.Identifier == identifier {
+				found = e
+			}
+			return found == nil
+		})
+		if found != nil {
+			break
+		}
+	}
+	return found
+}
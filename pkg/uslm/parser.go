@@ -11,7 +11,7 @@ import (
 // ParseBill parses XML data into a Bill struct.
 func ParseBill(data []byte) (*Bill, error) {
 	var bill Bill
-	if err := xml.Unmarshal(data, &bill); err != nil {
+	if err := unmarshalDocument("ParseBill", data, &bill); err != nil {
 		return nil, fmt.Errorf("failed to parse bill: %w", err)
 	}
 	return &bill, nil
@@ -20,7 +20,7 @@ func ParseBill(data []byte) (*Bill, error) {
 // ParseResolution parses XML data into a Resolution struct.
 func ParseResolution(data []byte) (*Resolution, error) {
 	var resolution Resolution
-	if err := xml.Unmarshal(data, &resolution); err != nil {
+	if err := unmarshalDocument("ParseResolution", data, &resolution); err != nil {
 		return nil, fmt.Errorf("failed to parse resolution: %w", err)
 	}
 	return &resolution, nil
@@ -29,7 +29,7 @@ func ParseResolution(data []byte) (*Resolution, error) {
 // ParseEngrossedAmendment parses XML data into an EngrossedAmendment struct.
 func ParseEngrossedAmendment(data []byte) (*EngrossedAmendment, error) {
 	var amendment EngrossedAmendment
-	if err := xml.Unmarshal(data, &amendment); err != nil {
+	if err := unmarshalDocument("ParseEngrossedAmendment", data, &amendment); err != nil {
 		return nil, fmt.Errorf("failed to parse engrossed amendment: %w", err)
 	}
 	return &amendment, nil
@@ -38,12 +38,21 @@ func ParseEngrossedAmendment(data []byte) (*EngrossedAmendment, error) {
 // ParseAmendment parses XML data into an Amendment struct.
 func ParseAmendment(data []byte) (*Amendment, error) {
 	var amendment Amendment
-	if err := xml.Unmarshal(data, &amendment); err != nil {
+	if err := unmarshalDocument("ParseAmendment", data, &amendment); err != nil {
 		return nil, fmt.Errorf("failed to parse amendment: %w", err)
 	}
 	return &amendment, nil
 }
 
+// ParseGenericDocument parses XML data into a GenericDocument struct.
+func ParseGenericDocument(data []byte) (*GenericDocument, error) {
+	var doc GenericDocument
+	if err := unmarshalDocument("ParseGenericDocument", data, &doc); err != nil {
+		return nil, fmt.Errorf("failed to parse generic document: %w", err)
+	}
+	return &doc, nil
+}
+
 // DocumentType represents the type of USLM document.
 type DocumentType string
 
@@ -52,6 +61,7 @@ const (
 	DocumentTypeResolution         DocumentType = "resolution"
 	DocumentTypeAmendment          DocumentType = "amendment"
 	DocumentTypeEngrossedAmendment DocumentType = "engrossedAmendment"
+	DocumentTypeGeneric            DocumentType = "document"
 	DocumentTypeUnknown            DocumentType = "unknown"
 )
 
@@ -72,6 +82,9 @@ func DetectDocumentType(data []byte) DocumentType {
 	if strings.Contains(content, "<amendment ") || strings.Contains(content, "<amendment>") {
 		return DocumentTypeAmendment
 	}
+	if strings.Contains(content, "<document ") || strings.Contains(content, "<document>") {
+		return DocumentTypeGeneric
+	}
 
 	return DocumentTypeUnknown
 }
@@ -90,6 +103,8 @@ func ParseDocument(data []byte) (LegislativeDocument, error) {
 		return ParseEngrossedAmendment(data)
 	case DocumentTypeAmendment:
 		return ParseAmendment(data)
+	case DocumentTypeGeneric:
+		return ParseGenericDocument(data)
 	default:
 		return nil, fmt.Errorf("unknown document type")
 	}
@@ -141,11 +156,45 @@ func MarshalAmendmentToXML(amendment *Amendment) ([]byte, error) {
 	return append([]byte(xml.Header), data...), nil
 }
 
+// MarshalGenericDocumentToXML marshals a GenericDocument to XML.
+func MarshalGenericDocumentToXML(doc *GenericDocument) ([]byte, error) {
+	data, err := xml.MarshalIndent(doc, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal generic document to XML: %w", err)
+	}
+	return append([]byte(xml.Header), data...), nil
+}
+
+// MarshalDocumentToXML marshals any supported document type to XML.
+func MarshalDocumentToXML(doc LegislativeDocument) ([]byte, error) {
+	switch d := doc.(type) {
+	case *Bill:
+		return MarshalBillToXML(d)
+	case *Resolution:
+		return MarshalResolutionToXML(d)
+	case *EngrossedAmendment:
+		return MarshalEngrossedAmendmentToXML(d)
+	case *Amendment:
+		return MarshalAmendmentToXML(d)
+	case *GenericDocument:
+		return MarshalGenericDocumentToXML(d)
+	default:
+		return nil, fmt.Errorf("unsupported document type %T", doc)
+	}
+}
+
 // ToJSON converts any USLM document to JSON.
 func ToJSON(doc interface{}) ([]byte, error) {
 	return json.MarshalIndent(doc, "", "  ")
 }
 
+// EncodeJSON writes any USLM document to w as indented JSON. Unlike ToJSON it
+// streams the output rather than building it in memory, which matters for
+// omnibus bills; use EncodeJSONWithOptions to skip indentation entirely.
+func EncodeJSON(w io.Writer, doc interface{}) error {
+	return EncodeJSONWithOptions(w, doc, JSONOptions{Indent: true})
+}
+
 // BillFromJSON parses JSON data into a Bill struct.
 func BillFromJSON(data []byte) (*Bill, error) {
 	var bill Bill
@@ -181,3 +230,53 @@ func AmendmentFromJSON(data []byte) (*Amendment, error) {
 	}
 	return &amendment, nil
 }
+
+// GenericDocumentFromJSON parses JSON data into a GenericDocument struct.
+func GenericDocumentFromJSON(data []byte) (*GenericDocument, error) {
+	var doc GenericDocument
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return nil, fmt.Errorf("failed to parse generic document from JSON: %w", err)
+	}
+	return &doc, nil
+}
+
+// DocumentFromJSON parses JSON data into the struct for the given document
+// type. JSON carries no root element name, so the type must be known.
+func DocumentFromJSON(data []byte, docType DocumentType) (LegislativeDocument, error) {
+	switch docType {
+	case DocumentTypeBill:
+		return BillFromJSON(data)
+	case DocumentTypeResolution:
+		return ResolutionFromJSON(data)
+	case DocumentTypeEngrossedAmendment:
+		return EngrossedAmendmentFromJSON(data)
+	case DocumentTypeAmendment:
+		return AmendmentFromJSON(data)
+	case DocumentTypeGeneric:
+		return GenericDocumentFromJSON(data)
+	default:
+		return nil, fmt.Errorf("unknown document type %q", docType)
+	}
+}
+
+// SectionToJSON converts a single section, with its nested levels, to JSON
+// so that provisions can be stored or sent independently of their document
+// (for example, one database row per section). The section's identifier is
+// its only link back to the document; see AssignIdentifiers for documents
+// that lack them.
+func SectionToJSON(sec *Section) ([]byte, error) {
+	if sec == nil {
+		return nil, fmt.Errorf("failed to convert section to JSON: section is nil")
+	}
+	return ToJSON(sec)
+}
+
+// SectionFromJSON parses JSON data written by SectionToJSON into a Section
+// struct.
+func SectionFromJSON(data []byte) (*Section, error) {
+	var sec Section
+	if err := json.Unmarshal(data, &sec); err != nil {
+		return nil, fmt.Errorf("failed to parse section from JSON: %w", err)
+	}
+	return &sec, nil
+}
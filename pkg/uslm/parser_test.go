@@ -1,9 +1,44 @@
 package uslm
 
 import (
+	"bytes"
+	"compress/gzip"
+	"compress/zlib"
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"html/template"
+	"io"
+	"log/slog"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"path/filepath"
+	"reflect"
+	"regexp"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
 	"testing"
+	"testing/fstest"
+	"testing/iotest"
+	"time"
+	"unicode/utf8"
+	"unsafe"
+
+	"github.com/usgpo/uslm/pkg/uslm/schema"
 )
 
 func TestParseBill(t *testing.T) {
@@ -337,3 +372,5445 @@ func TestMetadataDocumentInterface(t *testing.T) {
 		t.Error("expected processedDate to be set")
 	}
 }
+
+func TestTOCNesting(t *testing.T) {
+	data := []byte(`<toc>
+<referenceItem idref="T1" role="title">
+<designator>TITLE I—</designator><label>GENERAL PROVISIONS</label>
+<referenceItem idref="T1ST1" role="subtitle">
+<designator>Subtitle A—</designator><label>Definitions</label>
+<referenceItem idref="S101" role="section"><designator>Sec. 101.</designator><label>Definitions.</label></referenceItem>
+</referenceItem>
+</referenceItem>
+<referenceItem idref="S201" role="section"><designator>Sec. 201.</designator><label>Effective date.</label></referenceItem>
+</toc>`)
+
+	var toc TOC
+	if err := xml.Unmarshal(data, &toc); err != nil {
+		t.Fatalf("failed to parse toc: %v", err)
+	}
+
+	if len(toc.ReferenceItem) != 2 {
+		t.Fatalf("expected 2 top-level items, got %d", len(toc.ReferenceItem))
+	}
+	if toc.MaxDepth() != 2 {
+		t.Errorf("expected max depth 2, got %d", toc.MaxDepth())
+	}
+
+	var got []string
+	toc.Walk(func(item *ReferenceItem, depth int) bool {
+		got = append(got, fmt.Sprintf("%d:%s", depth, item.IDRef))
+		return true
+	})
+	want := []string{"0:T1", "1:T1ST1", "2:S101", "0:S201"}
+	if strings.Join(got, ",") != strings.Join(want, ",") {
+		t.Errorf("unexpected walk order: got %v, want %v", got, want)
+	}
+
+	// Returning false skips children
+	var count int
+	toc.Walk(func(item *ReferenceItem, depth int) bool {
+		count++
+		return item.Role != "title"
+	})
+	if count != 2 {
+		t.Errorf("expected 2 visited items when skipping titles, got %d", count)
+	}
+
+	if len(toc.Flatten()) != 4 {
+		t.Errorf("expected 4 flattened items, got %d", len(toc.Flatten()))
+	}
+}
+
+func TestMetaProperties(t *testing.T) {
+	data := []byte(`<meta xmlns:dc="http://purl.org/dc/elements/1.1/">
+<dc:title>Test</dc:title>
+<docNumber>1</docNumber>
+<property name="docPart" value="main"/>
+<property name="billStage">Introduced</property>
+<set name="versions" type="seq">
+<property name="introduced" type="date" date="2024-01-03">January 3, 2024</property>
+<set name="links"><property name="pdf" type="url" href="https://example.gov/1.pdf"/></set>
+</set>
+</meta>`)
+
+	var meta Meta
+	if err := xml.Unmarshal(data, &meta); err != nil {
+		t.Fatalf("failed to parse meta: %v", err)
+	}
+
+	tests := map[string]string{
+		"docPart":    "main",
+		"billStage":  "Introduced",
+		"introduced": "2024-01-03",
+		"pdf":        "https://example.gov/1.pdf",
+		"missing":    "",
+	}
+	for name, want := range tests {
+		if got := meta.GetProperty(name); got != want {
+			t.Errorf("GetProperty(%q): got '%s', want '%s'", name, got, want)
+		}
+	}
+
+	// Round-trip through XML keeps properties and sets
+	out, err := xml.Marshal(&meta)
+	if err != nil {
+		t.Fatalf("failed to marshal meta: %v", err)
+	}
+	var meta2 Meta
+	if err := xml.Unmarshal(out, &meta2); err != nil {
+		t.Fatalf("failed to re-parse meta: %v", err)
+	}
+	if meta2.GetProperty("pdf") != "https://example.gov/1.pdf" {
+		t.Errorf("nested set property not preserved through round-trip")
+	}
+}
+
+func TestUnmodeledAttributePreservation(t *testing.T) {
+	data := []byte(`<section xmlns="http://schemas.gpo.gov/xml/uslm" id="S1" style="-uslm-lc:I81" display-inline="yes-display-inline"><num value="1">SECTION 1.</num><content class="block" orientation="landscape">Text.</content></section>`)
+
+	var section Section
+	if err := xml.Unmarshal(data, &section); err != nil {
+		t.Fatalf("failed to parse section: %v", err)
+	}
+
+	if section.Attrs.Get("style") != "-uslm-lc:I81" {
+		t.Errorf("expected style to be captured, got '%s'", section.Attrs.Get("style"))
+	}
+	if !section.Attrs.Has("display-inline") {
+		t.Error("expected display-inline to be captured")
+	}
+	if section.Attrs.Has("id") {
+		t.Error("modeled attribute id should not be captured")
+	}
+	if section.Attrs.Has("xmlns") {
+		t.Error("namespace declarations should not be captured")
+	}
+	if section.Content == nil || section.Content.Attrs.Get("orientation") != "landscape" {
+		t.Error("expected content orientation to be captured")
+	}
+
+	// XML round-trip
+	out, err := xml.Marshal(&section)
+	if err != nil {
+		t.Fatalf("failed to marshal section: %v", err)
+	}
+	if !strings.Contains(string(out), `style="-uslm-lc:I81"`) || !strings.Contains(string(out), `orientation="landscape"`) {
+		t.Errorf("unmodeled attributes not preserved in XML: %s", out)
+	}
+
+	// JSON round-trip
+	jsonData, err := ToJSON(&section)
+	if err != nil {
+		t.Fatalf("failed to marshal section to JSON: %v", err)
+	}
+	var section2 Section
+	if err := json.Unmarshal(jsonData, &section2); err != nil {
+		t.Fatalf("failed to parse section from JSON: %v", err)
+	}
+	if section2.Attrs.Get("display-inline") != "yes-display-inline" {
+		t.Errorf("unmodeled attributes not preserved in JSON: %s", jsonData)
+	}
+}
+
+func TestAssignIdentifiers(t *testing.T) {
+	bill := &Bill{
+		Meta: &Meta{CitableAs: []string{"116 H. R. 3 RH", "116hr3rh"}},
+		Main: &Main{
+			Titles: []Title{{
+				Num: &Num{Value: "I"},
+				Sections: []Section{{
+					Num: &Num{Value: "101"},
+					Subsections: []Subsection{{
+						Num: &Num{Value: "b"},
+						Paragraphs: []Paragraph{{
+							Num:           &Num{Value: "1"},
+							Subparagraphs: []Subparagraph{{Num: &Num{Value: "C"}, Clauses: []Clause{{Num: &Num{Value: "ii"}}}}},
+						}},
+					}},
+				}},
+			}},
+			Sections: []Section{{ID: "keep", Identifier: "/keep", Num: &Num{Value: "1"}}},
+		},
+	}
+
+	if err := AssignIdentifiers(bill); err != nil {
+		t.Fatalf("failed to assign identifiers: %v", err)
+	}
+
+	title := bill.Main.Titles[0]
+	if title.Identifier != "/us/bill/116/hr/3/tI" {
+		t.Errorf("unexpected title identifier '%s'", title.Identifier)
+	}
+	clause := title.Sections[0].Subsections[0].Paragraphs[0].Subparagraphs[0].Clauses[0]
+	if clause.Identifier != "/us/bill/116/hr/3/tI/s101/b/1/C/ii" {
+		t.Errorf("unexpected clause identifier '%s'", clause.Identifier)
+	}
+	if !strings.HasPrefix(clause.ID, "id") || len(clause.ID) != 34 {
+		t.Errorf("expected GUID-style id, got '%s'", clause.ID)
+	}
+	if bill.Main.Sections[0].ID != "keep" || bill.Main.Sections[0].Identifier != "/keep" {
+		t.Error("existing id and identifier should not be overwritten")
+	}
+
+	// Positional ids are deterministic
+	res := &Resolution{Main: &Main{Sections: []Section{{Num: &Num{Value: "2"}, Paragraphs: []Paragraph{{}}}}}}
+	if err := AssignIdentifiersWithStyle(res, IDStylePositional); err != nil {
+		t.Fatalf("failed to assign identifiers: %v", err)
+	}
+	if res.Main.Sections[0].ID != "s2" || res.Main.Sections[0].Paragraphs[0].ID != "s2_p1" {
+		t.Errorf("unexpected positional ids '%s', '%s'", res.Main.Sections[0].ID, res.Main.Sections[0].Paragraphs[0].ID)
+	}
+	if res.Main.Sections[0].Identifier != "" {
+		t.Error("identifier should not be assigned without a document citation")
+	}
+
+	// Resolutions, joint and concurrent ones included, are addressed under
+	// /us/resolution
+	for citation, want := range map[string]string{"116sres100is": "/us/resolution/116/sres/100/s1", "116hjres31enr": "/us/resolution/116/hjres/31/s1"} {
+		res := &Resolution{Meta: &Meta{CitableAs: []string{citation}}, Main: &Main{Sections: []Section{{Num: &Num{Value: "1"}}}}}
+		if err := AssignIdentifiers(res); err != nil {
+			t.Fatalf("failed to assign identifiers: %v", err)
+		}
+		if got := res.Main.Sections[0].Identifier; got != want {
+			t.Errorf("unexpected section identifier '%s' for %s, want '%s'", got, citation, want)
+		}
+	}
+}
+
+func TestCheckIdentifiers(t *testing.T) {
+	bill := &Bill{
+		Meta: &Meta{CitableAs: []string{"114s32cds"}},
+		Main: &Main{Sections: []Section{
+			{ID: "S1", Identifier: "/us/bill/114/s/32/s1", Subsections: []Subsection{
+				{ID: "A", Identifier: "/us/bill/114/s/32/s1/a"},
+				{ID: "B", Identifier: "/us/bill/114/s/32/s2/b"},
+			}},
+			{ID: "S1", Identifier: "/us/bill/114/s/32/s1"},
+		}},
+	}
+
+	counts := make(map[IssueKind]int)
+	for _, issue := range CheckIdentifiers(bill) {
+		counts[issue.Kind]++
+	}
+	if counts[IssueDuplicateID] != 1 {
+		t.Errorf("expected 1 duplicate id, got %d", counts[IssueDuplicateID])
+	}
+	if counts[IssueDuplicateIdentifier] != 1 {
+		t.Errorf("expected 1 duplicate identifier, got %d", counts[IssueDuplicateIdentifier])
+	}
+	if counts[IssueInconsistentIdentifier] != 1 {
+		t.Errorf("expected 1 inconsistent identifier, got %d", counts[IssueInconsistentIdentifier])
+	}
+
+	// A clean sample produces no issues
+	data, err := os.ReadFile(filepath.Join("..", "..", "bill-version-samples-september-2024", "BILLS-114s32cds.xml"))
+	if err != nil {
+		t.Fatalf("failed to read sample bill: %v", err)
+	}
+	sample, err := ParseBill(data)
+	if err != nil {
+		t.Fatalf("failed to parse bill: %v", err)
+	}
+	if issues := CheckIdentifiers(sample); len(issues) != 0 {
+		t.Errorf("expected no issues, got %v", issues)
+	}
+}
+
+func TestExtractQuotedBlocks(t *testing.T) {
+	data, err := os.ReadFile(filepath.Join("..", "..", "bill-version-samples-september-2024", "BILLS-114s32cds.xml"))
+	if err != nil {
+		t.Fatalf("failed to read sample bill: %v", err)
+	}
+	bill, err := ParseBill(data)
+	if err != nil {
+		t.Fatalf("failed to parse bill: %v", err)
+	}
+
+	var blocks []QuotedBlock
+	for _, block := range ExtractQuotedBlocks(bill) {
+		if block.Identifier == "/us/bill/114/s/32/s2/2" {
+			blocks = append(blocks, block)
+		}
+	}
+	if len(blocks) != 2 {
+		t.Fatalf("expected 2 quoted blocks in section 2(2), got %d", len(blocks))
+	}
+
+	// "by striking "It shall" and all that follows and inserting the following:"
+	if blocks[0].GetText() != "It shall" {
+		t.Errorf("expected quoted text 'It shall', got '%s'", blocks[0].GetText())
+	}
+	if blocks[0].Action == nil || blocks[0].Action.Type != "delete" {
+		t.Errorf("expected 'delete' action for quoted text, got %+v", blocks[0].Action)
+	}
+	if blocks[1].QuotedContent == nil {
+		t.Error("expected quoted content")
+	}
+	if blocks[1].Action == nil || blocks[1].Action.Type != "insert" {
+		t.Errorf("expected 'insert' action for quoted content, got %+v", blocks[1].Action)
+	}
+
+	// Target comes from the enclosing section chapeau
+	if len(blocks[0].Targets) == 0 || blocks[0].Targets[0].InnerRef == nil || blocks[0].Targets[0].InnerRef.Href != "/us/usc/t21/s959" {
+		t.Errorf("expected target '/us/usc/t21/s959', got %+v", blocks[0].Targets)
+	}
+}
+
+func TestClassifyAmendingActions(t *testing.T) {
+	tests := []struct {
+		action  AmendingAction
+		context string
+		want    AmendingActionKind
+	}{
+		{AmendingAction{Type: "delete", Text: "striking"}, "", AmendingActionStrike},
+		{AmendingAction{Type: "insert", Text: "inserting"}, "", AmendingActionInsert},
+		{AmendingAction{Type: "redesignate", Text: "redesignating"}, "", AmendingActionRedesignate},
+		{AmendingAction{Type: "add", Text: "adding"}, "by  at the end the following:", AmendingActionAddAtEnd},
+		{AmendingAction{Type: "add", Text: "adding"}, "by  after paragraph (2) the following:", AmendingActionInsert},
+		{AmendingAction{Type: "amend", Text: "is amended"}, "Section 5  to read as follows:", AmendingActionAmendToRead},
+		{AmendingAction{Type: "amend", Text: "is amended"}, "Section 5 —", AmendingActionAmend},
+		{AmendingAction{Text: "is repealed"}, "", AmendingActionRepeal},
+		{AmendingAction{Text: "notwithstanding"}, "", AmendingActionUnknown},
+	}
+	for _, tt := range tests {
+		if got := tt.action.Classify(tt.context); got != tt.want {
+			t.Errorf("Classify(%q, %q): got %s, want %s", tt.action.Text, tt.context, got, tt.want)
+		}
+	}
+
+	// Prose-only instruction
+	kinds := ClassifyAmendingText(`in subsection (a), by striking "2019" and inserting "2020"`)
+	if len(kinds) != 2 || kinds[0] != AmendingActionStrike || kinds[1] != AmendingActionInsert {
+		t.Errorf("unexpected prose classification: %v", kinds)
+	}
+	kinds = ClassifyAmendingText("Section 3 of such Act is amended—")
+	if len(kinds) != 1 || kinds[0] != AmendingActionAmend {
+		t.Errorf("unexpected prose classification: %v", kinds)
+	}
+
+	// Content falls back to prose when no amendingAction markup is present
+	content := &Content{Text: "by redesignating paragraphs (3) and (4) as paragraphs (4) and (5)"}
+	if kinds := content.AmendingActionKinds(); len(kinds) != 1 || kinds[0] != AmendingActionRedesignate {
+		t.Errorf("unexpected content classification: %v", kinds)
+	}
+}
+
+func TestPopularNames(t *testing.T) {
+	table := DefaultPopularNames()
+
+	mentions := table.FindActMentions("section 1009 of the Controlled Substances Import and Export Act and title II of the Social Security Act")
+	if len(mentions) != 2 {
+		t.Fatalf("expected 2 mentions, got %d: %+v", len(mentions), mentions)
+	}
+	if mentions[0].Name != "Controlled Substances Import and Export Act" || mentions[0].Location.USCodeHref != "/us/usc/t21/s951" {
+		t.Errorf("unexpected first mention %+v", mentions[0])
+	}
+	if mentions[1].Name != "Social Security Act" || mentions[1].Location.StatutesAtLarge != "49 Stat. 620" {
+		t.Errorf("unexpected second mention %+v", mentions[1])
+	}
+
+	// Custom entries are pluggable
+	table.Add("Transnational Drug Trafficking Act of 2015", ActLocation{StatutesAtLarge: "130 Stat. 27"})
+	if _, ok := table.Lookup("Transnational Drug Trafficking Act of 2015"); !ok {
+		t.Error("expected custom entry to be registered")
+	}
+
+	data, err := os.ReadFile(filepath.Join("..", "..", "bill-version-samples-september-2024", "BILLS-114s32cds.xml"))
+	if err != nil {
+		t.Fatalf("failed to read sample bill: %v", err)
+	}
+	bill, err := ParseBill(data)
+	if err != nil {
+		t.Fatalf("failed to parse bill: %v", err)
+	}
+	var found bool
+	for _, m := range table.ExtractActMentions(bill) {
+		if m.Identifier == "/us/bill/114/s/32/s2" && m.Name == "Controlled Substances Import and Export Act" {
+			found = true
+		}
+	}
+	if !found {
+		t.Error("expected mention of the Controlled Substances Import and Export Act in section 2")
+	}
+}
+
+func TestParseGenericDocument(t *testing.T) {
+	data := []byte(`<?xml version="1.0" encoding="UTF-8"?>
+<document xmlns="http://schemas.gpo.gov/xml/uslm" xmlns:dc="http://purl.org/dc/elements/1.1/" xml:lang="en">
+<meta>
+<dc:title>Rules Committee Print 118-10</dc:title>
+<dc:type>Committee Print</dc:type>
+<docNumber>118-10</docNumber>
+<docStage>Rules Committee Print</docStage>
+<congress>118</congress>
+<session>1</session>
+<publicPrivate>public</publicPrivate>
+</meta>
+<content>
+<p>Text of the bill as reported.</p>
+<section id="S1"><num value="1">SECTION 1. </num><heading>SHORT TITLE.</heading><content>This Act may be cited as the Example Act.</content></section>
+</content>
+</document>`)
+
+	if docType := DetectDocumentType(data); docType != DocumentTypeGeneric {
+		t.Fatalf("expected %s, got %s", DocumentTypeGeneric, docType)
+	}
+
+	doc, err := ParseDocument(data)
+	if err != nil {
+		t.Fatalf("failed to parse document: %v", err)
+	}
+	generic, ok := doc.(*GenericDocument)
+	if !ok {
+		t.Fatalf("expected *GenericDocument, got %T", doc)
+	}
+
+	if generic.GetDocumentNumber() != "118-10" {
+		t.Errorf("expected doc number '118-10', got '%s'", generic.GetDocumentNumber())
+	}
+	if len(generic.GetSections()) != 1 || generic.GetSections()[0].GetHeading() != "SHORT TITLE." {
+		t.Errorf("expected 1 section with heading 'SHORT TITLE.', got %+v", generic.GetSections())
+	}
+	if !IsCommitteePrint(generic) {
+		t.Error("expected document to be a committee print")
+	}
+	if IsCongressionalDocument(generic) {
+		t.Error("did not expect document to be a congressional document")
+	}
+
+	// Round-trip through JSON and XML
+	jsonData, err := ToJSON(generic)
+	if err != nil {
+		t.Fatalf("failed to marshal document to JSON: %v", err)
+	}
+	generic2, err := GenericDocumentFromJSON(jsonData)
+	if err != nil {
+		t.Fatalf("failed to parse document from JSON: %v", err)
+	}
+	xmlData, err := MarshalGenericDocumentToXML(generic2)
+	if err != nil {
+		t.Fatalf("failed to marshal document to XML: %v", err)
+	}
+	generic3, err := ParseGenericDocument(xmlData)
+	if err != nil {
+		t.Fatalf("failed to re-parse document: %v", err)
+	}
+	if generic3.GetTitle() != generic.GetTitle() {
+		t.Errorf("title not preserved: got '%s', want '%s'", generic3.GetTitle(), generic.GetTitle())
+	}
+}
+
+func TestSummarize(t *testing.T) {
+	data, err := os.ReadFile(filepath.Join("..", "..", "bill-version-samples-september-2024", "BILLS-114s32cds.xml"))
+	if err != nil {
+		t.Fatalf("failed to read sample bill: %v", err)
+	}
+	bill, err := ParseBill(data)
+	if err != nil {
+		t.Fatalf("failed to parse bill: %v", err)
+	}
+
+	summary := Summarize(bill)
+	if len(summary.Entries) != 3 {
+		t.Fatalf("expected 3 entries, got %d", len(summary.Entries))
+	}
+
+	first := summary.Entries[0]
+	if first.Num != "SECTION 1." || first.Heading != "SHORT TITLE." {
+		t.Errorf("unexpected num/heading '%s' '%s'", first.Num, first.Heading)
+	}
+	// Inline shortTitle text is kept in reading order
+	if first.FirstSentence != "This Act may be cited as the “Transnational Drug Trafficking Act of 2015”." {
+		t.Errorf("unexpected first sentence '%s'", first.FirstSentence)
+	}
+
+	// Abbreviations do not end the sentence
+	if got := summary.Entries[1].FirstSentence; !strings.HasSuffix(got, "(21 U.S.C. 959) is amended—") {
+		t.Errorf("unexpected first sentence '%s'", got)
+	}
+
+	md := summary.Markdown()
+	if !strings.HasPrefix(md, "# Senate Bill 32") {
+		t.Errorf("unexpected markdown heading: %s", md)
+	}
+	if !strings.Contains(md, "**SECTION 1. SHORT TITLE.** This Act may be cited") {
+		t.Errorf("expected section line in markdown: %s", md)
+	}
+
+	if got := firstSentence("Section 5 is amended. The Secretary shall act."); got != "Section 5 is amended." {
+		t.Errorf("unexpected first sentence '%s'", got)
+	}
+}
+
+func TestNormalizeText(t *testing.T) {
+	input := "Section 1 \u00a0“short\u00adtitle”—the Act’s ‘purpose’ …\u2009 "
+
+	got := NormalizeText(input, DefaultNormalizeOptions())
+	want := `Section 1 "shorttitle"-the Act's 'purpose' ...`
+	if got != want {
+		t.Errorf("unexpected normalization: got %q, want %q", got, want)
+	}
+
+	// Options are independent
+	got = NormalizeText("“x”—y", NormalizeOptions{Quotes: true})
+	if got != "\"x\"—y" {
+		t.Errorf("expected only quotes to be normalized, got %q", got)
+	}
+
+	data, err := os.ReadFile(filepath.Join("..", "..", "bill-version-samples-september-2024", "BILLS-114s32cds.xml"))
+	if err != nil {
+		t.Fatalf("failed to read sample bill: %v", err)
+	}
+	bill, err := ParseBill(data)
+	if err != nil {
+		t.Fatalf("failed to parse bill: %v", err)
+	}
+	text := ExtractText(bill, DefaultNormalizeOptions())
+	if !strings.Contains(text, `This Act may be cited as the "Transnational Drug Trafficking Act of 2015".`) {
+		t.Errorf("expected normalized short title in extracted text")
+	}
+	if strings.ContainsAny(text, "“”—") {
+		t.Error("expected typographic characters to be normalized")
+	}
+}
+
+func TestJSONOptions(t *testing.T) {
+	for in, want := range map[string]string{
+		"docNumber":         "doc_number",
+		"xmlnsHTML":         "xmlns_html",
+		"xsiSchemaLocation": "xsi_schema_location",
+		"usCodeHref":        "us_code_href",
+		"idref":             "idref",
+	} {
+		if got := snakeCase(in); got != want {
+			t.Errorf("snakeCase(%q) = %q, want %q", in, got, want)
+		}
+	}
+
+	data, err := os.ReadFile(filepath.Join("..", "..", "bill-version-samples-september-2024", "BILLS-114s32cds.xml"))
+	if err != nil {
+		t.Fatalf("failed to read sample bill: %v", err)
+	}
+	bill, err := ParseBill(data)
+	if err != nil {
+		t.Fatalf("failed to parse bill: %v", err)
+	}
+
+	opts := JSONOptions{Naming: FieldNamingSnake, SortKeys: true}
+	out, err := ToJSONWithOptions(bill, opts)
+	if err != nil {
+		t.Fatalf("failed to marshal: %v", err)
+	}
+	if !strings.Contains(string(out), `"doc_number":"32"`) {
+		t.Errorf("expected snake_case doc_number key in %.200s", out)
+	}
+	if strings.Contains(string(out), `"docNumber"`) {
+		t.Error("expected no camelCase keys")
+	}
+
+	again, err := ToJSONWithOptions(bill, opts)
+	if err != nil {
+		t.Fatalf("failed to marshal: %v", err)
+	}
+	if string(out) != string(again) {
+		t.Error("expected identical output across runs")
+	}
+
+	prev := ""
+	for _, key := range strings.Split(topLevelKeys(t, out), ",") {
+		if key < prev {
+			t.Errorf("keys not sorted: %q after %q", key, prev)
+		}
+		prev = key
+	}
+
+	var decoded Bill
+	if err := FromJSONWithOptions(out, &decoded, opts); err != nil {
+		t.Fatalf("failed to unmarshal: %v", err)
+	}
+	if decoded.GetDocumentNumber() != bill.GetDocumentNumber() || decoded.XMLNSDC != bill.XMLNSDC {
+		t.Errorf("round trip mismatch: %q vs %q", decoded.GetDocumentNumber(), bill.GetDocumentNumber())
+	}
+	if len(decoded.GetSections()) != len(bill.GetSections()) {
+		t.Errorf("expected %d sections after round trip, got %d", len(bill.GetSections()), len(decoded.GetSections()))
+	}
+}
+
+// topLevelKeys returns the keys of a JSON object in document order, comma separated.
+func topLevelKeys(t *testing.T, data []byte) string {
+	dec := json.NewDecoder(strings.NewReader(string(data)))
+	if _, err := dec.Token(); err != nil {
+		t.Fatalf("invalid JSON: %v", err)
+	}
+	var keys []string
+	for dec.More() {
+		key, err := dec.Token()
+		if err != nil {
+			t.Fatalf("invalid JSON: %v", err)
+		}
+		keys = append(keys, key.(string))
+		var skip json.RawMessage
+		if err := dec.Decode(&skip); err != nil {
+			t.Fatalf("invalid JSON: %v", err)
+		}
+	}
+	return strings.Join(keys, ",")
+}
+
+func TestEncodeJSON(t *testing.T) {
+	files, err := filepath.Glob(filepath.Join("..", "..", "bill-version-samples-september-2024", "*.xml"))
+	if err != nil || len(files) == 0 {
+		t.Fatalf("failed to list samples: %v", err)
+	}
+
+	for _, file := range files {
+		data, err := os.ReadFile(file)
+		if err != nil {
+			t.Fatalf("failed to read %s: %v", file, err)
+		}
+		doc, err := ParseDocument(data)
+		if err != nil {
+			continue
+		}
+
+		want, err := ToJSON(doc)
+		if err != nil {
+			t.Fatalf("failed to marshal %s: %v", file, err)
+		}
+		var buf strings.Builder
+		if err := EncodeJSON(&buf, doc); err != nil {
+			t.Fatalf("failed to encode %s: %v", file, err)
+		}
+		if buf.String() != string(want)+"\n" {
+			t.Errorf("%s: encoded JSON differs from ToJSON", filepath.Base(file))
+		}
+
+		buf.Reset()
+		if err := EncodeJSONWithOptions(&buf, doc, JSONOptions{}); err != nil {
+			t.Fatalf("failed to encode %s: %v", file, err)
+		}
+		if strings.Contains(buf.String(), "\n  ") {
+			t.Errorf("%s: expected compact output", filepath.Base(file))
+		}
+
+		for _, opts := range jsonOptionSets {
+			buf.Reset()
+			if err := EncodeJSONWithOptions(&buf, doc, opts); err != nil {
+				t.Fatalf("failed to encode %s: %v", file, err)
+			}
+			if want := rewrittenJSON(t, doc, opts); buf.String() != want {
+				t.Errorf("%s: %+v: encoded JSON differs from the marshaled and rewritten form", filepath.Base(file), opts)
+			}
+		}
+	}
+
+	// Values encoding/json treats specially are encoded as it would.
+	type inner struct {
+		A string `json:"a,omitempty"`
+		B []byte `json:"b"`
+	}
+	type embedded struct {
+		inner
+		C int `json:"c,string"`
+	}
+	v := struct {
+		HTML     string                 `json:"html"`
+		Attrs    Attributes             `json:"attrs"`
+		Map      map[string]interface{} `json:"map"`
+		Embedded embedded               `json:"embedded"`
+		Inners   [2]*inner              `json:"inners"`
+		Any      interface{}            `json:"any"`
+		Skipped  string                 `json:"-"`
+		Untagged float64
+		hidden   string
+	}{
+		HTML:     "<a> & b",
+		Attrs:    Attributes{{Name: xml.Name{Local: "styleType"}, Value: "OLC"}},
+		Map:      map[string]interface{}{"zedKey": []int{}, "aKey": map[string]string{"x": ""}},
+		Embedded: embedded{inner{A: "a"}, 7},
+		Inners:   [2]*inner{{B: []byte("b")}},
+		Any:      inner{},
+		Untagged: 1.5,
+		hidden:   "h",
+	}
+	for _, opts := range jsonOptionSets {
+		var buf strings.Builder
+		if err := EncodeJSONWithOptions(&buf, v, opts); err != nil {
+			t.Fatalf("failed to encode: %v", err)
+		}
+		if want := rewrittenJSON(t, v, opts); buf.String() != want {
+			t.Errorf("%+v: got %s\nwant %s", opts, buf.String(), want)
+		}
+	}
+
+	// Output reaches the writer before the document is fully encoded.
+	data, err := os.ReadFile(filepath.Join("..", "..", "bill-version-samples-september-2024", "BILLS-114s32cds.xml"))
+	if err != nil {
+		t.Fatalf("failed to read sample bill: %v", err)
+	}
+	bill, err := ParseBill(data)
+	if err != nil {
+		t.Fatalf("failed to parse bill: %v", err)
+	}
+	w := &writeRecorder{}
+	if err := EncodeJSON(w, bill); err != nil {
+		t.Fatalf("failed to encode: %v", err)
+	}
+	if len(w.writes) < 2 || w.writes[0] >= w.total {
+		t.Errorf("expected the output in several writes, got %v", w.writes)
+	}
+}
+
+// jsonOptionSets covers every combination of JSONOptions.
+var jsonOptionSets = func() []JSONOptions {
+	var sets []JSONOptions
+	for i := 0; i < 16; i++ {
+		sets = append(sets, JSONOptions{Naming: FieldNaming(i & 1), SortKeys: i&2 != 0, Indent: i&4 != 0, Canonical: i&8 != 0})
+	}
+	return sets
+}()
+
+// rewrittenJSON encodes v by marshaling it in full and rewriting the result,
+// against which the streaming encoder is checked.
+func rewrittenJSON(t *testing.T, v interface{}, opts JSONOptions) string {
+	t.Helper()
+	data, err := json.Marshal(v)
+	if err != nil {
+		t.Fatalf("failed to marshal: %v", err)
+	}
+	indent := ""
+	if opts.Indent && !opts.Canonical {
+		indent = "  "
+	}
+	r := newJSONRewriter(bytes.NewReader(data), opts.Naming, opts.SortKeys || opts.Canonical, indent)
+	r.canonical = opts.Canonical
+	var out bytes.Buffer
+	if err := r.value(&out, 0); err != nil {
+		t.Fatalf("failed to rewrite: %v", err)
+	}
+	return out.String() + "\n"
+}
+
+// writeRecorder records the size of each write.
+type writeRecorder struct {
+	writes []int
+	total  int
+}
+
+func (w *writeRecorder) Write(p []byte) (int, error) {
+	w.writes = append(w.writes, len(p))
+	w.total += len(p)
+	return len(p), nil
+}
+
+func TestProvisions(t *testing.T) {
+	data, err := os.ReadFile(filepath.Join("..", "..", "bill-version-samples-september-2024", "BILLS-114s32cds.xml"))
+	if err != nil {
+		t.Fatalf("failed to read sample bill: %v", err)
+	}
+	bill, err := ParseBill(data)
+	if err != nil {
+		t.Fatalf("failed to parse bill: %v", err)
+	}
+
+	top := Provisions(bill)
+	if len(top) != len(bill.GetSections()) {
+		t.Fatalf("expected %d top-level provisions, got %d", len(bill.GetSections()), len(top))
+	}
+	s2 := top[1]
+	if s2.Element != "section" || s2.Identifier != "/us/bill/114/s/32/s2" {
+		t.Errorf("unexpected second provision %s %s", s2.Element, s2.Identifier)
+	}
+	if len(s2.Children) != 2 || s2.Children[1].GetNumValue() != "2" || s2.Children[1].Depth != 1 {
+		t.Errorf("unexpected children of section 2")
+	}
+
+	var hrefs []string
+	for _, r := range s2.GetRefs() {
+		hrefs = append(hrefs, r.Href)
+	}
+	if strings.Join(hrefs, " ") != "usc/21/959 /us/usc/t21/s959" {
+		t.Errorf("unexpected refs %v", hrefs)
+	}
+
+	count := 0
+	for _, p := range top {
+		p.Walk(func(*Provision) bool {
+			count++
+			return true
+		})
+	}
+	levels := 0
+	walkDocumentLevels(bill, func(*level) bool {
+		levels++
+		return true
+	})
+	if count != levels {
+		t.Errorf("expected %d provisions, walked %d", levels, count)
+	}
+}
+
+func TestDocumentCache(t *testing.T) {
+	cache := NewDocumentCache(2)
+	a, b, c := &Bill{}, &Bill{}, &Bill{}
+	cache.Add("a", a)
+	cache.Add("b", b)
+	if doc, ok := cache.Get("a"); !ok || doc != a {
+		t.Fatal("expected a to be cached")
+	}
+	cache.Add("c", c) // evicts b, the least recently used
+	if _, ok := cache.Get("b"); ok {
+		t.Error("expected b to be evicted")
+	}
+	if cache.Len() != 2 {
+		t.Errorf("expected 2 cached documents, got %d", cache.Len())
+	}
+	cache.Remove("a")
+	if _, ok := cache.Get("a"); ok {
+		t.Error("expected a to be removed")
+	}
+
+	data, err := os.ReadFile(filepath.Join("..", "..", "bill-version-samples-september-2024", "BILLS-114s32cds.xml"))
+	if err != nil {
+		t.Fatalf("failed to read sample bill: %v", err)
+	}
+	first, err := cache.Parse(data)
+	if err != nil {
+		t.Fatalf("failed to parse: %v", err)
+	}
+	second, err := cache.Parse(data)
+	if err != nil {
+		t.Fatalf("failed to parse: %v", err)
+	}
+	if first != second {
+		t.Error("expected the cached document to be returned on the second parse")
+	}
+	if _, ok := cache.Get(ContentHash(data)); !ok {
+		t.Error("expected document to be cached under its content hash")
+	}
+}
+
+func TestFSStore(t *testing.T) {
+	ctx := context.Background()
+	samples := NewFSStore(os.DirFS(filepath.Join("..", "..", "bill-version-samples-september-2024")))
+
+	ids, err := samples.List(ctx)
+	if err != nil {
+		t.Fatalf("failed to list: %v", err)
+	}
+	if len(ids) == 0 || !strings.HasPrefix(ids[0], "BILLS-") {
+		t.Fatalf("unexpected ids %v", ids)
+	}
+	bill, err := samples.Get(ctx, "BILLS-114s32cds")
+	if err != nil {
+		t.Fatalf("failed to get: %v", err)
+	}
+	if bill.GetDocumentNumber() != "32" {
+		t.Errorf("unexpected document number %s", bill.GetDocumentNumber())
+	}
+	if _, err := samples.Get(ctx, "BILLS-missing"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("expected ErrNotFound, got %v", err)
+	}
+	if _, err := samples.Get(ctx, "../README"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("expected ErrNotFound for path outside the store, got %v", err)
+	}
+	if err := samples.Put(ctx, "x", bill); !errors.Is(err, ErrReadOnly) {
+		t.Errorf("expected ErrReadOnly, got %v", err)
+	}
+
+	dir := NewDirStore(t.TempDir())
+	if err := dir.Put(ctx, "BILLS-114s32cds", bill); err != nil {
+		t.Fatalf("failed to put: %v", err)
+	}
+	ids, err = dir.List(ctx)
+	if err != nil || len(ids) != 1 || ids[0] != "BILLS-114s32cds" {
+		t.Fatalf("unexpected ids %v (%v)", ids, err)
+	}
+	again, err := dir.Get(ctx, "BILLS-114s32cds")
+	if err != nil {
+		t.Fatalf("failed to get: %v", err)
+	}
+	if again.GetTitle() != bill.GetTitle() {
+		t.Errorf("title changed after round trip")
+	}
+
+	ids, err = samples.List(ctx)
+	if err != nil || !slices.Contains(ids, "H1000_IH") {
+		t.Fatalf("expected upper-case H1000_IH.XML to be listed, got %v (%v)", ids, err)
+	}
+	if _, err := samples.Get(ctx, "H1000_IH"); err != nil {
+		t.Errorf("failed to get upper-case file: %v", err)
+	}
+
+	// Put replaces an upper-case file rather than adding a second one.
+	upper := t.TempDir()
+	data, err := MarshalDocumentToXML(bill)
+	if err != nil {
+		t.Fatalf("failed to marshal: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(upper, "S32_CDS.XML"), data, 0o644); err != nil {
+		t.Fatal(err)
+	}
+	store := NewDirStore(upper)
+	if _, err := store.Get(ctx, "S32_CDS"); err != nil {
+		t.Fatalf("failed to get upper-case file: %v", err)
+	}
+	if err := store.Put(ctx, "S32_CDS", bill); err != nil {
+		t.Fatalf("failed to put: %v", err)
+	}
+	entries, err := os.ReadDir(upper)
+	if err != nil || len(entries) != 1 || entries[0].Name() != "S32_CDS.XML" {
+		t.Errorf("expected only S32_CDS.XML after put, got %v (%v)", entries, err)
+	}
+}
+
+func TestDecodeDocumentAndMeta(t *testing.T) {
+	for _, file := range []string{"BILLS-114s32cds.xml", "BILLS-116hr1865eas.xml", "BILLS-116sres100ats.xml"} {
+		data, err := os.ReadFile(filepath.Join("..", "..", "bill-version-samples-september-2024", file))
+		if err != nil {
+			t.Fatalf("failed to read %s: %v", file, err)
+		}
+		want, err := ParseDocument(data)
+		if err != nil {
+			t.Fatalf("failed to parse %s: %v", file, err)
+		}
+
+		doc, err := DecodeDocument(strings.NewReader(string(data)))
+		if err != nil {
+			t.Fatalf("failed to decode %s: %v", file, err)
+		}
+		if fmt.Sprintf("%T", doc) != fmt.Sprintf("%T", want) || doc.GetTitle() != want.GetTitle() {
+			t.Errorf("%s: decoded %T %q, want %T %q", file, doc, doc.GetTitle(), want, want.GetTitle())
+		}
+
+		// Truncating after the metadata block must not matter.
+		cut := strings.Index(string(data), "</meta>")
+		if cut < 0 {
+			cut = strings.Index(string(data), "</amendMeta>")
+		}
+		meta, err := ParseDocumentMeta(strings.NewReader(string(data[:cut+20])))
+		if err != nil {
+			t.Fatalf("failed to parse metadata of %s: %v", file, err)
+		}
+		if meta.GetDocumentNumber() != want.GetDocumentNumber() || meta.GetCongress() != want.GetCongress() {
+			t.Errorf("%s: metadata mismatch", file)
+		}
+	}
+
+	if _, err := DecodeDocument(strings.NewReader("<statute/>")); err == nil {
+		t.Error("expected error for unknown root element")
+	}
+}
+
+func TestFingerprint(t *testing.T) {
+	data, err := os.ReadFile(filepath.Join("..", "..", "bill-version-samples-september-2024", "BILLS-114s32cds.xml"))
+	if err != nil {
+		t.Fatalf("failed to read sample bill: %v", err)
+	}
+	parse := func(s string) LegislativeDocument {
+		doc, err := ParseDocument([]byte(s))
+		if err != nil {
+			t.Fatalf("failed to parse: %v", err)
+		}
+		return doc
+	}
+	original := string(data)
+	want := Fingerprint(parse(original))
+	if len(want) != 64 {
+		t.Fatalf("unexpected fingerprint %q", want)
+	}
+
+	// Processing metadata, element ids, and typography do not matter.
+	reprocessed := strings.Replace(original, "<processedDate>", "<processedDate>1999-01-01 ", 1)
+	reprocessed = strings.Replace(reprocessed, `id="S1"`, `id="idSECTION1"`, 1)
+	reprocessed = strings.Replace(reprocessed, "“Transnational", "\"Transnational", 1)
+	if reprocessed == original {
+		t.Fatal("expected substitutions to change the sample")
+	}
+	if got := Fingerprint(parse(reprocessed)); got != want {
+		t.Error("expected fingerprint to ignore processing metadata, ids, and typography")
+	}
+
+	// Text changes do.
+	amended := strings.Replace(original, "Transnational Drug", "Transnational Narcotics", 1)
+	if got := Fingerprint(parse(amended)); got == want {
+		t.Error("expected fingerprint to change with the text")
+	}
+}
+
+func TestWatch(t *testing.T) {
+	data, err := os.ReadFile(filepath.Join("..", "..", "bill-version-samples-september-2024", "BILLS-114s32cds.xml"))
+	if err != nil {
+		t.Fatalf("failed to read sample bill: %v", err)
+	}
+	dir := t.TempDir()
+	path := filepath.Join(dir, "BILLS-114s32cds.xml")
+	if err := os.WriteFile(path, data, 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "notes.txt"), []byte("ignored"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	events := Watch(ctx, dir, WatchOptions{Interval: 10 * time.Millisecond})
+
+	next := func() WatchEvent {
+		select {
+		case e := <-events:
+			return e
+		case <-time.After(5 * time.Second):
+			t.Fatal("timed out waiting for event")
+		}
+		return WatchEvent{}
+	}
+
+	e := next()
+	if e.Kind != WatchCreated || e.Path != path || e.Err != nil || e.Document.GetDocumentNumber() != "32" {
+		t.Fatalf("unexpected event %+v", e)
+	}
+
+	// Touching the file without changing its content is not reported.
+	later := time.Now().Add(time.Minute)
+	os.Chtimes(path, later, later)
+	modified := strings.Replace(string(data), "Transnational Drug", "Transnational Narcotics", 1)
+	if err := os.WriteFile(path, []byte(modified), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	e = next()
+	if e.Kind != WatchModified || e.Hash != ContentHash([]byte(modified)) {
+		t.Fatalf("unexpected event %+v", e)
+	}
+
+	if err := os.Remove(path); err != nil {
+		t.Fatal(err)
+	}
+	if e = next(); e.Kind != WatchRemoved || e.Path != path {
+		t.Fatalf("unexpected event %+v", e)
+	}
+
+	// Known files are not reported again.
+	cancel()
+	for range events {
+	}
+	if err := os.WriteFile(path, data, 0o644); err != nil {
+		t.Fatal(err)
+	}
+	ctx, cancel = context.WithCancel(context.Background())
+	defer cancel()
+	events = Watch(ctx, dir, WatchOptions{Interval: 10 * time.Millisecond, Known: map[string]string{path: ContentHash(data)}})
+	select {
+	case e := <-events:
+		t.Fatalf("unexpected event for known file %+v", e)
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	// Upper-case extensions are watched too.
+	upper := filepath.Join(dir, "S32_CDS.XML")
+	if err := os.WriteFile(upper, data, 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if e := next(); e.Kind != WatchCreated || e.Path != upper {
+		t.Fatalf("unexpected event %+v", e)
+	}
+}
+
+func TestParseDocumentFromURL(t *testing.T) {
+	data, err := os.ReadFile(filepath.Join("..", "..", "bill-version-samples-september-2024", "BILLS-114s32cds.xml"))
+	if err != nil {
+		t.Fatalf("failed to read sample bill: %v", err)
+	}
+	var gz, zl bytes.Buffer
+	gw := gzip.NewWriter(&gz)
+	gw.Write(data)
+	gw.Close()
+	zw := zlib.NewWriter(&zl)
+	zw.Write(data)
+	zw.Close()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/plain.xml":
+			w.Write(data)
+		case "/encoded.xml":
+			w.Header().Set("Content-Encoding", "gzip")
+			w.Write(gz.Bytes())
+		case "/deflate.xml":
+			w.Header().Set("Content-Encoding", "deflate")
+			w.Write(zl.Bytes())
+		case "/file.xml.gz":
+			w.Header().Set("Content-Type", "application/gzip")
+			w.Write(gz.Bytes())
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer srv.Close()
+
+	for _, path := range []string{"/plain.xml", "/encoded.xml", "/deflate.xml", "/file.xml.gz"} {
+		doc, err := ParseDocumentFromURL(context.Background(), srv.URL+path, srv.Client())
+		if err != nil {
+			t.Errorf("%s: %v", path, err)
+			continue
+		}
+		if doc.GetDocumentNumber() != "32" {
+			t.Errorf("%s: unexpected document number %s", path, doc.GetDocumentNumber())
+		}
+	}
+	if _, err := ParseDocumentFromURL(context.Background(), srv.URL+"/missing.xml", srv.Client()); err == nil {
+		t.Error("expected error for 404")
+	}
+
+	limited := &sizeLimitReader{r: strings.NewReader(string(data)), limit: 100}
+	if _, err := io.ReadAll(limited); err == nil || !strings.Contains(err.Error(), "exceeds 100 bytes") {
+		t.Errorf("expected size limit error, got %v", err)
+	}
+}
+
+func TestGetMeta(t *testing.T) {
+	for file, degree := range map[string]string{
+		"BILLS-114s32cds.xml":    "",
+		"BILLS-116hr1865eas.xml": "first",
+	} {
+		data, err := os.ReadFile(filepath.Join("..", "..", "bill-version-samples-september-2024", file))
+		if err != nil {
+			t.Fatalf("failed to read %s: %v", file, err)
+		}
+		doc, err := ParseDocument(data)
+		if err != nil {
+			t.Fatalf("failed to parse %s: %v", file, err)
+		}
+		meta := doc.GetMeta()
+		if meta == nil {
+			t.Fatalf("%s: expected metadata", file)
+		}
+		if meta.DocNumber != doc.GetDocumentNumber() || meta.Congress != doc.GetCongress() || meta.DocStage != doc.GetStage() {
+			t.Errorf("%s: metadata view disagrees with accessors", file)
+		}
+		if meta.AmendDegree != degree {
+			t.Errorf("%s: expected amendDegree %q, got %q", file, degree, meta.AmendDegree)
+		}
+	}
+
+	if (&Bill{}).GetMeta() != nil {
+		t.Error("expected nil metadata for empty bill")
+	}
+}
+
+func TestParseRaw(t *testing.T) {
+	data, err := os.ReadFile(filepath.Join("..", "..", "bill-version-samples-september-2024", "BILLS-114s32cds.xml"))
+	if err != nil {
+		t.Fatalf("failed to read sample bill: %v", err)
+	}
+	raw, err := ParseRaw(data)
+	if err != nil {
+		t.Fatalf("failed to parse raw: %v", err)
+	}
+
+	root := raw.Root()
+	if root.Name != "bill" || root.Attr("xmlns:dc") != "http://purl.org/dc/elements/1.1/" || root.Attr("lang") != "en" {
+		t.Errorf("unexpected root %s %v", root.Name, root.Attrs)
+	}
+	if title := raw.Find("dc:title"); title == nil || !strings.HasPrefix(title.InnerText(), "114 S 32 CDS") {
+		t.Errorf("expected dc:title element")
+	}
+	if got := len(raw.FindAll("section")); got < 3 {
+		t.Errorf("expected at least 3 sections, got %d", got)
+	}
+
+	// Writing the tree and re-reading it is lossless.
+	again, err := ParseRaw(raw.Bytes())
+	if err != nil {
+		t.Fatalf("failed to re-parse raw output: %v", err)
+	}
+	if string(again.Bytes()) != string(raw.Bytes()) {
+		t.Error("expected raw output to be stable")
+	}
+
+	// Conversion to and from the typed model.
+	doc, err := raw.Document()
+	if err != nil {
+		t.Fatalf("failed to convert to document: %v", err)
+	}
+	want, _ := ParseBill(data)
+	if doc.GetTitle() != want.GetTitle() || len(doc.(*Bill).GetSections()) != len(want.GetSections()) {
+		t.Error("expected typed document to match")
+	}
+	var section Section
+	if err := raw.Find("section").Decode(&section); err != nil {
+		t.Fatalf("failed to decode section: %v", err)
+	}
+	if section.GetNumValue() != "1" {
+		t.Errorf("unexpected section %s", section.GetNumValue())
+	}
+	node, err := NodeFromElement(&section)
+	if err != nil {
+		t.Fatalf("failed to convert section: %v", err)
+	}
+	if node.Name != "section" || node.Find("heading") == nil {
+		t.Errorf("unexpected node %s", node.Name)
+	}
+
+	if _, err := ParseRaw([]byte("<a><b></a>")); err == nil {
+		t.Error("expected error for mismatched tags")
+	}
+}
+
+func TestNamespaceFidelity(t *testing.T) {
+	// rootDeclarations returns the namespace declarations and xsi/xml
+	// attributes of the root element, with prefixes as written.
+	rootDeclarations := func(data []byte) []string {
+		d := xml.NewDecoder(bytes.NewReader(data))
+		for {
+			tok, err := d.RawToken()
+			if err != nil {
+				t.Fatalf("failed to find root element: %v", err)
+			}
+			if start, ok := tok.(xml.StartElement); ok {
+				var attrs []string
+				for _, a := range start.Attr {
+					switch {
+					case a.Name.Space == "xmlns", a.Name.Space == "xsi", a.Name.Space == "xml", a.Name.Local == "xmlns":
+						attrs = append(attrs, a.Name.Space+":"+a.Name.Local+"="+a.Value)
+					}
+				}
+				return attrs
+			}
+		}
+	}
+
+	files, err := filepath.Glob(filepath.Join("..", "..", "bill-version-samples-september-2024", "*.xml"))
+	if err != nil || len(files) == 0 {
+		t.Fatalf("failed to find samples: %v", err)
+	}
+	for _, file := range files {
+		data, err := os.ReadFile(file)
+		if err != nil {
+			t.Fatalf("failed to read %s: %v", file, err)
+		}
+		doc, err := ParseDocument(data)
+		if err != nil {
+			t.Fatalf("failed to parse %s: %v", file, err)
+		}
+		out, err := MarshalDocumentToXML(doc)
+		if err != nil {
+			t.Fatalf("failed to marshal %s: %v", file, err)
+		}
+
+		want, got := rootDeclarations(data), rootDeclarations(out)
+		if strings.Join(got, " ") != strings.Join(want, " ") {
+			t.Errorf("%s: root declarations differ\nwant %v\n got %v", filepath.Base(file), want, got)
+		}
+		if bytes.Contains(out, []byte("_xmlns")) || bytes.Contains(out, []byte(`xmlns="`+NamespaceDC+`"`)) {
+			t.Errorf("%s: output contains generated namespace prefixes", filepath.Base(file))
+		}
+		if !bytes.Contains(out, []byte("<dc:title>")) {
+			t.Errorf("%s: expected dc:title in output", filepath.Base(file))
+		}
+
+		// MarshalIndent adds whitespace to mixed content, so compare a compact
+		// round-trip.
+		compact, err := xml.Marshal(doc)
+		if err != nil {
+			t.Fatalf("failed to marshal %s: %v", file, err)
+		}
+		again, err := ParseDocument(compact)
+		if err != nil {
+			t.Fatalf("failed to re-parse %s: %v", file, err)
+		}
+		before, _ := ToJSON(doc)
+		after, _ := ToJSON(again)
+		if !bytes.Equal(before, after) {
+			t.Errorf("%s: document changed across XML round-trip", filepath.Base(file))
+		}
+	}
+
+	// Documents built in code still declare the prefixes their output uses.
+	out, err := MarshalBillToXML(&Bill{XMLNS: NamespaceUSLM, XSISchemaLocation: "x.xsd", Meta: &Meta{DCTitle: "t"}})
+	if err != nil {
+		t.Fatalf("failed to marshal bill: %v", err)
+	}
+	if _, err := ParseRaw(out); err != nil || !bytes.Contains(out, []byte(`xmlns:dc="`+NamespaceDC+`"`)) || !bytes.Contains(out, []byte(`xmlns:xsi="`+NamespaceXSI+`"`)) {
+		t.Errorf("expected dc and xsi declarations, got %s (%v)", out, err)
+	}
+}
+
+func TestEntityHardening(t *testing.T) {
+	bill := func(doctype, title string) []byte {
+		return []byte(`<?xml version="1.0"?>` + doctype + `<bill xmlns="` + NamespaceUSLM + `" xml:base="https://www.govinfo.gov/link/"><meta><docNumber>` + title + `</docNumber></meta></bill>`)
+	}
+
+	for name, doctype := range map[string]string{
+		"external":  `<!DOCTYPE bill [<!ENTITY xxe SYSTEM "file:///etc/passwd">]>`,
+		"public":    `<!DOCTYPE bill [<!ENTITY xxe PUBLIC "-//X//EN" "http://example.com/x">]>`,
+		"parameter": `<!DOCTYPE bill [<!ENTITY % p "x">]>`,
+		"nested":    `<!DOCTYPE bill [<!ENTITY a "x"><!ENTITY xxe "&a;&a;">]>`,
+		"oversized": `<!DOCTYPE bill [<!ENTITY xxe "` + strings.Repeat("x", MaxEntityValueBytes+1) + `">]>`,
+	} {
+		data := bill(doctype, "&xxe;")
+		if _, err := ParseDocument(data); !errors.Is(err, ErrUnsafeDocument) {
+			t.Errorf("%s: ParseDocument: expected ErrUnsafeDocument, got %v", name, err)
+		}
+		if _, err := DecodeDocument(bytes.NewReader(data)); !errors.Is(err, ErrUnsafeDocument) {
+			t.Errorf("%s: DecodeDocument: expected ErrUnsafeDocument, got %v", name, err)
+		}
+		if _, err := ParseRaw(data); !errors.Is(err, ErrUnsafeDocument) {
+			t.Errorf("%s: ParseRaw: expected ErrUnsafeDocument, got %v", name, err)
+		}
+	}
+
+	var many strings.Builder
+	for i := 0; i <= MaxEntityDeclarations; i++ {
+		fmt.Fprintf(&many, `<!ENTITY e%d "x">`, i)
+	}
+	if _, err := ParseDocument(bill(`<!DOCTYPE bill [`+many.String()+`]>`, "1")); !errors.Is(err, ErrUnsafeDocument) {
+		t.Errorf("expected ErrUnsafeDocument for too many entities, got %v", err)
+	}
+
+	// Undeclared entities are still an error, and internal ones are expanded.
+	if _, err := ParseDocument(bill("", "&xxe;")); err == nil {
+		t.Error("expected error for undeclared entity")
+	}
+	doc, err := ParseDocument(bill(`<!DOCTYPE bill [<!ENTITY num "1865">]>`, "&num;"))
+	if err != nil {
+		t.Fatalf("failed to parse document with internal entity: %v", err)
+	}
+	if doc.GetDocumentNumber() != "1865" {
+		t.Errorf("expected expanded entity, got %q", doc.GetDocumentNumber())
+	}
+
+	b := doc.(*Bill)
+	if b.XMLBase != "https://www.govinfo.gov/link/" {
+		t.Errorf("expected xml:base to be recorded, got %q", b.XMLBase)
+	}
+	if got := ResolveHref(doc, "us/usc/t42/s301"); got != "https://www.govinfo.gov/link/us/usc/t42/s301" {
+		t.Errorf("unexpected resolved href %q", got)
+	}
+	if got := ResolveHref(&Bill{}, "/us/usc/t42/s301"); got != "/us/usc/t42/s301" {
+		t.Errorf("expected href unchanged without xml:base, got %q", got)
+	}
+	out, err := MarshalBillToXML(b)
+	if err != nil {
+		t.Fatalf("failed to marshal bill: %v", err)
+	}
+	if !bytes.Contains(out, []byte(`xml:base="https://www.govinfo.gov/link/"`)) {
+		t.Error("expected xml:base in marshaled output")
+	}
+}
+
+func TestLimits(t *testing.T) {
+	data, err := os.ReadFile(filepath.Join("..", "..", "bill-version-samples-september-2024", "BILLS-114s32cds.xml"))
+	if err != nil {
+		t.Fatalf("failed to read sample bill: %v", err)
+	}
+	if _, err := ParseDocumentWithLimits(data, DefaultLimits); err != nil {
+		t.Fatalf("sample bill should parse under default limits: %v", err)
+	}
+
+	for name, limits := range map[string]Limits{
+		"bytes":  {MaxBytes: int64(len(data)) / 2},
+		"depth":  {MaxDepth: 3},
+		"attr":   {MaxAttrLength: 8},
+		"stream": {MaxBytes: 1024},
+	} {
+		if _, err := ParseDocumentWithLimits(data, limits); !errors.Is(err, ErrLimitExceeded) {
+			t.Errorf("%s: ParseDocumentWithLimits: expected ErrLimitExceeded, got %v", name, err)
+		}
+		if _, err := DecodeDocumentWithLimits(bytes.NewReader(data), limits); !errors.Is(err, ErrLimitExceeded) {
+			t.Errorf("%s: DecodeDocumentWithLimits: expected ErrLimitExceeded, got %v", name, err)
+		}
+	}
+
+	// The stream is abandoned once the byte limit is passed.
+	r := &countingReader{r: bytes.NewReader(data)}
+	if _, err := DecodeDocumentWithLimits(r, Limits{MaxBytes: 1024}); !errors.Is(err, ErrLimitExceeded) {
+		t.Fatalf("expected ErrLimitExceeded, got %v", err)
+	}
+	if r.n > 1024+1 {
+		t.Errorf("read %d bytes past a 1024-byte limit", r.n)
+	}
+
+	deep := strings.Repeat("<p>", 1000) + strings.Repeat("</p>", 1000)
+	if _, err := ParseDocumentWithLimits([]byte(`<bill>`+deep+`</bill>`), DefaultLimits); !errors.Is(err, ErrLimitExceeded) {
+		t.Errorf("expected ErrLimitExceeded for deep nesting, got %v", err)
+	}
+}
+
+// countingReader counts the bytes read through it.
+type countingReader struct {
+	r io.Reader
+	n int
+}
+
+func (c *countingReader) Read(p []byte) (int, error) {
+	n, err := c.r.Read(p)
+	c.n += n
+	return n, err
+}
+
+func TestProvisionPath(t *testing.T) {
+	data, err := os.ReadFile(filepath.Join("..", "..", "bill-version-samples-september-2024", "BILLS-116s1014es.xml"))
+	if err != nil {
+		t.Fatalf("failed to read sample bill: %v", err)
+	}
+	doc, err := ParseDocument(data)
+	if err != nil {
+		t.Fatalf("failed to parse sample bill: %v", err)
+	}
+
+	var checked int
+	for _, top := range Provisions(doc) {
+		if top.Parent != nil {
+			t.Errorf("top-level %s has a parent", top.Element)
+		}
+		top.Walk(func(p *Provision) bool {
+			path := p.Path()
+			if path[len(path)-1] != p || path[0] != top {
+				t.Errorf("%s: path does not run from %s to the provision", p.Identifier, top.Element)
+			}
+			for i := 1; i < len(path); i++ {
+				if path[i].Parent != path[i-1] {
+					t.Errorf("%s: broken parent link at %d", p.Identifier, i)
+				}
+			}
+			if p.Element == "paragraph" && p.Parent.Element == "subsection" && p.Parent.Parent.Element == "section" {
+				want := fmt.Sprintf("Section %s(%s)(%s)", p.Parent.Parent.GetNumValue(), p.Parent.GetNumValue(), p.GetNumValue())
+				if got := p.PathString(); got != want {
+					t.Errorf("expected %q, got %q", want, got)
+				}
+				checked++
+			}
+			return true
+		})
+	}
+	if checked == 0 {
+		t.Error("expected paragraphs within subsections")
+	}
+
+	title := &Provision{Element: "title", Num: &Num{Value: "III"}}
+	section := &Provision{Element: "section", Num: &Num{Value: "301"}, Parent: title}
+	sub := &Provision{Element: "subsection", Num: &Num{Value: "a"}, Parent: section}
+	para := &Provision{Element: "paragraph", Num: &Num{Text: "(2)"}, Parent: sub}
+	if got := para.PathString(); got != "Section 301(a)(2)" {
+		t.Errorf("expected %q, got %q", "Section 301(a)(2)", got)
+	}
+	if got := title.PathString(); got != "Title III" {
+		t.Errorf("expected %q, got %q", "Title III", got)
+	}
+	if got := len(para.Path()); got != 4 {
+		t.Errorf("expected a path of 4, got %d", got)
+	}
+}
+
+func TestIndex(t *testing.T) {
+	data, err := os.ReadFile(filepath.Join("..", "..", "bill-version-samples-september-2024", "BILLS-116s1014es.xml"))
+	if err != nil {
+		t.Fatalf("failed to read sample bill: %v", err)
+	}
+	bill, err := ParseBill(data)
+	if err != nil {
+		t.Fatalf("failed to parse sample bill: %v", err)
+	}
+	idx := Index(bill)
+	if idx.Document() != LegislativeDocument(bill) {
+		t.Error("expected index to report its document")
+	}
+
+	var found bool
+	for i := range bill.Main.Sections {
+		section := &bill.Main.Sections[i]
+		for j := range section.Subsections {
+			sub := &section.Subsections[j]
+			for k := range sub.Paragraphs {
+				para := &sub.Paragraphs[k]
+				found = true
+				if idx.Parent(para) != sub {
+					t.Errorf("expected subsection as parent of paragraph %s", para.Identifier)
+				}
+				if idx.Section(para) != section {
+					t.Errorf("expected enclosing section of paragraph %s", para.Identifier)
+				}
+				if para.Content != nil && idx.Parent(para.Content) != para {
+					t.Errorf("expected paragraph as parent of its content")
+				}
+				if p := idx.Provision(para); p == nil || p.Node != para {
+					t.Errorf("expected provision for paragraph %s", para.Identifier)
+				}
+			}
+		}
+		if idx.Parent(section) != nil || idx.Section(section) != section {
+			t.Errorf("unexpected upward links for section %s", section.Identifier)
+		}
+	}
+	if !found {
+		t.Fatal("expected paragraphs within subsections")
+	}
+	if idx.Parent(&Paragraph{}) != nil || idx.Section(&Paragraph{}) != nil {
+		t.Error("expected nil for elements outside the document")
+	}
+}
+
+func TestFindSections(t *testing.T) {
+	data, err := os.ReadFile(filepath.Join("..", "..", "bill-version-samples-september-2024", "BILLS-116s1014es.xml"))
+	if err != nil {
+		t.Fatalf("failed to read sample bill: %v", err)
+	}
+	bill, err := ParseBill(data)
+	if err != nil {
+		t.Fatalf("failed to parse sample bill: %v", err)
+	}
+
+	all := FindSections(bill, func(Section) bool { return true })
+	if len(all) == 0 {
+		t.Fatal("expected sections")
+	}
+	first := all[0].Section
+	heading := first.Heading.PlainText()
+	if heading == "" {
+		t.Fatal("expected first section to have a heading")
+	}
+
+	matches := FindSections(bill, HeadingContains(strings.ToUpper(heading)))
+	if len(matches) == 0 || matches[0].Section != first {
+		t.Fatalf("expected HeadingContains to find %q", heading)
+	}
+	path := matches[0].Path
+	if len(path) == 0 || path[len(path)-1].Node != first {
+		t.Error("expected match path to end at the section")
+	}
+	if got := path[len(path)-1].PathString(); got != "Section "+first.GetNumValue() {
+		t.Errorf("unexpected citation %q", got)
+	}
+
+	// Text matching reaches into lower levels.
+	var deep string
+	for _, m := range all {
+		if len(m.Section.Subsections) > 0 && m.Section.Subsections[0].Content != nil {
+			deep = m.Section.Subsections[0].Content.PlainText()
+			break
+		}
+	}
+	if deep == "" {
+		t.Fatal("expected a subsection with content")
+	}
+	words := strings.Fields(deep)
+	if len(words) > 4 {
+		words = words[:4]
+	}
+	re := regexp.MustCompile(regexp.QuoteMeta(strings.Join(words, " ")))
+	if len(FindSections(bill, TextMatchesRegexp(re))) == 0 {
+		t.Errorf("expected TextMatchesRegexp to match subsection text %q", re)
+	}
+	if len(FindSections(bill, HeadingContains("no such heading anywhere"))) != 0 {
+		t.Error("expected no matches")
+	}
+}
+
+func TestFindEntities(t *testing.T) {
+	text := `The Secretary of Agriculture (in this section referred to as the “Secretary”) shall, with the Department of Homeland Security (DHS) and the Environmental Protection Agency, administer the Supplemental Nutrition Assistance Program (SNAP) under clause (II).`
+	want := []EntityMention{
+		{Kind: EntityAcronym, Text: "Secretary", Expansion: "Secretary of Agriculture"},
+		{Kind: EntityAgency, Text: "Department of Homeland Security"},
+		{Kind: EntityAcronym, Text: "DHS", Expansion: "Department of Homeland Security"},
+		{Kind: EntityAgency, Text: "Environmental Protection Agency"},
+		{Kind: EntityProgram, Text: "Supplemental Nutrition Assistance Program"},
+		{Kind: EntityAcronym, Text: "SNAP", Expansion: "Supplemental Nutrition Assistance Program"},
+	}
+	got := FindEntities(text)
+	if len(got) != len(want) {
+		t.Fatalf("expected %d entities, got %d: %+v", len(want), len(got), got)
+	}
+	for i, m := range got {
+		if m.Kind != want[i].Kind || m.Text != want[i].Text || m.Expansion != want[i].Expansion {
+			t.Errorf("entity %d: expected %+v, got %+v", i, want[i], m)
+		}
+		if text[m.Offset:m.Offset+len(m.Text)] != m.Text {
+			t.Errorf("entity %d: offset %d does not locate %q", i, m.Offset, m.Text)
+		}
+	}
+
+	data, err := os.ReadFile(filepath.Join("..", "..", "bill-version-samples-september-2024", "BILLS-116s1014es.xml"))
+	if err != nil {
+		t.Fatalf("failed to read sample bill: %v", err)
+	}
+	doc, err := ParseDocument(data)
+	if err != nil {
+		t.Fatalf("failed to parse sample bill: %v", err)
+	}
+	mentions := ExtractEntities(doc)
+	if len(mentions) == 0 {
+		t.Fatal("expected entities in sample bill")
+	}
+	for _, m := range mentions {
+		if m.Element == "" || m.Text == "" {
+			t.Errorf("expected located mention, got %+v", m)
+		}
+	}
+}
+
+func TestBuildReferenceGraph(t *testing.T) {
+	data, err := os.ReadFile(filepath.Join("..", "..", "bill-version-samples-september-2024", "S1900_RS.xml"))
+	if err != nil {
+		t.Fatalf("failed to read sample bill: %v", err)
+	}
+	doc, err := ParseDocument(data)
+	if err != nil {
+		t.Fatalf("failed to parse sample bill: %v", err)
+	}
+	g := BuildReferenceGraph(doc)
+	if len(g.Edges) == 0 {
+		t.Fatal("expected references in sample bill")
+	}
+	nodes := make(map[string]GraphNode)
+	for _, n := range g.Nodes {
+		nodes[n.ID] = n
+	}
+	for _, e := range g.Edges {
+		if nodes[e.From].Kind != GraphNodeProvision {
+			t.Errorf("expected edge from a provision, got %q", e.From)
+		}
+		if _, ok := nodes[e.To]; !ok {
+			t.Errorf("edge target %q is not a node", e.To)
+		}
+	}
+	if n := nodes["/us/usc/t6/s279/g/2"]; n.Kind != GraphNodeUSC || n.Label != "6 U.S.C. 279" {
+		t.Errorf("expected U.S. Code node labeled 6 U.S.C. 279, got %+v", n)
+	}
+
+	for href, want := range map[string]string{
+		"usc/26/4192":         "/us/usc/t26/s4192",
+		"usc-chapter/26/32":   "/us/usc/t26/ch32",
+		"/us/usc/t44":         "/us/usc/t44",
+		"/us/bill/116/s/1/s2": "",
+	} {
+		if got := normalizeUSCHref(href); got != want {
+			t.Errorf("normalizeUSCHref(%q): expected %q, got %q", href, want, got)
+		}
+	}
+
+	var dot bytes.Buffer
+	if err := g.WriteDOT(&dot); err != nil {
+		t.Fatalf("failed to write DOT: %v", err)
+	}
+	if !strings.HasPrefix(dot.String(), "digraph references {") || !strings.Contains(dot.String(), `-> "/us/usc/t6/s279/g/2"`) {
+		t.Errorf("unexpected DOT output:\n%s", dot.String())
+	}
+
+	var graphml bytes.Buffer
+	if err := g.WriteGraphML(&graphml); err != nil {
+		t.Fatalf("failed to write GraphML: %v", err)
+	}
+	var parsed struct {
+		Nodes []struct{} `xml:"graph>node"`
+		Edges []struct{} `xml:"graph>edge"`
+	}
+	if err := xml.Unmarshal(graphml.Bytes(), &parsed); err != nil {
+		t.Fatalf("failed to parse GraphML: %v", err)
+	}
+	if len(parsed.Nodes) != len(g.Nodes) || len(parsed.Edges) != len(g.Edges) {
+		t.Errorf("expected %d nodes and %d edges in GraphML, got %d and %d", len(g.Nodes), len(g.Edges), len(parsed.Nodes), len(parsed.Edges))
+	}
+}
+
+func TestJSONPatch(t *testing.T) {
+	oldJSON := []byte(`{"a/b": 1, "keep": [1, 2, 3], "list": [{"n": "1"}, {"n": "2"}, {"n": "4"}], "gone": null}`)
+	newJSON := []byte(`{"a/b": 2, "keep": [1, 2, 3], "list": [{"n": "1"}, {"n": "2"}, {"n": "3"}, {"n": "4"}], "new": false}`)
+	ops, err := DiffJSON(oldJSON, newJSON)
+	if err != nil {
+		t.Fatalf("failed to diff JSON: %v", err)
+	}
+	want := []PatchOperation{
+		{Op: "replace", Path: "/a~1b", Value: json.RawMessage(`2`)},
+		{Op: "remove", Path: "/gone"},
+		{Op: "add", Path: "/list/2", Value: json.RawMessage(`{"n":"3"}`)},
+		{Op: "add", Path: "/new", Value: json.RawMessage(`false`)},
+	}
+	got, _ := json.Marshal(ops)
+	expected, _ := json.Marshal(want)
+	if !bytes.Equal(got, expected) {
+		t.Errorf("expected patch %s, got %s", expected, got)
+	}
+
+	data, err := os.ReadFile(filepath.Join("..", "..", "bill-version-samples-september-2024", "BILLS-116s1014es.xml"))
+	if err != nil {
+		t.Fatalf("failed to read sample bill: %v", err)
+	}
+	oldDoc, err := ParseBill(data)
+	if err != nil {
+		t.Fatalf("failed to parse sample bill: %v", err)
+	}
+	newDoc, err := ParseBill(data)
+	if err != nil {
+		t.Fatalf("failed to parse sample bill: %v", err)
+	}
+	if ops, err := JSONPatch(oldDoc, newDoc); err != nil || len(ops) != 0 {
+		t.Errorf("expected empty patch for identical documents, got %v, %v", ops, err)
+	}
+	newDoc.Meta.DCTitle = "Changed title"
+	ops, err = JSONPatch(oldDoc, newDoc)
+	if err != nil {
+		t.Fatalf("failed to build patch: %v", err)
+	}
+	if len(ops) != 1 || ops[0].Op != "replace" || string(ops[0].Value) != `"Changed title"` {
+		t.Errorf("expected a single title replacement, got %+v", ops)
+	}
+}
+
+func TestDiffProvisions(t *testing.T) {
+	data, err := os.ReadFile(filepath.Join("..", "..", "bill-version-samples-september-2024", "BILLS-114s32cds.xml"))
+	if err != nil {
+		t.Fatalf("failed to read sample bill: %v", err)
+	}
+	bill := string(data)
+	parse := func(xml string) LegislativeDocument {
+		t.Helper()
+		doc, err := ParseDocument([]byte(xml))
+		if err != nil {
+			t.Fatalf("failed to parse: %v", err)
+		}
+		return doc
+	}
+	type change struct {
+		kind             ProvisionChangeKind
+		identifier       string
+		oldText, newText string
+	}
+	diff := func(newXML string, opts DiffOptions) []change {
+		t.Helper()
+		got := []change{}
+		for _, c := range DiffProvisions(parse(bill), parse(newXML), opts) {
+			if (c.Old == nil) != (c.Kind == ProvisionAdded) || (c.New == nil) != (c.Kind == ProvisionRemoved) {
+				t.Errorf("%s %s: Old %v, New %v", c.Kind, c.Identifier, c.Old, c.New)
+			}
+			got = append(got, change{c.Kind, c.Identifier, c.OldText, c.NewText})
+		}
+		return got
+	}
+	structure := DiffOptions{Structure: true}
+
+	if got := diff(bill, DiffOptions{}); len(got) != 0 {
+		t.Errorf("expected no changes between identical documents, got %+v", got)
+	}
+
+	// A text edit is a modification, which the structural diff ignores.
+	edited := strings.Replace(bill, "Transnational Drug Trafficking Act", "Transnational Narcotics Trafficking Act", 1)
+	if got := diff(edited, DiffOptions{}); len(got) != 1 || got[0].kind != ProvisionModified || got[0].identifier != "/us/bill/114/s/32/s1" || !strings.Contains(got[0].newText, "Narcotics") {
+		t.Errorf("unexpected changes for a text edit: %+v", got)
+	}
+	if got := diff(edited, structure); len(got) != 0 {
+		t.Errorf("expected no structural changes for a text edit, got %+v", got)
+	}
+
+	// A new number alone is a renumbering in either mode.
+	renumbered := strings.Replace(bill, `<num value="3">SEC. 3. </num>`, `<num value="4">SEC. 4. </num>`, 1)
+	for _, opts := range []DiffOptions{{}, structure} {
+		want := []change{{ProvisionRenumbered, "/us/bill/114/s/32/s3", "SEC. 3.", "SEC. 4."}}
+		if got := diff(renumbered, opts); !reflect.DeepEqual(got, want) {
+			t.Errorf("%+v: unexpected changes for a renumbering:\n got %+v\nwant %+v", opts, got, want)
+		}
+	}
+
+	// A new heading is a modification, described structurally by number
+	// and heading.
+	reheaded := strings.Replace(renumbered, "TRAFFICKING IN COUNTERFEIT GOODS OR SERVICES.", "COUNTERFEIT GOODS.", 1)
+	want := []change{{ProvisionModified, "/us/bill/114/s/32/s3", "SEC. 3. TRAFFICKING IN COUNTERFEIT GOODS OR SERVICES.", "SEC. 4. COUNTERFEIT GOODS."}}
+	if got := diff(reheaded, structure); !reflect.DeepEqual(got, want) {
+		t.Errorf("unexpected changes for a new heading:\n got %+v\nwant %+v", got, want)
+	}
+
+	// Provisions are added and removed by identifier.
+	moved := strings.Replace(bill, `identifier="/us/bill/114/s/32/s1"`, `identifier="/us/bill/114/s/32/s1a"`, 1)
+	want = []change{
+		{ProvisionAdded, "/us/bill/114/s/32/s1a", "", "SECTION 1. SHORT TITLE."},
+		{ProvisionRemoved, "/us/bill/114/s/32/s1", "SECTION 1. SHORT TITLE.", ""},
+	}
+	if got := diff(moved, structure); !reflect.DeepEqual(got, want) {
+		t.Errorf("unexpected changes for a new identifier:\n got %+v\nwant %+v", got, want)
+	}
+}
+
+func TestCanonicalize(t *testing.T) {
+	tree, err := ParseRaw([]byte(`<a xmlns="urn:u" xmlns:x="urn:v" xml:lang="en"><x:b x:c="2" attr='1'><c/><!-- note --></x:b></a>`))
+	if err != nil {
+		t.Fatalf("failed to parse: %v", err)
+	}
+	root := tree.Root()
+	b := root.Elements()[0]
+	for _, tc := range []struct {
+		method string
+		want   string
+	}{
+		{C14NMethod, `<x:b xmlns="urn:u" xmlns:x="urn:v" attr="1" xml:lang="en" x:c="2"><c></c></x:b>`},
+		{C14NWithCommentsMethod, `<x:b xmlns="urn:u" xmlns:x="urn:v" attr="1" xml:lang="en" x:c="2"><c></c><!-- note --></x:b>`},
+		{ExcC14NMethod, `<x:b xmlns:x="urn:v" attr="1" x:c="2"><c xmlns="urn:u"></c></x:b>`},
+	} {
+		c, err := newCanonicalizer(tc.method, "")
+		if err != nil {
+			t.Fatalf("failed to create canonicalizer: %v", err)
+		}
+		if got := string(c.canonicalize(b, []*Node{root})); got != tc.want {
+			t.Errorf("%s: expected %s, got %s", tc.method, tc.want, got)
+		}
+	}
+}
+
+func TestVerifySignature(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("failed to generate key: %v", err)
+	}
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "Test Publisher"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("failed to create certificate: %v", err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatalf("failed to parse certificate: %v", err)
+	}
+	roots := x509.NewCertPool()
+	roots.AddCert(cert)
+
+	// The document and SignedInfo are written in canonical form, so their
+	// digests can be computed directly from the text.
+	body := `<bill xmlns="http://schemas.gpo.gov/xml/uslm" xmlns:dc="http://purl.org/dc/elements/1.1/"><meta><dc:title>A bill to test signatures.</dc:title></meta><main><section identifier="/us/bill/118/hr/1/s1"><num value="1">SECTION 1. </num><content>Text &amp; more.</content></section></main>%s</bill>`
+	digest := sha256.Sum256([]byte(fmt.Sprintf(body, "")))
+	signedInfo := `<ds:SignedInfo xmlns:ds="http://www.w3.org/2000/09/xmldsig#">` +
+		`<ds:CanonicalizationMethod Algorithm="http://www.w3.org/2001/10/xml-exc-c14n#"></ds:CanonicalizationMethod>` +
+		`<ds:SignatureMethod Algorithm="http://www.w3.org/2001/04/xmldsig-more#rsa-sha256"></ds:SignatureMethod>` +
+		`<ds:Reference URI=""><ds:Transforms>` +
+		`<ds:Transform Algorithm="http://www.w3.org/2000/09/xmldsig#enveloped-signature"></ds:Transform>` +
+		`<ds:Transform Algorithm="http://www.w3.org/TR/2001/REC-xml-c14n-20010315"></ds:Transform>` +
+		`</ds:Transforms><ds:DigestMethod Algorithm="http://www.w3.org/2001/04/xmlenc#sha256"></ds:DigestMethod>` +
+		`<ds:DigestValue>` + base64.StdEncoding.EncodeToString(digest[:]) + `</ds:DigestValue></ds:Reference></ds:SignedInfo>`
+	hashed := sha256.Sum256([]byte(signedInfo))
+	value, err := rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, hashed[:])
+	if err != nil {
+		t.Fatalf("failed to sign: %v", err)
+	}
+	encodedCert := base64.StdEncoding.EncodeToString(der)
+	signature := `<ds:Signature xmlns:ds="http://www.w3.org/2000/09/xmldsig#">` +
+		strings.Replace(signedInfo, ` xmlns:ds="http://www.w3.org/2000/09/xmldsig#"`, "", 1) +
+		`<ds:SignatureValue>` + base64.StdEncoding.EncodeToString(value) + `</ds:SignatureValue>` +
+		`<ds:KeyInfo><ds:X509Data><ds:X509Certificate>` + encodedCert[:64] + "\n" + encodedCert[64:] +
+		`</ds:X509Certificate></ds:X509Data></ds:KeyInfo></ds:Signature>`
+	data := []byte(`<?xml version="1.0" encoding="UTF-8"?>` + "\n" + fmt.Sprintf(body, signature))
+
+	signer, err := VerifySignature(data, roots)
+	if err != nil {
+		t.Fatalf("failed to verify signature: %v", err)
+	}
+	if signer.Subject.CommonName != "Test Publisher" {
+		t.Errorf("expected signer Test Publisher, got %q", signer.Subject.CommonName)
+	}
+
+	bill, err := ParseBill(data)
+	if err != nil {
+		t.Fatalf("failed to parse signed bill: %v", err)
+	}
+	sig := bill.DigitalSignature
+	if sig == nil || len(sig.SignedInfo.References) != 1 || sig.SignedInfo.References[0].DigestMethod.Algorithm != SHA256Digest {
+		t.Fatalf("expected parsed signature with one SHA-256 reference, got %+v", sig)
+	}
+	if sig.KeyInfo == nil || len(sig.KeyInfo.X509Certificates) != 1 {
+		t.Errorf("expected one certificate in KeyInfo, got %+v", sig.KeyInfo)
+	}
+
+	tampered := bytes.Replace(data, []byte("Text &amp; more."), []byte("Text &amp; less."), 1)
+	if _, err := VerifySignature(tampered, roots); !errors.Is(err, ErrInvalidSignature) {
+		t.Errorf("expected ErrInvalidSignature for tampered document, got %v", err)
+	}
+	if _, err := VerifySignature(data, x509.NewCertPool()); !errors.Is(err, ErrInvalidSignature) {
+		t.Errorf("expected ErrInvalidSignature for untrusted signer, got %v", err)
+	}
+	if _, err := VerifySignature([]byte(fmt.Sprintf(body, "")), roots); !errors.Is(err, ErrNoSignature) {
+		t.Errorf("expected ErrNoSignature for unsigned document, got %v", err)
+	}
+}
+
+func TestReadHeader(t *testing.T) {
+	files, err := filepath.Glob(filepath.Join("..", "..", "bill-version-samples-september-2024", "*.xml"))
+	if err != nil || len(files) == 0 {
+		t.Fatalf("failed to find sample documents: %v", err)
+	}
+	for _, file := range files {
+		data, err := os.ReadFile(file)
+		if err != nil {
+			t.Fatalf("failed to read %s: %v", file, err)
+		}
+		want, err := ParseDocument(data)
+		if err != nil {
+			t.Fatalf("failed to parse %s: %v", file, err)
+		}
+		r := &countingReader{r: bytes.NewReader(data)}
+		got, err := ReadHeader(r)
+		if err != nil {
+			t.Fatalf("failed to read header of %s: %v", file, err)
+		}
+		if got.GetDocumentNumber() != want.GetDocumentNumber() || got.GetStage() != want.GetStage() {
+			t.Errorf("%s: metadata mismatch", file)
+		}
+		if a, ok := want.(ActionDocument); ok {
+			if len(got.(ActionDocument).GetActions()) != len(a.GetActions()) {
+				t.Errorf("%s: expected %d actions, got %d", file, len(a.GetActions()), len(got.(ActionDocument).GetActions()))
+			}
+		}
+		if s, ok := want.(SponsoredDocument); ok {
+			if len(got.(SponsoredDocument).GetSponsors()) != len(s.GetSponsors()) {
+				t.Errorf("%s: sponsor mismatch", file)
+			}
+		}
+		if c, ok := want.(CommitteeDocument); ok {
+			if len(got.(CommitteeDocument).GetCommittees()) != len(c.GetCommittees()) {
+				t.Errorf("%s: committee mismatch", file)
+			}
+		}
+		if h, ok := got.(HierarchicalDocument); ok && len(h.GetSections()) != 0 {
+			t.Errorf("%s: expected no body from ReadHeader", file)
+		}
+		if len(data) > 1<<20 && r.n >= len(data) {
+			t.Errorf("%s: read all %d bytes", file, len(data))
+		}
+	}
+}
+
+// recordingObserver records parser events for TestParserObserver.
+type recordingObserver struct {
+	mu       sync.Mutex
+	elements map[string]int
+	phases   []ParsePhase
+	parses   []ParseStats
+	errs     []error
+}
+
+func (o *recordingObserver) ElementDecoded(name string) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.elements[name]++
+}
+
+func (o *recordingObserver) PhaseCompleted(operation string, phase ParsePhase, elapsed time.Duration) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.phases = append(o.phases, phase)
+}
+
+func (o *recordingObserver) ParseCompleted(stats ParseStats, err error) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.parses = append(o.parses, stats)
+	o.errs = append(o.errs, err)
+}
+
+func TestParserObserver(t *testing.T) {
+	data, err := os.ReadFile(filepath.Join("..", "..", "bill-version-samples-september-2024", "BILLS-114s32cds.xml"))
+	if err != nil {
+		t.Fatalf("failed to read sample bill: %v", err)
+	}
+	observe := func() *recordingObserver {
+		o := &recordingObserver{elements: make(map[string]int)}
+		SetParserObserver(o)
+		return o
+	}
+	defer SetParserObserver(nil)
+
+	o := observe()
+	if _, err := ParseDocument(data); err != nil {
+		t.Fatalf("failed to parse sample bill: %v", err)
+	}
+	if len(o.parses) != 1 || o.errs[0] != nil {
+		t.Fatalf("expected one successful parse, got %+v, %v", o.parses, o.errs)
+	}
+	stats := o.parses[0]
+	if stats.Operation != "ParseBill" || stats.Bytes != int64(len(data)) {
+		t.Errorf("expected ParseBill reading %d bytes, got %+v", len(data), stats)
+	}
+	total := 0
+	for _, n := range o.elements {
+		total += n
+	}
+	if int64(total) != stats.Elements || o.elements["bill"] != 1 || o.elements["section"] == 0 {
+		t.Errorf("unexpected element counts %v for %d elements", o.elements, stats.Elements)
+	}
+
+	o = observe()
+	if _, err := DecodeDocument(bytes.NewReader(data)); err != nil {
+		t.Fatalf("failed to decode sample bill: %v", err)
+	}
+	if len(o.phases) != 2 || o.phases[0] != PhaseProlog || o.phases[1] != PhaseDecode {
+		t.Errorf("expected prolog and decode phases, got %v", o.phases)
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write(data)
+	}))
+	defer server.Close()
+	o = observe()
+	if _, err := ParseDocumentFromURL(context.Background(), server.URL, nil); err != nil {
+		t.Fatalf("failed to fetch sample bill: %v", err)
+	}
+	if len(o.phases) != 3 || o.phases[0] != PhaseFetch || o.parses[0].Operation != "ParseDocumentFromURL" {
+		t.Errorf("expected a fetch phase for ParseDocumentFromURL, got %v, %+v", o.phases, o.parses)
+	}
+
+	o = observe()
+	if _, err := ReadHeader(strings.NewReader("<bill><meta>")); err == nil {
+		t.Fatal("expected error for truncated document")
+	}
+	if len(o.errs) != 1 || o.errs[0] == nil || o.parses[0].Operation != "ReadHeader" {
+		t.Errorf("expected failed ReadHeader to be reported, got %+v, %v", o.parses, o.errs)
+	}
+
+	SetParserObserver(nil)
+	if _, err := ParseDocument(data); err != nil {
+		t.Fatalf("failed to parse sample bill: %v", err)
+	}
+	if len(o.parses) != 1 {
+		t.Errorf("expected no events after removing the observer, got %d parses", len(o.parses))
+	}
+}
+
+func TestParseOptionsLogger(t *testing.T) {
+	const doc = `<?xml version="1.0" encoding="UTF-8"?>
+<bill xmlns="http://schemas.gpo.gov/xml/uslm" xmlns:dc="http://purl.org/dc/elements/1.1/">
+<meta><dc:title>A bill</dc:title><dc:type>Senate Bill</dc:type><congress>118</congress></meta>
+<main>
+<section identifier="/us/bill/118/s/1/s1"><num>SEC. 1.</num><heading>Short title</heading>
+<widget><part>nested</part></widget>
+<content>This Act may be cited as the Example Act.</content>
+</section>
+</main>
+</bill>`
+
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buf, nil))
+	if _, err := ParseDocumentWithOptions([]byte(doc), ParseOptions{Logger: logger}); err != nil {
+		t.Fatalf("failed to parse: %v", err)
+	}
+
+	var records []map[string]interface{}
+	for _, line := range strings.Split(strings.TrimSpace(buf.String()), "\n") {
+		var r map[string]interface{}
+		if err := json.Unmarshal([]byte(line), &r); err != nil {
+			t.Fatalf("failed to decode log record %q: %v", line, err)
+		}
+		records = append(records, r)
+	}
+	want := []struct{ msg, element string }{
+		{"unknown element ignored", "widget"},
+		{"required field missing", "docNumber"},
+		{"required field missing", "section"},
+	}
+	if len(records) != len(want) {
+		t.Fatalf("expected %d log records, got %d:\n%s", len(want), len(records), buf.String())
+	}
+	for i, w := range want {
+		if records[i]["msg"] != w.msg || records[i]["element"] != w.element {
+			t.Errorf("record %d: expected %q for %s, got %v", i, w.msg, w.element, records[i])
+		}
+	}
+	if records[0]["path"] != "bill/main/section/widget" || records[0]["line"] != float64(6) {
+		t.Errorf("unexpected location of unknown element: %v", records[0])
+	}
+
+	// A well-formed sample logs nothing, and a parse without a logger is
+	// unchanged.
+	data, err := os.ReadFile(filepath.Join("..", "..", "bill-version-samples-september-2024", "BILLS-116hr1865eas.xml"))
+	if err != nil {
+		t.Fatalf("failed to read sample: %v", err)
+	}
+	buf.Reset()
+	if _, err := DecodeDocumentWithOptions(bytes.NewReader(data), ParseOptions{Limits: DefaultLimits, Logger: logger}); err != nil {
+		t.Fatalf("failed to decode sample: %v", err)
+	}
+	if buf.Len() != 0 {
+		t.Errorf("expected no anomalies in sample, got:\n%s", buf.String())
+	}
+}
+
+type recordedSpan struct {
+	name   string
+	parent string
+	attrs  map[string]interface{}
+	err    error
+	ended  bool
+}
+
+type spanKey struct{}
+
+type recordingTracer struct {
+	mu    sync.Mutex
+	spans []*recordedSpan
+}
+
+func (t *recordingTracer) Start(ctx context.Context, name string, attrs ...SpanAttribute) (context.Context, Span) {
+	s := &recordedSpan{name: name, attrs: map[string]interface{}{}}
+	if parent, ok := ctx.Value(spanKey{}).(*recordedSpan); ok {
+		s.parent = parent.name
+	}
+	s.SetAttributes(attrs...)
+	t.mu.Lock()
+	t.spans = append(t.spans, s)
+	t.mu.Unlock()
+	return context.WithValue(ctx, spanKey{}, s), s
+}
+
+func (s *recordedSpan) SetAttributes(attrs ...SpanAttribute) {
+	for _, a := range attrs {
+		s.attrs[a.Key] = a.Value
+	}
+}
+
+func (s *recordedSpan) RecordError(err error) { s.err = err }
+func (s *recordedSpan) End()                  { s.ended = true }
+
+func TestTracing(t *testing.T) {
+	data, err := os.ReadFile(filepath.Join("..", "..", "bill-version-samples-september-2024", "BILLS-114s32cds.xml"))
+	if err != nil {
+		t.Fatalf("failed to read sample: %v", err)
+	}
+
+	// Without a tracer, StartSpan is a no-op.
+	ctx := context.Background()
+	if got, span := StartSpan(ctx, "untraced"); got != ctx || span == nil {
+		t.Errorf("expected unchanged context and a no-op span")
+	}
+
+	tracer := &recordingTracer{}
+	SetTracer(tracer)
+	defer SetTracer(nil)
+
+	ctx, parent := StartSpan(context.Background(), "ingest")
+	if _, err := ParseDocumentWithOptions(data, ParseOptions{Context: ctx}); err != nil {
+		t.Fatalf("failed to parse: %v", err)
+	}
+	if _, err := DecodeDocumentWithOptions(bytes.NewReader(data), ParseOptions{}); err != nil {
+		t.Fatalf("failed to decode: %v", err)
+	}
+	if _, err := ParseDocumentWithOptions([]byte("<bill>"), ParseOptions{}); err == nil {
+		t.Fatal("expected an error for a truncated document")
+	}
+	parent.End()
+
+	if len(tracer.spans) != 4 {
+		t.Fatalf("expected 4 spans, got %d", len(tracer.spans))
+	}
+	parsed, decoded, failed := tracer.spans[1], tracer.spans[2], tracer.spans[3]
+	if parsed.name != "uslm.ParseDocument" || parsed.parent != "ingest" {
+		t.Errorf("unexpected parse span %+v", parsed)
+	}
+	if decoded.name != "uslm.DecodeDocument" || decoded.parent != "" {
+		t.Errorf("unexpected decode span %+v", decoded)
+	}
+	for _, s := range []*recordedSpan{parsed, decoded} {
+		if !s.ended || s.err != nil {
+			t.Errorf("%s: expected a successful, ended span", s.name)
+		}
+		if s.attrs[AttrDocumentSize] != int64(len(data)) || s.attrs[AttrDocumentType] != "Senate Bill" {
+			t.Errorf("%s: unexpected attributes %v", s.name, s.attrs)
+		}
+	}
+	if !failed.ended || failed.err == nil {
+		t.Errorf("expected the failed parse to record its error")
+	}
+	if _, ok := failed.attrs[AttrDocumentType]; ok {
+		t.Errorf("expected no document type on a failed parse")
+	}
+}
+
+func TestEstimateSize(t *testing.T) {
+	if est := EstimateSize(nil); est != (SizeEstimate{}) {
+		t.Errorf("expected zero estimate for nil, got %+v", est)
+	}
+
+	data, err := os.ReadFile(filepath.Join("..", "..", "bill-version-samples-september-2024", "BILLS-116s1014es.xml"))
+	if err != nil {
+		t.Fatalf("failed to read sample: %v", err)
+	}
+	doc, err := ParseDocument(data)
+	if err != nil {
+		t.Fatalf("failed to parse: %v", err)
+	}
+	est := EstimateSize(doc)
+
+	levels := 0
+	for _, p := range Provisions(doc) {
+		p.Walk(func(*Provision) bool {
+			levels++
+			return true
+		})
+	}
+	if est.Levels != levels {
+		t.Errorf("expected %d levels, got %d", levels, est.Levels)
+	}
+	if est.Elements <= est.Levels {
+		t.Errorf("expected more elements than levels, got %+v", est)
+	}
+	// The text of the document is held in memory, plus the structs around it.
+	if est.StringBytes < int64(len(data))/2 || est.Bytes <= est.StringBytes {
+		t.Errorf("implausible byte counts for a %d-byte document: %+v", len(data), est)
+	}
+
+	// Growing the document grows the estimate.
+	bill := doc.(*Bill)
+	bill.Meta.DCTitle += strings.Repeat("x", 1000)
+	if grown := EstimateSize(bill); grown.Bytes != est.Bytes+1000 || grown.StringBytes != est.StringBytes+1000 {
+		t.Errorf("expected estimate to grow by 1000 bytes, got %+v from %+v", grown, est)
+	}
+}
+
+func TestExportDir(t *testing.T) {
+	src, dst := t.TempDir(), t.TempDir()
+	for _, f := range []string{"BILLS-114s32cds.xml", "BILLS-116s1014es.xml", "H1000_IH.XML"} {
+		data, err := os.ReadFile(filepath.Join("..", "..", "bill-version-samples-september-2024", f))
+		if err != nil {
+			t.Fatalf("failed to read sample: %v", err)
+		}
+		if err := os.WriteFile(filepath.Join(src, f), data, 0o644); err != nil {
+			t.Fatalf("failed to write sample: %v", err)
+		}
+	}
+	if err := os.WriteFile(filepath.Join(src, "broken.xml"), []byte("<bill><main>"), 0o644); err != nil {
+		t.Fatalf("failed to write broken file: %v", err)
+	}
+
+	var progress []ExportProgress
+	manifest, err := ExportDir(context.Background(), src, dst, ExportOptions{
+		Formats:  []ExportFormat{ExportJSON, ExportMarkdown, ExportHTML},
+		Workers:  2,
+		Progress: func(p ExportProgress) { progress = append(progress, p) },
+	})
+	if err != nil {
+		t.Fatalf("failed to export: %v", err)
+	}
+	if manifest.Succeeded != 3 || manifest.Failed != 1 || len(manifest.Files) != 4 {
+		t.Fatalf("unexpected manifest counts: %+v", manifest)
+	}
+	if len(progress) != 4 || progress[3].Done != 4 || progress[3].Total != 4 {
+		t.Errorf("unexpected progress reports: %+v", progress)
+	}
+	if f := manifest.Files[2]; f.Source != "H1000_IH.XML" || f.Error != "" {
+		t.Errorf("expected upper-case H1000_IH.XML to be exported, got %+v", f)
+	}
+	if f := manifest.Files[3]; f.Source != "broken.xml" || f.Error == "" || len(f.Outputs) != 0 {
+		t.Errorf("expected broken.xml to fail, got %+v", f)
+	}
+
+	first := manifest.Files[0]
+	wantOutputs := []string{"BILLS-114s32cds.json", "BILLS-114s32cds.md", "BILLS-114s32cds.html"}
+	if first.Source != "BILLS-114s32cds.xml" || strings.Join(first.Outputs, ",") != strings.Join(wantOutputs, ",") {
+		t.Errorf("unexpected result %+v", first)
+	}
+	md, err := os.ReadFile(filepath.Join(dst, "BILLS-114s32cds.md"))
+	if err != nil || !strings.Contains(string(md), "### SEC. 2. POSSESSION") {
+		t.Errorf("expected section heading in Markdown output (err %v)", err)
+	}
+	page, err := os.ReadFile(filepath.Join(dst, "BILLS-114s32cds.html"))
+	if err != nil || !strings.Contains(string(page), `data-identifier="/us/bill/114/s/32/s2/1"`) {
+		t.Errorf("expected paragraph section in HTML output (err %v)", err)
+	}
+	var doc Bill
+	if data, err := os.ReadFile(filepath.Join(dst, "BILLS-114s32cds.json")); err != nil || json.Unmarshal(data, &doc) != nil {
+		t.Errorf("expected JSON output to decode (err %v)", err)
+	}
+
+	var written ExportManifest
+	data, err := os.ReadFile(filepath.Join(dst, ExportManifestName))
+	if err != nil || json.Unmarshal(data, &written) != nil || written.Succeeded != 3 {
+		t.Errorf("expected manifest on disk (err %v)", err)
+	}
+
+	// A cancelled run still writes the manifest of what it finished.
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	manifest, err = ExportDir(ctx, src, t.TempDir(), ExportOptions{})
+	if !errors.Is(err, context.Canceled) || manifest == nil || len(manifest.Files) != 0 {
+		t.Errorf("expected a cancelled, empty export, got %+v, %v", manifest, err)
+	}
+	if _, err := ExportDir(context.Background(), src, dst, ExportOptions{Formats: []ExportFormat{"pdf"}}); err == nil {
+		t.Error("expected an error for an unsupported format")
+	}
+}
+
+func TestTemplateFuncs(t *testing.T) {
+	data, err := os.ReadFile(filepath.Join("..", "..", "bill-version-samples-september-2024", "BILLS-114s32cds.xml"))
+	if err != nil {
+		t.Fatalf("failed to read sample: %v", err)
+	}
+	doc, err := ParseDocument(data)
+	if err != nil {
+		t.Fatalf("failed to parse: %v", err)
+	}
+
+	tmpl := template.Must(template.New("bill").Funcs(TemplateFuncs()).Parse(
+		`{{range uslmsections .}}<h2>{{uslmnum .}} {{uslmheading .}}</h2>` +
+			`{{range uslmchildren .}}<p title="{{uslmcite .}}">{{uslmnum .}} {{uslmtext .}}</p>{{end}}{{end}}`))
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, doc); err != nil {
+		t.Fatalf("failed to execute template: %v", err)
+	}
+	out := buf.String()
+	for _, want := range []string{
+		"<h2>SECTION 1. SHORT TITLE.</h2>",
+		`<p title="Section 2(1)">(1) by redesignating subsections (b) and (c)`,
+		`<p title="Section 3(2)">(2) in section 2320—</p>`,
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("expected output to contain %q", want)
+		}
+	}
+	if strings.Count(out, "<h2>") != 3 {
+		t.Errorf("expected 3 sections, got %d", strings.Count(out, "<h2>"))
+	}
+
+	funcs := TemplateFuncs()
+	cite := funcs["uslmcite"].(func(interface{}) (string, error))
+	for _, tc := range []struct {
+		in   interface{}
+		want string
+	}{
+		{"/us/usc/t21/s959", "21 U.S.C. 959"},
+		{Ref{Href: "usc/26/4192", Text: "x"}, "26 U.S.C. 4192"},
+		{Ref{Href: "/us/pl/116/1", Text: " P.L. 116–1 "}, "P.L. 116–1"},
+	} {
+		if got, err := cite(tc.in); err != nil || got != tc.want {
+			t.Errorf("uslmcite(%v) = %q, %v; want %q", tc.in, got, err, tc.want)
+		}
+	}
+	if _, err := funcs["uslmtext"].(func(interface{}) (string, error))(42); err == nil {
+		t.Error("expected an error for an unsupported type")
+	}
+}
+
+func TestToHTMLAccessible(t *testing.T) {
+	const doc = `<bill xmlns="http://schemas.gpo.gov/xml/uslm" xmlns:dc="http://purl.org/dc/elements/1.1/">
+<meta><dc:title>A bill</dc:title><dc:type>House Bill</dc:type><docNumber>1</docNumber><dc:language>EN</dc:language></meta>
+<main>
+<title identifier="/us/bill/118/hr/1/tI"><num value="I">TITLE I</num><heading>GENERAL PROVISIONS</heading>
+<section id="s101" identifier="/us/bill/118/hr/1/tI/s101"><num value="101">SEC. 101.</num><heading>Rules</heading>
+<subsection identifier="/us/bill/118/hr/1/tI/s101/a"><num value="a">(a)</num>
+<paragraph identifier="/us/bill/118/hr/1/tI/s101/a/1"><num value="1">(1)</num>
+<subparagraph identifier="/us/bill/118/hr/1/tI/s101/a/1/A"><num value="A">(A)</num>
+<clause identifier="/us/bill/118/hr/1/tI/s101/a/1/A/i"><num value="i">(i)</num><content>Deep &amp; nested.</content></clause>
+</subparagraph></paragraph></subsection></section>
+</title>
+<title identifier="/us/bill/118/hr/1/tII"><num value="II">TITLE II</num><heading>OTHER</heading></title>
+</main>
+</bill>`
+	parsed, err := ParseDocument([]byte(doc))
+	if err != nil {
+		t.Fatalf("failed to parse: %v", err)
+	}
+
+	out := ToHTMLWithOptions(parsed, HTMLOptions{Accessible: true})
+	for _, want := range []string{
+		`<html lang="en">`,
+		`<li><a href="#main">Skip to main content</a></li>`,
+		`<li><a href="#title-1">Skip to TITLE I GENERAL PROVISIONS</a></li>`,
+		`<li><a href="#title-2">Skip to TITLE II OTHER</a></li>`,
+		`<main id="main">`,
+		`<section class="title" id="title-1" data-identifier="/us/bill/118/hr/1/tI" aria-labelledby="title-1-heading">`,
+		`<h2 id="title-1-heading">`,
+		`<section class="section" id="s101" data-identifier="/us/bill/118/hr/1/tI/s101" aria-labelledby="s101-heading">`,
+		`<h3 id="s101-heading">`,
+		`<h6 id="subparagraph-`,
+		`<div role="heading" aria-level="7" id="clause-`,
+		`<p class="content">Deep &amp; nested.</p>`,
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("expected accessible output to contain %q", want)
+		}
+	}
+
+	plain := ToHTML(parsed)
+	if strings.Contains(plain, "aria-") || strings.Contains(plain, "<nav") || !strings.Contains(plain, "<h4><span class=\"num\">(i)</span></h4>") {
+		t.Errorf("expected default output without accessibility markup:\n%s", plain)
+	}
+}
+
+func TestToPrintText(t *testing.T) {
+	data, err := os.ReadFile(filepath.Join("..", "..", "bill-version-samples-september-2024", "BILLS-116s1014es.xml"))
+	if err != nil {
+		t.Fatalf("failed to read sample: %v", err)
+	}
+	doc, err := ParseDocument(data)
+	if err != nil {
+		t.Fatalf("failed to parse: %v", err)
+	}
+
+	out := ToPrintText(doc, PrintOptions{})
+	lines := strings.Split(out, "\n")
+	if lines[0] != "116TH CONGRESS"+strings.Repeat(" ", 72-len("116TH CONGRESS")-len("S. 1014"))+"S. 1014" {
+		t.Errorf("expected right-aligned document number, got %q", lines[0])
+	}
+	if lines[1] != "  2D SESSION" {
+		t.Errorf("unexpected session line %q", lines[1])
+	}
+	for _, want := range []string{
+		"\n" + strings.Repeat(" ", 33) + "AN ACT\n",
+		"\n  SEC. 2. FINDINGS.\n  Congress finds that—\n    (1) Route 66 was the first all-weather highway in the United States\n  connecting",
+		"\n  (a) NUMBER AND APPOINTMENT.—The Commission shall be composed of 15\nmembers",
+		"\n      (A) the issuance of commemorative coins, medals, certificates of\n    recognition",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("expected output to contain %q", want)
+		}
+	}
+	for _, line := range lines {
+		if utf8.RuneCountInString(line) > 72 {
+			t.Errorf("line exceeds 72 columns: %q", line)
+		}
+	}
+
+	narrow := ToPrintText(doc, PrintOptions{Width: 40, Indent: 4})
+	if !strings.Contains(narrow, "\n        (1) Route 66 was the first\n    all-weather") {
+		t.Errorf("expected narrow layout with 4-column indents:\n%s", narrow[:600])
+	}
+}
+
+func TestTitledDocument(t *testing.T) {
+	data, err := os.ReadFile(filepath.Join("..", "..", "bill-version-samples-september-2024", "BILLS-116s1014es.xml"))
+	if err != nil {
+		t.Fatalf("failed to read sample: %v", err)
+	}
+	doc, err := ParseDocument(data)
+	if err != nil {
+		t.Fatalf("failed to parse: %v", err)
+	}
+	titled, ok := doc.(TitledDocument)
+	if !ok {
+		t.Fatalf("expected %T to implement TitledDocument", doc)
+	}
+	if got := titled.GetDocTitle(); got != "AN ACT" {
+		t.Errorf("expected doc title %q, got %q", "AN ACT", got)
+	}
+	want := "To establish the Route 66 Centennial Commission, and for other purposes."
+	if got := titled.GetOfficialTitle(); got != want {
+		t.Errorf("expected official title %q, got %q", want, got)
+	}
+	// The metadata title carries a citation prefix the official title lacks.
+	if doc.GetTitle() == want {
+		t.Errorf("expected dc:title to differ from the official title")
+	}
+
+	var empty Resolution
+	if empty.GetLongTitle() != nil || empty.GetDocTitle() != "" || empty.GetOfficialTitle() != "" {
+		t.Errorf("expected empty titles for a document without a body")
+	}
+}
+
+func TestResolutionRecitals(t *testing.T) {
+	data, err := os.ReadFile(filepath.Join("..", "..", "bill-version-samples-september-2024", "BILLS-116sres100ats.xml"))
+	if err != nil {
+		t.Fatalf("failed to read sample: %v", err)
+	}
+	res, err := ParseResolution(data)
+	if err != nil {
+		t.Fatalf("failed to parse: %v", err)
+	}
+	recitals := res.GetRecitals()
+	if len(recitals) != 28 {
+		t.Fatalf("expected 28 recitals, got %d", len(recitals))
+	}
+	want := "Whereas an estimated 3,081,000 American Indian, Alaska Native, and Native Hawaiian women live in the United States;"
+	if recitals[1] != want {
+		t.Errorf("expected recital %q, got %q", want, recitals[1])
+	}
+
+	// Text around inline elements and in nested paragraphs keeps its order.
+	xmlData := []byte(`<?xml version="1.0" encoding="UTF-8"?>
+<resolution xmlns="http://schemas.gpo.gov/xml/uslm">
+<main>
+<preamble>
+<recital>Whereas the <shortTitle>Example Act</shortTitle> was enacted;</recital>
+<recital><p class="inline">Whereas the Commission found—</p>
+<paragraph><num value="1">(1)</num><content>first; and</content></paragraph>
+<paragraph><num value="2">(2)</num><content>second;</content></paragraph>
+<p>and</p></recital>
+</preamble>
+</main>
+</resolution>`)
+	res, err = ParseResolution(xmlData)
+	if err != nil {
+		t.Fatalf("failed to parse: %v", err)
+	}
+	got := res.GetRecitals()
+	expected := []string{
+		"Whereas the Example Act was enacted;",
+		"Whereas the Commission found— (1) first; and (2) second; and",
+	}
+	if len(got) != len(expected) {
+		t.Fatalf("expected %d recitals, got %d: %q", len(expected), len(got), got)
+	}
+	for i := range expected {
+		if got[i] != expected[i] {
+			t.Errorf("recital %d: expected %q, got %q", i, expected[i], got[i])
+		}
+	}
+
+	var empty Resolution
+	if empty.GetRecitals() != nil {
+		t.Errorf("expected no recitals without a preamble")
+	}
+}
+
+func TestAmendmentCommittees(t *testing.T) {
+	xmlData := []byte(`<?xml version="1.0" encoding="UTF-8"?>
+<amendment xmlns="http://schemas.gpo.gov/xml/uslm">
+<amendMeta><dc:type xmlns:dc="http://purl.org/dc/elements/1.1/">Senate Amendment</dc:type></amendMeta>
+<amendPreface>
+<action><date date="2024-03-05">March 5, 2024</date><actionDescription>Referred to the <committee committeeId="SSFI00">Committee on Finance</committee> and ordered to be printed</actionDescription></action>
+<action><actionDescription>Reported by the <committee committeeId="SSFI00">Committee on Finance</committee></actionDescription></action>
+</amendPreface>
+</amendment>`)
+	doc, err := ParseDocument(xmlData)
+	if err != nil {
+		t.Fatalf("failed to parse: %v", err)
+	}
+	cd, ok := doc.(CommitteeDocument)
+	if !ok {
+		t.Fatalf("expected %T to implement CommitteeDocument", doc)
+	}
+	committees := cd.GetCommittees()
+	if len(committees) != 2 {
+		t.Fatalf("expected 2 committees, got %d", len(committees))
+	}
+	if committees[0].GetID() != "SSFI00" || committees[0].GetName() != "Committee on Finance" {
+		t.Errorf("unexpected committee %+v", committees[0])
+	}
+
+	var engrossed EngrossedAmendment
+	if engrossed.GetCommittees() != nil {
+		t.Errorf("expected no committees without a preface")
+	}
+}
+
+func TestMemberID(t *testing.T) {
+	data, err := os.ReadFile(filepath.Join("..", "..", "bill-version-samples-september-2024", "BILLS-114s32cds.xml"))
+	if err != nil {
+		t.Fatalf("failed to read sample: %v", err)
+	}
+	bill, err := ParseBill(data)
+	if err != nil {
+		t.Fatalf("failed to parse: %v", err)
+	}
+	id := bill.GetSponsors()[0].GetMemberID()
+	if id.Scheme != MemberIDSenate || id.Value != "S221" || id.Chamber() != "SENATE" {
+		t.Errorf("unexpected sponsor ID %+v", id)
+	}
+	if id.String() != "senate:S221" {
+		t.Errorf("expected %q, got %q", "senate:S221", id.String())
+	}
+
+	tests := []struct {
+		sponsor Sponsor
+		want    MemberID
+	}{
+		{Sponsor{HouseID: "H001"}, MemberID{MemberIDHouse, "H001"}},
+		{Sponsor{BioGuideID: "b001303"}, MemberID{MemberIDBioGuide, "B001303"}},
+		{Sponsor{SenateID: "S221", BioGuideID: "B001303"}, MemberID{MemberIDSenate, "S221"}},
+		{Sponsor{SenateID: "B001303"}, MemberID{MemberIDBioGuide, "B001303"}},
+		{Sponsor{}, MemberID{}},
+	}
+	for _, tt := range tests {
+		got := tt.sponsor.GetMemberID()
+		if got != tt.want {
+			t.Errorf("%+v: expected %+v, got %+v", tt.sponsor, tt.want, got)
+		}
+		if tt.sponsor.GetID() != tt.want.Value {
+			t.Errorf("%+v: expected GetID %q, got %q", tt.sponsor, tt.want.Value, tt.sponsor.GetID())
+		}
+	}
+
+	for _, s := range []string{"senate:S221", "house:H001", "bioguide:B001303"} {
+		parsed, err := ParseMemberID(s)
+		if err != nil {
+			t.Errorf("ParseMemberID(%q): %v", s, err)
+		} else if parsed.String() != s {
+			t.Errorf("ParseMemberID(%q) round-tripped to %q", s, parsed.String())
+		}
+	}
+	if parsed, err := ParseMemberID("b001303"); err != nil || parsed != (MemberID{MemberIDBioGuide, "B001303"}) {
+		t.Errorf("expected bare Bioguide ID to parse, got %+v, %v", parsed, err)
+	}
+	for _, s := range []string{"", "12345", "senate:", "state:CA01"} {
+		if _, err := ParseMemberID(s); err == nil {
+			t.Errorf("ParseMemberID(%q): expected error", s)
+		}
+	}
+}
+
+func TestCosponsorships(t *testing.T) {
+	data, err := os.ReadFile(filepath.Join("..", "..", "bill-version-samples-september-2024", "HJ37_RH.XML"))
+	if err != nil {
+		t.Fatalf("failed to read sample: %v", err)
+	}
+	doc, err := ParseDocument(data)
+	if err != nil {
+		t.Fatalf("failed to parse: %v", err)
+	}
+	joined := make(map[string]int)
+	for _, c := range doc.(SponsoredDocument).GetCosponsorships() {
+		joined[c.Joined]++
+	}
+	// The additional sponsors action has only a printed date.
+	if joined["2019-01-30"] != 70 || joined["2019-02-08"] != 26 || len(joined) != 2 {
+		t.Errorf("unexpected join dates %v", joined)
+	}
+
+	xmlData := []byte(`<?xml version="1.0" encoding="UTF-8"?>
+<bill xmlns="http://schemas.gpo.gov/xml/uslm">
+<preface>
+<action><date date="2019-12-02">December 2, 2019</date><actionDescription><sponsor bioGuideId="K000389">Mr. Khanna</sponsor> (for himself and <cosponsor bioGuideId="P000607">Mr. Pocan</cosponsor>) introduced the following bill</actionDescription></action>
+<action><date><inline class="smallCaps">December </inline>3 (legislative day, December 2), 2019.</date><actionDescription>Additional sponsors: <cosponsor bioGuideId="P000607">Mr. Pocan</cosponsor>, <cosponsor>Ms. Lee</cosponsor>, and <cosponsor>Ms. Lee</cosponsor></actionDescription></action>
+</preface>
+</bill>`)
+	bill, err := ParseBill(xmlData)
+	if err != nil {
+		t.Fatalf("failed to parse: %v", err)
+	}
+	got := bill.GetCosponsorships()
+	if len(got) != 2 {
+		t.Fatalf("expected 2 cosponsorships, got %d: %+v", len(got), got)
+	}
+	if got[0].Cosponsor.GetID() != "P000607" || got[0].Joined != "2019-12-02" {
+		t.Errorf("unexpected first cosponsorship %+v", got[0])
+	}
+	if got[1].Cosponsor.GetName() != "Ms. Lee" || got[1].Joined != "2019-12-03" {
+		t.Errorf("unexpected second cosponsorship %+v", got[1])
+	}
+	if n := len(bill.GetCosponsors()); n != 2 {
+		t.Errorf("expected 2 cosponsors, got %d", n)
+	}
+}
+
+func TestCongressDates(t *testing.T) {
+	day := func(s string) time.Time {
+		d, err := time.Parse("2006-01-02", s)
+		if err != nil {
+			t.Fatalf("bad date %q: %v", s, err)
+		}
+		return d
+	}
+	tests := []struct {
+		n          int
+		start, end string
+	}{
+		{1, "1789-03-04", "1791-03-04"},
+		{72, "1931-03-04", "1933-03-04"},
+		{73, "1933-03-04", "1935-01-03"},
+		{74, "1935-01-03", "1937-01-03"},
+		{116, "2019-01-03", "2021-01-03"},
+	}
+	for _, tt := range tests {
+		start, end := CongressDates(tt.n)
+		if !start.Equal(day(tt.start)) || !end.Equal(day(tt.end)) {
+			t.Errorf("CongressDates(%d) = %s, %s; want %s, %s", tt.n,
+				start.Format("2006-01-02"), end.Format("2006-01-02"), tt.start, tt.end)
+		}
+		if got := CongressForDate(start); got != tt.n {
+			t.Errorf("CongressForDate(%s) = %d, want %d", tt.start, got, tt.n)
+		}
+		if got := CongressForDate(end.AddDate(0, 0, -1)); got != tt.n {
+			t.Errorf("CongressForDate(day before %s) = %d, want %d", tt.end, got, tt.n)
+		}
+	}
+	if start, end := CongressDates(0); !start.IsZero() || !end.IsZero() {
+		t.Errorf("expected zero times for Congress 0")
+	}
+	if got := CongressForDate(day("1789-03-03")); got != 0 {
+		t.Errorf("expected 0 before the 1st Congress, got %d", got)
+	}
+
+	// The calendar day is taken in t's own location.
+	eastern := time.FixedZone("EST", -5*60*60)
+	if got := CongressForDate(time.Date(2019, time.January, 2, 22, 0, 0, 0, eastern)); got != 115 {
+		t.Errorf("expected 115th Congress on the evening of January 2, 2019, got %d", got)
+	}
+}
+
+func TestScanCorpus(t *testing.T) {
+	samples := os.DirFS(filepath.Join("..", "..", "bill-version-samples-september-2024"))
+	var n int
+	for r := range ScanCorpus(context.Background(), samples, ScanOptions{MetadataOnly: true, Workers: 4}) {
+		n++
+		if r.Err != nil {
+			t.Errorf("%s: %v", r.Path, r.Err)
+			continue
+		}
+		if r.Document.GetMeta() == nil {
+			t.Errorf("%s: expected metadata", r.Path)
+		}
+		if h, ok := r.Document.(HierarchicalDocument); ok && len(h.GetSections()) != 0 {
+			t.Errorf("%s: expected no body from a metadata-only scan", r.Path)
+		}
+	}
+	if n != 75 {
+		t.Errorf("expected all 75 samples to be scanned, got %d", n)
+	}
+
+	bill, err := os.ReadFile(filepath.Join("..", "..", "bill-version-samples-september-2024", "BILLS-114s32cds.xml"))
+	if err != nil {
+		t.Fatalf("failed to read sample: %v", err)
+	}
+	fsys := fstest.MapFS{
+		"a/BILLS-114s32cds.xml":   {Data: bill},
+		"a/b/BILLS-114s32cds.xml": {Data: bill},
+		"broken.xml":              {Data: []byte("<bill><meta>")},
+		"notes.txt":               {Data: []byte("not USLM")},
+	}
+	got := make(map[string]error)
+	for r := range ScanCorpus(context.Background(), fsys, ScanOptions{}) {
+		got[r.Path] = r.Err
+		if r.Err == nil && len(r.Document.(*Bill).GetSections()) == 0 {
+			t.Errorf("%s: expected a full parse", r.Path)
+		}
+	}
+	if len(got) != 3 {
+		t.Errorf("expected 3 results, got %v", got)
+	}
+	if got["a/b/BILLS-114s32cds.xml"] != nil || got["a/BILLS-114s32cds.xml"] != nil {
+		t.Errorf("unexpected errors %v", got)
+	}
+	if got["broken.xml"] == nil {
+		t.Errorf("expected an error for broken.xml")
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	results := ScanCorpus(ctx, samples, ScanOptions{Pattern: "*.XML", Workers: 2})
+	<-results
+	cancel()
+	for range results {
+	}
+}
+
+func TestCorpusStats(t *testing.T) {
+	samples := os.DirFS(filepath.Join("..", "..", "bill-version-samples-september-2024"))
+	stats := AggregateCorpus(ScanCorpus(context.Background(), samples, ScanOptions{Pattern: "*.xml"}))
+	if stats.Documents != 14 || stats.Failed != 0 {
+		t.Fatalf("expected 14 documents and no failures, got %d and %d", stats.Documents, stats.Failed)
+	}
+	var total int
+	for _, n := range stats.ByCongress {
+		total += n
+	}
+	if total != stats.Documents {
+		t.Errorf("expected congress counts to sum to %d, got %d", stats.Documents, total)
+	}
+	if stats.ByCongress["116"] == 0 {
+		t.Errorf("expected documents from the 116th Congress, got %v", stats.ByCongress)
+	}
+	if stats.Sections == 0 || stats.AverageSections <= 0 {
+		t.Errorf("expected section counts, got %d and %f", stats.Sections, stats.AverageSections)
+	}
+	for i := 1; i < len(stats.Members); i++ {
+		if stats.Members[i].Sponsored > stats.Members[i-1].Sponsored {
+			t.Fatalf("expected members ordered by sponsorships")
+		}
+	}
+
+	// Metadata-only documents count toward totals but not section averages.
+	a := NewCorpusAggregator()
+	for r := range ScanCorpus(context.Background(), samples, ScanOptions{Pattern: "BILLS-114s32cds.xml"}) {
+		a.Add(r)
+	}
+	for r := range ScanCorpus(context.Background(), samples, ScanOptions{Pattern: "BILLS-114s32cds.xml", MetadataOnly: true}) {
+		a.Add(r)
+	}
+	a.Add(ScanResult{Path: "broken.xml", Err: errors.New("broken")})
+	single := a.Stats()
+	if single.Documents != 2 || single.Failed != 1 {
+		t.Errorf("expected 2 documents and 1 failure, got %d and %d", single.Documents, single.Failed)
+	}
+	if single.AverageSections != float64(single.Sections) {
+		t.Errorf("expected the average over the one full parse, got %f of %d", single.AverageSections, single.Sections)
+	}
+	// The metadata-only parse has no preface, so sponsors come from the full parse.
+	if len(single.Members) == 0 || single.Members[0].ID != "senate:S221" || single.Members[0].Sponsored != 1 {
+		t.Errorf("unexpected members %+v", single.Members)
+	}
+
+	var buf bytes.Buffer
+	if err := single.WriteJSON(&buf); err != nil {
+		t.Fatalf("WriteJSON: %v", err)
+	}
+	var decoded CorpusStats
+	if err := json.Unmarshal(buf.Bytes(), &decoded); err != nil {
+		t.Fatalf("failed to decode JSON: %v", err)
+	}
+	if decoded.Documents != 2 || decoded.ByCongress["114"] != 2 {
+		t.Errorf("unexpected decoded stats %+v", decoded)
+	}
+
+	buf.Reset()
+	if err := single.WriteCSV(&buf); err != nil {
+		t.Fatalf("WriteCSV: %v", err)
+	}
+	out := buf.String()
+	for _, want := range []string{"metric,key,name,value\n", "documents,,,2\n", "failed,,,1\n", "congress,114,,2\n", "sponsored,senate:S221,"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("expected CSV to contain %q:\n%s", want, out)
+		}
+	}
+}
+
+func TestSchemaCoverage(t *testing.T) {
+	var schemas []io.Reader
+	for _, name := range []string{"uslm-components-2.1.0.xsd", "uslm-table-module-2.1.0.xsd"} {
+		f, err := os.Open(filepath.Join("..", "..", "bill-version-samples-september-2024", name))
+		if err != nil {
+			t.Fatalf("failed to open schema: %v", err)
+		}
+		defer f.Close()
+		schemas = append(schemas, f)
+	}
+	report, err := SchemaCoverage(schemas...)
+	if err != nil {
+		t.Fatalf("SchemaCoverage: %v", err)
+	}
+	covered, total := report.ElementCoverage()
+	t.Logf("element coverage %d/%d; uncovered: %v", covered, total, report.Missing())
+
+	// Elements the model must always decode.
+	status := make(map[string]bool)
+	for _, item := range report.Elements {
+		status[item.Name] = item.Covered
+	}
+	for _, name := range []string{"bill", "resolution", "amendment", "meta", "preface", "main",
+		"title", "section", "subsection", "paragraph", "subparagraph", "clause", "subclause",
+		"num", "heading", "chapeau", "content", "ref", "quotedContent", "sponsor", "cosponsor"} {
+		covered, declared := status[name]
+		if !declared {
+			t.Errorf("%s: not declared by the schema", name)
+		} else if !covered {
+			t.Errorf("%s: not covered by the model", name)
+		}
+	}
+	// Raise this floor as the model grows; it guards against regressions.
+	if covered < 73 {
+		t.Errorf("element coverage fell to %d/%d", covered, total)
+	}
+	if attrs, _ := report.AttributeCoverage(); attrs < 18 {
+		t.Errorf("attribute coverage fell to %d", attrs)
+	}
+
+	var buf bytes.Buffer
+	if err := report.WriteMarkdown(&buf); err != nil {
+		t.Fatalf("WriteMarkdown: %v", err)
+	}
+	if !strings.Contains(buf.String(), fmt.Sprintf("| Elements | %d | %d |", covered, total)) || !strings.Contains(buf.String(), "- `table`") {
+		t.Errorf("unexpected report:\n%s", buf.String())
+	}
+
+	if _, err := SchemaCoverage(); err == nil {
+		t.Errorf("expected an error without schemas")
+	}
+	if _, err := SchemaCoverage(strings.NewReader("<xsd:schema")); err == nil {
+		t.Errorf("expected an error for a malformed schema")
+	}
+}
+
+func TestGeneratedSchemaTypes(t *testing.T) {
+	data, err := os.ReadFile(filepath.Join("..", "..", "bill-version-samples-september-2024", "BILLS-114s32cds.xml"))
+	if err != nil {
+		t.Fatalf("failed to read sample: %v", err)
+	}
+	var generated schema.LawDocType
+	if err := xml.Unmarshal(data, &generated); err != nil {
+		t.Fatalf("failed to decode into generated types: %v", err)
+	}
+	if generated.XMLName.Space != schema.Namespace || generated.XMLName.Local != "bill" {
+		t.Errorf("unexpected root %v", generated.XMLName)
+	}
+	if generated.XMLLang != "en" {
+		t.Errorf("xml:lang = %q, want en", generated.XMLLang)
+	}
+
+	doc, err := ParseDocument(data)
+	if err != nil {
+		t.Fatalf("ParseDocument: %v", err)
+	}
+	sections := doc.(*Bill).GetSections()
+	if generated.Main == nil || len(generated.Main.Section) != len(sections) {
+		t.Fatalf("generated types decoded a different number of sections than the model (%d)", len(sections))
+	}
+	for i, s := range generated.Main.Section {
+		if s.Identifier != sections[i].Identifier {
+			t.Errorf("section %d: identifier %q, want %q", i, s.Identifier, sections[i].Identifier)
+		}
+		if len(s.Num) != 1 || strings.TrimSpace(s.Num[0].Value) != strings.TrimSpace(sections[i].Num.Value) {
+			t.Errorf("section %d: num does not match the model", i)
+		}
+	}
+}
+
+func TestUnknownContent(t *testing.T) {
+	const doc = `<?xml version="1.0" encoding="UTF-8"?>
+<bill xmlns="http://schemas.gpo.gov/xml/uslm" xmlns:dc="http://purl.org/dc/elements/1.1/" id="b1">
+<meta><dc:title>A bill</dc:title><dc:type>Senate Bill</dc:type><congress>118</congress></meta>
+<main>
+<section identifier="/us/bill/118/s/1/s1" style="kept"><num value="1">SEC. 1.</num><heading>Short title</heading>
+<widget flavor="x"><part>nested</part></widget>
+<content>This Act may be cited as the Example Act.</content>
+</section>
+</main>
+</bill>`
+
+	var unknown UnknownContent
+	parsed, err := ParseDocumentWithOptions([]byte(doc), ParseOptions{Unknown: &unknown})
+	if err != nil {
+		t.Fatalf("failed to parse: %v", err)
+	}
+	// The bill's id and the section's style are kept in their Attrs, and
+	// nothing inside the widget is reported separately.
+	wantElements := []UnknownItem{{Name: "widget", Path: "bill/main/section/widget", Line: 6}}
+	if fmt.Sprint(unknown.Elements) != fmt.Sprint(wantElements) {
+		t.Errorf("elements = %v, want %v", unknown.Elements, wantElements)
+	}
+	if len(unknown.Attributes) != 0 {
+		t.Errorf("attributes = %v, want none", unknown.Attributes)
+	}
+	if unknown.Len() != 1 || unknown.Counts()["widget"] != 1 {
+		t.Errorf("unexpected totals: %d %v", unknown.Len(), unknown.Counts())
+	}
+	if parsed.(*Bill).Attrs.Get("id") != "b1" {
+		t.Errorf("expected the bill's id to be kept, got %v", parsed.(*Bill).Attrs)
+	}
+
+	_, err = ParseDocumentWithOptions([]byte(doc), ParseOptions{Strict: true})
+	if !errors.Is(err, ErrUnknownContent) {
+		t.Fatalf("expected ErrUnknownContent in strict mode, got %v", err)
+	}
+	if !strings.Contains(err.Error(), "element widget at bill/main/section/widget") {
+		t.Errorf("strict error does not locate the element: %v", err)
+	}
+
+	// A sample the model covers fully parses in strict mode, and the report
+	// is reset by each parse.
+	data, err := os.ReadFile(filepath.Join("..", "..", "bill-version-samples-september-2024", "BILLS-116hr1865eas.xml"))
+	if err != nil {
+		t.Fatalf("failed to read sample: %v", err)
+	}
+	if _, err := ParseDocumentWithOptions(data, ParseOptions{Strict: true, Unknown: &unknown}); err != nil {
+		t.Errorf("strict parse of sample failed: %v", err)
+	}
+	if unknown.Len() != 0 {
+		t.Errorf("expected the report to be reset, got %v", unknown)
+	}
+
+	// Scanning the corpus records the loss per file.
+	dir := os.DirFS(filepath.Join("..", "..", "bill-version-samples-september-2024"))
+	lossy := 0
+	for r := range ScanCorpus(context.Background(), dir, ScanOptions{Pattern: "*.[xX][mM][lL]", RecordUnknown: true}) {
+		if r.Err != nil {
+			t.Errorf("%s: %v", r.Path, r.Err)
+			continue
+		}
+		if r.Unknown == nil {
+			t.Fatalf("%s: no unknown content recorded", r.Path)
+		}
+		if r.Unknown.Len() > 0 {
+			lossy++
+		}
+	}
+	if lossy == 0 {
+		t.Errorf("expected some samples to have content the model drops")
+	}
+}
+
+func TestBatchErrors(t *testing.T) {
+	const doc = `<?xml version="1.0" encoding="UTF-8"?>
+<bill xmlns="http://schemas.gpo.gov/xml/uslm" xmlns:dc="http://purl.org/dc/elements/1.1/">
+<meta><dc:title>A bill</dc:title><dc:type>Senate Bill</dc:type><congress>118</congress></meta>
+<main>
+<section id="s1" identifier="/us/bill/118/s/1/s1"><num>SEC. 1.</num></section>
+<section id="s1" identifier="/us/bill/118/s/1/s1"><num value="2">SEC. 2.</num></section>
+</main>
+</bill>`
+	parsed, err := ParseDocument([]byte(doc))
+	if err != nil {
+		t.Fatalf("failed to parse: %v", err)
+	}
+
+	// Validate reports the missing fields and the duplicates in one pass.
+	err = Validate(parsed)
+	var verr *ValidationError
+	if !errors.As(err, &verr) {
+		t.Fatalf("expected a *ValidationError, got %v", err)
+	}
+	kinds := make(map[IssueKind]int)
+	for _, issue := range verr.Issues {
+		kinds[issue.Kind]++
+	}
+	if kinds[IssueMissingField] != 2 || kinds[IssueDuplicateID] != 1 || kinds[IssueDuplicateIdentifier] != 1 {
+		t.Errorf("unexpected issues: %v", verr.Issues)
+	}
+	var issue Issue
+	if !errors.As(err, &issue) || issue.Kind != IssueMissingField {
+		t.Errorf("expected errors.As to find the first issue, got %v", issue)
+	}
+	if !strings.HasPrefix(err.Error(), "4 issues:\n") {
+		t.Errorf("unexpected message: %q", err.Error())
+	}
+
+	good := filepath.Join("..", "..", "bill-version-samples-september-2024", "BILLS-114s32cds.xml")
+	data, err := os.ReadFile(good)
+	if err != nil {
+		t.Fatalf("failed to read sample: %v", err)
+	}
+	sample, err := ParseDocument(data)
+	if err != nil {
+		t.Fatalf("failed to parse sample: %v", err)
+	}
+	if err := Validate(sample); err != nil {
+		t.Errorf("expected the sample to validate, got %v", err)
+	}
+
+	// A batch parse attempts every file and attributes each failure.
+	dir := t.TempDir()
+	bad := filepath.Join(dir, "bad.xml")
+	if err := os.WriteFile(bad, []byte("<bill><main>"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	missing := filepath.Join(dir, "missing.xml")
+	docs, err := ParseFiles([]string{bad, good, missing}, ParseOptions{})
+	if len(docs) != 3 || docs[0] != nil || docs[1] == nil || docs[2] != nil {
+		t.Errorf("unexpected documents: %v", docs)
+	}
+	var berr *BatchError
+	if !errors.As(err, &berr) {
+		t.Fatalf("expected a *BatchError, got %v", err)
+	}
+	if items := berr.Items(); len(items) != 2 || items[0] != bad || items[1] != missing {
+		t.Errorf("unexpected failed items: %v", items)
+	}
+	if !errors.Is(err, os.ErrNotExist) {
+		t.Errorf("expected errors.Is to find the missing file")
+	}
+	var item *ItemError
+	if !errors.As(err, &item) || item.Item != bad {
+		t.Errorf("expected the first item error to name %s, got %v", bad, item)
+	}
+
+	fsys := fstest.MapFS{
+		"a.xml": {Data: data},
+		"b.xml": {Data: []byte("<bill>")},
+		"c.xml": {Data: []byte("not xml")},
+	}
+	byPath, err := CollectScan(ScanCorpus(context.Background(), fsys, ScanOptions{}))
+	if len(byPath) != 1 || byPath["a.xml"] == nil {
+		t.Errorf("unexpected scanned documents: %v", byPath)
+	}
+	if !errors.As(err, &berr) || fmt.Sprint(berr.Items()) != "[b.xml c.xml]" {
+		t.Errorf("unexpected scan errors: %v", err)
+	}
+
+	manifest := &ExportManifest{Files: []ExportResult{{Source: "a.xml"}, {Source: "b.xml", Error: "failed to parse"}}}
+	if err := manifest.Err(); err == nil || err.Error() != "b.xml: failed to parse" {
+		t.Errorf("unexpected manifest error: %v", err)
+	}
+}
+
+func TestCanonicalJSON(t *testing.T) {
+	data, err := os.ReadFile(filepath.Join("..", "..", "bill-version-samples-september-2024", "BILLS-114s32cds.xml"))
+	if err != nil {
+		t.Fatalf("failed to read sample bill: %v", err)
+	}
+	bill, err := ParseBill(data)
+	if err != nil {
+		t.Fatalf("failed to parse bill: %v", err)
+	}
+
+	opts := JSONOptions{Canonical: true, Indent: true}
+	out, err := ToJSONWithOptions(bill, opts)
+	if err != nil {
+		t.Fatalf("failed to marshal: %v", err)
+	}
+	for _, unwanted := range []string{"\n", `:""`, ":[]", ":{}", ":null"} {
+		if strings.Contains(string(out), unwanted) {
+			t.Errorf("canonical output contains %q", unwanted)
+		}
+	}
+	prev := ""
+	for _, key := range strings.Split(topLevelKeys(t, out), ",") {
+		if key < prev {
+			t.Errorf("keys not sorted: %q after %q", key, prev)
+		}
+		prev = key
+	}
+
+	// Decoding and re-encoding reproduces the same bytes, as does a fresh
+	// parse of the same XML.
+	var decoded Bill
+	if err := FromJSONWithOptions(out, &decoded, opts); err != nil {
+		t.Fatalf("failed to unmarshal: %v", err)
+	}
+	again, err := ToJSONWithOptions(&decoded, opts)
+	if err != nil {
+		t.Fatalf("failed to marshal: %v", err)
+	}
+	if !bytes.Equal(out, again) {
+		t.Error("expected canonical output to survive a JSON round trip unchanged")
+	}
+	doc, err := ParseDocument(data)
+	if err != nil {
+		t.Fatalf("failed to parse: %v", err)
+	}
+	if fresh, _ := ToJSONWithOptions(doc, opts); !bytes.Equal(out, fresh) {
+		t.Error("expected identical output for a fresh parse")
+	}
+
+	// Emptiness is judged after nested members are dropped, and HTML
+	// characters are left as they are.
+	type inner struct {
+		A string   `json:"a"`
+		B []string `json:"b"`
+	}
+	v := struct {
+		Z     string  `json:"z"`
+		Inner inner   `json:"inner"`
+		Ptr   *inner  `json:"ptr"`
+		List  []inner `json:"list"`
+		Zero  int     `json:"zero"`
+	}{Z: "a < b & c", List: []inner{{}}}
+	got, err := ToJSONWithOptions(v, JSONOptions{Canonical: true})
+	if err != nil {
+		t.Fatalf("failed to marshal: %v", err)
+	}
+	if want := `{"list":[{}],"z":"a < b & c","zero":0}`; string(got) != want {
+		t.Errorf("got %s, want %s", got, want)
+	}
+}
+
+func TestStreamParser(t *testing.T) {
+	for _, f := range []string{"BILLS-114s32cds.xml", "H2839_RH.XML"} {
+		t.Run(f, func(t *testing.T) {
+			data, err := os.ReadFile(filepath.Join("..", "..", "bill-version-samples-september-2024", f))
+			if err != nil {
+				t.Fatalf("failed to read sample: %v", err)
+			}
+			want, err := ParseDocument(data)
+			if err != nil {
+				t.Fatalf("failed to parse: %v", err)
+			}
+
+			var sections []string
+			var actions, refs, nested int
+			p := NewStreamParser(ParseOptions{})
+			p.OnSection(func(s Section) { sections = append(sections, s.Identifier) })
+			p.OnAction(func(Action) { actions++ })
+			p.OnRef(func(r Ref) {
+				refs++
+				for in := r.InnerRef; in != nil; in = in.InnerRef {
+					nested++
+				}
+			})
+			doc, err := p.Parse(bytes.NewReader(data))
+			if err != nil {
+				t.Fatalf("failed to stream: %v", err)
+			}
+
+			if doc.GetDocumentType() != want.GetDocumentType() || doc.GetCongress() != want.GetCongress() {
+				t.Errorf("got %s of congress %s, want %s of congress %s",
+					doc.GetDocumentType(), doc.GetCongress(), want.GetDocumentType(), want.GetCongress())
+			}
+			if len(Provisions(doc)) != 0 {
+				t.Error("expected the streamed document to hold no body")
+			}
+			// The stream is in document order, where Provisions puts titles
+			// ahead of sections outside them.
+			var wantSections []string
+			for _, s := range templateSections(want) {
+				wantSections = append(wantSections, s.Identifier)
+			}
+			sort.Strings(sections)
+			sort.Strings(wantSections)
+			if got, want := strings.Join(sections, " "), strings.Join(wantSections, " "); got != want {
+				t.Errorf("got sections %s, want %s", got, want)
+			}
+			if n := bytes.Count(data, []byte("<action>")) + bytes.Count(data, []byte("<action ")); actions != n {
+				t.Errorf("got %d actions, want %d", actions, n)
+			}
+			if n := bytes.Count(data, []byte("<ref ")); refs == 0 || refs+nested != n {
+				t.Errorf("got %d refs and %d nested, want %d in all", refs, nested, n)
+			}
+		})
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	data, err := os.ReadFile(filepath.Join("..", "..", "bill-version-samples-september-2024", "BILLS-114s32cds.xml"))
+	if err != nil {
+		t.Fatalf("failed to read sample: %v", err)
+	}
+	if _, err := NewStreamParser(ParseOptions{Context: ctx}).Parse(bytes.NewReader(data)); !errors.Is(err, context.Canceled) {
+		t.Errorf("got %v, want context.Canceled", err)
+	}
+}
+
+func TestParseProgress(t *testing.T) {
+	path := filepath.Join("..", "..", "bill-version-samples-september-2024", "BILLS-116hr1865eah.xml")
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("failed to read sample: %v", err)
+	}
+	var elements int64
+	raw := xml.NewDecoder(bytes.NewReader(data))
+	for {
+		tok, err := raw.RawToken()
+		if err != nil {
+			break
+		}
+		if _, ok := tok.(xml.StartElement); ok {
+			elements++
+		}
+	}
+
+	check := func(t *testing.T, reports []ParseProgress) {
+		t.Helper()
+		if len(reports) < 2 {
+			t.Fatalf("got %d reports, want several", len(reports))
+		}
+		for i, r := range reports {
+			if r.Total != int64(len(data)) {
+				t.Errorf("report %d: got total %d, want %d", i, r.Total, len(data))
+			}
+			if i > 0 && (r.Bytes < reports[i-1].Bytes || r.Elements < reports[i-1].Elements) {
+				t.Errorf("report %d went backwards: %+v after %+v", i, r, reports[i-1])
+			}
+			if r.Done != (i == len(reports)-1) {
+				t.Errorf("report %d: got done %v", i, r.Done)
+			}
+		}
+		last := reports[len(reports)-1]
+		if last.Elements != elements || last.Bytes == 0 || last.Bytes > last.Total {
+			t.Errorf("got final report %+v, want %d elements", last, elements)
+		}
+	}
+
+	t.Run("ParseDocumentWithOptions", func(t *testing.T) {
+		var reports []ParseProgress
+		opts := ParseOptions{Progress: func(p ParseProgress) { reports = append(reports, p) }}
+		if _, err := ParseDocumentWithOptions(data, opts); err != nil {
+			t.Fatalf("failed to parse: %v", err)
+		}
+		check(t, reports)
+	})
+
+	t.Run("StreamParser", func(t *testing.T) {
+		f, err := os.Open(path)
+		if err != nil {
+			t.Fatalf("failed to open sample: %v", err)
+		}
+		defer f.Close()
+		var reports []ParseProgress
+		opts := ParseOptions{Progress: func(p ParseProgress) { reports = append(reports, p) }}
+		if _, err := NewStreamParser(opts).Parse(f); err != nil {
+			t.Fatalf("failed to stream: %v", err)
+		}
+		check(t, reports)
+	})
+}
+
+func TestClassify(t *testing.T) {
+	tests := []struct {
+		file string
+		want []MeasureClass
+	}{
+		{"H264_PCS.XML", []MeasureClass{ClassAppropriations}},
+		{"H2740_RH.XML", []MeasureClass{ClassAppropriations}},
+		{"HJ107_RDS.XML", []MeasureClass{ClassAppropriations, ClassContinuingResolution}},
+		{"S2731_IPS.XML", []MeasureClass{ClassAuthorization}},
+		{"SR100_IS.XML", []MeasureClass{ClassCommemorative}},
+		{"HR1000_IH.XML", []MeasureClass{ClassCommemorative}},
+		{"S1000_IS.XML", []MeasureClass{ClassTax}},
+		// A statement of policy, not an observance.
+		{"SC10_IS.XML", nil},
+		{"BILLS-114s32cds.xml", nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.file, func(t *testing.T) {
+			data, err := os.ReadFile(filepath.Join("..", "..", "bill-version-samples-september-2024", tt.file))
+			if err != nil {
+				t.Fatalf("failed to read sample: %v", err)
+			}
+			doc, err := ParseDocument(data)
+			if err != nil {
+				t.Fatalf("failed to parse: %v", err)
+			}
+			got := Classify(doc)
+			var classes []MeasureClass
+			for _, c := range got {
+				if c.Reason == "" {
+					t.Errorf("%s has no reason", c.Class)
+				}
+				classes = append(classes, c.Class)
+			}
+			if fmt.Sprint(classes) != fmt.Sprint(tt.want) {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+			for _, class := range tt.want {
+				if !HasClass(doc, class) {
+					t.Errorf("expected HasClass(%s)", class)
+				}
+			}
+		})
+	}
+}
+
+func TestPrefaceDistribution(t *testing.T) {
+	data, err := os.ReadFile(filepath.Join("..", "..", "bill-version-samples-september-2024", "H1_RH.XML"))
+	if err != nil {
+		t.Fatalf("failed to read sample: %v", err)
+	}
+	doc, err := ParseDocument(data)
+	if err != nil {
+		t.Fatalf("failed to parse: %v", err)
+	}
+	bill := doc.(*Bill)
+	preface := bill.Preface
+
+	code := preface.DistributionCode.Code()
+	if code != DistCodeIB || !code.Known() || code.Chamber() != "HOUSE" || !preface.DistributionCode.Displayed() {
+		t.Errorf("got distribution code %q in the %q", code, code.Chamber())
+	}
+	slug, err := ParseSlugLine(preface.SlugLine)
+	if err != nil {
+		t.Fatalf("failed to parse slug line: %v", err)
+	}
+	if want := (SlugLine{Marker: "•", Type: "HR", Number: "1", Version: "RH"}); slug != want {
+		t.Errorf("got slug line %+v, want %+v", slug, want)
+	}
+	if slug.String() != "•HR 1 RH" || slug.MeasureType() != "hr" || slug.Chamber() != "HOUSE" {
+		t.Errorf("got %q of type %q from the %q", slug, slug.MeasureType(), slug.Chamber())
+	}
+	cal := preface.Calendar()
+	if cal == nil || *cal != (Calendar{Chamber: "HOUSE", Name: "Union", Number: "5", Href: "/us/116/hcal/Union/5"}) {
+		t.Errorf("got calendar %+v", cal)
+	}
+	if issues := CheckPreface(doc); len(issues) != 0 {
+		t.Errorf("expected no issues, got %v", issues)
+	}
+
+	if _, err := ParseSlugLine("HR one RH"); err == nil {
+		t.Error("expected an error for a malformed slug line")
+	}
+	if s, err := ParseSlugLine("† SCON 13 ES"); err != nil || s.MeasureType() != "sconres" || s.Chamber() != "SENATE" {
+		t.Errorf("got %+v, %v", s, err)
+	}
+
+	preface.DistributionCode.Text = "V"
+	preface.SlugLine = "•HR 2 RH"
+	issues := CheckPreface(doc)
+	kinds := map[IssueKind]int{}
+	for _, issue := range issues {
+		kinds[issue.Kind]++
+	}
+	if kinds[IssueInvalidPreface] != 1 || kinds[IssueInconsistentPreface] != 1 {
+		t.Errorf("got issues %v", issues)
+	}
+
+	preface.DistributionCode.Text = "II"
+	preface.SlugLine = "•HR 1 EH"
+	issues = CheckPreface(doc)
+	if len(issues) != 2 || issues[0].Element != "slugLine" || issues[1].Element != "distributionCode" {
+		t.Errorf("got issues %v", issues)
+	}
+	var verr *ValidationError
+	if err := Validate(doc); !errors.As(err, &verr) || len(verr.Issues) < 2 {
+		t.Errorf("expected Validate to report the preface issues, got %v", err)
+	}
+}
+
+func TestReportedDocument(t *testing.T) {
+	tests := []struct {
+		file      string
+		calendar  Calendar
+		citations []string
+	}{
+		{"H1_RH.XML", Calendar{Chamber: "HOUSE", Name: "Union", Number: "5", Href: "/us/116/hcal/Union/5"}, []string{"H. Rept. 116-15, Part 1"}},
+		{"BILLS-116hr3rh.xml", Calendar{Chamber: "HOUSE", Name: "Union", Number: "264", Href: "/us/116/hcal/Union/264"},
+			[]string{"H. Rept. 116-324, Part 1", "H. Rept. 116-324, Part 2", "H. Rept. 116-324, Part 3"}},
+		{"H1079_RS.XML", Calendar{Chamber: "SENATE", Number: "127", Href: "/us/116/scal/127"}, []string{"S. Rept. 116-50"}},
+		{"SR123_RS.XML", Calendar{Chamber: "SENATE", Number: "55", Href: "/us/116/scal/55"}, nil},
+		{"H1000_IH.XML", Calendar{}, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.file, func(t *testing.T) {
+			data, err := os.ReadFile(filepath.Join("..", "..", "bill-version-samples-september-2024", tt.file))
+			if err != nil {
+				t.Fatalf("failed to read sample: %v", err)
+			}
+			doc, err := ParseDocument(data)
+			if err != nil {
+				t.Fatalf("failed to parse: %v", err)
+			}
+			reported, ok := doc.(ReportedDocument)
+			if !ok {
+				t.Fatalf("%T does not implement ReportedDocument", doc)
+			}
+
+			cal := reported.GetCalendar()
+			switch {
+			case tt.calendar == Calendar{} && cal != nil:
+				t.Errorf("expected no calendar, got %+v", cal)
+			case tt.calendar != Calendar{} && (cal == nil || *cal != tt.calendar):
+				t.Errorf("got calendar %+v, want %+v", cal, tt.calendar)
+			}
+			if reported.GetCalendarNumber() != tt.calendar.Number {
+				t.Errorf("got calendar number %q, want %q", reported.GetCalendarNumber(), tt.calendar.Number)
+			}
+
+			var citations []string
+			for _, r := range reported.GetReports() {
+				if !strings.HasPrefix(r.Value, "CRPT-") {
+					t.Errorf("got report value %q", r.Value)
+				}
+				citations = append(citations, r.Citation())
+			}
+			if fmt.Sprint(citations) != fmt.Sprint(tt.citations) {
+				t.Errorf("got reports %q, want %q", citations, tt.citations)
+			}
+			want := ""
+			if len(tt.citations) > 0 {
+				want = tt.citations[0]
+			}
+			if reported.GetReportCitation() != want {
+				t.Errorf("got report citation %q, want %q", reported.GetReportCitation(), want)
+			}
+		})
+	}
+}
+
+func TestActionInstructions(t *testing.T) {
+	data, err := os.ReadFile(filepath.Join("..", "..", "bill-version-samples-september-2024", "BILLS-116hr3rh.xml"))
+	if err != nil {
+		t.Fatalf("failed to read sample: %v", err)
+	}
+	doc, err := ParseDocument(data)
+	if err != nil {
+		t.Fatalf("failed to parse: %v", err)
+	}
+	var last Action
+	for _, a := range doc.(ActionDocument).GetActions() {
+		if len(a.ActionInstructions) > 0 {
+			last = a
+		}
+	}
+	if len(last.ActionInstructions) != 2 {
+		t.Fatalf("got %d instructions on the last action, want 2", len(last.ActionInstructions))
+	}
+
+	amend := last.ActionInstructions[0].Parse()
+	if amend.Kind != InstructionAmendment || amend.Strike != "all after the enacting clause" || amend.Insert != "boldface italic" || !amend.ReplacesText() {
+		t.Errorf("got %+v", amend)
+	}
+	see := last.ActionInstructions[1].Parse()
+	if see.Kind != InstructionSeeText || see.Subject != "introduced bill" || see.Date != "2019-09-19" || see.ReplacesText() {
+		t.Errorf("got %+v", see)
+	}
+
+	tests := []struct {
+		text string
+		want ParsedInstruction
+	}{
+		{"[Omit the part struck through and insert the part printed in italic]",
+			ParsedInstruction{Kind: InstructionAmendment, Strike: "the part struck through", Insert: "italic", Text: "Omit the part struck through and insert the part printed in italic"}},
+		{"[Strike the preamble and insert the part printed in italic]",
+			ParsedInstruction{Kind: InstructionAmendment, Strike: "the preamble", Insert: "italic", Text: "Strike the preamble and insert the part printed in italic"}},
+		{"[Insert the part printed in italic]",
+			ParsedInstruction{Kind: InstructionAmendment, Insert: "italic", Text: "Insert the part printed in italic"}},
+		{"[Omit the part in black brackets]",
+			ParsedInstruction{Kind: InstructionAmendment, Strike: "the part in black brackets", Text: "Omit the part in black brackets"}},
+		{"[Printed pursuant to order]",
+			ParsedInstruction{Kind: InstructionOther, Text: "Printed pursuant to order"}},
+	}
+	for _, tt := range tests {
+		if got := (ActionInstruction{Text: tt.text}).Parse(); got != tt.want {
+			t.Errorf("%s: got %+v, want %+v", tt.text, got, tt.want)
+		}
+	}
+
+	// Both instructions survive a round trip.
+	out, err := xml.Marshal(last)
+	if err != nil {
+		t.Fatalf("failed to marshal: %v", err)
+	}
+	if n := bytes.Count(out, []byte("<actionInstruction>")); n != 2 {
+		t.Errorf("got %d instructions in %s", n, out)
+	}
+}
+
+func TestProposedChanges(t *testing.T) {
+	parse := func(name string) LegislativeDocument {
+		t.Helper()
+		data, err := os.ReadFile(filepath.Join("..", "..", "bill-version-samples-september-2024", name))
+		if err != nil {
+			t.Fatalf("failed to read sample: %v", err)
+		}
+		doc, err := ParseDocument(data)
+		if err != nil {
+			t.Fatalf("failed to parse %s: %v", name, err)
+		}
+		return doc
+	}
+
+	changes := GetProposedChanges(parse("SJ4_RS.XML"))
+	if len(changes.Struck) != 5 || len(changes.Inserted) != 6 {
+		t.Fatalf("got %d struck and %d inserted, want 5 and 6", len(changes.Struck), len(changes.Inserted))
+	}
+	for _, c := range append(changes.Struck, changes.Inserted...) {
+		if c.Element != "section" || c.Citation == "" || c.Text == "" {
+			t.Errorf("got %+v", c)
+		}
+		if c.Change == ChangeDeleted && strings.Contains(changes.Proposed, c.Text) {
+			t.Errorf("struck %s remains in the proposed text", c.Citation)
+		}
+		if c.Change == ChangeAdded && strings.Contains(changes.Original, c.Text) {
+			t.Errorf("inserted %s appears in the original text", c.Citation)
+		}
+	}
+
+	changes = GetProposedChanges(parse("BILLS-116sc10rs.xml"))
+	if len(changes.Struck) == 0 || len(changes.Inserted) == 0 {
+		t.Fatalf("got %d struck and %d inserted recitals", len(changes.Struck), len(changes.Inserted))
+	}
+	if c := changes.Struck[0]; c.Element != "recital" || !strings.HasPrefix(c.Citation, "Recital ") {
+		t.Errorf("got %+v", c)
+	}
+
+	changes = GetProposedChanges(parse("BILLS-110s2062ris.xml"))
+	if len(changes.Inserted) != 1 || changes.Inserted[0].Element != "addedText" {
+		t.Fatalf("got %+v", changes.Inserted)
+	}
+	if added := changes.Inserted[0].Text; !strings.Contains(added, "(25 U.S.C. 4212)") || strings.Contains(changes.Original, added) || !strings.Contains(changes.Proposed, added) {
+		t.Errorf("got %q", added)
+	}
+
+	changes = GetProposedChanges(parse("BILLS-114s32cds.xml"))
+	if len(changes.Struck) != 0 || len(changes.Inserted) != 0 || changes.Original != changes.Proposed || changes.Original == "" {
+		t.Errorf("got %d struck and %d inserted in an unamended bill", len(changes.Struck), len(changes.Inserted))
+	}
+}
+
+func TestSubstitutes(t *testing.T) {
+	parse := func(name string) LegislativeDocument {
+		t.Helper()
+		data, err := os.ReadFile(filepath.Join("..", "..", "bill-version-samples-september-2024", name))
+		if err != nil {
+			t.Fatalf("failed to read sample: %v", err)
+		}
+		doc, err := ParseDocument(data)
+		if err != nil {
+			t.Fatalf("failed to parse %s: %v", name, err)
+		}
+		return doc
+	}
+
+	// Struck and inserted components.
+	sub := GetSubstitute(parse("BILLS-118s1325rs.xml"))
+	if sub == nil || sub.Origin != "#SSFR00" || sub.Committee == nil || sub.Committee.GetName() != "Committee on Foreign Relations" {
+		t.Fatalf("got %+v", sub)
+	}
+	if sub.Original == nil || len(sub.Provisions()) == 0 || len(sub.OriginalProvisions()) == 0 {
+		t.Errorf("got %d substitute and %d original provisions", len(sub.Provisions()), len(sub.OriginalProvisions()))
+	}
+	if a, b := sub.Provisions()[0], sub.OriginalProvisions()[0]; a.ID == b.ID {
+		t.Errorf("substitute and original share first provision %s", a.ID)
+	}
+
+	// One substitute per reporting committee.
+	var origins []string
+	for _, s := range GetSubstitutes(parse("BILLS-116hr3rh.xml")) {
+		origins = append(origins, s.Origin)
+		if s.Original != nil {
+			t.Errorf("%s: got an original", s.Origin)
+		}
+	}
+	if got := strings.Join(origins, ","); got != "#HIF00,#HWM00,#HED00" {
+		t.Errorf("got origins %s", got)
+	}
+
+	// A main marked added as a whole.
+	if sub := GetSubstitute(parse("H1_RH.XML")); sub == nil || sub.Origin != "#HHA00" || sub.Original != nil || len(sub.Provisions()) == 0 {
+		t.Errorf("got %+v", sub)
+	}
+
+	// Sections each marked added or deleted.
+	sub = GetSubstitute(parse("SJ4_RS.XML"))
+	if sub == nil || len(sub.Provisions()) != 6 || len(sub.OriginalProvisions()) != 5 {
+		t.Fatalf("got %+v", sub)
+	}
+	for _, p := range sub.Provisions() {
+		if p.Changed != ChangeAdded {
+			t.Errorf("%s: got %q", p.PathString(), p.Changed)
+		}
+	}
+
+	// Amendments to individual provisions and unamended bills.
+	for _, name := range []string{"H2839_RH.XML", "BILLS-110s2062ris.xml", "BILLS-114s32cds.xml"} {
+		if subs := GetSubstitutes(parse(name)); len(subs) != 0 {
+			t.Errorf("%s: got %d substitutes", name, len(subs))
+		}
+	}
+}
+
+func TestTargetMeasure(t *testing.T) {
+	data, err := os.ReadFile(filepath.Join("..", "..", "bill-version-samples-september-2024", "BILLS-115hr1eas2.xml"))
+	if err != nil {
+		t.Fatalf("failed to read sample: %v", err)
+	}
+	doc, err := ParseDocument(data)
+	if err != nil {
+		t.Fatalf("failed to parse: %v", err)
+	}
+	amdt := doc.(*EngrossedAmendment)
+	want := TargetMeasure{Congress: "115", Type: "hr", Number: "1"}
+
+	// Each source is used when those before it are missing.
+	for _, step := range []struct {
+		name  string
+		strip func()
+	}{
+		{"citableAs", func() {}},
+		{"dc:title", func() { amdt.AmendMeta.CitableAs = nil }},
+		{"slug line", func() { amdt.AmendMeta.DCTitle = "" }},
+		{"instruction", func() { amdt.AmendPreface.SlugLine = "" }},
+	} {
+		step.strip()
+		got := amdt.GetTargetMeasure()
+		if got == nil || *got != want {
+			t.Errorf("%s: got %+v, want %+v", step.name, got, want)
+		}
+	}
+	amdt.AmendMain = nil
+	if got := amdt.GetTargetMeasure(); got != nil {
+		t.Errorf("got %+v with no sources", got)
+	}
+
+	data, err = os.ReadFile(filepath.Join("..", "..", "bill-version-samples-september-2024", "BILLS-116hr1865eah.xml"))
+	if err != nil {
+		t.Fatalf("failed to read sample: %v", err)
+	}
+	doc, err = ParseDocument(data)
+	if err != nil {
+		t.Fatalf("failed to parse: %v", err)
+	}
+	target := doc.(AmendmentDocument).GetTargetMeasure()
+	if target == nil || target.Citation() != "H.R. 1865" || target.Identifier() != "/us/bill/116/hr/1865" {
+		t.Fatalf("got %+v", target)
+	}
+
+	if got := (TargetMeasure{Congress: "116", Type: "sjres", Number: "4"}); got.Citation() != "S.J. Res. 4" || got.Identifier() != "/us/resolution/116/sjres/4" {
+		t.Errorf("got %q and %q", got.Citation(), got.Identifier())
+	}
+	if got := citationType("H. Con. Res."); got != "hconres" {
+		t.Errorf("got %q", got)
+	}
+}
+
+func TestScanFilters(t *testing.T) {
+	dir := filepath.Join("..", "..", "bill-version-samples-september-2024")
+	filters := []ScanFilter{
+		ScanCongress("116", "118"),
+		ScanChamber("senate"),
+		ScanActionDates(time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2019, 12, 31, 0, 0, 0, 0, time.UTC)),
+	}
+
+	// The files accepted on their headers are those accepted on a full parse.
+	want := map[string]bool{}
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		t.Fatalf("failed to read samples: %v", err)
+	}
+	for _, e := range entries {
+		if ok, _ := filepath.Match("*.[xX][mM][lL]", e.Name()); !ok {
+			continue
+		}
+		data, err := os.ReadFile(filepath.Join(dir, e.Name()))
+		if err != nil {
+			t.Fatalf("failed to read sample: %v", err)
+		}
+		doc, err := ParseDocument(data)
+		if err != nil {
+			continue
+		}
+		accepted := true
+		for _, accept := range filters {
+			accepted = accepted && accept(doc)
+		}
+		if accepted {
+			want[e.Name()] = true
+		}
+	}
+	if len(want) == 0 {
+		t.Fatal("expected some samples to match")
+	}
+
+	got := map[string]bool{}
+	for r := range ScanCorpus(context.Background(), os.DirFS(dir), ScanOptions{Pattern: "*.[xX][mM][lL]", Filters: filters}) {
+		if r.Err != nil {
+			t.Errorf("%s: %v", r.Path, r.Err)
+			continue
+		}
+		got[r.Path] = true
+		if h, ok := r.Document.(HierarchicalDocument); ok && len(h.GetSections()) == 0 && len(Provisions(r.Document)) == 0 {
+			t.Errorf("%s: expected a full parse", r.Path)
+		}
+	}
+	if len(got) != len(want) {
+		t.Errorf("got %d files, want %d", len(got), len(want))
+	}
+	for name := range want {
+		if !got[name] {
+			t.Errorf("missing %s", name)
+		}
+	}
+
+	var n int
+	for r := range ScanCorpus(context.Background(), os.DirFS(dir), ScanOptions{Pattern: "*.[xX][mM][lL]", MetadataOnly: true, Filters: []ScanFilter{ScanSponsor("s221"), ScanStage("committee discharged senate")}}) {
+		n++
+		if r.Path != "BILLS-114s32cds.xml" {
+			t.Errorf("got %s", r.Path)
+		}
+		if len(r.Document.(*Bill).GetSections()) != 0 || len(r.Document.(*Bill).GetSponsors()) == 0 {
+			t.Errorf("%s: expected only the header", r.Path)
+		}
+	}
+	if n != 1 {
+		t.Errorf("got %d files for the sponsor", n)
+	}
+}
+
+func TestCharsets(t *testing.T) {
+	bill := func(decl, title string) []byte {
+		return []byte(decl + `<bill xmlns="http://schemas.gpo.gov/xml/uslm" xmlns:dc="http://purl.org/dc/elements/1.1/"><meta><dc:title>` + title + `</dc:title></meta></bill>`)
+	}
+	tests := []struct {
+		name string
+		data []byte
+		want string
+	}{
+		{"declared ISO-8859-1", bill(`<?xml version="1.0" encoding="ISO-8859-1"?>`, "Caf\xe9 \x93Act\x94"), "Café “Act”"},
+		{"declared windows-1252", bill(`<?xml version='1.0' encoding='windows-1252'?>`, "Fees \x80 5"), "Fees € 5"},
+		{"undeclared Windows-1252 bytes", bill("", "Caf\xe9 \x96 na\xefve"), "Café – naïve"},
+		{"Windows-1252 bytes in declared UTF-8", bill(`<?xml version="1.0" encoding="UTF-8"?>`, "\x93Act\x94 “Act”"), "“Act” “Act”"},
+		{"UTF-8", bill("\xef\xbb\xbf", "Café — naïve"), "Café — naïve"},
+	}
+	for _, tt := range tests {
+		doc, err := ParseDocument(tt.data)
+		if err != nil {
+			t.Errorf("%s: %v", tt.name, err)
+			continue
+		}
+		if got := doc.GetTitle(); got != tt.want {
+			t.Errorf("%s: got %q, want %q", tt.name, got, tt.want)
+		}
+
+		// Multi-byte sequences split across reads are kept whole.
+		doc, err = ParseDocumentMeta(iotest.OneByteReader(bytes.NewReader(tt.data)))
+		if err != nil {
+			t.Errorf("%s: %v", tt.name, err)
+			continue
+		}
+		if got := doc.GetTitle(); got != tt.want {
+			t.Errorf("%s: got %q from one-byte reads, want %q", tt.name, got, tt.want)
+		}
+	}
+
+	if _, err := ParseDocument(bill(`<?xml version="1.0" encoding="Shift_JIS"?>`, "x")); err == nil || !strings.Contains(err.Error(), "Shift_JIS") {
+		t.Errorf("expected an unsupported encoding error, got %v", err)
+	}
+}
+
+func TestPreserveLexical(t *testing.T) {
+	src := `<?xml version="1.0" encoding="UTF-8"?>
+<!DOCTYPE bill [<!ENTITY agency "Environmental Protection Agency">]>
+<bill xmlns="http://schemas.gpo.gov/xml/uslm" xmlns:dc="http://purl.org/dc/elements/1.1/"><meta><dc:title>The &agency; Act of &quot;2024&quot;</dc:title></meta>` +
+		`<main><section><content><![CDATA[if a < b & c]]> then the &agency; shall act</content></section></main></bill>`
+	doc, err := ParseDocument([]byte(src))
+	if err != nil {
+		t.Fatalf("failed to parse: %v", err)
+	}
+
+	plain, err := MarshalDocumentToXML(doc)
+	if err != nil {
+		t.Fatalf("failed to marshal: %v", err)
+	}
+	if bytes.Contains(plain, []byte("CDATA")) || bytes.Contains(plain, []byte("&agency;")) {
+		t.Errorf("expected plain escaping by default, got %s", plain)
+	}
+
+	out, err := MarshalDocumentToXMLWithOptions(doc, XMLOptions{PreserveLexical: true})
+	if err != nil {
+		t.Fatalf("failed to marshal: %v", err)
+	}
+	for _, want := range []string{
+		`<!DOCTYPE bill [<!ENTITY agency "Environmental Protection Agency">]>`,
+		`<dc:title>The &agency; Act of &quot;2024&quot;</dc:title>`,
+		`<content><![CDATA[if a < b & c]]> then the &agency; shall act</content>`,
+	} {
+		if !bytes.Contains(out, []byte(want)) {
+			t.Errorf("missing %s in\n%s", want, out)
+		}
+	}
+
+	again, err := ParseDocument(out)
+	if err != nil {
+		t.Fatalf("failed to reparse: %v", err)
+	}
+	if again.GetTitle() != doc.GetTitle() || ExtractText(again, DefaultNormalizeOptions()) != ExtractText(doc, DefaultNormalizeOptions()) {
+		t.Errorf("text changed in round trip: %q", again.GetTitle())
+	}
+
+	// In a declared single-byte encoding, forms after non-ASCII text are
+	// found in the converted input.
+	latin := "<?xml version=\"1.0\" encoding=\"ISO-8859-1\"?>\n" +
+		`<bill xmlns="http://schemas.gpo.gov/xml/uslm"><main><section><content>Caf` + "\xe9 \x93Act\x94 " + `<![CDATA[x < y]]> &amp; more</content></section></main></bill>`
+	doc, err = ParseDocument([]byte(latin))
+	if err != nil {
+		t.Fatalf("failed to parse ISO-8859-1: %v", err)
+	}
+	out, err = MarshalDocumentToXMLWithOptions(doc, XMLOptions{PreserveLexical: true})
+	if err != nil {
+		t.Fatalf("failed to marshal: %v", err)
+	}
+	if want := "<content>Café “Act” <![CDATA[x < y]]> &amp; more</content>"; !bytes.Contains(out, []byte(want)) {
+		t.Errorf("missing %s in\n%s", want, out)
+	}
+
+	// Documents without such forms, or not parsed from XML, are unchanged.
+	data, err := os.ReadFile(filepath.Join("..", "..", "bill-version-samples-september-2024", "BILLS-114s32cds.xml"))
+	if err != nil {
+		t.Fatalf("failed to read sample: %v", err)
+	}
+	sample, err := ParseDocument(data)
+	if err != nil {
+		t.Fatalf("failed to parse: %v", err)
+	}
+	for _, d := range []LegislativeDocument{sample, &Bill{Meta: &Meta{DCTitle: `"Quoted"`}}} {
+		a, _ := MarshalDocumentToXML(d)
+		b, err := MarshalDocumentToXMLWithOptions(d, XMLOptions{PreserveLexical: true})
+		if err != nil || !bytes.Equal(a, b) {
+			t.Errorf("%s: output changed (%v)", d.GetTitle(), err)
+		}
+	}
+}
+
+func TestXMLFormatting(t *testing.T) {
+	data, err := os.ReadFile(filepath.Join("..", "..", "bill-version-samples-september-2024", "BILLS-114s32cds.xml"))
+	if err != nil {
+		t.Fatalf("failed to read sample: %v", err)
+	}
+	doc, err := ParseDocument(data)
+	if err != nil {
+		t.Fatalf("failed to parse: %v", err)
+	}
+	// Formatting must not change the text the default output reads back as.
+	plain, _ := MarshalDocumentToXML(doc)
+	base, err := ParseDocument(plain)
+	if err != nil {
+		t.Fatalf("failed to reparse: %v", err)
+	}
+	want := ExtractText(base, DefaultNormalizeOptions())
+	reparse := func(name string, out []byte) {
+		t.Helper()
+		again, err := ParseDocument(out)
+		if err != nil {
+			t.Fatalf("%s: failed to reparse: %v", name, err)
+		}
+		if got := ExtractText(again, DefaultNormalizeOptions()); got != want {
+			t.Errorf("%s: text changed in round trip", name)
+		}
+	}
+
+	defaults, err := MarshalDocumentToXMLWithOptions(doc, XMLOptions{})
+	if err != nil || !bytes.Equal(plain, defaults) {
+		t.Errorf("expected default options to match MarshalDocumentToXML (%v)", err)
+	}
+
+	tabs, err := MarshalDocumentToXMLWithOptions(doc, XMLOptions{Indent: "\t"})
+	if err != nil {
+		t.Fatalf("failed to marshal: %v", err)
+	}
+	if !bytes.Contains(tabs, []byte("\n\t<")) || bytes.Contains(tabs, []byte("\n  <")) {
+		t.Errorf("expected tab indentation")
+	}
+	reparse("indent", tabs)
+
+	compact, err := MarshalDocumentToXMLWithOptions(doc, XMLOptions{Compact: true, WrapAttributes: true})
+	if err != nil {
+		t.Fatalf("failed to marshal: %v", err)
+	}
+	if n := bytes.Count(compact, []byte("\n")); n > 2 {
+		t.Errorf("expected compact output, got %d line breaks", n)
+	}
+	// Without line breaks between elements, words of adjacent elements run
+	// together in extracted text; the characters must still match.
+	again, err := ParseDocument(compact)
+	if err != nil {
+		t.Fatalf("compact: failed to reparse: %v", err)
+	}
+	squeeze := func(s string) string { return strings.Join(strings.Fields(s), "") }
+	if squeeze(ExtractText(again, DefaultNormalizeOptions())) != squeeze(want) {
+		t.Errorf("compact: text changed in round trip")
+	}
+
+	wrapped, err := MarshalDocumentToXMLWithOptions(doc, XMLOptions{WrapAttributes: true})
+	if err != nil {
+		t.Fatalf("failed to marshal: %v", err)
+	}
+	for _, line := range strings.Split(string(wrapped), "\n") {
+		trimmed := strings.TrimSpace(line)
+		if strings.HasPrefix(trimmed, "<") && !strings.HasPrefix(trimmed, "<?") && strings.Count(trimmed, `="`) > 1 {
+			t.Errorf("expected one attribute per line, got %q", line)
+			break
+		}
+	}
+	reparse("wrap attributes", wrapped)
+
+	const max = 80
+	long, err := MarshalDocumentToXMLWithOptions(doc, XMLOptions{MaxLineLength: max})
+	if err != nil {
+		t.Fatalf("failed to marshal: %v", err)
+	}
+	for _, line := range strings.Split(string(long), "\n") {
+		if utf8.RuneCountInString(line) > max && strings.Contains(strings.TrimSpace(line), " ") {
+			t.Errorf("line longer than %d characters: %q", max, line)
+			break
+		}
+	}
+	reparse("max line length", long)
+}
+
+func TestUnmodeledAttributesEverywhere(t *testing.T) {
+	const doc = `<?xml version="1.0" encoding="UTF-8"?>
+<bill xmlns="http://schemas.gpo.gov/xml/uslm" xmlns:dc="http://purl.org/dc/elements/1.1/" id="b1" role="enrolled">
+<meta annotation="m"><dc:title>A bill</dc:title><dc:type>Senate Bill</dc:type><congress>118</congress><property name="x" role="p">1</property></meta>
+<preface style="-uslm-lc:I1"><congress value="118" style="c">118th CONGRESS</congress><action role="a"><actionDescription><sponsor senateId="S001" style="s">Mr. Smith</sponsor></actionDescription></action></preface>
+<main><longTitle role="lt"><docTitle>A BILL</docTitle></longTitle>
+<section><content>The <quotedText style="t">term</quotedText> and <ref href="/us/usc/t5/s1" role="r">5 U.S.C. 1</ref>.</content></section>
+</main>
+</bill>`
+	parsed, err := ParseDocumentWithOptions([]byte(doc), ParseOptions{Strict: true})
+	if err != nil {
+		t.Fatalf("failed to parse in strict mode: %v", err)
+	}
+	bill := parsed.(*Bill)
+	content := bill.Main.Sections[0].Content
+	for _, c := range []struct {
+		name  string
+		attrs Attributes
+		local string
+		want  string
+	}{
+		{"bill", bill.Attrs, "role", "enrolled"},
+		{"meta", bill.Meta.Attrs, "annotation", "m"},
+		{"property", bill.Meta.Properties[0].Attrs, "role", "p"},
+		{"preface", bill.Preface.Attrs, "style", "-uslm-lc:I1"},
+		{"congress", bill.Preface.Congress.Attrs, "style", "c"},
+		{"action", bill.Preface.Actions[0].Attrs, "role", "a"},
+		{"sponsor", bill.Preface.Actions[0].ActionDescription.Sponsors[0].Attrs, "style", "s"},
+		{"longTitle", bill.Main.LongTitle.Attrs, "role", "lt"},
+		{"quotedText", content.QuotedText[0].Attrs, "style", "t"},
+		{"ref", content.Ref[0].Attrs, "role", "r"},
+	} {
+		if got := c.attrs.Get(c.local); got != c.want {
+			t.Errorf("%s: %s = %q, want %q", c.name, c.local, got, c.want)
+		}
+	}
+
+	out, err := MarshalDocumentToXML(bill)
+	if err != nil {
+		t.Fatalf("failed to marshal: %v", err)
+	}
+	for _, want := range []string{`id="b1"`, `role="enrolled"`, `annotation="m"`, `style="-uslm-lc:I1"`, `style="s"`, `role="lt"`, `style="t"`, `role="r"`} {
+		if !bytes.Contains(out, []byte(want)) {
+			t.Errorf("missing %s in XML output", want)
+		}
+	}
+
+	data, err := ToJSON(bill)
+	if err != nil {
+		t.Fatalf("failed to marshal to JSON: %v", err)
+	}
+	var again Bill
+	if err := json.Unmarshal(data, &again); err != nil {
+		t.Fatalf("failed to parse JSON: %v", err)
+	}
+	if again.Attrs.Get("role") != "enrolled" || again.Preface.Actions[0].ActionDescription.Sponsors[0].Attrs.Get("style") != "s" {
+		t.Errorf("attributes not preserved in JSON: %s", data)
+	}
+}
+
+func TestOutline(t *testing.T) {
+	data, err := os.ReadFile(filepath.Join("..", "..", "bill-version-samples-september-2024", "BILLS-114s32cds.xml"))
+	if err != nil {
+		t.Fatalf("failed to read sample: %v", err)
+	}
+	doc, err := ParseDocument(data)
+	if err != nil {
+		t.Fatalf("failed to parse: %v", err)
+	}
+	outline := Outline(doc)
+	if len(outline) != 3 {
+		t.Fatalf("expected 3 top-level entries, got %d", len(outline))
+	}
+	first := outline[0]
+	if first.Element != "section" || first.Num != "SECTION 1." || first.Heading != "SHORT TITLE." || first.Identifier != "/us/bill/114/s/32/s1" {
+		t.Errorf("unexpected first entry: %+v", first)
+	}
+	entry := FindOutlineEntry(outline, "/us/bill/114/s/32/s3/2/A")
+	if entry == nil || entry.Element != "subparagraph" || entry.Num != "(A)" {
+		t.Errorf("unexpected entry for s3/2/A: %+v", entry)
+	}
+	if FindOutlineEntry(outline, "/us/bill/114/s/32/s9") != nil {
+		t.Error("expected no entry for a missing identifier")
+	}
+
+	// The outline carries no body text.
+	full, _ := json.Marshal(doc)
+	out, _ := json.Marshal(outline)
+	if len(out)*4 > len(full) || bytes.Contains(out, []byte("Transnational Drug Trafficking Act")) {
+		t.Errorf("expected a much smaller outline without text: %d vs %d bytes", len(out), len(full))
+	}
+
+	// Levels without identifiers get derived or positional addresses.
+	const src = `<bill xmlns="http://schemas.gpo.gov/xml/uslm" xmlns:dc="http://purl.org/dc/elements/1.1/">
+<meta><dc:title>A bill</dc:title><dc:type>House Bill</dc:type><citableAs>118hr5ih</citableAs></meta>
+<main><section><num value="1">SEC. 1.</num><heading>Short <inline class="smallCaps">title</inline></heading><subsection><num value="a">(a)</num></subsection></section>
+<section><num>SEC. 2.</num><paragraph><num value="1">(1)</num></paragraph></section></main>
+</bill>`
+	doc, err = ParseDocument([]byte(src))
+	if err != nil {
+		t.Fatalf("failed to parse: %v", err)
+	}
+	outline = Outline(doc)
+	var got []string
+	for _, e := range outline {
+		e.Walk(func(e *OutlineEntry) bool {
+			got = append(got, e.Identifier)
+			return true
+		})
+	}
+	want := []string{"/us/bill/118/hr/5/s1", "/us/bill/118/hr/5/s1/a", "#s2", "#s2_1"}
+	if fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Errorf("identifiers = %v, want %v", got, want)
+	}
+	if outline[0].Heading != "Short title" {
+		t.Errorf("heading = %q", outline[0].Heading)
+	}
+}
+
+func TestSectionJSON(t *testing.T) {
+	data, err := os.ReadFile(filepath.Join("..", "..", "bill-version-samples-september-2024", "BILLS-114s32cds.xml"))
+	if err != nil {
+		t.Fatalf("failed to read sample: %v", err)
+	}
+	bill, err := ParseBill(data)
+	if err != nil {
+		t.Fatalf("failed to parse: %v", err)
+	}
+
+	for i := range bill.Main.Sections {
+		sec := &bill.Main.Sections[i]
+		out, err := SectionToJSON(sec)
+		if err != nil {
+			t.Fatalf("failed to convert section to JSON: %v", err)
+		}
+		again, err := SectionFromJSON(out)
+		if err != nil {
+			t.Fatalf("failed to parse section from JSON: %v", err)
+		}
+		if again.Identifier != sec.Identifier || again.GetNum() != sec.GetNum() || again.GetHeading() != sec.GetHeading() {
+			t.Errorf("section %d: header changed in round trip", i)
+		}
+		if len(again.Paragraphs) != len(sec.Paragraphs) {
+			t.Errorf("section %d: expected %d paragraphs, got %d", i, len(sec.Paragraphs), len(again.Paragraphs))
+		}
+		before, _ := json.Marshal(Outline(&Bill{Main: &Main{Sections: []Section{*sec}}}))
+		after, _ := json.Marshal(Outline(&Bill{Main: &Main{Sections: []Section{*again}}}))
+		if !bytes.Equal(before, after) {
+			t.Errorf("section %d: nested levels changed in round trip", i)
+		}
+	}
+
+	if _, err := SectionToJSON(nil); err == nil {
+		t.Error("expected an error for a nil section")
+	}
+	if _, err := SectionFromJSON([]byte("[")); err == nil {
+		t.Error("expected an error for invalid JSON")
+	}
+}
+
+func TestSplitAssemble(t *testing.T) {
+	dir := filepath.Join("..", "..", "bill-version-samples-september-2024")
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		t.Fatalf("failed to read samples: %v", err)
+	}
+	for _, e := range entries {
+		data, err := os.ReadFile(filepath.Join(dir, e.Name()))
+		if err != nil {
+			t.Fatalf("failed to read sample: %v", err)
+		}
+		doc, err := ParseDocument(data)
+		if err != nil {
+			continue
+		}
+		want, err := MarshalDocumentToXML(doc)
+		if err != nil {
+			t.Fatalf("%s: failed to marshal: %v", e.Name(), err)
+		}
+		fragments, err := Split(doc)
+		if err != nil {
+			t.Fatalf("%s: failed to split: %v", e.Name(), err)
+		}
+		// Fragments survive JSON and may arrive in any order.
+		encoded, err := json.Marshal(fragments)
+		if err != nil {
+			t.Fatalf("%s: failed to marshal fragments: %v", e.Name(), err)
+		}
+		var decoded []Fragment
+		if err := json.Unmarshal(encoded, &decoded); err != nil {
+			t.Fatalf("%s: failed to parse fragments: %v", e.Name(), err)
+		}
+		for i, j := 0, len(decoded)-1; i < j; i, j = i+1, j-1 {
+			decoded[i], decoded[j] = decoded[j], decoded[i]
+		}
+		// JSON does not keep the source's namespace declarations, so the
+		// decoded fragments are compared with the document after the same
+		// round trip.
+		whole, _ := json.Marshal(doc)
+		viaJSON, err := DocumentFromJSON(whole, DetectDocumentType(data))
+		if err != nil {
+			t.Fatalf("%s: failed to parse JSON: %v", e.Name(), err)
+		}
+		wantJSON, _ := MarshalDocumentToXML(viaJSON)
+		for _, c := range []struct {
+			frags []Fragment
+			want  []byte
+		}{{fragments, want}, {decoded, wantJSON}} {
+			frags, want := c.frags, c.want
+			assembled, err := Assemble(frags)
+			if err != nil {
+				t.Fatalf("%s: failed to assemble: %v", e.Name(), err)
+			}
+			got, err := MarshalDocumentToXML(assembled)
+			if err != nil {
+				t.Fatalf("%s: failed to marshal assembled document: %v", e.Name(), err)
+			}
+			if !bytes.Equal(got, want) {
+				t.Errorf("%s: assembled document differs from the original", e.Name())
+			}
+		}
+	}
+
+	data, err := os.ReadFile(filepath.Join(dir, "BILLS-110s2062ris.xml"))
+	if err != nil {
+		t.Fatalf("failed to read sample: %v", err)
+	}
+	bill, err := ParseBill(data)
+	if err != nil {
+		t.Fatalf("failed to parse: %v", err)
+	}
+	fragments, err := Split(bill)
+	if err != nil {
+		t.Fatalf("failed to split: %v", err)
+	}
+	sections := len(bill.Main.Sections)
+	for _, title := range bill.Main.Titles {
+		sections += len(title.Sections)
+	}
+	if len(fragments) != sections {
+		t.Errorf("expected %d fragments, got %d", sections, len(fragments))
+	}
+	// Each fragment is a document of its own with the source's metadata.
+	last := fragments[len(fragments)-1].Document.(*Bill)
+	if last.GetTitle() != bill.GetTitle() || len(Provisions(last)) != 1 || last.Main.TOC != nil {
+		t.Errorf("unexpected last fragment: %q, %d provisions", last.GetTitle(), len(Provisions(last)))
+	}
+	if len(bill.Main.Titles) == 0 || fragments[0].TitleIndex != 0 {
+		t.Errorf("expected the first fragment to be in the first title, got %d", fragments[0].TitleIndex)
+	}
+
+	if _, err := Assemble(fragments[1:]); err == nil {
+		t.Error("expected an error for a missing fragment")
+	}
+	if _, err := Assemble(nil); err == nil {
+		t.Error("expected an error for no fragments")
+	}
+}
+
+func TestProvenance(t *testing.T) {
+	data, err := os.ReadFile(filepath.Join("..", "..", "bill-version-samples-september-2024", "BILLS-114s32cds.xml"))
+	if err != nil {
+		t.Fatalf("failed to read sample bill: %v", err)
+	}
+	bill, err := ParseBill(data)
+	if err != nil {
+		t.Fatalf("failed to parse: %v", err)
+	}
+	steps := bill.GetProvenance()
+	if len(steps) != 1 || steps[0].System != "GPO XPub Bill to USLM Generator, version 0.5 + manual changes" || steps[0].Date != "2024-09-09" {
+		t.Fatalf("unexpected provenance: %+v", steps)
+	}
+	if got := steps[0].Time(); got.Year() != 2024 || got.Month() != 9 || got.Day() != 9 {
+		t.Errorf("unexpected processing time: %v", got)
+	}
+	if bill.Meta.Processing != nil {
+		t.Errorf("expected no processing history for a single step, got %+v", bill.Meta.Processing)
+	}
+
+	const doc = `<?xml version="1.0" encoding="UTF-8"?>
+<bill xmlns="http://schemas.gpo.gov/xml/uslm" xmlns:dc="http://purl.org/dc/elements/1.1/">
+<meta><dc:title>A bill</dc:title><processedBy>Drafting System</processedBy><processedDate>2024-01-02</processedDate><processedBy>GPO Access</processedBy><processedDate>January 5, 2024</processedDate></meta>
+<main><section><content>Text.</content></section></main>
+</bill>`
+	parsed, err := ParseDocument([]byte(doc))
+	if err != nil {
+		t.Fatalf("failed to parse: %v", err)
+	}
+	want := []ProcessingStep{{System: "Drafting System", Date: "2024-01-02"}, {System: "GPO Access", Date: "January 5, 2024"}}
+	check := func(name string, d LegislativeDocument) {
+		t.Helper()
+		md := d.(MetadataDocument)
+		got := md.GetProvenance()
+		if len(got) != len(want) || got[0] != want[0] || got[1] != want[1] {
+			t.Errorf("%s: expected %+v, got %+v", name, want, got)
+		}
+		if md.GetProcessedBy() != "Drafting System" || md.GetProcessedDate() != "2024-01-02" {
+			t.Errorf("%s: expected the first step, got %q, %q", name, md.GetProcessedBy(), md.GetProcessedDate())
+		}
+	}
+	check("parsed", parsed)
+	if got := parsed.(*Bill).GetProvenance()[1].Time(); got.Month() != 1 || got.Day() != 5 {
+		t.Errorf("unexpected processing time: %v", got)
+	}
+
+	data, err = MarshalDocumentToXML(parsed)
+	if err != nil {
+		t.Fatalf("failed to marshal: %v", err)
+	}
+	if strings.Count(string(data), "<processedBy>") != 2 || !strings.Contains(string(data), "<dc:title>") {
+		t.Errorf("expected both steps in the XML:\n%s", data)
+	}
+	reparsed, err := ParseDocument(data)
+	if err != nil {
+		t.Fatalf("failed to reparse: %v", err)
+	}
+	check("XML round trip", reparsed)
+
+	jsonData, err := ToJSON(parsed)
+	if err != nil {
+		t.Fatalf("failed to marshal JSON: %v", err)
+	}
+	fromJSON, err := DocumentFromJSON(jsonData, DocumentTypeBill)
+	if err != nil {
+		t.Fatalf("failed to unmarshal JSON: %v", err)
+	}
+	check("JSON round trip", fromJSON)
+}
+
+func TestRenditionText(t *testing.T) {
+	data, err := os.ReadFile(filepath.Join("..", "..", "bill-version-samples-september-2024", "BILLS-110s2062ris.xml"))
+	if err != nil {
+		t.Fatalf("failed to read sample bill: %v", err)
+	}
+	bill, err := ParseBill(data)
+	if err != nil {
+		t.Fatalf("failed to parse: %v", err)
+	}
+
+	// Lay the printed text out as text extracted from the PDF would be:
+	// numbered lines, pages with running heads, and hyphenated words.
+	var pdf []string
+	for i, line := range strings.Split(strings.TrimSpace(ToPrintText(bill, PrintOptions{})), "\n") {
+		if i > 0 && i%25 == 0 {
+			pdf = append(pdf, "VerDate Sep 11 2014 Jkt 000000 PO 00000 Frm 00002", "•S 2062 RIS", fmt.Sprint(i/25+1))
+		}
+		pdf = append(pdf, fmt.Sprintf("%2d %s", i%25+1, line))
+	}
+	rendition := strings.Join(pdf, "\n")
+	rendition = strings.Replace(rendition, "housing", "hous-\n 7 ing", 1)
+	rendition = strings.Replace(rendition, "“", "``", 1)
+
+	c := CompareRenditionText(bill, rendition)
+	if c.ExtractedWords == 0 || c.Coverage() != 1 || c.Truncated {
+		t.Fatalf("expected the print text to cover the extracted text, got %d of %d words: %+v", c.MatchingWords, c.ExtractedWords, c.Divergences)
+	}
+	for _, d := range c.Divergences {
+		if d.Extracted != "" || strings.Contains(d.Rendition, "VerDate") || strings.Contains(d.Rendition, "RIS") {
+			t.Errorf("unexpected divergence: %+v", d)
+		}
+	}
+
+	// Dropping a word from the rendition is reported where it occurs.
+	dropped := strings.Replace(rendition, "benefit 1 Indian tribe", "benefit Indian tribe", 1)
+	c = CompareRenditionText(bill, dropped)
+	found := false
+	for _, d := range c.Divergences {
+		if d.Extracted == "1" && d.Rendition == "" {
+			found = true
+		}
+	}
+	if !found || c.Coverage() >= 1 {
+		t.Errorf("expected the dropped word to be reported, got %+v", c.Divergences)
+	}
+
+	if got := NormalizeRenditionText("[Congressional Bills 110th Congress]\n<DOC>\nthe ``Act'' to pro-\nvide\n</DOC>"); got != `the "Act" to provide` {
+		t.Errorf("unexpected normalized rendition: %q", got)
+	}
+	c = compareTexts("a b c d e", "a x c d f e")
+	if c.MatchingWords != 4 || len(c.Divergences) != 2 || c.Divergences[0].Extracted != "b" || c.Divergences[0].Rendition != "x" || c.Divergences[1].Rendition != "f" {
+		t.Errorf("unexpected comparison: %+v", c)
+	}
+}
+
+func TestConcordance(t *testing.T) {
+	data, err := os.ReadFile(filepath.Join("..", "..", "bill-version-samples-september-2024", "BILLS-110s2062ris.xml"))
+	if err != nil {
+		t.Fatalf("failed to read sample bill: %v", err)
+	}
+	bill, err := ParseBill(data)
+	if err != nil {
+		t.Fatalf("failed to parse: %v", err)
+	}
+
+	entries := Concordance(bill, "Indian tribe")
+	if len(entries) == 0 {
+		t.Fatal("expected occurrences of \"Indian tribe\"")
+	}
+	for _, e := range entries {
+		if !strings.EqualFold(e.Match, "Indian tribe") || e.Citation == "" || e.Provision == nil {
+			t.Errorf("unexpected entry: %+v", e)
+		}
+		if len(strings.Fields(e.Before)) > 8 || len(strings.Fields(e.After)) > 8 {
+			t.Errorf("expected at most 8 words of context, got %q", e.String())
+		}
+		if !strings.Contains(NormalizeText(e.Provision.GetHeading()+" "+e.Provision.GetText(), DefaultNormalizeOptions()), e.Match) {
+			t.Errorf("expected the match in the provision's text: %q", e.String())
+		}
+	}
+	if got := Concordance(bill, "  indian\n TRIBE "); len(got) != len(entries) {
+		t.Errorf("expected case and whitespace to be ignored, got %d of %d", len(got), len(entries))
+	}
+
+	// Whole words only: "Indian trib" is not a word.
+	if got := Concordance(bill, "Indian trib"); len(got) != 0 {
+		t.Errorf("expected no partial-word matches, got %v", got[0])
+	}
+	if got := Concordance(bill, ""); got != nil {
+		t.Errorf("expected nil for an empty term, got %d entries", len(got))
+	}
+	plural := Concordance(bill, "Indian tribes")
+	for _, e := range plural {
+		if !strings.EqualFold(e.Match, "Indian tribes") {
+			t.Errorf("unexpected entry: %+v", e)
+		}
+	}
+}
+
+func TestTitleCaseHeading(t *testing.T) {
+	for in, want := range map[string]string{
+		"SHORT TITLE; TABLE OF CONTENTS.":                 "Short Title; Table of Contents.",
+		"Short title":                                     "Short Title",
+		"In general.—":                                    "In General.—",
+		"TITLE I—GENERAL PROVISIONS":                      "Title I—General Provisions",
+		"SUBTITLE A—AMENDMENTS TO THE U.S. CODE":          "Subtitle A—Amendments to the U.S. Code",
+		"SELF-DETERMINATION AND RIGHT-OF-WAY":             "Self-Determination and Right-of-Way",
+		"REPORTS BY HUD AND THE U.S. AND FOR FISCAL 2024": "Reports by HUD and the U.S. and for Fiscal 2024",
+		"Grants under the FEMA program for eBay":          "Grants Under the FEMA Program for eBay",
+		"  WHAT   THE ACT IS FOR ":                        "What the Act Is For",
+		"":                                                "",
+	} {
+		if got := TitleCaseHeading(in); got != want {
+			t.Errorf("TitleCaseHeading(%q) = %q, want %q", in, got, want)
+		}
+	}
+
+	data, err := os.ReadFile(filepath.Join("..", "..", "bill-version-samples-september-2024", "BILLS-110s2062ris.xml"))
+	if err != nil {
+		t.Fatalf("failed to read sample bill: %v", err)
+	}
+	bill, err := ParseBill(data)
+	if err != nil {
+		t.Fatalf("failed to parse: %v", err)
+	}
+	out := ToHTMLWithOptions(bill, HTMLOptions{TitleCaseHeadings: true})
+	for _, want := range []string{
+		`<span class="heading">Short Title; Table of Contents.</span>`,
+		`<span class="heading">Short Title.—</span>`,
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("expected %s in the HTML", want)
+		}
+	}
+	if strings.Contains(out, "SHORT TITLE; TABLE OF CONTENTS.") {
+		t.Error("expected no capitalized headings in the HTML")
+	}
+}
+
+func TestReconcileMetadata(t *testing.T) {
+	read := func() *Bill {
+		t.Helper()
+		data, err := os.ReadFile(filepath.Join("..", "..", "bill-version-samples-september-2024", "BILLS-110s2062ris.xml"))
+		if err != nil {
+			t.Fatalf("failed to read sample bill: %v", err)
+		}
+		bill, err := ParseBill(data)
+		if err != nil {
+			t.Fatalf("failed to parse: %v", err)
+		}
+		return bill
+	}
+
+	bill := read()
+	if conflicts, err := ReconcileMetadata(bill); err != nil || len(conflicts) != 0 {
+		t.Fatalf("expected the sample to agree with itself, got %v, %v", conflicts, err)
+	}
+
+	// Meta and preface disagree on the congress and the chamber.
+	bill.Meta.Congress, bill.Meta.CurrentChamber = "111", "HOUSE"
+	conflicts, err := ReconcileMetadata(bill)
+	if err != nil {
+		t.Fatalf("failed to reconcile: %v", err)
+	}
+	if len(conflicts) != 2 || conflicts[0].Field != "congress" || conflicts[0].Meta != "111" || conflicts[0].Preface != "110" ||
+		conflicts[1].Field != "currentChamber" || conflicts[1].Resolved {
+		t.Fatalf("unexpected conflicts: %v", conflicts)
+	}
+	if bill.Meta.Congress != "111" || bill.Preface.Congress.Value != "110" {
+		t.Error("expected the report-only policy to leave the document alone")
+	}
+
+	if _, err := ReconcileMetadataWithPolicy(bill, MetadataPreferMeta); err != nil {
+		t.Fatalf("failed to reconcile: %v", err)
+	}
+	if bill.Preface.Congress.Value != "111" || bill.Preface.Congress.Text != "111TH CONGRESS" ||
+		bill.Preface.CurrentChamber.Value != "HOUSE" || bill.Preface.CurrentChamber.Text != "IN THE HOUSE OF REPRESENTATIVES" {
+		t.Errorf("expected the preface to follow the metadata, got %+v, %+v", bill.Preface.Congress, bill.Preface.CurrentChamber)
+	}
+	if conflicts, _ := ReconcileMetadata(bill); len(conflicts) != 0 {
+		t.Errorf("expected no conflicts after reconciling, got %v", conflicts)
+	}
+
+	bill = read()
+	bill.Meta.Session, bill.Meta.DCTitle = "2", "110 S 2062 RIS: A different title."
+	conflicts, err = ReconcileMetadataWithPolicy(bill, MetadataPreferPreface)
+	if err != nil || len(conflicts) != 2 || !conflicts[0].Resolved || conflicts[1].Meta != "110 S 2062 RIS: A different title." {
+		t.Fatalf("unexpected conflicts: %v, %v", conflicts, err)
+	}
+	if bill.Meta.Session != "1" || bill.Meta.DCTitle != "110 S 2062 RIS: "+bill.Preface.DCTitle {
+		t.Errorf("expected the metadata to follow the preface, got %q, %q", bill.Meta.Session, bill.Meta.DCTitle)
+	}
+}
+
+func TestLayoutDocument(t *testing.T) {
+	data, err := os.ReadFile(filepath.Join("..", "..", "bill-version-samples-september-2024", "BILLS-110s2062ris.xml"))
+	if err != nil {
+		t.Fatalf("failed to read sample bill: %v", err)
+	}
+	bill, err := ParseBill(data)
+	if err != nil {
+		t.Fatalf("failed to parse: %v", err)
+	}
+
+	layout := LayoutDocument(bill, LayoutOptions{})
+	if len(layout.Pages) < 2 || layout.RunningHead != "•S 2062 RIS" || layout.LinesPerPage != 25 || layout.Width > 72 {
+		t.Fatalf("unexpected layout: %d pages, %q, %d lines, width %d", len(layout.Pages), layout.RunningHead, layout.LinesPerPage, layout.Width)
+	}
+	if first := layout.Pages[0].Lines[0]; first.Number != 0 || !strings.Contains(first.Text, "110TH CONGRESS") {
+		t.Errorf("expected the header block to open the first page, got %+v", first)
+	}
+	var body []string
+	for i, page := range layout.Pages {
+		if page.Number != i+1 {
+			t.Errorf("expected page %d, got %d", i+1, page.Number)
+		}
+		next := 1
+		for _, line := range page.Lines {
+			if line.Number == 0 {
+				continue
+			}
+			if line.Number != next {
+				t.Errorf("page %d: expected line %d, got %d", page.Number, next, line.Number)
+			}
+			next++
+			body = append(body, line.Text)
+		}
+		if next-1 > 25 || (i < len(layout.Pages)-1 && next-1 != 25) {
+			t.Errorf("page %d: unexpected %d numbered lines", page.Number, next-1)
+		}
+	}
+	// The numbered lines are the print text below the header.
+	lines, header := printLines(bill, PrintOptions{})
+	var want []string
+	for _, line := range lines[header:] {
+		if line != "" {
+			want = append(want, line)
+		}
+	}
+	if strings.Join(body, "\n") != strings.Join(want, "\n") {
+		t.Error("expected the numbered lines to be the print text below the header")
+	}
+
+	var text bytes.Buffer
+	if err := (TextLayoutRenderer{}).RenderLayout(&text, layout); err != nil {
+		t.Fatalf("failed to render text: %v", err)
+	}
+	if got := strings.Count(text.String(), "\f"); got != len(layout.Pages)-1 {
+		t.Errorf("expected %d page breaks, got %d", len(layout.Pages)-1, got)
+	}
+
+	pdf, err := ToPDF(bill, LayoutOptions{})
+	if err != nil {
+		t.Fatalf("failed to render PDF: %v", err)
+	}
+	if !bytes.HasPrefix(pdf, []byte("%PDF-1.4\n")) || !bytes.HasSuffix(pdf, []byte("%%EOF\n")) ||
+		!bytes.Contains(pdf, []byte(fmt.Sprintf("/Count %d", len(layout.Pages)))) || !bytes.Contains(pdf, []byte("(\\225S 2062 RIS)")) {
+		t.Fatal("unexpected PDF structure")
+	}
+	// Every cross-reference entry points at its object.
+	i := bytes.LastIndex(pdf, []byte("startxref\n"))
+	xref, err := strconv.Atoi(string(bytes.Fields(pdf[i+len("startxref\n"):])[0]))
+	if err != nil || !bytes.HasPrefix(pdf[xref:], []byte("xref\n")) {
+		t.Fatalf("unexpected startxref: %v", err)
+	}
+	entries := strings.Split(string(pdf[xref:]), "\n")[3:]
+	for n := 1; n <= 4+2*len(layout.Pages); n++ {
+		offset, err := strconv.Atoi(entries[n-1][:10])
+		if err != nil || !bytes.HasPrefix(pdf[offset:], []byte(fmt.Sprintf("%d 0 obj\n", n))) {
+			t.Fatalf("object %d: bad cross-reference entry %q", n, entries[n-1])
+		}
+	}
+}
+
+func TestPrintedPrefaceElements(t *testing.T) {
+	data, err := os.ReadFile(filepath.Join("..", "..", "bill-version-samples-september-2024", "h1058_enr.XML"))
+	if err != nil {
+		t.Fatalf("failed to read sample bill: %v", err)
+	}
+	bill, err := ParseBill(data)
+	if err != nil {
+		t.Fatalf("failed to parse: %v", err)
+	}
+	if got, want := bill.GetDateline(), "Begun and held at the City of Washington on Thursday, the third day of January, two thousand and nineteen"; got != want {
+		t.Errorf("GetDateline() = %q, want %q", got, want)
+	}
+	out, err := MarshalDocumentToXML(bill)
+	if err != nil {
+		t.Fatalf("failed to marshal: %v", err)
+	}
+	if !strings.Contains(string(out), "<enrolledDateline>Begun and held") {
+		t.Errorf("expected the dateline to round-trip, got:\n%s", out)
+	}
+
+	const doc = `<?xml version="1.0" encoding="UTF-8"?>
+<bill xmlns="http://schemas.gpo.gov/xml/uslm" xmlns:dc="http://purl.org/dc/elements/1.1/">
+<meta><dc:title>A bill</dc:title><docPublicationName>Meta Publication</docPublicationName></meta>
+<preface><slugLine>•HR 1 IH</slugLine><leftRunningHead class="smallCaps">Left head</leftRunningHead><rightRunningHead renderingPosition="odd">Right head</rightRunningHead><docPublicationName value="Congressional Bills"/></preface>
+<main><centerRunningHead>Body head</centerRunningHead><section><content>Text.</content></section></main>
+</bill>`
+	parsed, err := ParseBill([]byte(doc))
+	if err != nil {
+		t.Fatalf("failed to parse: %v", err)
+	}
+	if got := parsed.GetPublicationName(); got != "Congressional Bills" {
+		t.Errorf("GetPublicationName() = %q, want the preface value", got)
+	}
+	if got := parsed.Meta.DocPublicationName.GetValue(); got != "Meta Publication" {
+		t.Errorf("meta publication name = %q", got)
+	}
+	if parsed.GetDateline() != "" {
+		t.Errorf("expected no dateline, got %q", parsed.GetDateline())
+	}
+	heads := parsed.GetRunningHeads()
+	var got []string
+	for _, h := range heads {
+		got = append(got, h.Position+":"+h.Text)
+	}
+	if want := "left:Left head,right:Right head,center:Body head"; strings.Join(got, ",") != want {
+		t.Fatalf("running heads = %q, want %q", strings.Join(got, ","), want)
+	}
+	if heads[0].Class != "smallCaps" || heads[1].RenderingPosition != "odd" {
+		t.Errorf("running head attributes not parsed: %+v", heads)
+	}
+
+	out, err = MarshalDocumentToXML(parsed)
+	if err != nil {
+		t.Fatalf("failed to marshal: %v", err)
+	}
+	for _, want := range []string{
+		`<leftRunningHead class="smallCaps">Left head</leftRunningHead>`,
+		`<rightRunningHead renderingPosition="odd">Right head</rightRunningHead>`,
+		`<centerRunningHead>Body head</centerRunningHead>`,
+		`<docPublicationName value="Congressional Bills"></docPublicationName>`,
+		`<docPublicationName>Meta Publication</docPublicationName>`,
+	} {
+		if !strings.Contains(string(out), want) {
+			t.Errorf("expected %s in output:\n%s", want, out)
+		}
+	}
+}
+
+func TestResolver(t *testing.T) {
+	for href, want := range map[string]RefTarget{
+		"/us/bill/116/hr/1058/s2/1":    {Kind: RefBill, Congress: "116", Type: "hr", Number: "1058", Path: "s2/1"},
+		"/us/bill/116/hr/1058/enr/s2":  {Kind: RefBill, Congress: "116", Type: "hr", Number: "1058", Version: "enr", Path: "s2"},
+		"/us/resolution/116/sres/100":  {Kind: RefBill, Congress: "116", Type: "sres", Number: "100"},
+		"/us/pl/116/60/dB/s2":          {Kind: RefPublicLaw, Congress: "116", Number: "60", Path: "dB/s2"},
+		"/us/stat/133/3–5":             {Kind: RefStatute, Volume: "133", Page: "3"},
+		"/us/usc/t42/s1395w/etseq":     {Kind: RefUSC, Title: "42", Path: "s1395w"},
+		"usc/26/4192":                  {Kind: RefUSC, Title: "26", Path: "s4192"},
+		"/us/usc/t42/s1395w/a/1":       {Kind: RefUSC, Title: "42", Path: "s1395w/a/1"},
+		"/us/bill/116/hr/1058/enr/s2/": {Kind: RefBill, Congress: "116", Type: "hr", Number: "1058", Version: "enr", Path: "s2"},
+	} {
+		got, err := ParseRefHref(href)
+		if err != nil || got != want {
+			t.Errorf("ParseRefHref(%q) = %+v, %v; want %+v", href, got, err, want)
+		}
+	}
+	if _, err := ParseRefHref("/us/116/scal/5"); !errors.Is(err, ErrUnsupportedRef) {
+		t.Errorf("expected ErrUnsupportedRef for a calendar href, got %v", err)
+	}
+	law, _ := ParseRefHref("/us/pl/116/60")
+	if law.PackageID() != "PLAW-116publ60" || law.DocumentHref() != "/us/pl/116/60" {
+		t.Errorf("unexpected public law target: %s %s", law.PackageID(), law.DocumentHref())
+	}
+
+	ctx := context.Background()
+	data, err := os.ReadFile(filepath.Join("..", "..", "bill-version-samples-september-2024", "h1058_enr.XML"))
+	if err != nil {
+		t.Fatalf("failed to read sample bill: %v", err)
+	}
+	bill, err := ParseBill(data)
+	if err != nil {
+		t.Fatalf("failed to parse: %v", err)
+	}
+	store := NewMemoryStore()
+	store.Put(ctx, "BILLS-116hr1058enr", bill)
+	store.Put(ctx, "BILLS-116hr1058ih", &Bill{})
+	store.Put(ctx, "BILLS-116hr2000ih", &Bill{})
+	store.Put(ctx, "BILLS-116hr2000rh", &Bill{})
+
+	local := NewStoreResolver(store)
+	doc, p, err := FollowRef(ctx, local, "/us/bill/116/hr/1058/s2/1")
+	if err != nil {
+		t.Fatalf("FollowRef: %v", err)
+	}
+	if doc != LegislativeDocument(bill) {
+		t.Errorf("expected the enrolled version of an unversioned href")
+	}
+	if p == nil || p.Identifier != "/us/bill/116/hr/1058/s2/1" {
+		t.Errorf("unexpected provision: %+v", p)
+	}
+	if _, p, err := FollowRef(ctx, local, "/us/bill/116/hr/1058/enr/s2/1/A/zz"); err != nil || p == nil || p.Identifier != "/us/bill/116/hr/1058/s2/1/A" {
+		t.Errorf("expected the nearest enclosing provision, got %+v, %v", p, err)
+	}
+	if _, err := local.Resolve(ctx, "/us/bill/116/hr/2000"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("expected an ambiguous version to be reported as not found, got %v", err)
+	}
+	if _, err := local.Resolve(ctx, "/us/pl/116/60"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("expected ErrNotFound, got %v", err)
+	}
+
+	var fetched []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fetched = append(fetched, r.URL.RequestURI())
+		if r.URL.Path == "/link/bills/116/hr/1058" {
+			w.Write(data)
+			return
+		}
+		http.NotFound(w, r)
+	}))
+	defer srv.Close()
+	remote := &GovInfoResolver{Client: srv.Client(), BaseURL: srv.URL + "/link/"}
+	if u, _ := remote.URL(law); u != srv.URL+"/link/plaw/116/public/60?link-type=xml" {
+		t.Errorf("unexpected URL %s", u)
+	}
+
+	cached := NewCachingResolver(ChainResolvers(NewStoreResolver(NewMemoryStore()), remote), 4)
+	for _, href := range []string{"/us/bill/116/hr/1058/s1", "/us/bill/116/hr/1058/s2"} {
+		doc, err := cached.Resolve(ctx, href)
+		if err != nil {
+			t.Fatalf("Resolve(%q): %v", href, err)
+		}
+		if doc.GetDocumentNumber() != "1058" {
+			t.Errorf("unexpected document number %s", doc.GetDocumentNumber())
+		}
+	}
+	if want := []string{"/link/bills/116/hr/1058?link-type=xml"}; strings.Join(fetched, ",") != strings.Join(want, ",") {
+		t.Errorf("fetched %v, want %v", fetched, want)
+	}
+	if _, err := cached.Resolve(ctx, "/us/pl/116/60"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("expected ErrNotFound from the chain, got %v", err)
+	}
+}
+
+func TestFindCodificationChanges(t *testing.T) {
+	data, err := os.ReadFile(filepath.Join("..", "..", "bill-version-samples-september-2024", "BILLS-110s2062ris.xml"))
+	if err != nil {
+		t.Fatalf("failed to read sample bill: %v", err)
+	}
+	bill, err := ParseBill(data)
+	if err != nil {
+		t.Fatalf("failed to parse: %v", err)
+	}
+	var found []CodificationChange
+	for _, c := range FindCodificationChanges(bill) {
+		if c.Identifier == "/us/bill/110/s/2062/s3/2" {
+			found = append(found, c)
+		}
+	}
+	if len(found) != 14 {
+		t.Fatalf("expected paragraphs (8) through (21) as 14 redesignations, got %d", len(found))
+	}
+	first, last := found[0], found[13]
+	if first.Kind != AmendingActionRedesignate || first.Target != "/us/usc/t25/s4103" ||
+		first.From.String() != "paragraph (8)" || first.To == nil || first.To.String() != "paragraph (9)" {
+		t.Errorf("unexpected first change: %+v", first)
+	}
+	if last.From.Num != "(21)" || last.To.Num != "(22)" {
+		t.Errorf("unexpected last change: %+v -> %+v", last.From, last.To)
+	}
+
+	const doc = `<?xml version="1.0" encoding="UTF-8"?>
+<bill xmlns="http://schemas.gpo.gov/xml/uslm">
+<main>
+<section identifier="/us/bill/118/hr/1/s1"><num value="1">SECTION 1. </num><chapeau>Section 5 of the Foo Act (<ref href="/us/usc/t42/s5">42 U.S.C. 5</ref>) is amended—</chapeau>
+<paragraph identifier="/us/bill/118/hr/1/s1/1"><num value="1">(1)</num><content>by repealing subsections (b) and (c);</content></paragraph>
+<paragraph identifier="/us/bill/118/hr/1/s1/2"><num value="2">(2)</num><content>in subsection (d), by redesignating clauses (iv) through (vi) as clauses (iii) through (v), respectively; and</content></paragraph>
+<paragraph identifier="/us/bill/118/hr/1/s1/3"><num value="3">(3)</num><content>by redesignating subsection (e) (relating to reports) as subsection (b).</content></paragraph>
+</section>
+<section identifier="/us/bill/118/hr/1/s2"><num value="2">SEC. 2. </num><content>The Bar Act of 1990 is hereby repealed.</content></section>
+<section identifier="/us/bill/118/hr/1/s3"><num value="3">SEC. 3. </num><content>Sections 7 through 9 of title 10 are redesignated as sections 8 through 10, respectively.</content></section>
+</main>
+</bill>`
+	parsed, err := ParseBill([]byte(doc))
+	if err != nil {
+		t.Fatalf("failed to parse: %v", err)
+	}
+	var got []string
+	for _, c := range FindCodificationChanges(parsed) {
+		line := string(c.Kind) + " " + c.From.String()
+		if c.To != nil {
+			line += " -> " + c.To.String()
+		}
+		got = append(got, c.Target+": "+line)
+	}
+	want := []string{
+		"/us/usc/t42/s5: repeal subsection (b)",
+		"/us/usc/t42/s5: repeal subsection (c)",
+		"/us/usc/t42/s5: redesignate clause (iv) -> clause (iii)",
+		"/us/usc/t42/s5: redesignate clause (v) -> clause (iv)",
+		"/us/usc/t42/s5: redesignate clause (vi) -> clause (v)",
+		"/us/usc/t42/s5: redesignate subsection (e) -> subsection (b)",
+		": repeal The Bar Act of 1990",
+		": redesignate section 7 -> section 8",
+		": redesignate section 8 -> section 9",
+		": redesignate section 9 -> section 10",
+	}
+	if strings.Join(got, "\n") != strings.Join(want, "\n") {
+		t.Errorf("unexpected changes:\n%s\nwant:\n%s", strings.Join(got, "\n"), strings.Join(want, "\n"))
+	}
+}
+
+func TestLanguage(t *testing.T) {
+	const doc = `<?xml version="1.0" encoding="UTF-8"?>
+<bill xmlns="http://schemas.gpo.gov/xml/uslm" xml:lang="en">
+<main>
+<section identifier="/us/bill/118/hr/1/s1"><num value="1">SECTION 1. </num><heading>BOUNDARY.</heading><content>The boundary follows the <inline xml:lang="es">Río Bravo del Norte</inline> to the sea.</content></section>
+<section identifier="/us/bill/118/hr/1/s2" xml:lang="es"><num value="2">SEC. 2. </num><heading>TEXTO DEL TRATADO.</heading>
+<subsection identifier="/us/bill/118/hr/1/s2/a"><num value="a">(a)</num><content>Las Partes acuerdan lo siguiente.</content></subsection>
+<subsection identifier="/us/bill/118/hr/1/s2/b" xml:lang="en"><num value="b">(b)</num><content>English translation.</content></subsection>
+</section>
+</main>
+</bill>`
+	bill, err := ParseBill([]byte(doc))
+	if err != nil {
+		t.Fatalf("failed to parse: %v", err)
+	}
+	if got := DocumentLanguage(bill); got != "en" {
+		t.Errorf("DocumentLanguage = %q, want en", got)
+	}
+
+	langs := make(map[string]string)
+	for _, top := range Provisions(bill) {
+		top.Walk(func(p *Provision) bool {
+			langs[p.Identifier] = p.Lang
+			return true
+		})
+	}
+	for id, want := range map[string]string{
+		"/us/bill/118/hr/1/s1":   "en",
+		"/us/bill/118/hr/1/s2":   "es",
+		"/us/bill/118/hr/1/s2/a": "es",
+		"/us/bill/118/hr/1/s2/b": "en",
+	} {
+		if langs[id] != want {
+			t.Errorf("%s: Lang = %q, want %q", id, langs[id], want)
+		}
+	}
+
+	var got []string
+	for _, r := range ExtractTextRuns(bill, DefaultNormalizeOptions()) {
+		got = append(got, r.Lang+": "+r.Text)
+	}
+	want := []string{
+		"en: SECTION 1. BOUNDARY. The boundary follows the",
+		"es: Río Bravo del Norte",
+		"en: to the sea.",
+		"es: SEC. 2. TEXTO DEL TRATADO.",
+		"es: (a) Las Partes acuerdan lo siguiente.",
+		"en: (b) English translation.",
+	}
+	if strings.Join(got, "\n") != strings.Join(want, "\n") {
+		t.Errorf("unexpected runs:\n%s\nwant:\n%s", strings.Join(got, "\n"), strings.Join(want, "\n"))
+	}
+
+	out, err := MarshalDocumentToXML(bill)
+	if err != nil {
+		t.Fatalf("failed to marshal: %v", err)
+	}
+	for _, want := range []string{`<inline xml:lang="es">Río Bravo del Norte</inline>`, `<section identifier="/us/bill/118/hr/1/s2" xml:lang="es">`} {
+		if !strings.Contains(string(out), want) {
+			t.Errorf("expected %s in output:\n%s", want, out)
+		}
+	}
+}
+
+func TestWhitespaceCollapse(t *testing.T) {
+	const doc = `<?xml version="1.0" encoding="UTF-8"?>
+<bill xmlns="http://schemas.gpo.gov/xml/uslm">
+<meta>
+  <docNumber>
+    1
+  </docNumber>
+</meta>
+<main>
+<section identifier="/us/bill/118/hr/1/s1"><num value="1">SECTION 1. </num><heading>
+    SHORT   TITLE.
+  </heading><content>
+    This Act may be cited as the
+    <quotedText>Example   Act</quotedText>.
+  </content></section>
+</main>
+</bill>`
+	raw, err := ParseDocumentWithOptions([]byte(doc), ParseOptions{})
+	if err != nil {
+		t.Fatalf("failed to parse: %v", err)
+	}
+	section := raw.(*Bill).Main.Sections[0]
+	if got := section.Heading.Text; !strings.Contains(got, "\n") {
+		t.Errorf("preserved Heading.Text = %q, want source whitespace", got)
+	}
+
+	parsed, err := ParseDocumentWithOptions([]byte(doc), ParseOptions{Whitespace: WhitespaceCollapse})
+	if err != nil {
+		t.Fatalf("failed to parse: %v", err)
+	}
+	bill := parsed.(*Bill)
+	section = bill.Main.Sections[0]
+	for name, c := range map[string][2]string{
+		"DocNumber":    {bill.Meta.DocNumber, "1"},
+		"Num.Text":     {section.Num.Text, "SECTION 1."},
+		"Num.Value":    {section.Num.Value, "1"},
+		"Heading.Text": {section.Heading.Text, "SHORT TITLE."},
+	} {
+		if c[0] != c[1] {
+			t.Errorf("%s = %q, want %q", name, c[0], c[1])
+		}
+	}
+	if got := section.Content.Text; strings.Contains(got, "\n") || strings.Contains(got, "  ") {
+		t.Errorf("Content.Text = %q, want collapsed whitespace", got)
+	}
+	if got, want := section.Content.PlainText(), "This Act may be cited as the Example Act."; got != want {
+		t.Errorf("Content.PlainText() = %q, want %q", got, want)
+	}
+}
+
+func TestVerifyJSONRoundTrip(t *testing.T) {
+	data, err := os.ReadFile(filepath.Join("..", "..", "bill-version-samples-september-2024", "h1058_enr.XML"))
+	if err != nil {
+		t.Fatalf("failed to read sample: %v", err)
+	}
+	report, err := VerifyJSONRoundTrip(data)
+	if err != nil {
+		t.Fatalf("VerifyJSONRoundTrip: %v", err)
+	}
+	if report.DocumentType != DocumentTypeBill || !report.OK() {
+		t.Errorf("report = %+v, want a bill with no differences", report)
+	}
+
+	// A namespace declaration the model has no field for is kept only for
+	// XML output, so JSON loses it.
+	data, err = os.ReadFile(filepath.Join("..", "..", "bill-version-samples-september-2024", "BILLS-114hres99eh.xml"))
+	if err != nil {
+		t.Fatalf("failed to read sample: %v", err)
+	}
+	report, err = VerifyJSONRoundTrip(data)
+	if err != nil {
+		t.Fatalf("VerifyJSONRoundTrip: %v", err)
+	}
+	want := JSONRoundTripDifference{Kind: DifferenceAttribute, Path: "/resolution/@xmlns:slc", Want: "http://xml.senate.gov"}
+	if len(report.Differences) != 1 || report.Differences[0] != want {
+		t.Errorf("Differences = %v, want [%v]", report.Differences, want)
+	}
+
+	if _, err := VerifyJSONRoundTrip([]byte("<bill>")); err == nil {
+		t.Error("VerifyJSONRoundTrip accepted malformed XML")
+	}
+}
+
+func TestCompareNodes(t *testing.T) {
+	want, err := ParseRaw([]byte(`<section id="a"><num>1</num><heading>Title</heading><content>Old  text</content></section>`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := ParseRaw([]byte(`<section><num>1</num><content>New text</content><note/></section>`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var diffs []JSONRoundTripDifference
+	compareNodes(&diffs, "/section", want.Root(), got.Root())
+	var kinds []string
+	for _, d := range diffs {
+		kinds = append(kinds, string(d.Kind)+" "+d.Path)
+	}
+	wantKinds := []string{
+		"attribute /section/@id",
+		"missingElement /section/heading[1]",
+		"text /section/content[1]",
+		"extraElement /section/note",
+	}
+	if strings.Join(kinds, "\n") != strings.Join(wantKinds, "\n") {
+		t.Errorf("differences:\n%s\nwant:\n%s", strings.Join(kinds, "\n"), strings.Join(wantKinds, "\n"))
+	}
+}
+
+func TestBinaryCodec(t *testing.T) {
+	for _, name := range []string{"h1058_enr.XML", "BILLS-114hres99eh.xml", "BILLS-115hr1eas2.xml"} {
+		data, err := os.ReadFile(filepath.Join("..", "..", "bill-version-samples-september-2024", name))
+		if err != nil {
+			t.Fatalf("failed to read sample: %v", err)
+		}
+		doc, err := ParseDocument(data)
+		if err != nil {
+			t.Fatalf("%s: failed to parse: %v", name, err)
+		}
+		var buf bytes.Buffer
+		if err := EncodeBinary(&buf, doc); err != nil {
+			t.Fatalf("%s: EncodeBinary: %v", name, err)
+		}
+		back, err := DecodeBinary(&buf)
+		if err != nil {
+			t.Fatalf("%s: DecodeBinary: %v", name, err)
+		}
+		if !reflect.DeepEqual(doc, back) {
+			t.Errorf("%s: decoded document differs from the parsed one", name)
+		}
+		want, _ := MarshalDocumentToXMLWithOptions(doc, XMLOptions{})
+		got, err := MarshalDocumentToXMLWithOptions(back, XMLOptions{})
+		if err != nil || !bytes.Equal(got, want) {
+			t.Errorf("%s: decoded document marshals differently (err %v)", name, err)
+		}
+		if got, want := ExtractText(back, DefaultNormalizeOptions()), ExtractText(doc, DefaultNormalizeOptions()); got != want {
+			t.Errorf("%s: decoded document extracts different text", name)
+		}
+	}
+
+	bill, err := ParseBill([]byte(`<bill xmlns="http://schemas.gpo.gov/xml/uslm"><main><section><content>Text</content></section></main></bill>`))
+	if err != nil {
+		t.Fatalf("failed to parse: %v", err)
+	}
+	data, err := ToBinary(bill)
+	if err != nil {
+		t.Fatalf("ToBinary: %v", err)
+	}
+	stale := append([]byte(nil), data...)
+	stale[len(binaryMagic)] ^= 0xff
+	if _, err := DocumentFromBinary(stale); !errors.Is(err, ErrBinaryVersion) {
+		t.Errorf("changed fingerprint: err = %v, want ErrBinaryVersion", err)
+	}
+	if _, err := DocumentFromBinary(data[:len(data)-3]); err == nil {
+		t.Error("DocumentFromBinary accepted truncated data")
+	}
+	if _, err := DocumentFromBinary([]byte("<bill/>")); err == nil {
+		t.Error("DocumentFromBinary accepted XML")
+	}
+}
+
+// BenchmarkBinaryDecode compares parsing a document's XML with decoding its
+// binary form, on a short bill and on a long reported one. The speedup
+// quoted for ToBinary in the README comes from this benchmark.
+func BenchmarkBinaryDecode(b *testing.B) {
+	for _, name := range []string{"BILLS-114s32cds.xml", "H2740_RH.XML"} {
+		data, err := os.ReadFile(filepath.Join("..", "..", "bill-version-samples-september-2024", name))
+		if err != nil {
+			b.Fatalf("failed to read %s: %v", name, err)
+		}
+		doc, err := ParseDocument(data)
+		if err != nil {
+			b.Fatalf("failed to parse %s: %v", name, err)
+		}
+		bin, err := ToBinary(doc)
+		if err != nil {
+			b.Fatalf("failed to encode %s: %v", name, err)
+		}
+
+		b.Run(name+"/ParseDocument", func(b *testing.B) {
+			b.SetBytes(int64(len(data)))
+			for i := 0; i < b.N; i++ {
+				if _, err := ParseDocument(data); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+		b.Run(name+"/DocumentFromBinary", func(b *testing.B) {
+			b.SetBytes(int64(len(data)))
+			for i := 0; i < b.N; i++ {
+				if _, err := DocumentFromBinary(bin); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+func TestElementHandlers(t *testing.T) {
+	type budgetAuthority struct {
+		Amount string `xml:"amount,attr"`
+		Year   string `xml:"fiscalYear"`
+	}
+	RegisterElementHandler("budgetAuthority", func(d *xml.Decoder, start xml.StartElement) (interface{}, error) {
+		var v budgetAuthority
+		if err := d.DecodeElement(&v, &start); err != nil {
+			return nil, err
+		}
+		if v.Amount == "" {
+			return nil, errors.New("missing amount")
+		}
+		return v, nil
+	})
+	t.Cleanup(func() { RegisterElementHandler("budgetAuthority", nil) })
+
+	const doc = `<bill xmlns="http://schemas.gpo.gov/xml/uslm" xmlns:ext="http://example.gov/ext">
+<main>
+<section identifier="/us/bill/118/hr/1/s2"><num value="2">SEC. 2. </num><content>Funds are authorized.<ext:budgetAuthority amount="$5,000,000"><ext:fiscalYear>2025</ext:fiscalYear></ext:budgetAuthority></content></section>
+</main>
+</bill>`
+	parsed, err := ParseDocumentWithOptions([]byte(doc), ParseOptions{Strict: true})
+	if err != nil {
+		t.Fatalf("failed to parse: %v", err)
+	}
+	ext := Extensions(parsed)
+	if len(ext) != 1 {
+		t.Fatalf("Extensions = %+v, want one", ext)
+	}
+	want := Extension{
+		Name:       xml.Name{Space: "http://example.gov/ext", Local: "budgetAuthority"},
+		Path:       "bill/main/section/content/budgetAuthority",
+		Identifier: "/us/bill/118/hr/1/s2",
+		Value:      budgetAuthority{Amount: "$5,000,000", Year: "2025"},
+	}
+	if !reflect.DeepEqual(ext[0], want) {
+		t.Errorf("Extension = %+v, want %+v", ext[0], want)
+	}
+	bill := parsed.(*Bill)
+	if got := bill.Main.Sections[0].Content.PlainText(); got != "Funds are authorized." {
+		t.Errorf("PlainText() = %q", got)
+	}
+	if bill2, err := ParseBill([]byte(doc)); err != nil || len(Extensions(bill2)) != 1 {
+		t.Errorf("ParseBill: err %v, %d extensions", err, len(Extensions(bill2)))
+	}
+
+	if _, err := ParseDocument([]byte(strings.Replace(doc, ` amount="$5,000,000"`, "", 1))); err == nil || !strings.Contains(err.Error(), "missing amount") {
+		t.Errorf("handler error: err = %v", err)
+	}
+
+	RegisterElementHandler("budgetAuthority", nil)
+	if _, err := ParseDocumentWithOptions([]byte(doc), ParseOptions{Strict: true}); !errors.Is(err, ErrUnknownContent) {
+		t.Errorf("without handler: err = %v, want ErrUnknownContent", err)
+	}
+}
+
+func TestSenateNamespace(t *testing.T) {
+	const doc = `<?xml version="1.0" encoding="UTF-8"?>
+<amendment xmlns="http://schemas.gpo.gov/xml/uslm" xmlns:dc="http://purl.org/dc/elements/1.1/" xmlns:s="https://www.senate.gov/schemas" s:amendmentNumber="SA 1234">
+<amendMeta>
+<dc:title>Senate Amendment 1234</dc:title>
+<s:metaSenate>
+<s:for><s:for-office>Office of Senator Smith</s:for-office><s:for-sponsor>Mr. Smith</s:for-sponsor></s:for>
+<s:subject><s:subject-field1>Highway funding</s:subject-field1></s:subject>
+<s:type>Floor amendment</s:type>
+<s:peer-reviewed-by>JDoe</s:peer-reviewed-by>
+</s:metaSenate>
+</amendMeta>
+<amendMain>
+<section identifier="/us/bill/118/s/1/s1" s:printNumber="12"><num value="1">SEC. 1. </num></section>
+</amendMain>
+</amendment>`
+	parsed, err := ParseDocumentWithOptions([]byte(doc), ParseOptions{Strict: true})
+	if err != nil {
+		t.Fatalf("failed to parse: %v", err)
+	}
+	amendment := parsed.(*Amendment)
+	ms := amendment.AmendMeta.MetaSenate
+	if ms == nil || ms.Type != "Floor amendment" || ms.PeerReviewedBy != "JDoe" ||
+		ms.For == nil || ms.For.Sponsor != "Mr. Smith" || ms.Subject == nil || ms.Subject.Field1 != "Highway funding" {
+		t.Fatalf("MetaSenate = %+v", ms)
+	}
+	if got := amendment.Attrs.Senate("amendmentNumber"); got != "SA 1234" {
+		t.Errorf("amendmentNumber = %q, want SA 1234", got)
+	}
+	if got := amendment.AmendMain.Sections[0].Attrs.Senate("printNumber"); got != "12" {
+		t.Errorf("printNumber = %q, want 12", got)
+	}
+
+	out, err := MarshalDocumentToXML(parsed)
+	if err != nil {
+		t.Fatalf("failed to marshal: %v", err)
+	}
+	for _, want := range []string{
+		`xmlns:msenate="https://www.senate.gov/schemas" msenate:amendmentNumber="SA 1234"`,
+		`<msenate:metaSenate>`,
+		`<msenate:for-sponsor>Mr. Smith</msenate:for-sponsor>`,
+		`<msenate:peer-reviewed-by>JDoe</msenate:peer-reviewed-by>`,
+		`msenate:printNumber="12"`,
+	} {
+		if !bytes.Contains(out, []byte(want)) {
+			t.Errorf("output lacks %s:\n%s", want, out)
+		}
+	}
+	reparsed, err := ParseDocumentWithOptions(out, ParseOptions{Strict: true})
+	if err != nil {
+		t.Fatalf("failed to reparse: %v", err)
+	}
+	if !reflect.DeepEqual(reparsed.(*Amendment).AmendMeta.MetaSenate, ms) {
+		t.Errorf("MetaSenate after round trip = %+v", reparsed.(*Amendment).AmendMeta.MetaSenate)
+	}
+
+	raw, err := ParseRaw([]byte(doc))
+	if err != nil {
+		t.Fatalf("ParseRaw: %v", err)
+	}
+	if raw.Find("s:metaSenate") == nil {
+		t.Error("ParseRaw did not keep the source prefix")
+	}
+}
+
+func TestParseLazy(t *testing.T) {
+	data, err := os.ReadFile(filepath.Join("..", "..", "bill-version-samples-september-2024", "S1900_RS.xml"))
+	if err != nil {
+		t.Fatalf("failed to read sample: %v", err)
+	}
+	lazy, err := ParseLazy(data)
+	if err != nil {
+		t.Fatalf("ParseLazy: %v", err)
+	}
+	header, err := ReadHeader(bytes.NewReader(data))
+	if err != nil {
+		t.Fatalf("ReadHeader: %v", err)
+	}
+	if !reflect.DeepEqual(lazy.Head(), header) {
+		t.Error("Head differs from ReadHeader")
+	}
+
+	doc, err := ParseDocument(data)
+	if err != nil {
+		t.Fatalf("ParseDocument: %v", err)
+	}
+	const identifier = "/us/bill/116/s/1900/tIII/s302"
+	var want *Section
+	walkDocumentLevels(doc, func(l *level) bool {
+		if s, ok := l.node.(*Section); ok && s.Identifier == identifier {
+			want = s
+		}
+		return want == nil
+	})
+	if want == nil {
+		t.Fatalf("sample has no section %s", identifier)
+	}
+
+	got := make([]*Section, 8)
+	var wg sync.WaitGroup
+	for i := range got {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			got[i], _ = lazy.Section(identifier)
+		}(i)
+	}
+	wg.Wait()
+	for _, s := range got {
+		if s != got[0] {
+			t.Fatal("concurrent callers got different sections")
+		}
+	}
+	if !reflect.DeepEqual(got[0], want) {
+		t.Errorf("lazy section differs from the fully parsed one")
+	}
+	if s, err := lazy.Section(want.ID); err != nil || s != got[0] {
+		t.Errorf("Section by id = %v, %v", s, err)
+	}
+	if _, err := lazy.Section("/us/bill/116/s/1900/s999"); !errors.Is(err, ErrSectionNotFound) {
+		t.Errorf("missing section error = %v, want ErrSectionNotFound", err)
+	}
+	if full, err := lazy.Document(); err != nil || !reflect.DeepEqual(full, doc) {
+		t.Errorf("Document() = %v, want the full parse", err)
+	}
+
+	latin := []byte(`<?xml version="1.0" encoding="ISO-8859-1"?>
+<bill xmlns="http://schemas.gpo.gov/xml/uslm"><main><section id="s1" identifier="/us/bill/1/hr/1/s1"><content>Caf` + "\xe9" + ` act</content></section></main></bill>`)
+	lazy, err = ParseLazy(latin)
+	if err != nil {
+		t.Fatalf("ParseLazy ISO-8859-1: %v", err)
+	}
+	s, err := lazy.Section("/us/bill/1/hr/1/s1")
+	if err != nil || s.Content == nil || s.Content.Text != "Café act" {
+		t.Errorf("ISO-8859-1 section = %+v, %v", s, err)
+	}
+
+	// Stray Windows-1252 bytes in undeclared input are read as ParseDocument
+	// reads them.
+	stray := []byte(`<bill xmlns="http://schemas.gpo.gov/xml/uslm"><main><section id="s1" identifier="/us/bill/1/hr/1/s1"><content>The ` + "\x93Caf\xe9\x94" + ` Act</content></section></main></bill>`)
+	parsed, err := ParseDocument(stray)
+	if err != nil {
+		t.Fatalf("ParseDocument with stray bytes: %v", err)
+	}
+	lazy, err = ParseLazy(stray)
+	if err != nil {
+		t.Fatalf("ParseLazy with stray bytes: %v", err)
+	}
+	if full, err := lazy.Document(); err != nil || !reflect.DeepEqual(full, parsed) {
+		t.Errorf("Document() with stray bytes = %v, want the full parse", err)
+	}
+	s, err = lazy.Section("/us/bill/1/hr/1/s1")
+	if err != nil || !reflect.DeepEqual(*s, parsed.(*Bill).Main.Sections[0]) || s.Content.Text != "The “Café” Act" {
+		t.Errorf("section with stray bytes = %+v, %v", s, err)
+	}
+
+	// Sections may use the entities declared in the DOCTYPE.
+	entities := []byte(`<?xml version="1.0" encoding="UTF-8"?>
+<!DOCTYPE bill [<!ENTITY agency "Environmental Protection Agency"><!ENTITY q '"'>]>
+<bill xmlns="http://schemas.gpo.gov/xml/uslm"><main><section id="s1" identifier="/us/bill/1/hr/1/s1"><content>The &agency; shall say &q;no&q;.</content></section></main></bill>`)
+	if _, err := ParseDocument(entities); err != nil {
+		t.Fatalf("ParseDocument with entities: %v", err)
+	}
+	lazy, err = ParseLazy(entities)
+	if err != nil {
+		t.Fatalf("ParseLazy with entities: %v", err)
+	}
+	s, err = lazy.Section("/us/bill/1/hr/1/s1")
+	if err != nil || s.Content == nil || s.Content.Text != `The Environmental Protection Agency shall say "no".` {
+		t.Errorf("section with entities = %+v, %v", s, err)
+	}
+	external := bytes.Replace(entities, []byte(`"Environmental Protection Agency"`), []byte(`SYSTEM "file:///etc/passwd"`), 1)
+	if _, err := ParseLazy(external); !errors.Is(err, ErrUnsafeDocument) {
+		t.Errorf("ParseLazy with an external entity = %v, want ErrUnsafeDocument", err)
+	}
+}
+
+func TestArenaDecoding(t *testing.T) {
+	arena := NewArena()
+	for _, name := range []string{"BILLS-114s32cds.xml", "BILLS-116hr1865eas.xml", "S1900_RS.xml"} {
+		data, err := os.ReadFile(filepath.Join("..", "..", "bill-version-samples-september-2024", name))
+		if err != nil {
+			t.Fatalf("failed to read %s: %v", name, err)
+		}
+		doc, err := ParseDocument(data)
+		if err != nil {
+			t.Fatalf("failed to parse %s: %v", name, err)
+		}
+		encoded, err := ToBinary(doc)
+		if err != nil {
+			t.Fatalf("ToBinary(%s): %v", name, err)
+		}
+		for round := 0; round < 2; round++ {
+			decoded, err := DocumentFromBinaryInArena(arena, encoded)
+			if err != nil {
+				t.Fatalf("DocumentFromBinaryInArena(%s): %v", name, err)
+			}
+			if !reflect.DeepEqual(decoded, doc) {
+				t.Errorf("%s: arena-decoded document differs from the parsed one", name)
+			}
+			want, _ := MarshalDocumentToXML(doc)
+			got, err := MarshalDocumentToXML(decoded)
+			if err != nil || !bytes.Equal(got, want) {
+				t.Errorf("%s: arena-decoded document marshals differently (%v)", name, err)
+			}
+			arena.Free()
+		}
+	}
+	if _, err := DocumentFromBinaryInArena(arena, []byte("USLMB1")); !errors.Is(err, ErrBinaryVersion) {
+		t.Errorf("truncated header error = %v, want ErrBinaryVersion", err)
+	}
+}
+
+func TestInterner(t *testing.T) {
+	in := NewInterner()
+	section := in.Intern("/us/bill/116/s/1900/tIII/s302/a")
+	if got := in.Intern("/us/bill/116/s/1900/tIII/s302/a"); unsafe.StringData(got) != unsafe.StringData(section) {
+		t.Error("equal strings were not shared")
+	}
+	if got := in.Intern("/us/bill/116/s/1900/tIII/s302"); got != "/us/bill/116/s/1900/tIII/s302" || unsafe.StringData(got) != unsafe.StringData(section) {
+		t.Error("prefix was not a slice of the interned identifier")
+	}
+
+	data, err := os.ReadFile(filepath.Join("..", "..", "bill-version-samples-september-2024", "S1900_RS.xml"))
+	if err != nil {
+		t.Fatalf("failed to read sample: %v", err)
+	}
+	plain, err := ParseDocument(data)
+	if err != nil {
+		t.Fatalf("ParseDocument: %v", err)
+	}
+	in = NewInterner()
+	first, err := ParseDocumentWithOptions(data, ParseOptions{Interner: in})
+	if err != nil {
+		t.Fatalf("ParseDocumentWithOptions: %v", err)
+	}
+	if !reflect.DeepEqual(first, plain) {
+		t.Error("interning changed the document")
+	}
+	size := in.Len()
+	second, err := ParseDocumentWithOptions(data, ParseOptions{Interner: in})
+	if err != nil {
+		t.Fatalf("ParseDocumentWithOptions: %v", err)
+	}
+	if in.Len() != size {
+		t.Errorf("reparsing the same document grew the table from %d to %d", size, in.Len())
+	}
+	firstIdentifier := func(doc LegislativeDocument) string {
+		var identifier string
+		walkDocumentLevels(doc, func(l *level) bool {
+			if identifier == "" {
+				identifier = *l.identifier
+			}
+			return identifier == ""
+		})
+		return identifier
+	}
+	a, b := firstIdentifier(first), firstIdentifier(second)
+	if a == "" || unsafe.StringData(a) != unsafe.StringData(b) {
+		t.Errorf("identifier %q not shared between documents", a)
+	}
+}
+
+func TestGetReferrals(t *testing.T) {
+	const doc = `<bill xmlns="http://schemas.gpo.gov/xml/uslm"><preface>
+<action><date date="2019-01-03">January 3, 2019</date><actionDescription>Referred to the <committee committeeId="HHA00">Committee on House Administration</committee>, and in addition to the <committee committeeId="HJU00">Committees on the Judiciary</committee> and <committee committeeId="HWM00">Ways and Means</committee></actionDescription></action>
+<action><date date="2019-02-01">February 1, 2019</date><actionDescription>Referral to the <committee committeeId="HWM00">Committee on Ways and Means</committee> extended for a period ending not later than March 4, 2019</actionDescription></action>
+<action><date date="2019-03-04">March 4, 2019</date><actionDescription>Reported from the <committee committeeId="HHA00">Committee on House Administration</committee> with an amendment</actionDescription></action>
+<action><date date="2019-03-04">March 4, 2019</date><actionDescription>The Committee on the Judiciary discharged</actionDescription></action>
+</preface><main/></bill>`
+	parsed, err := ParseDocument([]byte(doc))
+	if err != nil {
+		t.Fatalf("failed to parse: %v", err)
+	}
+	type event struct {
+		kind ReferralEventKind
+		date string
+	}
+	want := []struct {
+		id      string
+		pending bool
+		events  []event
+	}{
+		{"HHA00", false, []event{{ReferralReferred, "2019-01-03"}, {ReferralReported, "2019-03-04"}}},
+		{"HJU00", false, []event{{ReferralReferred, "2019-01-03"}, {ReferralDischarged, "2019-03-04"}}},
+		{"HWM00", true, []event{{ReferralReferred, "2019-01-03"}, {ReferralExtended, "2019-02-01"}}},
+	}
+	referrals := GetReferrals(parsed)
+	if len(referrals) != len(want) {
+		t.Fatalf("got %d referrals, want %d: %+v", len(referrals), len(want), referrals)
+	}
+	for i, w := range want {
+		r := referrals[i]
+		if r.Committee.CommitteeID != w.id || r.Pending() != w.pending {
+			t.Errorf("referral %d = %s pending %v, want %s pending %v", i, r.Committee.CommitteeID, r.Pending(), w.id, w.pending)
+		}
+		var got []event
+		for _, e := range r.Events {
+			got = append(got, event{e.Kind, e.Date})
+			if e.Action == nil || e.Action.ActionDescription == nil {
+				t.Errorf("%s: event without its action", w.id)
+			}
+		}
+		if !reflect.DeepEqual(got, w.events) {
+			t.Errorf("%s events = %v, want %v", w.id, got, w.events)
+		}
+	}
+
+	// A discharge naming no committee ends every pending referral, even
+	// when the same action refers the measure elsewhere.
+	data, err := os.ReadFile(filepath.Join("..", "..", "bill-version-samples-september-2024", "BILLS-114s32cds.xml"))
+	if err != nil {
+		t.Fatalf("failed to read sample: %v", err)
+	}
+	sample, err := ParseDocument(data)
+	if err != nil {
+		t.Fatalf("failed to parse sample: %v", err)
+	}
+	referrals = GetReferrals(sample)
+	if len(referrals) != 2 || referrals[0].Committee.CommitteeID != "SSFI00" || referrals[0].Pending() ||
+		referrals[1].Committee.CommitteeID != "SSJU00" || !referrals[1].Pending() {
+		t.Errorf("sample referrals = %+v", referrals)
+	}
+}
+
+func TestSponsorQualifiers(t *testing.T) {
+	tests := []struct {
+		name        string
+		description string
+		want        SponsorQualifiers
+	}{
+		{
+			name:        "by request",
+			description: `<sponsor senateId="S221">Mr. <inline class="smallCaps">Smith</inline></sponsor> (for himself and <cosponsor senateId="S222">Ms. <inline class="smallCaps">Jones</inline></cosponsor>) (by request) introduced the following bill; which was read twice and referred to the <committee committeeId="SSFI00">Committee on Finance</committee>`,
+			want:        SponsorQualifiers{Kind: IntroductionIntroduced, Measure: "bill", ForSelf: true, ByRequest: true, ReadTwice: true},
+		},
+		{
+			name:        "by title",
+			description: `<sponsor houseId="H001">Ms. Doe</sponsor> submitted the following concurrent resolution; which was read the first time by title and ordered to be printed`,
+			want:        SponsorQualifiers{Kind: IntroductionSubmitted, Measure: "concurrent resolution", ByTitle: true},
+		},
+		{
+			name:        "original bill from a marked-up committee",
+			description: `<sponsor senateId="S236">Mr. <inline class="smallCaps">Inhofe</inline></sponsor>, from the <committee committeeId="SSAS00">Committee on Armed Services</committee>, reported the following original bill; which was read twice and placed on the calendar`,
+			want:        SponsorQualifiers{Kind: IntroductionReported, Measure: "bill", Original: true, Committee: "Committee on Armed Services", ReadTwice: true},
+		},
+		{
+			name:        "not a sponsor line",
+			description: `Reported by <sponsor senateId="S323">Mr. <inline class="smallCaps">Risch</inline></sponsor>, without amendment`,
+			want:        SponsorQualifiers{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var d ActionDescription
+			if err := xml.Unmarshal([]byte("<actionDescription>"+tt.description+"</actionDescription>"), &d); err != nil {
+				t.Fatalf("failed to parse: %v", err)
+			}
+			if got := d.SponsorQualifiers(); got != tt.want {
+				t.Errorf("SponsorQualifiers() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+	var nilDescription *ActionDescription
+	if got := nilDescription.SponsorQualifiers(); got != (SponsorQualifiers{}) {
+		t.Errorf("nil description qualifiers = %+v", got)
+	}
+}
@@ -0,0 +1,189 @@
+package uslm
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// PDFRenderer is a LayoutRenderer that writes a Layout as PDF, set in the
+// standard Courier font so that the columns of the layout line up. The
+// font is not embedded, which every PDF reader supports, and only the
+// characters of the Windows-1252 code page can be shown; others print as
+// "?".
+type PDFRenderer struct {
+	// FontSize is the size of the text in points. Defaults to 10.
+	FontSize float64
+
+	// PageWidth and PageHeight are the page size in points. Default to US
+	// Letter (612 by 792).
+	PageWidth  float64
+	PageHeight float64
+}
+
+// ToPDF lays the document out with LayoutDocument and writes it as PDF with
+// the default PDFRenderer.
+func ToPDF(doc LegislativeDocument, opts LayoutOptions) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := (PDFRenderer{}).RenderLayout(&buf, LayoutDocument(doc, opts)); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// RenderLayout writes layout to w.
+func (r PDFRenderer) RenderLayout(w io.Writer, layout *Layout) error {
+	if r.FontSize <= 0 {
+		r.FontSize = 10
+	}
+	if r.PageWidth <= 0 {
+		r.PageWidth = 612
+	}
+	if r.PageHeight <= 0 {
+		r.PageHeight = 792
+	}
+
+	var buf bytes.Buffer
+	var offsets []int
+	object := func(body string) {
+		offsets = append(offsets, buf.Len())
+		fmt.Fprintf(&buf, "%d 0 obj\n%s\nendobj\n", len(offsets), body)
+	}
+
+	buf.WriteString("%PDF-1.4\n")
+	// Objects 1 to 4 are the catalog, page tree, font, and document
+	// information; each page is then a page object and its content stream.
+	kids := make([]string, len(layout.Pages))
+	for i := range layout.Pages {
+		kids[i] = strconv.Itoa(5+2*i) + " 0 R"
+	}
+	object("<< /Type /Catalog /Pages 2 0 R >>")
+	object(fmt.Sprintf("<< /Type /Pages /Kids [%s] /Count %d >>", strings.Join(kids, " "), len(layout.Pages)))
+	object("<< /Type /Font /Subtype /Type1 /BaseFont /Courier /Encoding /WinAnsiEncoding >>")
+	// Information strings use PDFDocEncoding, which agrees with
+	// WinAnsiEncoding on ASCII alone.
+	object(fmt.Sprintf("<< /Title %s /Producer (uslm) >>", pdfString(NormalizeText(layout.Title, DefaultNormalizeOptions()))))
+
+	leading := r.leading(layout)
+	for i, page := range layout.Pages {
+		content := r.pageContent(layout, page, leading)
+		object(fmt.Sprintf("<< /Type /Page /Parent 2 0 R /MediaBox [0 0 %s %s] /Resources << /Font << /F1 3 0 R >> >> /Contents %d 0 R >>",
+			pdfNumber(r.PageWidth), pdfNumber(r.PageHeight), 6+2*i))
+		object(fmt.Sprintf("<< /Length %d >>\nstream\n%s\nendstream", len(content), content))
+	}
+
+	xref := buf.Len()
+	fmt.Fprintf(&buf, "xref\n0 %d\n0000000000 65535 f \n", len(offsets)+1)
+	for _, offset := range offsets {
+		fmt.Fprintf(&buf, "%010d 00000 n \n", offset)
+	}
+	fmt.Fprintf(&buf, "trailer\n<< /Size %d /Root 1 0 R /Info 4 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(offsets)+1, xref)
+	if _, err := w.Write(buf.Bytes()); err != nil {
+		return fmt.Errorf("failed to write PDF: %w", err)
+	}
+	return nil
+}
+
+// pdfMargin is the top and bottom page margin in points.
+const pdfMargin = 54
+
+// leading returns the distance between lines: double spacing, as on GPO
+// prints, or less if the longest page would not otherwise fit between the
+// page number and the running head.
+func (r PDFRenderer) leading(layout *Layout) float64 {
+	longest := 1
+	for _, page := range layout.Pages {
+		if len(page.Lines) > longest {
+			longest = len(page.Lines)
+		}
+	}
+	leading := 2 * r.FontSize
+	if fit := (r.PageHeight - 2*pdfMargin - 4*r.FontSize) / float64(longest); fit < leading {
+		leading = fit
+	}
+	return leading
+}
+
+// pageContent returns the content stream of one page.
+func (r PDFRenderer) pageContent(layout *Layout, page LayoutPage, leading float64) string {
+	// Courier glyphs are 0.6 em wide. The text is centered on the page,
+	// after a four-column margin for the line numbers.
+	column := 0.6 * r.FontSize
+	left := (r.PageWidth - float64(layout.Width+4)*column) / 2
+	if left < 0 {
+		left = 0
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "BT\n/F1 %s Tf\n", pdfNumber(r.FontSize))
+	show := func(x, y float64, text string) {
+		fmt.Fprintf(&b, "1 0 0 1 %s %s Tm %s Tj\n", pdfNumber(x), pdfNumber(y), pdfString(text))
+	}
+
+	top := r.PageHeight - pdfMargin
+	if page.Number > 1 {
+		number := strconv.Itoa(page.Number)
+		show(left+float64(layout.Width+4-len(number))*column, top, number)
+	}
+	y := top - 2*r.FontSize
+	for _, line := range page.Lines {
+		y -= leading
+		if line.Number > 0 {
+			show(left, y, fmt.Sprintf("%2d", line.Number))
+		}
+		if line.Text != "" {
+			show(left+4*column, y, line.Text)
+		}
+	}
+	if layout.RunningHead != "" {
+		show(left+4*column, pdfMargin, layout.RunningHead)
+	}
+	b.WriteString("ET")
+	return b.String()
+}
+
+// pdfNumber formats a PDF real number.
+func pdfNumber(f float64) string {
+	return strconv.FormatFloat(f, 'f', -1, 64)
+}
+
+// winAnsi maps the characters of Windows-1252 outside Latin-1 to their
+// codes.
+var winAnsi = map[rune]byte{
+	'€': 0x80, '‚': 0x82, 'ƒ': 0x83, '„': 0x84, '…': 0x85, '†': 0x86,
+	'‡': 0x87, 'ˆ': 0x88, '‰': 0x89, 'Š': 0x8a, '‹': 0x8b, 'Œ': 0x8c,
+	'Ž': 0x8e, '‘': 0x91, '’': 0x92, '“': 0x93, '”': 0x94, '•': 0x95,
+	'–': 0x96, '—': 0x97, '˜': 0x98, '™': 0x99, 'š': 0x9a, '›': 0x9b,
+	'œ': 0x9c, 'ž': 0x9e, 'Ÿ': 0x9f,
+}
+
+// pdfString returns s as a PDF literal string in WinAnsiEncoding, with
+// characters outside it replaced by "?" and bytes outside printable ASCII
+// written as octal escapes.
+func pdfString(s string) string {
+	var b strings.Builder
+	b.WriteByte('(')
+	for _, r := range s {
+		c, ok := winAnsi[r]
+		switch {
+		case ok:
+		case r >= 0x20 && r < 0x7f || r >= 0xa0 && r <= 0xff:
+			c = byte(r)
+		default:
+			c = '?'
+		}
+		switch {
+		case c == '(' || c == ')' || c == '\\':
+			b.WriteByte('\\')
+			b.WriteByte(c)
+		case c >= 0x80:
+			fmt.Fprintf(&b, "\\%03o", c)
+		default:
+			b.WriteByte(c)
+		}
+	}
+	b.WriteByte(')')
+	return b.String()
+}
@@ -0,0 +1,167 @@
+package uslm
+
+import (
+	"sort"
+	"strings"
+	"sync"
+)
+
+// ActLocation identifies where an Act is classified or published.
+type ActLocation struct {
+	// USCode is the U.S. Code classification in citation form (e.g., "42 U.S.C. 301 et seq.").
+	USCode string `json:"usCode,omitempty"`
+
+	// USCodeHref is the USLM reference for the classification (e.g., "/us/usc/t42/s301").
+	USCodeHref string `json:"usCodeHref,omitempty"`
+
+	// StatutesAtLarge is the enacted location (e.g., "49 Stat. 620").
+	StatutesAtLarge string `json:"statutesAtLarge,omitempty"`
+}
+
+// PopularNameTable maps Act popular names to their locations. It is safe for
+// concurrent use. The zero value is an empty table ready to use.
+type PopularNameTable struct {
+	mu    sync.RWMutex
+	names map[string]ActLocation
+}
+
+// NewPopularNameTable returns a table seeded with the given entries.
+func NewPopularNameTable(entries map[string]ActLocation) *PopularNameTable {
+	t := &PopularNameTable{}
+	for name, loc := range entries {
+		t.Add(name, loc)
+	}
+	return t
+}
+
+// DefaultPopularNames returns a new table seeded with frequently amended Acts.
+// Callers may extend it with Add.
+func DefaultPopularNames() *PopularNameTable {
+	return NewPopularNameTable(map[string]ActLocation{
+		"Social Security Act":                             {USCode: "42 U.S.C. 301 et seq.", USCodeHref: "/us/usc/t42/s301", StatutesAtLarge: "49 Stat. 620"},
+		"Internal Revenue Code of 1986":                   {USCode: "26 U.S.C. 1 et seq.", USCodeHref: "/us/usc/t26"},
+		"Public Health Service Act":                       {USCode: "42 U.S.C. 201 et seq.", USCodeHref: "/us/usc/t42/s201", StatutesAtLarge: "58 Stat. 682"},
+		"Federal Food, Drug, and Cosmetic Act":            {USCode: "21 U.S.C. 301 et seq.", USCodeHref: "/us/usc/t21/s301", StatutesAtLarge: "52 Stat. 1040"},
+		"Controlled Substances Act":                       {USCode: "21 U.S.C. 801 et seq.", USCodeHref: "/us/usc/t21/s801", StatutesAtLarge: "84 Stat. 1242"},
+		"Controlled Substances Import and Export Act":     {USCode: "21 U.S.C. 951 et seq.", USCodeHref: "/us/usc/t21/s951", StatutesAtLarge: "84 Stat. 1285"},
+		"Immigration and Nationality Act":                 {USCode: "8 U.S.C. 1101 et seq.", USCodeHref: "/us/usc/t8/s1101", StatutesAtLarge: "66 Stat. 163"},
+		"Higher Education Act of 1965":                    {USCode: "20 U.S.C. 1001 et seq.", USCodeHref: "/us/usc/t20/s1001", StatutesAtLarge: "79 Stat. 1219"},
+		"Elementary and Secondary Education Act of 1965":  {USCode: "20 U.S.C. 6301 et seq.", USCodeHref: "/us/usc/t20/s6301", StatutesAtLarge: "79 Stat. 27"},
+		"Fair Labor Standards Act of 1938":                {USCode: "29 U.S.C. 201 et seq.", USCodeHref: "/us/usc/t29/s201", StatutesAtLarge: "52 Stat. 1060"},
+		"Employee Retirement Income Security Act of 1974": {USCode: "29 U.S.C. 1001 et seq.", USCodeHref: "/us/usc/t29/s1001", StatutesAtLarge: "88 Stat. 829"},
+		"Endangered Species Act of 1973":                  {USCode: "16 U.S.C. 1531 et seq.", USCodeHref: "/us/usc/t16/s1531", StatutesAtLarge: "87 Stat. 884"},
+		"Clean Air Act":                                   {USCode: "42 U.S.C. 7401 et seq.", USCodeHref: "/us/usc/t42/s7401"},
+	})
+}
+
+// Add registers or replaces a popular name.
+func (t *PopularNameTable) Add(name string, loc ActLocation) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.names == nil {
+		t.names = make(map[string]ActLocation)
+	}
+	t.names[name] = loc
+}
+
+// Lookup returns the location registered for the popular name.
+func (t *PopularNameTable) Lookup(name string) (ActLocation, bool) {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	loc, ok := t.names[name]
+	return loc, ok
+}
+
+// ActMention is an occurrence of a known Act's popular name in document text.
+type ActMention struct {
+	Name     string      `json:"name"`
+	Location ActLocation `json:"location"`
+
+	// Offset is the byte offset of the mention within the searched text.
+	Offset int `json:"offset"`
+
+	// Element and Identifier describe the hierarchical level containing the
+	// mention; they are empty for mentions found by FindActMentions.
+	Element    string `json:"element,omitempty"`
+	Identifier string `json:"identifier,omitempty"`
+}
+
+// FindActMentions returns the popular names from the table that occur in text,
+// in order of appearance. Where names overlap, the longest match wins (so
+// "Controlled Substances Import and Export Act" is not also reported as a
+// shorter Act name it contains).
+func (t *PopularNameTable) FindActMentions(text string) []ActMention {
+	t.mu.RLock()
+	names := make([]string, 0, len(t.names))
+	for name := range t.names {
+		names = append(names, name)
+	}
+	t.mu.RUnlock()
+	sort.Slice(names, func(i, j int) bool { return len(names[i]) > len(names[j]) })
+
+	var mentions []ActMention
+	covered := func(start, end int) bool {
+		for _, m := range mentions {
+			if start < m.Offset+len(m.Name) && m.Offset < end {
+				return true
+			}
+		}
+		return false
+	}
+	for _, name := range names {
+		for from := 0; from < len(text); {
+			i := strings.Index(text[from:], name)
+			if i < 0 {
+				break
+			}
+			start := from + i
+			end := start + len(name)
+			if isWordBoundary(text, start, end) && !covered(start, end) {
+				loc, _ := t.Lookup(name)
+				mentions = append(mentions, ActMention{Name: name, Location: loc, Offset: start})
+			}
+			from = end
+		}
+	}
+
+	sort.Slice(mentions, func(i, j int) bool { return mentions[i].Offset < mentions[j].Offset })
+	return mentions
+}
+
+// ExtractActMentions tags popular-name mentions in the chapeau and content text
+// of every hierarchical level of the document.
+func (t *PopularNameTable) ExtractActMentions(doc LegislativeDocument) []ActMention {
+	var mentions []ActMention
+	walkDocumentLevels(doc, func(l *level) bool {
+		var texts []string
+		if l.chapeau != nil {
+			texts = append(texts, l.chapeau.Text)
+		}
+		if l.content != nil {
+			texts = append(texts, l.content.Text)
+		}
+		for _, text := range texts {
+			for _, m := range t.FindActMentions(text) {
+				m.Element = l.element
+				m.Identifier = *l.identifier
+				mentions = append(mentions, m)
+			}
+		}
+		return true
+	})
+	return mentions
+}
+
+// isWordBoundary reports whether text[start:end] is not embedded in a longer word.
+func isWordBoundary(text string, start, end int) bool {
+	isWordByte := func(b byte) bool {
+		return b == '_' || ('0' <= b && b <= '9') || ('a' <= b && b <= 'z') || ('A' <= b && b <= 'Z')
+	}
+	if start > 0 && isWordByte(text[start-1]) {
+		return false
+	}
+	if end < len(text) && isWordByte(text[end]) {
+		return false
+	}
+	return true
+}
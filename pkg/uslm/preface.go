@@ -1,75 +1,254 @@
 package uslm
 
-import "encoding/xml"
+import (
+	"encoding/xml"
+	"regexp"
+	"strings"
+	"time"
+)
 
 // Preface represents the preface section for bills and resolutions.
 // This contains metadata that IS rendered/published with the document.
 type Preface struct {
 	XMLName xml.Name `xml:"preface" json:"-"`
 
-	SlugLine         string            `xml:"slugLine,omitempty" json:"slugLine,omitempty"`
-	DistributionCode *DistributionCode `xml:"distributionCode" json:"distributionCode,omitempty"`
-	Congress         *CongressElement  `xml:"congress" json:"congress,omitempty"`
-	Session          *SessionElement   `xml:"session" json:"session,omitempty"`
-	DCType           string            `xml:"http://purl.org/dc/elements/1.1/ type" json:"dcType,omitempty"`
-	DocNumber        string            `xml:"docNumber,omitempty" json:"docNumber,omitempty"`
-	DCTitle          string            `xml:"http://purl.org/dc/elements/1.1/ title" json:"dcTitle,omitempty"`
-	CurrentChamber   *CurrentChamber   `xml:"currentChamber" json:"currentChamber,omitempty"`
-	Actions          []Action          `xml:"action" json:"actions,omitempty"`
+	SlugLine              string             `xml:"slugLine,omitempty" json:"slugLine,omitempty"`
+	LeftRunningHead       *RunningHead       `xml:"leftRunningHead" json:"leftRunningHead,omitempty"`
+	CenterRunningHead     *RunningHead       `xml:"centerRunningHead" json:"centerRunningHead,omitempty"`
+	RightRunningHead      *RunningHead       `xml:"rightRunningHead" json:"rightRunningHead,omitempty"`
+	DistributionCode      *DistributionCode  `xml:"distributionCode" json:"distributionCode,omitempty"`
+	RelatedDocuments      []RelatedDocument  `xml:"relatedDocument" json:"relatedDocuments,omitempty"`
+	RelatedDocumentGroups []RelatedDocuments `xml:"relatedDocuments" json:"relatedDocumentGroups,omitempty"`
+	Congress              *CongressElement   `xml:"congress" json:"congress,omitempty"`
+	Session               *SessionElement    `xml:"session" json:"session,omitempty"`
+	DCType                string             `xml:"http://purl.org/dc/elements/1.1/ type" json:"dcType,omitempty"`
+	DocNumber             string             `xml:"docNumber,omitempty" json:"docNumber,omitempty"`
+	DCTitle               string             `xml:"http://purl.org/dc/elements/1.1/ title" json:"dcTitle,omitempty"`
+	CurrentChamber        *CurrentChamber    `xml:"currentChamber" json:"currentChamber,omitempty"`
+	DocPublicationName    *PrintedProperty   `xml:"docPublicationName" json:"docPublicationName,omitempty"`
+	EnrolledDateline      *PrintedProperty   `xml:"enrolledDateline" json:"enrolledDateline,omitempty"`
+	Actions               []Action           `xml:"action" json:"actions,omitempty"`
+	Attrs                 Attributes         `xml:",any,attr" json:"attrs,omitempty"`
 }
 
 // AmendPreface represents the preface section for amendment documents.
 type AmendPreface struct {
 	XMLName xml.Name `xml:"amendPreface" json:"-"`
 
-	SlugLine       string          `xml:"slugLine,omitempty" json:"slugLine,omitempty"`
-	CurrentChamber *CurrentChamber `xml:"currentChamber" json:"currentChamber,omitempty"`
-	Actions        []Action        `xml:"action" json:"actions,omitempty"`
+	SlugLine          string          `xml:"slugLine,omitempty" json:"slugLine,omitempty"`
+	LeftRunningHead   *RunningHead    `xml:"leftRunningHead" json:"leftRunningHead,omitempty"`
+	CenterRunningHead *RunningHead    `xml:"centerRunningHead" json:"centerRunningHead,omitempty"`
+	RightRunningHead  *RunningHead    `xml:"rightRunningHead" json:"rightRunningHead,omitempty"`
+	CurrentChamber    *CurrentChamber `xml:"currentChamber" json:"currentChamber,omitempty"`
+	Actions           []Action        `xml:"action" json:"actions,omitempty"`
+	Attrs             Attributes      `xml:",any,attr" json:"attrs,omitempty"`
+}
+
+// RunningHead is the text printed at the left, center, or right of the
+// head of each page, from a <leftRunningHead>, <centerRunningHead>, or
+// <rightRunningHead> element.
+type RunningHead struct {
+	Class             string     `xml:"class,attr,omitempty" json:"class,omitempty"`
+	RenderingPosition string     `xml:"renderingPosition,attr,omitempty" json:"renderingPosition,omitempty"`
+	Text              string     `xml:",chardata" json:"text,omitempty"`
+	Attrs             Attributes `xml:",any,attr" json:"attrs,omitempty"`
+
+	// Position is "left", "center", or "right". It is set on the running
+	// heads GetRunningHeads returns; elsewhere the field holding the head
+	// gives its position.
+	Position string `xml:"-" json:"position,omitempty"`
+}
+
+// PrintedProperty is a property element with a name of its own, such as
+// <docPublicationName> or <enrolledDateline>, whose text is printed with
+// the document.
+type PrintedProperty struct {
+	Value string     `xml:"value,attr,omitempty" json:"value,omitempty"`
+	Date  string     `xml:"date,attr,omitempty" json:"date,omitempty"`
+	Text  string     `xml:",chardata" json:"text,omitempty"`
+	Attrs Attributes `xml:",any,attr" json:"attrs,omitempty"`
+}
+
+// GetValue returns the property's value attribute, falling back to its
+// text with whitespace normalized. It returns "" for a nil property.
+func (p *PrintedProperty) GetValue() string {
+	if p == nil {
+		return ""
+	}
+	if v := strings.TrimSpace(p.Value); v != "" {
+		return v
+	}
+	return normalizeSpace(p.Text)
+}
+
+// runningHeads returns the running heads that are set, in left, center,
+// right order, with their positions.
+func runningHeads(left, center, right *RunningHead) []RunningHead {
+	var out []RunningHead
+	for i, h := range []*RunningHead{left, center, right} {
+		if h != nil {
+			head := *h
+			head.Position = [...]string{"left", "center", "right"}[i]
+			out = append(out, head)
+		}
+	}
+	return out
 }
 
 // DistributionCode represents a distribution code element with display attribute.
 type DistributionCode struct {
-	XMLName xml.Name `xml:"distributionCode" json:"-"`
-	Display string   `xml:"display,attr,omitempty" json:"display,omitempty"`
-	Text    string   `xml:",chardata" json:"text,omitempty"`
+	XMLName xml.Name   `xml:"distributionCode" json:"-"`
+	Display string     `xml:"display,attr,omitempty" json:"display,omitempty"`
+	Text    string     `xml:",chardata" json:"text,omitempty"`
+	Attrs   Attributes `xml:",any,attr" json:"attrs,omitempty"`
 }
 
 // CongressElement represents the congress element with value attribute.
 type CongressElement struct {
-	XMLName xml.Name `xml:"congress" json:"-"`
-	Value   string   `xml:"value,attr,omitempty" json:"value,omitempty"`
-	Text    string   `xml:",chardata" json:"text,omitempty"`
+	XMLName xml.Name   `xml:"congress" json:"-"`
+	Value   string     `xml:"value,attr,omitempty" json:"value,omitempty"`
+	Text    string     `xml:",chardata" json:"text,omitempty"`
+	Attrs   Attributes `xml:",any,attr" json:"attrs,omitempty"`
 }
 
 // SessionElement represents the session element with value attribute.
 type SessionElement struct {
-	XMLName xml.Name `xml:"session" json:"-"`
-	Value   string   `xml:"value,attr,omitempty" json:"value,omitempty"`
-	Text    string   `xml:",chardata" json:"text,omitempty"`
+	XMLName xml.Name   `xml:"session" json:"-"`
+	Value   string     `xml:"value,attr,omitempty" json:"value,omitempty"`
+	Text    string     `xml:",chardata" json:"text,omitempty"`
+	Attrs   Attributes `xml:",any,attr" json:"attrs,omitempty"`
 }
 
 // CurrentChamber represents which chamber currently has the document.
 type CurrentChamber struct {
-	XMLName xml.Name `xml:"currentChamber" json:"-"`
-	Value   string   `xml:"value,attr,omitempty" json:"value,omitempty"`
-	Text    string   `xml:",chardata" json:"text,omitempty"`
+	XMLName xml.Name   `xml:"currentChamber" json:"-"`
+	Value   string     `xml:"value,attr,omitempty" json:"value,omitempty"`
+	Text    string     `xml:",chardata" json:"text,omitempty"`
+	Attrs   Attributes `xml:",any,attr" json:"attrs,omitempty"`
 }
 
 // Action represents a legislative action taken on the document.
 type Action struct {
-	XMLName           xml.Name           `xml:"action" json:"-"`
-	ActionStage       string             `xml:"actionStage,attr,omitempty" json:"actionStage,omitempty"`
-	Date              *ActionDate        `xml:"date" json:"date,omitempty"`
-	ActionDescription *ActionDescription `xml:"actionDescription" json:"actionDescription,omitempty"`
-	ActionInstruction string             `xml:"actionInstruction,omitempty" json:"actionInstruction,omitempty"`
+	XMLName            xml.Name            `xml:"action" json:"-"`
+	ActionStage        string              `xml:"actionStage,attr,omitempty" json:"actionStage,omitempty"`
+	Date               *ActionDate         `xml:"date" json:"date,omitempty"`
+	ActionDescription  *ActionDescription  `xml:"actionDescription" json:"actionDescription,omitempty"`
+	ActionInstructions []ActionInstruction `xml:"actionInstruction" json:"actionInstructions,omitempty"`
+	Attrs              Attributes          `xml:",any,attr" json:"attrs,omitempty"`
 }
 
 // ActionDate represents the date of an action.
 type ActionDate struct {
-	XMLName xml.Name `xml:"date" json:"-"`
-	Date    string   `xml:"date,attr,omitempty" json:"date,omitempty"` // ISO format YYYY-MM-DD
-	Text    string   `xml:",chardata" json:"text,omitempty"`
-	Inline  []Inline `xml:"inline" json:"inline,omitempty"`
+	XMLName xml.Name   `xml:"date" json:"-"`
+	Date    string     `xml:"date,attr,omitempty" json:"date,omitempty"` // ISO format YYYY-MM-DD
+	Text    string     `xml:",chardata" json:"text,omitempty"`
+	Inline  []Inline   `xml:"inline" json:"inline,omitempty"`
+	Attrs   Attributes `xml:",any,attr" json:"attrs,omitempty"`
+	text    string     `xml:"-" json:"-"`
+}
+
+// legislativeDayPattern matches the "(legislative day, December 2)" note
+// that follows the calendar day in some action dates.
+var legislativeDayPattern = regexp.MustCompile(`\s*\(legislative day[^)]*\)`)
+
+// ISODate returns the date in YYYY-MM-DD form: the date attribute when
+// present, otherwise the date parsed from the printed text (e.g.,
+// "February 8, 2019"), or "" if neither is available.
+func (d *ActionDate) ISODate() string {
+	if d == nil {
+		return ""
+	}
+	if date := strings.TrimSpace(d.Date); date != "" {
+		return date
+	}
+	text := normalizeSpace(legislativeDayPattern.ReplaceAllString(d.PlainText(), ""))
+	text = strings.TrimSuffix(text, ".")
+	t, err := time.Parse("January 2, 2006", text)
+	if err != nil {
+		return ""
+	}
+	return t.Format("2006-01-02")
+}
+
+// ActionInstruction is a bracketed printing instruction following an
+// action, e.g. "[Strike out all after the enacting clause and insert the part
+// printed in italic]".
+type ActionInstruction struct {
+	XMLName xml.Name   `xml:"actionInstruction" json:"-"`
+	Text    string     `xml:",chardata" json:"text,omitempty"`
+	Attrs   Attributes `xml:",any,attr" json:"attrs,omitempty"`
+}
+
+// InstructionKind classifies an action instruction.
+type InstructionKind string
+
+const (
+	// InstructionAmendment tells the reader how a committee amendment is
+	// printed: what it strikes or omits, and the typeface of what it
+	// inserts.
+	InstructionAmendment InstructionKind = "amendment"
+
+	// InstructionSeeText refers the reader to another print for text, as in
+	// "For text of introduced bill, see copy of bill as introduced on
+	// January 3, 2019".
+	InstructionSeeText InstructionKind = "seeText"
+
+	// InstructionOther is an instruction of no recognized form.
+	InstructionOther InstructionKind = "other"
+)
+
+// ParsedInstruction is the structured form of an ActionInstruction.
+type ParsedInstruction struct {
+	Kind InstructionKind `json:"kind"`
+
+	// Strike is what an amendment strikes or omits, e.g. "all after the
+	// enacting clause" or "the part struck through".
+	Strike string `json:"strike,omitempty"`
+
+	// Insert is the typeface of the text an amendment inserts, e.g.
+	// "italic" or "boldface roman".
+	Insert string `json:"insert,omitempty"`
+
+	// Subject is the text referred to by InstructionSeeText, e.g.
+	// "introduced bill", and Date the ISO date of the print it is in.
+	Subject string `json:"subject,omitempty"`
+	Date    string `json:"date,omitempty"`
+
+	// Text is the instruction without its brackets.
+	Text string `json:"text"`
+}
+
+// ReplacesText reports whether the instruction is for an amendment in the
+// nature of a substitute, one striking all after the enacting or resolving
+// clause.
+func (p ParsedInstruction) ReplacesText() bool {
+	return p.Kind == InstructionAmendment && strings.HasPrefix(strings.ToLower(p.Strike), "all after the")
+}
+
+var (
+	strikeInstructionPattern = regexp.MustCompile(`(?i)^(?:strike out|strike|omit) (.+?)(?: and insert (?:the part |the matter )?printed in (.+))?$`)
+	insertInstructionPattern = regexp.MustCompile(`(?i)^insert (?:the part |the matter )?printed in (.+)$`)
+	seeTextPattern           = regexp.MustCompile(`(?i)^for text of (.+?), see copy of .+? (?:on|of) ([A-Z][a-z]+ \d{1,2}, \d{4})$`)
+)
+
+// Parse returns the structured form of the instruction.
+func (a ActionInstruction) Parse() ParsedInstruction {
+	text := normalizeSpace(a.Text)
+	text = strings.TrimSuffix(strings.TrimPrefix(text, "["), "]")
+	text = strings.TrimSuffix(strings.TrimSpace(text), ".")
+	p := ParsedInstruction{Kind: InstructionOther, Text: text}
+
+	if m := seeTextPattern.FindStringSubmatch(text); m != nil {
+		p.Kind, p.Subject = InstructionSeeText, m[1]
+		if t, err := time.Parse("January 2, 2006", m[2]); err == nil {
+			p.Date = t.Format("2006-01-02")
+		}
+	} else if m := insertInstructionPattern.FindStringSubmatch(text); m != nil {
+		p.Kind, p.Insert = InstructionAmendment, m[1]
+	} else if m := strikeInstructionPattern.FindStringSubmatch(text); m != nil {
+		p.Kind, p.Strike, p.Insert = InstructionAmendment, m[1], m[2]
+	}
+	return p
 }
 
 // ActionDescription describes what happened in an action.
@@ -80,23 +259,127 @@ type ActionDescription struct {
 	Cosponsors []Cosponsor `xml:"cosponsor" json:"cosponsors,omitempty"`
 	Committees []Committee `xml:"committee" json:"committees,omitempty"`
 	Inline     []Inline    `xml:"inline" json:"inline,omitempty"`
+	Attrs      Attributes  `xml:",any,attr" json:"attrs,omitempty"`
+}
+
+// IntroductionKind is how a sponsor line brings a measure before the
+// chamber.
+type IntroductionKind string
+
+const (
+	// IntroductionIntroduced is a bill or joint resolution introduced by
+	// its sponsor.
+	IntroductionIntroduced IntroductionKind = "introduced"
+
+	// IntroductionSubmitted is a simple or concurrent resolution submitted
+	// by its sponsor.
+	IntroductionSubmitted IntroductionKind = "submitted"
+
+	// IntroductionReported is a measure reported by its sponsor from a
+	// committee, such as an original bill.
+	IntroductionReported IntroductionKind = "reported"
+)
+
+// SponsorQualifiers are the qualifiers of a sponsor line, e.g. "Mr. Smith
+// (for himself and Ms. Jones) (by request) introduced the following bill;
+// which was read twice and referred to the Committee on Finance".
+type SponsorQualifiers struct {
+	// Kind is how the measure was brought in, or "" if the description is
+	// not a sponsor line.
+	Kind IntroductionKind `json:"kind,omitempty"`
+
+	// Measure is the kind of measure named, e.g. "bill" or "joint
+	// resolution", and Original reports an original measure, one
+	// written by the committee reporting it.
+	Measure  string `json:"measure,omitempty"`
+	Original bool   `json:"original,omitempty"`
+
+	// Committee is the committee an IntroductionReported measure was
+	// reported from.
+	Committee string `json:"committee,omitempty"`
+
+	// ForSelf reports a sponsor acting "for himself" or "for herself" and
+	// the cosponsors listed with them.
+	ForSelf bool `json:"forSelf,omitempty"`
+
+	// ByRequest reports a measure introduced "(by request)", as a courtesy
+	// to the President or an executive agency rather than on the sponsor's
+	// own initiative.
+	ByRequest bool `json:"byRequest,omitempty"`
+
+	// ByTitle reports a measure read by title only, as in "read the first
+	// time by title".
+	ByTitle bool `json:"byTitle,omitempty"`
+
+	// ReadTwice reports a measure read twice on introduction, as the
+	// Senate records for one referred to committee.
+	ReadTwice bool `json:"readTwice,omitempty"`
+}
+
+var (
+	introductionPattern = regexp.MustCompile(`(?i)\b(introduced|submitted|reported) the following (original )?(bill|joint resolution|concurrent resolution|resolution)\b`)
+	forSelfPattern      = regexp.MustCompile(`(?i)\(\s*for (?:himself|herself|themselves)\b`)
+	byRequestPattern    = regexp.MustCompile(`(?i)\(\s*by request\s*\)`)
+	byTitlePattern      = regexp.MustCompile(`(?i)\bby (?:its )?title\b`)
+	readTwicePattern    = regexp.MustCompile(`(?i)\bread twice\b`)
+
+	// reportedFromPattern captures the committee of a reported measure
+	// when it is written out rather than marked up as a <committee>.
+	reportedFromPattern = regexp.MustCompile(`\bfrom the (Committee on [^,;]*?)\s*,\s*reported\b`)
+)
+
+// SponsorQualifiers parses the qualifiers of the description's sponsor line.
+// Descriptions of other actions, which name no sponsor introducing,
+// submitting, or reporting a measure, return the zero value.
+func (d *ActionDescription) SponsorQualifiers() SponsorQualifiers {
+	var q SponsorQualifiers
+	if d == nil || len(d.Sponsors) == 0 {
+		return q
+	}
+	text := normalizeSpace(d.Text)
+	m := introductionPattern.FindStringSubmatch(text)
+	if m == nil {
+		return q
+	}
+	q.Kind = IntroductionKind(strings.ToLower(m[1]))
+	q.Original = m[2] != ""
+	q.Measure = strings.ToLower(m[3])
+	q.ForSelf = forSelfPattern.MatchString(text)
+	q.ByRequest = byRequestPattern.MatchString(text)
+	q.ByTitle = byTitlePattern.MatchString(text)
+	q.ReadTwice = readTwicePattern.MatchString(text)
+	if q.Kind == IntroductionReported {
+		if c := reportedFromPattern.FindStringSubmatch(text); c != nil {
+			q.Committee = c[1]
+		} else if len(d.Committees) > 0 {
+			// "from the <committee>…</committee>, reported": the first
+			// committee of the line is the one reporting.
+			q.Committee = normalizeSpace(d.Committees[0].Text)
+		}
+	}
+	return q
 }
 
 // Sponsor represents the primary sponsor of legislation.
 type Sponsor struct {
-	XMLName  xml.Name `xml:"sponsor" json:"-"`
-	SenateID string   `xml:"senateId,attr,omitempty" json:"senateId,omitempty"`
-	HouseID  string   `xml:"houseId,attr,omitempty" json:"houseId,omitempty"`
-	Text     string   `xml:",chardata" json:"text,omitempty"`
-	Inline   []Inline `xml:"inline" json:"inline,omitempty"`
+	XMLName    xml.Name   `xml:"sponsor" json:"-"`
+	SenateID   string     `xml:"senateId,attr,omitempty" json:"senateId,omitempty"`
+	HouseID    string     `xml:"houseId,attr,omitempty" json:"houseId,omitempty"`
+	BioGuideID string     `xml:"bioGuideId,attr,omitempty" json:"bioGuideId,omitempty"`
+	Text       string     `xml:",chardata" json:"text,omitempty"`
+	Inline     []Inline   `xml:"inline" json:"inline,omitempty"`
+	Attrs      Attributes `xml:",any,attr" json:"attrs,omitempty"`
+}
+
+// GetMemberID returns the sponsor's ID together with the scheme it is drawn
+// from. See MemberID.
+func (s *Sponsor) GetMemberID() MemberID {
+	return newMemberID(s.SenateID, s.HouseID, s.BioGuideID)
 }
 
-// GetID returns the sponsor's official ID (Senate or House).
+// GetID returns the sponsor's official ID (Senate, House, or Bioguide).
 func (s *Sponsor) GetID() string {
-	if s.SenateID != "" {
-		return s.SenateID
-	}
-	return s.HouseID
+	return s.GetMemberID().Value
 }
 
 // GetName returns the sponsor's name text.
@@ -106,19 +389,24 @@ func (s *Sponsor) GetName() string {
 
 // Cosponsor represents a cosponsor of legislation.
 type Cosponsor struct {
-	XMLName  xml.Name `xml:"cosponsor" json:"-"`
-	SenateID string   `xml:"senateId,attr,omitempty" json:"senateId,omitempty"`
-	HouseID  string   `xml:"houseId,attr,omitempty" json:"houseId,omitempty"`
-	Text     string   `xml:",chardata" json:"text,omitempty"`
-	Inline   []Inline `xml:"inline" json:"inline,omitempty"`
+	XMLName    xml.Name   `xml:"cosponsor" json:"-"`
+	SenateID   string     `xml:"senateId,attr,omitempty" json:"senateId,omitempty"`
+	HouseID    string     `xml:"houseId,attr,omitempty" json:"houseId,omitempty"`
+	BioGuideID string     `xml:"bioGuideId,attr,omitempty" json:"bioGuideId,omitempty"`
+	Text       string     `xml:",chardata" json:"text,omitempty"`
+	Inline     []Inline   `xml:"inline" json:"inline,omitempty"`
+	Attrs      Attributes `xml:",any,attr" json:"attrs,omitempty"`
 }
 
-// GetID returns the cosponsor's official ID (Senate or House).
+// GetMemberID returns the cosponsor's ID together with the scheme it is drawn
+// from. See MemberID.
+func (c *Cosponsor) GetMemberID() MemberID {
+	return newMemberID(c.SenateID, c.HouseID, c.BioGuideID)
+}
+
+// GetID returns the cosponsor's official ID (Senate, House, or Bioguide).
 func (c *Cosponsor) GetID() string {
-	if c.SenateID != "" {
-		return c.SenateID
-	}
-	return c.HouseID
+	return c.GetMemberID().Value
 }
 
 // GetName returns the cosponsor's name text.
@@ -128,9 +416,10 @@ func (c *Cosponsor) GetName() string {
 
 // Committee represents a congressional committee.
 type Committee struct {
-	XMLName     xml.Name `xml:"committee" json:"-"`
-	CommitteeID string   `xml:"committeeId,attr,omitempty" json:"committeeId,omitempty"`
-	Text        string   `xml:",chardata" json:"text,omitempty"`
+	XMLName     xml.Name   `xml:"committee" json:"-"`
+	CommitteeID string     `xml:"committeeId,attr,omitempty" json:"committeeId,omitempty"`
+	Text        string     `xml:",chardata" json:"text,omitempty"`
+	Attrs       Attributes `xml:",any,attr" json:"attrs,omitempty"`
 }
 
 // GetID returns the committee's official ID.
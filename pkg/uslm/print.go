@@ -0,0 +1,324 @@
+package uslm
+
+import (
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// PrintOptions configures ToPrintText.
+type PrintOptions struct {
+	// Width is the line width in columns. Defaults to 72.
+	Width int
+
+	// Indent is the number of columns per indentation step. Defaults to 2.
+	Indent int
+}
+
+// ToPrintText renders the document as fixed-width text following the layout
+// conventions of the official print, for side-by-side comparison with GPO
+// PDFs: the Congress and session at the top left with the document number
+// right-aligned across from them, the document title ("AN ACT") and
+// official title centered, title headings centered, small-caps runs in
+// capitals, and each level indented by its print indentation (the indentN
+// class GPO records on it, or its depth when it has none), with a hanging
+// indent for its continuation lines.
+func ToPrintText(doc LegislativeDocument, opts PrintOptions) string {
+	lines, _ := printLines(doc, opts)
+	return strings.Join(lines, "\n") + "\n"
+}
+
+// printLines returns the lines of ToPrintText, and how many of them the
+// header block takes.
+func printLines(doc LegislativeDocument, opts PrintOptions) ([]string, int) {
+	if opts.Width <= 0 {
+		opts.Width = 72
+	}
+	if opts.Indent <= 0 {
+		opts.Indent = 2
+	}
+	p := printer{opts: opts}
+	p.header(doc)
+	header := len(p.lines)
+	for _, top := range Provisions(doc) {
+		top.Walk(func(prov *Provision) bool {
+			p.provision(prov)
+			return true
+		})
+	}
+	return p.lines, header
+}
+
+// printer accumulates the lines of ToPrintText.
+type printer struct {
+	opts  PrintOptions
+	lines []string
+}
+
+// header writes the Congress, session, and document number block followed
+// by the centered document and official titles.
+func (p *printer) header(doc LegislativeDocument) {
+	var preface *Preface
+	switch d := doc.(type) {
+	case *Bill:
+		preface = d.Preface
+	case *Resolution:
+		preface = d.Preface
+	}
+
+	congress, session := congressLine(doc.GetCongress()), sessionLine(doc.GetSession())
+	number := normalizeSpace(doc.GetDocumentNumber())
+	if preface != nil {
+		if preface.Congress != nil && strings.TrimSpace(preface.Congress.Text) != "" {
+			congress = strings.ToUpper(normalizeSpace(preface.Congress.Text))
+		}
+		if preface.Session != nil && strings.TrimSpace(preface.Session.Text) != "" {
+			session = strings.ToUpper(normalizeSpace(preface.Session.Text))
+		}
+		if preface.DocNumber != "" {
+			number = normalizeSpace(preface.DCType + " " + preface.DocNumber)
+		}
+	}
+	if congress != "" || number != "" {
+		p.lines = append(p.lines, spread(congress, number, p.opts.Width))
+	}
+	if session != "" {
+		p.lines = append(p.lines, strings.Repeat(" ", p.opts.Indent)+session)
+	}
+
+	var docTitle, official string
+	if t, ok := doc.(TitledDocument); ok {
+		docTitle, official = normalizeSpace(t.GetDocTitle()), normalizeSpace(t.GetOfficialTitle())
+	}
+	if official == "" && preface != nil {
+		official = normalizeSpace(preface.DCTitle)
+	}
+	if official == "" {
+		official = normalizeSpace(doc.GetTitle())
+	}
+	if docTitle != "" {
+		p.blank()
+		p.centered(strings.ToUpper(docTitle))
+	}
+	if official != "" {
+		p.blank()
+		p.centered(official)
+	}
+}
+
+// provision writes the lines of one level, without its children.
+func (p *printer) provision(prov *Provision) {
+	num := normalizeSpace(prov.GetNum())
+	heading := ""
+	if prov.Heading != nil {
+		heading = normalizeSpace(withSmallCaps(prov.Heading.PlainText(), prov.Heading.Inline))
+	}
+	chapeau, content := "", ""
+	if prov.Chapeau != nil {
+		chapeau = normalizeSpace(withSmallCaps(prov.Chapeau.PlainText(), prov.Chapeau.Inline))
+	}
+	if prov.Content != nil {
+		content = normalizeSpace(withSmallCaps(prov.Content.PlainText(), prov.Content.Inline))
+	}
+
+	switch prov.Element {
+	case "title":
+		p.blank()
+		p.centered(strings.ToUpper(joinPrintParts(num, heading)))
+		p.paragraph(chapeau, 0, 0)
+	case "section":
+		p.blank()
+		step := p.opts.Indent
+		p.paragraph(joinPrintParts(num, heading), step, step)
+		p.paragraph(chapeau, step, 0)
+		p.paragraph(content, step, 0)
+	default:
+		level := printIndent(prov)
+		first, rest := (level+1)*p.opts.Indent, level*p.opts.Indent
+		lead := chapeau
+		if lead == "" {
+			lead, content = content, ""
+		}
+		p.paragraph(joinPrintParts(joinPrintParts(num, heading), lead), first, rest)
+		p.paragraph(content, first, rest)
+	}
+}
+
+// blank appends an empty line unless the output is empty or already ends
+// with one.
+func (p *printer) blank() {
+	if n := len(p.lines); n > 0 && p.lines[n-1] != "" {
+		p.lines = append(p.lines, "")
+	}
+}
+
+// centered appends text wrapped to the line width with each line centered.
+func (p *printer) centered(text string) {
+	for _, line := range wrapWords(text, p.opts.Width, 0, 0) {
+		pad := (p.opts.Width - utf8.RuneCountInString(line)) / 2
+		p.lines = append(p.lines, strings.Repeat(" ", pad)+line)
+	}
+}
+
+// paragraph appends text wrapped with its first line indented by first
+// columns and the rest by rest. Empty text appends nothing.
+func (p *printer) paragraph(text string, first, rest int) {
+	text = strings.TrimSpace(text)
+	if text == "" {
+		return
+	}
+	for i, line := range wrapWords(text, p.opts.Width, first, rest) {
+		indent := rest
+		if i == 0 {
+			indent = first
+		}
+		p.lines = append(p.lines, strings.Repeat(" ", indent)+line)
+	}
+}
+
+// wrapWords breaks text into lines of at most width columns after
+// indentation, first for the first line and rest for the others. A word
+// longer than a line is left unbroken.
+func wrapWords(text string, width, first, rest int) []string {
+	var lines []string
+	var line strings.Builder
+	avail := width - first
+	for _, word := range strings.Fields(text) {
+		n := utf8.RuneCountInString(word)
+		switch {
+		case line.Len() == 0:
+		case utf8.RuneCountInString(line.String())+1+n <= avail:
+			line.WriteByte(' ')
+		default:
+			lines = append(lines, line.String())
+			line.Reset()
+			avail = width - rest
+		}
+		line.WriteString(word)
+	}
+	if line.Len() > 0 {
+		lines = append(lines, line.String())
+	}
+	return lines
+}
+
+// withSmallCaps returns text with the runs set in small caps (inline
+// elements of class smallCaps) in capitals, as they read in print.
+func withSmallCaps(text string, inlines []Inline) string {
+	for _, in := range inlines {
+		if !hasClass(in.Class, "smallCaps") {
+			continue
+		}
+		run := normalizeSpace(in.Text)
+		if run == "" {
+			continue
+		}
+		text = normalizeSpace(text)
+		text = strings.Replace(text, run, strings.ToUpper(run), 1)
+	}
+	return text
+}
+
+// hasClass reports whether the space-separated class list contains name.
+func hasClass(classes, name string) bool {
+	for _, c := range strings.Fields(classes) {
+		if c == name {
+			return true
+		}
+	}
+	return false
+}
+
+// printIndent returns the print indentation step of a level below a
+// section: its indentN class, or its rank below the section.
+func printIndent(prov *Provision) int {
+	var class string
+	switch n := prov.Node.(type) {
+	case *Subsection:
+		class = n.Class
+	case *Paragraph:
+		class = n.Class
+	case *Subparagraph:
+		class = n.Class
+	case *Clause:
+		class = n.Class
+	case *Subclause:
+		class = n.Class
+	}
+	for _, c := range strings.Fields(class) {
+		if strings.HasPrefix(c, "indent") {
+			if n, err := strconv.Atoi(strings.TrimPrefix(c, "indent")); err == nil {
+				if n < 0 {
+					n = 0
+				}
+				return n
+			}
+		}
+	}
+	depth := 0
+	for parent := prov.Parent; parent != nil && parent.Element != "section" && parent.Element != "title"; parent = parent.Parent {
+		depth++
+	}
+	return depth
+}
+
+// joinPrintParts joins two runs of text with a space, or directly when the
+// first ends in a dash, as in "TITLE I—GENERAL" and "(a) IN GENERAL.—The".
+func joinPrintParts(a, b string) string {
+	switch {
+	case a == "":
+		return b
+	case b == "":
+		return a
+	case strings.HasSuffix(a, "—"):
+		return a + b
+	default:
+		return a + " " + b
+	}
+}
+
+// spread returns left and right on one line of the given width, with right
+// aligned to the right margin.
+func spread(left, right string, width int) string {
+	gap := width - utf8.RuneCountInString(left) - utf8.RuneCountInString(right)
+	if gap < 1 {
+		gap = 1
+	}
+	return left + strings.Repeat(" ", gap) + right
+}
+
+// congressLine returns the "116TH CONGRESS" line for a congress number.
+func congressLine(congress string) string {
+	n, err := strconv.Atoi(strings.TrimSpace(congress))
+	if err != nil {
+		return ""
+	}
+	return strings.ToUpper(ordinal(n)) + " CONGRESS"
+}
+
+// sessionLine returns the "1ST SESSION" line for a session number.
+func sessionLine(session string) string {
+	n, err := strconv.Atoi(strings.TrimSpace(session))
+	if err != nil {
+		return ""
+	}
+	return strings.ToUpper(ordinal(n)) + " SESSION"
+}
+
+// ordinal returns n with its English ordinal suffix, e.g. "2nd" or "113th".
+func ordinal(n int) string {
+	suffix := "th"
+	switch n % 100 {
+	case 11, 12, 13:
+	default:
+		switch n % 10 {
+		case 1:
+			suffix = "st"
+		case 2:
+			suffix = "nd"
+		case 3:
+			suffix = "rd"
+		}
+	}
+	return strconv.Itoa(n) + suffix
+}
@@ -0,0 +1,107 @@
+package uslm
+
+import (
+	"io"
+	"os"
+)
+
+// progressInterval is the number of bytes read between progress reports.
+const progressInterval = 64 << 10
+
+// ParseProgress reports how far a parse has read to ParseOptions.Progress.
+type ParseProgress struct {
+	// Bytes is the number of bytes of input read so far.
+	Bytes int64
+
+	// Total is the size of the input in bytes when it is known in advance
+	// (for byte slices, *os.File, and readers with a Len method such as
+	// *bytes.Reader), and 0 otherwise.
+	Total int64
+
+	// Elements is the number of elements decoded so far.
+	Elements int64
+
+	// Done is set on the final report, made once the root element closes.
+	Done bool
+}
+
+// progressTracker counts the bytes and elements of a parse and reports them
+// to a ParseOptions.Progress callback.
+type progressTracker struct {
+	fn       func(ParseProgress)
+	total    int64
+	bytes    int64
+	elements int64
+	reported int64
+}
+
+// newProgressTracker returns a tracker reporting to fn on input r, or nil
+// when fn is nil.
+func newProgressTracker(fn func(ParseProgress), r io.Reader) *progressTracker {
+	if fn == nil {
+		return nil
+	}
+	return &progressTracker{fn: fn, total: inputSize(r)}
+}
+
+// reader returns r counting the bytes read into the tracker.
+func (p *progressTracker) reader(r io.Reader) io.Reader {
+	if p == nil {
+		return r
+	}
+	return &progressReader{r: r, p: p}
+}
+
+// element records an element read.
+func (p *progressTracker) element() {
+	if p != nil {
+		p.elements++
+	}
+}
+
+// done makes the final report.
+func (p *progressTracker) done() {
+	if p != nil {
+		p.fn(ParseProgress{Bytes: p.bytes, Total: p.total, Elements: p.elements, Done: true})
+	}
+}
+
+// progressReader counts the bytes read through it into a progressTracker,
+// reporting every progressInterval bytes.
+type progressReader struct {
+	r io.Reader
+	p *progressTracker
+}
+
+func (pr *progressReader) Read(b []byte) (int, error) {
+	n, err := pr.r.Read(b)
+	p := pr.p
+	p.bytes += int64(n)
+	if p.bytes-p.reported >= progressInterval {
+		p.reported = p.bytes
+		p.fn(ParseProgress{Bytes: p.bytes, Total: p.total, Elements: p.elements})
+	}
+	return n, err
+}
+
+// inputSize returns the number of bytes left to read from r, or 0 if it
+// cannot be told without reading.
+func inputSize(r io.Reader) int64 {
+	switch v := r.(type) {
+	case *byteCounter:
+		return inputSize(v.r)
+	case interface{ Len() int }:
+		return int64(v.Len())
+	case *os.File:
+		info, err := v.Stat()
+		if err != nil || !info.Mode().IsRegular() {
+			return 0
+		}
+		offset, err := v.Seek(0, io.SeekCurrent)
+		if err != nil {
+			return 0
+		}
+		return info.Size() - offset
+	}
+	return 0
+}
@@ -0,0 +1,167 @@
+package uslm
+
+import (
+	"encoding/xml"
+	"strings"
+	"time"
+)
+
+// A document may record several processing steps, each as a <processedBy>
+// naming the system followed by the <processedDate> it ran on (GPO Access
+// conversions, for instance, add their own step after the drafting
+// system's). Meta and AmendMeta keep the first step in ProcessedBy and
+// ProcessedDate, as they always have, and, when there is more than one step,
+// every step in Processing.
+
+// ProcessingStep is one step of a document's processing history.
+type ProcessingStep struct {
+	// System names the processing system, e.g. "GPO XPub Bill to USLM
+	// Generator, version 0.5".
+	System string `json:"system,omitempty"`
+
+	// Date is the processing date as written, e.g. "2024-09-09".
+	Date string `json:"date,omitempty"`
+}
+
+// Time returns the processing date parsed as an ISO date or timestamp, or as
+// a printed date such as "September 9, 2024", or the zero time.
+func (s ProcessingStep) Time() time.Time {
+	for _, layout := range []string{"2006-01-02", time.RFC3339, "2006-01-02T15:04:05", "January 2, 2006"} {
+		if t, err := time.Parse(layout, s.Date); err == nil {
+			return t
+		}
+	}
+	return time.Time{}
+}
+
+// processingTrail records processedBy and processedDate elements, in the
+// order they occur, as steps. A processedBy begins a step; a processedDate
+// completes the current step, or begins one if the current step is dated.
+type processingTrail struct {
+	steps *[]ProcessingStep
+}
+
+func (t processingTrail) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	var text string
+	if err := d.DecodeElement(&text, &start); err != nil {
+		return err
+	}
+	text = strings.TrimSpace(text)
+	steps := *t.steps
+	if start.Name.Local == "processedDate" {
+		if n := len(steps); n > 0 && steps[n-1].Date == "" {
+			steps[n-1].Date = text
+		} else {
+			steps = append(steps, ProcessingStep{Date: text})
+		}
+	} else {
+		if n := len(steps); n > 0 && steps[n-1].System == "" {
+			steps[n-1].System = text
+		} else {
+			steps = append(steps, ProcessingStep{System: text})
+		}
+	}
+	*t.steps = steps
+	return nil
+}
+
+// processingSteps writes steps as processedBy and processedDate pairs.
+type processingSteps []ProcessingStep
+
+func (s processingSteps) MarshalXML(e *xml.Encoder, _ xml.StartElement) error {
+	for _, step := range s {
+		if step.System != "" {
+			if err := e.EncodeElement(step.System, xml.StartElement{Name: xml.Name{Local: "processedBy"}}); err != nil {
+				return err
+			}
+		}
+		if step.Date != "" {
+			if err := e.EncodeElement(step.Date, xml.StartElement{Name: xml.Name{Local: "processedDate"}}); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// provenance returns the processing steps of a metadata block: processing,
+// with its first step taken from processedBy and processedDate so that edits
+// to those fields are honored.
+func provenance(processing []ProcessingStep, processedBy, processedDate string) []ProcessingStep {
+	if len(processing) == 0 {
+		if processedBy == "" && processedDate == "" {
+			return nil
+		}
+		return []ProcessingStep{{System: processedBy, Date: processedDate}}
+	}
+	steps := make([]ProcessingStep, len(processing))
+	copy(steps, processing)
+	steps[0] = ProcessingStep{System: processedBy, Date: processedDate}
+	return steps
+}
+
+// UnmarshalXML decodes the metadata while recording every processing step.
+func (m *Meta) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	// The embedded type is exported so that encoding/xml may set the
+	// fields it promotes.
+	type Plain Meta
+	var steps []ProcessingStep
+	aux := struct {
+		*Plain
+		ProcessedBy   processingTrail `xml:"processedBy"`
+		ProcessedDate processingTrail `xml:"processedDate"`
+	}{Plain: (*Plain)(m), ProcessedBy: processingTrail{&steps}, ProcessedDate: processingTrail{&steps}}
+	if err := d.DecodeElement(&aux, &start); err != nil {
+		return err
+	}
+	m.Processing = nil
+	if len(steps) > 0 {
+		m.ProcessedBy, m.ProcessedDate = steps[0].System, steps[0].Date
+	}
+	if len(steps) > 1 {
+		m.Processing = steps
+	}
+	return nil
+}
+
+// UnmarshalXML decodes the metadata while recording every processing step.
+func (m *AmendMeta) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	// The embedded type is exported so that encoding/xml may set the
+	// fields it promotes.
+	type Plain AmendMeta
+	var steps []ProcessingStep
+	aux := struct {
+		*Plain
+		ProcessedBy   processingTrail `xml:"processedBy"`
+		ProcessedDate processingTrail `xml:"processedDate"`
+	}{Plain: (*Plain)(m), ProcessedBy: processingTrail{&steps}, ProcessedDate: processingTrail{&steps}}
+	if err := d.DecodeElement(&aux, &start); err != nil {
+		return err
+	}
+	m.Processing = nil
+	if len(steps) > 0 {
+		m.ProcessedBy, m.ProcessedDate = steps[0].System, steps[0].Date
+	}
+	if len(steps) > 1 {
+		m.Processing = steps
+	}
+	return nil
+}
+
+// GetProvenance returns the document's processing steps in the order they
+// were recorded, or nil if it records none.
+func (m *Meta) GetProvenance() []ProcessingStep {
+	if m == nil {
+		return nil
+	}
+	return provenance(m.Processing, m.ProcessedBy, m.ProcessedDate)
+}
+
+// GetProvenance returns the document's processing steps in the order they
+// were recorded, or nil if it records none.
+func (m *AmendMeta) GetProvenance() []ProcessingStep {
+	if m == nil {
+		return nil
+	}
+	return provenance(m.Processing, m.ProcessedBy, m.ProcessedDate)
+}
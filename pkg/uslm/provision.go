@@ -0,0 +1,229 @@
+package uslm
+
+import (
+	"strings"
+	"unicode"
+)
+
+// Provision is a read-only view of one hierarchical level of a document
+// (title, section, subsection, paragraph, subparagraph, clause, or subclause)
+// with its nested levels as children. It lets code outside this package walk
+// any document type without switching on each level struct.
+type Provision struct {
+	Element    string       `json:"element"`
+	ID         string       `json:"id,omitempty"`
+	Identifier string       `json:"identifier,omitempty"`
+	Num        *Num         `json:"num,omitempty"`
+	Heading    *Heading     `json:"heading,omitempty"`
+	Chapeau    *Chapeau     `json:"chapeau,omitempty"`
+	Content    *Content     `json:"content,omitempty"`
+	Changed    ChangeMarker `json:"changed,omitempty"`
+
+	// Lang is the provision's effective language: the xml:lang of its
+	// element or of the nearest enclosing level with one, or else the
+	// document's (see DocumentLanguage).
+	Lang string `json:"lang,omitempty"`
+
+	Depth    int          `json:"depth"`
+	Children []*Provision `json:"children,omitempty"`
+
+	// Parent is the enclosing provision, or nil for a top-level provision.
+	Parent *Provision `json:"-"`
+
+	// Node is the underlying element struct (*Title, *Section, *Subsection,
+	// *Paragraph, *Subparagraph, *Clause, or *Subclause).
+	Node interface{} `json:"-"`
+}
+
+// Ensure Provision implements the element interfaces
+var (
+	_ Identifiable     = (*Provision)(nil)
+	_ Numbered         = (*Provision)(nil)
+	_ Headed           = (*Provision)(nil)
+	_ ContentContainer = (*Provision)(nil)
+)
+
+// Provisions returns the document's top-level provisions in document order.
+// Titles come before sections that are not in a title, matching the order in
+// which the other document-wide helpers visit them.
+func Provisions(doc LegislativeDocument) []*Provision {
+	return provisionTree(DocumentLanguage(doc), func(fn func(l *level) bool) {
+		walkDocumentLevels(doc, fn)
+	})
+}
+
+// provisionTree builds the provisions for the levels visited by walk, whose
+// language defaults to lang.
+func provisionTree(lang string, walk func(fn func(l *level) bool)) []*Provision {
+	var top []*Provision
+	byLevel := make(map[*level]*Provision)
+	walk(func(l *level) bool {
+		p := &Provision{
+			Element:    l.element,
+			ID:         *l.id,
+			Identifier: *l.identifier,
+			Num:        l.num,
+			Heading:    l.heading,
+			Chapeau:    l.chapeau,
+			Content:    l.content,
+			Changed:    *l.changed,
+			Depth:      l.depth,
+			Node:       l.node,
+			Lang:       l.lang(),
+		}
+		if p.Lang == "" {
+			p.Lang = lang
+		}
+		byLevel[l] = p
+		if parent, ok := byLevel[l.parent]; ok {
+			p.Parent = parent
+			parent.Children = append(parent.Children, p)
+		} else {
+			top = append(top, p)
+		}
+		return true
+	})
+	return top
+}
+
+// Walk visits p and its descendants in document order. Returning false from
+// fn skips the provision's children.
+func (p *Provision) Walk(fn func(p *Provision) bool) {
+	if !fn(p) {
+		return
+	}
+	for _, c := range p.Children {
+		c.Walk(fn)
+	}
+}
+
+// Path returns the chain of provisions from the top-level ancestor down to
+// and including p (e.g., Title III, Sec. 301, (a), (2)).
+func (p *Provision) Path() []*Provision {
+	var path []*Provision
+	for q := p; q != nil; q = q.Parent {
+		path = append(path, q)
+	}
+	for i, j := 0, len(path)-1; i < j; i, j = i+1, j-1 {
+		path[i], path[j] = path[j], path[i]
+	}
+	return path
+}
+
+// PathString returns a human-readable citation of p, such as
+// "Section 301(a)(2)". It starts at the enclosing section, as citations
+// within an Act do, or at the top-level ancestor when there is no section;
+// a title itself reads "Title III".
+func (p *Provision) PathString() string {
+	path := p.Path()
+	start := 0
+	for i, q := range path {
+		if q.Element == "section" {
+			start = i
+			break
+		}
+	}
+
+	var b strings.Builder
+	for i, q := range path[start:] {
+		num := q.GetNumValue()
+		if num == "" {
+			num = strings.Trim(q.GetNum(), "()., \u2014\u201c\u201d")
+		}
+		if i == 0 {
+			b.WriteString(capitalize(q.Element))
+			if num != "" {
+				b.WriteString(" " + num)
+			}
+			continue
+		}
+		b.WriteString("(" + num + ")")
+	}
+	return b.String()
+}
+
+// capitalize upper-cases the first letter of s.
+func capitalize(s string) string {
+	if s == "" {
+		return s
+	}
+	r := []rune(s)
+	r[0] = unicode.ToUpper(r[0])
+	return string(r)
+}
+
+// GetID returns the provision's unique ID.
+func (p *Provision) GetID() string {
+	return p.ID
+}
+
+// GetIdentifier returns the provision's logical identifier.
+func (p *Provision) GetIdentifier() string {
+	return p.Identifier
+}
+
+// GetNum returns the provision's number text.
+func (p *Provision) GetNum() string {
+	if p.Num != nil {
+		return p.Num.Text
+	}
+	return ""
+}
+
+// GetNumValue returns the provision's normalized number value.
+func (p *Provision) GetNumValue() string {
+	if p.Num != nil {
+		return p.Num.Value
+	}
+	return ""
+}
+
+// GetHeading returns the provision's heading text in reading order.
+func (p *Provision) GetHeading() string {
+	if p.Heading != nil {
+		return p.Heading.PlainText()
+	}
+	return ""
+}
+
+// GetChapeau returns the provision's chapeau text in reading order.
+func (p *Provision) GetChapeau() string {
+	if p.Chapeau != nil {
+		return p.Chapeau.PlainText()
+	}
+	return ""
+}
+
+// GetContent returns the provision's content text in reading order.
+func (p *Provision) GetContent() string {
+	if p.Content != nil {
+		return p.Content.PlainText()
+	}
+	return ""
+}
+
+// GetText returns the provision's own text: its chapeau followed by its
+// content, without the text of its children.
+func (p *Provision) GetText() string {
+	return strings.TrimSpace(p.GetChapeau() + " " + p.GetContent())
+}
+
+// GetRefs returns the references in the provision's chapeau and content,
+// including nested references, in document order.
+func (p *Provision) GetRefs() []Ref {
+	var refs []Ref
+	add := func(list []Ref) {
+		for i := range list {
+			for r := &list[i]; r != nil; r = r.InnerRef {
+				refs = append(refs, *r)
+			}
+		}
+	}
+	if p.Chapeau != nil {
+		add(p.Chapeau.Ref)
+	}
+	if p.Content != nil {
+		add(p.Content.Ref)
+	}
+	return refs
+}
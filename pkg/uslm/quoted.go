@@ -0,0 +1,121 @@
+package uslm
+
+// QuotedBlock is a fragment of quoted text or content found in an amending
+// instruction, together with the scaffolding that gives it meaning.
+type QuotedBlock struct {
+	// Exactly one of QuotedContent and QuotedText is set.
+	QuotedContent *QuotedContent `json:"quotedContent,omitempty"`
+	QuotedText    *QuotedText    `json:"quotedText,omitempty"`
+
+	// Action is the amending action that introduces the fragment, if one was found.
+	Action *AmendingAction `json:"action,omitempty"`
+
+	// Targets are the references to the provision being amended, taken from the
+	// same content or, failing that, from the nearest enclosing chapeau or content.
+	Targets []Ref `json:"targets,omitempty"`
+
+	// Element and Identifier describe the hierarchical level containing the fragment.
+	Element    string `json:"element,omitempty"`
+	Identifier string `json:"identifier,omitempty"`
+}
+
+// GetText returns the quoted text, or an empty string for quoted content.
+func (q *QuotedBlock) GetText() string {
+	if q.QuotedText != nil {
+		return q.QuotedText.Text
+	}
+	return ""
+}
+
+// ExtractQuotedBlocks returns every quotedContent and quotedText fragment in the
+// document's hierarchical levels (and, for amendment documents, its amendment
+// instructions) so that new law text can be isolated from amendatory scaffolding.
+//
+// Because element order within mixed content is not preserved by the parsed
+// structs, actions are matched to fragments positionally: when a content block
+// has as many amending actions as fragments, they are paired in order (e.g.,
+// "striking "x" and inserting "y""); otherwise every fragment is attributed to
+// the last action in the block (e.g., "is amended by adding at the end the following").
+func ExtractQuotedBlocks(doc LegislativeDocument) []QuotedBlock {
+	var blocks []QuotedBlock
+
+	walkDocumentLevels(doc, func(l *level) bool {
+		if l.content != nil {
+			blocks = append(blocks, quotedBlocks(l.content, nearestTargets(l), l.element, *l.identifier)...)
+		}
+		return true
+	})
+
+	var main *AmendMain
+	switch d := doc.(type) {
+	case *EngrossedAmendment:
+		main = d.AmendMain
+	case *Amendment:
+		main = d.AmendMain
+	}
+	if main != nil {
+		for _, instruction := range main.AmendmentInstructions {
+			if instruction.Content != nil {
+				blocks = append(blocks, quotedBlocks(instruction.Content, instruction.Content.Ref, "amendmentInstruction", "")...)
+			}
+		}
+	}
+
+	return blocks
+}
+
+// quotedBlocks extracts the fragments of a single content block.
+func quotedBlocks(content *Content, targets []Ref, element, identifier string) []QuotedBlock {
+	count := len(content.QuotedText) + len(content.QuotedContent)
+	if count == 0 {
+		return nil
+	}
+
+	action := func(i int) *AmendingAction {
+		switch {
+		case len(content.AmendingAction) == 0:
+			return nil
+		case len(content.AmendingAction) == count:
+			return &content.AmendingAction[i]
+		}
+		return &content.AmendingAction[len(content.AmendingAction)-1]
+	}
+
+	blocks := make([]QuotedBlock, 0, count)
+	for i := range content.QuotedText {
+		blocks = append(blocks, QuotedBlock{
+			QuotedText: &content.QuotedText[i],
+			Action:     action(i),
+			Targets:    targets,
+			Element:    element,
+			Identifier: identifier,
+		})
+	}
+	for i := range content.QuotedContent {
+		blocks = append(blocks, QuotedBlock{
+			QuotedContent: &content.QuotedContent[i],
+			Action:        action(len(content.QuotedText) + i),
+			Targets:       targets,
+			Element:       element,
+			Identifier:    identifier,
+		})
+	}
+	return blocks
+}
+
+// nearestTargets returns the refs in the level's own content, or those in the
+// closest ancestor chapeau or content that has any.
+func nearestTargets(l *level) []Ref {
+	if l.content != nil && len(l.content.Ref) > 0 {
+		return l.content.Ref
+	}
+	for p := l.parent; p != nil; p = p.parent {
+		if p.chapeau != nil && len(p.chapeau.Ref) > 0 {
+			return p.chapeau.Ref
+		}
+		if p.content != nil && len(p.content.Ref) > 0 {
+			return p.content.Ref
+		}
+	}
+	return nil
+}
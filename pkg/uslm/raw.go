@@ -0,0 +1,347 @@
+package uslm
+
+import (
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// NodeType identifies the kind of a Node.
+type NodeType string
+
+const (
+	DocumentNode  NodeType = "document"
+	ElementNode   NodeType = "element"
+	TextNode      NodeType = "text"
+	CommentNode   NodeType = "comment"
+	ProcInstNode  NodeType = "procInst"
+	DirectiveNode NodeType = "directive"
+)
+
+// Node is a generic, ordered XML tree used as a fallback for documents or
+// elements the typed model does not represent. Names keep the prefixes
+// written in the source (e.g., "dc:title"), and namespace declarations are
+// kept as ordinary attributes, so writing a tree back out reproduces the
+// source markup apart from insignificant formatting inside tags.
+type Node struct {
+	Type NodeType `json:"type"`
+
+	// Name is the qualified element name, or the target of a processing
+	// instruction.
+	Name string `json:"name,omitempty"`
+
+	Attrs []NodeAttr `json:"attrs,omitempty"`
+
+	// Text is the character data of a text node, or the content of a
+	// comment, processing instruction, or directive.
+	Text string `json:"text,omitempty"`
+
+	Children []*Node `json:"children,omitempty"`
+}
+
+// NodeAttr is an attribute of an element Node.
+type NodeAttr struct {
+	Name  string `json:"name"` // qualified, e.g., "xml:lang" or "xmlns:dc"
+	Value string `json:"value"`
+}
+
+// ParseRaw parses data into a Node tree without interpreting it against the
+// USLM model. The returned node is a DocumentNode whose children are the
+// prolog (comments, processing instructions other than the XML declaration,
+// and directives) and the root element.
+func ParseRaw(data []byte) (*Node, error) {
+	run := beginParse("ParseRaw")
+	doc, err := parseRaw(newDecoder(bytes.NewReader(data), ParseOptions{keepPrefixes: true}, run))
+	if err == nil {
+		run.phase(PhaseDecode)
+	}
+	run.end(err)
+	return doc, err
+}
+
+// parseRaw builds the Node tree from the tokens of d.
+func parseRaw(d *xml.Decoder) (*Node, error) {
+	doc := &Node{Type: DocumentNode}
+	stack := []*Node{doc}
+
+	for {
+		tok, err := d.RawToken()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse XML: %w", err)
+		}
+		parent := stack[len(stack)-1]
+
+		switch t := tok.(type) {
+		case xml.StartElement:
+			n := &Node{Type: ElementNode, Name: qualifiedName(t.Name)}
+			for _, a := range t.Attr {
+				n.Attrs = append(n.Attrs, NodeAttr{Name: qualifiedName(a.Name), Value: a.Value})
+			}
+			parent.Children = append(parent.Children, n)
+			stack = append(stack, n)
+		case xml.EndElement:
+			if len(stack) == 1 || parent.Name != qualifiedName(t.Name) {
+				return nil, fmt.Errorf("failed to parse XML: unexpected end element </%s>", qualifiedName(t.Name))
+			}
+			stack = stack[:len(stack)-1]
+		case xml.CharData:
+			if parent == doc {
+				continue // whitespace between prolog items
+			}
+			if last := lastChild(parent); last != nil && last.Type == TextNode {
+				last.Text += string(t)
+			} else {
+				parent.Children = append(parent.Children, &Node{Type: TextNode, Text: string(t)})
+			}
+		case xml.Comment:
+			parent.Children = append(parent.Children, &Node{Type: CommentNode, Text: string(t)})
+		case xml.ProcInst:
+			if t.Target == "xml" {
+				continue // the declaration is regenerated on output
+			}
+			parent.Children = append(parent.Children, &Node{Type: ProcInstNode, Name: t.Target, Text: string(t.Inst)})
+		case xml.Directive:
+			parent.Children = append(parent.Children, &Node{Type: DirectiveNode, Text: string(t)})
+		}
+	}
+
+	if len(stack) != 1 {
+		return nil, fmt.Errorf("failed to parse XML: unclosed element <%s>", stack[len(stack)-1].Name)
+	}
+	if doc.Root() == nil {
+		return nil, fmt.Errorf("failed to parse XML: no root element")
+	}
+	return doc, nil
+}
+
+// NodeFromElement converts a typed document or element (e.g., *Bill or
+// *Section) to a Node tree by marshaling it.
+func NodeFromElement(v interface{}) (*Node, error) {
+	data, err := xml.Marshal(v)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal %T: %w", v, err)
+	}
+	doc, err := ParseRaw(data)
+	if err != nil {
+		return nil, err
+	}
+	return doc.Root(), nil
+}
+
+// Decode unmarshals the tree into a typed document or element, such as a
+// *Section.
+func (n *Node) Decode(v interface{}) error {
+	if err := xml.Unmarshal(n.Bytes(), v); err != nil {
+		return fmt.Errorf("failed to decode %s: %w", n.Name, err)
+	}
+	return nil
+}
+
+// Document parses the tree as a typed document.
+func (n *Node) Document() (LegislativeDocument, error) {
+	return ParseDocument(n.Bytes())
+}
+
+// Root returns the root element of a DocumentNode, or n itself for an element.
+func (n *Node) Root() *Node {
+	if n.Type != DocumentNode {
+		return n
+	}
+	for _, c := range n.Children {
+		if c.Type == ElementNode {
+			return c
+		}
+	}
+	return nil
+}
+
+// LocalName returns the element name without its prefix.
+func (n *Node) LocalName() string {
+	if i := strings.IndexByte(n.Name, ':'); i >= 0 {
+		return n.Name[i+1:]
+	}
+	return n.Name
+}
+
+// Attr returns the value of the attribute with the given qualified or local
+// name, or an empty string.
+func (n *Node) Attr(name string) string {
+	for _, a := range n.Attrs {
+		if a.Name == name {
+			return a.Value
+		}
+	}
+	for _, a := range n.Attrs {
+		if i := strings.IndexByte(a.Name, ':'); i >= 0 && a.Name[i+1:] == name && a.Name[:i] != "xmlns" {
+			return a.Value
+		}
+	}
+	return ""
+}
+
+// Elements returns the element children of n.
+func (n *Node) Elements() []*Node {
+	var elements []*Node
+	for _, c := range n.Children {
+		if c.Type == ElementNode {
+			elements = append(elements, c)
+		}
+	}
+	return elements
+}
+
+// Find returns the first descendant element (in document order) whose
+// qualified or local name is name, or nil.
+func (n *Node) Find(name string) *Node {
+	if all := n.find(name, true); len(all) > 0 {
+		return all[0]
+	}
+	return nil
+}
+
+// FindAll returns every descendant element whose qualified or local name is
+// name, in document order.
+func (n *Node) FindAll(name string) []*Node {
+	return n.find(name, false)
+}
+
+func (n *Node) find(name string, first bool) []*Node {
+	var found []*Node
+	var visit func(*Node) bool
+	visit = func(node *Node) bool {
+		for _, c := range node.Children {
+			if c.Type != ElementNode {
+				continue
+			}
+			if c.Name == name || c.LocalName() == name {
+				found = append(found, c)
+				if first {
+					return false
+				}
+			}
+			if !visit(c) {
+				return false
+			}
+		}
+		return true
+	}
+	visit(n)
+	return found
+}
+
+// InnerText returns the concatenated text of n and its descendants.
+func (n *Node) InnerText() string {
+	if n.Type == TextNode {
+		return n.Text
+	}
+	var b strings.Builder
+	for _, c := range n.Children {
+		if c.Type == TextNode || c.Type == ElementNode {
+			b.WriteString(c.InnerText())
+		}
+	}
+	return b.String()
+}
+
+// Bytes returns the tree as XML. A DocumentNode is preceded by an XML
+// declaration.
+func (n *Node) Bytes() []byte {
+	var buf bytes.Buffer
+	n.WriteXML(&buf)
+	return buf.Bytes()
+}
+
+// WriteXML writes the tree to w as XML.
+func (n *Node) WriteXML(w io.Writer) error {
+	var buf bytes.Buffer
+	n.write(&buf)
+	_, err := w.Write(buf.Bytes())
+	return err
+}
+
+func (n *Node) write(buf *bytes.Buffer) {
+	switch n.Type {
+	case DocumentNode:
+		buf.WriteString(xml.Header)
+		for i, c := range n.Children {
+			if i > 0 {
+				buf.WriteByte('\n')
+			}
+			c.write(buf)
+		}
+		buf.WriteByte('\n')
+	case ElementNode:
+		buf.WriteString("<" + n.Name)
+		for _, a := range n.Attrs {
+			buf.WriteString(" " + a.Name + `="`)
+			escapeRaw(buf, a.Value, true)
+			buf.WriteByte('"')
+		}
+		if len(n.Children) == 0 {
+			buf.WriteString("/>")
+			return
+		}
+		buf.WriteByte('>')
+		for _, c := range n.Children {
+			c.write(buf)
+		}
+		buf.WriteString("</" + n.Name + ">")
+	case TextNode:
+		escapeRaw(buf, n.Text, false)
+	case CommentNode:
+		buf.WriteString("<!--" + n.Text + "-->")
+	case ProcInstNode:
+		buf.WriteString("<?" + n.Name)
+		if n.Text != "" {
+			buf.WriteString(" " + n.Text)
+		}
+		buf.WriteString("?>")
+	case DirectiveNode:
+		buf.WriteString("<!" + n.Text + ">")
+	}
+}
+
+// escapeRaw writes s with the characters XML requires escaped. Unlike
+// xml.EscapeText it leaves newlines in text alone so output stays readable.
+func escapeRaw(buf *bytes.Buffer, s string, attr bool) {
+	for _, r := range s {
+		switch {
+		case r == '&':
+			buf.WriteString("&amp;")
+		case r == '<':
+			buf.WriteString("&lt;")
+		case r == '>':
+			buf.WriteString("&gt;")
+		case attr && r == '"':
+			buf.WriteString("&quot;")
+		case attr && r == '\n':
+			buf.WriteString("&#xA;")
+		case attr && r == '\t':
+			buf.WriteString("&#x9;")
+		case r == '\r':
+			buf.WriteString("&#xD;")
+		default:
+			buf.WriteRune(r)
+		}
+	}
+}
+
+func qualifiedName(name xml.Name) string {
+	if name.Space != "" {
+		return name.Space + ":" + name.Local
+	}
+	return name.Local
+}
+
+func lastChild(n *Node) *Node {
+	if len(n.Children) == 0 {
+		return nil
+	}
+	return n.Children[len(n.Children)-1]
+}
@@ -0,0 +1,212 @@
+package uslm
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// MetadataPolicy selects how ReconcileMetadataWithPolicy resolves
+// disagreements between a document's metadata and its preface.
+type MetadataPolicy int
+
+const (
+	// MetadataReportOnly reports conflicts without changing the document.
+	MetadataReportOnly MetadataPolicy = iota
+
+	// MetadataPreferMeta rewrites the preface to agree with the metadata.
+	MetadataPreferMeta
+
+	// MetadataPreferPreface rewrites the metadata to agree with the preface,
+	// whose values are the ones printed on the document.
+	MetadataPreferPreface
+)
+
+// MetadataConflict is a value the metadata and preface of a document both
+// give, differently.
+type MetadataConflict struct {
+	// Field is "congress", "session", "docNumber", "currentChamber", or
+	// "dcTitle".
+	Field string `json:"field"`
+
+	// Meta and Preface are the values as written, before any rewrite.
+	Meta    string `json:"meta"`
+	Preface string `json:"preface"`
+
+	// Resolved is set when the policy rewrote one side to match the other.
+	Resolved bool `json:"resolved,omitempty"`
+}
+
+func (c MetadataConflict) String() string {
+	return fmt.Sprintf("%s: meta %q, preface %q", c.Field, c.Meta, c.Preface)
+}
+
+var (
+	// leadingNumber matches the number of a printed congress or session,
+	// as in "110th CONGRESS" and "1st Session".
+	leadingNumber = regexp.MustCompile(`^\s*(\d+)`)
+
+	// titleCitationPrefix matches the citation GPO puts before the title in
+	// the metadata, as in "110 S 2062 RIS: ".
+	titleCitationPrefix = regexp.MustCompile(`^\d+ [^:]*\d[^:]*: `)
+)
+
+// ReconcileMetadata reports where a document's metadata and preface
+// disagree, without changing the document. See ReconcileMetadataWithPolicy.
+func ReconcileMetadata(doc LegislativeDocument) ([]MetadataConflict, error) {
+	return ReconcileMetadataWithPolicy(doc, MetadataReportOnly)
+}
+
+// ReconcileMetadataWithPolicy reports where a document's metadata and
+// preface disagree, and resolves each conflict according to policy.
+//
+// Bills and resolutions give their congress, session, document number,
+// current chamber, and title in both; amendments give only their current
+// chamber in both, and generic documents have no preface. Values are
+// compared as their meaning rather than their spelling: the preface's
+// printed "110th CONGRESS" agrees with a metadata congress of 110, and a
+// metadata title carrying the citation prefix ("110 S 2062 RIS: To amend
+// ...") agrees with the bare preface title, quotation marks aside. A value
+// given on only one side is not a conflict and is left alone.
+//
+// Rewriting the preface updates both the value attribute and the printed
+// text of its congress, session, and current chamber.
+func ReconcileMetadataWithPolicy(doc LegislativeDocument, policy MetadataPolicy) ([]MetadataConflict, error) {
+	switch d := doc.(type) {
+	case *Bill:
+		return reconcilePreface(d.Meta, d.Preface, policy), nil
+	case *Resolution:
+		return reconcilePreface(d.Meta, d.Preface, policy), nil
+	case *EngrossedAmendment:
+		return reconcileAmendPreface(d.AmendMeta, d.AmendPreface, policy), nil
+	case *Amendment:
+		return reconcileAmendPreface(d.AmendMeta, d.AmendPreface, policy), nil
+	case *GenericDocument:
+		return nil, nil
+	}
+	return nil, fmt.Errorf("unsupported document type %T", doc)
+}
+
+// reconcilePreface compares and resolves the fields a bill or resolution
+// gives in both its metadata and its preface.
+func reconcilePreface(m *Meta, p *Preface, policy MetadataPolicy) []MetadataConflict {
+	if m == nil || p == nil {
+		return nil
+	}
+	var conflicts []MetadataConflict
+	add := func(c *MetadataConflict) {
+		if c != nil {
+			conflicts = append(conflicts, *c)
+		}
+	}
+
+	if p.Congress != nil {
+		add(reconcileField("congress", &m.Congress, prefaceNumber(p.Congress.Value, p.Congress.Text), normalizeSpace, policy, func(v string) {
+			p.Congress.Value, p.Congress.Text = v, congressLine(v)
+		}))
+	}
+	if p.Session != nil {
+		add(reconcileField("session", &m.Session, prefaceNumber(p.Session.Value, p.Session.Text), normalizeSpace, policy, func(v string) {
+			p.Session.Value, p.Session.Text = v, sessionLine(v)
+		}))
+	}
+	add(reconcileField("docNumber", &m.DocNumber, p.DocNumber, func(s string) string {
+		return strings.ToLower(normalizeSpace(s))
+	}, policy, func(v string) {
+		p.DocNumber = v
+	}))
+	if p.CurrentChamber != nil {
+		add(reconcileChamber(&m.CurrentChamber, p.CurrentChamber, policy))
+	}
+
+	// The metadata title carries a citation prefix the preface omits.
+	prefix := titleCitationPrefix.FindString(m.DCTitle)
+	title := m.DCTitle[len(prefix):]
+	if c := reconcileField("dcTitle", &title, p.DCTitle, titleKey, policy, func(v string) {
+		p.DCTitle = v
+	}); c != nil {
+		c.Meta = m.DCTitle
+		m.DCTitle = prefix + title
+		add(c)
+	}
+	return conflicts
+}
+
+// reconcileAmendPreface compares and resolves the current chamber an
+// amendment gives in both its metadata and its preface.
+func reconcileAmendPreface(m *AmendMeta, p *AmendPreface, policy MetadataPolicy) []MetadataConflict {
+	if m == nil || p == nil || p.CurrentChamber == nil {
+		return nil
+	}
+	if c := reconcileChamber(&m.CurrentChamber, p.CurrentChamber, policy); c != nil {
+		return []MetadataConflict{*c}
+	}
+	return nil
+}
+
+// reconcileChamber compares and resolves a current chamber.
+func reconcileChamber(meta *string, p *CurrentChamber, policy MetadataPolicy) *MetadataConflict {
+	value := p.Value
+	if value == "" {
+		value = chamberOf(p.Text)
+	}
+	return reconcileField("currentChamber", meta, value, chamberOf, policy, func(v string) {
+		p.Value = strings.ToUpper(v)
+		switch chamberOf(v) {
+		case "SENATE":
+			p.Text = "IN THE SENATE OF THE UNITED STATES"
+		case "HOUSE":
+			p.Text = "IN THE HOUSE OF REPRESENTATIVES"
+		}
+	})
+}
+
+// reconcileField compares the metadata value *meta with the preface value
+// after applying key to both, and on a conflict applies policy: it sets
+// *meta, or calls setPreface with the metadata value.
+func reconcileField(field string, meta *string, preface string, key func(string) string, policy MetadataPolicy, setPreface func(string)) *MetadataConflict {
+	if *meta == "" || preface == "" || key(*meta) == key(preface) {
+		return nil
+	}
+	c := &MetadataConflict{Field: field, Meta: *meta, Preface: preface}
+	switch policy {
+	case MetadataPreferMeta:
+		setPreface(*meta)
+		c.Resolved = true
+	case MetadataPreferPreface:
+		*meta = preface
+		c.Resolved = true
+	}
+	return c
+}
+
+// prefaceNumber returns the number of a preface congress or session: its
+// value attribute, or the number its printed text begins with.
+func prefaceNumber(value, text string) string {
+	if value != "" {
+		return value
+	}
+	if m := leadingNumber.FindStringSubmatch(text); m != nil {
+		return m[1]
+	}
+	return ""
+}
+
+// titleKey returns a title without typographic differences or quotation
+// marks, which the metadata title often omits.
+func titleKey(s string) string {
+	return normalizeSpace(strings.NewReplacer(`"`, "", "'", "").Replace(NormalizeText(s, DefaultNormalizeOptions())))
+}
+
+// chamberOf returns "HOUSE" or "SENATE" for a chamber value or its printed
+// form, or s in capitals.
+func chamberOf(s string) string {
+	upper := strings.ToUpper(normalizeSpace(s))
+	switch {
+	case strings.Contains(upper, "SENATE"):
+		return "SENATE"
+	case strings.Contains(upper, "HOUSE"):
+		return "HOUSE"
+	}
+	return upper
+}
@@ -0,0 +1,231 @@
+package uslm
+
+import (
+	"regexp"
+	"strings"
+)
+
+// ReferralEventKind classifies what an action did to a committee's referral.
+type ReferralEventKind string
+
+const (
+	// ReferralReferred is the referral of the measure to the committee.
+	ReferralReferred ReferralEventKind = "referred"
+
+	// ReferralExtended is an extension of the period of a referral.
+	ReferralExtended ReferralEventKind = "extended"
+
+	// ReferralReported is the committee's report of the measure, which
+	// ends the referral.
+	ReferralReported ReferralEventKind = "reported"
+
+	// ReferralDischarged is the committee's discharge from further
+	// consideration of the measure, which ends the referral.
+	ReferralDischarged ReferralEventKind = "discharged"
+)
+
+// ReferralEvent is one action in a committee's referral history.
+type ReferralEvent struct {
+	Kind ReferralEventKind
+
+	// Date is the date (YYYY-MM-DD) of the action, or "" if it has none.
+	// See ActionDate.ISODate.
+	Date string
+
+	// Action is the action recording the event.
+	Action *Action
+}
+
+// Referral is the referral history of one committee: the actions that
+// referred the measure to it, extended the referral, and reported the
+// measure or discharged the committee, in document order.
+type Referral struct {
+	Committee Committee
+	Events    []ReferralEvent
+}
+
+// Pending reports whether the committee still holds the measure: it was
+// referred and has neither reported nor been discharged since.
+func (r Referral) Pending() bool {
+	if len(r.Events) == 0 {
+		return false
+	}
+	switch r.Events[len(r.Events)-1].Kind {
+	case ReferralReferred, ReferralExtended:
+		return true
+	}
+	return false
+}
+
+var (
+	// referralPattern matches the referral of a measure to a committee,
+	// as in "read twice and referred to the Committee on Finance" and
+	// "Referred to the Committee on the Judiciary".
+	referralPattern = regexp.MustCompile(`(?i)\breferred to\b`)
+
+	// referralExtendedPattern matches the extension of a referral period.
+	referralExtendedPattern = regexp.MustCompile(`(?i)\breferral to\b.*\bextended\b`)
+
+	// reportedPattern matches a committee's report, as in "Reported from
+	// the Committee on Ways and Means" and "from the Committee on Armed
+	// Services, reported the following original bill".
+	reportedPattern = regexp.MustCompile(`(?i)\breported\b`)
+
+	// dischargedPattern matches a committee's discharge.
+	dischargedPattern = regexp.MustCompile(`(?i)\bdischarged\b`)
+
+	// reportingCommitteePattern captures the committee named in an
+	// original bill's report when it is not marked up as a <committee>.
+	reportingCommitteePattern = regexp.MustCompile(`\bfrom the (Committee on [A-Z][^,;]*)`)
+)
+
+// GetReferrals reconstructs the committee referral history of a document
+// from its actions: one Referral per committee, in the order the committees
+// first appear, with the actions that referred the measure to it, extended
+// the referral, reported it, or discharged the committee.
+//
+// Committees are taken from the <committee> elements of each action and
+// matched across actions by committee ID, or by name when they have none.
+// Discharge actions often name no <committee>: "Committee discharged" ends
+// every referral still pending, and "The Committees on Agriculture and Ways
+// and Means discharged" ends the pending referrals whose committee names
+// appear in the text. A document without actions has no referrals.
+func GetReferrals(doc LegislativeDocument) []Referral {
+	ad, ok := doc.(ActionDocument)
+	if !ok {
+		return nil
+	}
+	actions := ad.GetActions()
+
+	var referrals []*Referral
+	byKey := make(map[string]*Referral)
+	referral := func(c Committee) *Referral {
+		key := committeeKey(c)
+		if r, ok := byKey[key]; ok {
+			return r
+		}
+		r := &Referral{Committee: c}
+		byKey[key] = r
+		referrals = append(referrals, r)
+		return r
+	}
+
+	for i := range actions {
+		action := &actions[i]
+		d := action.ActionDescription
+		if d == nil {
+			continue
+		}
+		text := normalizeSpace(d.Text + " " + committeeNames(d.Committees))
+		event := func(r *Referral, kind ReferralEventKind) {
+			r.Events = append(r.Events, ReferralEvent{Kind: kind, Date: action.Date.ISODate(), Action: action})
+		}
+
+		referred := referralPattern.MatchString(text)
+		if dischargedPattern.MatchString(text) {
+			// The committees marked up in an action that also refers the
+			// measure are those it is referred to, not those discharged.
+			var named []Committee
+			if !referred {
+				named = d.Committees
+			}
+			for _, r := range dischargedReferrals(referrals, named, d.Text) {
+				event(r, ReferralDischarged)
+			}
+		}
+
+		var kind ReferralEventKind
+		switch {
+		case referralExtendedPattern.MatchString(text):
+			kind = ReferralExtended
+		case referred:
+			kind = ReferralReferred
+		case reportedPattern.MatchString(text):
+			kind = ReferralReported
+		default:
+			continue
+		}
+		committees := d.Committees
+		if len(committees) == 0 && kind == ReferralReported {
+			if m := reportingCommitteePattern.FindStringSubmatch(d.Text); m != nil {
+				committees = []Committee{{Text: strings.TrimSpace(m[1])}}
+			}
+		}
+		for _, c := range committees {
+			event(referral(c), kind)
+		}
+	}
+
+	result := make([]Referral, len(referrals))
+	for i, r := range referrals {
+		result[i] = *r
+	}
+	return result
+}
+
+// dischargedReferrals returns the pending referrals a discharge action
+// ends: those of the committees it marks up, or else those whose committee
+// names appear in its text, or else every pending referral.
+func dischargedReferrals(referrals []*Referral, named []Committee, text string) []*Referral {
+	var pending []*Referral
+	for _, r := range referrals {
+		if r.Pending() {
+			pending = append(pending, r)
+		}
+	}
+	if len(named) > 0 {
+		keys := make(map[string]bool)
+		for _, c := range named {
+			keys[committeeKey(c)] = true
+		}
+		var matched []*Referral
+		for _, r := range pending {
+			if keys[committeeKey(r.Committee)] {
+				matched = append(matched, r)
+			}
+		}
+		return matched
+	}
+	text = strings.ToLower(normalizeSpace(text))
+	var matched []*Referral
+	for _, r := range pending {
+		if name := committeeShortName(r.Committee.Text); name != "" && strings.Contains(text, name) {
+			matched = append(matched, r)
+		}
+	}
+	if len(matched) > 0 {
+		return matched
+	}
+	return pending
+}
+
+// committeeKey identifies a committee across actions: by its ID, or by
+// its short name when it has none.
+func committeeKey(c Committee) string {
+	if id := strings.ToUpper(strings.TrimSpace(c.CommitteeID)); id != "" {
+		return "id:" + id
+	}
+	return "name:" + committeeShortName(c.Text)
+}
+
+// committeeShortName returns a committee's name in lower case without the
+// "Committee on" and "the" that precede it in running text, so that
+// "Committee on the Judiciary" and "the Judiciary" compare equal.
+func committeeShortName(name string) string {
+	name = strings.ToLower(normalizeSpace(name))
+	for _, prefix := range []string{"committees on ", "committee on ", "the "} {
+		name = strings.TrimPrefix(name, prefix)
+	}
+	return name
+}
+
+// committeeNames joins the names of committees for pattern matching, since
+// a description's character data omits the text of its <committee>
+// elements.
+func committeeNames(committees []Committee) string {
+	names := make([]string, len(committees))
+	for i, c := range committees {
+		names[i] = c.Text
+	}
+	return strings.Join(names, " ")
+}
@@ -0,0 +1,253 @@
+package uslm
+
+import (
+	"fmt"
+	"html"
+	"strings"
+)
+
+// markdownEscaper escapes the characters that Markdown would otherwise read
+// as emphasis, links, code, or HTML in running text.
+var markdownEscaper = strings.NewReplacer(
+	`\`, `\\`, "*", `\*`, "_", `\_`, "`", "\\`", "[", `\[`, "]", `\]`, "<", "&lt;", ">", "&gt;",
+)
+
+// documentHeading returns the "Senate Bill 32 — Title" heading used by the
+// rendered forms of doc.
+func documentHeading(doc LegislativeDocument) string {
+	heading := normalizeSpace(doc.GetDocumentType() + " " + doc.GetDocumentNumber())
+	title := normalizeSpace(doc.GetTitle())
+	switch {
+	case heading != "" && title != "":
+		return heading + " — " + title
+	case heading != "":
+		return heading
+	default:
+		return title
+	}
+}
+
+// ToMarkdown renders the full text of the document as Markdown: titles and
+// sections become headings, and each lower level a paragraph led by its
+// number and heading in bold. Unlike Summarize, no text is dropped.
+func ToMarkdown(doc LegislativeDocument) string {
+	var b strings.Builder
+	if heading := documentHeading(doc); heading != "" {
+		fmt.Fprintf(&b, "# %s\n", markdownEscaper.Replace(heading))
+	}
+
+	for _, top := range Provisions(doc) {
+		top.Walk(func(p *Provision) bool {
+			label := markdownEscaper.Replace(strings.TrimSpace(normalizeSpace(p.GetNum()) + " " + p.GetHeading()))
+			text := markdownEscaper.Replace(p.GetText())
+
+			switch p.Element {
+			case "title", "section":
+				marker := "##"
+				if p.Element == "section" {
+					marker = "###"
+				}
+				fmt.Fprintf(&b, "\n%s %s\n", marker, label)
+				if text != "" {
+					fmt.Fprintf(&b, "\n%s\n", text)
+				}
+			default:
+				if label == "" && text == "" {
+					return true
+				}
+				b.WriteString("\n")
+				if label != "" {
+					fmt.Fprintf(&b, "**%s**", label)
+					if text != "" {
+						b.WriteString(" ")
+					}
+				}
+				b.WriteString(text)
+				b.WriteString("\n")
+			}
+			return true
+		})
+	}
+
+	return b.String()
+}
+
+// HTMLOptions configures ToHTMLWithOptions.
+type HTMLOptions struct {
+	// Accessible adds the markup assistive technology relies on, for
+	// publishing under Section 508: the page language from dc:language, a
+	// main landmark with skip links to it and to each title, heading levels
+	// that follow the nesting of the provisions (levels nested deeper than
+	// <h6> use role="heading" with aria-level), and accessible names tying
+	// each provision to its heading or, when it has none, its citation.
+	Accessible bool
+
+	// TitleCaseHeadings writes the headings of provisions in title case
+	// (see TitleCaseHeading), so that capitalized section headings and
+	// small-caps subsection headings read alike.
+	TitleCaseHeadings bool
+}
+
+// ToHTML renders the full text of the document as a standalone HTML page.
+// Each level becomes a <section> whose class is its element name and whose
+// id and data-identifier carry the level's id and identifier, so the page
+// can be styled and linked into by citation.
+func ToHTML(doc LegislativeDocument) string {
+	return ToHTMLWithOptions(doc, HTMLOptions{})
+}
+
+// ToHTMLWithOptions is like ToHTML but applies opts.
+func ToHTMLWithOptions(doc LegislativeDocument, opts HTMLOptions) string {
+	r := htmlRenderer{opts: opts}
+	heading := html.EscapeString(documentHeading(doc))
+	provisions := Provisions(doc)
+
+	lang := "en"
+	if meta := doc.GetMeta(); opts.Accessible && meta != nil && strings.TrimSpace(meta.DCLanguage) != "" {
+		lang = strings.ToLower(strings.TrimSpace(meta.DCLanguage))
+	}
+	fmt.Fprintf(&r.b, "<!DOCTYPE html>\n<html lang=\"%s\">\n<head>\n<meta charset=\"utf-8\">\n", html.EscapeString(lang))
+	fmt.Fprintf(&r.b, "<title>%s</title>\n</head>\n<body>\n", heading)
+
+	if !opts.Accessible {
+		r.b.WriteString("<article>\n")
+		if heading != "" {
+			fmt.Fprintf(&r.b, "<h1>%s</h1>\n", heading)
+		}
+		for _, p := range provisions {
+			r.provision(p)
+		}
+		r.b.WriteString("</article>\n</body>\n</html>\n")
+		return r.b.String()
+	}
+
+	r.b.WriteString("<nav aria-label=\"Skip links\">\n<ul>\n<li><a href=\"#main\">Skip to main content</a></li>\n")
+	for _, p := range provisions {
+		if p.Element == "title" {
+			fmt.Fprintf(&r.b, "<li><a href=\"#%s\">Skip to %s</a></li>\n",
+				html.EscapeString(r.anchor(p)), html.EscapeString(provisionLabel(p)))
+		}
+	}
+	r.b.WriteString("</ul>\n</nav>\n<main id=\"main\">\n<article aria-labelledby=\"document-heading\">\n")
+	fmt.Fprintf(&r.b, "<h1 id=\"document-heading\">%s</h1>\n", heading)
+	for _, p := range provisions {
+		r.provision(p)
+	}
+	r.b.WriteString("</article>\n</main>\n</body>\n</html>\n")
+	return r.b.String()
+}
+
+// htmlRenderer writes the HTML form of a document.
+type htmlRenderer struct {
+	opts HTMLOptions
+	b    strings.Builder
+
+	// anchors holds the ids assigned to provisions that have none, so that
+	// skip links and accessible names can point at them.
+	anchors map[*Provision]string
+}
+
+// anchor returns the id of p's <section>, assigning one if p has no id.
+func (r *htmlRenderer) anchor(p *Provision) string {
+	if p.ID != "" {
+		return p.ID
+	}
+	if id, ok := r.anchors[p]; ok {
+		return id
+	}
+	if r.anchors == nil {
+		r.anchors = make(map[*Provision]string)
+	}
+	id := fmt.Sprintf("%s-%d", p.Element, len(r.anchors)+1)
+	r.anchors[p] = id
+	return id
+}
+
+// provisionLabel returns p's number and heading, e.g. "TITLE I—GENERAL
+// PROVISIONS", falling back to its citation.
+func provisionLabel(p *Provision) string {
+	if label := strings.TrimSpace(normalizeSpace(p.GetNum()) + " " + p.GetHeading()); label != "" {
+		return label
+	}
+	return p.PathString()
+}
+
+// provision writes p and its children as nested <section> elements.
+func (r *htmlRenderer) provision(p *Provision) {
+	b := &r.b
+	num, heading := normalizeSpace(p.GetNum()), p.GetHeading()
+	if r.opts.TitleCaseHeadings {
+		heading = TitleCaseHeading(heading)
+	}
+	hasHeading := num != "" || heading != ""
+
+	fmt.Fprintf(b, "<section class=\"%s\"", html.EscapeString(p.Element))
+	var id string
+	if r.opts.Accessible {
+		id = r.anchor(p)
+	} else {
+		id = p.ID
+	}
+	if id != "" {
+		fmt.Fprintf(b, " id=\"%s\"", html.EscapeString(id))
+	}
+	if p.Identifier != "" {
+		fmt.Fprintf(b, " data-identifier=\"%s\"", html.EscapeString(p.Identifier))
+	}
+	if r.opts.Accessible {
+		if hasHeading {
+			fmt.Fprintf(b, " aria-labelledby=\"%s-heading\"", html.EscapeString(id))
+		} else {
+			fmt.Fprintf(b, " aria-label=\"%s\"", html.EscapeString(p.PathString()))
+		}
+	}
+	b.WriteString(">\n")
+
+	if hasHeading {
+		open, end := r.headingTags(p, id)
+		b.WriteString(open)
+		if num != "" {
+			fmt.Fprintf(b, "<span class=\"num\">%s</span>", html.EscapeString(num))
+			if heading != "" {
+				b.WriteString(" ")
+			}
+		}
+		if heading != "" {
+			fmt.Fprintf(b, "<span class=\"heading\">%s</span>", html.EscapeString(heading))
+		}
+		b.WriteString(end + "\n")
+	}
+	if text := p.GetChapeau(); text != "" {
+		fmt.Fprintf(b, "<p class=\"chapeau\">%s</p>\n", html.EscapeString(text))
+	}
+	for _, child := range p.Children {
+		r.provision(child)
+	}
+	if text := p.GetContent(); text != "" {
+		fmt.Fprintf(b, "<p class=\"content\">%s</p>\n", html.EscapeString(text))
+	}
+	b.WriteString("</section>\n")
+}
+
+// headingTags returns the tags enclosing p's heading. By default titles are
+// h2 and sections h3, and deeper levels share h4 so the outline stays within
+// the six heading levels. Accessible output instead ranks headings by
+// nesting below the h1 of the document, continuing past h6 with ARIA levels.
+func (r *htmlRenderer) headingTags(p *Provision, id string) (string, string) {
+	if !r.opts.Accessible {
+		tag := "h4"
+		switch p.Element {
+		case "title":
+			tag = "h2"
+		case "section":
+			tag = "h3"
+		}
+		return "<" + tag + ">", "</" + tag + ">"
+	}
+	level := p.Depth + 2
+	headingID := html.EscapeString(id) + "-heading"
+	if level > 6 {
+		return fmt.Sprintf("<div role=\"heading\" aria-level=\"%d\" id=\"%s\">", level, headingID), "</div>"
+	}
+	return fmt.Sprintf("<h%d id=\"%s\">", level, headingID), fmt.Sprintf("</h%d>", level)
+}
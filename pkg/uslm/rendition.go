@@ -0,0 +1,297 @@
+package uslm
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// TextComparison reports how the text extracted from a document (by
+// ExtractText) compares, word for word, with the text of one of its official
+// renditions: the plain text published alongside the XML, or text extracted
+// from the PDF. Words are compared ignoring case, punctuation, and
+// typographic differences, so that divergences point at text the extraction
+// dropped, duplicated, or garbled rather than at presentation.
+type TextComparison struct {
+	// ExtractedWords and RenditionWords count the words of each text, and
+	// MatchingWords those found in both, in the same order.
+	ExtractedWords int `json:"extractedWords"`
+	RenditionWords int `json:"renditionWords"`
+	MatchingWords  int `json:"matchingWords"`
+
+	// Divergences lists the runs of words that differ, in document order.
+	Divergences []TextDivergence `json:"divergences,omitempty"`
+
+	// Truncated is set when the texts differ too much to be aligned word by
+	// word. The unaligned middle of the texts is then reported as a single
+	// divergence.
+	Truncated bool `json:"truncated,omitempty"`
+}
+
+// TextDivergence is a run of words found in only one of the compared texts,
+// or replaced by other words in the other.
+type TextDivergence struct {
+	// Offset is the position, in words, of the run in the extracted text.
+	Offset int `json:"offset"`
+
+	// Extracted holds the words of the extracted text and Rendition those of
+	// the rendition; either is empty for a run found in only one text. Front
+	// matter and tables of contents, which ExtractText omits, appear as
+	// rendition-only runs.
+	Extracted string `json:"extracted,omitempty"`
+	Rendition string `json:"rendition,omitempty"`
+}
+
+// Coverage returns the fraction of the extracted words found in the
+// rendition, from 0 to 1. A faithful extraction covers nearly all of them.
+func (c *TextComparison) Coverage() float64 {
+	if c.ExtractedWords == 0 {
+		return 1
+	}
+	return float64(c.MatchingWords) / float64(c.ExtractedWords)
+}
+
+// Similarity returns the share of the words of both texts that match, from
+// 0 to 1. Unlike Coverage, it is lowered by rendition-only text.
+func (c *TextComparison) Similarity() float64 {
+	if c.ExtractedWords+c.RenditionWords == 0 {
+		return 1
+	}
+	return 2 * float64(c.MatchingWords) / float64(c.ExtractedWords+c.RenditionWords)
+}
+
+// CompareRenditionText compares the text ExtractText returns for doc with
+// rendition, the text of an official rendition of the same document, after
+// normalizing rendition with NormalizeRenditionText.
+func CompareRenditionText(doc LegislativeDocument, rendition string) *TextComparison {
+	return compareTexts(ExtractText(doc, DefaultNormalizeOptions()), NormalizeRenditionText(rendition))
+}
+
+var (
+	// renditionNoise matches lines of a rendition that are not document
+	// text: the bracketed banner and DOC markers of GPO plain text, and the
+	// page numbers, bullet running heads ("•S 32 PCS"), VerDate slugs, and
+	// rules of the PDF.
+	renditionNoise = regexp.MustCompile(`^\s*(\[[^\]]*\]|</?DOC>|<all>|\d+|•.*|VerDate .*|[_\-–—]{5,})\s*$`)
+
+	// renditionLineNumber matches the line number printed in the left
+	// margin of bill PDFs.
+	renditionLineNumber = regexp.MustCompile(`^\s*(\d{1,2})\s+`)
+
+	// gpoQuoteReplacer replaces the paired backquotes and apostrophes GPO
+	// plain text uses for quotation marks.
+	gpoQuoteReplacer = strings.NewReplacer("``", `"`, "''", `"`)
+)
+
+// NormalizeRenditionText prepares the text of an official rendition for
+// comparison with extracted text. It removes the banner of GPO plain text,
+// and the page numbers, running heads, and margin line numbers of text
+// extracted from PDFs; rejoins words hyphenated across lines; replaces GPO
+// plain-text quotation marks; and applies every normalization of
+// NormalizeText, leaving the text on a single line.
+func NormalizeRenditionText(s string) string {
+	lines := strings.Split(strings.ReplaceAll(s, "\r\n", "\n"), "\n")
+	kept := lines[:0]
+	for _, line := range lines {
+		if !renditionNoise.MatchString(line) {
+			kept = append(kept, line)
+		}
+	}
+	if hasLineNumbers(kept) {
+		for i, line := range kept {
+			kept[i] = renditionLineNumber.ReplaceAllString(line, "")
+		}
+	}
+
+	var joined []string
+	for _, line := range kept {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+		if n := len(joined); n > 0 && hyphenatedAtEnd(joined[n-1]) && startsWithLetter(line) {
+			joined[n-1] = strings.TrimSuffix(joined[n-1], "-") + line
+			continue
+		}
+		joined = append(joined, line)
+	}
+	return NormalizeText(gpoQuoteReplacer.Replace(strings.Join(joined, " ")), DefaultNormalizeOptions())
+}
+
+// hasLineNumbers reports whether most lines begin with a PDF margin line
+// number (1 to 25), as opposed to text that happens to begin with a number.
+func hasLineNumbers(lines []string) bool {
+	var numbered, total int
+	for _, line := range lines {
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		total++
+		if m := renditionLineNumber.FindStringSubmatch(line); m != nil {
+			if n, _ := strconv.Atoi(m[1]); n >= 1 && n <= 25 {
+				numbered++
+			}
+		}
+	}
+	return total > 0 && numbered*2 >= total
+}
+
+// hyphenatedAtEnd reports whether a line ends with a word broken by a
+// hyphen, rather than with a dash.
+func hyphenatedAtEnd(line string) bool {
+	return strings.HasSuffix(line, "-") && !strings.HasSuffix(line, "--") && !strings.HasSuffix(line, " -")
+}
+
+// startsWithLetter reports whether s begins with a letter.
+func startsWithLetter(s string) bool {
+	for _, r := range s {
+		return unicode.IsLetter(r)
+	}
+	return false
+}
+
+// comparedWords splits text into words, at spaces and dashes, keeping each
+// word as written and its comparison key: its letters and digits, in lower
+// case. Words without letters or digits are dropped.
+func comparedWords(text string) (words, keys []string) {
+	for _, w := range strings.FieldsFunc(text, func(r rune) bool { return unicode.IsSpace(r) || r == '-' }) {
+		key := strings.Map(func(r rune) rune {
+			if unicode.IsLetter(r) || unicode.IsDigit(r) {
+				return unicode.ToLower(r)
+			}
+			return -1
+		}, w)
+		if key != "" {
+			words = append(words, w)
+			keys = append(keys, key)
+		}
+	}
+	return words, keys
+}
+
+// compareTexts aligns the words of extracted and rendition, both normalized.
+func compareTexts(extracted, rendition string) *TextComparison {
+	aWords, aKeys := comparedWords(extracted)
+	bWords, bKeys := comparedWords(rendition)
+	matches, truncated := matchWords(aKeys, bKeys)
+
+	c := &TextComparison{
+		ExtractedWords: len(aKeys),
+		RenditionWords: len(bKeys),
+		MatchingWords:  len(matches),
+		Truncated:      truncated,
+	}
+	var i, j int
+	for _, m := range append(matches, [2]int{len(aKeys), len(bKeys)}) {
+		if m[0] > i || m[1] > j {
+			c.Divergences = append(c.Divergences, TextDivergence{
+				Offset:    i,
+				Extracted: strings.Join(aWords[i:m[0]], " "),
+				Rendition: strings.Join(bWords[j:m[1]], " "),
+			})
+		}
+		i, j = m[0]+1, m[1]+1
+	}
+	return c
+}
+
+// maxTextEdits bounds the number of word insertions and deletions
+// matchWords searches for, which bounds its time and memory.
+const maxTextEdits = 1000
+
+// matchWords returns the positions, in a and b, of the words of a longest
+// common subsequence of a and b, found with Myers' algorithm after trimming
+// the common prefix and suffix. If a and b differ by more than maxTextEdits
+// words, the words between the common prefix and suffix are left unmatched
+// and truncated is set.
+func matchWords(a, b []string) (matches [][2]int, truncated bool) {
+	start := 0
+	for start < len(a) && start < len(b) && a[start] == b[start] {
+		matches = append(matches, [2]int{start, start})
+		start++
+	}
+	endA, endB := len(a), len(b)
+	for endA > start && endB > start && a[endA-1] == b[endB-1] {
+		endA--
+		endB--
+	}
+
+	middle, ok := myersMatches(a[start:endA], b[start:endB])
+	for _, m := range middle {
+		matches = append(matches, [2]int{start + m[0], start + m[1]})
+	}
+	for k := 0; endA+k < len(a); k++ {
+		matches = append(matches, [2]int{endA + k, endB + k})
+	}
+	return matches, !ok
+}
+
+// myersMatches returns the matched positions of a shortest edit script
+// turning a into b, or false if it needs more than maxTextEdits edits.
+func myersMatches(a, b []string) ([][2]int, bool) {
+	n, m := len(a), len(b)
+	limit := n + m
+	if limit > maxTextEdits {
+		limit = maxTextEdits
+	}
+	offset := limit + 1
+	v := make([]int, 2*limit+3)
+	// trace[d] holds the furthest x reached on diagonals -d-1 to d+1 before
+	// step d, for the backtrack.
+	var trace [][]int
+	for d := 0; d <= limit; d++ {
+		trace = append(trace, append([]int(nil), v[offset-d-1:offset+d+2]...))
+		for k := -d; k <= d; k += 2 {
+			var x int
+			if k == -d || (k != d && v[offset+k-1] < v[offset+k+1]) {
+				x = v[offset+k+1]
+			} else {
+				x = v[offset+k-1] + 1
+			}
+			y := x - k
+			for x < n && y < m && a[x] == b[y] {
+				x++
+				y++
+			}
+			v[offset+k] = x
+			if x >= n && y >= m {
+				return backtrackMatches(trace, n, m), true
+			}
+		}
+	}
+	return nil, false
+}
+
+// backtrackMatches recovers the matched positions from the trace recorded by
+// myersMatches for an edit script ending at (n, m).
+func backtrackMatches(trace [][]int, n, m int) [][2]int {
+	var matches [][2]int
+	x, y := n, m
+	for d := len(trace) - 1; d > 0; d-- {
+		prev := trace[d]
+		at := func(k int) int { return prev[k+d+1] }
+		k := x - y
+		prevK := k - 1
+		if k == -d || (k != d && at(k-1) < at(k+1)) {
+			prevK = k + 1
+		}
+		prevX := at(prevK)
+		prevY := prevX - prevK
+		for x > prevX && y > prevY {
+			x--
+			y--
+			matches = append(matches, [2]int{x, y})
+		}
+		x, y = prevX, prevY
+	}
+	for x > 0 && y > 0 {
+		x--
+		y--
+		matches = append(matches, [2]int{x, y})
+	}
+	for i, j := 0, len(matches)-1; i < j; i, j = i+1, j-1 {
+		matches[i], matches[j] = matches[j], matches[i]
+	}
+	return matches
+}
@@ -0,0 +1,366 @@
+package uslm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+// ErrUnsupportedRef is returned for reference hrefs that name no document a
+// Resolver can fetch, such as calendar and report references.
+var ErrUnsupportedRef = errors.New("unsupported reference")
+
+// RefKind classifies the document a reference href points to.
+type RefKind string
+
+const (
+	// RefBill is a bill or resolution, as in "/us/bill/116/hr/1058".
+	RefBill RefKind = "bill"
+
+	// RefPublicLaw is a public law, as in "/us/pl/116/60".
+	RefPublicLaw RefKind = "pl"
+
+	// RefStatute is a page of the Statutes at Large, as in "/us/stat/133/3".
+	RefStatute RefKind = "stat"
+
+	// RefUSC is a title of the U.S. Code, as in "/us/usc/t42".
+	RefUSC RefKind = "usc"
+)
+
+// RefTarget is the parsed form of a reference href: the document it points
+// to and the path of the provision within it.
+type RefTarget struct {
+	Kind RefKind `json:"kind"`
+
+	// Congress, Type, Number, and Version identify a bill or resolution
+	// (Type is e.g. "hr" or "sres", and Version e.g. "ih", if the href
+	// names one). Public laws set Congress and Number.
+	Congress string `json:"congress,omitempty"`
+	Type     string `json:"type,omitempty"`
+	Number   string `json:"number,omitempty"`
+	Version  string `json:"version,omitempty"`
+
+	// Volume and Page locate a Statutes at Large reference; Title is the
+	// U.S. Code title.
+	Volume string `json:"volume,omitempty"`
+	Page   string `json:"page,omitempty"`
+	Title  string `json:"title,omitempty"`
+
+	// Path is the rest of the href below the document, e.g. "s2/a" for
+	// "/us/pl/116/60/s2/a" or "s1395w" for "/us/usc/t42/s1395w".
+	Path string `json:"path,omitempty"`
+}
+
+var (
+	billHrefPattern    = regexp.MustCompile(`^/us/(bill|resolution)/(\d+)/([a-z]+)/(\d+)(?:/(.*))?$`)
+	lawHrefPattern     = regexp.MustCompile(`^/us/pl/(\d+)/(\d+)(?:/(.*))?$`)
+	statuteHrefPattern = regexp.MustCompile(`^/us/stat/(\d+)/(\d+)`)
+	uscHrefPattern     = regexp.MustCompile(`^/us/usc/t(\w+)(?:/(.*))?$`)
+)
+
+// billVersions are the version codes govinfo uses for bills and
+// resolutions, which may follow the number in a bill href.
+var billVersions = map[string]bool{
+	"as": true, "ash": true, "ath": true, "ats": true, "cdh": true, "cds": true,
+	"cph": true, "cps": true, "eah": true, "eas": true, "eh": true, "enr": true,
+	"eph": true, "es": true, "fah": true, "fph": true, "fps": true, "hdh": true,
+	"hds": true, "ih": true, "iph": true, "ips": true, "is": true, "lth": true,
+	"lts": true, "oph": true, "ops": true, "pap": true, "pav": true, "pch": true,
+	"pcs": true, "pp": true, "pwah": true, "rah": true, "ras": true, "rch": true,
+	"rcs": true, "rdh": true, "rds": true, "re": true, "reah": true, "renr": true,
+	"res": true, "rfh": true, "rfs": true, "rh": true, "rih": true, "ris": true,
+	"rs": true, "rth": true, "rts": true, "sas": true, "sc": true,
+}
+
+// ParseRefHref parses a reference href to a bill or resolution, public law,
+// Statutes at Large page, or U.S. Code location. The older "usc/42/1395"
+// form of U.S. Code references is accepted, and a trailing "etseq" is
+// dropped. Other hrefs return an error wrapping ErrUnsupportedRef.
+func ParseRefHref(href string) (RefTarget, error) {
+	h := strings.TrimSuffix(strings.TrimSpace(href), "/")
+	if usc := normalizeUSCHref(h); usc != "" {
+		h = strings.TrimSuffix(usc, "/etseq")
+	}
+	if m := billHrefPattern.FindStringSubmatch(h); m != nil {
+		t := RefTarget{Kind: RefBill, Congress: m[2], Type: m[3], Number: m[4], Path: m[5]}
+		first, rest, _ := strings.Cut(t.Path, "/")
+		if billVersions[first] {
+			t.Version, t.Path = first, rest
+		}
+		return t, nil
+	}
+	if m := lawHrefPattern.FindStringSubmatch(h); m != nil {
+		return RefTarget{Kind: RefPublicLaw, Congress: m[1], Number: m[2], Path: m[3]}, nil
+	}
+	if m := statuteHrefPattern.FindStringSubmatch(h); m != nil {
+		// A page range ("133/3–5") resolves to its first page.
+		return RefTarget{Kind: RefStatute, Volume: m[1], Page: m[2]}, nil
+	}
+	if m := uscHrefPattern.FindStringSubmatch(h); m != nil {
+		return RefTarget{Kind: RefUSC, Title: m[1], Path: m[2]}, nil
+	}
+	return RefTarget{}, fmt.Errorf("%w: %q", ErrUnsupportedRef, href)
+}
+
+// DocumentHref returns the href of the target document, without the
+// provision path, e.g. "/us/bill/116/hr/1058/ih" or "/us/usc/t42".
+func (t RefTarget) DocumentHref() string {
+	switch t.Kind {
+	case RefBill:
+		kind := "bill"
+		if strings.HasSuffix(t.Type, "res") {
+			kind = "resolution"
+		}
+		h := "/us/" + kind + "/" + t.Congress + "/" + t.Type + "/" + t.Number
+		if t.Version != "" {
+			h += "/" + t.Version
+		}
+		return h
+	case RefPublicLaw:
+		return "/us/pl/" + t.Congress + "/" + t.Number
+	case RefStatute:
+		return "/us/stat/" + t.Volume + "/" + t.Page
+	case RefUSC:
+		return "/us/usc/t" + t.Title
+	}
+	return ""
+}
+
+// PackageID returns the govinfo package ID of the target document (e.g.,
+// "BILLS-116hr1058ih", "PLAW-116publ60", or "STATUTE-133-Pg3"), or for a
+// U.S. Code title the name of its file in the Office of the Law Revision
+// Counsel's USLM release (e.g., "usc42"). A bill without a version has no
+// package ID of its own; the ID without the version is returned.
+func (t RefTarget) PackageID() string {
+	switch t.Kind {
+	case RefBill:
+		return "BILLS-" + t.Congress + t.Type + t.Number + t.Version
+	case RefPublicLaw:
+		return "PLAW-" + t.Congress + "publ" + t.Number
+	case RefStatute:
+		return "STATUTE-" + t.Volume + "-Pg" + t.Page
+	case RefUSC:
+		return "usc" + t.Title
+	}
+	return ""
+}
+
+// Resolver fetches and parses the document a reference href points to.
+// StoreResolver reads a local mirror, GovInfoResolver fetches from govinfo,
+// and CachingResolver and ChainResolvers combine them; other sources can be
+// plugged in by implementing Resolve or with a ResolverFunc.
+//
+// Resolve returns an error wrapping ErrNotFound when the source does not
+// have the document, and ErrUnsupportedRef when the href names none.
+type Resolver interface {
+	Resolve(ctx context.Context, href string) (LegislativeDocument, error)
+}
+
+// Ensure the resolver implementations satisfy Resolver
+var (
+	_ Resolver = ResolverFunc(nil)
+	_ Resolver = (*StoreResolver)(nil)
+	_ Resolver = (*GovInfoResolver)(nil)
+	_ Resolver = (*CachingResolver)(nil)
+)
+
+// ResolverFunc adapts a function to the Resolver interface.
+type ResolverFunc func(ctx context.Context, href string) (LegislativeDocument, error)
+
+// Resolve calls f(ctx, href).
+func (f ResolverFunc) Resolve(ctx context.Context, href string) (LegislativeDocument, error) {
+	return f(ctx, href)
+}
+
+// FollowRef resolves href with r and returns the target document together
+// with the provision the href points to: the one whose identifier is the
+// longest prefix of the href. The provision is nil when the href names the
+// whole document or no provision matches.
+func FollowRef(ctx context.Context, r Resolver, href string) (LegislativeDocument, *Provision, error) {
+	doc, err := r.Resolve(ctx, href)
+	if err != nil {
+		return nil, nil, err
+	}
+	target, err := ParseRefHref(href)
+	if err != nil || target.Path == "" {
+		return doc, nil, nil
+	}
+	// Provision identifiers do not carry the bill version.
+	target.Version = ""
+	want := target.DocumentHref() + "/" + target.Path
+
+	byIdentifier := make(map[string]*Provision)
+	for _, top := range Provisions(doc) {
+		top.Walk(func(p *Provision) bool {
+			if p.Identifier != "" {
+				byIdentifier[p.Identifier] = p
+			}
+			return true
+		})
+	}
+	return doc, provisionForHref(byIdentifier, want), nil
+}
+
+// StoreResolver resolves references from a Store holding a local mirror,
+// with documents stored under their PackageID. A bill href without a
+// version resolves to the enrolled version if the store has it, and
+// otherwise to the only version it has.
+type StoreResolver struct {
+	Store Store
+}
+
+// NewStoreResolver returns a resolver reading documents from store.
+func NewStoreResolver(store Store) *StoreResolver {
+	return &StoreResolver{Store: store}
+}
+
+// Resolve returns the stored document href points to.
+func (s *StoreResolver) Resolve(ctx context.Context, href string) (LegislativeDocument, error) {
+	target, err := ParseRefHref(href)
+	if err != nil {
+		return nil, err
+	}
+	id := target.PackageID()
+	if target.Kind == RefBill && target.Version == "" {
+		if id, err = s.versionID(ctx, id); err != nil {
+			return nil, err
+		}
+	}
+	return s.Store.Get(ctx, id)
+}
+
+// versionID returns the ID of the stored version of the bill whose
+// unversioned package ID is prefix.
+func (s *StoreResolver) versionID(ctx context.Context, prefix string) (string, error) {
+	ids, err := s.Store.List(ctx)
+	if err != nil {
+		return "", err
+	}
+	var versions []string
+	for _, id := range ids {
+		if v := strings.TrimPrefix(id, prefix); v != id && billVersions[v] {
+			if v == "enr" {
+				return id, nil
+			}
+			versions = append(versions, id)
+		}
+	}
+	switch len(versions) {
+	case 0:
+		return "", fmt.Errorf("%s: %w", prefix, ErrNotFound)
+	case 1:
+		return versions[0], nil
+	}
+	return "", fmt.Errorf("%s is ambiguous among %s: %w", prefix, strings.Join(versions, ", "), ErrNotFound)
+}
+
+// DefaultGovInfoLinkURL is the base URL of the govinfo link service.
+const DefaultGovInfoLinkURL = "https://www.govinfo.gov/link/"
+
+// GovInfoResolver resolves references by fetching the XML of the target
+// document through the govinfo link service, with ParseDocumentFromURL. A
+// bill href without a version fetches the latest version. Only documents
+// whose root this package models can be parsed; U.S. Code titles, whose
+// root is <uscDoc>, and other collections fail with a parse error.
+type GovInfoResolver struct {
+	// Client is the HTTP client to use. Defaults to http.DefaultClient.
+	Client *http.Client
+
+	// BaseURL is the link service URL. Defaults to DefaultGovInfoLinkURL.
+	BaseURL string
+}
+
+// URL returns the link service URL of the XML of the target document.
+func (g *GovInfoResolver) URL(target RefTarget) (string, error) {
+	var p []string
+	switch target.Kind {
+	case RefBill:
+		p = []string{"bills", target.Congress, target.Type, target.Number}
+		if target.Version != "" {
+			p = append(p, target.Version)
+		}
+	case RefPublicLaw:
+		p = []string{"plaw", target.Congress, "public", target.Number}
+	case RefStatute:
+		p = []string{"statute", target.Volume, target.Page}
+	case RefUSC:
+		p = []string{"uscode", target.Title}
+	default:
+		return "", fmt.Errorf("%w: kind %q", ErrUnsupportedRef, target.Kind)
+	}
+	base := g.BaseURL
+	if base == "" {
+		base = DefaultGovInfoLinkURL
+	}
+	for i := range p {
+		p[i] = url.PathEscape(p[i])
+	}
+	return strings.TrimSuffix(base, "/") + "/" + strings.Join(p, "/") + "?link-type=xml", nil
+}
+
+// Resolve fetches and parses the document href points to.
+func (g *GovInfoResolver) Resolve(ctx context.Context, href string) (LegislativeDocument, error) {
+	target, err := ParseRefHref(href)
+	if err != nil {
+		return nil, err
+	}
+	u, err := g.URL(target)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDocumentFromURL(ctx, u, g.Client)
+}
+
+// CachingResolver wraps a Resolver with a DocumentCache, keyed by the
+// DocumentHref of each target, so that references to different provisions
+// of one document fetch it once. Cached documents are shared between
+// callers and must be treated as read-only.
+type CachingResolver struct {
+	Resolver Resolver
+	Cache    *DocumentCache
+}
+
+// NewCachingResolver returns a resolver caching up to capacity documents
+// resolved by r.
+func NewCachingResolver(r Resolver, capacity int) *CachingResolver {
+	return &CachingResolver{Resolver: r, Cache: NewDocumentCache(capacity)}
+}
+
+// Resolve returns the cached document href points to, resolving and caching
+// it on a miss. Errors are not cached.
+func (c *CachingResolver) Resolve(ctx context.Context, href string) (LegislativeDocument, error) {
+	target, err := ParseRefHref(href)
+	if err != nil {
+		return nil, err
+	}
+	key := target.DocumentHref()
+	if doc, ok := c.Cache.Get(key); ok {
+		return doc, nil
+	}
+	doc, err := c.Resolver.Resolve(ctx, href)
+	if err != nil {
+		return nil, err
+	}
+	c.Cache.Add(key, doc)
+	return doc, nil
+}
+
+// ChainResolvers returns a resolver trying each of resolvers in turn, such
+// as a local mirror and then govinfo, and moving on to the next when one
+// reports ErrNotFound. Other errors are returned at once.
+func ChainResolvers(resolvers ...Resolver) Resolver {
+	return ResolverFunc(func(ctx context.Context, href string) (LegislativeDocument, error) {
+		err := fmt.Errorf("%s: %w", href, ErrNotFound)
+		for _, r := range resolvers {
+			var doc LegislativeDocument
+			if doc, err = r.Resolve(ctx, href); err == nil || !errors.Is(err, ErrNotFound) {
+				return doc, err
+			}
+		}
+		return nil, err
+	})
+}
@@ -0,0 +1,282 @@
+// Package s3store implements uslm.Store over S3-compatible object storage
+// (Amazon S3, MinIO, Cloudflare R2, and similar) using the S3 REST API
+// directly, so no cloud SDK is required.
+//
+// Documents are stored as "<Prefix><id>.xml" objects, or "<Prefix><id>.XML"
+// as GPO names some of its files. Get streams and parses
+// the object body as it arrives, and GetMeta uses ranged reads so that
+// cataloging a bucket only transfers the head of each file.
+package s3store
+
+import (
+	"bytes"
+	"context"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"path"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/usgpo/uslm/pkg/uslm"
+)
+
+// Config describes the bucket a Store reads and writes.
+type Config struct {
+	// Endpoint is the service URL, e.g., "https://s3.us-east-1.amazonaws.com"
+	// or "http://localhost:9000". Requests use path-style addressing.
+	Endpoint string
+
+	// Region is the signing region, e.g., "us-east-1".
+	Region string
+
+	// Bucket is the bucket name.
+	Bucket string
+
+	// Prefix is prepended to every object key, e.g., "BILLS/118/".
+	Prefix string
+
+	// AccessKeyID and SecretAccessKey sign requests. When AccessKeyID is
+	// empty requests are sent unsigned, for public buckets.
+	AccessKeyID     string
+	SecretAccessKey string
+
+	// SessionToken is set for temporary credentials.
+	SessionToken string
+
+	// HTTPClient is used for requests; http.DefaultClient if nil.
+	HTTPClient *http.Client
+}
+
+// Store is a uslm.Store backed by an S3 bucket.
+type Store struct {
+	cfg    Config
+	client *http.Client
+	now    func() time.Time
+}
+
+// Ensure Store implements uslm.Store
+var _ uslm.Store = (*Store)(nil)
+
+// metaRangeBytes is the size of the first ranged read made by GetMeta. The
+// metadata block of a GPO bill is typically a few kilobytes.
+const metaRangeBytes = 64 << 10
+
+// New returns a Store for cfg.
+func New(cfg Config) *Store {
+	client := cfg.HTTPClient
+	if client == nil {
+		client = http.DefaultClient
+	}
+	cfg.Endpoint = strings.TrimRight(cfg.Endpoint, "/")
+	return &Store{cfg: cfg, client: client, now: time.Now}
+}
+
+// Get streams the object for id and parses it.
+func (s *Store) Get(ctx context.Context, id string) (uslm.LegislativeDocument, error) {
+	resp, _, err := s.get(ctx, id, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get document %s: %w", id, err)
+	}
+	defer resp.Body.Close()
+	doc, err := uslm.DecodeDocument(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse document %s: %w", id, err)
+	}
+	return doc, nil
+}
+
+// GetMeta returns a document with only its metadata block populated, reading
+// the object in ranged chunks until the block is complete.
+func (s *Store) GetMeta(ctx context.Context, id string) (uslm.LegislativeDocument, error) {
+	var head []byte
+	var key string
+	for size := metaRangeBytes; ; size *= 4 {
+		header := http.Header{"Range": {fmt.Sprintf("bytes=%d-%d", len(head), size-1)}}
+		var resp *http.Response
+		var err error
+		if key == "" {
+			resp, key, err = s.get(ctx, id, header)
+		} else {
+			resp, err = s.do(ctx, http.MethodGet, key, nil, header, nil)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to get document %s: %w", id, err)
+		}
+		chunk, err := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		if err != nil {
+			return nil, fmt.Errorf("failed to read document %s: %w", id, err)
+		}
+		head = append(head, chunk...)
+		// A 200 means the server ignored the range and sent everything.
+		complete := resp.StatusCode == http.StatusOK || len(head) < size
+
+		doc, err := uslm.ParseDocumentMeta(bytes.NewReader(head))
+		if err == nil || complete {
+			if err != nil {
+				return nil, fmt.Errorf("failed to parse metadata of %s: %w", id, err)
+			}
+			return doc, nil
+		}
+	}
+}
+
+// Put writes doc as XML to the object for id, replacing an existing
+// "<id>.XML" object rather than adding a second. New objects are named
+// "<id>.xml".
+func (s *Store) Put(ctx context.Context, id string, doc uslm.LegislativeDocument) error {
+	data, err := uslm.MarshalDocumentToXML(doc)
+	if err != nil {
+		return err
+	}
+	key, err := s.existingKey(ctx, id)
+	if errors.Is(err, uslm.ErrNotFound) {
+		key = s.keys(id)[0]
+	} else if err != nil {
+		return fmt.Errorf("failed to put document %s: %w", id, err)
+	}
+	header := http.Header{"Content-Type": {"application/xml"}}
+	resp, err := s.do(ctx, http.MethodPut, key, nil, header, data)
+	if err != nil {
+		return fmt.Errorf("failed to put document %s: %w", id, err)
+	}
+	resp.Body.Close()
+	return nil
+}
+
+// List returns the ids of the XML objects under the configured prefix,
+// whatever the case of their extension.
+func (s *Store) List(ctx context.Context) ([]string, error) {
+	var ids []string
+	seen := make(map[string]bool)
+	token := ""
+	for {
+		query := url.Values{"list-type": {"2"}, "prefix": {s.cfg.Prefix}}
+		if token != "" {
+			query.Set("continuation-token", token)
+		}
+		resp, err := s.do(ctx, http.MethodGet, "", query, nil, nil)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list documents: %w", err)
+		}
+		var result struct {
+			Contents []struct {
+				Key string `xml:"Key"`
+			} `xml:"Contents"`
+			IsTruncated           bool   `xml:"IsTruncated"`
+			NextContinuationToken string `xml:"NextContinuationToken"`
+		}
+		err = xml.NewDecoder(resp.Body).Decode(&result)
+		resp.Body.Close()
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse object listing: %w", err)
+		}
+
+		for _, c := range result.Contents {
+			name := strings.TrimPrefix(c.Key, s.cfg.Prefix)
+			if !uslm.IsXMLFileName(name) || strings.Contains(name, "/") {
+				continue
+			}
+			if id := strings.TrimSuffix(name, path.Ext(name)); !seen[id] {
+				seen[id] = true
+				ids = append(ids, id)
+			}
+		}
+		if !result.IsTruncated || result.NextContinuationToken == "" {
+			break
+		}
+		token = result.NextContinuationToken
+	}
+	sort.Strings(ids)
+	return ids, nil
+}
+
+// keys returns the object keys id may be stored under, in the order they
+// are tried. S3 keys are case-sensitive, so each is requested in turn.
+func (s *Store) keys(id string) []string {
+	return []string{s.cfg.Prefix + id + ".xml", s.cfg.Prefix + id + ".XML"}
+}
+
+// get sends a GET with header for the object of id, returning the response
+// and the key it was found under.
+func (s *Store) get(ctx context.Context, id string, header http.Header) (*http.Response, string, error) {
+	var err error
+	for _, key := range s.keys(id) {
+		var resp *http.Response
+		if resp, err = s.do(ctx, http.MethodGet, key, nil, header, nil); !errors.Is(err, uslm.ErrNotFound) {
+			return resp, key, err
+		}
+	}
+	return nil, "", err
+}
+
+// existingKey returns the key of the object stored for id, or an error
+// wrapping uslm.ErrNotFound if there is none.
+func (s *Store) existingKey(ctx context.Context, id string) (string, error) {
+	var err error
+	for _, key := range s.keys(id) {
+		var resp *http.Response
+		if resp, err = s.do(ctx, http.MethodHead, key, nil, nil, nil); err == nil {
+			resp.Body.Close()
+			return key, nil
+		}
+		if !errors.Is(err, uslm.ErrNotFound) {
+			return "", err
+		}
+	}
+	return "", err
+}
+
+// do sends a request for key (or the bucket itself when key is empty) and
+// returns the response if its status is 2xx. A 404 is reported as
+// uslm.ErrNotFound.
+func (s *Store) do(ctx context.Context, method, key string, query url.Values, header http.Header, body []byte) (*http.Response, error) {
+	u, err := url.Parse(s.cfg.Endpoint + "/" + s.cfg.Bucket + "/" + key)
+	if err != nil {
+		return nil, err
+	}
+	u.Path = "/" + s.cfg.Bucket + "/" + key
+	u.RawPath = ""
+	u.RawQuery = query.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, method, u.String(), bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	for name, values := range header {
+		req.Header[name] = values
+	}
+	if body == nil {
+		req.Body = http.NoBody
+	}
+	if s.cfg.AccessKeyID != "" {
+		payloadHash := emptyPayloadHash
+		if body != nil {
+			payloadHash = hashHex(body)
+		}
+		s.sign(req, payloadHash, s.now())
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return resp, nil
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, fmt.Errorf("%s: %w", key, uslm.ErrNotFound)
+	}
+	if resp.StatusCode == http.StatusRequestedRangeNotSatisfiable {
+		// The object is shorter than the requested range; nothing more to read.
+		return &http.Response{StatusCode: http.StatusPartialContent, Body: http.NoBody}, nil
+	}
+	msg, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+	return nil, errors.New(resp.Status + ": " + strings.TrimSpace(string(msg)))
+}
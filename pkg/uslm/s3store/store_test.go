@@ -0,0 +1,192 @@
+package s3store
+
+import (
+	"context"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/usgpo/uslm/pkg/uslm"
+)
+
+// fakeS3 serves a single bucket from memory, paging listings two keys at a
+// time and honoring byte ranges.
+type fakeS3 struct {
+	t      *testing.T
+	bucket string
+
+	mu      sync.Mutex
+	objects map[string][]byte
+	ranges  []string
+}
+
+func (f *fakeS3) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if !strings.HasPrefix(r.Header.Get("Authorization"), "AWS4-HMAC-SHA256 Credential=AKID/") {
+		http.Error(w, "unsigned request", http.StatusForbidden)
+		return
+	}
+	key, ok := strings.CutPrefix(r.URL.Path, "/"+f.bucket+"/")
+	if !ok {
+		http.Error(w, "no such bucket", http.StatusNotFound)
+		return
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if key == "" && r.URL.Query().Get("list-type") == "2" {
+		f.list(w, r)
+		return
+	}
+	switch r.Method {
+	case http.MethodPut:
+		data, err := io.ReadAll(r.Body)
+		if err != nil {
+			f.t.Errorf("failed to read PUT body: %v", err)
+		}
+		f.objects[key] = data
+	case http.MethodGet, http.MethodHead:
+		data, ok := f.objects[key]
+		if !ok {
+			http.Error(w, "NoSuchKey", http.StatusNotFound)
+			return
+		}
+		rng := r.Header.Get("Range")
+		if rng == "" || r.Method == http.MethodHead {
+			w.Write(data)
+			return
+		}
+		f.ranges = append(f.ranges, rng)
+		var start, end int
+		fmt.Sscanf(rng, "bytes=%d-%d", &start, &end)
+		if start >= len(data) {
+			w.WriteHeader(http.StatusRequestedRangeNotSatisfiable)
+			return
+		}
+		w.WriteHeader(http.StatusPartialContent)
+		w.Write(data[start:min(end+1, len(data))])
+	default:
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+	}
+}
+
+func (f *fakeS3) list(w http.ResponseWriter, r *http.Request) {
+	var keys []string
+	for key := range f.objects {
+		if strings.HasPrefix(key, r.URL.Query().Get("prefix")) {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	start, _ := strconv.Atoi(r.URL.Query().Get("continuation-token"))
+	type content struct {
+		Key string
+	}
+	result := struct {
+		XMLName               xml.Name `xml:"ListBucketResult"`
+		Contents              []content
+		IsTruncated           bool
+		NextContinuationToken string `xml:",omitempty"`
+	}{}
+	for _, key := range keys[start:min(start+2, len(keys))] {
+		result.Contents = append(result.Contents, content{key})
+	}
+	if start+2 < len(keys) {
+		result.IsTruncated = true
+		result.NextContinuationToken = strconv.Itoa(start + 2)
+	}
+	xml.NewEncoder(w).Encode(result)
+}
+
+func TestStore(t *testing.T) {
+	samples := filepath.Join("..", "..", "..", "bill-version-samples-september-2024")
+	lower, err := os.ReadFile(filepath.Join(samples, "BILLS-114s32cds.xml"))
+	if err != nil {
+		t.Fatalf("failed to read sample: %v", err)
+	}
+	upper, err := os.ReadFile(filepath.Join(samples, "H1000_IH.XML"))
+	if err != nil {
+		t.Fatalf("failed to read sample: %v", err)
+	}
+	fake := &fakeS3{t: t, bucket: "bills", objects: map[string][]byte{
+		"BILLS/114/BILLS-114s32cds.xml": lower,
+		"BILLS/114/H1000_IH.XML":        upper,
+		"BILLS/114/notes.txt":           []byte("not USLM"),
+		"BILLS/114/sub/other.xml":       lower,
+		"other/BILLS-115hr1.xml":        lower,
+	}}
+	server := httptest.NewServer(fake)
+	defer server.Close()
+
+	ctx := context.Background()
+	store := New(Config{Endpoint: server.URL + "/", Region: "us-east-1", Bucket: "bills", Prefix: "BILLS/114/", AccessKeyID: "AKID", SecretAccessKey: "secret"})
+
+	ids, err := store.List(ctx)
+	if err != nil {
+		t.Fatalf("failed to list: %v", err)
+	}
+	if got := strings.Join(ids, ","); got != "BILLS-114s32cds,H1000_IH" {
+		t.Errorf("List = %s", got)
+	}
+
+	bill, err := store.Get(ctx, "BILLS-114s32cds")
+	if err != nil {
+		t.Fatalf("failed to get: %v", err)
+	}
+	if bill.GetDocumentNumber() != "32" {
+		t.Errorf("unexpected document number %s", bill.GetDocumentNumber())
+	}
+	if _, err := store.Get(ctx, "H1000_IH"); err != nil {
+		t.Errorf("failed to get upper-case object: %v", err)
+	}
+	if _, err := store.Get(ctx, "BILLS-missing"); !errors.Is(err, uslm.ErrNotFound) {
+		t.Errorf("expected ErrNotFound, got %v", err)
+	}
+
+	meta, err := store.GetMeta(ctx, "H1000_IH")
+	if err != nil {
+		t.Fatalf("failed to get metadata: %v", err)
+	}
+	full, err := uslm.ParseDocument(upper)
+	if err != nil {
+		t.Fatalf("failed to parse sample: %v", err)
+	}
+	if meta.GetTitle() != full.GetTitle() {
+		t.Errorf("GetMeta title %q, want %q", meta.GetTitle(), full.GetTitle())
+	}
+	if len(fake.ranges) == 0 || fake.ranges[0] != fmt.Sprintf("bytes=0-%d", metaRangeBytes-1) {
+		t.Errorf("expected a ranged read of the head, got %v", fake.ranges)
+	}
+
+	// Put replaces the upper-case object rather than adding a second.
+	if err := store.Put(ctx, "H1000_IH", bill); err != nil {
+		t.Fatalf("failed to put: %v", err)
+	}
+	if _, ok := fake.objects["BILLS/114/H1000_IH.xml"]; ok {
+		t.Error("expected Put to replace H1000_IH.XML, not add H1000_IH.xml")
+	}
+	if err := store.Put(ctx, "BILLS-114s32cdsnew", bill); err != nil {
+		t.Fatalf("failed to put: %v", err)
+	}
+	if _, ok := fake.objects["BILLS/114/BILLS-114s32cdsnew.xml"]; !ok {
+		t.Error("expected a new object to be named .xml")
+	}
+	again, err := store.Get(ctx, "H1000_IH")
+	if err != nil || again.GetDocumentNumber() != "32" {
+		t.Errorf("expected the replaced document, got %v", err)
+	}
+
+	unsigned := New(Config{Endpoint: server.URL, Region: "us-east-1", Bucket: "bills"})
+	if _, err := unsigned.Get(ctx, "BILLS-114s32cds"); err == nil || !strings.Contains(err.Error(), "403") {
+		t.Errorf("expected the fake to reject an unsigned request, got %v", err)
+	}
+}
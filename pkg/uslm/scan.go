@@ -0,0 +1,276 @@
+package uslm
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/fs"
+	"path"
+	"runtime"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ScanOptions configures ScanCorpus.
+type ScanOptions struct {
+	// Pattern selects files by base name using path.Match syntax. If empty,
+	// files with an ".xml" extension in any case are selected, since path.Match
+	// is case-sensitive and GPO publishes both ".xml" and ".XML" files.
+	Pattern string
+
+	// Workers is the number of files parsed at once. Defaults to
+	// runtime.GOMAXPROCS(0).
+	Workers int
+
+	// MetadataOnly parses each file only as far as its metadata block, as
+	// ParseDocumentMeta does, leaving the body unread.
+	MetadataOnly bool
+
+	// Parse configures full parses. Its Context, if nil, is set to the
+	// context given to ScanCorpus. It is not used when MetadataOnly is set.
+	Parse ParseOptions
+
+	// RecordUnknown sets each result's Unknown to the markup the model
+	// dropped from the file. Parse.Unknown is ignored. It is not used when
+	// MetadataOnly is set.
+	RecordUnknown bool
+
+	// Filters select the files to parse. Each file's header is read first,
+	// as ReadHeader does, and the file is skipped without a result unless
+	// every filter accepts it; only accepted files are parsed in full. With
+	// MetadataOnly set, an accepted file's result holds its header.
+	Filters []ScanFilter
+}
+
+// ScanFilter reports whether ScanCorpus should include a file, given a
+// document holding just the file's metadata and preface.
+type ScanFilter func(header LegislativeDocument) bool
+
+// ScanCongress accepts documents of any of the given Congresses, e.g. "118".
+func ScanCongress(congresses ...string) ScanFilter {
+	return func(doc LegislativeDocument) bool {
+		return containsFold(congresses, doc.GetCongress())
+	}
+}
+
+// ScanChamber accepts documents whose current chamber is chamber, "HOUSE"
+// or "SENATE".
+func ScanChamber(chamber string) ScanFilter {
+	return func(doc LegislativeDocument) bool {
+		return strings.EqualFold(strings.TrimSpace(doc.GetChamber()), chamber)
+	}
+}
+
+// ScanStage accepts documents at any of the given stages, e.g. "Introduced
+// in Senate", compared without regard to case.
+func ScanStage(stages ...string) ScanFilter {
+	return func(doc LegislativeDocument) bool {
+		return containsFold(stages, doc.GetStage())
+	}
+}
+
+// ScanActionDates accepts documents with an action dated from from through
+// to, inclusive. A zero time leaves that end of the range open. Documents
+// without dated actions are rejected.
+func ScanActionDates(from, to time.Time) ScanFilter {
+	return func(doc LegislativeDocument) bool {
+		actions, ok := doc.(ActionDocument)
+		if !ok {
+			return false
+		}
+		for _, a := range actions.GetActions() {
+			date, err := time.Parse("2006-01-02", a.Date.ISODate())
+			if err != nil {
+				continue
+			}
+			if (from.IsZero() || !date.Before(from)) && (to.IsZero() || !date.After(to)) {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// ScanSponsor accepts documents whose sponsor has the given Senate, House,
+// or Bioguide ID.
+func ScanSponsor(id string) ScanFilter {
+	return func(doc LegislativeDocument) bool {
+		sponsored, ok := doc.(SponsoredDocument)
+		if !ok {
+			return false
+		}
+		for _, s := range sponsored.GetSponsors() {
+			if containsFold([]string{s.SenateID, s.HouseID, s.BioGuideID}, id) {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// containsFold reports whether values contains s, ignoring case and
+// surrounding space.
+func containsFold(values []string, s string) bool {
+	s = strings.TrimSpace(s)
+	for _, v := range values {
+		if s != "" && strings.EqualFold(strings.TrimSpace(v), s) {
+			return true
+		}
+	}
+	return false
+}
+
+// ScanResult reports one file found by ScanCorpus.
+type ScanResult struct {
+	// Path is the file's path within the scanned fs.FS.
+	Path string
+
+	// Document is the parsed file, or nil if it could not be read or parsed,
+	// in which case Err is set. Err is also set, with Path naming the
+	// directory, when a directory cannot be read.
+	Document LegislativeDocument
+	Err      error
+
+	// Unknown lists the markup dropped from the file, when
+	// ScanOptions.RecordUnknown is set.
+	Unknown *UnknownContent
+}
+
+// ScanCorpus walks fsys from its root and parses every file matching
+// opts.Pattern and accepted by opts.Filters, sending a result for each as
+// soon as it is parsed. Files are
+// parsed concurrently by opts.Workers goroutines, so results arrive in
+// completion order rather than path order. A file that fails to parse is
+// reported and does not stop the scan. The channel is closed once every file
+// has been reported or ctx is done.
+//
+// Use os.DirFS to scan a directory on disk, or fs.Sub to scan part of a
+// larger tree such as the bulk data archive.
+func ScanCorpus(ctx context.Context, fsys fs.FS, opts ScanOptions) <-chan ScanResult {
+	if opts.Workers <= 0 {
+		opts.Workers = runtime.GOMAXPROCS(0)
+	}
+	if opts.Parse.Context == nil {
+		opts.Parse.Context = ctx
+	}
+
+	results := make(chan ScanResult)
+	send := func(r ScanResult) bool {
+		select {
+		case results <- r:
+			return true
+		case <-ctx.Done():
+			return false
+		}
+	}
+
+	paths := make(chan string)
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		defer close(paths)
+		fs.WalkDir(fsys, ".", func(p string, d fs.DirEntry, err error) error {
+			if ctx.Err() != nil {
+				return fs.SkipAll
+			}
+			if err != nil {
+				if !send(ScanResult{Path: p, Err: fmt.Errorf("failed to read directory: %w", err)}) {
+					return fs.SkipAll
+				}
+				return nil
+			}
+			if d.IsDir() {
+				return nil
+			}
+			if !matchPattern(opts.Pattern, d.Name()) {
+				return nil
+			}
+			select {
+			case paths <- p:
+				return nil
+			case <-ctx.Done():
+				return fs.SkipAll
+			}
+		})
+	}()
+
+	for w := 0; w < opts.Workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for p := range paths {
+				result, ok := scanFile(fsys, p, opts)
+				if ok && !send(result) {
+					return
+				}
+			}
+		}()
+	}
+
+	go func() {
+		wg.Wait()
+		close(results)
+	}()
+	return results
+}
+
+// scanFile parses the file at p for ScanCorpus. It reports false if the
+// file is rejected by opts.Filters.
+func scanFile(fsys fs.FS, p string, opts ScanOptions) (ScanResult, bool) {
+	result := ScanResult{Path: p}
+	if len(opts.Filters) > 0 {
+		header, err := scanHeader(fsys, p, ReadHeader)
+		if err != nil {
+			result.Err = err
+			return result, true
+		}
+		for _, accept := range opts.Filters {
+			if !accept(header) {
+				return result, false
+			}
+		}
+		if opts.MetadataOnly {
+			result.Document = header
+			return result, true
+		}
+	} else if opts.MetadataOnly {
+		result.Document, result.Err = scanHeader(fsys, p, ParseDocumentMeta)
+		return result, true
+	}
+
+	data, err := fs.ReadFile(fsys, p)
+	if err != nil {
+		result.Err = fmt.Errorf("failed to read file: %w", err)
+		return result, true
+	}
+	parse := opts.Parse
+	parse.Unknown = nil
+	if opts.RecordUnknown {
+		result.Unknown = &UnknownContent{}
+		parse.Unknown = result.Unknown
+	}
+	result.Document, result.Err = ParseDocumentWithOptions(data, parse)
+	return result, true
+}
+
+// scanHeader opens the file at p and decodes its head with read.
+func scanHeader(fsys fs.FS, p string, read func(io.Reader) (LegislativeDocument, error)) (LegislativeDocument, error) {
+	f, err := fsys.Open(p)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read file: %w", err)
+	}
+	defer f.Close()
+	return read(f)
+}
+
+// matchPattern reports whether name matches pattern, or for an empty
+// pattern whether it is an XML file name.
+func matchPattern(pattern, name string) bool {
+	if pattern == "" {
+		return IsXMLFileName(name)
+	}
+	ok, _ := path.Match(pattern, name)
+	return ok
+}
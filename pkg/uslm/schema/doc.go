@@ -0,0 +1,12 @@
+// Package schema holds Go types generated from the USLM XML Schema by
+// cmd/uslmgen, one struct per complex type. They decode any USLM document
+// the schema allows and serve as the starting point when a new schema
+// release adds elements the hand-written model in package uslm lacks:
+// regenerate, then compare the types for the changed elements.
+//
+// To regenerate after replacing the schema:
+//
+//	go generate ./schema
+package schema
+
+//go:generate go run ../cmd/uslmgen -pkg schema -o types_gen.go ../../../bill-version-samples-september-2024/uslm-components-2.1.0.xsd
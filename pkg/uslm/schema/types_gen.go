@@ -0,0 +1,5199 @@
+// Code generated by uslmgen from uslm-components-2.1.0.xsd. DO NOT EDIT.
+
+package schema
+
+import "encoding/xml"
+
+// Namespace is the target namespace of the schema.
+const Namespace = "http://schemas.gpo.gov/xml/uslm"
+
+// AnyElement holds an element the schema leaves open, or one from another
+// namespace, with its attributes and markup.
+type AnyElement struct {
+	XMLName  xml.Name
+	Attrs    []xml.Attr `xml:",any,attr"`
+	InnerXML string     `xml:",innerxml"`
+}
+
+// ActionDescriptionElement is generated from the anonymous type of the
+// actionDescription element.
+//
+// The actionDescription is the description of the legislative action taking
+// place.
+type ActionDescriptionElement struct {
+	XMLName    xml.Name
+	Text       string             `xml:",chardata"`
+	Committee  []CommitteeElement `xml:"committee"`
+	P          []PType            `xml:"p"`
+	Sponsor    []SponsorType      `xml:"sponsor"`
+	Cosponsor  []SponsorType      `xml:"cosponsor"`
+	Nonsponsor []SponsorType      `xml:"nonsponsor"`
+	Sup        []InlineType       `xml:"sup"`
+	Inline     []InlineType       `xml:"inline"`
+	XMLBase    string             `xml:"http://www.w3.org/XML/1998/namespace base,attr,omitempty"`
+	XMLLang    string             `xml:"http://www.w3.org/XML/1998/namespace lang,attr,omitempty"`
+	XMLSpace   string             `xml:"http://www.w3.org/XML/1998/namespace space,attr,omitempty"`
+	Role       string             `xml:"role,attr,omitempty"`
+	Class      string             `xml:"class,attr,omitempty"`
+	Style      string             `xml:"style,attr,omitempty"`
+	StyleType  string             `xml:"styleType,attr,omitempty"`
+	Title      string             `xml:"title,attr,omitempty"`
+	Brief      string             `xml:"brief,attr,omitempty"`
+	SortOrder  string             `xml:"sortOrder,attr,omitempty"`
+	Display    string             `xml:"display,attr,omitempty"`
+}
+
+// ActionElement is generated from the anonymous type of the action element.
+//
+// The action is the set of information pertaining to a floor action for a
+// measure or amendment, i.e., the actual description of the floor action and
+// the date on which the action occurred.
+type ActionElement struct {
+	XMLName           xml.Name
+	Text              string                     `xml:",chardata"`
+	Date              []DateType                 `xml:"date"`
+	ActionDescription []ActionDescriptionElement `xml:"actionDescription"`
+	ActionInstruction []PropertyType             `xml:"actionInstruction"`
+	ActionStage       string                     `xml:"actionStage,attr,omitempty"`
+}
+
+// AffectedElement is generated from the anonymous type of the affected
+// element.
+//
+// The affected is an element used to identify a provision or document that is
+// affected by this document.
+type AffectedElement struct {
+	XMLName   xml.Name
+	Text      string          `xml:",chardata"`
+	DocNumber []PropertyType  `xml:"docNumber"`
+	DocTitle  []StatementType `xml:"docTitle"`
+	Congress  []PropertyType  `xml:"congress"`
+	DCType    []AnyElement    `xml:"http://purl.org/dc/elements/1.1/ type"`
+}
+
+// AffiliationElement is generated from the anonymous type of the affiliation
+// element.
+//
+// The affiliation is the affiliation of the name in the signature.
+type AffiliationElement struct {
+	XMLName        xml.Name
+	Text           string               `xml:",chardata"`
+	Inline         []InlineType         `xml:"inline"`
+	AmendingAction []AmendingActionType `xml:"amendingAction"`
+	Ref            []RefType            `xml:"ref"`
+	Date           []DateType           `xml:"date"`
+	Center         []InlineType         `xml:"center"`
+	FillIn         []InlineType         `xml:"fillIn"`
+	CheckBox       []InlineType         `xml:"checkBox"`
+	B              []InlineType         `xml:"b"`
+	I              []InlineType         `xml:"i"`
+	Qualifier      []InlineType         `xml:"qualifier"`
+	Sub            []InlineType         `xml:"sub"`
+	Sup            []InlineType         `xml:"sup"`
+	HeadingText    []InlineType         `xml:"headingText"`
+	Span           []InlineType         `xml:"span"`
+	ShortTitle     []InlineType         `xml:"shortTitle"`
+	Term           []InlineType         `xml:"term"`
+	Entity         []InlineType         `xml:"entity"`
+	Marker         []MarkerType         `xml:"marker"`
+	Br             []BrType             `xml:"br"`
+	Img            []ImgType            `xml:"img"`
+	Role           string               `xml:"role,attr,omitempty"`
+	Class          string               `xml:"class,attr,omitempty"`
+	Style          string               `xml:"style,attr,omitempty"`
+	StyleType      string               `xml:"styleType,attr,omitempty"`
+}
+
+// AmendDocType is generated from the AmendDocType complex type.
+//
+// The amending document type is the core root element type for all documents
+// that amend law, including engrossed amendment documents.
+type AmendDocType struct {
+	XMLName                xml.Name
+	XMLBase                string                         `xml:"http://www.w3.org/XML/1998/namespace base,attr,omitempty"`
+	XMLLang                string                         `xml:"http://www.w3.org/XML/1998/namespace lang,attr,omitempty"`
+	XMLSpace               string                         `xml:"http://www.w3.org/XML/1998/namespace space,attr,omitempty"`
+	ID                     string                         `xml:"id,attr,omitempty"`
+	TemporalID             string                         `xml:"temporalId,attr,omitempty"`
+	Identifier             string                         `xml:"identifier,attr,omitempty"`
+	Scope                  string                         `xml:"scope,attr,omitempty"`
+	Role                   string                         `xml:"role,attr,omitempty"`
+	Class                  string                         `xml:"class,attr,omitempty"`
+	Style                  string                         `xml:"style,attr,omitempty"`
+	StyleType              string                         `xml:"styleType,attr,omitempty"`
+	Note                   string                         `xml:"note,attr,omitempty"`
+	Alt                    string                         `xml:"alt,attr,omitempty"`
+	Meta                   string                         `xml:"meta,attr,omitempty"`
+	Misc                   string                         `xml:"misc,attr,omitempty"`
+	DraftingTip            string                         `xml:"draftingTip,attr,omitempty"`
+	CodificationTip        string                         `xml:"codificationTip,attr,omitempty"`
+	Title                  string                         `xml:"title,attr,omitempty"`
+	Brief                  string                         `xml:"brief,attr,omitempty"`
+	SortOrder              string                         `xml:"sortOrder,attr,omitempty"`
+	StartPeriod            string                         `xml:"startPeriod,attr,omitempty"`
+	EndPeriod              string                         `xml:"endPeriod,attr,omitempty"`
+	Status                 string                         `xml:"status,attr,omitempty"`
+	Partial                string                         `xml:"partial,attr,omitempty"`
+	InEffect               string                         `xml:"inEffect,attr,omitempty"`
+	AnyAttrs               []xml.Attr                     `xml:",any,attr"`
+	AmendMeta              *AmendMetaType                 `xml:"amendMeta"`
+	Page                   *PageType                      `xml:"page"`
+	Line                   *PageType                      `xml:"line"`
+	AmendPreface           *AmendPrefaceType              `xml:"amendPreface"`
+	AmendMain              *AmendMainType                 `xml:"amendMain"`
+	OfficialTitleAmendment *OfficialTitleAmendmentElement `xml:"officialTitleAmendment"`
+	Attestation            []AttestationType              `xml:"attestation"`
+	Block                  []BlockType                    `xml:"block"`
+	Signatures             []SignaturesType               `xml:"signatures"`
+	Fragment               []BlockType                    `xml:"fragment"`
+	Endorsement            []EndorsementType              `xml:"endorsement"`
+	Notes                  []NotesType                    `xml:"notes"`
+	LegislativeHistory     []NotesType                    `xml:"legislativeHistory"`
+	BackMatter             []BackMatterType               `xml:"backMatter"`
+	EndMarker              []string                       `xml:"endMarker"`
+	SchemaVersion          string                         `xml:"schemaVersion,attr,omitempty"`
+}
+
+// AmendMainType is generated from the AmendMainType complex type.
+//
+// The main core type for amendment documents is the container for the main
+// part of the document.
+type AmendMainType struct {
+	XMLName                           xml.Name
+	XMLBase                           string                     `xml:"http://www.w3.org/XML/1998/namespace base,attr,omitempty"`
+	XMLLang                           string                     `xml:"http://www.w3.org/XML/1998/namespace lang,attr,omitempty"`
+	XMLSpace                          string                     `xml:"http://www.w3.org/XML/1998/namespace space,attr,omitempty"`
+	ID                                string                     `xml:"id,attr,omitempty"`
+	TemporalID                        string                     `xml:"temporalId,attr,omitempty"`
+	Identifier                        string                     `xml:"identifier,attr,omitempty"`
+	Scope                             string                     `xml:"scope,attr,omitempty"`
+	Role                              string                     `xml:"role,attr,omitempty"`
+	Class                             string                     `xml:"class,attr,omitempty"`
+	Style                             string                     `xml:"style,attr,omitempty"`
+	StyleType                         string                     `xml:"styleType,attr,omitempty"`
+	Note                              string                     `xml:"note,attr,omitempty"`
+	Alt                               string                     `xml:"alt,attr,omitempty"`
+	Meta                              string                     `xml:"meta,attr,omitempty"`
+	Misc                              string                     `xml:"misc,attr,omitempty"`
+	DraftingTip                       string                     `xml:"draftingTip,attr,omitempty"`
+	CodificationTip                   string                     `xml:"codificationTip,attr,omitempty"`
+	Title                             string                     `xml:"title,attr,omitempty"`
+	Brief                             string                     `xml:"brief,attr,omitempty"`
+	SortOrder                         string                     `xml:"sortOrder,attr,omitempty"`
+	StartPeriod                       string                     `xml:"startPeriod,attr,omitempty"`
+	EndPeriod                         string                     `xml:"endPeriod,attr,omitempty"`
+	Status                            string                     `xml:"status,attr,omitempty"`
+	Partial                           string                     `xml:"partial,attr,omitempty"`
+	InEffect                          string                     `xml:"inEffect,attr,omitempty"`
+	AnyAttrs                          []xml.Attr                 `xml:",any,attr"`
+	DocTitle                          []StatementType            `xml:"docTitle"`
+	OfficialTitle                     []StatementType            `xml:"officialTitle"`
+	ResolvingClause                   []StatementType            `xml:"resolvingClause"`
+	Section                           []LevelType                `xml:"section"`
+	AmendmentInstruction              []AmendmentInstructionType `xml:"amendmentInstruction"`
+	AmendmentInstructionLineNumbering string                     `xml:"amendmentInstructionLineNumbering,attr,omitempty"`
+}
+
+// AmendMetaType is generated from the AmendMetaType complex type.
+//
+// The AmendMetaType is a core level container for metadata relating to the
+// amendment document.
+type AmendMetaType struct {
+	XMLName                 xml.Name
+	XMLBase                 string                       `xml:"http://www.w3.org/XML/1998/namespace base,attr,omitempty"`
+	XMLLang                 string                       `xml:"http://www.w3.org/XML/1998/namespace lang,attr,omitempty"`
+	XMLSpace                string                       `xml:"http://www.w3.org/XML/1998/namespace space,attr,omitempty"`
+	ID                      string                       `xml:"id,attr,omitempty"`
+	TemporalID              string                       `xml:"temporalId,attr,omitempty"`
+	Identifier              string                       `xml:"identifier,attr,omitempty"`
+	Scope                   string                       `xml:"scope,attr,omitempty"`
+	Role                    string                       `xml:"role,attr,omitempty"`
+	Class                   string                       `xml:"class,attr,omitempty"`
+	Style                   string                       `xml:"style,attr,omitempty"`
+	StyleType               string                       `xml:"styleType,attr,omitempty"`
+	Note                    string                       `xml:"note,attr,omitempty"`
+	Alt                     string                       `xml:"alt,attr,omitempty"`
+	Meta                    string                       `xml:"meta,attr,omitempty"`
+	Misc                    string                       `xml:"misc,attr,omitempty"`
+	DraftingTip             string                       `xml:"draftingTip,attr,omitempty"`
+	CodificationTip         string                       `xml:"codificationTip,attr,omitempty"`
+	Title                   string                       `xml:"title,attr,omitempty"`
+	Brief                   string                       `xml:"brief,attr,omitempty"`
+	SortOrder               string                       `xml:"sortOrder,attr,omitempty"`
+	StartPeriod             string                       `xml:"startPeriod,attr,omitempty"`
+	EndPeriod               string                       `xml:"endPeriod,attr,omitempty"`
+	Status                  string                       `xml:"status,attr,omitempty"`
+	Partial                 string                       `xml:"partial,attr,omitempty"`
+	InEffect                string                       `xml:"inEffect,attr,omitempty"`
+	AnyAttrs                []xml.Attr                   `xml:",any,attr"`
+	Any                     []AnyElement                 `xml:",any"`
+	Action                  []ActionElement              `xml:"action"`
+	Affected                []AffectedElement            `xml:"affected"`
+	AmendDegree             []string                     `xml:"amendDegree"`
+	AmendStage              []string                     `xml:"amendStage"`
+	ApprovedDate            []PropertyType               `xml:"approvedDate"`
+	DocTitle                []StatementType              `xml:"docTitle"`
+	CitableAs               []CitableAsElement           `xml:"citableAs"`
+	CitableAsShortTitle     []CitableAsShortTitleElement `xml:"citableAsShortTitle"`
+	ContainsShortTitle      []ContainsShortTitleElement  `xml:"containsShortTitle"`
+	CreatedDate             []PropertyType               `xml:"createdDate"`
+	Issue                   []PropertyType               `xml:"issue"`
+	PopularName             []PropertyType               `xml:"popularName"`
+	ProcessedBy             []PropertyType               `xml:"processedBy"`
+	ProcessedDate           []PropertyType               `xml:"processedDate"`
+	StartingPage            []PropertyType               `xml:"startingPage"`
+	EndingPage              []PropertyType               `xml:"endingPage"`
+	StartingProvision       []PropertyType               `xml:"startingProvision"`
+	EndingProvision         []PropertyType               `xml:"endingProvision"`
+	Property                []PropertyType               `xml:"property"`
+	CoverTitle              []PropertyType               `xml:"coverTitle"`
+	AmendmentNumber         []AmendmentNumberElement     `xml:"amendmentNumber"`
+	Congress                []PropertyType               `xml:"congress"`
+	CurrentChamber          []PropertyType               `xml:"currentChamber"`
+	CurrentThroughPublicLaw []PropertyType               `xml:"currentThroughPublicLaw"`
+	DistributionCode        []PropertyType               `xml:"distributionCode"`
+	DocNumber               []PropertyType               `xml:"docNumber"`
+	DocPart                 []PropertyType               `xml:"docPart"`
+	DocPublicationName      []PropertyType               `xml:"docPublicationName"`
+	DocReleasePoint         []PropertyType               `xml:"docReleasePoint"`
+	DocStage                []PropertyType               `xml:"docStage"`
+	EnrolledDateline        []PropertyType               `xml:"enrolledDateline"`
+	Purpose                 []PropertyType               `xml:"purpose"`
+	RelatedDocument         []PropertyType               `xml:"relatedDocument"`
+	Session                 []PropertyType               `xml:"session"`
+	Subject                 []PropertyType               `xml:"subject"`
+	Volume                  []PropertyType               `xml:"volume"`
+	ProvisionRange          []PropertyType               `xml:"provisionRange"`
+	PublicPrint             []string                     `xml:"publicPrint"`
+	PublicPrivate           []string                     `xml:"publicPrivate"`
+	RelatedDocuments        []RelatedDocumentsElement    `xml:"relatedDocuments"`
+	Set                     []SetType                    `xml:"set"`
+	StarPrint               []StarPrintType              `xml:"starPrint"`
+}
+
+// AmendPrefaceType is generated from the AmendPrefaceType complex type.
+//
+// The AmendPrefaceType is used for preface elements in amendment documents.
+type AmendPrefaceType struct {
+	XMLName                 xml.Name
+	XMLBase                 string                    `xml:"http://www.w3.org/XML/1998/namespace base,attr,omitempty"`
+	XMLLang                 string                    `xml:"http://www.w3.org/XML/1998/namespace lang,attr,omitempty"`
+	XMLSpace                string                    `xml:"http://www.w3.org/XML/1998/namespace space,attr,omitempty"`
+	ID                      string                    `xml:"id,attr,omitempty"`
+	TemporalID              string                    `xml:"temporalId,attr,omitempty"`
+	Identifier              string                    `xml:"identifier,attr,omitempty"`
+	Scope                   string                    `xml:"scope,attr,omitempty"`
+	Role                    string                    `xml:"role,attr,omitempty"`
+	Class                   string                    `xml:"class,attr,omitempty"`
+	Style                   string                    `xml:"style,attr,omitempty"`
+	StyleType               string                    `xml:"styleType,attr,omitempty"`
+	NoteAttr                string                    `xml:"note,attr,omitempty"`
+	Alt                     string                    `xml:"alt,attr,omitempty"`
+	Meta                    string                    `xml:"meta,attr,omitempty"`
+	Misc                    string                    `xml:"misc,attr,omitempty"`
+	DraftingTip             string                    `xml:"draftingTip,attr,omitempty"`
+	CodificationTip         string                    `xml:"codificationTip,attr,omitempty"`
+	Title                   string                    `xml:"title,attr,omitempty"`
+	Brief                   string                    `xml:"brief,attr,omitempty"`
+	SortOrder               string                    `xml:"sortOrder,attr,omitempty"`
+	StartPeriod             string                    `xml:"startPeriod,attr,omitempty"`
+	EndPeriod               string                    `xml:"endPeriod,attr,omitempty"`
+	Status                  string                    `xml:"status,attr,omitempty"`
+	Partial                 string                    `xml:"partial,attr,omitempty"`
+	InEffect                string                    `xml:"inEffect,attr,omitempty"`
+	AnyAttrs                []xml.Attr                `xml:",any,attr"`
+	Any                     []AnyElement              `xml:",any"`
+	Action                  []ActionElement           `xml:"action"`
+	Affected                []AffectedElement         `xml:"affected"`
+	DraftingOffice          []SimplePropertyType      `xml:"draftingOffice"`
+	DocTitle                []StatementType           `xml:"docTitle"`
+	FirstPageHeading        []FirstPageHeadingType    `xml:"firstPageHeading"`
+	FirstPageSubheading     []FirstPageHeadingType    `xml:"firstPageSubheading"`
+	Footnote                []PositionedNoteType      `xml:"footnote"`
+	Sidenote                []PositionedNoteType      `xml:"sidenote"`
+	Endnote                 []PositionedNoteType      `xml:"endnote"`
+	Ear                     []PositionedNoteType      `xml:"ear"`
+	LeftRunningHead         []RunningHeadType         `xml:"leftRunningHead"`
+	CenterRunningHead       []RunningHeadType         `xml:"centerRunningHead"`
+	RightRunningHead        []RunningHeadType         `xml:"rightRunningHead"`
+	SlugLine                []RunningHeadType         `xml:"slugLine"`
+	OfficialTitle           []StatementType           `xml:"officialTitle"`
+	Note                    []NoteType                `xml:"note"`
+	Elided                  []ElidedType              `xml:"elided"`
+	SourceCredit            []NoteType                `xml:"sourceCredit"`
+	UscNote                 []NoteType                `xml:"uscNote"`
+	StatutoryNote           []NoteType                `xml:"statutoryNote"`
+	DrafterNote             []NoteType                `xml:"drafterNote"`
+	EditorialNote           []NoteType                `xml:"editorialNote"`
+	ChangeNote              []NoteType                `xml:"changeNote"`
+	Authority               []NoteType                `xml:"authority"`
+	Source                  []NoteType                `xml:"source"`
+	EffectiveDateNote       []NoteType                `xml:"effectiveDateNote"`
+	FrDocID                 []NoteType                `xml:"frDocId"`
+	BillingCode             []NoteType                `xml:"billingCode"`
+	EditionNote             []NoteType                `xml:"editionNote"`
+	OrganizationNote        []NoteType                `xml:"organizationNote"`
+	CitationNote            []NoteType                `xml:"citationNote"`
+	ExplanationNote         []NoteType                `xml:"explanationNote"`
+	FindingAidsNote         []NoteType                `xml:"findingAidsNote"`
+	Notes                   []NotesType               `xml:"notes"`
+	LegislativeHistory      []NotesType               `xml:"legislativeHistory"`
+	P                       []PType                   `xml:"p"`
+	Page                    []PageType                `xml:"page"`
+	Line                    []PageType                `xml:"line"`
+	Property                []PropertyType            `xml:"property"`
+	CoverTitle              []PropertyType            `xml:"coverTitle"`
+	AmendmentNumber         []AmendmentNumberElement  `xml:"amendmentNumber"`
+	Congress                []PropertyType            `xml:"congress"`
+	CurrentChamber          []PropertyType            `xml:"currentChamber"`
+	CurrentThroughPublicLaw []PropertyType            `xml:"currentThroughPublicLaw"`
+	DistributionCode        []PropertyType            `xml:"distributionCode"`
+	DocNumber               []PropertyType            `xml:"docNumber"`
+	DocPart                 []PropertyType            `xml:"docPart"`
+	DocPublicationName      []PropertyType            `xml:"docPublicationName"`
+	DocReleasePoint         []PropertyType            `xml:"docReleasePoint"`
+	DocStage                []PropertyType            `xml:"docStage"`
+	EnrolledDateline        []PropertyType            `xml:"enrolledDateline"`
+	Purpose                 []PropertyType            `xml:"purpose"`
+	RelatedDocument         []PropertyType            `xml:"relatedDocument"`
+	Session                 []PropertyType            `xml:"session"`
+	Subject                 []PropertyType            `xml:"subject"`
+	Volume                  []PropertyType            `xml:"volume"`
+	ProvisionRange          []PropertyType            `xml:"provisionRange"`
+	RelatedDocuments        []RelatedDocumentsElement `xml:"relatedDocuments"`
+	Set                     []SetType                 `xml:"set"`
+	StarPrint               []StarPrintType           `xml:"starPrint"`
+}
+
+// AmendingActionType is generated from the AmendingActionType complex type.
+//
+// An amending action type is used to describe an amendment action in terms
+// that a computer program can explain.
+type AmendingActionType struct {
+	XMLName            xml.Name
+	Text               string               `xml:",chardata"`
+	XMLBase            string               `xml:"http://www.w3.org/XML/1998/namespace base,attr,omitempty"`
+	XMLLang            string               `xml:"http://www.w3.org/XML/1998/namespace lang,attr,omitempty"`
+	XMLSpace           string               `xml:"http://www.w3.org/XML/1998/namespace space,attr,omitempty"`
+	ID                 string               `xml:"id,attr,omitempty"`
+	TemporalID         string               `xml:"temporalId,attr,omitempty"`
+	Identifier         string               `xml:"identifier,attr,omitempty"`
+	Scope              string               `xml:"scope,attr,omitempty"`
+	Role               string               `xml:"role,attr,omitempty"`
+	Class              string               `xml:"class,attr,omitempty"`
+	Style              string               `xml:"style,attr,omitempty"`
+	StyleType          string               `xml:"styleType,attr,omitempty"`
+	NoteAttr           string               `xml:"note,attr,omitempty"`
+	Alt                string               `xml:"alt,attr,omitempty"`
+	Meta               string               `xml:"meta,attr,omitempty"`
+	Misc               string               `xml:"misc,attr,omitempty"`
+	DraftingTip        string               `xml:"draftingTip,attr,omitempty"`
+	CodificationTip    string               `xml:"codificationTip,attr,omitempty"`
+	Title              string               `xml:"title,attr,omitempty"`
+	Brief              string               `xml:"brief,attr,omitempty"`
+	SortOrder          string               `xml:"sortOrder,attr,omitempty"`
+	StartPeriod        string               `xml:"startPeriod,attr,omitempty"`
+	EndPeriod          string               `xml:"endPeriod,attr,omitempty"`
+	Status             string               `xml:"status,attr,omitempty"`
+	Partial            string               `xml:"partial,attr,omitempty"`
+	InEffect           string               `xml:"inEffect,attr,omitempty"`
+	AnyAttrs           []xml.Attr           `xml:",any,attr"`
+	Marker             []MarkerType         `xml:"marker"`
+	Br                 []BrType             `xml:"br"`
+	Img                []ImgType            `xml:"img"`
+	Inline             []InlineType         `xml:"inline"`
+	AmendingAction     []AmendingActionType `xml:"amendingAction"`
+	Ref                []RefType            `xml:"ref"`
+	Date               []DateType           `xml:"date"`
+	Center             []InlineType         `xml:"center"`
+	FillIn             []InlineType         `xml:"fillIn"`
+	CheckBox           []InlineType         `xml:"checkBox"`
+	B                  []InlineType         `xml:"b"`
+	I                  []InlineType         `xml:"i"`
+	Qualifier          []InlineType         `xml:"qualifier"`
+	Sub                []InlineType         `xml:"sub"`
+	Sup                []InlineType         `xml:"sup"`
+	HeadingText        []InlineType         `xml:"headingText"`
+	Span               []InlineType         `xml:"span"`
+	ShortTitle         []InlineType         `xml:"shortTitle"`
+	Term               []InlineType         `xml:"term"`
+	Entity             []InlineType         `xml:"entity"`
+	Committee          []CommitteeElement   `xml:"committee"`
+	Organization       []InlinePropertyType `xml:"organization"`
+	Note               []NoteType           `xml:"note"`
+	Elided             []ElidedType         `xml:"elided"`
+	SourceCredit       []NoteType           `xml:"sourceCredit"`
+	UscNote            []NoteType           `xml:"uscNote"`
+	StatutoryNote      []NoteType           `xml:"statutoryNote"`
+	DrafterNote        []NoteType           `xml:"drafterNote"`
+	EditorialNote      []NoteType           `xml:"editorialNote"`
+	ChangeNote         []NoteType           `xml:"changeNote"`
+	Authority          []NoteType           `xml:"authority"`
+	Source             []NoteType           `xml:"source"`
+	EffectiveDateNote  []NoteType           `xml:"effectiveDateNote"`
+	FrDocID            []NoteType           `xml:"frDocId"`
+	BillingCode        []NoteType           `xml:"billingCode"`
+	EditionNote        []NoteType           `xml:"editionNote"`
+	OrganizationNote   []NoteType           `xml:"organizationNote"`
+	CitationNote       []NoteType           `xml:"citationNote"`
+	ExplanationNote    []NoteType           `xml:"explanationNote"`
+	FindingAidsNote    []NoteType           `xml:"findingAidsNote"`
+	Notes              []NotesType          `xml:"notes"`
+	LegislativeHistory []NotesType          `xml:"legislativeHistory"`
+	Footnote           []PositionedNoteType `xml:"footnote"`
+	Sidenote           []PositionedNoteType `xml:"sidenote"`
+	Endnote            []PositionedNoteType `xml:"endnote"`
+	Ear                []PositionedNoteType `xml:"ear"`
+	Page               []PageType           `xml:"page"`
+	Line               []PageType           `xml:"line"`
+	Proviso            []ProvisoType        `xml:"proviso"`
+	QuotedText         []QuotedTextType     `xml:"quotedText"`
+	AddedText          []QuotedTextType     `xml:"addedText"`
+	DeletedText        []QuotedTextType     `xml:"deletedText"`
+	Del                []QuotedTextType     `xml:"del"`
+	Ins                []QuotedTextType     `xml:"ins"`
+	Href               string               `xml:"href,attr,omitempty"`
+	Idref              string               `xml:"idref,attr,omitempty"`
+	Portion            string               `xml:"portion,attr,omitempty"`
+	Type               string               `xml:"type,attr,omitempty"`
+}
+
+// AmendmentContentType is generated from the AmendmentContentType complex
+// type.
+//
+// An AmendmentContentType is used for amendments to bills that are not yet law
+// and amendment documents.
+type AmendmentContentType struct {
+	XMLName         xml.Name
+	Text            string       `xml:",chardata"`
+	XMLBase         string       `xml:"http://www.w3.org/XML/1998/namespace base,attr,omitempty"`
+	XMLLang         string       `xml:"http://www.w3.org/XML/1998/namespace lang,attr,omitempty"`
+	XMLSpace        string       `xml:"http://www.w3.org/XML/1998/namespace space,attr,omitempty"`
+	ID              string       `xml:"id,attr,omitempty"`
+	TemporalID      string       `xml:"temporalId,attr,omitempty"`
+	Identifier      string       `xml:"identifier,attr,omitempty"`
+	Scope           string       `xml:"scope,attr,omitempty"`
+	Role            string       `xml:"role,attr,omitempty"`
+	Class           string       `xml:"class,attr,omitempty"`
+	Style           string       `xml:"style,attr,omitempty"`
+	StyleType       string       `xml:"styleType,attr,omitempty"`
+	Note            string       `xml:"note,attr,omitempty"`
+	Alt             string       `xml:"alt,attr,omitempty"`
+	Meta            string       `xml:"meta,attr,omitempty"`
+	Misc            string       `xml:"misc,attr,omitempty"`
+	DraftingTip     string       `xml:"draftingTip,attr,omitempty"`
+	CodificationTip string       `xml:"codificationTip,attr,omitempty"`
+	Title           string       `xml:"title,attr,omitempty"`
+	Brief           string       `xml:"brief,attr,omitempty"`
+	SortOrder       string       `xml:"sortOrder,attr,omitempty"`
+	StartPeriod     string       `xml:"startPeriod,attr,omitempty"`
+	EndPeriod       string       `xml:"endPeriod,attr,omitempty"`
+	Status          string       `xml:"status,attr,omitempty"`
+	Partial         string       `xml:"partial,attr,omitempty"`
+	InEffect        string       `xml:"inEffect,attr,omitempty"`
+	AnyAttrs        []xml.Attr   `xml:",any,attr"`
+	Any             []AnyElement `xml:",any"`
+	XhtmlImg        []AnyElement `xml:"http://www.w3.org/1999/xhtml img"`
+	XhtmlTable      []AnyElement `xml:"http://www.w3.org/1999/xhtml table"`
+	MathmlMath      []AnyElement `xml:"http://www.w3.org/1998/Math/MathML math"`
+	Orientation     string       `xml:"orientation,attr,omitempty"`
+	Changed         string       `xml:"changed,attr,omitempty"`
+	Origin          string       `xml:"origin,attr,omitempty"`
+}
+
+// AmendmentInstructionType is generated from the AmendmentInstructionType
+// complex type.
+//
+// An amendment instruction type is used to describe an amendment instruction,
+// which in turn can contain amending actions and the locations in the
+// legislative document to which these are applied.
+type AmendmentInstructionType struct {
+	XMLName                     xml.Name
+	XMLBase                     string                `xml:"http://www.w3.org/XML/1998/namespace base,attr,omitempty"`
+	XMLLang                     string                `xml:"http://www.w3.org/XML/1998/namespace lang,attr,omitempty"`
+	XMLSpace                    string                `xml:"http://www.w3.org/XML/1998/namespace space,attr,omitempty"`
+	ID                          string                `xml:"id,attr,omitempty"`
+	TemporalID                  string                `xml:"temporalId,attr,omitempty"`
+	Identifier                  string                `xml:"identifier,attr,omitempty"`
+	Scope                       string                `xml:"scope,attr,omitempty"`
+	Role                        string                `xml:"role,attr,omitempty"`
+	Class                       string                `xml:"class,attr,omitempty"`
+	Style                       string                `xml:"style,attr,omitempty"`
+	StyleType                   string                `xml:"styleType,attr,omitempty"`
+	NoteAttr                    string                `xml:"note,attr,omitempty"`
+	Alt                         string                `xml:"alt,attr,omitempty"`
+	Meta                        string                `xml:"meta,attr,omitempty"`
+	Misc                        string                `xml:"misc,attr,omitempty"`
+	DraftingTip                 string                `xml:"draftingTip,attr,omitempty"`
+	CodificationTip             string                `xml:"codificationTip,attr,omitempty"`
+	TitleAttr                   string                `xml:"title,attr,omitempty"`
+	Brief                       string                `xml:"brief,attr,omitempty"`
+	SortOrder                   string                `xml:"sortOrder,attr,omitempty"`
+	StartPeriod                 string                `xml:"startPeriod,attr,omitempty"`
+	EndPeriod                   string                `xml:"endPeriod,attr,omitempty"`
+	Status                      string                `xml:"status,attr,omitempty"`
+	Partial                     string                `xml:"partial,attr,omitempty"`
+	InEffect                    string                `xml:"inEffect,attr,omitempty"`
+	AnyAttrs                    []xml.Attr            `xml:",any,attr"`
+	ReferenceMarker             []NumType             `xml:"referenceMarker"`
+	Num                         []NumType             `xml:"num"`
+	Footnote                    []PositionedNoteType  `xml:"footnote"`
+	Sidenote                    []PositionedNoteType  `xml:"sidenote"`
+	Endnote                     []PositionedNoteType  `xml:"endnote"`
+	Ear                         []PositionedNoteType  `xml:"ear"`
+	Note                        []NoteType            `xml:"note"`
+	Elided                      []ElidedType          `xml:"elided"`
+	SourceCredit                []NoteType            `xml:"sourceCredit"`
+	UscNote                     []NoteType            `xml:"uscNote"`
+	StatutoryNote               []NoteType            `xml:"statutoryNote"`
+	DrafterNote                 []NoteType            `xml:"drafterNote"`
+	EditorialNote               []NoteType            `xml:"editorialNote"`
+	ChangeNote                  []NoteType            `xml:"changeNote"`
+	Authority                   []NoteType            `xml:"authority"`
+	Source                      []NoteType            `xml:"source"`
+	EffectiveDateNote           []NoteType            `xml:"effectiveDateNote"`
+	FrDocID                     []NoteType            `xml:"frDocId"`
+	BillingCode                 []NoteType            `xml:"billingCode"`
+	EditionNote                 []NoteType            `xml:"editionNote"`
+	OrganizationNote            []NoteType            `xml:"organizationNote"`
+	CitationNote                []NoteType            `xml:"citationNote"`
+	ExplanationNote             []NoteType            `xml:"explanationNote"`
+	FindingAidsNote             []NoteType            `xml:"findingAidsNote"`
+	Notes                       []NotesType           `xml:"notes"`
+	LegislativeHistory          []NotesType           `xml:"legislativeHistory"`
+	Heading                     []HeadingType         `xml:"heading"`
+	Subheading                  []HeadingType         `xml:"subheading"`
+	Page                        []PageType            `xml:"page"`
+	Line                        []PageType            `xml:"line"`
+	Marker                      *MarkerType           `xml:"marker"`
+	Br                          *BrType               `xml:"br"`
+	Img                         *ImgType              `xml:"img"`
+	Toc                         []ToCIndexType        `xml:"toc"`
+	Index                       []ToCIndexType        `xml:"index"`
+	TableOfTitlesAndChapters    []ToCIndexType        `xml:"tableOfTitlesAndChapters"`
+	ListOfAgencies              []ToCIndexType        `xml:"listOfAgencies"`
+	ListOfSectionsAffected      []ToCIndexType        `xml:"listOfSectionsAffected"`
+	ListOfBillsEnacted          []ToCIndexType        `xml:"listOfBillsEnacted"`
+	ListOfPublicLaws            []ToCIndexType        `xml:"listOfPublicLaws"`
+	ListOfPrivateLaws           []ToCIndexType        `xml:"listOfPrivateLaws"`
+	ListOfConcurrentResolutions []ToCIndexType        `xml:"listOfConcurrentResolutions"`
+	ListOfProclamations         []ToCIndexType        `xml:"listOfProclamations"`
+	PopularNameIndex            []ToCIndexType        `xml:"popularNameIndex"`
+	SubjectIndex                []ToCIndexType        `xml:"subjectIndex"`
+	Statement                   *StatementType        `xml:"statement"`
+	LongTitle                   *LongTitleElement     `xml:"longTitle"`
+	DocTitle                    *StatementType        `xml:"docTitle"`
+	OfficialTitle               *StatementType        `xml:"officialTitle"`
+	Foreign                     []ForeignElement      `xml:"foreign"`
+	Content                     []ContentType         `xml:"content"`
+	QuotedContent               []QuotedContentType   `xml:"quotedContent"`
+	P                           []PType               `xml:"p"`
+	Text                        []ContentType         `xml:"text"`
+	Chapeau                     []ContentType         `xml:"chapeau"`
+	Continuation                []ContinuationElement `xml:"continuation"`
+	Proviso                     []ProvisoType         `xml:"proviso"`
+	Level                       []LevelType           `xml:"level"`
+	Preliminary                 []LevelType           `xml:"preliminary"`
+	Title                       []LevelType           `xml:"title"`
+	Subtitle                    []LevelType           `xml:"subtitle"`
+	Part                        []LevelType           `xml:"part"`
+	Subpart                     []LevelType           `xml:"subpart"`
+	Division                    []LevelType           `xml:"division"`
+	Subdivision                 []LevelType           `xml:"subdivision"`
+	Chapter                     []LevelType           `xml:"chapter"`
+	Subchapter                  []LevelType           `xml:"subchapter"`
+	Article                     []LevelType           `xml:"article"`
+	Subarticle                  []LevelType           `xml:"subarticle"`
+	CompiledAct                 []LevelType           `xml:"compiledAct"`
+	CourtRules                  []LevelType           `xml:"courtRules"`
+	CourtRule                   []LevelType           `xml:"courtRule"`
+	ReorganizationPlans         []LevelType           `xml:"reorganizationPlans"`
+	ReorganizationPlan          []LevelType           `xml:"reorganizationPlan"`
+	Section                     []LevelType           `xml:"section"`
+	Subsection                  []LevelType           `xml:"subsection"`
+	Paragraph                   []LevelType           `xml:"paragraph"`
+	Subparagraph                []LevelType           `xml:"subparagraph"`
+	Clause                      []LevelType           `xml:"clause"`
+	Subclause                   []LevelType           `xml:"subclause"`
+	Item                        []LevelType           `xml:"item"`
+	Subitem                     []LevelType           `xml:"subitem"`
+	Subsubitem                  []LevelType           `xml:"subsubitem"`
+	CrossHeading                []HeadingType         `xml:"crossHeading"`
+	LeftRunningHead             []RunningHeadType     `xml:"leftRunningHead"`
+	CenterRunningHead           []RunningHeadType     `xml:"centerRunningHead"`
+	RightRunningHead            []RunningHeadType     `xml:"rightRunningHead"`
+	SlugLine                    []RunningHeadType     `xml:"slugLine"`
+	Appropriations              []AppropriationsType  `xml:"appropriations"`
+	Signature                   []SignatureType       `xml:"signature"`
+	Appendix                    []AppendixType        `xml:"appendix"`
+	Schedule                    []AppendixType        `xml:"schedule"`
+	Changed                     string                `xml:"changed,attr,omitempty"`
+	Origin                      string                `xml:"origin,attr,omitempty"`
+}
+
+// AmendmentNumberElement is generated from the anonymous type of the
+// amendmentNumber element.
+//
+// The amendmentNumber is a property used to identify the amendment number
+// attached to this amendment document.
+type AmendmentNumberElement struct {
+	XMLName         xml.Name
+	Text            string     `xml:",chardata"`
+	XMLBase         string     `xml:"http://www.w3.org/XML/1998/namespace base,attr,omitempty"`
+	XMLLang         string     `xml:"http://www.w3.org/XML/1998/namespace lang,attr,omitempty"`
+	XMLSpace        string     `xml:"http://www.w3.org/XML/1998/namespace space,attr,omitempty"`
+	ID              string     `xml:"id,attr,omitempty"`
+	TemporalID      string     `xml:"temporalId,attr,omitempty"`
+	Identifier      string     `xml:"identifier,attr,omitempty"`
+	Scope           string     `xml:"scope,attr,omitempty"`
+	Role            string     `xml:"role,attr,omitempty"`
+	Class           string     `xml:"class,attr,omitempty"`
+	Style           string     `xml:"style,attr,omitempty"`
+	StyleType       string     `xml:"styleType,attr,omitempty"`
+	Note            string     `xml:"note,attr,omitempty"`
+	Alt             string     `xml:"alt,attr,omitempty"`
+	Meta            string     `xml:"meta,attr,omitempty"`
+	Misc            string     `xml:"misc,attr,omitempty"`
+	DraftingTip     string     `xml:"draftingTip,attr,omitempty"`
+	CodificationTip string     `xml:"codificationTip,attr,omitempty"`
+	Title           string     `xml:"title,attr,omitempty"`
+	Brief           string     `xml:"brief,attr,omitempty"`
+	SortOrder       string     `xml:"sortOrder,attr,omitempty"`
+	StartPeriod     string     `xml:"startPeriod,attr,omitempty"`
+	EndPeriod       string     `xml:"endPeriod,attr,omitempty"`
+	Status          string     `xml:"status,attr,omitempty"`
+	Partial         string     `xml:"partial,attr,omitempty"`
+	InEffect        string     `xml:"inEffect,attr,omitempty"`
+	AnyAttrs        []xml.Attr `xml:",any,attr"`
+	Date            string     `xml:"date,attr,omitempty"`
+	LegisDate       string     `xml:"legisDate,attr,omitempty"`
+	StartDate       string     `xml:"startDate,attr,omitempty"`
+	EndDate         string     `xml:"endDate,attr,omitempty"`
+	Display         string     `xml:"display,attr,omitempty"`
+	Value           string     `xml:"value,attr,omitempty"`
+	StartValue      string     `xml:"startValue,attr,omitempty"`
+	EndValue        string     `xml:"endValue,attr,omitempty"`
+	Href            string     `xml:"href,attr,omitempty"`
+	Idref           string     `xml:"idref,attr,omitempty"`
+	Portion         string     `xml:"portion,attr,omitempty"`
+	Type            string     `xml:"type,attr,omitempty"`
+}
+
+// AppendixType is generated from the AppendixType complex type.
+//
+// The appendix type is used to attach associated documents to the main
+// document.
+type AppendixType struct {
+	XMLName                     xml.Name
+	Text                        string               `xml:",chardata"`
+	XMLBase                     string               `xml:"http://www.w3.org/XML/1998/namespace base,attr,omitempty"`
+	XMLLang                     string               `xml:"http://www.w3.org/XML/1998/namespace lang,attr,omitempty"`
+	XMLSpace                    string               `xml:"http://www.w3.org/XML/1998/namespace space,attr,omitempty"`
+	ID                          string               `xml:"id,attr,omitempty"`
+	TemporalID                  string               `xml:"temporalId,attr,omitempty"`
+	Identifier                  string               `xml:"identifier,attr,omitempty"`
+	Scope                       string               `xml:"scope,attr,omitempty"`
+	Role                        string               `xml:"role,attr,omitempty"`
+	Class                       string               `xml:"class,attr,omitempty"`
+	Style                       string               `xml:"style,attr,omitempty"`
+	StyleType                   string               `xml:"styleType,attr,omitempty"`
+	NoteAttr                    string               `xml:"note,attr,omitempty"`
+	Alt                         string               `xml:"alt,attr,omitempty"`
+	Meta                        string               `xml:"meta,attr,omitempty"`
+	Misc                        string               `xml:"misc,attr,omitempty"`
+	DraftingTip                 string               `xml:"draftingTip,attr,omitempty"`
+	CodificationTip             string               `xml:"codificationTip,attr,omitempty"`
+	TitleAttr                   string               `xml:"title,attr,omitempty"`
+	Brief                       string               `xml:"brief,attr,omitempty"`
+	SortOrder                   string               `xml:"sortOrder,attr,omitempty"`
+	StartPeriod                 string               `xml:"startPeriod,attr,omitempty"`
+	EndPeriod                   string               `xml:"endPeriod,attr,omitempty"`
+	Status                      string               `xml:"status,attr,omitempty"`
+	Partial                     string               `xml:"partial,attr,omitempty"`
+	InEffect                    string               `xml:"inEffect,attr,omitempty"`
+	AnyAttrs                    []xml.Attr           `xml:",any,attr"`
+	Page                        []PageType           `xml:"page"`
+	Line                        []PageType           `xml:"line"`
+	Preface                     *PrefaceType         `xml:"preface"`
+	Main                        *MainType            `xml:"main"`
+	Content                     *ContentType         `xml:"content"`
+	QuotedContent               *QuotedContentType   `xml:"quotedContent"`
+	Notes                       []NotesType          `xml:"notes"`
+	LegislativeHistory          []NotesType          `xml:"legislativeHistory"`
+	Num                         []NumType            `xml:"num"`
+	Footnote                    []PositionedNoteType `xml:"footnote"`
+	Sidenote                    []PositionedNoteType `xml:"sidenote"`
+	Endnote                     []PositionedNoteType `xml:"endnote"`
+	Ear                         []PositionedNoteType `xml:"ear"`
+	Note                        []NoteType           `xml:"note"`
+	Elided                      []ElidedType         `xml:"elided"`
+	SourceCredit                []NoteType           `xml:"sourceCredit"`
+	UscNote                     []NoteType           `xml:"uscNote"`
+	StatutoryNote               []NoteType           `xml:"statutoryNote"`
+	DrafterNote                 []NoteType           `xml:"drafterNote"`
+	EditorialNote               []NoteType           `xml:"editorialNote"`
+	ChangeNote                  []NoteType           `xml:"changeNote"`
+	Authority                   []NoteType           `xml:"authority"`
+	Source                      []NoteType           `xml:"source"`
+	EffectiveDateNote           []NoteType           `xml:"effectiveDateNote"`
+	FrDocID                     []NoteType           `xml:"frDocId"`
+	BillingCode                 []NoteType           `xml:"billingCode"`
+	EditionNote                 []NoteType           `xml:"editionNote"`
+	OrganizationNote            []NoteType           `xml:"organizationNote"`
+	CitationNote                []NoteType           `xml:"citationNote"`
+	ExplanationNote             []NoteType           `xml:"explanationNote"`
+	FindingAidsNote             []NoteType           `xml:"findingAidsNote"`
+	Heading                     []HeadingType        `xml:"heading"`
+	Subheading                  []HeadingType        `xml:"subheading"`
+	Toc                         []ToCIndexType       `xml:"toc"`
+	Index                       []ToCIndexType       `xml:"index"`
+	TableOfTitlesAndChapters    []ToCIndexType       `xml:"tableOfTitlesAndChapters"`
+	ListOfAgencies              []ToCIndexType       `xml:"listOfAgencies"`
+	ListOfSectionsAffected      []ToCIndexType       `xml:"listOfSectionsAffected"`
+	ListOfBillsEnacted          []ToCIndexType       `xml:"listOfBillsEnacted"`
+	ListOfPublicLaws            []ToCIndexType       `xml:"listOfPublicLaws"`
+	ListOfPrivateLaws           []ToCIndexType       `xml:"listOfPrivateLaws"`
+	ListOfConcurrentResolutions []ToCIndexType       `xml:"listOfConcurrentResolutions"`
+	ListOfProclamations         []ToCIndexType       `xml:"listOfProclamations"`
+	PopularNameIndex            []ToCIndexType       `xml:"popularNameIndex"`
+	SubjectIndex                []ToCIndexType       `xml:"subjectIndex"`
+	Block                       []BlockType          `xml:"block"`
+	Signatures                  []SignaturesType     `xml:"signatures"`
+	Fragment                    []BlockType          `xml:"fragment"`
+	Level                       []LevelType          `xml:"level"`
+	Preliminary                 []LevelType          `xml:"preliminary"`
+	Title                       []LevelType          `xml:"title"`
+	Subtitle                    []LevelType          `xml:"subtitle"`
+	Part                        []LevelType          `xml:"part"`
+	Subpart                     []LevelType          `xml:"subpart"`
+	Division                    []LevelType          `xml:"division"`
+	Subdivision                 []LevelType          `xml:"subdivision"`
+	Chapter                     []LevelType          `xml:"chapter"`
+	Subchapter                  []LevelType          `xml:"subchapter"`
+	Article                     []LevelType          `xml:"article"`
+	Subarticle                  []LevelType          `xml:"subarticle"`
+	CompiledAct                 []LevelType          `xml:"compiledAct"`
+	CourtRules                  []LevelType          `xml:"courtRules"`
+	CourtRule                   []LevelType          `xml:"courtRule"`
+	ReorganizationPlans         []LevelType          `xml:"reorganizationPlans"`
+	ReorganizationPlan          []LevelType          `xml:"reorganizationPlan"`
+	Section                     []LevelType          `xml:"section"`
+	Subsection                  []LevelType          `xml:"subsection"`
+	Paragraph                   []LevelType          `xml:"paragraph"`
+	Subparagraph                []LevelType          `xml:"subparagraph"`
+	Clause                      []LevelType          `xml:"clause"`
+	Subclause                   []LevelType          `xml:"subclause"`
+	Item                        []LevelType          `xml:"item"`
+	Subitem                     []LevelType          `xml:"subitem"`
+	Subsubitem                  []LevelType          `xml:"subsubitem"`
+	Src                         string               `xml:"src,attr,omitempty"`
+	Orientation                 string               `xml:"orientation,attr,omitempty"`
+}
+
+// AppropriationsType is generated from the AppropriationsType complex type.
+//
+// Appropriations are similar to levels, with additional attributes related to
+// the appropriation.
+type AppropriationsType struct {
+	XMLName             xml.Name
+	XMLBase             string                `xml:"http://www.w3.org/XML/1998/namespace base,attr,omitempty"`
+	XMLLang             string                `xml:"http://www.w3.org/XML/1998/namespace lang,attr,omitempty"`
+	XMLSpace            string                `xml:"http://www.w3.org/XML/1998/namespace space,attr,omitempty"`
+	ID                  string                `xml:"id,attr,omitempty"`
+	TemporalID          string                `xml:"temporalId,attr,omitempty"`
+	Identifier          string                `xml:"identifier,attr,omitempty"`
+	Scope               string                `xml:"scope,attr,omitempty"`
+	Role                string                `xml:"role,attr,omitempty"`
+	Class               string                `xml:"class,attr,omitempty"`
+	Style               string                `xml:"style,attr,omitempty"`
+	StyleType           string                `xml:"styleType,attr,omitempty"`
+	NoteAttr            string                `xml:"note,attr,omitempty"`
+	Alt                 string                `xml:"alt,attr,omitempty"`
+	Meta                string                `xml:"meta,attr,omitempty"`
+	Misc                string                `xml:"misc,attr,omitempty"`
+	DraftingTip         string                `xml:"draftingTip,attr,omitempty"`
+	CodificationTip     string                `xml:"codificationTip,attr,omitempty"`
+	TitleAttr           string                `xml:"title,attr,omitempty"`
+	Brief               string                `xml:"brief,attr,omitempty"`
+	SortOrder           string                `xml:"sortOrder,attr,omitempty"`
+	StartPeriod         string                `xml:"startPeriod,attr,omitempty"`
+	EndPeriod           string                `xml:"endPeriod,attr,omitempty"`
+	Status              string                `xml:"status,attr,omitempty"`
+	Partial             string                `xml:"partial,attr,omitempty"`
+	InEffect            string                `xml:"inEffect,attr,omitempty"`
+	AnyAttrs            []xml.Attr            `xml:",any,attr"`
+	Heading             []HeadingType         `xml:"heading"`
+	Subheading          []HeadingType         `xml:"subheading"`
+	Footnote            []PositionedNoteType  `xml:"footnote"`
+	Sidenote            []PositionedNoteType  `xml:"sidenote"`
+	Endnote             []PositionedNoteType  `xml:"endnote"`
+	Ear                 []PositionedNoteType  `xml:"ear"`
+	Note                []NoteType            `xml:"note"`
+	Elided              []ElidedType          `xml:"elided"`
+	SourceCredit        []NoteType            `xml:"sourceCredit"`
+	UscNote             []NoteType            `xml:"uscNote"`
+	StatutoryNote       []NoteType            `xml:"statutoryNote"`
+	DrafterNote         []NoteType            `xml:"drafterNote"`
+	EditorialNote       []NoteType            `xml:"editorialNote"`
+	ChangeNote          []NoteType            `xml:"changeNote"`
+	Authority           []NoteType            `xml:"authority"`
+	Source              []NoteType            `xml:"source"`
+	EffectiveDateNote   []NoteType            `xml:"effectiveDateNote"`
+	FrDocID             []NoteType            `xml:"frDocId"`
+	BillingCode         []NoteType            `xml:"billingCode"`
+	EditionNote         []NoteType            `xml:"editionNote"`
+	OrganizationNote    []NoteType            `xml:"organizationNote"`
+	CitationNote        []NoteType            `xml:"citationNote"`
+	ExplanationNote     []NoteType            `xml:"explanationNote"`
+	FindingAidsNote     []NoteType            `xml:"findingAidsNote"`
+	Notes               []NotesType           `xml:"notes"`
+	LegislativeHistory  []NotesType           `xml:"legislativeHistory"`
+	Page                []PageType            `xml:"page"`
+	Line                []PageType            `xml:"line"`
+	Content             []ContentType         `xml:"content"`
+	QuotedContent       []QuotedContentType   `xml:"quotedContent"`
+	P                   []PType               `xml:"p"`
+	Text                []ContentType         `xml:"text"`
+	Chapeau             []ContentType         `xml:"chapeau"`
+	Continuation        []ContinuationElement `xml:"continuation"`
+	Proviso             []ProvisoType         `xml:"proviso"`
+	Level               []LevelType           `xml:"level"`
+	Preliminary         []LevelType           `xml:"preliminary"`
+	Title               []LevelType           `xml:"title"`
+	Subtitle            []LevelType           `xml:"subtitle"`
+	Part                []LevelType           `xml:"part"`
+	Subpart             []LevelType           `xml:"subpart"`
+	Division            []LevelType           `xml:"division"`
+	Subdivision         []LevelType           `xml:"subdivision"`
+	Chapter             []LevelType           `xml:"chapter"`
+	Subchapter          []LevelType           `xml:"subchapter"`
+	Article             []LevelType           `xml:"article"`
+	Subarticle          []LevelType           `xml:"subarticle"`
+	CompiledAct         []LevelType           `xml:"compiledAct"`
+	CourtRules          []LevelType           `xml:"courtRules"`
+	CourtRule           []LevelType           `xml:"courtRule"`
+	ReorganizationPlans []LevelType           `xml:"reorganizationPlans"`
+	ReorganizationPlan  []LevelType           `xml:"reorganizationPlan"`
+	Section             []LevelType           `xml:"section"`
+	Subsection          []LevelType           `xml:"subsection"`
+	Paragraph           []LevelType           `xml:"paragraph"`
+	Subparagraph        []LevelType           `xml:"subparagraph"`
+	Clause              []LevelType           `xml:"clause"`
+	Subclause           []LevelType           `xml:"subclause"`
+	Item                []LevelType           `xml:"item"`
+	Subitem             []LevelType           `xml:"subitem"`
+	Subsubitem          []LevelType           `xml:"subsubitem"`
+	CrossHeading        []HeadingType         `xml:"crossHeading"`
+	LeftRunningHead     []RunningHeadType     `xml:"leftRunningHead"`
+	CenterRunningHead   []RunningHeadType     `xml:"centerRunningHead"`
+	RightRunningHead    []RunningHeadType     `xml:"rightRunningHead"`
+	SlugLine            []RunningHeadType     `xml:"slugLine"`
+	Appropriations      []AppropriationsType  `xml:"appropriations"`
+	Signature           []SignatureType       `xml:"signature"`
+	Changed             string                `xml:"changed,attr,omitempty"`
+	Origin              string                `xml:"origin,attr,omitempty"`
+	LevelAttr           string                `xml:"level,attr,omitempty"`
+	ForType             string                `xml:"forType,attr,omitempty"`
+	ForValue            string                `xml:"forValue,attr,omitempty"`
+}
+
+// AttestationType is generated from the AttestationType complex type.
+//
+// Defines a block for a set of attestations.
+type AttestationType struct {
+	XMLName         xml.Name
+	XMLBase         string          `xml:"http://www.w3.org/XML/1998/namespace base,attr,omitempty"`
+	XMLLang         string          `xml:"http://www.w3.org/XML/1998/namespace lang,attr,omitempty"`
+	XMLSpace        string          `xml:"http://www.w3.org/XML/1998/namespace space,attr,omitempty"`
+	ID              string          `xml:"id,attr,omitempty"`
+	TemporalID      string          `xml:"temporalId,attr,omitempty"`
+	Identifier      string          `xml:"identifier,attr,omitempty"`
+	Scope           string          `xml:"scope,attr,omitempty"`
+	Role            string          `xml:"role,attr,omitempty"`
+	Class           string          `xml:"class,attr,omitempty"`
+	Style           string          `xml:"style,attr,omitempty"`
+	StyleType       string          `xml:"styleType,attr,omitempty"`
+	Note            string          `xml:"note,attr,omitempty"`
+	Alt             string          `xml:"alt,attr,omitempty"`
+	Meta            string          `xml:"meta,attr,omitempty"`
+	Misc            string          `xml:"misc,attr,omitempty"`
+	DraftingTip     string          `xml:"draftingTip,attr,omitempty"`
+	CodificationTip string          `xml:"codificationTip,attr,omitempty"`
+	Title           string          `xml:"title,attr,omitempty"`
+	Brief           string          `xml:"brief,attr,omitempty"`
+	SortOrder       string          `xml:"sortOrder,attr,omitempty"`
+	StartPeriod     string          `xml:"startPeriod,attr,omitempty"`
+	EndPeriod       string          `xml:"endPeriod,attr,omitempty"`
+	Status          string          `xml:"status,attr,omitempty"`
+	Partial         string          `xml:"partial,attr,omitempty"`
+	InEffect        string          `xml:"inEffect,attr,omitempty"`
+	AnyAttrs        []xml.Attr      `xml:",any,attr"`
+	Changed         string          `xml:"changed,attr,omitempty"`
+	Orientation     string          `xml:"orientation,attr,omitempty"`
+	Origin          string          `xml:"origin,attr,omitempty"`
+	Action          *ActionElement  `xml:"action"`
+	Signatures      *SignaturesType `xml:"signatures"`
+}
+
+// BackMatterType is generated from the BackMatterType complex type.
+//
+// The back matter type is used to contain multiple components at the end of a
+// document.
+type BackMatterType struct {
+	XMLName                     xml.Name
+	Text                        string              `xml:",chardata"`
+	XMLBase                     string              `xml:"http://www.w3.org/XML/1998/namespace base,attr,omitempty"`
+	XMLLang                     string              `xml:"http://www.w3.org/XML/1998/namespace lang,attr,omitempty"`
+	XMLSpace                    string              `xml:"http://www.w3.org/XML/1998/namespace space,attr,omitempty"`
+	ID                          string              `xml:"id,attr,omitempty"`
+	TemporalID                  string              `xml:"temporalId,attr,omitempty"`
+	Identifier                  string              `xml:"identifier,attr,omitempty"`
+	Scope                       string              `xml:"scope,attr,omitempty"`
+	Role                        string              `xml:"role,attr,omitempty"`
+	Class                       string              `xml:"class,attr,omitempty"`
+	Style                       string              `xml:"style,attr,omitempty"`
+	StyleType                   string              `xml:"styleType,attr,omitempty"`
+	NoteAttr                    string              `xml:"note,attr,omitempty"`
+	Alt                         string              `xml:"alt,attr,omitempty"`
+	Meta                        string              `xml:"meta,attr,omitempty"`
+	Misc                        string              `xml:"misc,attr,omitempty"`
+	DraftingTip                 string              `xml:"draftingTip,attr,omitempty"`
+	CodificationTip             string              `xml:"codificationTip,attr,omitempty"`
+	Title                       string              `xml:"title,attr,omitempty"`
+	Brief                       string              `xml:"brief,attr,omitempty"`
+	SortOrder                   string              `xml:"sortOrder,attr,omitempty"`
+	StartPeriod                 string              `xml:"startPeriod,attr,omitempty"`
+	EndPeriod                   string              `xml:"endPeriod,attr,omitempty"`
+	Status                      string              `xml:"status,attr,omitempty"`
+	Partial                     string              `xml:"partial,attr,omitempty"`
+	InEffect                    string              `xml:"inEffect,attr,omitempty"`
+	AnyAttrs                    []xml.Attr          `xml:",any,attr"`
+	Content                     []ContentType       `xml:"content"`
+	QuotedContent               []QuotedContentType `xml:"quotedContent"`
+	Index                       []ToCIndexType      `xml:"index"`
+	TableOfTitlesAndChapters    []ToCIndexType      `xml:"tableOfTitlesAndChapters"`
+	ListOfAgencies              []ToCIndexType      `xml:"listOfAgencies"`
+	ListOfSectionsAffected      []ToCIndexType      `xml:"listOfSectionsAffected"`
+	ListOfBillsEnacted          []ToCIndexType      `xml:"listOfBillsEnacted"`
+	ListOfPublicLaws            []ToCIndexType      `xml:"listOfPublicLaws"`
+	ListOfPrivateLaws           []ToCIndexType      `xml:"listOfPrivateLaws"`
+	ListOfConcurrentResolutions []ToCIndexType      `xml:"listOfConcurrentResolutions"`
+	ListOfProclamations         []ToCIndexType      `xml:"listOfProclamations"`
+	PopularNameIndex            []ToCIndexType      `xml:"popularNameIndex"`
+	SubjectIndex                []ToCIndexType      `xml:"subjectIndex"`
+	P                           []PType             `xml:"p"`
+	Page                        []PageType          `xml:"page"`
+	Line                        []PageType          `xml:"line"`
+	Note                        []NoteType          `xml:"note"`
+	Elided                      []ElidedType        `xml:"elided"`
+	SourceCredit                []NoteType          `xml:"sourceCredit"`
+	UscNote                     []NoteType          `xml:"uscNote"`
+	StatutoryNote               []NoteType          `xml:"statutoryNote"`
+	DrafterNote                 []NoteType          `xml:"drafterNote"`
+	EditorialNote               []NoteType          `xml:"editorialNote"`
+	ChangeNote                  []NoteType          `xml:"changeNote"`
+	Authority                   []NoteType          `xml:"authority"`
+	Source                      []NoteType          `xml:"source"`
+	EffectiveDateNote           []NoteType          `xml:"effectiveDateNote"`
+	FrDocID                     []NoteType          `xml:"frDocId"`
+	BillingCode                 []NoteType          `xml:"billingCode"`
+	EditionNote                 []NoteType          `xml:"editionNote"`
+	OrganizationNote            []NoteType          `xml:"organizationNote"`
+	CitationNote                []NoteType          `xml:"citationNote"`
+	ExplanationNote             []NoteType          `xml:"explanationNote"`
+	FindingAidsNote             []NoteType          `xml:"findingAidsNote"`
+	Notes                       []NotesType         `xml:"notes"`
+	LegislativeHistory          []NotesType         `xml:"legislativeHistory"`
+}
+
+// BaseBlockType is generated from the BaseBlockType complex type.
+//
+// The base block type is a variant of the base type, but having a content
+// structure to support block level children - elements but no text.
+type BaseBlockType struct {
+	XMLName         xml.Name
+	XMLBase         string     `xml:"http://www.w3.org/XML/1998/namespace base,attr,omitempty"`
+	XMLLang         string     `xml:"http://www.w3.org/XML/1998/namespace lang,attr,omitempty"`
+	XMLSpace        string     `xml:"http://www.w3.org/XML/1998/namespace space,attr,omitempty"`
+	ID              string     `xml:"id,attr,omitempty"`
+	TemporalID      string     `xml:"temporalId,attr,omitempty"`
+	Identifier      string     `xml:"identifier,attr,omitempty"`
+	Scope           string     `xml:"scope,attr,omitempty"`
+	Role            string     `xml:"role,attr,omitempty"`
+	Class           string     `xml:"class,attr,omitempty"`
+	Style           string     `xml:"style,attr,omitempty"`
+	StyleType       string     `xml:"styleType,attr,omitempty"`
+	Note            string     `xml:"note,attr,omitempty"`
+	Alt             string     `xml:"alt,attr,omitempty"`
+	Meta            string     `xml:"meta,attr,omitempty"`
+	Misc            string     `xml:"misc,attr,omitempty"`
+	DraftingTip     string     `xml:"draftingTip,attr,omitempty"`
+	CodificationTip string     `xml:"codificationTip,attr,omitempty"`
+	Title           string     `xml:"title,attr,omitempty"`
+	Brief           string     `xml:"brief,attr,omitempty"`
+	SortOrder       string     `xml:"sortOrder,attr,omitempty"`
+	StartPeriod     string     `xml:"startPeriod,attr,omitempty"`
+	EndPeriod       string     `xml:"endPeriod,attr,omitempty"`
+	Status          string     `xml:"status,attr,omitempty"`
+	Partial         string     `xml:"partial,attr,omitempty"`
+	InEffect        string     `xml:"inEffect,attr,omitempty"`
+	AnyAttrs        []xml.Attr `xml:",any,attr"`
+}
+
+// BaseContentType is generated from the BaseContentType complex type.
+//
+// The base content type is a variant of the base type, but having a very open
+// content model including text.
+type BaseContentType struct {
+	XMLName         xml.Name
+	Text            string     `xml:",chardata"`
+	XMLBase         string     `xml:"http://www.w3.org/XML/1998/namespace base,attr,omitempty"`
+	XMLLang         string     `xml:"http://www.w3.org/XML/1998/namespace lang,attr,omitempty"`
+	XMLSpace        string     `xml:"http://www.w3.org/XML/1998/namespace space,attr,omitempty"`
+	ID              string     `xml:"id,attr,omitempty"`
+	TemporalID      string     `xml:"temporalId,attr,omitempty"`
+	Identifier      string     `xml:"identifier,attr,omitempty"`
+	Scope           string     `xml:"scope,attr,omitempty"`
+	Role            string     `xml:"role,attr,omitempty"`
+	Class           string     `xml:"class,attr,omitempty"`
+	Style           string     `xml:"style,attr,omitempty"`
+	StyleType       string     `xml:"styleType,attr,omitempty"`
+	Note            string     `xml:"note,attr,omitempty"`
+	Alt             string     `xml:"alt,attr,omitempty"`
+	Meta            string     `xml:"meta,attr,omitempty"`
+	Misc            string     `xml:"misc,attr,omitempty"`
+	DraftingTip     string     `xml:"draftingTip,attr,omitempty"`
+	CodificationTip string     `xml:"codificationTip,attr,omitempty"`
+	Title           string     `xml:"title,attr,omitempty"`
+	Brief           string     `xml:"brief,attr,omitempty"`
+	SortOrder       string     `xml:"sortOrder,attr,omitempty"`
+	StartPeriod     string     `xml:"startPeriod,attr,omitempty"`
+	EndPeriod       string     `xml:"endPeriod,attr,omitempty"`
+	Status          string     `xml:"status,attr,omitempty"`
+	Partial         string     `xml:"partial,attr,omitempty"`
+	InEffect        string     `xml:"inEffect,attr,omitempty"`
+	AnyAttrs        []xml.Attr `xml:",any,attr"`
+}
+
+// BaseType is generated from the BaseType complex type.
+//
+// The base type defines the most general element, specifying the attributes
+// which can be found on all elements - specifically attributes belonging to
+// the identification, classification, and annotation groups.
+type BaseType struct {
+	XMLName         xml.Name
+	Text            string     `xml:",chardata"`
+	XMLBase         string     `xml:"http://www.w3.org/XML/1998/namespace base,attr,omitempty"`
+	XMLLang         string     `xml:"http://www.w3.org/XML/1998/namespace lang,attr,omitempty"`
+	XMLSpace        string     `xml:"http://www.w3.org/XML/1998/namespace space,attr,omitempty"`
+	ID              string     `xml:"id,attr,omitempty"`
+	TemporalID      string     `xml:"temporalId,attr,omitempty"`
+	Identifier      string     `xml:"identifier,attr,omitempty"`
+	Scope           string     `xml:"scope,attr,omitempty"`
+	Role            string     `xml:"role,attr,omitempty"`
+	Class           string     `xml:"class,attr,omitempty"`
+	Style           string     `xml:"style,attr,omitempty"`
+	StyleType       string     `xml:"styleType,attr,omitempty"`
+	Note            string     `xml:"note,attr,omitempty"`
+	Alt             string     `xml:"alt,attr,omitempty"`
+	Meta            string     `xml:"meta,attr,omitempty"`
+	Misc            string     `xml:"misc,attr,omitempty"`
+	DraftingTip     string     `xml:"draftingTip,attr,omitempty"`
+	CodificationTip string     `xml:"codificationTip,attr,omitempty"`
+	Title           string     `xml:"title,attr,omitempty"`
+	Brief           string     `xml:"brief,attr,omitempty"`
+	SortOrder       string     `xml:"sortOrder,attr,omitempty"`
+	StartPeriod     string     `xml:"startPeriod,attr,omitempty"`
+	EndPeriod       string     `xml:"endPeriod,attr,omitempty"`
+	Status          string     `xml:"status,attr,omitempty"`
+	Partial         string     `xml:"partial,attr,omitempty"`
+	InEffect        string     `xml:"inEffect,attr,omitempty"`
+	AnyAttrs        []xml.Attr `xml:",any,attr"`
+}
+
+// BlockType is generated from the BlockType complex type.
+//
+// The block type is a extension of the base type to content consisting of only
+// elements.
+type BlockType struct {
+	XMLName         xml.Name
+	XMLBase         string       `xml:"http://www.w3.org/XML/1998/namespace base,attr,omitempty"`
+	XMLLang         string       `xml:"http://www.w3.org/XML/1998/namespace lang,attr,omitempty"`
+	XMLSpace        string       `xml:"http://www.w3.org/XML/1998/namespace space,attr,omitempty"`
+	ID              string       `xml:"id,attr,omitempty"`
+	TemporalID      string       `xml:"temporalId,attr,omitempty"`
+	Identifier      string       `xml:"identifier,attr,omitempty"`
+	Scope           string       `xml:"scope,attr,omitempty"`
+	Role            string       `xml:"role,attr,omitempty"`
+	Class           string       `xml:"class,attr,omitempty"`
+	Style           string       `xml:"style,attr,omitempty"`
+	StyleType       string       `xml:"styleType,attr,omitempty"`
+	Note            string       `xml:"note,attr,omitempty"`
+	Alt             string       `xml:"alt,attr,omitempty"`
+	Meta            string       `xml:"meta,attr,omitempty"`
+	Misc            string       `xml:"misc,attr,omitempty"`
+	DraftingTip     string       `xml:"draftingTip,attr,omitempty"`
+	CodificationTip string       `xml:"codificationTip,attr,omitempty"`
+	Title           string       `xml:"title,attr,omitempty"`
+	Brief           string       `xml:"brief,attr,omitempty"`
+	SortOrder       string       `xml:"sortOrder,attr,omitempty"`
+	StartPeriod     string       `xml:"startPeriod,attr,omitempty"`
+	EndPeriod       string       `xml:"endPeriod,attr,omitempty"`
+	Status          string       `xml:"status,attr,omitempty"`
+	Partial         string       `xml:"partial,attr,omitempty"`
+	InEffect        string       `xml:"inEffect,attr,omitempty"`
+	AnyAttrs        []xml.Attr   `xml:",any,attr"`
+	Any             []AnyElement `xml:",any"`
+	Changed         string       `xml:"changed,attr,omitempty"`
+	Orientation     string       `xml:"orientation,attr,omitempty"`
+	Origin          string       `xml:"origin,attr,omitempty"`
+}
+
+// BrType is generated from the BrType complex type.
+//
+// A break type is simple marker element denoting a line break.
+type BrType struct {
+	XMLName         xml.Name
+	Text            string     `xml:",chardata"`
+	XMLBase         string     `xml:"http://www.w3.org/XML/1998/namespace base,attr,omitempty"`
+	XMLLang         string     `xml:"http://www.w3.org/XML/1998/namespace lang,attr,omitempty"`
+	XMLSpace        string     `xml:"http://www.w3.org/XML/1998/namespace space,attr,omitempty"`
+	ID              string     `xml:"id,attr,omitempty"`
+	TemporalID      string     `xml:"temporalId,attr,omitempty"`
+	Identifier      string     `xml:"identifier,attr,omitempty"`
+	Scope           string     `xml:"scope,attr,omitempty"`
+	Role            string     `xml:"role,attr,omitempty"`
+	Class           string     `xml:"class,attr,omitempty"`
+	Style           string     `xml:"style,attr,omitempty"`
+	StyleType       string     `xml:"styleType,attr,omitempty"`
+	Note            string     `xml:"note,attr,omitempty"`
+	Alt             string     `xml:"alt,attr,omitempty"`
+	Meta            string     `xml:"meta,attr,omitempty"`
+	Misc            string     `xml:"misc,attr,omitempty"`
+	DraftingTip     string     `xml:"draftingTip,attr,omitempty"`
+	CodificationTip string     `xml:"codificationTip,attr,omitempty"`
+	Title           string     `xml:"title,attr,omitempty"`
+	Brief           string     `xml:"brief,attr,omitempty"`
+	SortOrder       string     `xml:"sortOrder,attr,omitempty"`
+	StartPeriod     string     `xml:"startPeriod,attr,omitempty"`
+	EndPeriod       string     `xml:"endPeriod,attr,omitempty"`
+	Status          string     `xml:"status,attr,omitempty"`
+	Partial         string     `xml:"partial,attr,omitempty"`
+	InEffect        string     `xml:"inEffect,attr,omitempty"`
+	AnyAttrs        []xml.Attr `xml:",any,attr"`
+	VerticalSpace   string     `xml:"verticalSpace,attr,omitempty"`
+}
+
+// CitableAsElement is generated from the anonymous type of the citableAs
+// element.
+//
+// The citableAs is a property that specifies how this document may be cited.
+type CitableAsElement struct {
+	XMLName         xml.Name
+	Text            string     `xml:",chardata"`
+	XMLBase         string     `xml:"http://www.w3.org/XML/1998/namespace base,attr,omitempty"`
+	XMLLang         string     `xml:"http://www.w3.org/XML/1998/namespace lang,attr,omitempty"`
+	XMLSpace        string     `xml:"http://www.w3.org/XML/1998/namespace space,attr,omitempty"`
+	ID              string     `xml:"id,attr,omitempty"`
+	TemporalID      string     `xml:"temporalId,attr,omitempty"`
+	Identifier      string     `xml:"identifier,attr,omitempty"`
+	Scope           string     `xml:"scope,attr,omitempty"`
+	Role            string     `xml:"role,attr,omitempty"`
+	Class           string     `xml:"class,attr,omitempty"`
+	Style           string     `xml:"style,attr,omitempty"`
+	StyleType       string     `xml:"styleType,attr,omitempty"`
+	Note            string     `xml:"note,attr,omitempty"`
+	Alt             string     `xml:"alt,attr,omitempty"`
+	Meta            string     `xml:"meta,attr,omitempty"`
+	Misc            string     `xml:"misc,attr,omitempty"`
+	DraftingTip     string     `xml:"draftingTip,attr,omitempty"`
+	CodificationTip string     `xml:"codificationTip,attr,omitempty"`
+	Title           string     `xml:"title,attr,omitempty"`
+	Brief           string     `xml:"brief,attr,omitempty"`
+	SortOrder       string     `xml:"sortOrder,attr,omitempty"`
+	StartPeriod     string     `xml:"startPeriod,attr,omitempty"`
+	EndPeriod       string     `xml:"endPeriod,attr,omitempty"`
+	Status          string     `xml:"status,attr,omitempty"`
+	Partial         string     `xml:"partial,attr,omitempty"`
+	InEffect        string     `xml:"inEffect,attr,omitempty"`
+	AnyAttrs        []xml.Attr `xml:",any,attr"`
+	Date            string     `xml:"date,attr,omitempty"`
+	LegisDate       string     `xml:"legisDate,attr,omitempty"`
+	StartDate       string     `xml:"startDate,attr,omitempty"`
+	EndDate         string     `xml:"endDate,attr,omitempty"`
+	Display         string     `xml:"display,attr,omitempty"`
+	Value           string     `xml:"value,attr,omitempty"`
+	StartValue      string     `xml:"startValue,attr,omitempty"`
+	EndValue        string     `xml:"endValue,attr,omitempty"`
+	Href            string     `xml:"href,attr,omitempty"`
+	Idref           string     `xml:"idref,attr,omitempty"`
+	Portion         string     `xml:"portion,attr,omitempty"`
+	Type            string     `xml:"type,attr,omitempty"`
+}
+
+// CitableAsShortTitleElement is generated from the anonymous type of the
+// citableAsShortTitle element.
+//
+// The citableAsShortTitle is a property that specifies a short title by which
+// this document may be cited.
+type CitableAsShortTitleElement struct {
+	XMLName         xml.Name
+	Text            string     `xml:",chardata"`
+	XMLBase         string     `xml:"http://www.w3.org/XML/1998/namespace base,attr,omitempty"`
+	XMLLang         string     `xml:"http://www.w3.org/XML/1998/namespace lang,attr,omitempty"`
+	XMLSpace        string     `xml:"http://www.w3.org/XML/1998/namespace space,attr,omitempty"`
+	ID              string     `xml:"id,attr,omitempty"`
+	TemporalID      string     `xml:"temporalId,attr,omitempty"`
+	Identifier      string     `xml:"identifier,attr,omitempty"`
+	Scope           string     `xml:"scope,attr,omitempty"`
+	Role            string     `xml:"role,attr,omitempty"`
+	Class           string     `xml:"class,attr,omitempty"`
+	Style           string     `xml:"style,attr,omitempty"`
+	StyleType       string     `xml:"styleType,attr,omitempty"`
+	Note            string     `xml:"note,attr,omitempty"`
+	Alt             string     `xml:"alt,attr,omitempty"`
+	Meta            string     `xml:"meta,attr,omitempty"`
+	Misc            string     `xml:"misc,attr,omitempty"`
+	DraftingTip     string     `xml:"draftingTip,attr,omitempty"`
+	CodificationTip string     `xml:"codificationTip,attr,omitempty"`
+	Title           string     `xml:"title,attr,omitempty"`
+	Brief           string     `xml:"brief,attr,omitempty"`
+	SortOrder       string     `xml:"sortOrder,attr,omitempty"`
+	StartPeriod     string     `xml:"startPeriod,attr,omitempty"`
+	EndPeriod       string     `xml:"endPeriod,attr,omitempty"`
+	Status          string     `xml:"status,attr,omitempty"`
+	Partial         string     `xml:"partial,attr,omitempty"`
+	InEffect        string     `xml:"inEffect,attr,omitempty"`
+	AnyAttrs        []xml.Attr `xml:",any,attr"`
+	Date            string     `xml:"date,attr,omitempty"`
+	LegisDate       string     `xml:"legisDate,attr,omitempty"`
+	StartDate       string     `xml:"startDate,attr,omitempty"`
+	EndDate         string     `xml:"endDate,attr,omitempty"`
+	Display         string     `xml:"display,attr,omitempty"`
+	Value           string     `xml:"value,attr,omitempty"`
+	StartValue      string     `xml:"startValue,attr,omitempty"`
+	EndValue        string     `xml:"endValue,attr,omitempty"`
+	Href            string     `xml:"href,attr,omitempty"`
+	Idref           string     `xml:"idref,attr,omitempty"`
+	Portion         string     `xml:"portion,attr,omitempty"`
+	Type            string     `xml:"type,attr,omitempty"`
+}
+
+// CollectionType is generated from the CollectionType complex type.
+//
+// The collection core type is the basic element in a document that is a
+// collection of items, potentially from external sources.
+type CollectionType struct {
+	XMLName                     xml.Name
+	XMLBase                     string              `xml:"http://www.w3.org/XML/1998/namespace base,attr,omitempty"`
+	XMLLang                     string              `xml:"http://www.w3.org/XML/1998/namespace lang,attr,omitempty"`
+	XMLSpace                    string              `xml:"http://www.w3.org/XML/1998/namespace space,attr,omitempty"`
+	ID                          string              `xml:"id,attr,omitempty"`
+	TemporalID                  string              `xml:"temporalId,attr,omitempty"`
+	Identifier                  string              `xml:"identifier,attr,omitempty"`
+	Scope                       string              `xml:"scope,attr,omitempty"`
+	Role                        string              `xml:"role,attr,omitempty"`
+	Class                       string              `xml:"class,attr,omitempty"`
+	Style                       string              `xml:"style,attr,omitempty"`
+	StyleType                   string              `xml:"styleType,attr,omitempty"`
+	NoteAttr                    string              `xml:"note,attr,omitempty"`
+	Alt                         string              `xml:"alt,attr,omitempty"`
+	Meta                        string              `xml:"meta,attr,omitempty"`
+	Misc                        string              `xml:"misc,attr,omitempty"`
+	DraftingTip                 string              `xml:"draftingTip,attr,omitempty"`
+	CodificationTip             string              `xml:"codificationTip,attr,omitempty"`
+	Title                       string              `xml:"title,attr,omitempty"`
+	Brief                       string              `xml:"brief,attr,omitempty"`
+	SortOrder                   string              `xml:"sortOrder,attr,omitempty"`
+	StartPeriod                 string              `xml:"startPeriod,attr,omitempty"`
+	EndPeriod                   string              `xml:"endPeriod,attr,omitempty"`
+	Status                      string              `xml:"status,attr,omitempty"`
+	Partial                     string              `xml:"partial,attr,omitempty"`
+	InEffect                    string              `xml:"inEffect,attr,omitempty"`
+	AnyAttrs                    []xml.Attr          `xml:",any,attr"`
+	Preface                     []PrefaceType       `xml:"preface"`
+	Toc                         []ToCIndexType      `xml:"toc"`
+	Index                       []ToCIndexType      `xml:"index"`
+	TableOfTitlesAndChapters    []ToCIndexType      `xml:"tableOfTitlesAndChapters"`
+	ListOfAgencies              []ToCIndexType      `xml:"listOfAgencies"`
+	ListOfSectionsAffected      []ToCIndexType      `xml:"listOfSectionsAffected"`
+	ListOfBillsEnacted          []ToCIndexType      `xml:"listOfBillsEnacted"`
+	ListOfPublicLaws            []ToCIndexType      `xml:"listOfPublicLaws"`
+	ListOfPrivateLaws           []ToCIndexType      `xml:"listOfPrivateLaws"`
+	ListOfConcurrentResolutions []ToCIndexType      `xml:"listOfConcurrentResolutions"`
+	ListOfProclamations         []ToCIndexType      `xml:"listOfProclamations"`
+	PopularNameIndex            []ToCIndexType      `xml:"popularNameIndex"`
+	SubjectIndex                []ToCIndexType      `xml:"subjectIndex"`
+	Content                     []ContentType       `xml:"content"`
+	QuotedContent               []QuotedContentType `xml:"quotedContent"`
+	Collection                  []CollectionType    `xml:"collection"`
+	Notices                     []CollectionType    `xml:"notices"`
+	Rules                       []CollectionType    `xml:"rules"`
+	ProposedRules               []CollectionType    `xml:"proposedRules"`
+	PresidentialDocs            []CollectionType    `xml:"presidentialDocs"`
+	AgencyGroup                 []CollectionType    `xml:"agencyGroup"`
+	PublicLaws                  []CollectionType    `xml:"publicLaws"`
+	PrivateLaws                 []CollectionType    `xml:"privateLaws"`
+	ConcurrentResolutions       []CollectionType    `xml:"concurrentResolutions"`
+	Component                   []ComponentType     `xml:"component"`
+	Note                        []NoteType          `xml:"note"`
+	Elided                      []ElidedType        `xml:"elided"`
+	SourceCredit                []NoteType          `xml:"sourceCredit"`
+	UscNote                     []NoteType          `xml:"uscNote"`
+	StatutoryNote               []NoteType          `xml:"statutoryNote"`
+	DrafterNote                 []NoteType          `xml:"drafterNote"`
+	EditorialNote               []NoteType          `xml:"editorialNote"`
+	ChangeNote                  []NoteType          `xml:"changeNote"`
+	Authority                   []NoteType          `xml:"authority"`
+	Source                      []NoteType          `xml:"source"`
+	EffectiveDateNote           []NoteType          `xml:"effectiveDateNote"`
+	FrDocID                     []NoteType          `xml:"frDocId"`
+	BillingCode                 []NoteType          `xml:"billingCode"`
+	EditionNote                 []NoteType          `xml:"editionNote"`
+	OrganizationNote            []NoteType          `xml:"organizationNote"`
+	CitationNote                []NoteType          `xml:"citationNote"`
+	ExplanationNote             []NoteType          `xml:"explanationNote"`
+	FindingAidsNote             []NoteType          `xml:"findingAidsNote"`
+	Page                        []PageType          `xml:"page"`
+	Line                        []PageType          `xml:"line"`
+}
+
+// ColumnType is generated from the ColumnType complex type.
+//
+// A column type is a column in a layout structure.
+type ColumnType struct {
+	XMLName             xml.Name
+	Text                string               `xml:",chardata"`
+	Block               []BlockType          `xml:"block"`
+	Signatures          []SignaturesType     `xml:"signatures"`
+	Fragment            []BlockType          `xml:"fragment"`
+	Footnote            []PositionedNoteType `xml:"footnote"`
+	Sidenote            []PositionedNoteType `xml:"sidenote"`
+	Endnote             []PositionedNoteType `xml:"endnote"`
+	Ear                 []PositionedNoteType `xml:"ear"`
+	Foreign             []ForeignElement     `xml:"foreign"`
+	Heading             []HeadingType        `xml:"heading"`
+	Inline              []InlineType         `xml:"inline"`
+	AmendingAction      []AmendingActionType `xml:"amendingAction"`
+	Ref                 []RefType            `xml:"ref"`
+	Date                []DateType           `xml:"date"`
+	Center              []InlineType         `xml:"center"`
+	FillIn              []InlineType         `xml:"fillIn"`
+	CheckBox            []InlineType         `xml:"checkBox"`
+	B                   []InlineType         `xml:"b"`
+	I                   []InlineType         `xml:"i"`
+	Qualifier           []InlineType         `xml:"qualifier"`
+	Sub                 []InlineType         `xml:"sub"`
+	Sup                 []InlineType         `xml:"sup"`
+	HeadingText         []InlineType         `xml:"headingText"`
+	Span                []InlineType         `xml:"span"`
+	ShortTitle          []InlineType         `xml:"shortTitle"`
+	Term                []InlineType         `xml:"term"`
+	Entity              []InlineType         `xml:"entity"`
+	Figure              []FigureType         `xml:"figure"`
+	Level               []LevelType          `xml:"level"`
+	Preliminary         []LevelType          `xml:"preliminary"`
+	Title               []LevelType          `xml:"title"`
+	Subtitle            []LevelType          `xml:"subtitle"`
+	Part                []LevelType          `xml:"part"`
+	Subpart             []LevelType          `xml:"subpart"`
+	Division            []LevelType          `xml:"division"`
+	Subdivision         []LevelType          `xml:"subdivision"`
+	Chapter             []LevelType          `xml:"chapter"`
+	Subchapter          []LevelType          `xml:"subchapter"`
+	Article             []LevelType          `xml:"article"`
+	Subarticle          []LevelType          `xml:"subarticle"`
+	CompiledAct         []LevelType          `xml:"compiledAct"`
+	CourtRules          []LevelType          `xml:"courtRules"`
+	CourtRule           []LevelType          `xml:"courtRule"`
+	ReorganizationPlans []LevelType          `xml:"reorganizationPlans"`
+	ReorganizationPlan  []LevelType          `xml:"reorganizationPlan"`
+	Section             []LevelType          `xml:"section"`
+	Subsection          []LevelType          `xml:"subsection"`
+	Paragraph           []LevelType          `xml:"paragraph"`
+	Subparagraph        []LevelType          `xml:"subparagraph"`
+	Clause              []LevelType          `xml:"clause"`
+	Subclause           []LevelType          `xml:"subclause"`
+	Item                []LevelType          `xml:"item"`
+	Subitem             []LevelType          `xml:"subitem"`
+	Subsubitem          []LevelType          `xml:"subsubitem"`
+	List                []ListType           `xml:"list"`
+	Marker              []MarkerType         `xml:"marker"`
+	Br                  []BrType             `xml:"br"`
+	Img                 []ImgType            `xml:"img"`
+	MathmlMath          []AnyElement         `xml:"http://www.w3.org/1998/Math/MathML math"`
+	MathmlMo            []AnyElement         `xml:"http://www.w3.org/1998/Math/MathML mo"`
+	Notation            []NotationElement    `xml:"notation"`
+	P                   []PType              `xml:"p"`
+	Page                []PageType           `xml:"page"`
+	Line                []PageType           `xml:"line"`
+	QuotedContent       []QuotedContentType  `xml:"quotedContent"`
+	QuotedText          []QuotedTextType     `xml:"quotedText"`
+	AddedText           []QuotedTextType     `xml:"addedText"`
+	DeletedText         []QuotedTextType     `xml:"deletedText"`
+	Del                 []QuotedTextType     `xml:"del"`
+	Ins                 []QuotedTextType     `xml:"ins"`
+	Signature           []SignatureType      `xml:"signature"`
+	Subheading          []HeadingType        `xml:"subheading"`
+	XhtmlTable          []AnyElement         `xml:"http://www.w3.org/1999/xhtml table"`
+	Note                []NoteType           `xml:"note"`
+	Elided              []ElidedType         `xml:"elided"`
+	SourceCredit        []NoteType           `xml:"sourceCredit"`
+	UscNote             []NoteType           `xml:"uscNote"`
+	StatutoryNote       []NoteType           `xml:"statutoryNote"`
+	DrafterNote         []NoteType           `xml:"drafterNote"`
+	EditorialNote       []NoteType           `xml:"editorialNote"`
+	ChangeNote          []NoteType           `xml:"changeNote"`
+	Authority           []NoteType           `xml:"authority"`
+	Source              []NoteType           `xml:"source"`
+	EffectiveDateNote   []NoteType           `xml:"effectiveDateNote"`
+	FrDocID             []NoteType           `xml:"frDocId"`
+	BillingCode         []NoteType           `xml:"billingCode"`
+	EditionNote         []NoteType           `xml:"editionNote"`
+	OrganizationNote    []NoteType           `xml:"organizationNote"`
+	CitationNote        []NoteType           `xml:"citationNote"`
+	ExplanationNote     []NoteType           `xml:"explanationNote"`
+	FindingAidsNote     []NoteType           `xml:"findingAidsNote"`
+	Notes               []NotesType          `xml:"notes"`
+	LegislativeHistory  []NotesType          `xml:"legislativeHistory"`
+	Role                string               `xml:"role,attr,omitempty"`
+	Class               string               `xml:"class,attr,omitempty"`
+	Style               string               `xml:"style,attr,omitempty"`
+	StyleType           string               `xml:"styleType,attr,omitempty"`
+	ID                  string               `xml:"id,attr,omitempty"`
+	TemporalID          string               `xml:"temporalId,attr,omitempty"`
+	Identifier          string               `xml:"identifier,attr,omitempty"`
+	Scope               string               `xml:"scope,attr,omitempty"`
+	XMLBase             string               `xml:"http://www.w3.org/XML/1998/namespace base,attr,omitempty"`
+	XMLLang             string               `xml:"http://www.w3.org/XML/1998/namespace lang,attr,omitempty"`
+	XMLSpace            string               `xml:"http://www.w3.org/XML/1998/namespace space,attr,omitempty"`
+	Colspan             string               `xml:"colspan,attr,omitempty"`
+	Rowspan             string               `xml:"rowspan,attr,omitempty"`
+	Leaders             string               `xml:"leaders,attr,omitempty"`
+}
+
+// CommitteeElement is generated from the anonymous type of the committee
+// element.
+//
+// A committee element is used to tag references to committees in text.
+type CommitteeElement struct {
+	XMLName             xml.Name
+	Text                string               `xml:",chardata"`
+	XMLBase             string               `xml:"http://www.w3.org/XML/1998/namespace base,attr,omitempty"`
+	XMLLang             string               `xml:"http://www.w3.org/XML/1998/namespace lang,attr,omitempty"`
+	XMLSpace            string               `xml:"http://www.w3.org/XML/1998/namespace space,attr,omitempty"`
+	ID                  string               `xml:"id,attr,omitempty"`
+	TemporalID          string               `xml:"temporalId,attr,omitempty"`
+	Identifier          string               `xml:"identifier,attr,omitempty"`
+	Scope               string               `xml:"scope,attr,omitempty"`
+	Role                string               `xml:"role,attr,omitempty"`
+	Class               string               `xml:"class,attr,omitempty"`
+	Style               string               `xml:"style,attr,omitempty"`
+	StyleType           string               `xml:"styleType,attr,omitempty"`
+	NoteAttr            string               `xml:"note,attr,omitempty"`
+	Alt                 string               `xml:"alt,attr,omitempty"`
+	Meta                string               `xml:"meta,attr,omitempty"`
+	Misc                string               `xml:"misc,attr,omitempty"`
+	DraftingTip         string               `xml:"draftingTip,attr,omitempty"`
+	CodificationTip     string               `xml:"codificationTip,attr,omitempty"`
+	Title               string               `xml:"title,attr,omitempty"`
+	Brief               string               `xml:"brief,attr,omitempty"`
+	SortOrder           string               `xml:"sortOrder,attr,omitempty"`
+	StartPeriod         string               `xml:"startPeriod,attr,omitempty"`
+	EndPeriod           string               `xml:"endPeriod,attr,omitempty"`
+	Status              string               `xml:"status,attr,omitempty"`
+	Partial             string               `xml:"partial,attr,omitempty"`
+	InEffect            string               `xml:"inEffect,attr,omitempty"`
+	AnyAttrs            []xml.Attr           `xml:",any,attr"`
+	Marker              []MarkerType         `xml:"marker"`
+	Br                  []BrType             `xml:"br"`
+	Img                 []ImgType            `xml:"img"`
+	Inline              []InlineType         `xml:"inline"`
+	AmendingAction      []AmendingActionType `xml:"amendingAction"`
+	Ref                 []RefType            `xml:"ref"`
+	Date                []DateType           `xml:"date"`
+	Center              []InlineType         `xml:"center"`
+	FillIn              []InlineType         `xml:"fillIn"`
+	CheckBox            []InlineType         `xml:"checkBox"`
+	B                   []InlineType         `xml:"b"`
+	I                   []InlineType         `xml:"i"`
+	Qualifier           []InlineType         `xml:"qualifier"`
+	Sub                 []InlineType         `xml:"sub"`
+	Sup                 []InlineType         `xml:"sup"`
+	HeadingText         []InlineType         `xml:"headingText"`
+	Span                []InlineType         `xml:"span"`
+	ShortTitle          []InlineType         `xml:"shortTitle"`
+	Term                []InlineType         `xml:"term"`
+	Entity              []InlineType         `xml:"entity"`
+	Footnote            []PositionedNoteType `xml:"footnote"`
+	Sidenote            []PositionedNoteType `xml:"sidenote"`
+	Endnote             []PositionedNoteType `xml:"endnote"`
+	Ear                 []PositionedNoteType `xml:"ear"`
+	Note                []NoteType           `xml:"note"`
+	Elided              []ElidedType         `xml:"elided"`
+	SourceCredit        []NoteType           `xml:"sourceCredit"`
+	UscNote             []NoteType           `xml:"uscNote"`
+	StatutoryNote       []NoteType           `xml:"statutoryNote"`
+	DrafterNote         []NoteType           `xml:"drafterNote"`
+	EditorialNote       []NoteType           `xml:"editorialNote"`
+	ChangeNote          []NoteType           `xml:"changeNote"`
+	Authority           []NoteType           `xml:"authority"`
+	Source              []NoteType           `xml:"source"`
+	EffectiveDateNote   []NoteType           `xml:"effectiveDateNote"`
+	FrDocID             []NoteType           `xml:"frDocId"`
+	BillingCode         []NoteType           `xml:"billingCode"`
+	EditionNote         []NoteType           `xml:"editionNote"`
+	OrganizationNote    []NoteType           `xml:"organizationNote"`
+	CitationNote        []NoteType           `xml:"citationNote"`
+	ExplanationNote     []NoteType           `xml:"explanationNote"`
+	FindingAidsNote     []NoteType           `xml:"findingAidsNote"`
+	Notes               []NotesType          `xml:"notes"`
+	LegislativeHistory  []NotesType          `xml:"legislativeHistory"`
+	Page                []PageType           `xml:"page"`
+	Line                []PageType           `xml:"line"`
+	Display             string               `xml:"display,attr,omitempty"`
+	CommitteeID         string               `xml:"committeeId,attr,omitempty"`
+	AddedDisplayStyle   string               `xml:"addedDisplayStyle,attr,omitempty"`
+	DeletedDisplayStyle string               `xml:"deletedDisplayStyle,attr,omitempty"`
+}
+
+// ComponentType is generated from the ComponentType complex type.
+//
+// A ComponentType is a generic container for an individual item.
+type ComponentType struct {
+	XMLName         xml.Name
+	Text            string       `xml:",chardata"`
+	XMLBase         string       `xml:"http://www.w3.org/XML/1998/namespace base,attr,omitempty"`
+	XMLLang         string       `xml:"http://www.w3.org/XML/1998/namespace lang,attr,omitempty"`
+	XMLSpace        string       `xml:"http://www.w3.org/XML/1998/namespace space,attr,omitempty"`
+	ID              string       `xml:"id,attr,omitempty"`
+	TemporalID      string       `xml:"temporalId,attr,omitempty"`
+	Identifier      string       `xml:"identifier,attr,omitempty"`
+	Scope           string       `xml:"scope,attr,omitempty"`
+	Role            string       `xml:"role,attr,omitempty"`
+	Class           string       `xml:"class,attr,omitempty"`
+	Style           string       `xml:"style,attr,omitempty"`
+	StyleType       string       `xml:"styleType,attr,omitempty"`
+	Note            string       `xml:"note,attr,omitempty"`
+	Alt             string       `xml:"alt,attr,omitempty"`
+	Meta            string       `xml:"meta,attr,omitempty"`
+	Misc            string       `xml:"misc,attr,omitempty"`
+	DraftingTip     string       `xml:"draftingTip,attr,omitempty"`
+	CodificationTip string       `xml:"codificationTip,attr,omitempty"`
+	Title           string       `xml:"title,attr,omitempty"`
+	Brief           string       `xml:"brief,attr,omitempty"`
+	SortOrder       string       `xml:"sortOrder,attr,omitempty"`
+	StartPeriod     string       `xml:"startPeriod,attr,omitempty"`
+	EndPeriod       string       `xml:"endPeriod,attr,omitempty"`
+	Status          string       `xml:"status,attr,omitempty"`
+	Partial         string       `xml:"partial,attr,omitempty"`
+	InEffect        string       `xml:"inEffect,attr,omitempty"`
+	AnyAttrs        []xml.Attr   `xml:",any,attr"`
+	Any             []AnyElement `xml:",any"`
+	XhtmlImg        []AnyElement `xml:"http://www.w3.org/1999/xhtml img"`
+	XhtmlTable      []AnyElement `xml:"http://www.w3.org/1999/xhtml table"`
+	MathmlMath      []AnyElement `xml:"http://www.w3.org/1998/Math/MathML math"`
+	Orientation     string       `xml:"orientation,attr,omitempty"`
+	Changed         string       `xml:"changed,attr,omitempty"`
+	Origin          string       `xml:"origin,attr,omitempty"`
+	Href            string       `xml:"href,attr,omitempty"`
+	Idref           string       `xml:"idref,attr,omitempty"`
+	Portion         string       `xml:"portion,attr,omitempty"`
+}
+
+// ContainsShortTitleElement is generated from the anonymous type of the
+// containsShortTitle element.
+//
+// The containsShortTitle is a property that specifies a short title which is
+// defined in the document in a 'may be cited' clause.
+type ContainsShortTitleElement struct {
+	XMLName         xml.Name
+	Text            string     `xml:",chardata"`
+	XMLBase         string     `xml:"http://www.w3.org/XML/1998/namespace base,attr,omitempty"`
+	XMLLang         string     `xml:"http://www.w3.org/XML/1998/namespace lang,attr,omitempty"`
+	XMLSpace        string     `xml:"http://www.w3.org/XML/1998/namespace space,attr,omitempty"`
+	ID              string     `xml:"id,attr,omitempty"`
+	TemporalID      string     `xml:"temporalId,attr,omitempty"`
+	Identifier      string     `xml:"identifier,attr,omitempty"`
+	Scope           string     `xml:"scope,attr,omitempty"`
+	Role            string     `xml:"role,attr,omitempty"`
+	Class           string     `xml:"class,attr,omitempty"`
+	Style           string     `xml:"style,attr,omitempty"`
+	StyleType       string     `xml:"styleType,attr,omitempty"`
+	Note            string     `xml:"note,attr,omitempty"`
+	Alt             string     `xml:"alt,attr,omitempty"`
+	Meta            string     `xml:"meta,attr,omitempty"`
+	Misc            string     `xml:"misc,attr,omitempty"`
+	DraftingTip     string     `xml:"draftingTip,attr,omitempty"`
+	CodificationTip string     `xml:"codificationTip,attr,omitempty"`
+	Title           string     `xml:"title,attr,omitempty"`
+	Brief           string     `xml:"brief,attr,omitempty"`
+	SortOrder       string     `xml:"sortOrder,attr,omitempty"`
+	StartPeriod     string     `xml:"startPeriod,attr,omitempty"`
+	EndPeriod       string     `xml:"endPeriod,attr,omitempty"`
+	Status          string     `xml:"status,attr,omitempty"`
+	Partial         string     `xml:"partial,attr,omitempty"`
+	InEffect        string     `xml:"inEffect,attr,omitempty"`
+	AnyAttrs        []xml.Attr `xml:",any,attr"`
+	Date            string     `xml:"date,attr,omitempty"`
+	LegisDate       string     `xml:"legisDate,attr,omitempty"`
+	StartDate       string     `xml:"startDate,attr,omitempty"`
+	EndDate         string     `xml:"endDate,attr,omitempty"`
+	Display         string     `xml:"display,attr,omitempty"`
+	Value           string     `xml:"value,attr,omitempty"`
+	StartValue      string     `xml:"startValue,attr,omitempty"`
+	EndValue        string     `xml:"endValue,attr,omitempty"`
+	Href            string     `xml:"href,attr,omitempty"`
+	Idref           string     `xml:"idref,attr,omitempty"`
+	Portion         string     `xml:"portion,attr,omitempty"`
+	Type            string     `xml:"type,attr,omitempty"`
+}
+
+// ContentType is generated from the ContentType complex type.
+//
+// The content type is a broad base type allowing any content.
+type ContentType struct {
+	XMLName         xml.Name
+	Text            string       `xml:",chardata"`
+	XMLBase         string       `xml:"http://www.w3.org/XML/1998/namespace base,attr,omitempty"`
+	XMLLang         string       `xml:"http://www.w3.org/XML/1998/namespace lang,attr,omitempty"`
+	XMLSpace        string       `xml:"http://www.w3.org/XML/1998/namespace space,attr,omitempty"`
+	ID              string       `xml:"id,attr,omitempty"`
+	TemporalID      string       `xml:"temporalId,attr,omitempty"`
+	Identifier      string       `xml:"identifier,attr,omitempty"`
+	Scope           string       `xml:"scope,attr,omitempty"`
+	Role            string       `xml:"role,attr,omitempty"`
+	Class           string       `xml:"class,attr,omitempty"`
+	Style           string       `xml:"style,attr,omitempty"`
+	StyleType       string       `xml:"styleType,attr,omitempty"`
+	Note            string       `xml:"note,attr,omitempty"`
+	Alt             string       `xml:"alt,attr,omitempty"`
+	Meta            string       `xml:"meta,attr,omitempty"`
+	Misc            string       `xml:"misc,attr,omitempty"`
+	DraftingTip     string       `xml:"draftingTip,attr,omitempty"`
+	CodificationTip string       `xml:"codificationTip,attr,omitempty"`
+	Title           string       `xml:"title,attr,omitempty"`
+	Brief           string       `xml:"brief,attr,omitempty"`
+	SortOrder       string       `xml:"sortOrder,attr,omitempty"`
+	StartPeriod     string       `xml:"startPeriod,attr,omitempty"`
+	EndPeriod       string       `xml:"endPeriod,attr,omitempty"`
+	Status          string       `xml:"status,attr,omitempty"`
+	Partial         string       `xml:"partial,attr,omitempty"`
+	InEffect        string       `xml:"inEffect,attr,omitempty"`
+	AnyAttrs        []xml.Attr   `xml:",any,attr"`
+	Any             []AnyElement `xml:",any"`
+	XhtmlImg        []AnyElement `xml:"http://www.w3.org/1999/xhtml img"`
+	XhtmlTable      []AnyElement `xml:"http://www.w3.org/1999/xhtml table"`
+	MathmlMath      []AnyElement `xml:"http://www.w3.org/1998/Math/MathML math"`
+	Orientation     string       `xml:"orientation,attr,omitempty"`
+}
+
+// ContinuationElement is generated from the anonymous type of the continuation
+// element.
+//
+// Use a continuation for interstitial text or whenever there is final text
+// that comes after lower levels in a level hierarchy and the text alone is not
+// permitted by the content model.
+type ContinuationElement struct {
+	XMLName         xml.Name
+	Text            string       `xml:",chardata"`
+	XMLBase         string       `xml:"http://www.w3.org/XML/1998/namespace base,attr,omitempty"`
+	XMLLang         string       `xml:"http://www.w3.org/XML/1998/namespace lang,attr,omitempty"`
+	XMLSpace        string       `xml:"http://www.w3.org/XML/1998/namespace space,attr,omitempty"`
+	ID              string       `xml:"id,attr,omitempty"`
+	TemporalID      string       `xml:"temporalId,attr,omitempty"`
+	Identifier      string       `xml:"identifier,attr,omitempty"`
+	Scope           string       `xml:"scope,attr,omitempty"`
+	Role            string       `xml:"role,attr,omitempty"`
+	Class           string       `xml:"class,attr,omitempty"`
+	Style           string       `xml:"style,attr,omitempty"`
+	StyleType       string       `xml:"styleType,attr,omitempty"`
+	Note            string       `xml:"note,attr,omitempty"`
+	Alt             string       `xml:"alt,attr,omitempty"`
+	Meta            string       `xml:"meta,attr,omitempty"`
+	Misc            string       `xml:"misc,attr,omitempty"`
+	DraftingTip     string       `xml:"draftingTip,attr,omitempty"`
+	CodificationTip string       `xml:"codificationTip,attr,omitempty"`
+	Title           string       `xml:"title,attr,omitempty"`
+	Brief           string       `xml:"brief,attr,omitempty"`
+	SortOrder       string       `xml:"sortOrder,attr,omitempty"`
+	StartPeriod     string       `xml:"startPeriod,attr,omitempty"`
+	EndPeriod       string       `xml:"endPeriod,attr,omitempty"`
+	Status          string       `xml:"status,attr,omitempty"`
+	Partial         string       `xml:"partial,attr,omitempty"`
+	InEffect        string       `xml:"inEffect,attr,omitempty"`
+	AnyAttrs        []xml.Attr   `xml:",any,attr"`
+	Any             []AnyElement `xml:",any"`
+	XhtmlImg        []AnyElement `xml:"http://www.w3.org/1999/xhtml img"`
+	XhtmlTable      []AnyElement `xml:"http://www.w3.org/1999/xhtml table"`
+	MathmlMath      []AnyElement `xml:"http://www.w3.org/1998/Math/MathML math"`
+	Orientation     string       `xml:"orientation,attr,omitempty"`
+	Changed         string       `xml:"changed,attr,omitempty"`
+	Origin          string       `xml:"origin,attr,omitempty"`
+}
+
+// CoverTextType is generated from the CoverTextType complex type.
+//
+// A coverText is content on the cover page that is in addition to, or instead
+// of, the coverTitle.
+type CoverTextType struct {
+	XMLName            xml.Name
+	Text               string               `xml:",chardata"`
+	XMLBase            string               `xml:"http://www.w3.org/XML/1998/namespace base,attr,omitempty"`
+	XMLLang            string               `xml:"http://www.w3.org/XML/1998/namespace lang,attr,omitempty"`
+	XMLSpace           string               `xml:"http://www.w3.org/XML/1998/namespace space,attr,omitempty"`
+	ID                 string               `xml:"id,attr,omitempty"`
+	TemporalID         string               `xml:"temporalId,attr,omitempty"`
+	Identifier         string               `xml:"identifier,attr,omitempty"`
+	Scope              string               `xml:"scope,attr,omitempty"`
+	Role               string               `xml:"role,attr,omitempty"`
+	Class              string               `xml:"class,attr,omitempty"`
+	Style              string               `xml:"style,attr,omitempty"`
+	StyleType          string               `xml:"styleType,attr,omitempty"`
+	NoteAttr           string               `xml:"note,attr,omitempty"`
+	Alt                string               `xml:"alt,attr,omitempty"`
+	Meta               string               `xml:"meta,attr,omitempty"`
+	Misc               string               `xml:"misc,attr,omitempty"`
+	DraftingTip        string               `xml:"draftingTip,attr,omitempty"`
+	CodificationTip    string               `xml:"codificationTip,attr,omitempty"`
+	Title              string               `xml:"title,attr,omitempty"`
+	Brief              string               `xml:"brief,attr,omitempty"`
+	SortOrder          string               `xml:"sortOrder,attr,omitempty"`
+	StartPeriod        string               `xml:"startPeriod,attr,omitempty"`
+	EndPeriod          string               `xml:"endPeriod,attr,omitempty"`
+	Status             string               `xml:"status,attr,omitempty"`
+	Partial            string               `xml:"partial,attr,omitempty"`
+	InEffect           string               `xml:"inEffect,attr,omitempty"`
+	AnyAttrs           []xml.Attr           `xml:",any,attr"`
+	Inline             []InlineType         `xml:"inline"`
+	AmendingAction     []AmendingActionType `xml:"amendingAction"`
+	Ref                []RefType            `xml:"ref"`
+	Date               []DateType           `xml:"date"`
+	Center             []InlineType         `xml:"center"`
+	FillIn             []InlineType         `xml:"fillIn"`
+	CheckBox           []InlineType         `xml:"checkBox"`
+	B                  []InlineType         `xml:"b"`
+	I                  []InlineType         `xml:"i"`
+	Qualifier          []InlineType         `xml:"qualifier"`
+	Sub                []InlineType         `xml:"sub"`
+	Sup                []InlineType         `xml:"sup"`
+	HeadingText        []InlineType         `xml:"headingText"`
+	Span               []InlineType         `xml:"span"`
+	ShortTitle         []InlineType         `xml:"shortTitle"`
+	Term               []InlineType         `xml:"term"`
+	Entity             []InlineType         `xml:"entity"`
+	Footnote           []PositionedNoteType `xml:"footnote"`
+	Sidenote           []PositionedNoteType `xml:"sidenote"`
+	Endnote            []PositionedNoteType `xml:"endnote"`
+	Ear                []PositionedNoteType `xml:"ear"`
+	Marker             []MarkerType         `xml:"marker"`
+	Br                 []BrType             `xml:"br"`
+	Img                []ImgType            `xml:"img"`
+	Notes              []NotesType          `xml:"notes"`
+	LegislativeHistory []NotesType          `xml:"legislativeHistory"`
+	Note               []NoteType           `xml:"note"`
+	Elided             []ElidedType         `xml:"elided"`
+	SourceCredit       []NoteType           `xml:"sourceCredit"`
+	UscNote            []NoteType           `xml:"uscNote"`
+	StatutoryNote      []NoteType           `xml:"statutoryNote"`
+	DrafterNote        []NoteType           `xml:"drafterNote"`
+	EditorialNote      []NoteType           `xml:"editorialNote"`
+	ChangeNote         []NoteType           `xml:"changeNote"`
+	Authority          []NoteType           `xml:"authority"`
+	Source             []NoteType           `xml:"source"`
+	EffectiveDateNote  []NoteType           `xml:"effectiveDateNote"`
+	FrDocID            []NoteType           `xml:"frDocId"`
+	BillingCode        []NoteType           `xml:"billingCode"`
+	EditionNote        []NoteType           `xml:"editionNote"`
+	OrganizationNote   []NoteType           `xml:"organizationNote"`
+	CitationNote       []NoteType           `xml:"citationNote"`
+	ExplanationNote    []NoteType           `xml:"explanationNote"`
+	FindingAidsNote    []NoteType           `xml:"findingAidsNote"`
+	P                  []PType              `xml:"p"`
+	Page               []PageType           `xml:"page"`
+	Line               []PageType           `xml:"line"`
+	Block              []BlockType          `xml:"block"`
+	Signatures         []SignaturesType     `xml:"signatures"`
+	Fragment           []BlockType          `xml:"fragment"`
+}
+
+// DateType is generated from the DateType complex type.
+//
+// The date type is used to denote dates within the text of a document.
+type DateType struct {
+	XMLName            xml.Name
+	Text               string               `xml:",chardata"`
+	XMLBase            string               `xml:"http://www.w3.org/XML/1998/namespace base,attr,omitempty"`
+	XMLLang            string               `xml:"http://www.w3.org/XML/1998/namespace lang,attr,omitempty"`
+	XMLSpace           string               `xml:"http://www.w3.org/XML/1998/namespace space,attr,omitempty"`
+	ID                 string               `xml:"id,attr,omitempty"`
+	TemporalID         string               `xml:"temporalId,attr,omitempty"`
+	Identifier         string               `xml:"identifier,attr,omitempty"`
+	Scope              string               `xml:"scope,attr,omitempty"`
+	Role               string               `xml:"role,attr,omitempty"`
+	Class              string               `xml:"class,attr,omitempty"`
+	Style              string               `xml:"style,attr,omitempty"`
+	StyleType          string               `xml:"styleType,attr,omitempty"`
+	NoteAttr           string               `xml:"note,attr,omitempty"`
+	Alt                string               `xml:"alt,attr,omitempty"`
+	Meta               string               `xml:"meta,attr,omitempty"`
+	Misc               string               `xml:"misc,attr,omitempty"`
+	DraftingTip        string               `xml:"draftingTip,attr,omitempty"`
+	CodificationTip    string               `xml:"codificationTip,attr,omitempty"`
+	Title              string               `xml:"title,attr,omitempty"`
+	Brief              string               `xml:"brief,attr,omitempty"`
+	SortOrder          string               `xml:"sortOrder,attr,omitempty"`
+	StartPeriod        string               `xml:"startPeriod,attr,omitempty"`
+	EndPeriod          string               `xml:"endPeriod,attr,omitempty"`
+	Status             string               `xml:"status,attr,omitempty"`
+	Partial            string               `xml:"partial,attr,omitempty"`
+	InEffect           string               `xml:"inEffect,attr,omitempty"`
+	AnyAttrs           []xml.Attr           `xml:",any,attr"`
+	Marker             []MarkerType         `xml:"marker"`
+	Br                 []BrType             `xml:"br"`
+	Img                []ImgType            `xml:"img"`
+	Inline             []InlineType         `xml:"inline"`
+	AmendingAction     []AmendingActionType `xml:"amendingAction"`
+	Ref                []RefType            `xml:"ref"`
+	Date               []DateType           `xml:"date"`
+	Center             []InlineType         `xml:"center"`
+	FillIn             []InlineType         `xml:"fillIn"`
+	CheckBox           []InlineType         `xml:"checkBox"`
+	B                  []InlineType         `xml:"b"`
+	I                  []InlineType         `xml:"i"`
+	Qualifier          []InlineType         `xml:"qualifier"`
+	Sub                []InlineType         `xml:"sub"`
+	Sup                []InlineType         `xml:"sup"`
+	HeadingText        []InlineType         `xml:"headingText"`
+	Span               []InlineType         `xml:"span"`
+	ShortTitle         []InlineType         `xml:"shortTitle"`
+	Term               []InlineType         `xml:"term"`
+	Entity             []InlineType         `xml:"entity"`
+	Committee          []CommitteeElement   `xml:"committee"`
+	Organization       []InlinePropertyType `xml:"organization"`
+	Note               []NoteType           `xml:"note"`
+	Elided             []ElidedType         `xml:"elided"`
+	SourceCredit       []NoteType           `xml:"sourceCredit"`
+	UscNote            []NoteType           `xml:"uscNote"`
+	StatutoryNote      []NoteType           `xml:"statutoryNote"`
+	DrafterNote        []NoteType           `xml:"drafterNote"`
+	EditorialNote      []NoteType           `xml:"editorialNote"`
+	ChangeNote         []NoteType           `xml:"changeNote"`
+	Authority          []NoteType           `xml:"authority"`
+	Source             []NoteType           `xml:"source"`
+	EffectiveDateNote  []NoteType           `xml:"effectiveDateNote"`
+	FrDocID            []NoteType           `xml:"frDocId"`
+	BillingCode        []NoteType           `xml:"billingCode"`
+	EditionNote        []NoteType           `xml:"editionNote"`
+	OrganizationNote   []NoteType           `xml:"organizationNote"`
+	CitationNote       []NoteType           `xml:"citationNote"`
+	ExplanationNote    []NoteType           `xml:"explanationNote"`
+	FindingAidsNote    []NoteType           `xml:"findingAidsNote"`
+	Notes              []NotesType          `xml:"notes"`
+	LegislativeHistory []NotesType          `xml:"legislativeHistory"`
+	Footnote           []PositionedNoteType `xml:"footnote"`
+	Sidenote           []PositionedNoteType `xml:"sidenote"`
+	Endnote            []PositionedNoteType `xml:"endnote"`
+	Ear                []PositionedNoteType `xml:"ear"`
+	Page               []PageType           `xml:"page"`
+	Line               []PageType           `xml:"line"`
+	Proviso            []ProvisoType        `xml:"proviso"`
+	QuotedText         []QuotedTextType     `xml:"quotedText"`
+	AddedText          []QuotedTextType     `xml:"addedText"`
+	DeletedText        []QuotedTextType     `xml:"deletedText"`
+	Del                []QuotedTextType     `xml:"del"`
+	Ins                []QuotedTextType     `xml:"ins"`
+	DateAttr           string               `xml:"date,attr,omitempty"`
+	LegisDate          string               `xml:"legisDate,attr,omitempty"`
+	StartDate          string               `xml:"startDate,attr,omitempty"`
+	EndDate            string               `xml:"endDate,attr,omitempty"`
+}
+
+// DesignatorElement is generated from the anonymous type of the designator
+// element.
+//
+// A designator is a reference to a numbered item in a table of contents or
+// index.
+type DesignatorElement struct {
+	XMLName            xml.Name
+	Text               string               `xml:",chardata"`
+	XMLBase            string               `xml:"http://www.w3.org/XML/1998/namespace base,attr,omitempty"`
+	XMLLang            string               `xml:"http://www.w3.org/XML/1998/namespace lang,attr,omitempty"`
+	XMLSpace           string               `xml:"http://www.w3.org/XML/1998/namespace space,attr,omitempty"`
+	ID                 string               `xml:"id,attr,omitempty"`
+	TemporalID         string               `xml:"temporalId,attr,omitempty"`
+	Identifier         string               `xml:"identifier,attr,omitempty"`
+	Scope              string               `xml:"scope,attr,omitempty"`
+	Role               string               `xml:"role,attr,omitempty"`
+	Class              string               `xml:"class,attr,omitempty"`
+	Style              string               `xml:"style,attr,omitempty"`
+	StyleType          string               `xml:"styleType,attr,omitempty"`
+	NoteAttr           string               `xml:"note,attr,omitempty"`
+	Alt                string               `xml:"alt,attr,omitempty"`
+	Meta               string               `xml:"meta,attr,omitempty"`
+	Misc               string               `xml:"misc,attr,omitempty"`
+	DraftingTip        string               `xml:"draftingTip,attr,omitempty"`
+	CodificationTip    string               `xml:"codificationTip,attr,omitempty"`
+	Title              string               `xml:"title,attr,omitempty"`
+	Brief              string               `xml:"brief,attr,omitempty"`
+	SortOrder          string               `xml:"sortOrder,attr,omitempty"`
+	StartPeriod        string               `xml:"startPeriod,attr,omitempty"`
+	EndPeriod          string               `xml:"endPeriod,attr,omitempty"`
+	Status             string               `xml:"status,attr,omitempty"`
+	Partial            string               `xml:"partial,attr,omitempty"`
+	InEffect           string               `xml:"inEffect,attr,omitempty"`
+	AnyAttrs           []xml.Attr           `xml:",any,attr"`
+	Marker             []MarkerType         `xml:"marker"`
+	Br                 []BrType             `xml:"br"`
+	Img                []ImgType            `xml:"img"`
+	Inline             []InlineType         `xml:"inline"`
+	AmendingAction     []AmendingActionType `xml:"amendingAction"`
+	Ref                []RefType            `xml:"ref"`
+	Date               []DateType           `xml:"date"`
+	Center             []InlineType         `xml:"center"`
+	FillIn             []InlineType         `xml:"fillIn"`
+	CheckBox           []InlineType         `xml:"checkBox"`
+	B                  []InlineType         `xml:"b"`
+	I                  []InlineType         `xml:"i"`
+	Qualifier          []InlineType         `xml:"qualifier"`
+	Sub                []InlineType         `xml:"sub"`
+	Sup                []InlineType         `xml:"sup"`
+	HeadingText        []InlineType         `xml:"headingText"`
+	Span               []InlineType         `xml:"span"`
+	ShortTitle         []InlineType         `xml:"shortTitle"`
+	Term               []InlineType         `xml:"term"`
+	Entity             []InlineType         `xml:"entity"`
+	Committee          []CommitteeElement   `xml:"committee"`
+	Organization       []InlinePropertyType `xml:"organization"`
+	Note               []NoteType           `xml:"note"`
+	Elided             []ElidedType         `xml:"elided"`
+	SourceCredit       []NoteType           `xml:"sourceCredit"`
+	UscNote            []NoteType           `xml:"uscNote"`
+	StatutoryNote      []NoteType           `xml:"statutoryNote"`
+	DrafterNote        []NoteType           `xml:"drafterNote"`
+	EditorialNote      []NoteType           `xml:"editorialNote"`
+	ChangeNote         []NoteType           `xml:"changeNote"`
+	Authority          []NoteType           `xml:"authority"`
+	Source             []NoteType           `xml:"source"`
+	EffectiveDateNote  []NoteType           `xml:"effectiveDateNote"`
+	FrDocID            []NoteType           `xml:"frDocId"`
+	BillingCode        []NoteType           `xml:"billingCode"`
+	EditionNote        []NoteType           `xml:"editionNote"`
+	OrganizationNote   []NoteType           `xml:"organizationNote"`
+	CitationNote       []NoteType           `xml:"citationNote"`
+	ExplanationNote    []NoteType           `xml:"explanationNote"`
+	FindingAidsNote    []NoteType           `xml:"findingAidsNote"`
+	Notes              []NotesType          `xml:"notes"`
+	LegislativeHistory []NotesType          `xml:"legislativeHistory"`
+	Footnote           []PositionedNoteType `xml:"footnote"`
+	Sidenote           []PositionedNoteType `xml:"sidenote"`
+	Endnote            []PositionedNoteType `xml:"endnote"`
+	Ear                []PositionedNoteType `xml:"ear"`
+	Page               []PageType           `xml:"page"`
+	Line               []PageType           `xml:"line"`
+	Proviso            []ProvisoType        `xml:"proviso"`
+	QuotedText         []QuotedTextType     `xml:"quotedText"`
+	AddedText          []QuotedTextType     `xml:"addedText"`
+	DeletedText        []QuotedTextType     `xml:"deletedText"`
+	Del                []QuotedTextType     `xml:"del"`
+	Ins                []QuotedTextType     `xml:"ins"`
+	Pos                string               `xml:"pos,attr,omitempty"`
+	PosText            string               `xml:"posText,attr,omitempty"`
+	PosCount           string               `xml:"posCount,attr,omitempty"`
+	DateAttr           string               `xml:"date,attr,omitempty"`
+	LegisDate          string               `xml:"legisDate,attr,omitempty"`
+	StartDate          string               `xml:"startDate,attr,omitempty"`
+	EndDate            string               `xml:"endDate,attr,omitempty"`
+	Value              string               `xml:"value,attr,omitempty"`
+	StartValue         string               `xml:"startValue,attr,omitempty"`
+	EndValue           string               `xml:"endValue,attr,omitempty"`
+	Href               string               `xml:"href,attr,omitempty"`
+	Idref              string               `xml:"idref,attr,omitempty"`
+	Portion            string               `xml:"portion,attr,omitempty"`
+	Type               string               `xml:"type,attr,omitempty"`
+	LeaderChar         string               `xml:"leaderChar,attr,omitempty"`
+	LeaderAlign        string               `xml:"leaderAlign,attr,omitempty"`
+}
+
+// EditorialContentElement is generated from the anonymous type of the
+// editorialContent element.
+//
+// Documents may optionally have content defined in the preface that is
+// non-statutory content written by an editorial team, for example in a statute
+// compilation.
+type EditorialContentElement struct {
+	XMLName                     xml.Name
+	Text                        string         `xml:",chardata"`
+	Toc                         []ToCIndexType `xml:"toc"`
+	Index                       []ToCIndexType `xml:"index"`
+	TableOfTitlesAndChapters    []ToCIndexType `xml:"tableOfTitlesAndChapters"`
+	ListOfAgencies              []ToCIndexType `xml:"listOfAgencies"`
+	ListOfSectionsAffected      []ToCIndexType `xml:"listOfSectionsAffected"`
+	ListOfBillsEnacted          []ToCIndexType `xml:"listOfBillsEnacted"`
+	ListOfPublicLaws            []ToCIndexType `xml:"listOfPublicLaws"`
+	ListOfPrivateLaws           []ToCIndexType `xml:"listOfPrivateLaws"`
+	ListOfConcurrentResolutions []ToCIndexType `xml:"listOfConcurrentResolutions"`
+	ListOfProclamations         []ToCIndexType `xml:"listOfProclamations"`
+	PopularNameIndex            []ToCIndexType `xml:"popularNameIndex"`
+	SubjectIndex                []ToCIndexType `xml:"subjectIndex"`
+	Role                        string         `xml:"role,attr,omitempty"`
+	Class                       string         `xml:"class,attr,omitempty"`
+	Style                       string         `xml:"style,attr,omitempty"`
+	StyleType                   string         `xml:"styleType,attr,omitempty"`
+	Type                        string         `xml:"type,attr,omitempty"`
+}
+
+// ElidedType is generated from the ElidedType complex type.
+//
+// The content of the elided element is the text that is rendered in place of
+// the elided material.
+type ElidedType struct {
+	XMLName         xml.Name
+	Text            string     `xml:",chardata"`
+	XMLBase         string     `xml:"http://www.w3.org/XML/1998/namespace base,attr,omitempty"`
+	XMLLang         string     `xml:"http://www.w3.org/XML/1998/namespace lang,attr,omitempty"`
+	XMLSpace        string     `xml:"http://www.w3.org/XML/1998/namespace space,attr,omitempty"`
+	ID              string     `xml:"id,attr,omitempty"`
+	TemporalID      string     `xml:"temporalId,attr,omitempty"`
+	Identifier      string     `xml:"identifier,attr,omitempty"`
+	Scope           string     `xml:"scope,attr,omitempty"`
+	Role            string     `xml:"role,attr,omitempty"`
+	Class           string     `xml:"class,attr,omitempty"`
+	Style           string     `xml:"style,attr,omitempty"`
+	StyleType       string     `xml:"styleType,attr,omitempty"`
+	Note            string     `xml:"note,attr,omitempty"`
+	Alt             string     `xml:"alt,attr,omitempty"`
+	Meta            string     `xml:"meta,attr,omitempty"`
+	Misc            string     `xml:"misc,attr,omitempty"`
+	DraftingTip     string     `xml:"draftingTip,attr,omitempty"`
+	CodificationTip string     `xml:"codificationTip,attr,omitempty"`
+	Title           string     `xml:"title,attr,omitempty"`
+	Brief           string     `xml:"brief,attr,omitempty"`
+	SortOrder       string     `xml:"sortOrder,attr,omitempty"`
+	StartPeriod     string     `xml:"startPeriod,attr,omitempty"`
+	EndPeriod       string     `xml:"endPeriod,attr,omitempty"`
+	Status          string     `xml:"status,attr,omitempty"`
+	Partial         string     `xml:"partial,attr,omitempty"`
+	InEffect        string     `xml:"inEffect,attr,omitempty"`
+	AnyAttrs        []xml.Attr `xml:",any,attr"`
+	Orientation     string     `xml:"orientation,attr,omitempty"`
+	Type            string     `xml:"type,attr,omitempty"`
+	Topic           string     `xml:"topic,attr,omitempty"`
+	Date            string     `xml:"date,attr,omitempty"`
+	LegisDate       string     `xml:"legisDate,attr,omitempty"`
+	StartDate       string     `xml:"startDate,attr,omitempty"`
+	EndDate         string     `xml:"endDate,attr,omitempty"`
+	Href            string     `xml:"href,attr,omitempty"`
+	Idref           string     `xml:"idref,attr,omitempty"`
+	Portion         string     `xml:"portion,attr,omitempty"`
+	Display         string     `xml:"display,attr,omitempty"`
+}
+
+// EndorsementType is generated from the EndorsementType complex type.
+//
+// Defines a endorsement block for a measure.
+type EndorsementType struct {
+	XMLName                 xml.Name
+	XMLBase                 string                    `xml:"http://www.w3.org/XML/1998/namespace base,attr,omitempty"`
+	XMLLang                 string                    `xml:"http://www.w3.org/XML/1998/namespace lang,attr,omitempty"`
+	XMLSpace                string                    `xml:"http://www.w3.org/XML/1998/namespace space,attr,omitempty"`
+	ID                      string                    `xml:"id,attr,omitempty"`
+	TemporalID              string                    `xml:"temporalId,attr,omitempty"`
+	Identifier              string                    `xml:"identifier,attr,omitempty"`
+	Scope                   string                    `xml:"scope,attr,omitempty"`
+	Role                    string                    `xml:"role,attr,omitempty"`
+	Class                   string                    `xml:"class,attr,omitempty"`
+	Style                   string                    `xml:"style,attr,omitempty"`
+	StyleType               string                    `xml:"styleType,attr,omitempty"`
+	Note                    string                    `xml:"note,attr,omitempty"`
+	Alt                     string                    `xml:"alt,attr,omitempty"`
+	Meta                    string                    `xml:"meta,attr,omitempty"`
+	Misc                    string                    `xml:"misc,attr,omitempty"`
+	DraftingTip             string                    `xml:"draftingTip,attr,omitempty"`
+	CodificationTip         string                    `xml:"codificationTip,attr,omitempty"`
+	Title                   string                    `xml:"title,attr,omitempty"`
+	Brief                   string                    `xml:"brief,attr,omitempty"`
+	SortOrder               string                    `xml:"sortOrder,attr,omitempty"`
+	StartPeriod             string                    `xml:"startPeriod,attr,omitempty"`
+	EndPeriod               string                    `xml:"endPeriod,attr,omitempty"`
+	Status                  string                    `xml:"status,attr,omitempty"`
+	Partial                 string                    `xml:"partial,attr,omitempty"`
+	InEffect                string                    `xml:"inEffect,attr,omitempty"`
+	AnyAttrs                []xml.Attr                `xml:",any,attr"`
+	Any                     []AnyElement              `xml:",any"`
+	DocTitle                []StatementType           `xml:"docTitle"`
+	OfficialTitle           []StatementType           `xml:"officialTitle"`
+	FirstPageHeading        []FirstPageHeadingType    `xml:"firstPageHeading"`
+	FirstPageSubheading     []FirstPageHeadingType    `xml:"firstPageSubheading"`
+	Property                []PropertyType            `xml:"property"`
+	CoverTitle              []PropertyType            `xml:"coverTitle"`
+	AmendmentNumber         []AmendmentNumberElement  `xml:"amendmentNumber"`
+	Congress                []PropertyType            `xml:"congress"`
+	CurrentChamber          []PropertyType            `xml:"currentChamber"`
+	CurrentThroughPublicLaw []PropertyType            `xml:"currentThroughPublicLaw"`
+	DistributionCode        []PropertyType            `xml:"distributionCode"`
+	DocNumber               []PropertyType            `xml:"docNumber"`
+	DocPart                 []PropertyType            `xml:"docPart"`
+	DocPublicationName      []PropertyType            `xml:"docPublicationName"`
+	DocReleasePoint         []PropertyType            `xml:"docReleasePoint"`
+	DocStage                []PropertyType            `xml:"docStage"`
+	EnrolledDateline        []PropertyType            `xml:"enrolledDateline"`
+	Purpose                 []PropertyType            `xml:"purpose"`
+	RelatedDocument         []PropertyType            `xml:"relatedDocument"`
+	Session                 []PropertyType            `xml:"session"`
+	Subject                 []PropertyType            `xml:"subject"`
+	Volume                  []PropertyType            `xml:"volume"`
+	ProvisionRange          []PropertyType            `xml:"provisionRange"`
+	Action                  []ActionElement           `xml:"action"`
+	RelatedDocuments        []RelatedDocumentsElement `xml:"relatedDocuments"`
+	LongTitle               []LongTitleElement        `xml:"longTitle"`
+	Orientation             string                    `xml:"orientation,attr,omitempty"`
+}
+
+// FigureType is generated from the FigureType complex type.
+//
+// A figure is a unit of content, optionally with a caption, that is
+// self-contained, that is typically referenced as a single unit from the main
+// flow of the document, and that can be moved away from the main flow of the
+// document without affecting the document’s meaning.
+type FigureType struct {
+	XMLName    xml.Name
+	Img        []ImgType    `xml:"img"`
+	FigCaption []InlineType `xml:"figCaption"`
+	Page       []PageType   `xml:"page"`
+	Line       []PageType   `xml:"line"`
+	MathmlMath []AnyElement `xml:"http://www.w3.org/1998/Math/MathML math"`
+	XhtmlImg   []AnyElement `xml:"http://www.w3.org/1999/xhtml img"`
+	Role       string       `xml:"role,attr,omitempty"`
+	Class      string       `xml:"class,attr,omitempty"`
+	Style      string       `xml:"style,attr,omitempty"`
+	StyleType  string       `xml:"styleType,attr,omitempty"`
+}
+
+// FirstPageHeadingType is generated from the FirstPageHeadingType complex
+// type.
+//
+// The FirstPageHeadingType type is used to define the first page heading and
+// subheadings for amendment documents in the amendPreface element.
+type FirstPageHeadingType struct {
+	XMLName            xml.Name
+	Text               string               `xml:",chardata"`
+	Inline             []InlineType         `xml:"inline"`
+	AmendingAction     []AmendingActionType `xml:"amendingAction"`
+	Ref                []RefType            `xml:"ref"`
+	Date               []DateType           `xml:"date"`
+	Center             []InlineType         `xml:"center"`
+	FillIn             []InlineType         `xml:"fillIn"`
+	CheckBox           []InlineType         `xml:"checkBox"`
+	B                  []InlineType         `xml:"b"`
+	I                  []InlineType         `xml:"i"`
+	Qualifier          []InlineType         `xml:"qualifier"`
+	Sub                []InlineType         `xml:"sub"`
+	Sup                []InlineType         `xml:"sup"`
+	HeadingText        []InlineType         `xml:"headingText"`
+	Span               []InlineType         `xml:"span"`
+	ShortTitle         []InlineType         `xml:"shortTitle"`
+	Term               []InlineType         `xml:"term"`
+	Entity             []InlineType         `xml:"entity"`
+	Footnote           []PositionedNoteType `xml:"footnote"`
+	Sidenote           []PositionedNoteType `xml:"sidenote"`
+	Endnote            []PositionedNoteType `xml:"endnote"`
+	Ear                []PositionedNoteType `xml:"ear"`
+	Note               []NoteType           `xml:"note"`
+	Elided             []ElidedType         `xml:"elided"`
+	SourceCredit       []NoteType           `xml:"sourceCredit"`
+	UscNote            []NoteType           `xml:"uscNote"`
+	StatutoryNote      []NoteType           `xml:"statutoryNote"`
+	DrafterNote        []NoteType           `xml:"drafterNote"`
+	EditorialNote      []NoteType           `xml:"editorialNote"`
+	ChangeNote         []NoteType           `xml:"changeNote"`
+	Authority          []NoteType           `xml:"authority"`
+	Source             []NoteType           `xml:"source"`
+	EffectiveDateNote  []NoteType           `xml:"effectiveDateNote"`
+	FrDocID            []NoteType           `xml:"frDocId"`
+	BillingCode        []NoteType           `xml:"billingCode"`
+	EditionNote        []NoteType           `xml:"editionNote"`
+	OrganizationNote   []NoteType           `xml:"organizationNote"`
+	CitationNote       []NoteType           `xml:"citationNote"`
+	ExplanationNote    []NoteType           `xml:"explanationNote"`
+	FindingAidsNote    []NoteType           `xml:"findingAidsNote"`
+	Notes              []NotesType          `xml:"notes"`
+	LegislativeHistory []NotesType          `xml:"legislativeHistory"`
+	DocTitle           []StatementType      `xml:"docTitle"`
+	Role               string               `xml:"role,attr,omitempty"`
+	Class              string               `xml:"class,attr,omitempty"`
+	Style              string               `xml:"style,attr,omitempty"`
+	StyleType          string               `xml:"styleType,attr,omitempty"`
+	Display            string               `xml:"display,attr,omitempty"`
+}
+
+// ForeignElement is generated from the anonymous type of the foreign element.
+//
+// A foreign element is a wrapper for content that comes from an external
+// namespace.
+type ForeignElement struct {
+	XMLName         xml.Name
+	XMLBase         string       `xml:"http://www.w3.org/XML/1998/namespace base,attr,omitempty"`
+	XMLLang         string       `xml:"http://www.w3.org/XML/1998/namespace lang,attr,omitempty"`
+	XMLSpace        string       `xml:"http://www.w3.org/XML/1998/namespace space,attr,omitempty"`
+	ID              string       `xml:"id,attr,omitempty"`
+	TemporalID      string       `xml:"temporalId,attr,omitempty"`
+	Identifier      string       `xml:"identifier,attr,omitempty"`
+	Scope           string       `xml:"scope,attr,omitempty"`
+	Role            string       `xml:"role,attr,omitempty"`
+	Class           string       `xml:"class,attr,omitempty"`
+	Style           string       `xml:"style,attr,omitempty"`
+	StyleType       string       `xml:"styleType,attr,omitempty"`
+	Note            string       `xml:"note,attr,omitempty"`
+	Alt             string       `xml:"alt,attr,omitempty"`
+	Meta            string       `xml:"meta,attr,omitempty"`
+	Misc            string       `xml:"misc,attr,omitempty"`
+	DraftingTip     string       `xml:"draftingTip,attr,omitempty"`
+	CodificationTip string       `xml:"codificationTip,attr,omitempty"`
+	Title           string       `xml:"title,attr,omitempty"`
+	Brief           string       `xml:"brief,attr,omitempty"`
+	SortOrder       string       `xml:"sortOrder,attr,omitempty"`
+	StartPeriod     string       `xml:"startPeriod,attr,omitempty"`
+	EndPeriod       string       `xml:"endPeriod,attr,omitempty"`
+	Status          string       `xml:"status,attr,omitempty"`
+	Partial         string       `xml:"partial,attr,omitempty"`
+	InEffect        string       `xml:"inEffect,attr,omitempty"`
+	AnyAttrs        []xml.Attr   `xml:",any,attr"`
+	Any             []AnyElement `xml:",any"`
+}
+
+// GenericDocType is generated from the GenericDocType complex type.
+//
+// The generic doc type is the core type for generic loosely structured
+// documents.
+type GenericDocType struct {
+	XMLName         xml.Name
+	XMLBase         string             `xml:"http://www.w3.org/XML/1998/namespace base,attr,omitempty"`
+	XMLLang         string             `xml:"http://www.w3.org/XML/1998/namespace lang,attr,omitempty"`
+	XMLSpace        string             `xml:"http://www.w3.org/XML/1998/namespace space,attr,omitempty"`
+	ID              string             `xml:"id,attr,omitempty"`
+	TemporalID      string             `xml:"temporalId,attr,omitempty"`
+	Identifier      string             `xml:"identifier,attr,omitempty"`
+	Scope           string             `xml:"scope,attr,omitempty"`
+	Role            string             `xml:"role,attr,omitempty"`
+	Class           string             `xml:"class,attr,omitempty"`
+	Style           string             `xml:"style,attr,omitempty"`
+	StyleType       string             `xml:"styleType,attr,omitempty"`
+	Note            string             `xml:"note,attr,omitempty"`
+	Alt             string             `xml:"alt,attr,omitempty"`
+	MetaAttr        string             `xml:"meta,attr,omitempty"`
+	Misc            string             `xml:"misc,attr,omitempty"`
+	DraftingTip     string             `xml:"draftingTip,attr,omitempty"`
+	CodificationTip string             `xml:"codificationTip,attr,omitempty"`
+	Title           string             `xml:"title,attr,omitempty"`
+	Brief           string             `xml:"brief,attr,omitempty"`
+	SortOrder       string             `xml:"sortOrder,attr,omitempty"`
+	StartPeriod     string             `xml:"startPeriod,attr,omitempty"`
+	EndPeriod       string             `xml:"endPeriod,attr,omitempty"`
+	Status          string             `xml:"status,attr,omitempty"`
+	Partial         string             `xml:"partial,attr,omitempty"`
+	InEffect        string             `xml:"inEffect,attr,omitempty"`
+	AnyAttrs        []xml.Attr         `xml:",any,attr"`
+	Meta            *MetaType          `xml:"meta"`
+	Content         *ContentType       `xml:"content"`
+	QuotedContent   *QuotedContentType `xml:"quotedContent"`
+	Appendix        []AppendixType     `xml:"appendix"`
+	Schedule        []AppendixType     `xml:"schedule"`
+}
+
+// HeadingType is generated from the HeadingType complex type.
+//
+// The heading type is used to define heading and subheadings for levels and
+// other structured items.
+type HeadingType struct {
+	XMLName            xml.Name
+	Text               string               `xml:",chardata"`
+	Br                 []BrType             `xml:"br"`
+	Img                []ImgType            `xml:"img"`
+	Inline             []InlineType         `xml:"inline"`
+	AmendingAction     []AmendingActionType `xml:"amendingAction"`
+	Ref                []RefType            `xml:"ref"`
+	Date               []DateType           `xml:"date"`
+	Center             []InlineType         `xml:"center"`
+	FillIn             []InlineType         `xml:"fillIn"`
+	CheckBox           []InlineType         `xml:"checkBox"`
+	B                  []InlineType         `xml:"b"`
+	I                  []InlineType         `xml:"i"`
+	Qualifier          []InlineType         `xml:"qualifier"`
+	Sub                []InlineType         `xml:"sub"`
+	Sup                []InlineType         `xml:"sup"`
+	HeadingText        []InlineType         `xml:"headingText"`
+	Span               []InlineType         `xml:"span"`
+	ShortTitle         []InlineType         `xml:"shortTitle"`
+	Term               []InlineType         `xml:"term"`
+	Entity             []InlineType         `xml:"entity"`
+	Committee          []CommitteeElement   `xml:"committee"`
+	Organization       []InlinePropertyType `xml:"organization"`
+	Footnote           []PositionedNoteType `xml:"footnote"`
+	Sidenote           []PositionedNoteType `xml:"sidenote"`
+	Endnote            []PositionedNoteType `xml:"endnote"`
+	Ear                []PositionedNoteType `xml:"ear"`
+	Note               []NoteType           `xml:"note"`
+	Elided             []ElidedType         `xml:"elided"`
+	SourceCredit       []NoteType           `xml:"sourceCredit"`
+	UscNote            []NoteType           `xml:"uscNote"`
+	StatutoryNote      []NoteType           `xml:"statutoryNote"`
+	DrafterNote        []NoteType           `xml:"drafterNote"`
+	EditorialNote      []NoteType           `xml:"editorialNote"`
+	ChangeNote         []NoteType           `xml:"changeNote"`
+	Authority          []NoteType           `xml:"authority"`
+	Source             []NoteType           `xml:"source"`
+	EffectiveDateNote  []NoteType           `xml:"effectiveDateNote"`
+	FrDocID            []NoteType           `xml:"frDocId"`
+	BillingCode        []NoteType           `xml:"billingCode"`
+	EditionNote        []NoteType           `xml:"editionNote"`
+	OrganizationNote   []NoteType           `xml:"organizationNote"`
+	CitationNote       []NoteType           `xml:"citationNote"`
+	ExplanationNote    []NoteType           `xml:"explanationNote"`
+	FindingAidsNote    []NoteType           `xml:"findingAidsNote"`
+	Notes              []NotesType          `xml:"notes"`
+	LegislativeHistory []NotesType          `xml:"legislativeHistory"`
+	Page               []PageType           `xml:"page"`
+	Line               []PageType           `xml:"line"`
+	QuotedText         []QuotedTextType     `xml:"quotedText"`
+	AddedText          []QuotedTextType     `xml:"addedText"`
+	DeletedText        []QuotedTextType     `xml:"deletedText"`
+	Del                []QuotedTextType     `xml:"del"`
+	Ins                []QuotedTextType     `xml:"ins"`
+	XMLBase            string               `xml:"http://www.w3.org/XML/1998/namespace base,attr,omitempty"`
+	XMLLang            string               `xml:"http://www.w3.org/XML/1998/namespace lang,attr,omitempty"`
+	XMLSpace           string               `xml:"http://www.w3.org/XML/1998/namespace space,attr,omitempty"`
+	Role               string               `xml:"role,attr,omitempty"`
+	Class              string               `xml:"class,attr,omitempty"`
+	Style              string               `xml:"style,attr,omitempty"`
+	StyleType          string               `xml:"styleType,attr,omitempty"`
+	Display            string               `xml:"display,attr,omitempty"`
+	ID                 string               `xml:"id,attr,omitempty"`
+	TemporalID         string               `xml:"temporalId,attr,omitempty"`
+	Identifier         string               `xml:"identifier,attr,omitempty"`
+	Scope              string               `xml:"scope,attr,omitempty"`
+}
+
+// ImgType is generated from the ImgType complex type.
+//
+// An image type is a simple marker element denoting where a graphic image is
+// to be inserted.
+type ImgType struct {
+	XMLName         xml.Name
+	Text            string     `xml:",chardata"`
+	XMLBase         string     `xml:"http://www.w3.org/XML/1998/namespace base,attr,omitempty"`
+	XMLLang         string     `xml:"http://www.w3.org/XML/1998/namespace lang,attr,omitempty"`
+	XMLSpace        string     `xml:"http://www.w3.org/XML/1998/namespace space,attr,omitempty"`
+	ID              string     `xml:"id,attr,omitempty"`
+	TemporalID      string     `xml:"temporalId,attr,omitempty"`
+	Identifier      string     `xml:"identifier,attr,omitempty"`
+	Scope           string     `xml:"scope,attr,omitempty"`
+	Role            string     `xml:"role,attr,omitempty"`
+	Class           string     `xml:"class,attr,omitempty"`
+	Style           string     `xml:"style,attr,omitempty"`
+	StyleType       string     `xml:"styleType,attr,omitempty"`
+	Note            string     `xml:"note,attr,omitempty"`
+	Alt             string     `xml:"alt,attr,omitempty"`
+	Meta            string     `xml:"meta,attr,omitempty"`
+	Misc            string     `xml:"misc,attr,omitempty"`
+	DraftingTip     string     `xml:"draftingTip,attr,omitempty"`
+	CodificationTip string     `xml:"codificationTip,attr,omitempty"`
+	Title           string     `xml:"title,attr,omitempty"`
+	Brief           string     `xml:"brief,attr,omitempty"`
+	SortOrder       string     `xml:"sortOrder,attr,omitempty"`
+	StartPeriod     string     `xml:"startPeriod,attr,omitempty"`
+	EndPeriod       string     `xml:"endPeriod,attr,omitempty"`
+	Status          string     `xml:"status,attr,omitempty"`
+	Partial         string     `xml:"partial,attr,omitempty"`
+	InEffect        string     `xml:"inEffect,attr,omitempty"`
+	AnyAttrs        []xml.Attr `xml:",any,attr"`
+	Orientation     string     `xml:"orientation,attr,omitempty"`
+	Src             string     `xml:"src,attr,omitempty"`
+}
+
+// InlinePropertyType is generated from the InlinePropertyType complex type.
+//
+// The InlinePropertyType is designed for elements that can appear in the
+// inline or property substitution groups.
+type InlinePropertyType struct {
+	XMLName            xml.Name
+	Text               string               `xml:",chardata"`
+	XMLBase            string               `xml:"http://www.w3.org/XML/1998/namespace base,attr,omitempty"`
+	XMLLang            string               `xml:"http://www.w3.org/XML/1998/namespace lang,attr,omitempty"`
+	XMLSpace           string               `xml:"http://www.w3.org/XML/1998/namespace space,attr,omitempty"`
+	ID                 string               `xml:"id,attr,omitempty"`
+	TemporalID         string               `xml:"temporalId,attr,omitempty"`
+	Identifier         string               `xml:"identifier,attr,omitempty"`
+	Scope              string               `xml:"scope,attr,omitempty"`
+	Role               string               `xml:"role,attr,omitempty"`
+	Class              string               `xml:"class,attr,omitempty"`
+	Style              string               `xml:"style,attr,omitempty"`
+	StyleType          string               `xml:"styleType,attr,omitempty"`
+	NoteAttr           string               `xml:"note,attr,omitempty"`
+	Alt                string               `xml:"alt,attr,omitempty"`
+	Meta               string               `xml:"meta,attr,omitempty"`
+	Misc               string               `xml:"misc,attr,omitempty"`
+	DraftingTip        string               `xml:"draftingTip,attr,omitempty"`
+	CodificationTip    string               `xml:"codificationTip,attr,omitempty"`
+	Title              string               `xml:"title,attr,omitempty"`
+	Brief              string               `xml:"brief,attr,omitempty"`
+	SortOrder          string               `xml:"sortOrder,attr,omitempty"`
+	StartPeriod        string               `xml:"startPeriod,attr,omitempty"`
+	EndPeriod          string               `xml:"endPeriod,attr,omitempty"`
+	Status             string               `xml:"status,attr,omitempty"`
+	Partial            string               `xml:"partial,attr,omitempty"`
+	InEffect           string               `xml:"inEffect,attr,omitempty"`
+	AnyAttrs           []xml.Attr           `xml:",any,attr"`
+	Marker             []MarkerType         `xml:"marker"`
+	Br                 []BrType             `xml:"br"`
+	Img                []ImgType            `xml:"img"`
+	Inline             []InlineType         `xml:"inline"`
+	AmendingAction     []AmendingActionType `xml:"amendingAction"`
+	Ref                []RefType            `xml:"ref"`
+	Date               []DateType           `xml:"date"`
+	Center             []InlineType         `xml:"center"`
+	FillIn             []InlineType         `xml:"fillIn"`
+	CheckBox           []InlineType         `xml:"checkBox"`
+	B                  []InlineType         `xml:"b"`
+	I                  []InlineType         `xml:"i"`
+	Qualifier          []InlineType         `xml:"qualifier"`
+	Sub                []InlineType         `xml:"sub"`
+	Sup                []InlineType         `xml:"sup"`
+	HeadingText        []InlineType         `xml:"headingText"`
+	Span               []InlineType         `xml:"span"`
+	ShortTitle         []InlineType         `xml:"shortTitle"`
+	Term               []InlineType         `xml:"term"`
+	Entity             []InlineType         `xml:"entity"`
+	Footnote           []PositionedNoteType `xml:"footnote"`
+	Sidenote           []PositionedNoteType `xml:"sidenote"`
+	Endnote            []PositionedNoteType `xml:"endnote"`
+	Ear                []PositionedNoteType `xml:"ear"`
+	Note               []NoteType           `xml:"note"`
+	Elided             []ElidedType         `xml:"elided"`
+	SourceCredit       []NoteType           `xml:"sourceCredit"`
+	UscNote            []NoteType           `xml:"uscNote"`
+	StatutoryNote      []NoteType           `xml:"statutoryNote"`
+	DrafterNote        []NoteType           `xml:"drafterNote"`
+	EditorialNote      []NoteType           `xml:"editorialNote"`
+	ChangeNote         []NoteType           `xml:"changeNote"`
+	Authority          []NoteType           `xml:"authority"`
+	Source             []NoteType           `xml:"source"`
+	EffectiveDateNote  []NoteType           `xml:"effectiveDateNote"`
+	FrDocID            []NoteType           `xml:"frDocId"`
+	BillingCode        []NoteType           `xml:"billingCode"`
+	EditionNote        []NoteType           `xml:"editionNote"`
+	OrganizationNote   []NoteType           `xml:"organizationNote"`
+	CitationNote       []NoteType           `xml:"citationNote"`
+	ExplanationNote    []NoteType           `xml:"explanationNote"`
+	FindingAidsNote    []NoteType           `xml:"findingAidsNote"`
+	Notes              []NotesType          `xml:"notes"`
+	LegislativeHistory []NotesType          `xml:"legislativeHistory"`
+	Page               []PageType           `xml:"page"`
+	Line               []PageType           `xml:"line"`
+	Display            string               `xml:"display,attr,omitempty"`
+}
+
+// InlineType is generated from the InlineType complex type.
+//
+// The inline type is an extension of the base content type to allow textual
+// content or inline elements.
+type InlineType struct {
+	XMLName            xml.Name
+	Text               string               `xml:",chardata"`
+	XMLBase            string               `xml:"http://www.w3.org/XML/1998/namespace base,attr,omitempty"`
+	XMLLang            string               `xml:"http://www.w3.org/XML/1998/namespace lang,attr,omitempty"`
+	XMLSpace           string               `xml:"http://www.w3.org/XML/1998/namespace space,attr,omitempty"`
+	ID                 string               `xml:"id,attr,omitempty"`
+	TemporalID         string               `xml:"temporalId,attr,omitempty"`
+	Identifier         string               `xml:"identifier,attr,omitempty"`
+	Scope              string               `xml:"scope,attr,omitempty"`
+	Role               string               `xml:"role,attr,omitempty"`
+	Class              string               `xml:"class,attr,omitempty"`
+	Style              string               `xml:"style,attr,omitempty"`
+	StyleType          string               `xml:"styleType,attr,omitempty"`
+	NoteAttr           string               `xml:"note,attr,omitempty"`
+	Alt                string               `xml:"alt,attr,omitempty"`
+	Meta               string               `xml:"meta,attr,omitempty"`
+	Misc               string               `xml:"misc,attr,omitempty"`
+	DraftingTip        string               `xml:"draftingTip,attr,omitempty"`
+	CodificationTip    string               `xml:"codificationTip,attr,omitempty"`
+	Title              string               `xml:"title,attr,omitempty"`
+	Brief              string               `xml:"brief,attr,omitempty"`
+	SortOrder          string               `xml:"sortOrder,attr,omitempty"`
+	StartPeriod        string               `xml:"startPeriod,attr,omitempty"`
+	EndPeriod          string               `xml:"endPeriod,attr,omitempty"`
+	Status             string               `xml:"status,attr,omitempty"`
+	Partial            string               `xml:"partial,attr,omitempty"`
+	InEffect           string               `xml:"inEffect,attr,omitempty"`
+	AnyAttrs           []xml.Attr           `xml:",any,attr"`
+	Marker             []MarkerType         `xml:"marker"`
+	Br                 []BrType             `xml:"br"`
+	Img                []ImgType            `xml:"img"`
+	Inline             []InlineType         `xml:"inline"`
+	AmendingAction     []AmendingActionType `xml:"amendingAction"`
+	Ref                []RefType            `xml:"ref"`
+	Date               []DateType           `xml:"date"`
+	Center             []InlineType         `xml:"center"`
+	FillIn             []InlineType         `xml:"fillIn"`
+	CheckBox           []InlineType         `xml:"checkBox"`
+	B                  []InlineType         `xml:"b"`
+	I                  []InlineType         `xml:"i"`
+	Qualifier          []InlineType         `xml:"qualifier"`
+	Sub                []InlineType         `xml:"sub"`
+	Sup                []InlineType         `xml:"sup"`
+	HeadingText        []InlineType         `xml:"headingText"`
+	Span               []InlineType         `xml:"span"`
+	ShortTitle         []InlineType         `xml:"shortTitle"`
+	Term               []InlineType         `xml:"term"`
+	Entity             []InlineType         `xml:"entity"`
+	Committee          []CommitteeElement   `xml:"committee"`
+	Organization       []InlinePropertyType `xml:"organization"`
+	Note               []NoteType           `xml:"note"`
+	Elided             []ElidedType         `xml:"elided"`
+	SourceCredit       []NoteType           `xml:"sourceCredit"`
+	UscNote            []NoteType           `xml:"uscNote"`
+	StatutoryNote      []NoteType           `xml:"statutoryNote"`
+	DrafterNote        []NoteType           `xml:"drafterNote"`
+	EditorialNote      []NoteType           `xml:"editorialNote"`
+	ChangeNote         []NoteType           `xml:"changeNote"`
+	Authority          []NoteType           `xml:"authority"`
+	Source             []NoteType           `xml:"source"`
+	EffectiveDateNote  []NoteType           `xml:"effectiveDateNote"`
+	FrDocID            []NoteType           `xml:"frDocId"`
+	BillingCode        []NoteType           `xml:"billingCode"`
+	EditionNote        []NoteType           `xml:"editionNote"`
+	OrganizationNote   []NoteType           `xml:"organizationNote"`
+	CitationNote       []NoteType           `xml:"citationNote"`
+	ExplanationNote    []NoteType           `xml:"explanationNote"`
+	FindingAidsNote    []NoteType           `xml:"findingAidsNote"`
+	Notes              []NotesType          `xml:"notes"`
+	LegislativeHistory []NotesType          `xml:"legislativeHistory"`
+	Footnote           []PositionedNoteType `xml:"footnote"`
+	Sidenote           []PositionedNoteType `xml:"sidenote"`
+	Endnote            []PositionedNoteType `xml:"endnote"`
+	Ear                []PositionedNoteType `xml:"ear"`
+	Page               []PageType           `xml:"page"`
+	Line               []PageType           `xml:"line"`
+	Proviso            []ProvisoType        `xml:"proviso"`
+	QuotedText         []QuotedTextType     `xml:"quotedText"`
+	AddedText          []QuotedTextType     `xml:"addedText"`
+	DeletedText        []QuotedTextType     `xml:"deletedText"`
+	Del                []QuotedTextType     `xml:"del"`
+	Ins                []QuotedTextType     `xml:"ins"`
+}
+
+// ItemType is generated from the ItemType complex type.
+//
+// The item core type is an item in a table of contents or index.
+type ItemType struct {
+	XMLName         xml.Name
+	XMLBase         string               `xml:"http://www.w3.org/XML/1998/namespace base,attr,omitempty"`
+	XMLLang         string               `xml:"http://www.w3.org/XML/1998/namespace lang,attr,omitempty"`
+	XMLSpace        string               `xml:"http://www.w3.org/XML/1998/namespace space,attr,omitempty"`
+	ID              string               `xml:"id,attr,omitempty"`
+	TemporalID      string               `xml:"temporalId,attr,omitempty"`
+	Identifier      string               `xml:"identifier,attr,omitempty"`
+	Scope           string               `xml:"scope,attr,omitempty"`
+	Role            string               `xml:"role,attr,omitempty"`
+	Class           string               `xml:"class,attr,omitempty"`
+	Style           string               `xml:"style,attr,omitempty"`
+	StyleType       string               `xml:"styleType,attr,omitempty"`
+	Note            string               `xml:"note,attr,omitempty"`
+	Alt             string               `xml:"alt,attr,omitempty"`
+	Meta            string               `xml:"meta,attr,omitempty"`
+	Misc            string               `xml:"misc,attr,omitempty"`
+	DraftingTip     string               `xml:"draftingTip,attr,omitempty"`
+	CodificationTip string               `xml:"codificationTip,attr,omitempty"`
+	Title           string               `xml:"title,attr,omitempty"`
+	Brief           string               `xml:"brief,attr,omitempty"`
+	SortOrder       string               `xml:"sortOrder,attr,omitempty"`
+	StartPeriod     string               `xml:"startPeriod,attr,omitempty"`
+	EndPeriod       string               `xml:"endPeriod,attr,omitempty"`
+	Status          string               `xml:"status,attr,omitempty"`
+	Partial         string               `xml:"partial,attr,omitempty"`
+	InEffect        string               `xml:"inEffect,attr,omitempty"`
+	AnyAttrs        []xml.Attr           `xml:",any,attr"`
+	Designator      []DesignatorElement  `xml:"designator"`
+	Label           []LabelElement       `xml:"label"`
+	Target          []TargetElement      `xml:"target"`
+	Footnote        []PositionedNoteType `xml:"footnote"`
+	Sidenote        []PositionedNoteType `xml:"sidenote"`
+	Endnote         []PositionedNoteType `xml:"endnote"`
+	Ear             []PositionedNoteType `xml:"ear"`
+	Page            []PageType           `xml:"page"`
+	Line            []PageType           `xml:"line"`
+	ReferenceItem   []ItemType           `xml:"referenceItem"`
+	HeadingItem     []ItemType           `xml:"headingItem"`
+	GroupItem       []ItemType           `xml:"groupItem"`
+	Changed         string               `xml:"changed,attr,omitempty"`
+	Origin          string               `xml:"origin,attr,omitempty"`
+	Href            string               `xml:"href,attr,omitempty"`
+	Idref           string               `xml:"idref,attr,omitempty"`
+	Portion         string               `xml:"portion,attr,omitempty"`
+}
+
+// LabelElement is generated from the anonymous type of the label element.
+//
+// A label is a textual reference in a table of contents or index.
+type LabelElement struct {
+	XMLName            xml.Name
+	Text               string               `xml:",chardata"`
+	XMLBase            string               `xml:"http://www.w3.org/XML/1998/namespace base,attr,omitempty"`
+	XMLLang            string               `xml:"http://www.w3.org/XML/1998/namespace lang,attr,omitempty"`
+	XMLSpace           string               `xml:"http://www.w3.org/XML/1998/namespace space,attr,omitempty"`
+	ID                 string               `xml:"id,attr,omitempty"`
+	TemporalID         string               `xml:"temporalId,attr,omitempty"`
+	Identifier         string               `xml:"identifier,attr,omitempty"`
+	Scope              string               `xml:"scope,attr,omitempty"`
+	Role               string               `xml:"role,attr,omitempty"`
+	Class              string               `xml:"class,attr,omitempty"`
+	Style              string               `xml:"style,attr,omitempty"`
+	StyleType          string               `xml:"styleType,attr,omitempty"`
+	NoteAttr           string               `xml:"note,attr,omitempty"`
+	Alt                string               `xml:"alt,attr,omitempty"`
+	Meta               string               `xml:"meta,attr,omitempty"`
+	Misc               string               `xml:"misc,attr,omitempty"`
+	DraftingTip        string               `xml:"draftingTip,attr,omitempty"`
+	CodificationTip    string               `xml:"codificationTip,attr,omitempty"`
+	Title              string               `xml:"title,attr,omitempty"`
+	Brief              string               `xml:"brief,attr,omitempty"`
+	SortOrder          string               `xml:"sortOrder,attr,omitempty"`
+	StartPeriod        string               `xml:"startPeriod,attr,omitempty"`
+	EndPeriod          string               `xml:"endPeriod,attr,omitempty"`
+	Status             string               `xml:"status,attr,omitempty"`
+	Partial            string               `xml:"partial,attr,omitempty"`
+	InEffect           string               `xml:"inEffect,attr,omitempty"`
+	AnyAttrs           []xml.Attr           `xml:",any,attr"`
+	Marker             []MarkerType         `xml:"marker"`
+	Br                 []BrType             `xml:"br"`
+	Img                []ImgType            `xml:"img"`
+	Inline             []InlineType         `xml:"inline"`
+	AmendingAction     []AmendingActionType `xml:"amendingAction"`
+	Ref                []RefType            `xml:"ref"`
+	Date               []DateType           `xml:"date"`
+	Center             []InlineType         `xml:"center"`
+	FillIn             []InlineType         `xml:"fillIn"`
+	CheckBox           []InlineType         `xml:"checkBox"`
+	B                  []InlineType         `xml:"b"`
+	I                  []InlineType         `xml:"i"`
+	Qualifier          []InlineType         `xml:"qualifier"`
+	Sub                []InlineType         `xml:"sub"`
+	Sup                []InlineType         `xml:"sup"`
+	HeadingText        []InlineType         `xml:"headingText"`
+	Span               []InlineType         `xml:"span"`
+	ShortTitle         []InlineType         `xml:"shortTitle"`
+	Term               []InlineType         `xml:"term"`
+	Entity             []InlineType         `xml:"entity"`
+	Committee          []CommitteeElement   `xml:"committee"`
+	Organization       []InlinePropertyType `xml:"organization"`
+	Note               []NoteType           `xml:"note"`
+	Elided             []ElidedType         `xml:"elided"`
+	SourceCredit       []NoteType           `xml:"sourceCredit"`
+	UscNote            []NoteType           `xml:"uscNote"`
+	StatutoryNote      []NoteType           `xml:"statutoryNote"`
+	DrafterNote        []NoteType           `xml:"drafterNote"`
+	EditorialNote      []NoteType           `xml:"editorialNote"`
+	ChangeNote         []NoteType           `xml:"changeNote"`
+	Authority          []NoteType           `xml:"authority"`
+	Source             []NoteType           `xml:"source"`
+	EffectiveDateNote  []NoteType           `xml:"effectiveDateNote"`
+	FrDocID            []NoteType           `xml:"frDocId"`
+	BillingCode        []NoteType           `xml:"billingCode"`
+	EditionNote        []NoteType           `xml:"editionNote"`
+	OrganizationNote   []NoteType           `xml:"organizationNote"`
+	CitationNote       []NoteType           `xml:"citationNote"`
+	ExplanationNote    []NoteType           `xml:"explanationNote"`
+	FindingAidsNote    []NoteType           `xml:"findingAidsNote"`
+	Notes              []NotesType          `xml:"notes"`
+	LegislativeHistory []NotesType          `xml:"legislativeHistory"`
+	Footnote           []PositionedNoteType `xml:"footnote"`
+	Sidenote           []PositionedNoteType `xml:"sidenote"`
+	Endnote            []PositionedNoteType `xml:"endnote"`
+	Ear                []PositionedNoteType `xml:"ear"`
+	Page               []PageType           `xml:"page"`
+	Line               []PageType           `xml:"line"`
+	Proviso            []ProvisoType        `xml:"proviso"`
+	QuotedText         []QuotedTextType     `xml:"quotedText"`
+	AddedText          []QuotedTextType     `xml:"addedText"`
+	DeletedText        []QuotedTextType     `xml:"deletedText"`
+	Del                []QuotedTextType     `xml:"del"`
+	Ins                []QuotedTextType     `xml:"ins"`
+	Pos                string               `xml:"pos,attr,omitempty"`
+	PosText            string               `xml:"posText,attr,omitempty"`
+	PosCount           string               `xml:"posCount,attr,omitempty"`
+	DateAttr           string               `xml:"date,attr,omitempty"`
+	LegisDate          string               `xml:"legisDate,attr,omitempty"`
+	StartDate          string               `xml:"startDate,attr,omitempty"`
+	EndDate            string               `xml:"endDate,attr,omitempty"`
+	Value              string               `xml:"value,attr,omitempty"`
+	StartValue         string               `xml:"startValue,attr,omitempty"`
+	EndValue           string               `xml:"endValue,attr,omitempty"`
+	Href               string               `xml:"href,attr,omitempty"`
+	Idref              string               `xml:"idref,attr,omitempty"`
+	Portion            string               `xml:"portion,attr,omitempty"`
+	Type               string               `xml:"type,attr,omitempty"`
+	LeaderChar         string               `xml:"leaderChar,attr,omitempty"`
+	LeaderAlign        string               `xml:"leaderAlign,attr,omitempty"`
+}
+
+// LawDocType is generated from the LawDocType complex type.
+//
+// The law doc type is the core root element type for all documents that
+// contain law - either proposed or enacted.
+type LawDocType struct {
+	XMLName                xml.Name
+	XMLBase                string                         `xml:"http://www.w3.org/XML/1998/namespace base,attr,omitempty"`
+	XMLLang                string                         `xml:"http://www.w3.org/XML/1998/namespace lang,attr,omitempty"`
+	XMLSpace               string                         `xml:"http://www.w3.org/XML/1998/namespace space,attr,omitempty"`
+	ID                     string                         `xml:"id,attr,omitempty"`
+	TemporalID             string                         `xml:"temporalId,attr,omitempty"`
+	Identifier             string                         `xml:"identifier,attr,omitempty"`
+	Scope                  string                         `xml:"scope,attr,omitempty"`
+	Role                   string                         `xml:"role,attr,omitempty"`
+	Class                  string                         `xml:"class,attr,omitempty"`
+	Style                  string                         `xml:"style,attr,omitempty"`
+	StyleType              string                         `xml:"styleType,attr,omitempty"`
+	Note                   string                         `xml:"note,attr,omitempty"`
+	Alt                    string                         `xml:"alt,attr,omitempty"`
+	MetaAttr               string                         `xml:"meta,attr,omitempty"`
+	Misc                   string                         `xml:"misc,attr,omitempty"`
+	DraftingTip            string                         `xml:"draftingTip,attr,omitempty"`
+	CodificationTip        string                         `xml:"codificationTip,attr,omitempty"`
+	Title                  string                         `xml:"title,attr,omitempty"`
+	Brief                  string                         `xml:"brief,attr,omitempty"`
+	SortOrder              string                         `xml:"sortOrder,attr,omitempty"`
+	StartPeriod            string                         `xml:"startPeriod,attr,omitempty"`
+	EndPeriod              string                         `xml:"endPeriod,attr,omitempty"`
+	Status                 string                         `xml:"status,attr,omitempty"`
+	Partial                string                         `xml:"partial,attr,omitempty"`
+	InEffect               string                         `xml:"inEffect,attr,omitempty"`
+	AnyAttrs               []xml.Attr                     `xml:",any,attr"`
+	Meta                   *MetaType                      `xml:"meta"`
+	Page                   *PageType                      `xml:"page"`
+	Line                   *PageType                      `xml:"line"`
+	Preface                *PrefaceType                   `xml:"preface"`
+	Main                   *MainType                      `xml:"main"`
+	OfficialTitleAmendment *OfficialTitleAmendmentElement `xml:"officialTitleAmendment"`
+	Attestation            []AttestationType              `xml:"attestation"`
+	Block                  []BlockType                    `xml:"block"`
+	Signatures             []SignaturesType               `xml:"signatures"`
+	Fragment               []BlockType                    `xml:"fragment"`
+	Endorsement            []EndorsementType              `xml:"endorsement"`
+	Notes                  []NotesType                    `xml:"notes"`
+	LegislativeHistory     []NotesType                    `xml:"legislativeHistory"`
+	BackMatter             []BackMatterType               `xml:"backMatter"`
+	EndMarker              []string                       `xml:"endMarker"`
+	Appendix               []AppendixType                 `xml:"appendix"`
+	Schedule               []AppendixType                 `xml:"schedule"`
+	SchemaVersion          string                         `xml:"schemaVersion,attr,omitempty"`
+}
+
+// LayoutType is generated from the LayoutType complex type.
+//
+// A layout type is used to denote an area of text intended to be displayed in
+// a column-oriented layout similar to a table.
+type LayoutType struct {
+	XMLName            xml.Name
+	XMLBase            string           `xml:"http://www.w3.org/XML/1998/namespace base,attr,omitempty"`
+	XMLLang            string           `xml:"http://www.w3.org/XML/1998/namespace lang,attr,omitempty"`
+	XMLSpace           string           `xml:"http://www.w3.org/XML/1998/namespace space,attr,omitempty"`
+	ID                 string           `xml:"id,attr,omitempty"`
+	TemporalID         string           `xml:"temporalId,attr,omitempty"`
+	Identifier         string           `xml:"identifier,attr,omitempty"`
+	Scope              string           `xml:"scope,attr,omitempty"`
+	Role               string           `xml:"role,attr,omitempty"`
+	Class              string           `xml:"class,attr,omitempty"`
+	Style              string           `xml:"style,attr,omitempty"`
+	StyleType          string           `xml:"styleType,attr,omitempty"`
+	NoteAttr           string           `xml:"note,attr,omitempty"`
+	Alt                string           `xml:"alt,attr,omitempty"`
+	Meta               string           `xml:"meta,attr,omitempty"`
+	Misc               string           `xml:"misc,attr,omitempty"`
+	DraftingTip        string           `xml:"draftingTip,attr,omitempty"`
+	CodificationTip    string           `xml:"codificationTip,attr,omitempty"`
+	Title              string           `xml:"title,attr,omitempty"`
+	Brief              string           `xml:"brief,attr,omitempty"`
+	SortOrder          string           `xml:"sortOrder,attr,omitempty"`
+	StartPeriod        string           `xml:"startPeriod,attr,omitempty"`
+	EndPeriod          string           `xml:"endPeriod,attr,omitempty"`
+	Status             string           `xml:"status,attr,omitempty"`
+	Partial            string           `xml:"partial,attr,omitempty"`
+	InEffect           string           `xml:"inEffect,attr,omitempty"`
+	AnyAttrs           []xml.Attr       `xml:",any,attr"`
+	Header             []RowType        `xml:"header"`
+	Row                []RowType        `xml:"row"`
+	Block              []BlockType      `xml:"block"`
+	Signatures         []SignaturesType `xml:"signatures"`
+	Fragment           []BlockType      `xml:"fragment"`
+	Column             []ColumnType     `xml:"column"`
+	Page               []PageType       `xml:"page"`
+	Line               []PageType       `xml:"line"`
+	Note               []NoteType       `xml:"note"`
+	Elided             []ElidedType     `xml:"elided"`
+	SourceCredit       []NoteType       `xml:"sourceCredit"`
+	UscNote            []NoteType       `xml:"uscNote"`
+	StatutoryNote      []NoteType       `xml:"statutoryNote"`
+	DrafterNote        []NoteType       `xml:"drafterNote"`
+	EditorialNote      []NoteType       `xml:"editorialNote"`
+	ChangeNote         []NoteType       `xml:"changeNote"`
+	Authority          []NoteType       `xml:"authority"`
+	Source             []NoteType       `xml:"source"`
+	EffectiveDateNote  []NoteType       `xml:"effectiveDateNote"`
+	FrDocID            []NoteType       `xml:"frDocId"`
+	BillingCode        []NoteType       `xml:"billingCode"`
+	EditionNote        []NoteType       `xml:"editionNote"`
+	OrganizationNote   []NoteType       `xml:"organizationNote"`
+	CitationNote       []NoteType       `xml:"citationNote"`
+	ExplanationNote    []NoteType       `xml:"explanationNote"`
+	FindingAidsNote    []NoteType       `xml:"findingAidsNote"`
+	Notes              []NotesType      `xml:"notes"`
+	LegislativeHistory []NotesType      `xml:"legislativeHistory"`
+	CoverText          []CoverTextType  `xml:"coverText"`
+	Orientation        string           `xml:"orientation,attr,omitempty"`
+}
+
+// LevelType is generated from the LevelType complex type.
+//
+// The level core type is the basic element in the primary document hierarchy.
+type LevelType struct {
+	XMLName                     xml.Name
+	XMLBase                     string                `xml:"http://www.w3.org/XML/1998/namespace base,attr,omitempty"`
+	XMLLang                     string                `xml:"http://www.w3.org/XML/1998/namespace lang,attr,omitempty"`
+	XMLSpace                    string                `xml:"http://www.w3.org/XML/1998/namespace space,attr,omitempty"`
+	ID                          string                `xml:"id,attr,omitempty"`
+	TemporalID                  string                `xml:"temporalId,attr,omitempty"`
+	Identifier                  string                `xml:"identifier,attr,omitempty"`
+	Scope                       string                `xml:"scope,attr,omitempty"`
+	Role                        string                `xml:"role,attr,omitempty"`
+	Class                       string                `xml:"class,attr,omitempty"`
+	Style                       string                `xml:"style,attr,omitempty"`
+	StyleType                   string                `xml:"styleType,attr,omitempty"`
+	NoteAttr                    string                `xml:"note,attr,omitempty"`
+	Alt                         string                `xml:"alt,attr,omitempty"`
+	Meta                        string                `xml:"meta,attr,omitempty"`
+	Misc                        string                `xml:"misc,attr,omitempty"`
+	DraftingTip                 string                `xml:"draftingTip,attr,omitempty"`
+	CodificationTip             string                `xml:"codificationTip,attr,omitempty"`
+	TitleAttr                   string                `xml:"title,attr,omitempty"`
+	Brief                       string                `xml:"brief,attr,omitempty"`
+	SortOrder                   string                `xml:"sortOrder,attr,omitempty"`
+	StartPeriod                 string                `xml:"startPeriod,attr,omitempty"`
+	EndPeriod                   string                `xml:"endPeriod,attr,omitempty"`
+	Status                      string                `xml:"status,attr,omitempty"`
+	Partial                     string                `xml:"partial,attr,omitempty"`
+	InEffect                    string                `xml:"inEffect,attr,omitempty"`
+	AnyAttrs                    []xml.Attr            `xml:",any,attr"`
+	ReferenceMarker             []NumType             `xml:"referenceMarker"`
+	Num                         []NumType             `xml:"num"`
+	Footnote                    []PositionedNoteType  `xml:"footnote"`
+	Sidenote                    []PositionedNoteType  `xml:"sidenote"`
+	Endnote                     []PositionedNoteType  `xml:"endnote"`
+	Ear                         []PositionedNoteType  `xml:"ear"`
+	Note                        []NoteType            `xml:"note"`
+	Elided                      []ElidedType          `xml:"elided"`
+	SourceCredit                []NoteType            `xml:"sourceCredit"`
+	UscNote                     []NoteType            `xml:"uscNote"`
+	StatutoryNote               []NoteType            `xml:"statutoryNote"`
+	DrafterNote                 []NoteType            `xml:"drafterNote"`
+	EditorialNote               []NoteType            `xml:"editorialNote"`
+	ChangeNote                  []NoteType            `xml:"changeNote"`
+	Authority                   []NoteType            `xml:"authority"`
+	Source                      []NoteType            `xml:"source"`
+	EffectiveDateNote           []NoteType            `xml:"effectiveDateNote"`
+	FrDocID                     []NoteType            `xml:"frDocId"`
+	BillingCode                 []NoteType            `xml:"billingCode"`
+	EditionNote                 []NoteType            `xml:"editionNote"`
+	OrganizationNote            []NoteType            `xml:"organizationNote"`
+	CitationNote                []NoteType            `xml:"citationNote"`
+	ExplanationNote             []NoteType            `xml:"explanationNote"`
+	FindingAidsNote             []NoteType            `xml:"findingAidsNote"`
+	Notes                       []NotesType           `xml:"notes"`
+	LegislativeHistory          []NotesType           `xml:"legislativeHistory"`
+	Heading                     []HeadingType         `xml:"heading"`
+	Subheading                  []HeadingType         `xml:"subheading"`
+	Page                        []PageType            `xml:"page"`
+	Line                        []PageType            `xml:"line"`
+	Marker                      *MarkerType           `xml:"marker"`
+	Br                          *BrType               `xml:"br"`
+	Img                         *ImgType              `xml:"img"`
+	Toc                         []ToCIndexType        `xml:"toc"`
+	Index                       []ToCIndexType        `xml:"index"`
+	TableOfTitlesAndChapters    []ToCIndexType        `xml:"tableOfTitlesAndChapters"`
+	ListOfAgencies              []ToCIndexType        `xml:"listOfAgencies"`
+	ListOfSectionsAffected      []ToCIndexType        `xml:"listOfSectionsAffected"`
+	ListOfBillsEnacted          []ToCIndexType        `xml:"listOfBillsEnacted"`
+	ListOfPublicLaws            []ToCIndexType        `xml:"listOfPublicLaws"`
+	ListOfPrivateLaws           []ToCIndexType        `xml:"listOfPrivateLaws"`
+	ListOfConcurrentResolutions []ToCIndexType        `xml:"listOfConcurrentResolutions"`
+	ListOfProclamations         []ToCIndexType        `xml:"listOfProclamations"`
+	PopularNameIndex            []ToCIndexType        `xml:"popularNameIndex"`
+	SubjectIndex                []ToCIndexType        `xml:"subjectIndex"`
+	Statement                   *StatementType        `xml:"statement"`
+	LongTitle                   *LongTitleElement     `xml:"longTitle"`
+	DocTitle                    *StatementType        `xml:"docTitle"`
+	OfficialTitle               *StatementType        `xml:"officialTitle"`
+	Foreign                     []ForeignElement      `xml:"foreign"`
+	Content                     []ContentType         `xml:"content"`
+	QuotedContent               []QuotedContentType   `xml:"quotedContent"`
+	P                           []PType               `xml:"p"`
+	Text                        []ContentType         `xml:"text"`
+	Chapeau                     []ContentType         `xml:"chapeau"`
+	Continuation                []ContinuationElement `xml:"continuation"`
+	Proviso                     []ProvisoType         `xml:"proviso"`
+	Level                       []LevelType           `xml:"level"`
+	Preliminary                 []LevelType           `xml:"preliminary"`
+	Title                       []LevelType           `xml:"title"`
+	Subtitle                    []LevelType           `xml:"subtitle"`
+	Part                        []LevelType           `xml:"part"`
+	Subpart                     []LevelType           `xml:"subpart"`
+	Division                    []LevelType           `xml:"division"`
+	Subdivision                 []LevelType           `xml:"subdivision"`
+	Chapter                     []LevelType           `xml:"chapter"`
+	Subchapter                  []LevelType           `xml:"subchapter"`
+	Article                     []LevelType           `xml:"article"`
+	Subarticle                  []LevelType           `xml:"subarticle"`
+	CompiledAct                 []LevelType           `xml:"compiledAct"`
+	CourtRules                  []LevelType           `xml:"courtRules"`
+	CourtRule                   []LevelType           `xml:"courtRule"`
+	ReorganizationPlans         []LevelType           `xml:"reorganizationPlans"`
+	ReorganizationPlan          []LevelType           `xml:"reorganizationPlan"`
+	Section                     []LevelType           `xml:"section"`
+	Subsection                  []LevelType           `xml:"subsection"`
+	Paragraph                   []LevelType           `xml:"paragraph"`
+	Subparagraph                []LevelType           `xml:"subparagraph"`
+	Clause                      []LevelType           `xml:"clause"`
+	Subclause                   []LevelType           `xml:"subclause"`
+	Item                        []LevelType           `xml:"item"`
+	Subitem                     []LevelType           `xml:"subitem"`
+	Subsubitem                  []LevelType           `xml:"subsubitem"`
+	CrossHeading                []HeadingType         `xml:"crossHeading"`
+	LeftRunningHead             []RunningHeadType     `xml:"leftRunningHead"`
+	CenterRunningHead           []RunningHeadType     `xml:"centerRunningHead"`
+	RightRunningHead            []RunningHeadType     `xml:"rightRunningHead"`
+	SlugLine                    []RunningHeadType     `xml:"slugLine"`
+	Appropriations              []AppropriationsType  `xml:"appropriations"`
+	Signature                   []SignatureType       `xml:"signature"`
+	Appendix                    []AppendixType        `xml:"appendix"`
+	Schedule                    []AppendixType        `xml:"schedule"`
+	Changed                     string                `xml:"changed,attr,omitempty"`
+	Origin                      string                `xml:"origin,attr,omitempty"`
+}
+
+// ListItemType is generated from the ListItemType complex type.
+//
+// ListItemType supports simple one-level lists, with or without numbering.
+type ListItemType struct {
+	XMLName         xml.Name
+	Text            string      `xml:",chardata"`
+	XMLBase         string      `xml:"http://www.w3.org/XML/1998/namespace base,attr,omitempty"`
+	XMLLang         string      `xml:"http://www.w3.org/XML/1998/namespace lang,attr,omitempty"`
+	XMLSpace        string      `xml:"http://www.w3.org/XML/1998/namespace space,attr,omitempty"`
+	ID              string      `xml:"id,attr,omitempty"`
+	TemporalID      string      `xml:"temporalId,attr,omitempty"`
+	Identifier      string      `xml:"identifier,attr,omitempty"`
+	Scope           string      `xml:"scope,attr,omitempty"`
+	Role            string      `xml:"role,attr,omitempty"`
+	Class           string      `xml:"class,attr,omitempty"`
+	Style           string      `xml:"style,attr,omitempty"`
+	StyleType       string      `xml:"styleType,attr,omitempty"`
+	Note            string      `xml:"note,attr,omitempty"`
+	Alt             string      `xml:"alt,attr,omitempty"`
+	Meta            string      `xml:"meta,attr,omitempty"`
+	Misc            string      `xml:"misc,attr,omitempty"`
+	DraftingTip     string      `xml:"draftingTip,attr,omitempty"`
+	CodificationTip string      `xml:"codificationTip,attr,omitempty"`
+	Title           string      `xml:"title,attr,omitempty"`
+	Brief           string      `xml:"brief,attr,omitempty"`
+	SortOrder       string      `xml:"sortOrder,attr,omitempty"`
+	StartPeriod     string      `xml:"startPeriod,attr,omitempty"`
+	EndPeriod       string      `xml:"endPeriod,attr,omitempty"`
+	Status          string      `xml:"status,attr,omitempty"`
+	Partial         string      `xml:"partial,attr,omitempty"`
+	InEffect        string      `xml:"inEffect,attr,omitempty"`
+	AnyAttrs        []xml.Attr  `xml:",any,attr"`
+	ReferenceMarker *NumType    `xml:"referenceMarker"`
+	Num             *NumType    `xml:"num"`
+	ListContent     *InlineType `xml:"listContent"`
+	List            *ListType   `xml:"list"`
+}
+
+// ListType is generated from the ListType complex type.
+//
+// ListType is for simple lists.
+type ListType struct {
+	XMLName         xml.Name
+	Text            string         `xml:",chardata"`
+	XMLBase         string         `xml:"http://www.w3.org/XML/1998/namespace base,attr,omitempty"`
+	XMLLang         string         `xml:"http://www.w3.org/XML/1998/namespace lang,attr,omitempty"`
+	XMLSpace        string         `xml:"http://www.w3.org/XML/1998/namespace space,attr,omitempty"`
+	ID              string         `xml:"id,attr,omitempty"`
+	TemporalID      string         `xml:"temporalId,attr,omitempty"`
+	Identifier      string         `xml:"identifier,attr,omitempty"`
+	Scope           string         `xml:"scope,attr,omitempty"`
+	Role            string         `xml:"role,attr,omitempty"`
+	Class           string         `xml:"class,attr,omitempty"`
+	Style           string         `xml:"style,attr,omitempty"`
+	StyleType       string         `xml:"styleType,attr,omitempty"`
+	Note            string         `xml:"note,attr,omitempty"`
+	Alt             string         `xml:"alt,attr,omitempty"`
+	Meta            string         `xml:"meta,attr,omitempty"`
+	Misc            string         `xml:"misc,attr,omitempty"`
+	DraftingTip     string         `xml:"draftingTip,attr,omitempty"`
+	CodificationTip string         `xml:"codificationTip,attr,omitempty"`
+	Title           string         `xml:"title,attr,omitempty"`
+	Brief           string         `xml:"brief,attr,omitempty"`
+	SortOrder       string         `xml:"sortOrder,attr,omitempty"`
+	StartPeriod     string         `xml:"startPeriod,attr,omitempty"`
+	EndPeriod       string         `xml:"endPeriod,attr,omitempty"`
+	Status          string         `xml:"status,attr,omitempty"`
+	Partial         string         `xml:"partial,attr,omitempty"`
+	InEffect        string         `xml:"inEffect,attr,omitempty"`
+	AnyAttrs        []xml.Attr     `xml:",any,attr"`
+	ListHeading     *HeadingType   `xml:"listHeading"`
+	ListItem        []ListItemType `xml:"listItem"`
+	Changed         string         `xml:"changed,attr,omitempty"`
+	Origin          string         `xml:"origin,attr,omitempty"`
+}
+
+// LongTitleElement is generated from the anonymous type of the longTitle
+// element.
+//
+// A longTitle is a statement that sets out the purposes of the bill in general
+// terms.
+type LongTitleElement struct {
+	XMLName           xml.Name
+	Text              string               `xml:",chardata"`
+	Statement         []StatementType      `xml:"statement"`
+	LongTitle         []LongTitleElement   `xml:"longTitle"`
+	DocTitle          []StatementType      `xml:"docTitle"`
+	OfficialTitle     []StatementType      `xml:"officialTitle"`
+	Marker            []MarkerType         `xml:"marker"`
+	Br                []BrType             `xml:"br"`
+	Img               []ImgType            `xml:"img"`
+	Inline            []InlineType         `xml:"inline"`
+	AmendingAction    []AmendingActionType `xml:"amendingAction"`
+	Ref               []RefType            `xml:"ref"`
+	Date              []DateType           `xml:"date"`
+	Center            []InlineType         `xml:"center"`
+	FillIn            []InlineType         `xml:"fillIn"`
+	CheckBox          []InlineType         `xml:"checkBox"`
+	B                 []InlineType         `xml:"b"`
+	I                 []InlineType         `xml:"i"`
+	Qualifier         []InlineType         `xml:"qualifier"`
+	Sub               []InlineType         `xml:"sub"`
+	Sup               []InlineType         `xml:"sup"`
+	HeadingText       []InlineType         `xml:"headingText"`
+	Span              []InlineType         `xml:"span"`
+	ShortTitle        []InlineType         `xml:"shortTitle"`
+	Term              []InlineType         `xml:"term"`
+	Entity            []InlineType         `xml:"entity"`
+	Footnote          []PositionedNoteType `xml:"footnote"`
+	Sidenote          []PositionedNoteType `xml:"sidenote"`
+	Endnote           []PositionedNoteType `xml:"endnote"`
+	Ear               []PositionedNoteType `xml:"ear"`
+	Note              []NoteType           `xml:"note"`
+	Elided            []ElidedType         `xml:"elided"`
+	SourceCredit      []NoteType           `xml:"sourceCredit"`
+	UscNote           []NoteType           `xml:"uscNote"`
+	StatutoryNote     []NoteType           `xml:"statutoryNote"`
+	DrafterNote       []NoteType           `xml:"drafterNote"`
+	EditorialNote     []NoteType           `xml:"editorialNote"`
+	ChangeNote        []NoteType           `xml:"changeNote"`
+	Authority         []NoteType           `xml:"authority"`
+	Source            []NoteType           `xml:"source"`
+	EffectiveDateNote []NoteType           `xml:"effectiveDateNote"`
+	FrDocID           []NoteType           `xml:"frDocId"`
+	BillingCode       []NoteType           `xml:"billingCode"`
+	EditionNote       []NoteType           `xml:"editionNote"`
+	OrganizationNote  []NoteType           `xml:"organizationNote"`
+	CitationNote      []NoteType           `xml:"citationNote"`
+	ExplanationNote   []NoteType           `xml:"explanationNote"`
+	FindingAidsNote   []NoteType           `xml:"findingAidsNote"`
+	P                 []PType              `xml:"p"`
+	Page              []PageType           `xml:"page"`
+	Line              []PageType           `xml:"line"`
+	Role              string               `xml:"role,attr,omitempty"`
+	Class             string               `xml:"class,attr,omitempty"`
+	Style             string               `xml:"style,attr,omitempty"`
+	StyleType         string               `xml:"styleType,attr,omitempty"`
+	Display           string               `xml:"display,attr,omitempty"`
+}
+
+// MainType is generated from the MainType complex type.
+//
+// The main core type is the container for the main part of the document.
+type MainType struct {
+	XMLName                     xml.Name
+	XMLBase                     string                   `xml:"http://www.w3.org/XML/1998/namespace base,attr,omitempty"`
+	XMLLang                     string                   `xml:"http://www.w3.org/XML/1998/namespace lang,attr,omitempty"`
+	XMLSpace                    string                   `xml:"http://www.w3.org/XML/1998/namespace space,attr,omitempty"`
+	ID                          string                   `xml:"id,attr,omitempty"`
+	TemporalID                  string                   `xml:"temporalId,attr,omitempty"`
+	Identifier                  string                   `xml:"identifier,attr,omitempty"`
+	Scope                       string                   `xml:"scope,attr,omitempty"`
+	Role                        string                   `xml:"role,attr,omitempty"`
+	Class                       string                   `xml:"class,attr,omitempty"`
+	Style                       string                   `xml:"style,attr,omitempty"`
+	StyleType                   string                   `xml:"styleType,attr,omitempty"`
+	NoteAttr                    string                   `xml:"note,attr,omitempty"`
+	Alt                         string                   `xml:"alt,attr,omitempty"`
+	Meta                        string                   `xml:"meta,attr,omitempty"`
+	Misc                        string                   `xml:"misc,attr,omitempty"`
+	DraftingTip                 string                   `xml:"draftingTip,attr,omitempty"`
+	CodificationTip             string                   `xml:"codificationTip,attr,omitempty"`
+	TitleAttr                   string                   `xml:"title,attr,omitempty"`
+	Brief                       string                   `xml:"brief,attr,omitempty"`
+	SortOrder                   string                   `xml:"sortOrder,attr,omitempty"`
+	StartPeriod                 string                   `xml:"startPeriod,attr,omitempty"`
+	EndPeriod                   string                   `xml:"endPeriod,attr,omitempty"`
+	Status                      string                   `xml:"status,attr,omitempty"`
+	Partial                     string                   `xml:"partial,attr,omitempty"`
+	InEffect                    string                   `xml:"inEffect,attr,omitempty"`
+	AnyAttrs                    []xml.Attr               `xml:",any,attr"`
+	Changed                     string                   `xml:"changed,attr,omitempty"`
+	Orientation                 string                   `xml:"orientation,attr,omitempty"`
+	Origin                      string                   `xml:"origin,attr,omitempty"`
+	Property                    []PropertyType           `xml:"property"`
+	CoverTitle                  []PropertyType           `xml:"coverTitle"`
+	AmendmentNumber             []AmendmentNumberElement `xml:"amendmentNumber"`
+	Congress                    []PropertyType           `xml:"congress"`
+	CurrentChamber              []PropertyType           `xml:"currentChamber"`
+	CurrentThroughPublicLaw     []PropertyType           `xml:"currentThroughPublicLaw"`
+	DistributionCode            []PropertyType           `xml:"distributionCode"`
+	DocNumber                   []PropertyType           `xml:"docNumber"`
+	DocPart                     []PropertyType           `xml:"docPart"`
+	DocPublicationName          []PropertyType           `xml:"docPublicationName"`
+	DocReleasePoint             []PropertyType           `xml:"docReleasePoint"`
+	DocStage                    []PropertyType           `xml:"docStage"`
+	EnrolledDateline            []PropertyType           `xml:"enrolledDateline"`
+	Purpose                     []PropertyType           `xml:"purpose"`
+	RelatedDocument             []PropertyType           `xml:"relatedDocument"`
+	Session                     []PropertyType           `xml:"session"`
+	Subject                     []PropertyType           `xml:"subject"`
+	Volume                      []PropertyType           `xml:"volume"`
+	ProvisionRange              []PropertyType           `xml:"provisionRange"`
+	Block                       []BlockType              `xml:"block"`
+	Signatures                  []SignaturesType         `xml:"signatures"`
+	Fragment                    []BlockType              `xml:"fragment"`
+	Statement                   []StatementType          `xml:"statement"`
+	LongTitle                   []LongTitleElement       `xml:"longTitle"`
+	DocTitle                    []StatementType          `xml:"docTitle"`
+	OfficialTitle               []StatementType          `xml:"officialTitle"`
+	Toc                         []ToCIndexType           `xml:"toc"`
+	Index                       []ToCIndexType           `xml:"index"`
+	TableOfTitlesAndChapters    []ToCIndexType           `xml:"tableOfTitlesAndChapters"`
+	ListOfAgencies              []ToCIndexType           `xml:"listOfAgencies"`
+	ListOfSectionsAffected      []ToCIndexType           `xml:"listOfSectionsAffected"`
+	ListOfBillsEnacted          []ToCIndexType           `xml:"listOfBillsEnacted"`
+	ListOfPublicLaws            []ToCIndexType           `xml:"listOfPublicLaws"`
+	ListOfPrivateLaws           []ToCIndexType           `xml:"listOfPrivateLaws"`
+	ListOfConcurrentResolutions []ToCIndexType           `xml:"listOfConcurrentResolutions"`
+	ListOfProclamations         []ToCIndexType           `xml:"listOfProclamations"`
+	PopularNameIndex            []ToCIndexType           `xml:"popularNameIndex"`
+	SubjectIndex                []ToCIndexType           `xml:"subjectIndex"`
+	Footnote                    []PositionedNoteType     `xml:"footnote"`
+	Sidenote                    []PositionedNoteType     `xml:"sidenote"`
+	Endnote                     []PositionedNoteType     `xml:"endnote"`
+	Ear                         []PositionedNoteType     `xml:"ear"`
+	Note                        []NoteType               `xml:"note"`
+	Elided                      []ElidedType             `xml:"elided"`
+	SourceCredit                []NoteType               `xml:"sourceCredit"`
+	UscNote                     []NoteType               `xml:"uscNote"`
+	StatutoryNote               []NoteType               `xml:"statutoryNote"`
+	DrafterNote                 []NoteType               `xml:"drafterNote"`
+	EditorialNote               []NoteType               `xml:"editorialNote"`
+	ChangeNote                  []NoteType               `xml:"changeNote"`
+	Authority                   []NoteType               `xml:"authority"`
+	Source                      []NoteType               `xml:"source"`
+	EffectiveDateNote           []NoteType               `xml:"effectiveDateNote"`
+	FrDocID                     []NoteType               `xml:"frDocId"`
+	BillingCode                 []NoteType               `xml:"billingCode"`
+	EditionNote                 []NoteType               `xml:"editionNote"`
+	OrganizationNote            []NoteType               `xml:"organizationNote"`
+	CitationNote                []NoteType               `xml:"citationNote"`
+	ExplanationNote             []NoteType               `xml:"explanationNote"`
+	FindingAidsNote             []NoteType               `xml:"findingAidsNote"`
+	Notes                       []NotesType              `xml:"notes"`
+	LegislativeHistory          []NotesType              `xml:"legislativeHistory"`
+	Page                        []PageType               `xml:"page"`
+	Line                        []PageType               `xml:"line"`
+	Preamble                    []PreambleType           `xml:"preamble"`
+	RulePreamble                []RulePreambleType       `xml:"rulePreamble"`
+	EnactingFormula             []StatementType          `xml:"enactingFormula"`
+	ResolvingClause             []StatementType          `xml:"resolvingClause"`
+	Content                     []ContentType            `xml:"content"`
+	QuotedContent               []QuotedContentType      `xml:"quotedContent"`
+	P                           []PType                  `xml:"p"`
+	Text                        []ContentType            `xml:"text"`
+	Chapeau                     []ContentType            `xml:"chapeau"`
+	Continuation                []ContinuationElement    `xml:"continuation"`
+	Proviso                     []ProvisoType            `xml:"proviso"`
+	Level                       []LevelType              `xml:"level"`
+	Preliminary                 []LevelType              `xml:"preliminary"`
+	Title                       []LevelType              `xml:"title"`
+	Subtitle                    []LevelType              `xml:"subtitle"`
+	Part                        []LevelType              `xml:"part"`
+	Subpart                     []LevelType              `xml:"subpart"`
+	Division                    []LevelType              `xml:"division"`
+	Subdivision                 []LevelType              `xml:"subdivision"`
+	Chapter                     []LevelType              `xml:"chapter"`
+	Subchapter                  []LevelType              `xml:"subchapter"`
+	Article                     []LevelType              `xml:"article"`
+	Subarticle                  []LevelType              `xml:"subarticle"`
+	CompiledAct                 []LevelType              `xml:"compiledAct"`
+	CourtRules                  []LevelType              `xml:"courtRules"`
+	CourtRule                   []LevelType              `xml:"courtRule"`
+	ReorganizationPlans         []LevelType              `xml:"reorganizationPlans"`
+	ReorganizationPlan          []LevelType              `xml:"reorganizationPlan"`
+	Section                     []LevelType              `xml:"section"`
+	Subsection                  []LevelType              `xml:"subsection"`
+	Paragraph                   []LevelType              `xml:"paragraph"`
+	Subparagraph                []LevelType              `xml:"subparagraph"`
+	Clause                      []LevelType              `xml:"clause"`
+	Subclause                   []LevelType              `xml:"subclause"`
+	Item                        []LevelType              `xml:"item"`
+	Subitem                     []LevelType              `xml:"subitem"`
+	Subsubitem                  []LevelType              `xml:"subsubitem"`
+	CrossHeading                []HeadingType            `xml:"crossHeading"`
+	LeftRunningHead             []RunningHeadType        `xml:"leftRunningHead"`
+	CenterRunningHead           []RunningHeadType        `xml:"centerRunningHead"`
+	RightRunningHead            []RunningHeadType        `xml:"rightRunningHead"`
+	SlugLine                    []RunningHeadType        `xml:"slugLine"`
+	Appropriations              []AppropriationsType     `xml:"appropriations"`
+	Signature                   []SignatureType          `xml:"signature"`
+	Collection                  []CollectionType         `xml:"collection"`
+	Notices                     []CollectionType         `xml:"notices"`
+	Rules                       []CollectionType         `xml:"rules"`
+	ProposedRules               []CollectionType         `xml:"proposedRules"`
+	PresidentialDocs            []CollectionType         `xml:"presidentialDocs"`
+	AgencyGroup                 []CollectionType         `xml:"agencyGroup"`
+	PublicLaws                  []CollectionType         `xml:"publicLaws"`
+	PrivateLaws                 []CollectionType         `xml:"privateLaws"`
+	ConcurrentResolutions       []CollectionType         `xml:"concurrentResolutions"`
+	Action                      *ActionElement           `xml:"action"`
+}
+
+// MarkerType is generated from the MarkerType complex type.
+//
+// The marker type is a restriction of the base type to an element without
+// content.
+type MarkerType struct {
+	XMLName         xml.Name
+	Text            string     `xml:",chardata"`
+	XMLBase         string     `xml:"http://www.w3.org/XML/1998/namespace base,attr,omitempty"`
+	XMLLang         string     `xml:"http://www.w3.org/XML/1998/namespace lang,attr,omitempty"`
+	XMLSpace        string     `xml:"http://www.w3.org/XML/1998/namespace space,attr,omitempty"`
+	ID              string     `xml:"id,attr,omitempty"`
+	TemporalID      string     `xml:"temporalId,attr,omitempty"`
+	Identifier      string     `xml:"identifier,attr,omitempty"`
+	Scope           string     `xml:"scope,attr,omitempty"`
+	Role            string     `xml:"role,attr,omitempty"`
+	Class           string     `xml:"class,attr,omitempty"`
+	Style           string     `xml:"style,attr,omitempty"`
+	StyleType       string     `xml:"styleType,attr,omitempty"`
+	Note            string     `xml:"note,attr,omitempty"`
+	Alt             string     `xml:"alt,attr,omitempty"`
+	Meta            string     `xml:"meta,attr,omitempty"`
+	Misc            string     `xml:"misc,attr,omitempty"`
+	DraftingTip     string     `xml:"draftingTip,attr,omitempty"`
+	CodificationTip string     `xml:"codificationTip,attr,omitempty"`
+	Title           string     `xml:"title,attr,omitempty"`
+	Brief           string     `xml:"brief,attr,omitempty"`
+	SortOrder       string     `xml:"sortOrder,attr,omitempty"`
+	StartPeriod     string     `xml:"startPeriod,attr,omitempty"`
+	EndPeriod       string     `xml:"endPeriod,attr,omitempty"`
+	Status          string     `xml:"status,attr,omitempty"`
+	Partial         string     `xml:"partial,attr,omitempty"`
+	InEffect        string     `xml:"inEffect,attr,omitempty"`
+	AnyAttrs        []xml.Attr `xml:",any,attr"`
+}
+
+// MetaType is generated from the MetaType complex type.
+//
+// The meta type is a core level container for metadata relating to the
+// document.
+type MetaType struct {
+	XMLName                 xml.Name
+	XMLBase                 string                       `xml:"http://www.w3.org/XML/1998/namespace base,attr,omitempty"`
+	XMLLang                 string                       `xml:"http://www.w3.org/XML/1998/namespace lang,attr,omitempty"`
+	XMLSpace                string                       `xml:"http://www.w3.org/XML/1998/namespace space,attr,omitempty"`
+	ID                      string                       `xml:"id,attr,omitempty"`
+	TemporalID              string                       `xml:"temporalId,attr,omitempty"`
+	Identifier              string                       `xml:"identifier,attr,omitempty"`
+	Scope                   string                       `xml:"scope,attr,omitempty"`
+	Role                    string                       `xml:"role,attr,omitempty"`
+	Class                   string                       `xml:"class,attr,omitempty"`
+	Style                   string                       `xml:"style,attr,omitempty"`
+	StyleType               string                       `xml:"styleType,attr,omitempty"`
+	Note                    string                       `xml:"note,attr,omitempty"`
+	Alt                     string                       `xml:"alt,attr,omitempty"`
+	Meta                    string                       `xml:"meta,attr,omitempty"`
+	Misc                    string                       `xml:"misc,attr,omitempty"`
+	DraftingTip             string                       `xml:"draftingTip,attr,omitempty"`
+	CodificationTip         string                       `xml:"codificationTip,attr,omitempty"`
+	Title                   string                       `xml:"title,attr,omitempty"`
+	Brief                   string                       `xml:"brief,attr,omitempty"`
+	SortOrder               string                       `xml:"sortOrder,attr,omitempty"`
+	StartPeriod             string                       `xml:"startPeriod,attr,omitempty"`
+	EndPeriod               string                       `xml:"endPeriod,attr,omitempty"`
+	Status                  string                       `xml:"status,attr,omitempty"`
+	Partial                 string                       `xml:"partial,attr,omitempty"`
+	InEffect                string                       `xml:"inEffect,attr,omitempty"`
+	AnyAttrs                []xml.Attr                   `xml:",any,attr"`
+	Any                     []AnyElement                 `xml:",any"`
+	Action                  []ActionElement              `xml:"action"`
+	ApprovedDate            []PropertyType               `xml:"approvedDate"`
+	CitableAs               []CitableAsElement           `xml:"citableAs"`
+	CitableAsShortTitle     []CitableAsShortTitleElement `xml:"citableAsShortTitle"`
+	ContainsShortTitle      []ContainsShortTitleElement  `xml:"containsShortTitle"`
+	CreatedDate             []PropertyType               `xml:"createdDate"`
+	Issue                   []PropertyType               `xml:"issue"`
+	PopularName             []PropertyType               `xml:"popularName"`
+	ProcessedBy             []PropertyType               `xml:"processedBy"`
+	ProcessedDate           []PropertyType               `xml:"processedDate"`
+	StartingPage            []PropertyType               `xml:"startingPage"`
+	EndingPage              []PropertyType               `xml:"endingPage"`
+	StartingProvision       []PropertyType               `xml:"startingProvision"`
+	EndingProvision         []PropertyType               `xml:"endingProvision"`
+	Property                []PropertyType               `xml:"property"`
+	CoverTitle              []PropertyType               `xml:"coverTitle"`
+	AmendmentNumber         []AmendmentNumberElement     `xml:"amendmentNumber"`
+	Congress                []PropertyType               `xml:"congress"`
+	CurrentChamber          []PropertyType               `xml:"currentChamber"`
+	CurrentThroughPublicLaw []PropertyType               `xml:"currentThroughPublicLaw"`
+	DistributionCode        []PropertyType               `xml:"distributionCode"`
+	DocNumber               []PropertyType               `xml:"docNumber"`
+	DocPart                 []PropertyType               `xml:"docPart"`
+	DocPublicationName      []PropertyType               `xml:"docPublicationName"`
+	DocReleasePoint         []PropertyType               `xml:"docReleasePoint"`
+	DocStage                []PropertyType               `xml:"docStage"`
+	EnrolledDateline        []PropertyType               `xml:"enrolledDateline"`
+	Purpose                 []PropertyType               `xml:"purpose"`
+	RelatedDocument         []PropertyType               `xml:"relatedDocument"`
+	Session                 []PropertyType               `xml:"session"`
+	Subject                 []PropertyType               `xml:"subject"`
+	Volume                  []PropertyType               `xml:"volume"`
+	ProvisionRange          []PropertyType               `xml:"provisionRange"`
+	PublicPrint             []string                     `xml:"publicPrint"`
+	PublicPrivate           []string                     `xml:"publicPrivate"`
+	RelatedDocuments        []RelatedDocumentsElement    `xml:"relatedDocuments"`
+	Set                     []SetType                    `xml:"set"`
+	StarPrint               []StarPrintType              `xml:"starPrint"`
+}
+
+// NotationElement is generated from the anonymous type of the notation
+// element.
+//
+// The notation is the label for a signature or group of signatures.
+type NotationElement struct {
+	XMLName         xml.Name
+	Text            string     `xml:",chardata"`
+	XMLBase         string     `xml:"http://www.w3.org/XML/1998/namespace base,attr,omitempty"`
+	XMLLang         string     `xml:"http://www.w3.org/XML/1998/namespace lang,attr,omitempty"`
+	XMLSpace        string     `xml:"http://www.w3.org/XML/1998/namespace space,attr,omitempty"`
+	ID              string     `xml:"id,attr,omitempty"`
+	TemporalID      string     `xml:"temporalId,attr,omitempty"`
+	Identifier      string     `xml:"identifier,attr,omitempty"`
+	Scope           string     `xml:"scope,attr,omitempty"`
+	Role            string     `xml:"role,attr,omitempty"`
+	Class           string     `xml:"class,attr,omitempty"`
+	Style           string     `xml:"style,attr,omitempty"`
+	StyleType       string     `xml:"styleType,attr,omitempty"`
+	Note            string     `xml:"note,attr,omitempty"`
+	Alt             string     `xml:"alt,attr,omitempty"`
+	Meta            string     `xml:"meta,attr,omitempty"`
+	Misc            string     `xml:"misc,attr,omitempty"`
+	DraftingTip     string     `xml:"draftingTip,attr,omitempty"`
+	CodificationTip string     `xml:"codificationTip,attr,omitempty"`
+	Title           string     `xml:"title,attr,omitempty"`
+	Brief           string     `xml:"brief,attr,omitempty"`
+	SortOrder       string     `xml:"sortOrder,attr,omitempty"`
+	StartPeriod     string     `xml:"startPeriod,attr,omitempty"`
+	EndPeriod       string     `xml:"endPeriod,attr,omitempty"`
+	Status          string     `xml:"status,attr,omitempty"`
+	Partial         string     `xml:"partial,attr,omitempty"`
+	InEffect        string     `xml:"inEffect,attr,omitempty"`
+	AnyAttrs        []xml.Attr `xml:",any,attr"`
+	Type            string     `xml:"type,attr,omitempty"`
+}
+
+// NoteType is generated from the NoteType complex type.
+//
+// A note is an annotation recording important information about the document
+// or its content.
+type NoteType struct {
+	XMLName         xml.Name
+	Text            string       `xml:",chardata"`
+	XMLBase         string       `xml:"http://www.w3.org/XML/1998/namespace base,attr,omitempty"`
+	XMLLang         string       `xml:"http://www.w3.org/XML/1998/namespace lang,attr,omitempty"`
+	XMLSpace        string       `xml:"http://www.w3.org/XML/1998/namespace space,attr,omitempty"`
+	ID              string       `xml:"id,attr,omitempty"`
+	TemporalID      string       `xml:"temporalId,attr,omitempty"`
+	Identifier      string       `xml:"identifier,attr,omitempty"`
+	Scope           string       `xml:"scope,attr,omitempty"`
+	Role            string       `xml:"role,attr,omitempty"`
+	Class           string       `xml:"class,attr,omitempty"`
+	Style           string       `xml:"style,attr,omitempty"`
+	StyleType       string       `xml:"styleType,attr,omitempty"`
+	Note            string       `xml:"note,attr,omitempty"`
+	Alt             string       `xml:"alt,attr,omitempty"`
+	Meta            string       `xml:"meta,attr,omitempty"`
+	Misc            string       `xml:"misc,attr,omitempty"`
+	DraftingTip     string       `xml:"draftingTip,attr,omitempty"`
+	CodificationTip string       `xml:"codificationTip,attr,omitempty"`
+	Title           string       `xml:"title,attr,omitempty"`
+	Brief           string       `xml:"brief,attr,omitempty"`
+	SortOrder       string       `xml:"sortOrder,attr,omitempty"`
+	StartPeriod     string       `xml:"startPeriod,attr,omitempty"`
+	EndPeriod       string       `xml:"endPeriod,attr,omitempty"`
+	Status          string       `xml:"status,attr,omitempty"`
+	Partial         string       `xml:"partial,attr,omitempty"`
+	InEffect        string       `xml:"inEffect,attr,omitempty"`
+	AnyAttrs        []xml.Attr   `xml:",any,attr"`
+	Any             []AnyElement `xml:",any"`
+	XhtmlImg        []AnyElement `xml:"http://www.w3.org/1999/xhtml img"`
+	XhtmlTable      []AnyElement `xml:"http://www.w3.org/1999/xhtml table"`
+	MathmlMath      []AnyElement `xml:"http://www.w3.org/1998/Math/MathML math"`
+	Orientation     string       `xml:"orientation,attr,omitempty"`
+	Type            string       `xml:"type,attr,omitempty"`
+	Topic           string       `xml:"topic,attr,omitempty"`
+	Date            string       `xml:"date,attr,omitempty"`
+	LegisDate       string       `xml:"legisDate,attr,omitempty"`
+	StartDate       string       `xml:"startDate,attr,omitempty"`
+	EndDate         string       `xml:"endDate,attr,omitempty"`
+	Href            string       `xml:"href,attr,omitempty"`
+	Idref           string       `xml:"idref,attr,omitempty"`
+	Portion         string       `xml:"portion,attr,omitempty"`
+	Display         string       `xml:"display,attr,omitempty"`
+}
+
+// NotesType is generated from the NotesType complex type.
+//
+// Notes are a contiguous set of notes, like the US Code notes below a section.
+type NotesType struct {
+	XMLName           xml.Name
+	XMLBase           string        `xml:"http://www.w3.org/XML/1998/namespace base,attr,omitempty"`
+	XMLLang           string        `xml:"http://www.w3.org/XML/1998/namespace lang,attr,omitempty"`
+	XMLSpace          string        `xml:"http://www.w3.org/XML/1998/namespace space,attr,omitempty"`
+	ID                string        `xml:"id,attr,omitempty"`
+	TemporalID        string        `xml:"temporalId,attr,omitempty"`
+	Identifier        string        `xml:"identifier,attr,omitempty"`
+	Scope             string        `xml:"scope,attr,omitempty"`
+	Role              string        `xml:"role,attr,omitempty"`
+	Class             string        `xml:"class,attr,omitempty"`
+	Style             string        `xml:"style,attr,omitempty"`
+	StyleType         string        `xml:"styleType,attr,omitempty"`
+	NoteAttr          string        `xml:"note,attr,omitempty"`
+	Alt               string        `xml:"alt,attr,omitempty"`
+	Meta              string        `xml:"meta,attr,omitempty"`
+	Misc              string        `xml:"misc,attr,omitempty"`
+	DraftingTip       string        `xml:"draftingTip,attr,omitempty"`
+	CodificationTip   string        `xml:"codificationTip,attr,omitempty"`
+	Title             string        `xml:"title,attr,omitempty"`
+	Brief             string        `xml:"brief,attr,omitempty"`
+	SortOrder         string        `xml:"sortOrder,attr,omitempty"`
+	StartPeriod       string        `xml:"startPeriod,attr,omitempty"`
+	EndPeriod         string        `xml:"endPeriod,attr,omitempty"`
+	Status            string        `xml:"status,attr,omitempty"`
+	Partial           string        `xml:"partial,attr,omitempty"`
+	InEffect          string        `xml:"inEffect,attr,omitempty"`
+	AnyAttrs          []xml.Attr    `xml:",any,attr"`
+	Page              []PageType    `xml:"page"`
+	Line              []PageType    `xml:"line"`
+	Heading           *HeadingType  `xml:"heading"`
+	Subheading        []HeadingType `xml:"subheading"`
+	Note              []NoteType    `xml:"note"`
+	Elided            []ElidedType  `xml:"elided"`
+	SourceCredit      []NoteType    `xml:"sourceCredit"`
+	UscNote           []NoteType    `xml:"uscNote"`
+	StatutoryNote     []NoteType    `xml:"statutoryNote"`
+	DrafterNote       []NoteType    `xml:"drafterNote"`
+	EditorialNote     []NoteType    `xml:"editorialNote"`
+	ChangeNote        []NoteType    `xml:"changeNote"`
+	Authority         []NoteType    `xml:"authority"`
+	Source            []NoteType    `xml:"source"`
+	EffectiveDateNote []NoteType    `xml:"effectiveDateNote"`
+	FrDocID           []NoteType    `xml:"frDocId"`
+	BillingCode       []NoteType    `xml:"billingCode"`
+	EditionNote       []NoteType    `xml:"editionNote"`
+	OrganizationNote  []NoteType    `xml:"organizationNote"`
+	CitationNote      []NoteType    `xml:"citationNote"`
+	ExplanationNote   []NoteType    `xml:"explanationNote"`
+	FindingAidsNote   []NoteType    `xml:"findingAidsNote"`
+	Layout            *LayoutType   `xml:"layout"`
+	Type              string        `xml:"type,attr,omitempty"`
+	Topic             string        `xml:"topic,attr,omitempty"`
+}
+
+// NumType is generated from the NumType complex type.
+//
+// The numType is used for the alphanumeric designation given to a hierarchical
+// level and other items in a document.
+type NumType struct {
+	XMLName            xml.Name
+	Text               string               `xml:",chardata"`
+	XMLBase            string               `xml:"http://www.w3.org/XML/1998/namespace base,attr,omitempty"`
+	XMLLang            string               `xml:"http://www.w3.org/XML/1998/namespace lang,attr,omitempty"`
+	XMLSpace           string               `xml:"http://www.w3.org/XML/1998/namespace space,attr,omitempty"`
+	ID                 string               `xml:"id,attr,omitempty"`
+	TemporalID         string               `xml:"temporalId,attr,omitempty"`
+	Identifier         string               `xml:"identifier,attr,omitempty"`
+	Scope              string               `xml:"scope,attr,omitempty"`
+	Role               string               `xml:"role,attr,omitempty"`
+	Class              string               `xml:"class,attr,omitempty"`
+	Style              string               `xml:"style,attr,omitempty"`
+	StyleType          string               `xml:"styleType,attr,omitempty"`
+	NoteAttr           string               `xml:"note,attr,omitempty"`
+	Alt                string               `xml:"alt,attr,omitempty"`
+	Meta               string               `xml:"meta,attr,omitempty"`
+	Misc               string               `xml:"misc,attr,omitempty"`
+	DraftingTip        string               `xml:"draftingTip,attr,omitempty"`
+	CodificationTip    string               `xml:"codificationTip,attr,omitempty"`
+	Title              string               `xml:"title,attr,omitempty"`
+	Brief              string               `xml:"brief,attr,omitempty"`
+	SortOrder          string               `xml:"sortOrder,attr,omitempty"`
+	StartPeriod        string               `xml:"startPeriod,attr,omitempty"`
+	EndPeriod          string               `xml:"endPeriod,attr,omitempty"`
+	Status             string               `xml:"status,attr,omitempty"`
+	Partial            string               `xml:"partial,attr,omitempty"`
+	InEffect           string               `xml:"inEffect,attr,omitempty"`
+	AnyAttrs           []xml.Attr           `xml:",any,attr"`
+	Marker             []MarkerType         `xml:"marker"`
+	Br                 []BrType             `xml:"br"`
+	Img                []ImgType            `xml:"img"`
+	Inline             []InlineType         `xml:"inline"`
+	AmendingAction     []AmendingActionType `xml:"amendingAction"`
+	Ref                []RefType            `xml:"ref"`
+	Date               []DateType           `xml:"date"`
+	Center             []InlineType         `xml:"center"`
+	FillIn             []InlineType         `xml:"fillIn"`
+	CheckBox           []InlineType         `xml:"checkBox"`
+	B                  []InlineType         `xml:"b"`
+	I                  []InlineType         `xml:"i"`
+	Qualifier          []InlineType         `xml:"qualifier"`
+	Sub                []InlineType         `xml:"sub"`
+	Sup                []InlineType         `xml:"sup"`
+	HeadingText        []InlineType         `xml:"headingText"`
+	Span               []InlineType         `xml:"span"`
+	ShortTitle         []InlineType         `xml:"shortTitle"`
+	Term               []InlineType         `xml:"term"`
+	Entity             []InlineType         `xml:"entity"`
+	Committee          []CommitteeElement   `xml:"committee"`
+	Organization       []InlinePropertyType `xml:"organization"`
+	Note               []NoteType           `xml:"note"`
+	Elided             []ElidedType         `xml:"elided"`
+	SourceCredit       []NoteType           `xml:"sourceCredit"`
+	UscNote            []NoteType           `xml:"uscNote"`
+	StatutoryNote      []NoteType           `xml:"statutoryNote"`
+	DrafterNote        []NoteType           `xml:"drafterNote"`
+	EditorialNote      []NoteType           `xml:"editorialNote"`
+	ChangeNote         []NoteType           `xml:"changeNote"`
+	Authority          []NoteType           `xml:"authority"`
+	Source             []NoteType           `xml:"source"`
+	EffectiveDateNote  []NoteType           `xml:"effectiveDateNote"`
+	FrDocID            []NoteType           `xml:"frDocId"`
+	BillingCode        []NoteType           `xml:"billingCode"`
+	EditionNote        []NoteType           `xml:"editionNote"`
+	OrganizationNote   []NoteType           `xml:"organizationNote"`
+	CitationNote       []NoteType           `xml:"citationNote"`
+	ExplanationNote    []NoteType           `xml:"explanationNote"`
+	FindingAidsNote    []NoteType           `xml:"findingAidsNote"`
+	Notes              []NotesType          `xml:"notes"`
+	LegislativeHistory []NotesType          `xml:"legislativeHistory"`
+	Footnote           []PositionedNoteType `xml:"footnote"`
+	Sidenote           []PositionedNoteType `xml:"sidenote"`
+	Endnote            []PositionedNoteType `xml:"endnote"`
+	Ear                []PositionedNoteType `xml:"ear"`
+	Page               []PageType           `xml:"page"`
+	Line               []PageType           `xml:"line"`
+	Proviso            []ProvisoType        `xml:"proviso"`
+	QuotedText         []QuotedTextType     `xml:"quotedText"`
+	AddedText          []QuotedTextType     `xml:"addedText"`
+	DeletedText        []QuotedTextType     `xml:"deletedText"`
+	Del                []QuotedTextType     `xml:"del"`
+	Ins                []QuotedTextType     `xml:"ins"`
+	Value              string               `xml:"value,attr,omitempty"`
+	StartValue         string               `xml:"startValue,attr,omitempty"`
+	EndValue           string               `xml:"endValue,attr,omitempty"`
+}
+
+// OfficialTitleAmendmentElement is generated from the anonymous type of the
+// officialTitleAmendment element.
+//
+// An officialTitleAmendment is used to amend the official title of a bill or
+// resolution before it becomes law.
+type OfficialTitleAmendmentElement struct {
+	XMLName          xml.Name
+	Text             string                `xml:",chardata"`
+	XMLBase          string                `xml:"http://www.w3.org/XML/1998/namespace base,attr,omitempty"`
+	XMLLang          string                `xml:"http://www.w3.org/XML/1998/namespace lang,attr,omitempty"`
+	XMLSpace         string                `xml:"http://www.w3.org/XML/1998/namespace space,attr,omitempty"`
+	ID               string                `xml:"id,attr,omitempty"`
+	TemporalID       string                `xml:"temporalId,attr,omitempty"`
+	Identifier       string                `xml:"identifier,attr,omitempty"`
+	Scope            string                `xml:"scope,attr,omitempty"`
+	Role             string                `xml:"role,attr,omitempty"`
+	Class            string                `xml:"class,attr,omitempty"`
+	Style            string                `xml:"style,attr,omitempty"`
+	StyleType        string                `xml:"styleType,attr,omitempty"`
+	Note             string                `xml:"note,attr,omitempty"`
+	Alt              string                `xml:"alt,attr,omitempty"`
+	Meta             string                `xml:"meta,attr,omitempty"`
+	Misc             string                `xml:"misc,attr,omitempty"`
+	DraftingTip      string                `xml:"draftingTip,attr,omitempty"`
+	CodificationTip  string                `xml:"codificationTip,attr,omitempty"`
+	Title            string                `xml:"title,attr,omitempty"`
+	Brief            string                `xml:"brief,attr,omitempty"`
+	SortOrder        string                `xml:"sortOrder,attr,omitempty"`
+	StartPeriod      string                `xml:"startPeriod,attr,omitempty"`
+	EndPeriod        string                `xml:"endPeriod,attr,omitempty"`
+	Status           string                `xml:"status,attr,omitempty"`
+	Partial          string                `xml:"partial,attr,omitempty"`
+	InEffect         string                `xml:"inEffect,attr,omitempty"`
+	AnyAttrs         []xml.Attr            `xml:",any,attr"`
+	OfficialTitle    *StatementType        `xml:"officialTitle"`
+	LongTitle        *LongTitleElement     `xml:"longTitle"`
+	AmendmentContent *AmendmentContentType `xml:"amendmentContent"`
+}
+
+// PType is generated from the PType complex type.
+//
+// A "P" type is a simple unnumbered paragraph.
+type PType struct {
+	XMLName                 xml.Name
+	Text                    string                   `xml:",chardata"`
+	Affected                []AffectedElement        `xml:"affected"`
+	ApprovedDate            []PropertyType           `xml:"approvedDate"`
+	DocTitle                []StatementType          `xml:"docTitle"`
+	Inline                  []InlineType             `xml:"inline"`
+	AmendingAction          []AmendingActionType     `xml:"amendingAction"`
+	Ref                     []RefType                `xml:"ref"`
+	Date                    []DateType               `xml:"date"`
+	Center                  []InlineType             `xml:"center"`
+	FillIn                  []InlineType             `xml:"fillIn"`
+	CheckBox                []InlineType             `xml:"checkBox"`
+	B                       []InlineType             `xml:"b"`
+	I                       []InlineType             `xml:"i"`
+	Qualifier               []InlineType             `xml:"qualifier"`
+	Sub                     []InlineType             `xml:"sub"`
+	Sup                     []InlineType             `xml:"sup"`
+	HeadingText             []InlineType             `xml:"headingText"`
+	Span                    []InlineType             `xml:"span"`
+	ShortTitle              []InlineType             `xml:"shortTitle"`
+	Term                    []InlineType             `xml:"term"`
+	Entity                  []InlineType             `xml:"entity"`
+	Footnote                []PositionedNoteType     `xml:"footnote"`
+	Sidenote                []PositionedNoteType     `xml:"sidenote"`
+	Endnote                 []PositionedNoteType     `xml:"endnote"`
+	Ear                     []PositionedNoteType     `xml:"ear"`
+	Foreign                 []ForeignElement         `xml:"foreign"`
+	Marker                  []MarkerType             `xml:"marker"`
+	Br                      []BrType                 `xml:"br"`
+	Img                     []ImgType                `xml:"img"`
+	MathmlMath              []AnyElement             `xml:"http://www.w3.org/1998/Math/MathML math"`
+	Page                    []PageType               `xml:"page"`
+	Line                    []PageType               `xml:"line"`
+	Property                []PropertyType           `xml:"property"`
+	CoverTitle              []PropertyType           `xml:"coverTitle"`
+	AmendmentNumber         []AmendmentNumberElement `xml:"amendmentNumber"`
+	Congress                []PropertyType           `xml:"congress"`
+	CurrentChamber          []PropertyType           `xml:"currentChamber"`
+	CurrentThroughPublicLaw []PropertyType           `xml:"currentThroughPublicLaw"`
+	DistributionCode        []PropertyType           `xml:"distributionCode"`
+	DocNumber               []PropertyType           `xml:"docNumber"`
+	DocPart                 []PropertyType           `xml:"docPart"`
+	DocPublicationName      []PropertyType           `xml:"docPublicationName"`
+	DocReleasePoint         []PropertyType           `xml:"docReleasePoint"`
+	DocStage                []PropertyType           `xml:"docStage"`
+	EnrolledDateline        []PropertyType           `xml:"enrolledDateline"`
+	Purpose                 []PropertyType           `xml:"purpose"`
+	RelatedDocument         []PropertyType           `xml:"relatedDocument"`
+	Session                 []PropertyType           `xml:"session"`
+	Subject                 []PropertyType           `xml:"subject"`
+	Volume                  []PropertyType           `xml:"volume"`
+	ProvisionRange          []PropertyType           `xml:"provisionRange"`
+	Proviso                 []ProvisoType            `xml:"proviso"`
+	Signature               []SignatureType          `xml:"signature"`
+	Signatures              []SignaturesType         `xml:"signatures"`
+	QuotedContent           []QuotedContentType      `xml:"quotedContent"`
+	QuotedText              []QuotedTextType         `xml:"quotedText"`
+	AddedText               []QuotedTextType         `xml:"addedText"`
+	DeletedText             []QuotedTextType         `xml:"deletedText"`
+	Del                     []QuotedTextType         `xml:"del"`
+	Ins                     []QuotedTextType         `xml:"ins"`
+	XhtmlTable              []AnyElement             `xml:"http://www.w3.org/1999/xhtml table"`
+	Note                    []NoteType               `xml:"note"`
+	Elided                  []ElidedType             `xml:"elided"`
+	SourceCredit            []NoteType               `xml:"sourceCredit"`
+	UscNote                 []NoteType               `xml:"uscNote"`
+	StatutoryNote           []NoteType               `xml:"statutoryNote"`
+	DrafterNote             []NoteType               `xml:"drafterNote"`
+	EditorialNote           []NoteType               `xml:"editorialNote"`
+	ChangeNote              []NoteType               `xml:"changeNote"`
+	Authority               []NoteType               `xml:"authority"`
+	Source                  []NoteType               `xml:"source"`
+	EffectiveDateNote       []NoteType               `xml:"effectiveDateNote"`
+	FrDocID                 []NoteType               `xml:"frDocId"`
+	BillingCode             []NoteType               `xml:"billingCode"`
+	EditionNote             []NoteType               `xml:"editionNote"`
+	OrganizationNote        []NoteType               `xml:"organizationNote"`
+	CitationNote            []NoteType               `xml:"citationNote"`
+	ExplanationNote         []NoteType               `xml:"explanationNote"`
+	FindingAidsNote         []NoteType               `xml:"findingAidsNote"`
+	Notes                   []NotesType              `xml:"notes"`
+	LegislativeHistory      []NotesType              `xml:"legislativeHistory"`
+	Role                    string                   `xml:"role,attr,omitempty"`
+	Class                   string                   `xml:"class,attr,omitempty"`
+	Style                   string                   `xml:"style,attr,omitempty"`
+	StyleType               string                   `xml:"styleType,attr,omitempty"`
+	ID                      string                   `xml:"id,attr,omitempty"`
+	TemporalID              string                   `xml:"temporalId,attr,omitempty"`
+	Identifier              string                   `xml:"identifier,attr,omitempty"`
+	Scope                   string                   `xml:"scope,attr,omitempty"`
+	XMLBase                 string                   `xml:"http://www.w3.org/XML/1998/namespace base,attr,omitempty"`
+	XMLLang                 string                   `xml:"http://www.w3.org/XML/1998/namespace lang,attr,omitempty"`
+	XMLSpace                string                   `xml:"http://www.w3.org/XML/1998/namespace space,attr,omitempty"`
+}
+
+// PageType is generated from the PageType complex type.
+//
+// The page type is used to define the page and line elements.
+type PageType struct {
+	XMLName           xml.Name
+	Text              string              `xml:",chardata"`
+	Inline            *InlineType         `xml:"inline"`
+	AmendingAction    *AmendingActionType `xml:"amendingAction"`
+	Ref               *RefType            `xml:"ref"`
+	Date              *DateType           `xml:"date"`
+	Center            *InlineType         `xml:"center"`
+	FillIn            *InlineType         `xml:"fillIn"`
+	CheckBox          *InlineType         `xml:"checkBox"`
+	B                 *InlineType         `xml:"b"`
+	I                 *InlineType         `xml:"i"`
+	Qualifier         *InlineType         `xml:"qualifier"`
+	Sub               *InlineType         `xml:"sub"`
+	Sup               *InlineType         `xml:"sup"`
+	HeadingText       *InlineType         `xml:"headingText"`
+	Span              *InlineType         `xml:"span"`
+	ShortTitle        *InlineType         `xml:"shortTitle"`
+	Term              *InlineType         `xml:"term"`
+	Entity            *InlineType         `xml:"entity"`
+	Role              string              `xml:"role,attr,omitempty"`
+	Class             string              `xml:"class,attr,omitempty"`
+	Style             string              `xml:"style,attr,omitempty"`
+	StyleType         string              `xml:"styleType,attr,omitempty"`
+	ID                string              `xml:"id,attr,omitempty"`
+	TemporalID        string              `xml:"temporalId,attr,omitempty"`
+	Identifier        string              `xml:"identifier,attr,omitempty"`
+	Scope             string              `xml:"scope,attr,omitempty"`
+	Display           string              `xml:"display,attr,omitempty"`
+	RenderingPosition string              `xml:"renderingPosition,attr,omitempty"`
+	RelativeTo        string              `xml:"relativeTo,attr,omitempty"`
+}
+
+// PositionedNoteType is generated from the PositionedNoteType complex type.
+//
+// The content model of a PositionedNoteType element allows selected elements,
+// with additional attributes to specify where the note is to be rendered.
+type PositionedNoteType struct {
+	XMLName            xml.Name
+	Text               string               `xml:",chardata"`
+	Br                 []BrType             `xml:"br"`
+	Img                []ImgType            `xml:"img"`
+	Inline             []InlineType         `xml:"inline"`
+	AmendingAction     []AmendingActionType `xml:"amendingAction"`
+	Ref                []RefType            `xml:"ref"`
+	Date               []DateType           `xml:"date"`
+	Center             []InlineType         `xml:"center"`
+	FillIn             []InlineType         `xml:"fillIn"`
+	CheckBox           []InlineType         `xml:"checkBox"`
+	B                  []InlineType         `xml:"b"`
+	I                  []InlineType         `xml:"i"`
+	Qualifier          []InlineType         `xml:"qualifier"`
+	Sub                []InlineType         `xml:"sub"`
+	Sup                []InlineType         `xml:"sup"`
+	HeadingText        []InlineType         `xml:"headingText"`
+	Span               []InlineType         `xml:"span"`
+	ShortTitle         []InlineType         `xml:"shortTitle"`
+	Term               []InlineType         `xml:"term"`
+	Entity             []InlineType         `xml:"entity"`
+	List               []ListType           `xml:"list"`
+	Note               []NoteType           `xml:"note"`
+	Elided             []ElidedType         `xml:"elided"`
+	SourceCredit       []NoteType           `xml:"sourceCredit"`
+	UscNote            []NoteType           `xml:"uscNote"`
+	StatutoryNote      []NoteType           `xml:"statutoryNote"`
+	DrafterNote        []NoteType           `xml:"drafterNote"`
+	EditorialNote      []NoteType           `xml:"editorialNote"`
+	ChangeNote         []NoteType           `xml:"changeNote"`
+	Authority          []NoteType           `xml:"authority"`
+	Source             []NoteType           `xml:"source"`
+	EffectiveDateNote  []NoteType           `xml:"effectiveDateNote"`
+	FrDocID            []NoteType           `xml:"frDocId"`
+	BillingCode        []NoteType           `xml:"billingCode"`
+	EditionNote        []NoteType           `xml:"editionNote"`
+	OrganizationNote   []NoteType           `xml:"organizationNote"`
+	CitationNote       []NoteType           `xml:"citationNote"`
+	ExplanationNote    []NoteType           `xml:"explanationNote"`
+	FindingAidsNote    []NoteType           `xml:"findingAidsNote"`
+	Notes              []NotesType          `xml:"notes"`
+	LegislativeHistory []NotesType          `xml:"legislativeHistory"`
+	Num                []NumType            `xml:"num"`
+	P                  []PType              `xml:"p"`
+	XhtmlTable         []AnyElement         `xml:"http://www.w3.org/1999/xhtml table"`
+	XMLBase            string               `xml:"http://www.w3.org/XML/1998/namespace base,attr,omitempty"`
+	XMLLang            string               `xml:"http://www.w3.org/XML/1998/namespace lang,attr,omitempty"`
+	XMLSpace           string               `xml:"http://www.w3.org/XML/1998/namespace space,attr,omitempty"`
+	Role               string               `xml:"role,attr,omitempty"`
+	Class              string               `xml:"class,attr,omitempty"`
+	Style              string               `xml:"style,attr,omitempty"`
+	StyleType          string               `xml:"styleType,attr,omitempty"`
+	ID                 string               `xml:"id,attr,omitempty"`
+	TemporalID         string               `xml:"temporalId,attr,omitempty"`
+	Identifier         string               `xml:"identifier,attr,omitempty"`
+	Scope              string               `xml:"scope,attr,omitempty"`
+	Href               string               `xml:"href,attr,omitempty"`
+	Idref              string               `xml:"idref,attr,omitempty"`
+	Portion            string               `xml:"portion,attr,omitempty"`
+	RenderingPosition  string               `xml:"renderingPosition,attr,omitempty"`
+	RelativeTo         string               `xml:"relativeTo,attr,omitempty"`
+}
+
+// PreambleType is generated from the PreambleType complex type.
+//
+// The preamble core type is the optional container for recitals and the
+// enacting formula.
+type PreambleType struct {
+	XMLName            xml.Name
+	XMLBase            string               `xml:"http://www.w3.org/XML/1998/namespace base,attr,omitempty"`
+	XMLLang            string               `xml:"http://www.w3.org/XML/1998/namespace lang,attr,omitempty"`
+	XMLSpace           string               `xml:"http://www.w3.org/XML/1998/namespace space,attr,omitempty"`
+	ID                 string               `xml:"id,attr,omitempty"`
+	TemporalID         string               `xml:"temporalId,attr,omitempty"`
+	Identifier         string               `xml:"identifier,attr,omitempty"`
+	Scope              string               `xml:"scope,attr,omitempty"`
+	Role               string               `xml:"role,attr,omitempty"`
+	Class              string               `xml:"class,attr,omitempty"`
+	Style              string               `xml:"style,attr,omitempty"`
+	StyleType          string               `xml:"styleType,attr,omitempty"`
+	NoteAttr           string               `xml:"note,attr,omitempty"`
+	Alt                string               `xml:"alt,attr,omitempty"`
+	Meta               string               `xml:"meta,attr,omitempty"`
+	Misc               string               `xml:"misc,attr,omitempty"`
+	DraftingTip        string               `xml:"draftingTip,attr,omitempty"`
+	CodificationTip    string               `xml:"codificationTip,attr,omitempty"`
+	Title              string               `xml:"title,attr,omitempty"`
+	Brief              string               `xml:"brief,attr,omitempty"`
+	SortOrder          string               `xml:"sortOrder,attr,omitempty"`
+	StartPeriod        string               `xml:"startPeriod,attr,omitempty"`
+	EndPeriod          string               `xml:"endPeriod,attr,omitempty"`
+	Status             string               `xml:"status,attr,omitempty"`
+	Partial            string               `xml:"partial,attr,omitempty"`
+	InEffect           string               `xml:"inEffect,attr,omitempty"`
+	AnyAttrs           []xml.Attr           `xml:",any,attr"`
+	Heading            []HeadingType        `xml:"heading"`
+	Subheading         []HeadingType        `xml:"subheading"`
+	Footnote           []PositionedNoteType `xml:"footnote"`
+	Sidenote           []PositionedNoteType `xml:"sidenote"`
+	Endnote            []PositionedNoteType `xml:"endnote"`
+	Ear                []PositionedNoteType `xml:"ear"`
+	Note               []NoteType           `xml:"note"`
+	Elided             []ElidedType         `xml:"elided"`
+	SourceCredit       []NoteType           `xml:"sourceCredit"`
+	UscNote            []NoteType           `xml:"uscNote"`
+	StatutoryNote      []NoteType           `xml:"statutoryNote"`
+	DrafterNote        []NoteType           `xml:"drafterNote"`
+	EditorialNote      []NoteType           `xml:"editorialNote"`
+	ChangeNote         []NoteType           `xml:"changeNote"`
+	Authority          []NoteType           `xml:"authority"`
+	Source             []NoteType           `xml:"source"`
+	EffectiveDateNote  []NoteType           `xml:"effectiveDateNote"`
+	FrDocID            []NoteType           `xml:"frDocId"`
+	BillingCode        []NoteType           `xml:"billingCode"`
+	EditionNote        []NoteType           `xml:"editionNote"`
+	OrganizationNote   []NoteType           `xml:"organizationNote"`
+	CitationNote       []NoteType           `xml:"citationNote"`
+	ExplanationNote    []NoteType           `xml:"explanationNote"`
+	FindingAidsNote    []NoteType           `xml:"findingAidsNote"`
+	Notes              []NotesType          `xml:"notes"`
+	LegislativeHistory []NotesType          `xml:"legislativeHistory"`
+	Page               []PageType           `xml:"page"`
+	Line               []PageType           `xml:"line"`
+	Recital            []RecitalElement     `xml:"recital"`
+	EnactingFormula    *StatementType       `xml:"enactingFormula"`
+	ResolvingClause    *StatementType       `xml:"resolvingClause"`
+}
+
+// PrefaceType is generated from the PrefaceType complex type.
+//
+// The PrefaceType is used for preface elements.
+type PrefaceType struct {
+	XMLName                     xml.Name
+	XMLBase                     string                    `xml:"http://www.w3.org/XML/1998/namespace base,attr,omitempty"`
+	XMLLang                     string                    `xml:"http://www.w3.org/XML/1998/namespace lang,attr,omitempty"`
+	XMLSpace                    string                    `xml:"http://www.w3.org/XML/1998/namespace space,attr,omitempty"`
+	ID                          string                    `xml:"id,attr,omitempty"`
+	TemporalID                  string                    `xml:"temporalId,attr,omitempty"`
+	Identifier                  string                    `xml:"identifier,attr,omitempty"`
+	Scope                       string                    `xml:"scope,attr,omitempty"`
+	Role                        string                    `xml:"role,attr,omitempty"`
+	Class                       string                    `xml:"class,attr,omitempty"`
+	Style                       string                    `xml:"style,attr,omitempty"`
+	StyleType                   string                    `xml:"styleType,attr,omitempty"`
+	NoteAttr                    string                    `xml:"note,attr,omitempty"`
+	Alt                         string                    `xml:"alt,attr,omitempty"`
+	Meta                        string                    `xml:"meta,attr,omitempty"`
+	Misc                        string                    `xml:"misc,attr,omitempty"`
+	DraftingTip                 string                    `xml:"draftingTip,attr,omitempty"`
+	CodificationTip             string                    `xml:"codificationTip,attr,omitempty"`
+	Title                       string                    `xml:"title,attr,omitempty"`
+	Brief                       string                    `xml:"brief,attr,omitempty"`
+	SortOrder                   string                    `xml:"sortOrder,attr,omitempty"`
+	StartPeriod                 string                    `xml:"startPeriod,attr,omitempty"`
+	EndPeriod                   string                    `xml:"endPeriod,attr,omitempty"`
+	Status                      string                    `xml:"status,attr,omitempty"`
+	Partial                     string                    `xml:"partial,attr,omitempty"`
+	InEffect                    string                    `xml:"inEffect,attr,omitempty"`
+	AnyAttrs                    []xml.Attr                `xml:",any,attr"`
+	Any                         []AnyElement              `xml:",any"`
+	Property                    []PropertyType            `xml:"property"`
+	CoverTitle                  []PropertyType            `xml:"coverTitle"`
+	AmendmentNumber             []AmendmentNumberElement  `xml:"amendmentNumber"`
+	Congress                    []PropertyType            `xml:"congress"`
+	CurrentChamber              []PropertyType            `xml:"currentChamber"`
+	CurrentThroughPublicLaw     []PropertyType            `xml:"currentThroughPublicLaw"`
+	DistributionCode            []PropertyType            `xml:"distributionCode"`
+	DocNumber                   []PropertyType            `xml:"docNumber"`
+	DocPart                     []PropertyType            `xml:"docPart"`
+	DocPublicationName          []PropertyType            `xml:"docPublicationName"`
+	DocReleasePoint             []PropertyType            `xml:"docReleasePoint"`
+	DocStage                    []PropertyType            `xml:"docStage"`
+	EnrolledDateline            []PropertyType            `xml:"enrolledDateline"`
+	Purpose                     []PropertyType            `xml:"purpose"`
+	RelatedDocument             []PropertyType            `xml:"relatedDocument"`
+	Session                     []PropertyType            `xml:"session"`
+	Subject                     []PropertyType            `xml:"subject"`
+	Volume                      []PropertyType            `xml:"volume"`
+	ProvisionRange              []PropertyType            `xml:"provisionRange"`
+	Set                         []SetType                 `xml:"set"`
+	Action                      []ActionElement           `xml:"action"`
+	CoverText                   []CoverTextType           `xml:"coverText"`
+	DraftingOffice              []SimplePropertyType      `xml:"draftingOffice"`
+	Figure                      []FigureType              `xml:"figure"`
+	FirstPageHeading            []FirstPageHeadingType    `xml:"firstPageHeading"`
+	FirstPageSubheading         []FirstPageHeadingType    `xml:"firstPageSubheading"`
+	LeftRunningHead             []RunningHeadType         `xml:"leftRunningHead"`
+	CenterRunningHead           []RunningHeadType         `xml:"centerRunningHead"`
+	RightRunningHead            []RunningHeadType         `xml:"rightRunningHead"`
+	SlugLine                    []RunningHeadType         `xml:"slugLine"`
+	RelatedDocuments            []RelatedDocumentsElement `xml:"relatedDocuments"`
+	StarPrint                   []StarPrintType           `xml:"starPrint"`
+	Notes                       []NotesType               `xml:"notes"`
+	LegislativeHistory          []NotesType               `xml:"legislativeHistory"`
+	Note                        []NoteType                `xml:"note"`
+	Elided                      []ElidedType              `xml:"elided"`
+	SourceCredit                []NoteType                `xml:"sourceCredit"`
+	UscNote                     []NoteType                `xml:"uscNote"`
+	StatutoryNote               []NoteType                `xml:"statutoryNote"`
+	DrafterNote                 []NoteType                `xml:"drafterNote"`
+	EditorialNote               []NoteType                `xml:"editorialNote"`
+	ChangeNote                  []NoteType                `xml:"changeNote"`
+	Authority                   []NoteType                `xml:"authority"`
+	Source                      []NoteType                `xml:"source"`
+	EffectiveDateNote           []NoteType                `xml:"effectiveDateNote"`
+	FrDocID                     []NoteType                `xml:"frDocId"`
+	BillingCode                 []NoteType                `xml:"billingCode"`
+	EditionNote                 []NoteType                `xml:"editionNote"`
+	OrganizationNote            []NoteType                `xml:"organizationNote"`
+	CitationNote                []NoteType                `xml:"citationNote"`
+	ExplanationNote             []NoteType                `xml:"explanationNote"`
+	FindingAidsNote             []NoteType                `xml:"findingAidsNote"`
+	Footnote                    []PositionedNoteType      `xml:"footnote"`
+	Sidenote                    []PositionedNoteType      `xml:"sidenote"`
+	Endnote                     []PositionedNoteType      `xml:"endnote"`
+	Ear                         []PositionedNoteType      `xml:"ear"`
+	P                           []PType                   `xml:"p"`
+	Page                        []PageType                `xml:"page"`
+	Line                        []PageType                `xml:"line"`
+	EditorialContent            []EditorialContentElement `xml:"editorialContent"`
+	Toc                         []ToCIndexType            `xml:"toc"`
+	Index                       []ToCIndexType            `xml:"index"`
+	TableOfTitlesAndChapters    []ToCIndexType            `xml:"tableOfTitlesAndChapters"`
+	ListOfAgencies              []ToCIndexType            `xml:"listOfAgencies"`
+	ListOfSectionsAffected      []ToCIndexType            `xml:"listOfSectionsAffected"`
+	ListOfBillsEnacted          []ToCIndexType            `xml:"listOfBillsEnacted"`
+	ListOfPublicLaws            []ToCIndexType            `xml:"listOfPublicLaws"`
+	ListOfPrivateLaws           []ToCIndexType            `xml:"listOfPrivateLaws"`
+	ListOfConcurrentResolutions []ToCIndexType            `xml:"listOfConcurrentResolutions"`
+	ListOfProclamations         []ToCIndexType            `xml:"listOfProclamations"`
+	PopularNameIndex            []ToCIndexType            `xml:"popularNameIndex"`
+	SubjectIndex                []ToCIndexType            `xml:"subjectIndex"`
+}
+
+// PropertyType is generated from the PropertyType complex type.
+//
+// The property core type is used to record metadata about a document.
+type PropertyType struct {
+	XMLName            xml.Name
+	Text               string               `xml:",chardata"`
+	XMLBase            string               `xml:"http://www.w3.org/XML/1998/namespace base,attr,omitempty"`
+	XMLLang            string               `xml:"http://www.w3.org/XML/1998/namespace lang,attr,omitempty"`
+	XMLSpace           string               `xml:"http://www.w3.org/XML/1998/namespace space,attr,omitempty"`
+	ID                 string               `xml:"id,attr,omitempty"`
+	TemporalID         string               `xml:"temporalId,attr,omitempty"`
+	Identifier         string               `xml:"identifier,attr,omitempty"`
+	Scope              string               `xml:"scope,attr,omitempty"`
+	Role               string               `xml:"role,attr,omitempty"`
+	Class              string               `xml:"class,attr,omitempty"`
+	Style              string               `xml:"style,attr,omitempty"`
+	StyleType          string               `xml:"styleType,attr,omitempty"`
+	NoteAttr           string               `xml:"note,attr,omitempty"`
+	Alt                string               `xml:"alt,attr,omitempty"`
+	Meta               string               `xml:"meta,attr,omitempty"`
+	Misc               string               `xml:"misc,attr,omitempty"`
+	DraftingTip        string               `xml:"draftingTip,attr,omitempty"`
+	CodificationTip    string               `xml:"codificationTip,attr,omitempty"`
+	Title              string               `xml:"title,attr,omitempty"`
+	Brief              string               `xml:"brief,attr,omitempty"`
+	SortOrder          string               `xml:"sortOrder,attr,omitempty"`
+	StartPeriod        string               `xml:"startPeriod,attr,omitempty"`
+	EndPeriod          string               `xml:"endPeriod,attr,omitempty"`
+	Status             string               `xml:"status,attr,omitempty"`
+	Partial            string               `xml:"partial,attr,omitempty"`
+	InEffect           string               `xml:"inEffect,attr,omitempty"`
+	AnyAttrs           []xml.Attr           `xml:",any,attr"`
+	Marker             []MarkerType         `xml:"marker"`
+	Br                 []BrType             `xml:"br"`
+	Img                []ImgType            `xml:"img"`
+	Inline             []InlineType         `xml:"inline"`
+	AmendingAction     []AmendingActionType `xml:"amendingAction"`
+	Ref                []RefType            `xml:"ref"`
+	Date               []DateType           `xml:"date"`
+	Center             []InlineType         `xml:"center"`
+	FillIn             []InlineType         `xml:"fillIn"`
+	CheckBox           []InlineType         `xml:"checkBox"`
+	B                  []InlineType         `xml:"b"`
+	I                  []InlineType         `xml:"i"`
+	Qualifier          []InlineType         `xml:"qualifier"`
+	Sub                []InlineType         `xml:"sub"`
+	Sup                []InlineType         `xml:"sup"`
+	HeadingText        []InlineType         `xml:"headingText"`
+	Span               []InlineType         `xml:"span"`
+	ShortTitle         []InlineType         `xml:"shortTitle"`
+	Term               []InlineType         `xml:"term"`
+	Entity             []InlineType         `xml:"entity"`
+	Footnote           []PositionedNoteType `xml:"footnote"`
+	Sidenote           []PositionedNoteType `xml:"sidenote"`
+	Endnote            []PositionedNoteType `xml:"endnote"`
+	Ear                []PositionedNoteType `xml:"ear"`
+	Note               []NoteType           `xml:"note"`
+	Elided             []ElidedType         `xml:"elided"`
+	SourceCredit       []NoteType           `xml:"sourceCredit"`
+	UscNote            []NoteType           `xml:"uscNote"`
+	StatutoryNote      []NoteType           `xml:"statutoryNote"`
+	DrafterNote        []NoteType           `xml:"drafterNote"`
+	EditorialNote      []NoteType           `xml:"editorialNote"`
+	ChangeNote         []NoteType           `xml:"changeNote"`
+	Authority          []NoteType           `xml:"authority"`
+	Source             []NoteType           `xml:"source"`
+	EffectiveDateNote  []NoteType           `xml:"effectiveDateNote"`
+	FrDocID            []NoteType           `xml:"frDocId"`
+	BillingCode        []NoteType           `xml:"billingCode"`
+	EditionNote        []NoteType           `xml:"editionNote"`
+	OrganizationNote   []NoteType           `xml:"organizationNote"`
+	CitationNote       []NoteType           `xml:"citationNote"`
+	ExplanationNote    []NoteType           `xml:"explanationNote"`
+	FindingAidsNote    []NoteType           `xml:"findingAidsNote"`
+	Notes              []NotesType          `xml:"notes"`
+	LegislativeHistory []NotesType          `xml:"legislativeHistory"`
+	Affected           []AffectedElement    `xml:"affected"`
+	Page               []PageType           `xml:"page"`
+	Line               []PageType           `xml:"line"`
+	DateAttr           string               `xml:"date,attr,omitempty"`
+	LegisDate          string               `xml:"legisDate,attr,omitempty"`
+	StartDate          string               `xml:"startDate,attr,omitempty"`
+	EndDate            string               `xml:"endDate,attr,omitempty"`
+	Display            string               `xml:"display,attr,omitempty"`
+	Value              string               `xml:"value,attr,omitempty"`
+	StartValue         string               `xml:"startValue,attr,omitempty"`
+	EndValue           string               `xml:"endValue,attr,omitempty"`
+	Href               string               `xml:"href,attr,omitempty"`
+	Idref              string               `xml:"idref,attr,omitempty"`
+	Portion            string               `xml:"portion,attr,omitempty"`
+	Type               string               `xml:"type,attr,omitempty"`
+}
+
+// ProvisoType is generated from the ProvisoType complex type.
+//
+// A proviso is a sentence, clause, or paragraph that states conditions
+// relating to the law it is related to.
+type ProvisoType struct {
+	XMLName            xml.Name
+	Text               string               `xml:",chardata"`
+	Br                 []BrType             `xml:"br"`
+	Inline             []InlineType         `xml:"inline"`
+	AmendingAction     []AmendingActionType `xml:"amendingAction"`
+	Ref                []RefType            `xml:"ref"`
+	Date               []DateType           `xml:"date"`
+	Center             []InlineType         `xml:"center"`
+	FillIn             []InlineType         `xml:"fillIn"`
+	CheckBox           []InlineType         `xml:"checkBox"`
+	B                  []InlineType         `xml:"b"`
+	I                  []InlineType         `xml:"i"`
+	Qualifier          []InlineType         `xml:"qualifier"`
+	Sub                []InlineType         `xml:"sub"`
+	Sup                []InlineType         `xml:"sup"`
+	HeadingText        []InlineType         `xml:"headingText"`
+	Span               []InlineType         `xml:"span"`
+	ShortTitle         []InlineType         `xml:"shortTitle"`
+	Term               []InlineType         `xml:"term"`
+	Entity             []InlineType         `xml:"entity"`
+	List               []ListType           `xml:"list"`
+	P                  []PType              `xml:"p"`
+	Page               []PageType           `xml:"page"`
+	Line               []PageType           `xml:"line"`
+	Note               []NoteType           `xml:"note"`
+	Elided             []ElidedType         `xml:"elided"`
+	SourceCredit       []NoteType           `xml:"sourceCredit"`
+	UscNote            []NoteType           `xml:"uscNote"`
+	StatutoryNote      []NoteType           `xml:"statutoryNote"`
+	DrafterNote        []NoteType           `xml:"drafterNote"`
+	EditorialNote      []NoteType           `xml:"editorialNote"`
+	ChangeNote         []NoteType           `xml:"changeNote"`
+	Authority          []NoteType           `xml:"authority"`
+	Source             []NoteType           `xml:"source"`
+	EffectiveDateNote  []NoteType           `xml:"effectiveDateNote"`
+	FrDocID            []NoteType           `xml:"frDocId"`
+	BillingCode        []NoteType           `xml:"billingCode"`
+	EditionNote        []NoteType           `xml:"editionNote"`
+	OrganizationNote   []NoteType           `xml:"organizationNote"`
+	CitationNote       []NoteType           `xml:"citationNote"`
+	ExplanationNote    []NoteType           `xml:"explanationNote"`
+	FindingAidsNote    []NoteType           `xml:"findingAidsNote"`
+	Notes              []NotesType          `xml:"notes"`
+	LegislativeHistory []NotesType          `xml:"legislativeHistory"`
+	Footnote           []PositionedNoteType `xml:"footnote"`
+	Sidenote           []PositionedNoteType `xml:"sidenote"`
+	Endnote            []PositionedNoteType `xml:"endnote"`
+	Ear                []PositionedNoteType `xml:"ear"`
+	QuotedContent      []QuotedContentType  `xml:"quotedContent"`
+	QuotedText         []QuotedTextType     `xml:"quotedText"`
+	AddedText          []QuotedTextType     `xml:"addedText"`
+	DeletedText        []QuotedTextType     `xml:"deletedText"`
+	Del                []QuotedTextType     `xml:"del"`
+	Ins                []QuotedTextType     `xml:"ins"`
+	XMLBase            string               `xml:"http://www.w3.org/XML/1998/namespace base,attr,omitempty"`
+	XMLLang            string               `xml:"http://www.w3.org/XML/1998/namespace lang,attr,omitempty"`
+	XMLSpace           string               `xml:"http://www.w3.org/XML/1998/namespace space,attr,omitempty"`
+	Role               string               `xml:"role,attr,omitempty"`
+	Class              string               `xml:"class,attr,omitempty"`
+	Style              string               `xml:"style,attr,omitempty"`
+	StyleType          string               `xml:"styleType,attr,omitempty"`
+	ID                 string               `xml:"id,attr,omitempty"`
+	TemporalID         string               `xml:"temporalId,attr,omitempty"`
+	Identifier         string               `xml:"identifier,attr,omitempty"`
+	Scope              string               `xml:"scope,attr,omitempty"`
+}
+
+// QuotedContentType is generated from the QuotedContentType complex type.
+//
+// A QuotedContentType is used for an extraction of potentially structured text
+// (text with XML elements) from another source or origin.
+type QuotedContentType struct {
+	XMLName         xml.Name
+	Text            string       `xml:",chardata"`
+	XMLBase         string       `xml:"http://www.w3.org/XML/1998/namespace base,attr,omitempty"`
+	XMLLang         string       `xml:"http://www.w3.org/XML/1998/namespace lang,attr,omitempty"`
+	XMLSpace        string       `xml:"http://www.w3.org/XML/1998/namespace space,attr,omitempty"`
+	ID              string       `xml:"id,attr,omitempty"`
+	TemporalID      string       `xml:"temporalId,attr,omitempty"`
+	Identifier      string       `xml:"identifier,attr,omitempty"`
+	Scope           string       `xml:"scope,attr,omitempty"`
+	Role            string       `xml:"role,attr,omitempty"`
+	Class           string       `xml:"class,attr,omitempty"`
+	Style           string       `xml:"style,attr,omitempty"`
+	StyleType       string       `xml:"styleType,attr,omitempty"`
+	Note            string       `xml:"note,attr,omitempty"`
+	Alt             string       `xml:"alt,attr,omitempty"`
+	Meta            string       `xml:"meta,attr,omitempty"`
+	Misc            string       `xml:"misc,attr,omitempty"`
+	DraftingTip     string       `xml:"draftingTip,attr,omitempty"`
+	CodificationTip string       `xml:"codificationTip,attr,omitempty"`
+	Title           string       `xml:"title,attr,omitempty"`
+	Brief           string       `xml:"brief,attr,omitempty"`
+	SortOrder       string       `xml:"sortOrder,attr,omitempty"`
+	StartPeriod     string       `xml:"startPeriod,attr,omitempty"`
+	EndPeriod       string       `xml:"endPeriod,attr,omitempty"`
+	Status          string       `xml:"status,attr,omitempty"`
+	Partial         string       `xml:"partial,attr,omitempty"`
+	InEffect        string       `xml:"inEffect,attr,omitempty"`
+	AnyAttrs        []xml.Attr   `xml:",any,attr"`
+	Any             []AnyElement `xml:",any"`
+	XhtmlImg        []AnyElement `xml:"http://www.w3.org/1999/xhtml img"`
+	XhtmlTable      []AnyElement `xml:"http://www.w3.org/1999/xhtml table"`
+	MathmlMath      []AnyElement `xml:"http://www.w3.org/1998/Math/MathML math"`
+	Orientation     string       `xml:"orientation,attr,omitempty"`
+	Changed         string       `xml:"changed,attr,omitempty"`
+	Origin          string       `xml:"origin,attr,omitempty"`
+}
+
+// QuotedTextType is generated from the QuotedTextType complex type.
+//
+// A quoted text type is an extraction of simple text from another source or
+// origin.
+type QuotedTextType struct {
+	XMLName            xml.Name
+	Text               string               `xml:",chardata"`
+	XMLBase            string               `xml:"http://www.w3.org/XML/1998/namespace base,attr,omitempty"`
+	XMLLang            string               `xml:"http://www.w3.org/XML/1998/namespace lang,attr,omitempty"`
+	XMLSpace           string               `xml:"http://www.w3.org/XML/1998/namespace space,attr,omitempty"`
+	ID                 string               `xml:"id,attr,omitempty"`
+	TemporalID         string               `xml:"temporalId,attr,omitempty"`
+	Identifier         string               `xml:"identifier,attr,omitempty"`
+	Scope              string               `xml:"scope,attr,omitempty"`
+	Role               string               `xml:"role,attr,omitempty"`
+	Class              string               `xml:"class,attr,omitempty"`
+	Style              string               `xml:"style,attr,omitempty"`
+	StyleType          string               `xml:"styleType,attr,omitempty"`
+	NoteAttr           string               `xml:"note,attr,omitempty"`
+	Alt                string               `xml:"alt,attr,omitempty"`
+	Meta               string               `xml:"meta,attr,omitempty"`
+	Misc               string               `xml:"misc,attr,omitempty"`
+	DraftingTip        string               `xml:"draftingTip,attr,omitempty"`
+	CodificationTip    string               `xml:"codificationTip,attr,omitempty"`
+	Title              string               `xml:"title,attr,omitempty"`
+	Brief              string               `xml:"brief,attr,omitempty"`
+	SortOrder          string               `xml:"sortOrder,attr,omitempty"`
+	StartPeriod        string               `xml:"startPeriod,attr,omitempty"`
+	EndPeriod          string               `xml:"endPeriod,attr,omitempty"`
+	Status             string               `xml:"status,attr,omitempty"`
+	Partial            string               `xml:"partial,attr,omitempty"`
+	InEffect           string               `xml:"inEffect,attr,omitempty"`
+	AnyAttrs           []xml.Attr           `xml:",any,attr"`
+	Marker             []MarkerType         `xml:"marker"`
+	Br                 []BrType             `xml:"br"`
+	Img                []ImgType            `xml:"img"`
+	Inline             []InlineType         `xml:"inline"`
+	AmendingAction     []AmendingActionType `xml:"amendingAction"`
+	Ref                []RefType            `xml:"ref"`
+	Date               []DateType           `xml:"date"`
+	Center             []InlineType         `xml:"center"`
+	FillIn             []InlineType         `xml:"fillIn"`
+	CheckBox           []InlineType         `xml:"checkBox"`
+	B                  []InlineType         `xml:"b"`
+	I                  []InlineType         `xml:"i"`
+	Qualifier          []InlineType         `xml:"qualifier"`
+	Sub                []InlineType         `xml:"sub"`
+	Sup                []InlineType         `xml:"sup"`
+	HeadingText        []InlineType         `xml:"headingText"`
+	Span               []InlineType         `xml:"span"`
+	ShortTitle         []InlineType         `xml:"shortTitle"`
+	Term               []InlineType         `xml:"term"`
+	Entity             []InlineType         `xml:"entity"`
+	Committee          []CommitteeElement   `xml:"committee"`
+	Organization       []InlinePropertyType `xml:"organization"`
+	Note               []NoteType           `xml:"note"`
+	Elided             []ElidedType         `xml:"elided"`
+	SourceCredit       []NoteType           `xml:"sourceCredit"`
+	UscNote            []NoteType           `xml:"uscNote"`
+	StatutoryNote      []NoteType           `xml:"statutoryNote"`
+	DrafterNote        []NoteType           `xml:"drafterNote"`
+	EditorialNote      []NoteType           `xml:"editorialNote"`
+	ChangeNote         []NoteType           `xml:"changeNote"`
+	Authority          []NoteType           `xml:"authority"`
+	Source             []NoteType           `xml:"source"`
+	EffectiveDateNote  []NoteType           `xml:"effectiveDateNote"`
+	FrDocID            []NoteType           `xml:"frDocId"`
+	BillingCode        []NoteType           `xml:"billingCode"`
+	EditionNote        []NoteType           `xml:"editionNote"`
+	OrganizationNote   []NoteType           `xml:"organizationNote"`
+	CitationNote       []NoteType           `xml:"citationNote"`
+	ExplanationNote    []NoteType           `xml:"explanationNote"`
+	FindingAidsNote    []NoteType           `xml:"findingAidsNote"`
+	Notes              []NotesType          `xml:"notes"`
+	LegislativeHistory []NotesType          `xml:"legislativeHistory"`
+	Footnote           []PositionedNoteType `xml:"footnote"`
+	Sidenote           []PositionedNoteType `xml:"sidenote"`
+	Endnote            []PositionedNoteType `xml:"endnote"`
+	Ear                []PositionedNoteType `xml:"ear"`
+	Page               []PageType           `xml:"page"`
+	Line               []PageType           `xml:"line"`
+	Proviso            []ProvisoType        `xml:"proviso"`
+	QuotedText         []QuotedTextType     `xml:"quotedText"`
+	AddedText          []QuotedTextType     `xml:"addedText"`
+	DeletedText        []QuotedTextType     `xml:"deletedText"`
+	Del                []QuotedTextType     `xml:"del"`
+	Ins                []QuotedTextType     `xml:"ins"`
+	Origin             string               `xml:"origin,attr,omitempty"`
+}
+
+// RecitalElement is generated from the anonymous type of the recital element.
+//
+// A recital is a preliminary statement in a bill stating the reasons for the
+// Bill.
+type RecitalElement struct {
+	XMLName             xml.Name
+	Text                string               `xml:",chardata"`
+	Block               []BlockType          `xml:"block"`
+	Signatures          []SignaturesType     `xml:"signatures"`
+	Fragment            []BlockType          `xml:"fragment"`
+	Level               []LevelType          `xml:"level"`
+	Preliminary         []LevelType          `xml:"preliminary"`
+	Title               []LevelType          `xml:"title"`
+	Subtitle            []LevelType          `xml:"subtitle"`
+	Part                []LevelType          `xml:"part"`
+	Subpart             []LevelType          `xml:"subpart"`
+	Division            []LevelType          `xml:"division"`
+	Subdivision         []LevelType          `xml:"subdivision"`
+	Chapter             []LevelType          `xml:"chapter"`
+	Subchapter          []LevelType          `xml:"subchapter"`
+	Article             []LevelType          `xml:"article"`
+	Subarticle          []LevelType          `xml:"subarticle"`
+	CompiledAct         []LevelType          `xml:"compiledAct"`
+	CourtRules          []LevelType          `xml:"courtRules"`
+	CourtRule           []LevelType          `xml:"courtRule"`
+	ReorganizationPlans []LevelType          `xml:"reorganizationPlans"`
+	ReorganizationPlan  []LevelType          `xml:"reorganizationPlan"`
+	Section             []LevelType          `xml:"section"`
+	Subsection          []LevelType          `xml:"subsection"`
+	Paragraph           []LevelType          `xml:"paragraph"`
+	Subparagraph        []LevelType          `xml:"subparagraph"`
+	Clause              []LevelType          `xml:"clause"`
+	Subclause           []LevelType          `xml:"subclause"`
+	Item                []LevelType          `xml:"item"`
+	Subitem             []LevelType          `xml:"subitem"`
+	Subsubitem          []LevelType          `xml:"subsubitem"`
+	QuotedText          []QuotedTextType     `xml:"quotedText"`
+	AddedText           []QuotedTextType     `xml:"addedText"`
+	DeletedText         []QuotedTextType     `xml:"deletedText"`
+	Del                 []QuotedTextType     `xml:"del"`
+	Ins                 []QuotedTextType     `xml:"ins"`
+	QuotedContent       []QuotedContentType  `xml:"quotedContent"`
+	Statement           []StatementType      `xml:"statement"`
+	LongTitle           []LongTitleElement   `xml:"longTitle"`
+	DocTitle            []StatementType      `xml:"docTitle"`
+	OfficialTitle       []StatementType      `xml:"officialTitle"`
+	XhtmlTable          []AnyElement         `xml:"http://www.w3.org/1999/xhtml table"`
+	Marker              []MarkerType         `xml:"marker"`
+	Br                  []BrType             `xml:"br"`
+	Img                 []ImgType            `xml:"img"`
+	Inline              []InlineType         `xml:"inline"`
+	AmendingAction      []AmendingActionType `xml:"amendingAction"`
+	Ref                 []RefType            `xml:"ref"`
+	Date                []DateType           `xml:"date"`
+	Center              []InlineType         `xml:"center"`
+	FillIn              []InlineType         `xml:"fillIn"`
+	CheckBox            []InlineType         `xml:"checkBox"`
+	B                   []InlineType         `xml:"b"`
+	I                   []InlineType         `xml:"i"`
+	Qualifier           []InlineType         `xml:"qualifier"`
+	Sub                 []InlineType         `xml:"sub"`
+	Sup                 []InlineType         `xml:"sup"`
+	HeadingText         []InlineType         `xml:"headingText"`
+	Span                []InlineType         `xml:"span"`
+	ShortTitle          []InlineType         `xml:"shortTitle"`
+	Term                []InlineType         `xml:"term"`
+	Entity              []InlineType         `xml:"entity"`
+	Footnote            []PositionedNoteType `xml:"footnote"`
+	Sidenote            []PositionedNoteType `xml:"sidenote"`
+	Endnote             []PositionedNoteType `xml:"endnote"`
+	Ear                 []PositionedNoteType `xml:"ear"`
+	Note                []NoteType           `xml:"note"`
+	Elided              []ElidedType         `xml:"elided"`
+	SourceCredit        []NoteType           `xml:"sourceCredit"`
+	UscNote             []NoteType           `xml:"uscNote"`
+	StatutoryNote       []NoteType           `xml:"statutoryNote"`
+	DrafterNote         []NoteType           `xml:"drafterNote"`
+	EditorialNote       []NoteType           `xml:"editorialNote"`
+	ChangeNote          []NoteType           `xml:"changeNote"`
+	Authority           []NoteType           `xml:"authority"`
+	Source              []NoteType           `xml:"source"`
+	EffectiveDateNote   []NoteType           `xml:"effectiveDateNote"`
+	FrDocID             []NoteType           `xml:"frDocId"`
+	BillingCode         []NoteType           `xml:"billingCode"`
+	EditionNote         []NoteType           `xml:"editionNote"`
+	OrganizationNote    []NoteType           `xml:"organizationNote"`
+	CitationNote        []NoteType           `xml:"citationNote"`
+	ExplanationNote     []NoteType           `xml:"explanationNote"`
+	FindingAidsNote     []NoteType           `xml:"findingAidsNote"`
+	P                   []PType              `xml:"p"`
+	Page                []PageType           `xml:"page"`
+	Line                []PageType           `xml:"line"`
+	Role                string               `xml:"role,attr,omitempty"`
+	Class               string               `xml:"class,attr,omitempty"`
+	Style               string               `xml:"style,attr,omitempty"`
+	StyleType           string               `xml:"styleType,attr,omitempty"`
+	ID                  string               `xml:"id,attr,omitempty"`
+	TemporalID          string               `xml:"temporalId,attr,omitempty"`
+	Identifier          string               `xml:"identifier,attr,omitempty"`
+	Scope               string               `xml:"scope,attr,omitempty"`
+	Changed             string               `xml:"changed,attr,omitempty"`
+	Origin              string               `xml:"origin,attr,omitempty"`
+}
+
+// RefType is generated from the RefType complex type.
+//
+// The ref type is used to denote references within the text of a document.
+type RefType struct {
+	XMLName            xml.Name
+	Text               string               `xml:",chardata"`
+	XMLBase            string               `xml:"http://www.w3.org/XML/1998/namespace base,attr,omitempty"`
+	XMLLang            string               `xml:"http://www.w3.org/XML/1998/namespace lang,attr,omitempty"`
+	XMLSpace           string               `xml:"http://www.w3.org/XML/1998/namespace space,attr,omitempty"`
+	ID                 string               `xml:"id,attr,omitempty"`
+	TemporalID         string               `xml:"temporalId,attr,omitempty"`
+	Identifier         string               `xml:"identifier,attr,omitempty"`
+	Scope              string               `xml:"scope,attr,omitempty"`
+	Role               string               `xml:"role,attr,omitempty"`
+	Class              string               `xml:"class,attr,omitempty"`
+	Style              string               `xml:"style,attr,omitempty"`
+	StyleType          string               `xml:"styleType,attr,omitempty"`
+	NoteAttr           string               `xml:"note,attr,omitempty"`
+	Alt                string               `xml:"alt,attr,omitempty"`
+	Meta               string               `xml:"meta,attr,omitempty"`
+	Misc               string               `xml:"misc,attr,omitempty"`
+	DraftingTip        string               `xml:"draftingTip,attr,omitempty"`
+	CodificationTip    string               `xml:"codificationTip,attr,omitempty"`
+	Title              string               `xml:"title,attr,omitempty"`
+	Brief              string               `xml:"brief,attr,omitempty"`
+	SortOrder          string               `xml:"sortOrder,attr,omitempty"`
+	StartPeriod        string               `xml:"startPeriod,attr,omitempty"`
+	EndPeriod          string               `xml:"endPeriod,attr,omitempty"`
+	Status             string               `xml:"status,attr,omitempty"`
+	Partial            string               `xml:"partial,attr,omitempty"`
+	InEffect           string               `xml:"inEffect,attr,omitempty"`
+	AnyAttrs           []xml.Attr           `xml:",any,attr"`
+	Marker             []MarkerType         `xml:"marker"`
+	Br                 []BrType             `xml:"br"`
+	Img                []ImgType            `xml:"img"`
+	Inline             []InlineType         `xml:"inline"`
+	AmendingAction     []AmendingActionType `xml:"amendingAction"`
+	Ref                []RefType            `xml:"ref"`
+	Date               []DateType           `xml:"date"`
+	Center             []InlineType         `xml:"center"`
+	FillIn             []InlineType         `xml:"fillIn"`
+	CheckBox           []InlineType         `xml:"checkBox"`
+	B                  []InlineType         `xml:"b"`
+	I                  []InlineType         `xml:"i"`
+	Qualifier          []InlineType         `xml:"qualifier"`
+	Sub                []InlineType         `xml:"sub"`
+	Sup                []InlineType         `xml:"sup"`
+	HeadingText        []InlineType         `xml:"headingText"`
+	Span               []InlineType         `xml:"span"`
+	ShortTitle         []InlineType         `xml:"shortTitle"`
+	Term               []InlineType         `xml:"term"`
+	Entity             []InlineType         `xml:"entity"`
+	Committee          []CommitteeElement   `xml:"committee"`
+	Organization       []InlinePropertyType `xml:"organization"`
+	Note               []NoteType           `xml:"note"`
+	Elided             []ElidedType         `xml:"elided"`
+	SourceCredit       []NoteType           `xml:"sourceCredit"`
+	UscNote            []NoteType           `xml:"uscNote"`
+	StatutoryNote      []NoteType           `xml:"statutoryNote"`
+	DrafterNote        []NoteType           `xml:"drafterNote"`
+	EditorialNote      []NoteType           `xml:"editorialNote"`
+	ChangeNote         []NoteType           `xml:"changeNote"`
+	Authority          []NoteType           `xml:"authority"`
+	Source             []NoteType           `xml:"source"`
+	EffectiveDateNote  []NoteType           `xml:"effectiveDateNote"`
+	FrDocID            []NoteType           `xml:"frDocId"`
+	BillingCode        []NoteType           `xml:"billingCode"`
+	EditionNote        []NoteType           `xml:"editionNote"`
+	OrganizationNote   []NoteType           `xml:"organizationNote"`
+	CitationNote       []NoteType           `xml:"citationNote"`
+	ExplanationNote    []NoteType           `xml:"explanationNote"`
+	FindingAidsNote    []NoteType           `xml:"findingAidsNote"`
+	Notes              []NotesType          `xml:"notes"`
+	LegislativeHistory []NotesType          `xml:"legislativeHistory"`
+	Footnote           []PositionedNoteType `xml:"footnote"`
+	Sidenote           []PositionedNoteType `xml:"sidenote"`
+	Endnote            []PositionedNoteType `xml:"endnote"`
+	Ear                []PositionedNoteType `xml:"ear"`
+	Page               []PageType           `xml:"page"`
+	Line               []PageType           `xml:"line"`
+	Proviso            []ProvisoType        `xml:"proviso"`
+	QuotedText         []QuotedTextType     `xml:"quotedText"`
+	AddedText          []QuotedTextType     `xml:"addedText"`
+	DeletedText        []QuotedTextType     `xml:"deletedText"`
+	Del                []QuotedTextType     `xml:"del"`
+	Ins                []QuotedTextType     `xml:"ins"`
+	Pos                string               `xml:"pos,attr,omitempty"`
+	PosText            string               `xml:"posText,attr,omitempty"`
+	PosCount           string               `xml:"posCount,attr,omitempty"`
+	DateAttr           string               `xml:"date,attr,omitempty"`
+	LegisDate          string               `xml:"legisDate,attr,omitempty"`
+	StartDate          string               `xml:"startDate,attr,omitempty"`
+	EndDate            string               `xml:"endDate,attr,omitempty"`
+	Value              string               `xml:"value,attr,omitempty"`
+	StartValue         string               `xml:"startValue,attr,omitempty"`
+	EndValue           string               `xml:"endValue,attr,omitempty"`
+	Href               string               `xml:"href,attr,omitempty"`
+	Idref              string               `xml:"idref,attr,omitempty"`
+	Portion            string               `xml:"portion,attr,omitempty"`
+	Type               string               `xml:"type,attr,omitempty"`
+}
+
+// RelatedDocumentsElement is generated from the anonymous type of the
+// relatedDocuments element.
+//
+// The relatedDocuments is used in the preface and meta to hold a set of
+// relatedDocument elements that are related to each other.
+type RelatedDocumentsElement struct {
+	XMLName         xml.Name
+	Text            string         `xml:",chardata"`
+	RelatedDocument []PropertyType `xml:"relatedDocument"`
+}
+
+// RowType is generated from the RowType complex type.
+//
+// A row type denotes a row entry within a layout structure.
+type RowType struct {
+	XMLName         xml.Name
+	XMLBase         string       `xml:"http://www.w3.org/XML/1998/namespace base,attr,omitempty"`
+	XMLLang         string       `xml:"http://www.w3.org/XML/1998/namespace lang,attr,omitempty"`
+	XMLSpace        string       `xml:"http://www.w3.org/XML/1998/namespace space,attr,omitempty"`
+	ID              string       `xml:"id,attr,omitempty"`
+	TemporalID      string       `xml:"temporalId,attr,omitempty"`
+	Identifier      string       `xml:"identifier,attr,omitempty"`
+	Scope           string       `xml:"scope,attr,omitempty"`
+	Role            string       `xml:"role,attr,omitempty"`
+	Class           string       `xml:"class,attr,omitempty"`
+	Style           string       `xml:"style,attr,omitempty"`
+	StyleType       string       `xml:"styleType,attr,omitempty"`
+	Note            string       `xml:"note,attr,omitempty"`
+	Alt             string       `xml:"alt,attr,omitempty"`
+	Meta            string       `xml:"meta,attr,omitempty"`
+	Misc            string       `xml:"misc,attr,omitempty"`
+	DraftingTip     string       `xml:"draftingTip,attr,omitempty"`
+	CodificationTip string       `xml:"codificationTip,attr,omitempty"`
+	Title           string       `xml:"title,attr,omitempty"`
+	Brief           string       `xml:"brief,attr,omitempty"`
+	SortOrder       string       `xml:"sortOrder,attr,omitempty"`
+	StartPeriod     string       `xml:"startPeriod,attr,omitempty"`
+	EndPeriod       string       `xml:"endPeriod,attr,omitempty"`
+	Status          string       `xml:"status,attr,omitempty"`
+	Partial         string       `xml:"partial,attr,omitempty"`
+	InEffect        string       `xml:"inEffect,attr,omitempty"`
+	AnyAttrs        []xml.Attr   `xml:",any,attr"`
+	Column          []ColumnType `xml:"column"`
+}
+
+// RulePreambleType is generated from the RulePreambleType complex type.
+//
+// The RulePreambleType is the container for statements, signature blocks, and
+// the words of issuance for a Federal Register entry.
+type RulePreambleType struct {
+	XMLName            xml.Name
+	XMLBase            string             `xml:"http://www.w3.org/XML/1998/namespace base,attr,omitempty"`
+	XMLLang            string             `xml:"http://www.w3.org/XML/1998/namespace lang,attr,omitempty"`
+	XMLSpace           string             `xml:"http://www.w3.org/XML/1998/namespace space,attr,omitempty"`
+	ID                 string             `xml:"id,attr,omitempty"`
+	TemporalID         string             `xml:"temporalId,attr,omitempty"`
+	Identifier         string             `xml:"identifier,attr,omitempty"`
+	Scope              string             `xml:"scope,attr,omitempty"`
+	Role               string             `xml:"role,attr,omitempty"`
+	Class              string             `xml:"class,attr,omitempty"`
+	Style              string             `xml:"style,attr,omitempty"`
+	StyleType          string             `xml:"styleType,attr,omitempty"`
+	NoteAttr           string             `xml:"note,attr,omitempty"`
+	Alt                string             `xml:"alt,attr,omitempty"`
+	Meta               string             `xml:"meta,attr,omitempty"`
+	Misc               string             `xml:"misc,attr,omitempty"`
+	DraftingTip        string             `xml:"draftingTip,attr,omitempty"`
+	CodificationTip    string             `xml:"codificationTip,attr,omitempty"`
+	Title              string             `xml:"title,attr,omitempty"`
+	Brief              string             `xml:"brief,attr,omitempty"`
+	SortOrder          string             `xml:"sortOrder,attr,omitempty"`
+	StartPeriod        string             `xml:"startPeriod,attr,omitempty"`
+	EndPeriod          string             `xml:"endPeriod,attr,omitempty"`
+	Status             string             `xml:"status,attr,omitempty"`
+	Partial            string             `xml:"partial,attr,omitempty"`
+	InEffect           string             `xml:"inEffect,attr,omitempty"`
+	AnyAttrs           []xml.Attr         `xml:",any,attr"`
+	Statement          []StatementType    `xml:"statement"`
+	LongTitle          []LongTitleElement `xml:"longTitle"`
+	DocTitle           []StatementType    `xml:"docTitle"`
+	OfficialTitle      []StatementType    `xml:"officialTitle"`
+	Note               []NoteType         `xml:"note"`
+	Elided             []ElidedType       `xml:"elided"`
+	SourceCredit       []NoteType         `xml:"sourceCredit"`
+	UscNote            []NoteType         `xml:"uscNote"`
+	StatutoryNote      []NoteType         `xml:"statutoryNote"`
+	DrafterNote        []NoteType         `xml:"drafterNote"`
+	EditorialNote      []NoteType         `xml:"editorialNote"`
+	ChangeNote         []NoteType         `xml:"changeNote"`
+	Authority          []NoteType         `xml:"authority"`
+	Source             []NoteType         `xml:"source"`
+	EffectiveDateNote  []NoteType         `xml:"effectiveDateNote"`
+	FrDocID            []NoteType         `xml:"frDocId"`
+	BillingCode        []NoteType         `xml:"billingCode"`
+	EditionNote        []NoteType         `xml:"editionNote"`
+	OrganizationNote   []NoteType         `xml:"organizationNote"`
+	CitationNote       []NoteType         `xml:"citationNote"`
+	ExplanationNote    []NoteType         `xml:"explanationNote"`
+	FindingAidsNote    []NoteType         `xml:"findingAidsNote"`
+	Notes              []NotesType        `xml:"notes"`
+	LegislativeHistory []NotesType        `xml:"legislativeHistory"`
+	Signatures         []SignaturesType   `xml:"signatures"`
+	WordsOfIssuance    []StatementType    `xml:"wordsOfIssuance"`
+}
+
+// RunningHeadType is generated from the RunningHeadType complex type.
+//
+// The content model of a RunningHeadType element is a string, with additional
+// attributes to specify display attributes.
+type RunningHeadType struct {
+	XMLName           xml.Name
+	Text              string `xml:",chardata"`
+	Role              string `xml:"role,attr,omitempty"`
+	Class             string `xml:"class,attr,omitempty"`
+	Style             string `xml:"style,attr,omitempty"`
+	StyleType         string `xml:"styleType,attr,omitempty"`
+	Display           string `xml:"display,attr,omitempty"`
+	RenderingPosition string `xml:"renderingPosition,attr,omitempty"`
+	RelativeTo        string `xml:"relativeTo,attr,omitempty"`
+}
+
+// SetType is generated from the SetType complex type.
+//
+// The set type is the core type for a group of properties.
+type SetType struct {
+	XMLName                 xml.Name
+	XMLBase                 string                   `xml:"http://www.w3.org/XML/1998/namespace base,attr,omitempty"`
+	XMLLang                 string                   `xml:"http://www.w3.org/XML/1998/namespace lang,attr,omitempty"`
+	XMLSpace                string                   `xml:"http://www.w3.org/XML/1998/namespace space,attr,omitempty"`
+	ID                      string                   `xml:"id,attr,omitempty"`
+	TemporalID              string                   `xml:"temporalId,attr,omitempty"`
+	Identifier              string                   `xml:"identifier,attr,omitempty"`
+	Scope                   string                   `xml:"scope,attr,omitempty"`
+	Role                    string                   `xml:"role,attr,omitempty"`
+	Class                   string                   `xml:"class,attr,omitempty"`
+	Style                   string                   `xml:"style,attr,omitempty"`
+	StyleType               string                   `xml:"styleType,attr,omitempty"`
+	Note                    string                   `xml:"note,attr,omitempty"`
+	Alt                     string                   `xml:"alt,attr,omitempty"`
+	Meta                    string                   `xml:"meta,attr,omitempty"`
+	Misc                    string                   `xml:"misc,attr,omitempty"`
+	DraftingTip             string                   `xml:"draftingTip,attr,omitempty"`
+	CodificationTip         string                   `xml:"codificationTip,attr,omitempty"`
+	Title                   string                   `xml:"title,attr,omitempty"`
+	Brief                   string                   `xml:"brief,attr,omitempty"`
+	SortOrder               string                   `xml:"sortOrder,attr,omitempty"`
+	StartPeriod             string                   `xml:"startPeriod,attr,omitempty"`
+	EndPeriod               string                   `xml:"endPeriod,attr,omitempty"`
+	Status                  string                   `xml:"status,attr,omitempty"`
+	Partial                 string                   `xml:"partial,attr,omitempty"`
+	InEffect                string                   `xml:"inEffect,attr,omitempty"`
+	AnyAttrs                []xml.Attr               `xml:",any,attr"`
+	Any                     []AnyElement             `xml:",any"`
+	Property                []PropertyType           `xml:"property"`
+	CoverTitle              []PropertyType           `xml:"coverTitle"`
+	AmendmentNumber         []AmendmentNumberElement `xml:"amendmentNumber"`
+	Congress                []PropertyType           `xml:"congress"`
+	CurrentChamber          []PropertyType           `xml:"currentChamber"`
+	CurrentThroughPublicLaw []PropertyType           `xml:"currentThroughPublicLaw"`
+	DistributionCode        []PropertyType           `xml:"distributionCode"`
+	DocNumber               []PropertyType           `xml:"docNumber"`
+	DocPart                 []PropertyType           `xml:"docPart"`
+	DocPublicationName      []PropertyType           `xml:"docPublicationName"`
+	DocReleasePoint         []PropertyType           `xml:"docReleasePoint"`
+	DocStage                []PropertyType           `xml:"docStage"`
+	EnrolledDateline        []PropertyType           `xml:"enrolledDateline"`
+	Purpose                 []PropertyType           `xml:"purpose"`
+	RelatedDocument         []PropertyType           `xml:"relatedDocument"`
+	Session                 []PropertyType           `xml:"session"`
+	Subject                 []PropertyType           `xml:"subject"`
+	Volume                  []PropertyType           `xml:"volume"`
+	ProvisionRange          []PropertyType           `xml:"provisionRange"`
+	Set                     []SetType                `xml:"set"`
+	Type                    string                   `xml:"type,attr,omitempty"`
+}
+
+// SignatureType is generated from the SignatureType complex type.
+//
+// Defines a basic signature element comprising a name and optionally the
+// person's role, their affiliation, and a date.
+type SignatureType struct {
+	XMLName       xml.Name
+	Text          string               `xml:",chardata"`
+	Page          []PageType           `xml:"page"`
+	Line          []PageType           `xml:"line"`
+	Notation      []NotationElement    `xml:"notation"`
+	Autograph     []PropertyType       `xml:"autograph"`
+	Name          []PropertyType       `xml:"name"`
+	Role          []PropertyType       `xml:"role"`
+	Affiliation   []AffiliationElement `xml:"affiliation"`
+	SignatureDate []NoteType           `xml:"signatureDate"`
+	XMLBase       string               `xml:"http://www.w3.org/XML/1998/namespace base,attr,omitempty"`
+	XMLLang       string               `xml:"http://www.w3.org/XML/1998/namespace lang,attr,omitempty"`
+	XMLSpace      string               `xml:"http://www.w3.org/XML/1998/namespace space,attr,omitempty"`
+	ID            string               `xml:"id,attr,omitempty"`
+	TemporalID    string               `xml:"temporalId,attr,omitempty"`
+	Identifier    string               `xml:"identifier,attr,omitempty"`
+	Scope         string               `xml:"scope,attr,omitempty"`
+	RoleAttr      string               `xml:"role,attr,omitempty"`
+	Class         string               `xml:"class,attr,omitempty"`
+	Style         string               `xml:"style,attr,omitempty"`
+	StyleType     string               `xml:"styleType,attr,omitempty"`
+	AnyAttrs      []xml.Attr           `xml:",any,attr"`
+}
+
+// SignaturesType is generated from the SignaturesType complex type.
+//
+// Defines a block for a set of signatures.
+type SignaturesType struct {
+	XMLName         xml.Name
+	XMLBase         string           `xml:"http://www.w3.org/XML/1998/namespace base,attr,omitempty"`
+	XMLLang         string           `xml:"http://www.w3.org/XML/1998/namespace lang,attr,omitempty"`
+	XMLSpace        string           `xml:"http://www.w3.org/XML/1998/namespace space,attr,omitempty"`
+	ID              string           `xml:"id,attr,omitempty"`
+	TemporalID      string           `xml:"temporalId,attr,omitempty"`
+	Identifier      string           `xml:"identifier,attr,omitempty"`
+	Scope           string           `xml:"scope,attr,omitempty"`
+	RoleAttr        string           `xml:"role,attr,omitempty"`
+	Class           string           `xml:"class,attr,omitempty"`
+	Style           string           `xml:"style,attr,omitempty"`
+	StyleType       string           `xml:"styleType,attr,omitempty"`
+	Note            string           `xml:"note,attr,omitempty"`
+	Alt             string           `xml:"alt,attr,omitempty"`
+	Meta            string           `xml:"meta,attr,omitempty"`
+	Misc            string           `xml:"misc,attr,omitempty"`
+	DraftingTip     string           `xml:"draftingTip,attr,omitempty"`
+	CodificationTip string           `xml:"codificationTip,attr,omitempty"`
+	Title           string           `xml:"title,attr,omitempty"`
+	Brief           string           `xml:"brief,attr,omitempty"`
+	SortOrder       string           `xml:"sortOrder,attr,omitempty"`
+	StartPeriod     string           `xml:"startPeriod,attr,omitempty"`
+	EndPeriod       string           `xml:"endPeriod,attr,omitempty"`
+	Status          string           `xml:"status,attr,omitempty"`
+	Partial         string           `xml:"partial,attr,omitempty"`
+	InEffect        string           `xml:"inEffect,attr,omitempty"`
+	AnyAttrs        []xml.Attr       `xml:",any,attr"`
+	Changed         string           `xml:"changed,attr,omitempty"`
+	Orientation     string           `xml:"orientation,attr,omitempty"`
+	Origin          string           `xml:"origin,attr,omitempty"`
+	Notation        *NotationElement `xml:"notation"`
+	Signature       []SignatureType  `xml:"signature"`
+	Layout          *LayoutType      `xml:"layout"`
+	Role            *PropertyType    `xml:"role"`
+	SignatureDate   *NoteType        `xml:"signatureDate"`
+	Page            *PageType        `xml:"page"`
+	Line            *PageType        `xml:"line"`
+}
+
+// SimplePropertyType is generated from the SimplePropertyType complex type.
+//
+// The property core type is used to record metadata about a document.
+type SimplePropertyType struct {
+	XMLName         xml.Name
+	Text            string     `xml:",chardata"`
+	XMLBase         string     `xml:"http://www.w3.org/XML/1998/namespace base,attr,omitempty"`
+	XMLLang         string     `xml:"http://www.w3.org/XML/1998/namespace lang,attr,omitempty"`
+	XMLSpace        string     `xml:"http://www.w3.org/XML/1998/namespace space,attr,omitempty"`
+	ID              string     `xml:"id,attr,omitempty"`
+	TemporalID      string     `xml:"temporalId,attr,omitempty"`
+	Identifier      string     `xml:"identifier,attr,omitempty"`
+	Scope           string     `xml:"scope,attr,omitempty"`
+	Role            string     `xml:"role,attr,omitempty"`
+	Class           string     `xml:"class,attr,omitempty"`
+	Style           string     `xml:"style,attr,omitempty"`
+	StyleType       string     `xml:"styleType,attr,omitempty"`
+	Note            string     `xml:"note,attr,omitempty"`
+	Alt             string     `xml:"alt,attr,omitempty"`
+	Meta            string     `xml:"meta,attr,omitempty"`
+	Misc            string     `xml:"misc,attr,omitempty"`
+	DraftingTip     string     `xml:"draftingTip,attr,omitempty"`
+	CodificationTip string     `xml:"codificationTip,attr,omitempty"`
+	Title           string     `xml:"title,attr,omitempty"`
+	Brief           string     `xml:"brief,attr,omitempty"`
+	SortOrder       string     `xml:"sortOrder,attr,omitempty"`
+	StartPeriod     string     `xml:"startPeriod,attr,omitempty"`
+	EndPeriod       string     `xml:"endPeriod,attr,omitempty"`
+	Status          string     `xml:"status,attr,omitempty"`
+	Partial         string     `xml:"partial,attr,omitempty"`
+	InEffect        string     `xml:"inEffect,attr,omitempty"`
+	AnyAttrs        []xml.Attr `xml:",any,attr"`
+	Value           string     `xml:"value,attr,omitempty"`
+	StartValue      string     `xml:"startValue,attr,omitempty"`
+	EndValue        string     `xml:"endValue,attr,omitempty"`
+	Display         string     `xml:"display,attr,omitempty"`
+}
+
+// SponsorType is generated from the SponsorType complex type.
+//
+// The sponsor type is the type for sponsors and cosponsors.
+type SponsorType struct {
+	XMLName            xml.Name
+	Text               string               `xml:",chardata"`
+	Marker             []MarkerType         `xml:"marker"`
+	Br                 []BrType             `xml:"br"`
+	Img                []ImgType            `xml:"img"`
+	Inline             []InlineType         `xml:"inline"`
+	AmendingAction     []AmendingActionType `xml:"amendingAction"`
+	Ref                []RefType            `xml:"ref"`
+	Date               []DateType           `xml:"date"`
+	Center             []InlineType         `xml:"center"`
+	FillIn             []InlineType         `xml:"fillIn"`
+	CheckBox           []InlineType         `xml:"checkBox"`
+	B                  []InlineType         `xml:"b"`
+	I                  []InlineType         `xml:"i"`
+	Qualifier          []InlineType         `xml:"qualifier"`
+	Sub                []InlineType         `xml:"sub"`
+	Sup                []InlineType         `xml:"sup"`
+	HeadingText        []InlineType         `xml:"headingText"`
+	Span               []InlineType         `xml:"span"`
+	ShortTitle         []InlineType         `xml:"shortTitle"`
+	Term               []InlineType         `xml:"term"`
+	Entity             []InlineType         `xml:"entity"`
+	Note               []NoteType           `xml:"note"`
+	Elided             []ElidedType         `xml:"elided"`
+	SourceCredit       []NoteType           `xml:"sourceCredit"`
+	UscNote            []NoteType           `xml:"uscNote"`
+	StatutoryNote      []NoteType           `xml:"statutoryNote"`
+	DrafterNote        []NoteType           `xml:"drafterNote"`
+	EditorialNote      []NoteType           `xml:"editorialNote"`
+	ChangeNote         []NoteType           `xml:"changeNote"`
+	Authority          []NoteType           `xml:"authority"`
+	Source             []NoteType           `xml:"source"`
+	EffectiveDateNote  []NoteType           `xml:"effectiveDateNote"`
+	FrDocID            []NoteType           `xml:"frDocId"`
+	BillingCode        []NoteType           `xml:"billingCode"`
+	EditionNote        []NoteType           `xml:"editionNote"`
+	OrganizationNote   []NoteType           `xml:"organizationNote"`
+	CitationNote       []NoteType           `xml:"citationNote"`
+	ExplanationNote    []NoteType           `xml:"explanationNote"`
+	FindingAidsNote    []NoteType           `xml:"findingAidsNote"`
+	Notes              []NotesType          `xml:"notes"`
+	LegislativeHistory []NotesType          `xml:"legislativeHistory"`
+	XMLBase            string               `xml:"http://www.w3.org/XML/1998/namespace base,attr,omitempty"`
+	XMLLang            string               `xml:"http://www.w3.org/XML/1998/namespace lang,attr,omitempty"`
+	XMLSpace           string               `xml:"http://www.w3.org/XML/1998/namespace space,attr,omitempty"`
+	Role               string               `xml:"role,attr,omitempty"`
+	Class              string               `xml:"class,attr,omitempty"`
+	Style              string               `xml:"style,attr,omitempty"`
+	StyleType          string               `xml:"styleType,attr,omitempty"`
+	BioGuideID         string               `xml:"bioGuideId,attr,omitempty"`
+	SenateID           string               `xml:"senateId,attr,omitempty"`
+}
+
+// StarPrintType is generated from the StarPrintType complex type.
+//
+// A reprint of a bill, resolution, amendment, or committee report correcting
+// technical or substantive errors in a previous printing; so called because of
+// the small black star that appears on the front page or cover.
+type StarPrintType struct {
+	XMLName            xml.Name
+	Text               string               `xml:",chardata"`
+	XMLBase            string               `xml:"http://www.w3.org/XML/1998/namespace base,attr,omitempty"`
+	XMLLang            string               `xml:"http://www.w3.org/XML/1998/namespace lang,attr,omitempty"`
+	XMLSpace           string               `xml:"http://www.w3.org/XML/1998/namespace space,attr,omitempty"`
+	ID                 string               `xml:"id,attr,omitempty"`
+	TemporalID         string               `xml:"temporalId,attr,omitempty"`
+	Identifier         string               `xml:"identifier,attr,omitempty"`
+	Scope              string               `xml:"scope,attr,omitempty"`
+	Role               string               `xml:"role,attr,omitempty"`
+	Class              string               `xml:"class,attr,omitempty"`
+	Style              string               `xml:"style,attr,omitempty"`
+	StyleType          string               `xml:"styleType,attr,omitempty"`
+	NoteAttr           string               `xml:"note,attr,omitempty"`
+	Alt                string               `xml:"alt,attr,omitempty"`
+	Meta               string               `xml:"meta,attr,omitempty"`
+	Misc               string               `xml:"misc,attr,omitempty"`
+	DraftingTip        string               `xml:"draftingTip,attr,omitempty"`
+	CodificationTip    string               `xml:"codificationTip,attr,omitempty"`
+	Title              string               `xml:"title,attr,omitempty"`
+	Brief              string               `xml:"brief,attr,omitempty"`
+	SortOrder          string               `xml:"sortOrder,attr,omitempty"`
+	StartPeriod        string               `xml:"startPeriod,attr,omitempty"`
+	EndPeriod          string               `xml:"endPeriod,attr,omitempty"`
+	Status             string               `xml:"status,attr,omitempty"`
+	Partial            string               `xml:"partial,attr,omitempty"`
+	InEffect           string               `xml:"inEffect,attr,omitempty"`
+	AnyAttrs           []xml.Attr           `xml:",any,attr"`
+	Marker             []MarkerType         `xml:"marker"`
+	Br                 []BrType             `xml:"br"`
+	Img                []ImgType            `xml:"img"`
+	Inline             []InlineType         `xml:"inline"`
+	AmendingAction     []AmendingActionType `xml:"amendingAction"`
+	Ref                []RefType            `xml:"ref"`
+	Date               []DateType           `xml:"date"`
+	Center             []InlineType         `xml:"center"`
+	FillIn             []InlineType         `xml:"fillIn"`
+	CheckBox           []InlineType         `xml:"checkBox"`
+	B                  []InlineType         `xml:"b"`
+	I                  []InlineType         `xml:"i"`
+	Qualifier          []InlineType         `xml:"qualifier"`
+	Sub                []InlineType         `xml:"sub"`
+	Sup                []InlineType         `xml:"sup"`
+	HeadingText        []InlineType         `xml:"headingText"`
+	Span               []InlineType         `xml:"span"`
+	ShortTitle         []InlineType         `xml:"shortTitle"`
+	Term               []InlineType         `xml:"term"`
+	Entity             []InlineType         `xml:"entity"`
+	Footnote           []PositionedNoteType `xml:"footnote"`
+	Sidenote           []PositionedNoteType `xml:"sidenote"`
+	Endnote            []PositionedNoteType `xml:"endnote"`
+	Ear                []PositionedNoteType `xml:"ear"`
+	Note               []NoteType           `xml:"note"`
+	Elided             []ElidedType         `xml:"elided"`
+	SourceCredit       []NoteType           `xml:"sourceCredit"`
+	UscNote            []NoteType           `xml:"uscNote"`
+	StatutoryNote      []NoteType           `xml:"statutoryNote"`
+	DrafterNote        []NoteType           `xml:"drafterNote"`
+	EditorialNote      []NoteType           `xml:"editorialNote"`
+	ChangeNote         []NoteType           `xml:"changeNote"`
+	Authority          []NoteType           `xml:"authority"`
+	Source             []NoteType           `xml:"source"`
+	EffectiveDateNote  []NoteType           `xml:"effectiveDateNote"`
+	FrDocID            []NoteType           `xml:"frDocId"`
+	BillingCode        []NoteType           `xml:"billingCode"`
+	EditionNote        []NoteType           `xml:"editionNote"`
+	OrganizationNote   []NoteType           `xml:"organizationNote"`
+	CitationNote       []NoteType           `xml:"citationNote"`
+	ExplanationNote    []NoteType           `xml:"explanationNote"`
+	FindingAidsNote    []NoteType           `xml:"findingAidsNote"`
+	Notes              []NotesType          `xml:"notes"`
+	LegislativeHistory []NotesType          `xml:"legislativeHistory"`
+	Affected           []AffectedElement    `xml:"affected"`
+	Number             string               `xml:"number,attr,omitempty"`
+	DateAttr           string               `xml:"date,attr,omitempty"`
+	LegisDate          string               `xml:"legisDate,attr,omitempty"`
+	StartDate          string               `xml:"startDate,attr,omitempty"`
+	EndDate            string               `xml:"endDate,attr,omitempty"`
+	Value              string               `xml:"value,attr,omitempty"`
+	StartValue         string               `xml:"startValue,attr,omitempty"`
+	EndValue           string               `xml:"endValue,attr,omitempty"`
+}
+
+// StatementType is generated from the StatementType complex type.
+//
+// The statement core type is used for most of the provisions that begin a
+// legislative document.
+type StatementType struct {
+	XMLName           xml.Name
+	Text              string               `xml:",chardata"`
+	Statement         []StatementType      `xml:"statement"`
+	LongTitle         []LongTitleElement   `xml:"longTitle"`
+	DocTitle          []StatementType      `xml:"docTitle"`
+	OfficialTitle     []StatementType      `xml:"officialTitle"`
+	Marker            []MarkerType         `xml:"marker"`
+	Br                []BrType             `xml:"br"`
+	Img               []ImgType            `xml:"img"`
+	Inline            []InlineType         `xml:"inline"`
+	AmendingAction    []AmendingActionType `xml:"amendingAction"`
+	Ref               []RefType            `xml:"ref"`
+	Date              []DateType           `xml:"date"`
+	Center            []InlineType         `xml:"center"`
+	FillIn            []InlineType         `xml:"fillIn"`
+	CheckBox          []InlineType         `xml:"checkBox"`
+	B                 []InlineType         `xml:"b"`
+	I                 []InlineType         `xml:"i"`
+	Qualifier         []InlineType         `xml:"qualifier"`
+	Sub               []InlineType         `xml:"sub"`
+	Sup               []InlineType         `xml:"sup"`
+	HeadingText       []InlineType         `xml:"headingText"`
+	Span              []InlineType         `xml:"span"`
+	ShortTitle        []InlineType         `xml:"shortTitle"`
+	Term              []InlineType         `xml:"term"`
+	Entity            []InlineType         `xml:"entity"`
+	Footnote          []PositionedNoteType `xml:"footnote"`
+	Sidenote          []PositionedNoteType `xml:"sidenote"`
+	Endnote           []PositionedNoteType `xml:"endnote"`
+	Ear               []PositionedNoteType `xml:"ear"`
+	Note              []NoteType           `xml:"note"`
+	Elided            []ElidedType         `xml:"elided"`
+	SourceCredit      []NoteType           `xml:"sourceCredit"`
+	UscNote           []NoteType           `xml:"uscNote"`
+	StatutoryNote     []NoteType           `xml:"statutoryNote"`
+	DrafterNote       []NoteType           `xml:"drafterNote"`
+	EditorialNote     []NoteType           `xml:"editorialNote"`
+	ChangeNote        []NoteType           `xml:"changeNote"`
+	Authority         []NoteType           `xml:"authority"`
+	Source            []NoteType           `xml:"source"`
+	EffectiveDateNote []NoteType           `xml:"effectiveDateNote"`
+	FrDocID           []NoteType           `xml:"frDocId"`
+	BillingCode       []NoteType           `xml:"billingCode"`
+	EditionNote       []NoteType           `xml:"editionNote"`
+	OrganizationNote  []NoteType           `xml:"organizationNote"`
+	CitationNote      []NoteType           `xml:"citationNote"`
+	ExplanationNote   []NoteType           `xml:"explanationNote"`
+	FindingAidsNote   []NoteType           `xml:"findingAidsNote"`
+	P                 []PType              `xml:"p"`
+	Page              []PageType           `xml:"page"`
+	Line              []PageType           `xml:"line"`
+	Role              string               `xml:"role,attr,omitempty"`
+	Class             string               `xml:"class,attr,omitempty"`
+	Style             string               `xml:"style,attr,omitempty"`
+	StyleType         string               `xml:"styleType,attr,omitempty"`
+}
+
+// TargetElement is generated from the anonymous type of the target element.
+//
+// A target is a reference to the target in a table of contents.
+type TargetElement struct {
+	XMLName            xml.Name
+	Text               string               `xml:",chardata"`
+	XMLBase            string               `xml:"http://www.w3.org/XML/1998/namespace base,attr,omitempty"`
+	XMLLang            string               `xml:"http://www.w3.org/XML/1998/namespace lang,attr,omitempty"`
+	XMLSpace           string               `xml:"http://www.w3.org/XML/1998/namespace space,attr,omitempty"`
+	ID                 string               `xml:"id,attr,omitempty"`
+	TemporalID         string               `xml:"temporalId,attr,omitempty"`
+	Identifier         string               `xml:"identifier,attr,omitempty"`
+	Scope              string               `xml:"scope,attr,omitempty"`
+	Role               string               `xml:"role,attr,omitempty"`
+	Class              string               `xml:"class,attr,omitempty"`
+	Style              string               `xml:"style,attr,omitempty"`
+	StyleType          string               `xml:"styleType,attr,omitempty"`
+	NoteAttr           string               `xml:"note,attr,omitempty"`
+	Alt                string               `xml:"alt,attr,omitempty"`
+	Meta               string               `xml:"meta,attr,omitempty"`
+	Misc               string               `xml:"misc,attr,omitempty"`
+	DraftingTip        string               `xml:"draftingTip,attr,omitempty"`
+	CodificationTip    string               `xml:"codificationTip,attr,omitempty"`
+	Title              string               `xml:"title,attr,omitempty"`
+	Brief              string               `xml:"brief,attr,omitempty"`
+	SortOrder          string               `xml:"sortOrder,attr,omitempty"`
+	StartPeriod        string               `xml:"startPeriod,attr,omitempty"`
+	EndPeriod          string               `xml:"endPeriod,attr,omitempty"`
+	Status             string               `xml:"status,attr,omitempty"`
+	Partial            string               `xml:"partial,attr,omitempty"`
+	InEffect           string               `xml:"inEffect,attr,omitempty"`
+	AnyAttrs           []xml.Attr           `xml:",any,attr"`
+	Marker             []MarkerType         `xml:"marker"`
+	Br                 []BrType             `xml:"br"`
+	Img                []ImgType            `xml:"img"`
+	Inline             []InlineType         `xml:"inline"`
+	AmendingAction     []AmendingActionType `xml:"amendingAction"`
+	Ref                []RefType            `xml:"ref"`
+	Date               []DateType           `xml:"date"`
+	Center             []InlineType         `xml:"center"`
+	FillIn             []InlineType         `xml:"fillIn"`
+	CheckBox           []InlineType         `xml:"checkBox"`
+	B                  []InlineType         `xml:"b"`
+	I                  []InlineType         `xml:"i"`
+	Qualifier          []InlineType         `xml:"qualifier"`
+	Sub                []InlineType         `xml:"sub"`
+	Sup                []InlineType         `xml:"sup"`
+	HeadingText        []InlineType         `xml:"headingText"`
+	Span               []InlineType         `xml:"span"`
+	ShortTitle         []InlineType         `xml:"shortTitle"`
+	Term               []InlineType         `xml:"term"`
+	Entity             []InlineType         `xml:"entity"`
+	Committee          []CommitteeElement   `xml:"committee"`
+	Organization       []InlinePropertyType `xml:"organization"`
+	Note               []NoteType           `xml:"note"`
+	Elided             []ElidedType         `xml:"elided"`
+	SourceCredit       []NoteType           `xml:"sourceCredit"`
+	UscNote            []NoteType           `xml:"uscNote"`
+	StatutoryNote      []NoteType           `xml:"statutoryNote"`
+	DrafterNote        []NoteType           `xml:"drafterNote"`
+	EditorialNote      []NoteType           `xml:"editorialNote"`
+	ChangeNote         []NoteType           `xml:"changeNote"`
+	Authority          []NoteType           `xml:"authority"`
+	Source             []NoteType           `xml:"source"`
+	EffectiveDateNote  []NoteType           `xml:"effectiveDateNote"`
+	FrDocID            []NoteType           `xml:"frDocId"`
+	BillingCode        []NoteType           `xml:"billingCode"`
+	EditionNote        []NoteType           `xml:"editionNote"`
+	OrganizationNote   []NoteType           `xml:"organizationNote"`
+	CitationNote       []NoteType           `xml:"citationNote"`
+	ExplanationNote    []NoteType           `xml:"explanationNote"`
+	FindingAidsNote    []NoteType           `xml:"findingAidsNote"`
+	Notes              []NotesType          `xml:"notes"`
+	LegislativeHistory []NotesType          `xml:"legislativeHistory"`
+	Footnote           []PositionedNoteType `xml:"footnote"`
+	Sidenote           []PositionedNoteType `xml:"sidenote"`
+	Endnote            []PositionedNoteType `xml:"endnote"`
+	Ear                []PositionedNoteType `xml:"ear"`
+	Page               []PageType           `xml:"page"`
+	Line               []PageType           `xml:"line"`
+	Proviso            []ProvisoType        `xml:"proviso"`
+	QuotedText         []QuotedTextType     `xml:"quotedText"`
+	AddedText          []QuotedTextType     `xml:"addedText"`
+	DeletedText        []QuotedTextType     `xml:"deletedText"`
+	Del                []QuotedTextType     `xml:"del"`
+	Ins                []QuotedTextType     `xml:"ins"`
+	Pos                string               `xml:"pos,attr,omitempty"`
+	PosText            string               `xml:"posText,attr,omitempty"`
+	PosCount           string               `xml:"posCount,attr,omitempty"`
+	DateAttr           string               `xml:"date,attr,omitempty"`
+	LegisDate          string               `xml:"legisDate,attr,omitempty"`
+	StartDate          string               `xml:"startDate,attr,omitempty"`
+	EndDate            string               `xml:"endDate,attr,omitempty"`
+	Value              string               `xml:"value,attr,omitempty"`
+	StartValue         string               `xml:"startValue,attr,omitempty"`
+	EndValue           string               `xml:"endValue,attr,omitempty"`
+	Href               string               `xml:"href,attr,omitempty"`
+	Idref              string               `xml:"idref,attr,omitempty"`
+	Portion            string               `xml:"portion,attr,omitempty"`
+	Type               string               `xml:"type,attr,omitempty"`
+	LeaderChar         string               `xml:"leaderChar,attr,omitempty"`
+	LeaderAlign        string               `xml:"leaderAlign,attr,omitempty"`
+}
+
+// ToCIndexType is generated from the ToCIndexType complex type.
+//
+// The TOCIndexType is a base type for indices and tables of content.
+type ToCIndexType struct {
+	XMLName           xml.Name
+	XMLBase           string               `xml:"http://www.w3.org/XML/1998/namespace base,attr,omitempty"`
+	XMLLang           string               `xml:"http://www.w3.org/XML/1998/namespace lang,attr,omitempty"`
+	XMLSpace          string               `xml:"http://www.w3.org/XML/1998/namespace space,attr,omitempty"`
+	ID                string               `xml:"id,attr,omitempty"`
+	TemporalID        string               `xml:"temporalId,attr,omitempty"`
+	Identifier        string               `xml:"identifier,attr,omitempty"`
+	Scope             string               `xml:"scope,attr,omitempty"`
+	Role              string               `xml:"role,attr,omitempty"`
+	Class             string               `xml:"class,attr,omitempty"`
+	Style             string               `xml:"style,attr,omitempty"`
+	StyleType         string               `xml:"styleType,attr,omitempty"`
+	NoteAttr          string               `xml:"note,attr,omitempty"`
+	Alt               string               `xml:"alt,attr,omitempty"`
+	Meta              string               `xml:"meta,attr,omitempty"`
+	Misc              string               `xml:"misc,attr,omitempty"`
+	DraftingTip       string               `xml:"draftingTip,attr,omitempty"`
+	CodificationTip   string               `xml:"codificationTip,attr,omitempty"`
+	Title             string               `xml:"title,attr,omitempty"`
+	Brief             string               `xml:"brief,attr,omitempty"`
+	SortOrder         string               `xml:"sortOrder,attr,omitempty"`
+	StartPeriod       string               `xml:"startPeriod,attr,omitempty"`
+	EndPeriod         string               `xml:"endPeriod,attr,omitempty"`
+	Status            string               `xml:"status,attr,omitempty"`
+	Partial           string               `xml:"partial,attr,omitempty"`
+	InEffect          string               `xml:"inEffect,attr,omitempty"`
+	AnyAttrs          []xml.Attr           `xml:",any,attr"`
+	Heading           []HeadingType        `xml:"heading"`
+	Subheading        []HeadingType        `xml:"subheading"`
+	ReferenceItem     []ItemType           `xml:"referenceItem"`
+	HeadingItem       []ItemType           `xml:"headingItem"`
+	GroupItem         []ItemType           `xml:"groupItem"`
+	Footnote          []PositionedNoteType `xml:"footnote"`
+	Sidenote          []PositionedNoteType `xml:"sidenote"`
+	Endnote           []PositionedNoteType `xml:"endnote"`
+	Ear               []PositionedNoteType `xml:"ear"`
+	Note              []NoteType           `xml:"note"`
+	Elided            []ElidedType         `xml:"elided"`
+	SourceCredit      []NoteType           `xml:"sourceCredit"`
+	UscNote           []NoteType           `xml:"uscNote"`
+	StatutoryNote     []NoteType           `xml:"statutoryNote"`
+	DrafterNote       []NoteType           `xml:"drafterNote"`
+	EditorialNote     []NoteType           `xml:"editorialNote"`
+	ChangeNote        []NoteType           `xml:"changeNote"`
+	Authority         []NoteType           `xml:"authority"`
+	Source            []NoteType           `xml:"source"`
+	EffectiveDateNote []NoteType           `xml:"effectiveDateNote"`
+	FrDocID           []NoteType           `xml:"frDocId"`
+	BillingCode       []NoteType           `xml:"billingCode"`
+	EditionNote       []NoteType           `xml:"editionNote"`
+	OrganizationNote  []NoteType           `xml:"organizationNote"`
+	CitationNote      []NoteType           `xml:"citationNote"`
+	ExplanationNote   []NoteType           `xml:"explanationNote"`
+	FindingAidsNote   []NoteType           `xml:"findingAidsNote"`
+	Page              []PageType           `xml:"page"`
+	Line              []PageType           `xml:"line"`
+	Changed           string               `xml:"changed,attr,omitempty"`
+	Origin            string               `xml:"origin,attr,omitempty"`
+	Href              string               `xml:"href,attr,omitempty"`
+	Idref             string               `xml:"idref,attr,omitempty"`
+	Portion           string               `xml:"portion,attr,omitempty"`
+}
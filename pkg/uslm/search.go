@@ -0,0 +1,61 @@
+package uslm
+
+import (
+	"regexp"
+	"strings"
+)
+
+// SectionMatch is a section found by FindSections.
+type SectionMatch struct {
+	// Section points into the document, so it may be modified in place.
+	Section *Section
+
+	// Path runs from the top-level provision (e.g., a title) down to the
+	// section; the last element's PathString gives its citation.
+	Path []*Provision
+}
+
+// FindSections returns the sections of doc, at any depth, for which match
+// returns true, in document order.
+func FindSections(doc LegislativeDocument, match func(Section) bool) []SectionMatch {
+	var matches []SectionMatch
+	for _, top := range Provisions(doc) {
+		top.Walk(func(p *Provision) bool {
+			if s, ok := p.Node.(*Section); ok && match(*s) {
+				matches = append(matches, SectionMatch{Section: s, Path: p.Path()})
+			}
+			return true
+		})
+	}
+	return matches
+}
+
+// HeadingContains matches sections whose heading contains substr, ignoring
+// case and differences in whitespace.
+func HeadingContains(substr string) func(Section) bool {
+	substr = strings.ToLower(normalizeSpace(substr))
+	return func(s Section) bool {
+		return s.Heading != nil && strings.Contains(strings.ToLower(s.Heading.PlainText()), substr)
+	}
+}
+
+// TextMatchesRegexp matches sections whose text, including the text of all
+// their subsections, paragraphs, and lower levels, matches re. Levels are
+// joined by newlines, with whitespace within each level normalized.
+func TextMatchesRegexp(re *regexp.Regexp) func(Section) bool {
+	return func(s Section) bool {
+		return re.MatchString(sectionText(s))
+	}
+}
+
+// sectionText returns the text of s and its descendants, one level per line.
+func sectionText(s Section) string {
+	var lines []string
+	walkSectionLevels([]Section{s}, nil, func(l *level) bool {
+		if line := normalizeSpace(levelLine(l)); line != "" {
+			lines = append(lines, line)
+		}
+		return true
+	})
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,190 @@
+package uslm
+
+import (
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"strings"
+)
+
+// ErrUnsafeDocument is returned when a document is rejected by the parser's
+// hardening checks: external or parameter entity declarations, or internal
+// entities beyond the limits below.
+var ErrUnsafeDocument = errors.New("unsafe document")
+
+const (
+	// MaxEntityDeclarations is the most internal entities a DOCTYPE may declare.
+	MaxEntityDeclarations = 64
+
+	// MaxEntityValueBytes is the longest replacement text an internal entity
+	// may have. Entity values may not contain references of their own, so a
+	// single reference expands to at most this many bytes.
+	MaxEntityValueBytes = 256
+)
+
+// encoding/xml never fetches external resources and ignores entities
+// declared in a DOCTYPE, failing on any reference to them in strict mode. The
+// checks here make that policy explicit rather than incidental: documents
+// that declare external entities are rejected outright, and simple internal
+// entities are honored within fixed bounds.
+
+// newDecoder returns a strict decoder for untrusted input that enforces
+// limits as it reads. Directives before the root element are vetted by
+// checkDirective, which also records the entities they declare. Bytes and
+// elements read are counted into run, which may be nil. With opts.Logger,
+// opts.Unknown, or opts.Strict set, markup the model will drop is reported as
+// it is read. With opts.lexical set, the lexical forms of the source are
+// recorded into it. Input in ISO-8859-1 or Windows-1252 is converted to UTF-8 (see
+// utf8Input).
+func newDecoder(r io.Reader, opts ParseOptions, run *parseRun) *xml.Decoder {
+	progress := newProgressTracker(opts.Progress, r)
+	r = progress.reader(run.reader(r))
+	if opts.Limits.MaxBytes > 0 {
+		r = &sizeLimitReader{r: r, limit: opts.Limits.MaxBytes}
+	}
+	r = utf8Input(r)
+	var source *sourceTap
+	if opts.lexical != nil {
+		source = &sourceTap{r: r}
+		r = source
+	}
+	raw := xml.NewDecoder(r)
+	raw.Strict = true
+	raw.Entity = map[string]string{}
+	raw.CharsetReader = charsetReader
+	if source != nil {
+		// The decoder counts offsets in the converted input, so the source
+		// of tokens after the declaration is taken from it too.
+		raw.CharsetReader = func(label string, input io.Reader) (io.Reader, error) {
+			r, err := charsetReader(label, input)
+			if err != nil {
+				return nil, err
+			}
+			return source.convert(r, raw.InputOffset()), nil
+		}
+	}
+	// The outer decoder resolves namespaces and checks nesting over the raw
+	// tokens, exactly as a plain Decoder would.
+	g := &guardedTokens{d: raw, limits: opts.Limits, run: run, progress: progress, lexical: opts.lexical, source: source, extensions: opts.extensions, keepPrefixes: opts.keepPrefixes}
+	if opts.Logger != nil || opts.Unknown != nil || opts.Strict {
+		if opts.Unknown != nil {
+			*opts.Unknown = UnknownContent{}
+		}
+		g.tracker = &elementTracker{logger: opts.Logger, report: opts.Unknown, strict: opts.Strict}
+	}
+	return xml.NewTokenDecoder(g)
+}
+
+// unmarshalDocument decodes data into v, a pointer to a root document type,
+// reporting the parse to the observer as the named operation.
+func unmarshalDocument(operation string, data []byte, v interface{}) error {
+	run := beginParse(operation)
+	opts := ParseOptions{lexical: &lexicalForms{}, extensions: newExtensionRecorder()}
+	err := newDecoder(bytes.NewReader(data), opts, run).Decode(v)
+	if err == nil {
+		setLexical(v, opts.lexical)
+		setExtensions(v, opts.extensions)
+		run.phase(PhaseDecode)
+	}
+	run.end(err)
+	return err
+}
+
+// checkDirective vets a directive read before the root element and records
+// the internal entities it declares in d.Entity.
+func checkDirective(d *xml.Decoder, dir xml.Directive) error {
+	s := string(dir)
+	if !strings.HasPrefix(s, "DOCTYPE") {
+		return nil
+	}
+
+	for {
+		i := strings.Index(s, "<!ENTITY")
+		if i < 0 {
+			return nil
+		}
+		s = strings.TrimLeft(s[i+len("<!ENTITY"):], " \t\r\n")
+
+		if strings.HasPrefix(s, "%") {
+			return fmt.Errorf("%w: parameter entity declared", ErrUnsafeDocument)
+		}
+		end := strings.IndexAny(s, " \t\r\n")
+		if end <= 0 {
+			return fmt.Errorf("%w: malformed entity declaration", ErrUnsafeDocument)
+		}
+		name := s[:end]
+		s = strings.TrimLeft(s[end:], " \t\r\n")
+
+		if strings.HasPrefix(s, "SYSTEM") || strings.HasPrefix(s, "PUBLIC") {
+			return fmt.Errorf("%w: external entity %q declared", ErrUnsafeDocument, name)
+		}
+		if s == "" || (s[0] != '"' && s[0] != '\'') {
+			return fmt.Errorf("%w: malformed entity declaration %q", ErrUnsafeDocument, name)
+		}
+		closing := strings.IndexByte(s[1:], s[0])
+		if closing < 0 {
+			return fmt.Errorf("%w: malformed entity declaration %q", ErrUnsafeDocument, name)
+		}
+		value := s[1 : closing+1]
+		s = s[closing+2:]
+
+		switch {
+		case strings.ContainsAny(value, "&%"):
+			return fmt.Errorf("%w: entity %q contains a reference", ErrUnsafeDocument, name)
+		case len(value) > MaxEntityValueBytes:
+			return fmt.Errorf("%w: entity %q exceeds %d bytes", ErrUnsafeDocument, name, MaxEntityValueBytes)
+		case len(d.Entity) >= MaxEntityDeclarations:
+			return fmt.Errorf("%w: more than %d entities declared", ErrUnsafeDocument, MaxEntityDeclarations)
+		}
+		d.Entity[name] = value
+	}
+}
+
+// ResolveHref resolves href against the document's xml:base, if it declares
+// one. The href is returned unchanged when there is no base or either value
+// is not a valid URL reference.
+func ResolveHref(doc LegislativeDocument, href string) string {
+	base := documentBase(doc)
+	if base == "" {
+		return href
+	}
+	b, err := url.Parse(base)
+	if err != nil {
+		return href
+	}
+	ref, err := url.Parse(href)
+	if err != nil {
+		return href
+	}
+	return b.ResolveReference(ref).String()
+}
+
+// documentBase returns the root element's xml:base.
+func documentBase(doc LegislativeDocument) string {
+	switch d := doc.(type) {
+	case *Bill:
+		if d != nil {
+			return d.XMLBase
+		}
+	case *Resolution:
+		if d != nil {
+			return d.XMLBase
+		}
+	case *EngrossedAmendment:
+		if d != nil {
+			return d.XMLBase
+		}
+	case *Amendment:
+		if d != nil {
+			return d.XMLBase
+		}
+	case *GenericDocument:
+		if d != nil {
+			return d.XMLBase
+		}
+	}
+	return ""
+}
@@ -0,0 +1,119 @@
+package uslm
+
+import "encoding/xml"
+
+// The Senate defines its own metadata (uslm-meta-senate.xsd) and attributes
+// in the NamespaceSenate namespace, which documents may bind to any prefix.
+// Parsing binds it to senatePrefix throughout, so Senate attributes, which
+// the model keeps in Attributes, are written back with a prefix the root
+// declares, and <metaSenate> is written as msenate:metaSenate.
+
+// senatePrefix is the prefix the Senate schema prefers for NamespaceSenate.
+const senatePrefix = "msenate"
+
+// MetaSenate is the Senate's metadata for a document, such as a floor
+// amendment, kept in <msenate:metaSenate> within <meta> or <amendMeta>.
+type MetaSenate struct {
+	XMLName        xml.Name       `xml:"https://www.senate.gov/schemas metaSenate" json:"-"`
+	For            *SenateFor     `xml:"https://www.senate.gov/schemas for" json:"for,omitempty"`
+	Subject        *SenateSubject `xml:"https://www.senate.gov/schemas subject" json:"subject,omitempty"`
+	Type           string         `xml:"https://www.senate.gov/schemas type,omitempty" json:"type,omitempty"`
+	Description    string         `xml:"https://www.senate.gov/schemas description,omitempty" json:"description,omitempty"`
+	Received       string         `xml:"https://www.senate.gov/schemas received,omitempty" json:"received,omitempty"`
+	Delivered      string         `xml:"https://www.senate.gov/schemas delivered,omitempty" json:"delivered,omitempty"`
+	Staff          string         `xml:"https://www.senate.gov/schemas staff,omitempty" json:"staff,omitempty"`
+	PeerReviewedBy string         `xml:"https://www.senate.gov/schemas peer-reviewed-by,omitempty" json:"peerReviewedBy,omitempty"`
+	Previous       string         `xml:"https://www.senate.gov/schemas previous,omitempty" json:"previous,omitempty"`
+	Next           string         `xml:"https://www.senate.gov/schemas next,omitempty" json:"next,omitempty"`
+}
+
+// SenateFor names the office, Senator, and staff member a document was
+// prepared for.
+type SenateFor struct {
+	XMLName xml.Name `xml:"https://www.senate.gov/schemas for" json:"-"`
+	Office  string   `xml:"https://www.senate.gov/schemas for-office,omitempty" json:"office,omitempty"`
+	Sponsor string   `xml:"https://www.senate.gov/schemas for-sponsor,omitempty" json:"sponsor,omitempty"`
+	Staffer string   `xml:"https://www.senate.gov/schemas for-staffer,omitempty" json:"staffer,omitempty"`
+}
+
+// SenateSubject holds up to three lines describing a document's subject.
+type SenateSubject struct {
+	XMLName xml.Name `xml:"https://www.senate.gov/schemas subject" json:"-"`
+	Field1  string   `xml:"https://www.senate.gov/schemas subject-field1,omitempty" json:"field1,omitempty"`
+	Field2  string   `xml:"https://www.senate.gov/schemas subject-field2,omitempty" json:"field2,omitempty"`
+	Field3  string   `xml:"https://www.senate.gov/schemas subject-field3,omitempty" json:"field3,omitempty"`
+}
+
+// MarshalXML encodes the metadata with Senate elements as msenate:*.
+func (m *MetaSenate) MarshalXML(e *xml.Encoder, _ xml.StartElement) error {
+	return encodePrefixed(e, m)
+}
+
+// MarshalXML encodes the element with Senate elements as msenate:*.
+func (f *SenateFor) MarshalXML(e *xml.Encoder, _ xml.StartElement) error {
+	return encodePrefixed(e, f)
+}
+
+// MarshalXML encodes the element with Senate elements as msenate:*.
+func (s *SenateSubject) MarshalXML(e *xml.Encoder, _ xml.StartElement) error {
+	return encodePrefixed(e, s)
+}
+
+// Senate returns the value of the Senate-namespace attribute with the given
+// local name (e.g., "amendmentNumber" for msenate:amendmentNumber), or an
+// empty string.
+func (a Attributes) Senate(local string) string {
+	for _, attr := range a {
+		if attr.Name.Space == "" && attr.Name.Local == senatePrefix+":"+local ||
+			attr.Name.Space == NamespaceSenate && attr.Name.Local == local {
+			return attr.Value
+		}
+	}
+	return ""
+}
+
+// senateDecls returns the declaration of senatePrefix when present is true,
+// for documents whose metadata includes Senate elements.
+func senateDecls(present bool) []namespaceDecl {
+	if present {
+		return []namespaceDecl{{senatePrefix, NamespaceSenate}}
+	}
+	return nil
+}
+
+// bindSenatePrefix rebinds the prefixes that tok declares for, or uses from,
+// NamespaceSenate to senatePrefix. Senate attributes are renamed to their
+// prefixed form, e.g. "msenate:amendmentNumber" with no namespace, which
+// Attributes keeps and the encoder writes as is.
+func (g *guardedTokens) bindSenatePrefix(tok xml.Token) xml.Token {
+	switch t := tok.(type) {
+	case xml.StartElement:
+		for i, a := range t.Attr {
+			if a.Name.Space == "xmlns" && a.Value == NamespaceSenate {
+				if g.senatePrefixes == nil {
+					g.senatePrefixes = make(map[string]bool)
+				}
+				g.senatePrefixes[a.Name.Local] = true
+				t.Attr[i].Name.Local = senatePrefix
+			}
+		}
+		if len(g.senatePrefixes) == 0 {
+			return tok
+		}
+		if g.senatePrefixes[t.Name.Space] {
+			t.Name.Space = senatePrefix
+		}
+		for i, a := range t.Attr {
+			if g.senatePrefixes[a.Name.Space] {
+				t.Attr[i].Name = xml.Name{Local: senatePrefix + ":" + a.Name.Local}
+			}
+		}
+		return t
+	case xml.EndElement:
+		if g.senatePrefixes[t.Name.Space] {
+			t.Name.Space = senatePrefix
+		}
+		return t
+	}
+	return tok
+}
@@ -0,0 +1,403 @@
+package uslm
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/rsa"
+	_ "crypto/sha256" // register SHA-256 for crypto.Hash
+	_ "crypto/sha512" // register SHA-384 and SHA-512 for crypto.Hash
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+	"strings"
+)
+
+// ErrNoSignature is returned by VerifySignature for a document without an
+// enveloped XML Signature.
+var ErrNoSignature = errors.New("document is not signed")
+
+// ErrInvalidSignature is returned by VerifySignature when a signature does not
+// verify or cannot be trusted.
+var ErrInvalidSignature = errors.New("invalid signature")
+
+// XMLDSigNamespace is the namespace of XML Signature elements.
+const XMLDSigNamespace = "http://www.w3.org/2000/09/xmldsig#"
+
+// Algorithms supported for signature verification, in addition to the
+// canonicalization methods. SHA-1 based algorithms are rejected as too weak
+// to authenticate a document.
+const (
+	EnvelopedSignatureTransform = XMLDSigNamespace + "enveloped-signature"
+
+	SHA256Digest = "http://www.w3.org/2001/04/xmlenc#sha256"
+	SHA384Digest = "http://www.w3.org/2001/04/xmldsig-more#sha384"
+	SHA512Digest = "http://www.w3.org/2001/04/xmlenc#sha512"
+
+	RSASHA256Signature   = "http://www.w3.org/2001/04/xmldsig-more#rsa-sha256"
+	RSASHA384Signature   = "http://www.w3.org/2001/04/xmldsig-more#rsa-sha384"
+	RSASHA512Signature   = "http://www.w3.org/2001/04/xmldsig-more#rsa-sha512"
+	ECDSASHA256Signature = "http://www.w3.org/2001/04/xmldsig-more#ecdsa-sha256"
+	ECDSASHA384Signature = "http://www.w3.org/2001/04/xmldsig-more#ecdsa-sha384"
+	ECDSASHA512Signature = "http://www.w3.org/2001/04/xmldsig-more#ecdsa-sha512"
+)
+
+var digestMethods = map[string]crypto.Hash{
+	SHA256Digest: crypto.SHA256,
+	SHA384Digest: crypto.SHA384,
+	SHA512Digest: crypto.SHA512,
+}
+
+var signatureMethods = map[string]struct {
+	hash  crypto.Hash
+	ecdsa bool
+}{
+	RSASHA256Signature:   {crypto.SHA256, false},
+	RSASHA384Signature:   {crypto.SHA384, false},
+	RSASHA512Signature:   {crypto.SHA512, false},
+	ECDSASHA256Signature: {crypto.SHA256, true},
+	ECDSASHA384Signature: {crypto.SHA384, true},
+	ECDSASHA512Signature: {crypto.SHA512, true},
+}
+
+// XMLSignature represents an enveloped XML Signature (XMLDSig) block, which
+// authenticated govinfo documents carry as the last child of the root.
+type XMLSignature struct {
+	XMLName        xml.Name   `xml:"http://www.w3.org/2000/09/xmldsig# Signature" json:"-"`
+	ID             string     `xml:"Id,attr,omitempty" json:"id,omitempty"`
+	SignedInfo     SignedInfo `xml:"SignedInfo" json:"signedInfo"`
+	SignatureValue string     `xml:"SignatureValue" json:"signatureValue"`
+	KeyInfo        *KeyInfo   `xml:"KeyInfo" json:"keyInfo,omitempty"`
+	Attrs          Attributes `xml:",any,attr" json:"attrs,omitempty"`
+}
+
+// SignedInfo represents the signed portion of an XML Signature.
+type SignedInfo struct {
+	CanonicalizationMethod SignatureAlgorithm   `xml:"CanonicalizationMethod" json:"canonicalizationMethod"`
+	SignatureMethod        SignatureAlgorithm   `xml:"SignatureMethod" json:"signatureMethod"`
+	References             []SignatureReference `xml:"Reference" json:"references"`
+	Attrs                  Attributes           `xml:",any,attr" json:"attrs,omitempty"`
+}
+
+// SignatureAlgorithm identifies an algorithm by URI, with the inclusive
+// namespace prefix list of exclusive canonicalization when present.
+type SignatureAlgorithm struct {
+	Algorithm           string               `xml:"Algorithm,attr" json:"algorithm"`
+	InclusiveNamespaces *InclusiveNamespaces `xml:"http://www.w3.org/2001/10/xml-exc-c14n# InclusiveNamespaces" json:"inclusiveNamespaces,omitempty"`
+	Attrs               Attributes           `xml:",any,attr" json:"attrs,omitempty"`
+}
+
+// InclusiveNamespaces lists the prefixes exclusive canonicalization treats
+// inclusively.
+type InclusiveNamespaces struct {
+	PrefixList string     `xml:"PrefixList,attr" json:"prefixList"`
+	Attrs      Attributes `xml:",any,attr" json:"attrs,omitempty"`
+}
+
+// SignatureReference represents a reference to the signed data and its digest.
+type SignatureReference struct {
+	URI          string               `xml:"URI,attr" json:"uri"`
+	Transforms   []SignatureAlgorithm `xml:"Transforms>Transform" json:"transforms,omitempty"`
+	DigestMethod SignatureAlgorithm   `xml:"DigestMethod" json:"digestMethod"`
+	DigestValue  string               `xml:"DigestValue" json:"digestValue"`
+	Attrs        Attributes           `xml:",any,attr" json:"attrs,omitempty"`
+}
+
+// KeyInfo represents the key information of an XML Signature. Only X.509
+// certificates are modeled; the signing certificate comes first.
+type KeyInfo struct {
+	X509Certificates []string   `xml:"X509Data>X509Certificate" json:"x509Certificates,omitempty"`
+	Attrs            Attributes `xml:",any,attr" json:"attrs,omitempty"`
+}
+
+// MarshalXML encodes the signature in the XML Signature namespace.
+func (s *XMLSignature) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain XMLSignature
+	start.Name = xml.Name{Space: XMLDSigNamespace, Local: "Signature"}
+	return e.EncodeElement((*plain)(s), start)
+}
+
+// PrefixList returns the algorithm's inclusive namespace prefix list, or an
+// empty string.
+func (a SignatureAlgorithm) PrefixList() string {
+	if a.InclusiveNamespaces == nil {
+		return ""
+	}
+	return a.InclusiveNamespaces.PrefixList
+}
+
+// VerifySignature checks the enveloped XML Signature of a document and
+// returns the certificate that signed it. The signature must be a child of
+// the root element and cover the whole document, every reference digest must
+// match, and the signing certificate (the first in KeyInfo, with any others
+// used as intermediates) must chain to roots, or to the system roots when
+// roots is nil.
+//
+// Only same-document references are supported, with the enveloped-signature
+// and canonicalization transforms. Verify data and then parse the same bytes;
+// a signature vouches for the bytes it was checked against, not for a
+// document re-encoded from the typed model.
+func VerifySignature(data []byte, roots *x509.CertPool) (*x509.Certificate, error) {
+	tree, err := ParseRaw(data)
+	if err != nil {
+		return nil, err
+	}
+	root := tree.Root()
+	rootScope := withDeclarations(map[string]string{}, root)
+
+	var sigNode *Node
+	for _, c := range root.Elements() {
+		if c.LocalName() == "Signature" && withDeclarations(rootScope, c)[namePrefix(c.Name)] == XMLDSigNamespace {
+			if sigNode != nil {
+				return nil, fmt.Errorf("%w: more than one signature", ErrInvalidSignature)
+			}
+			sigNode = c
+		}
+	}
+	if sigNode == nil {
+		return nil, ErrNoSignature
+	}
+
+	var signedInfo *Node
+	for _, c := range sigNode.Elements() {
+		if c.LocalName() == "SignedInfo" {
+			if signedInfo != nil {
+				return nil, fmt.Errorf("%w: more than one SignedInfo", ErrInvalidSignature)
+			}
+			signedInfo = c
+		}
+	}
+	if signedInfo == nil {
+		return nil, fmt.Errorf("%w: missing SignedInfo", ErrInvalidSignature)
+	}
+
+	sig, err := decodeSignature(sigNode, rootScope)
+	if err != nil {
+		return nil, err
+	}
+	cert, err := signingCertificate(sig, roots)
+	if err != nil {
+		return nil, err
+	}
+	if err := verifyReferences(tree, sigNode, sig); err != nil {
+		return nil, err
+	}
+	if err := verifySignedInfo(signedInfo, []*Node{root, sigNode}, sig, cert); err != nil {
+		return nil, err
+	}
+	return cert, nil
+}
+
+// decodeSignature decodes the typed signature from its node, declaring the
+// namespaces in scope from the root so prefixed names resolve.
+func decodeSignature(sigNode *Node, scope map[string]string) (*XMLSignature, error) {
+	prefixes := make([]string, 0, len(scope))
+	for p := range scope {
+		prefixes = append(prefixes, p)
+	}
+	sort.Strings(prefixes)
+
+	detached := *sigNode
+	detached.Attrs = nil
+	for _, p := range prefixes {
+		name := "xmlns"
+		if p != "" {
+			name += ":" + p
+		}
+		if !hasAttr(sigNode, name) {
+			detached.Attrs = append(detached.Attrs, NodeAttr{Name: name, Value: scope[p]})
+		}
+	}
+	detached.Attrs = append(detached.Attrs, sigNode.Attrs...)
+
+	sig := &XMLSignature{}
+	if err := detached.Decode(sig); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidSignature, err)
+	}
+	return sig, nil
+}
+
+// signingCertificate returns the first certificate in the signature's
+// KeyInfo after verifying its chain to roots.
+func signingCertificate(sig *XMLSignature, roots *x509.CertPool) (*x509.Certificate, error) {
+	if sig.KeyInfo == nil || len(sig.KeyInfo.X509Certificates) == 0 {
+		return nil, fmt.Errorf("%w: no X.509 certificate in KeyInfo", ErrInvalidSignature)
+	}
+	var certs []*x509.Certificate
+	for _, encoded := range sig.KeyInfo.X509Certificates {
+		der, err := decodeBase64(encoded)
+		if err != nil {
+			return nil, fmt.Errorf("%w: failed to decode certificate: %v", ErrInvalidSignature, err)
+		}
+		cert, err := x509.ParseCertificate(der)
+		if err != nil {
+			return nil, fmt.Errorf("%w: failed to parse certificate: %v", ErrInvalidSignature, err)
+		}
+		certs = append(certs, cert)
+	}
+
+	intermediates := x509.NewCertPool()
+	for _, cert := range certs[1:] {
+		intermediates.AddCert(cert)
+	}
+	opts := x509.VerifyOptions{
+		Roots:         roots,
+		Intermediates: intermediates,
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageAny},
+	}
+	if _, err := certs[0].Verify(opts); err != nil {
+		return nil, fmt.Errorf("%w: untrusted certificate: %v", ErrInvalidSignature, err)
+	}
+	return certs[0], nil
+}
+
+// verifyReferences checks the digest of every reference and that one of them
+// covers the root element. References select no comments, so the
+// canonicalization transforms are applied without them.
+func verifyReferences(tree, sigNode *Node, sig *XMLSignature) error {
+	root := tree.Root()
+	covered := false
+	for _, ref := range sig.SignedInfo.References {
+		var target *Node
+		var ancestors []*Node
+		switch {
+		case ref.URI == "":
+			target = tree
+			covered = true
+		case strings.HasPrefix(ref.URI, "#"):
+			var err error
+			target, ancestors, err = elementByID(root, ref.URI[1:])
+			if err != nil {
+				return err
+			}
+			if target == root {
+				covered = true
+			}
+		default:
+			return fmt.Errorf("%w: unsupported reference URI %q", ErrInvalidSignature, ref.URI)
+		}
+
+		method, prefixList := C14NMethod, ""
+		var omit *Node
+		for _, t := range ref.Transforms {
+			switch t.Algorithm {
+			case EnvelopedSignatureTransform:
+				omit = sigNode
+			case C14NMethod, C14NWithCommentsMethod, ExcC14NMethod, ExcC14NWithCommentsMethod:
+				method, prefixList = t.Algorithm, t.PrefixList()
+			default:
+				return fmt.Errorf("%w: unsupported transform %q", ErrInvalidSignature, t.Algorithm)
+			}
+		}
+		c, err := newCanonicalizer(method, prefixList)
+		if err != nil {
+			return fmt.Errorf("%w: %v", ErrInvalidSignature, err)
+		}
+		c.comments = false
+		c.omit = omit
+
+		hash, ok := digestMethods[ref.DigestMethod.Algorithm]
+		if !ok {
+			return fmt.Errorf("%w: unsupported digest method %q", ErrInvalidSignature, ref.DigestMethod.Algorithm)
+		}
+		want, err := decodeBase64(ref.DigestValue)
+		if err != nil {
+			return fmt.Errorf("%w: failed to decode digest: %v", ErrInvalidSignature, err)
+		}
+		h := hash.New()
+		h.Write(c.canonicalize(target, ancestors))
+		if !bytes.Equal(h.Sum(nil), want) {
+			return fmt.Errorf("%w: digest mismatch for reference %q", ErrInvalidSignature, ref.URI)
+		}
+	}
+	if !covered {
+		return fmt.Errorf("%w: signature does not cover the document", ErrInvalidSignature)
+	}
+	return nil
+}
+
+// verifySignedInfo checks the signature value over the canonical SignedInfo.
+func verifySignedInfo(signedInfo *Node, ancestors []*Node, sig *XMLSignature, cert *x509.Certificate) error {
+	c, err := newCanonicalizer(sig.SignedInfo.CanonicalizationMethod.Algorithm, sig.SignedInfo.CanonicalizationMethod.PrefixList())
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidSignature, err)
+	}
+	method, ok := signatureMethods[sig.SignedInfo.SignatureMethod.Algorithm]
+	if !ok {
+		return fmt.Errorf("%w: unsupported signature method %q", ErrInvalidSignature, sig.SignedInfo.SignatureMethod.Algorithm)
+	}
+	value, err := decodeBase64(sig.SignatureValue)
+	if err != nil {
+		return fmt.Errorf("%w: failed to decode signature value: %v", ErrInvalidSignature, err)
+	}
+	h := method.hash.New()
+	h.Write(c.canonicalize(signedInfo, ancestors))
+	digest := h.Sum(nil)
+
+	switch key := cert.PublicKey.(type) {
+	case *rsa.PublicKey:
+		if method.ecdsa {
+			break
+		}
+		if err := rsa.VerifyPKCS1v15(key, method.hash, digest, value); err != nil {
+			return fmt.Errorf("%w: %v", ErrInvalidSignature, err)
+		}
+		return nil
+	case *ecdsa.PublicKey:
+		if !method.ecdsa {
+			break
+		}
+		size := (key.Curve.Params().BitSize + 7) / 8
+		if len(value) != 2*size {
+			return fmt.Errorf("%w: malformed ECDSA signature value", ErrInvalidSignature)
+		}
+		r := new(big.Int).SetBytes(value[:size])
+		s := new(big.Int).SetBytes(value[size:])
+		if !ecdsa.Verify(key, digest, r, s) {
+			return fmt.Errorf("%w: ECDSA verification failed", ErrInvalidSignature)
+		}
+		return nil
+	}
+	return fmt.Errorf("%w: signature method %q does not match the certificate key", ErrInvalidSignature, sig.SignedInfo.SignatureMethod.Algorithm)
+}
+
+// elementByID returns the element under root (inclusive) whose id, Id, or
+// ID attribute is id, along with its ancestors. The id must be unique, so a
+// reference cannot be redirected by a second element carrying the same id.
+func elementByID(root *Node, id string) (*Node, []*Node, error) {
+	var found *Node
+	var foundAncestors []*Node
+	count := 0
+	var visit func(n *Node, ancestors []*Node)
+	visit = func(n *Node, ancestors []*Node) {
+		for _, a := range n.Attrs {
+			if (a.Name == "id" || a.Name == "Id" || a.Name == "ID") && a.Value == id {
+				count++
+				found, foundAncestors = n, append([]*Node(nil), ancestors...)
+				break
+			}
+		}
+		ancestors = append(ancestors, n)
+		for _, c := range n.Elements() {
+			visit(c, ancestors)
+		}
+	}
+	visit(root, nil)
+	switch count {
+	case 0:
+		return nil, nil, fmt.Errorf("%w: no element with id %q", ErrInvalidSignature, id)
+	case 1:
+		return found, foundAncestors, nil
+	default:
+		return nil, nil, fmt.Errorf("%w: duplicate id %q", ErrInvalidSignature, id)
+	}
+}
+
+// decodeBase64 decodes base64 content, which signatures commonly wrap.
+func decodeBase64(s string) ([]byte, error) {
+	return base64.StdEncoding.DecodeString(strings.Join(strings.Fields(s), ""))
+}
@@ -0,0 +1,145 @@
+package uslm
+
+import (
+	"reflect"
+	"strings"
+	"unsafe"
+)
+
+// SizeEstimate approximates the memory held by a parsed document.
+type SizeEstimate struct {
+	// Bytes is the approximate heap memory reachable from the document:
+	// structs, slice backing arrays, string data, and maps. Allocator
+	// rounding and map bucket overhead are not modeled, so real usage runs
+	// somewhat higher.
+	Bytes int64
+
+	// StringBytes is the part of Bytes held by string data.
+	StringBytes int64
+
+	// Elements is the number of XML elements the document retains.
+	Elements int
+
+	// Levels is the number of hierarchical levels (sections, paragraphs,
+	// and the like) in the provision tree.
+	Levels int
+}
+
+// EstimateSize reports the approximate in-memory size and node counts of
+// doc, for example to size a DocumentCache holding many parsed bills. It
+// visits every value in the document, so it is meant for sampling rather
+// than for every cached document.
+func EstimateSize(doc LegislativeDocument) SizeEstimate {
+	var est SizeEstimate
+	if doc == nil {
+		return est
+	}
+	s := sizer{est: &est, seen: make(map[uintptr]bool)}
+	s.value(reflect.ValueOf(doc))
+	est.Elements++ // the root, which is not held in a tagged field
+	walkDocumentLevels(doc, func(*level) bool {
+		est.Levels++
+		return true
+	})
+	return est
+}
+
+// sizer accumulates a SizeEstimate over a reflected document.
+type sizer struct {
+	est *SizeEstimate
+
+	// seen holds the pointers already counted, so shared values are counted
+	// once.
+	seen map[uintptr]bool
+}
+
+// value adds the memory v refers to outside its own inline storage, which
+// its container has already counted.
+func (s *sizer) value(v reflect.Value) {
+	switch v.Kind() {
+	case reflect.String:
+		s.est.Bytes += int64(v.Len())
+		s.est.StringBytes += int64(v.Len())
+	case reflect.Pointer:
+		if v.IsNil() || s.seen[v.Pointer()] {
+			return
+		}
+		s.seen[v.Pointer()] = true
+		s.est.Bytes += int64(v.Type().Elem().Size())
+		s.value(v.Elem())
+	case reflect.Interface:
+		if v.IsNil() {
+			return
+		}
+		e := v.Elem()
+		if e.Kind() != reflect.Pointer {
+			s.est.Bytes += int64(e.Type().Size())
+		}
+		s.value(e)
+	case reflect.Slice:
+		if v.IsNil() || s.seen[v.Pointer()] {
+			return
+		}
+		s.seen[v.Pointer()] = true
+		s.est.Bytes += int64(v.Cap()) * int64(v.Type().Elem().Size())
+		for i := 0; i < v.Len(); i++ {
+			s.value(v.Index(i))
+		}
+	case reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			s.value(v.Index(i))
+		}
+	case reflect.Map:
+		if v.IsNil() {
+			return
+		}
+		t := v.Type()
+		s.est.Bytes += int64(v.Len()) * int64(t.Key().Size()+t.Elem().Size()+unsafe.Sizeof(uintptr(0)))
+		iter := v.MapRange()
+		for iter.Next() {
+			s.value(iter.Key())
+			s.value(iter.Value())
+		}
+	case reflect.Struct:
+		t := v.Type()
+		for i := 0; i < t.NumField(); i++ {
+			f := v.Field(i)
+			if isElementField(t.Field(i)) {
+				s.est.Elements += elementCount(f)
+			}
+			s.value(f)
+		}
+	}
+}
+
+// isElementField reports whether f holds child elements when decoded.
+func isElementField(f reflect.StructField) bool {
+	if !f.IsExported() || f.Anonymous || f.Name == "XMLName" {
+		return false
+	}
+	tag := f.Tag.Get("xml")
+	if tag == "-" {
+		return false
+	}
+	_, flags, _ := strings.Cut(tag, ",")
+	return !strings.Contains(flags, "attr") && !strings.Contains(flags, "chardata") &&
+		!strings.Contains(flags, "innerxml") && !strings.Contains(flags, "comment")
+}
+
+// elementCount returns the number of elements held by an element field.
+func elementCount(v reflect.Value) int {
+	switch v.Kind() {
+	case reflect.Slice:
+		return v.Len()
+	case reflect.Pointer, reflect.Interface:
+		if v.IsNil() {
+			return 0
+		}
+		return 1
+	default:
+		if v.IsZero() {
+			return 0
+		}
+		return 1
+	}
+}
@@ -0,0 +1,310 @@
+package uslm
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+)
+
+// Fragment is one provision of a document split by Split, packaged as a
+// document of the same type so that it can be parsed, rendered, or
+// serialized on its own.
+type Fragment struct {
+	// Index is the fragment's position among the Count fragments of the
+	// document.
+	Index int `json:"index"`
+	Count int `json:"count"`
+
+	// TitleIndex is the position among the document's titles of the title
+	// enclosing the fragment's provision, or -1 for a provision that is not
+	// in a title.
+	TitleIndex int `json:"titleIndex"`
+
+	// Document holds the metadata and preface of the source document and a
+	// single top-level section, or a title holding a single section (or
+	// none, for a title without sections). The first fragment also holds
+	// the rest of the source: long title, enacting formula, table of
+	// contents, preamble, amendment instructions, signatures, appendices,
+	// and so on. Fragments share unmodified data with the source document.
+	Document LegislativeDocument `json:"-"`
+}
+
+// fragmentJSON is the JSON form of a Fragment, which records the document
+// type needed to decode it.
+type fragmentJSON struct {
+	Index        int             `json:"index"`
+	Count        int             `json:"count"`
+	TitleIndex   int             `json:"titleIndex"`
+	DocumentType DocumentType    `json:"documentType"`
+	Document     json.RawMessage `json:"document"`
+}
+
+// MarshalJSON encodes the fragment with its document type.
+func (f Fragment) MarshalJSON() ([]byte, error) {
+	doc, err := json.Marshal(f.Document)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(fragmentJSON{
+		Index:        f.Index,
+		Count:        f.Count,
+		TitleIndex:   f.TitleIndex,
+		DocumentType: documentTypeOf(f.Document),
+		Document:     doc,
+	})
+}
+
+// UnmarshalJSON decodes a fragment written by MarshalJSON.
+func (f *Fragment) UnmarshalJSON(data []byte) error {
+	var in fragmentJSON
+	if err := json.Unmarshal(data, &in); err != nil {
+		return err
+	}
+	doc, err := DocumentFromJSON(in.Document, in.DocumentType)
+	if err != nil {
+		return err
+	}
+	*f = Fragment{Index: in.Index, Count: in.Count, TitleIndex: in.TitleIndex, Document: doc}
+	return nil
+}
+
+// Split divides a document into one fragment per top-level section, and per
+// section within each title, in the order Provisions visits them (titles
+// first). A document without sections yields a single fragment. Assemble
+// reverses the split. Very large documents, such as omnibus bills, can then
+// be processed a provision at a time.
+func Split(doc LegislativeDocument) ([]Fragment, error) {
+	titles, sections, err := provisionSlices(doc)
+	if err != nil {
+		return nil, err
+	}
+
+	type piece struct {
+		title   int
+		section *Section
+	}
+	var pieces []piece
+	if titles != nil {
+		for i := range *titles {
+			if len((*titles)[i].Sections) == 0 {
+				pieces = append(pieces, piece{title: i})
+			}
+			for j := range (*titles)[i].Sections {
+				pieces = append(pieces, piece{title: i, section: &(*titles)[i].Sections[j]})
+			}
+		}
+	}
+	if sections != nil {
+		for i := range *sections {
+			pieces = append(pieces, piece{title: -1, section: &(*sections)[i]})
+		}
+	}
+	if len(pieces) == 0 {
+		pieces = append(pieces, piece{title: -2})
+	}
+
+	fragments := make([]Fragment, len(pieces))
+	for i, p := range pieces {
+		shell, shellTitles, shellSections := documentShell(doc, i == 0)
+		switch {
+		case p.title >= 0:
+			t := (*titles)[p.title]
+			t.Sections = nil
+			if p.section != nil {
+				t.Sections = []Section{*p.section}
+			}
+			*shellTitles = []Title{t}
+		case p.title == -1:
+			*shellSections = []Section{*p.section}
+		}
+		titleIndex := p.title
+		if titleIndex < -1 {
+			titleIndex = -1
+		}
+		fragments[i] = Fragment{Index: i, Count: len(pieces), TitleIndex: titleIndex, Document: shell}
+	}
+	return fragments, nil
+}
+
+// Assemble rebuilds the document split into fragments, which may be given
+// in any order but must all be present.
+func Assemble(fragments []Fragment) (LegislativeDocument, error) {
+	if len(fragments) == 0 {
+		return nil, fmt.Errorf("failed to assemble document: no fragments")
+	}
+	sorted := make([]Fragment, len(fragments))
+	copy(sorted, fragments)
+	sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].Index < sorted[j].Index })
+	docType := documentTypeOf(sorted[0].Document)
+	for i, f := range sorted {
+		if f.Index != i || f.Count != len(sorted) {
+			return nil, fmt.Errorf("failed to assemble document: fragment %d of %d is missing or repeated", i, len(sorted))
+		}
+		if documentTypeOf(f.Document) != docType {
+			return nil, fmt.Errorf("failed to assemble document: fragment %d is a %s, not a %s", i, documentTypeOf(f.Document), docType)
+		}
+	}
+
+	doc, titles, sections := documentShell(sorted[0].Document, true)
+	lastTitle := -1
+	for _, f := range sorted {
+		fragmentTitles, fragmentSections, err := provisionSlices(f.Document)
+		if err != nil {
+			return nil, fmt.Errorf("failed to assemble document: %w", err)
+		}
+		if fragmentTitles != nil && titles != nil {
+			for _, t := range *fragmentTitles {
+				if f.TitleIndex >= 0 && f.TitleIndex == lastTitle && len(*titles) > 0 {
+					last := &(*titles)[len(*titles)-1]
+					last.Sections = append(last.Sections, t.Sections...)
+					continue
+				}
+				t.Sections = append([]Section(nil), t.Sections...)
+				*titles = append(*titles, t)
+				lastTitle = f.TitleIndex
+			}
+		}
+		if fragmentSections != nil && sections != nil {
+			*sections = append(*sections, *fragmentSections...)
+		}
+	}
+	return doc, nil
+}
+
+// provisionSlices returns the titles and top-level sections of doc, either
+// of which is nil when the document has no element to hold them.
+func provisionSlices(doc LegislativeDocument) (*[]Title, *[]Section, error) {
+	switch d := doc.(type) {
+	case *Bill:
+		if d.Main != nil {
+			return &d.Main.Titles, &d.Main.Sections, nil
+		}
+	case *Resolution:
+		if d.Main != nil {
+			return &d.Main.Titles, &d.Main.Sections, nil
+		}
+	case *EngrossedAmendment:
+		if d.AmendMain != nil {
+			return nil, &d.AmendMain.Sections, nil
+		}
+	case *Amendment:
+		if d.AmendMain != nil {
+			return nil, &d.AmendMain.Sections, nil
+		}
+	case *GenericDocument:
+		if d.Content != nil {
+			return &d.Content.Titles, &d.Content.Sections, nil
+		}
+	default:
+		return nil, nil, fmt.Errorf("unsupported document type %T", doc)
+	}
+	return nil, nil, nil
+}
+
+// documentShell returns a copy of doc without titles or top-level sections,
+// and the slices of the copy that hold them. Unless full is set, the copy
+// keeps only the root attributes, metadata, and preface, and the attributes
+// of the element holding the provisions.
+func documentShell(doc LegislativeDocument, full bool) (LegislativeDocument, *[]Title, *[]Section) {
+	switch d := doc.(type) {
+	case *Bill:
+		c := *d
+		c.lexical = nil
+		if !full {
+			c.EndMarker, c.DigitalSignature = "", nil
+		}
+		if d.Main == nil {
+			return &c, nil, nil
+		}
+		c.Main = mainShell(d.Main, full)
+		return &c, &c.Main.Titles, &c.Main.Sections
+	case *Resolution:
+		c := *d
+		c.lexical = nil
+		if !full {
+			c.EndMarker, c.DigitalSignature = "", nil
+		}
+		if d.Main == nil {
+			return &c, nil, nil
+		}
+		c.Main = mainShell(d.Main, full)
+		return &c, &c.Main.Titles, &c.Main.Sections
+	case *EngrossedAmendment:
+		c := *d
+		c.lexical = nil
+		if !full {
+			c.Signatures, c.Endorsement, c.DigitalSignature = nil, nil, nil
+		}
+		if d.AmendMain == nil {
+			return &c, nil, nil
+		}
+		c.AmendMain = amendMainShell(d.AmendMain, full)
+		return &c, nil, &c.AmendMain.Sections
+	case *Amendment:
+		c := *d
+		c.lexical = nil
+		if !full {
+			c.DigitalSignature = nil
+		}
+		if d.AmendMain == nil {
+			return &c, nil, nil
+		}
+		c.AmendMain = amendMainShell(d.AmendMain, full)
+		return &c, nil, &c.AmendMain.Sections
+	case *GenericDocument:
+		c := *d
+		c.lexical = nil
+		if !full {
+			c.Appendices, c.DigitalSignature = nil, nil
+		}
+		if d.Content == nil {
+			return &c, nil, nil
+		}
+		content := *d.Content
+		content.Titles, content.Sections = nil, nil
+		if !full {
+			content = DocumentContent{XMLName: d.Content.XMLName, Class: d.Content.Class, Attrs: d.Content.Attrs}
+		}
+		c.Content = &content
+		return &c, &c.Content.Titles, &c.Content.Sections
+	}
+	return doc, nil, nil
+}
+
+// mainShell returns a copy of m without titles or sections; see
+// documentShell.
+func mainShell(m *Main, full bool) *Main {
+	c := *m
+	c.Titles, c.Sections = nil, nil
+	if !full {
+		c = Main{XMLName: m.XMLName, StyleType: m.StyleType, Changed: m.Changed, Origin: m.Origin, Attrs: m.Attrs}
+	}
+	return &c
+}
+
+// amendMainShell returns a copy of m without sections; see documentShell.
+func amendMainShell(m *AmendMain, full bool) *AmendMain {
+	c := *m
+	c.Sections = nil
+	if !full {
+		c = AmendMain{XMLName: m.XMLName, AmendmentInstructionLineNumbering: m.AmendmentInstructionLineNumbering, Attrs: m.Attrs}
+	}
+	return &c
+}
+
+// documentTypeOf returns the DocumentType of a document struct.
+func documentTypeOf(doc LegislativeDocument) DocumentType {
+	switch doc.(type) {
+	case *Bill:
+		return DocumentTypeBill
+	case *Resolution:
+		return DocumentTypeResolution
+	case *EngrossedAmendment:
+		return DocumentTypeEngrossedAmendment
+	case *Amendment:
+		return DocumentTypeAmendment
+	case *GenericDocument:
+		return DocumentTypeGeneric
+	}
+	return DocumentTypeUnknown
+}
@@ -0,0 +1,244 @@
+package uslm
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"sync"
+)
+
+// CorpusStats summarizes a corpus of documents for research reporting.
+type CorpusStats struct {
+	// Documents is the number of documents parsed, and Failed the number of
+	// files that could not be read or parsed.
+	Documents int `json:"documents"`
+	Failed    int `json:"failed"`
+
+	// ByCongress, ByStage, and ByChamber count documents by the values of
+	// GetCongress, GetStage, and GetChamber. Documents without a value are
+	// counted under "".
+	ByCongress map[string]int `json:"byCongress"`
+	ByStage    map[string]int `json:"byStage"`
+	ByChamber  map[string]int `json:"byChamber"`
+
+	// Members counts the documents each member sponsored and cosponsored,
+	// most frequent sponsors first.
+	Members []MemberStats `json:"members"`
+
+	// Sections is the total number of sections, including those within
+	// titles, and AverageSections the mean per document. Both count only
+	// documents with at least one hierarchical level, so documents parsed
+	// metadata-only do not lower the average.
+	Sections        int     `json:"sections"`
+	AverageSections float64 `json:"averageSections"`
+}
+
+// MemberStats counts a member's sponsorships in CorpusStats.
+type MemberStats struct {
+	// ID is the member's MemberID in its "scheme:value" form, or "" if the
+	// member has none, in which case members are told apart by Name.
+	ID          string `json:"id,omitempty"`
+	Name        string `json:"name"`
+	Sponsored   int    `json:"sponsored"`
+	Cosponsored int    `json:"cosponsored"`
+}
+
+// CorpusAggregator accumulates CorpusStats from scan results. It is safe for
+// concurrent use.
+type CorpusAggregator struct {
+	mu         sync.Mutex
+	stats      CorpusStats
+	members    map[string]*MemberStats
+	withBodies int
+}
+
+// NewCorpusAggregator returns an empty CorpusAggregator.
+func NewCorpusAggregator() *CorpusAggregator {
+	return &CorpusAggregator{
+		stats: CorpusStats{
+			ByCongress: make(map[string]int),
+			ByStage:    make(map[string]int),
+			ByChamber:  make(map[string]int),
+		},
+		members: make(map[string]*MemberStats),
+	}
+}
+
+// AggregateCorpus adds every result received from results, such as the
+// channel returned by ScanCorpus, and returns the statistics once it is
+// closed.
+func AggregateCorpus(results <-chan ScanResult) *CorpusStats {
+	a := NewCorpusAggregator()
+	for r := range results {
+		a.Add(r)
+	}
+	return a.Stats()
+}
+
+// Add counts one scan result. A result with an error counts as failed.
+func (a *CorpusAggregator) Add(r ScanResult) {
+	if r.Err != nil || r.Document == nil {
+		a.mu.Lock()
+		a.stats.Failed++
+		a.mu.Unlock()
+		return
+	}
+	a.AddDocument(r.Document)
+}
+
+// AddDocument counts one document.
+func (a *CorpusAggregator) AddDocument(doc LegislativeDocument) {
+	sections, hasBody := 0, false
+	walkDocumentLevels(doc, func(l *level) bool {
+		hasBody = true
+		if l.element == "section" {
+			sections++
+		}
+		return true
+	})
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.stats.Documents++
+	a.stats.ByCongress[normalizeSpace(doc.GetCongress())]++
+	a.stats.ByStage[normalizeSpace(doc.GetStage())]++
+	a.stats.ByChamber[normalizeSpace(doc.GetChamber())]++
+	if hasBody {
+		a.withBodies++
+		a.stats.Sections += sections
+	}
+
+	s, ok := doc.(SponsoredDocument)
+	if !ok {
+		return
+	}
+	for _, sp := range s.GetSponsors() {
+		if m := a.member(sp.GetMemberID(), memberName(sp.Text, sp.Inline)); m != nil {
+			m.Sponsored++
+		}
+	}
+	for _, c := range s.GetCosponsors() {
+		if m := a.member(c.GetMemberID(), memberName(c.Text, c.Inline)); m != nil {
+			m.Cosponsored++
+		}
+	}
+}
+
+// member returns the counts for the member with the given ID or, when the
+// ID is empty, name. It returns nil for a member with neither.
+func (a *CorpusAggregator) member(id MemberID, name string) *MemberStats {
+	key := id.String()
+	if key == "" {
+		if name == "" {
+			return nil
+		}
+		key = "name:" + name
+	}
+	m, ok := a.members[key]
+	if !ok {
+		m = &MemberStats{ID: id.String(), Name: name}
+		a.members[key] = m
+	}
+	if m.Name == "" {
+		m.Name = name
+	}
+	return m
+}
+
+// Stats returns the statistics accumulated so far.
+func (a *CorpusAggregator) Stats() *CorpusStats {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	stats := a.stats
+	stats.ByCongress = copyCounts(a.stats.ByCongress)
+	stats.ByStage = copyCounts(a.stats.ByStage)
+	stats.ByChamber = copyCounts(a.stats.ByChamber)
+	stats.Members = make([]MemberStats, 0, len(a.members))
+	for _, m := range a.members {
+		stats.Members = append(stats.Members, *m)
+	}
+	sort.Slice(stats.Members, func(i, j int) bool {
+		mi, mj := stats.Members[i], stats.Members[j]
+		if mi.Sponsored != mj.Sponsored {
+			return mi.Sponsored > mj.Sponsored
+		}
+		if mi.Cosponsored != mj.Cosponsored {
+			return mi.Cosponsored > mj.Cosponsored
+		}
+		if mi.ID != mj.ID {
+			return mi.ID < mj.ID
+		}
+		return mi.Name < mj.Name
+	})
+	if a.withBodies > 0 {
+		stats.AverageSections = float64(stats.Sections) / float64(a.withBodies)
+	}
+	return &stats
+}
+
+func copyCounts(counts map[string]int) map[string]int {
+	c := make(map[string]int, len(counts))
+	for k, v := range counts {
+		c[k] = v
+	}
+	return c
+}
+
+// WriteJSON writes the statistics as indented JSON.
+func (s *CorpusStats) WriteJSON(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(s); err != nil {
+		return fmt.Errorf("failed to write JSON: %w", err)
+	}
+	return nil
+}
+
+// WriteCSV writes the statistics as CSV with the columns metric, key, name,
+// and value: one row each for the documents, failed, sections, and
+// averageSections totals (with an empty key), one row per congress, stage,
+// and chamber count, and sponsored and cosponsored rows per member keyed by
+// MemberID with the member's name. Count rows are in key order; member rows
+// follow the order of Members.
+func (s *CorpusStats) WriteCSV(w io.Writer) error {
+	cw := csv.NewWriter(w)
+	cw.Write([]string{"metric", "key", "name", "value"})
+	cw.Write([]string{"documents", "", "", strconv.Itoa(s.Documents)})
+	cw.Write([]string{"failed", "", "", strconv.Itoa(s.Failed)})
+	cw.Write([]string{"sections", "", "", strconv.Itoa(s.Sections)})
+	cw.Write([]string{"averageSections", "", "", strconv.FormatFloat(s.AverageSections, 'f', 2, 64)})
+	for _, group := range []struct {
+		metric string
+		counts map[string]int
+	}{
+		{"congress", s.ByCongress},
+		{"stage", s.ByStage},
+		{"chamber", s.ByChamber},
+	} {
+		keys := make([]string, 0, len(group.counts))
+		for k := range group.counts {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			cw.Write([]string{group.metric, k, "", strconv.Itoa(group.counts[k])})
+		}
+	}
+	for _, m := range s.Members {
+		if m.Sponsored > 0 {
+			cw.Write([]string{"sponsored", m.ID, m.Name, strconv.Itoa(m.Sponsored)})
+		}
+		if m.Cosponsored > 0 {
+			cw.Write([]string{"cosponsored", m.ID, m.Name, strconv.Itoa(m.Cosponsored)})
+		}
+	}
+	cw.Flush()
+	if err := cw.Error(); err != nil {
+		return fmt.Errorf("failed to write CSV: %w", err)
+	}
+	return nil
+}
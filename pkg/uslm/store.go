@@ -0,0 +1,219 @@
+package uslm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// ErrNotFound is returned by Store.Get when no document is stored under an id.
+var ErrNotFound = errors.New("document not found")
+
+// ErrReadOnly is returned by Store.Put on stores that cannot be written.
+var ErrReadOnly = errors.New("store is read-only")
+
+// Store is the persistence abstraction shared by tools built on this package.
+// Document ids are package IDs such as "BILLS-114s32cds".
+type Store interface {
+	// Get returns the document stored under id, or an error wrapping
+	// ErrNotFound if there is none.
+	Get(ctx context.Context, id string) (LegislativeDocument, error)
+
+	// Put stores doc under id, replacing any existing document.
+	Put(ctx context.Context, id string, doc LegislativeDocument) error
+
+	// List returns the ids of all stored documents in sorted order.
+	List(ctx context.Context) ([]string, error)
+}
+
+// Ensure the store implementations satisfy Store
+var (
+	_ Store = (*FSStore)(nil)
+	_ Store = (*MemoryStore)(nil)
+)
+
+// FSStore is a Store over a directory of USLM XML files named "<id>.xml",
+// the extension in any case (see IsXMLFileName).
+type FSStore struct {
+	fsys fs.FS
+	dir  string // empty for read-only stores
+}
+
+// NewFSStore returns a read-only store reading documents from fsys, such as
+// an embed.FS or a zip archive.
+func NewFSStore(fsys fs.FS) *FSStore {
+	return &FSStore{fsys: fsys}
+}
+
+// NewDirStore returns a store reading and writing documents in dir.
+func NewDirStore(dir string) *FSStore {
+	return &FSStore{fsys: os.DirFS(dir), dir: dir}
+}
+
+// Get parses the file for id.
+func (s *FSStore) Get(ctx context.Context, id string) (LegislativeDocument, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if !validStoreID(id) {
+		return nil, fmt.Errorf("invalid document id %q: %w", id, ErrNotFound)
+	}
+	name, err := s.fileName(id)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, fmt.Errorf("%s: %w", id, ErrNotFound)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read document %s: %w", id, err)
+	}
+	data, err := fs.ReadFile(s.fsys, name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read document %s: %w", id, err)
+	}
+	doc, err := ParseDocument(data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse document %s: %w", id, err)
+	}
+	return doc, nil
+}
+
+// Put writes doc as XML to the file for id, replacing it atomically. A new
+// file is named "<id>.xml". It returns ErrReadOnly for stores created with
+// NewFSStore.
+func (s *FSStore) Put(ctx context.Context, id string, doc LegislativeDocument) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if s.dir == "" {
+		return ErrReadOnly
+	}
+	if !validStoreID(id) {
+		return fmt.Errorf("invalid document id %q", id)
+	}
+	data, err := MarshalDocumentToXML(doc)
+	if err != nil {
+		return err
+	}
+	name, err := s.fileName(id)
+	if errors.Is(err, fs.ErrNotExist) {
+		name = id + ".xml"
+	} else if err != nil {
+		return fmt.Errorf("failed to write document %s: %w", id, err)
+	}
+
+	tmp, err := os.CreateTemp(s.dir, "."+id+".*.tmp")
+	if err != nil {
+		return fmt.Errorf("failed to write document %s: %w", id, err)
+	}
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
+		return fmt.Errorf("failed to write document %s: %w", id, err)
+	}
+	if err := tmp.Close(); err != nil {
+		os.Remove(tmp.Name())
+		return fmt.Errorf("failed to write document %s: %w", id, err)
+	}
+	if err := os.Rename(tmp.Name(), filepath.Join(s.dir, name)); err != nil {
+		os.Remove(tmp.Name())
+		return fmt.Errorf("failed to write document %s: %w", id, err)
+	}
+	return nil
+}
+
+// List returns the ids of the XML files in the store's root directory.
+func (s *FSStore) List(ctx context.Context) ([]string, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	entries, err := fs.ReadDir(s.fsys, ".")
+	if err != nil {
+		return nil, fmt.Errorf("failed to list documents: %w", err)
+	}
+	var ids []string
+	for _, e := range entries {
+		if name := e.Name(); !e.IsDir() && IsXMLFileName(name) {
+			ids = append(ids, strings.TrimSuffix(name, path.Ext(name)))
+		}
+	}
+	sort.Strings(ids)
+	return ids, nil
+}
+
+// fileName returns the name of the file holding id, whatever the case of its
+// extension, or an error wrapping fs.ErrNotExist if there is none.
+func (s *FSStore) fileName(id string) (string, error) {
+	if _, err := fs.Stat(s.fsys, id+".xml"); err == nil {
+		return id + ".xml", nil
+	}
+	entries, err := fs.ReadDir(s.fsys, ".")
+	if err != nil {
+		return "", err
+	}
+	for _, e := range entries {
+		name := e.Name()
+		if !e.IsDir() && IsXMLFileName(name) && strings.TrimSuffix(name, path.Ext(name)) == id {
+			return name, nil
+		}
+	}
+	return "", fs.ErrNotExist
+}
+
+// IsXMLFileName reports whether name has an ".xml" extension in any case.
+// GPO publishes files named both "BILLS-114s32cds.xml" and "H1000_IH.XML".
+func IsXMLFileName(name string) bool {
+	return strings.EqualFold(path.Ext(name), ".xml")
+}
+
+// validStoreID reports whether id names a file directly inside the store.
+func validStoreID(id string) bool {
+	return id != "" && fs.ValidPath(id) && !strings.ContainsAny(id, `/\`) && !strings.HasPrefix(id, ".")
+}
+
+// MemoryStore is a Store backed by a map. It is safe for concurrent use.
+// Documents are stored by reference, so callers share them with the store.
+type MemoryStore struct {
+	mu   sync.RWMutex
+	docs map[string]LegislativeDocument
+}
+
+// NewMemoryStore returns an empty MemoryStore.
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{docs: make(map[string]LegislativeDocument)}
+}
+
+// Get returns the document stored under id.
+func (m *MemoryStore) Get(_ context.Context, id string) (LegislativeDocument, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	if doc, ok := m.docs[id]; ok {
+		return doc, nil
+	}
+	return nil, fmt.Errorf("%s: %w", id, ErrNotFound)
+}
+
+// Put stores doc under id.
+func (m *MemoryStore) Put(_ context.Context, id string, doc LegislativeDocument) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.docs[id] = doc
+	return nil
+}
+
+// List returns the ids of all stored documents in sorted order.
+func (m *MemoryStore) List(_ context.Context) ([]string, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	ids := make([]string, 0, len(m.docs))
+	for id := range m.docs {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	return ids, nil
+}
@@ -0,0 +1,189 @@
+package uslm
+
+import (
+	"context"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// StreamParser decodes a document as a stream, passing each section, action,
+// and reference to the handlers registered for it as soon as it has been
+// read. Only the element being handed over is held in memory, so extraction
+// pipelines can pull what they need from the largest bills cheaply:
+//
+//	p := uslm.NewStreamParser(uslm.ParseOptions{})
+//	p.OnSection(func(s uslm.Section) { index(s) })
+//	p.OnRef(func(r uslm.Ref) { links = append(links, r.Href) })
+//	doc, err := p.Parse(f)
+//
+// A StreamParser may be reused but not shared by concurrent parses.
+type StreamParser struct {
+	opts      ParseOptions
+	onSection []func(Section)
+	onAction  []func(Action)
+	onRef     []func(Ref)
+}
+
+// NewStreamParser returns a StreamParser that applies opts to each parse.
+func NewStreamParser(opts ParseOptions) *StreamParser {
+	return &StreamParser{opts: opts}
+}
+
+// OnSection registers fn to receive every section of the document body,
+// including those within titles, in document order. Sections quoted in
+// amending text are part of the section or instruction quoting them and are
+// not reported on their own.
+func (p *StreamParser) OnSection(fn func(Section)) {
+	p.onSection = append(p.onSection, fn)
+}
+
+// OnAction registers fn to receive every action of the document: those of
+// its preface, and those recorded in its attestation and endorsement.
+func (p *StreamParser) OnAction(fn func(Action)) {
+	p.onAction = append(p.onAction, fn)
+}
+
+// OnRef registers fn to receive every reference in the document, wherever it
+// appears. A ref nested in another is reported as the outer ref's InnerRef.
+// Refs are reported as they are read, so those of a section come before the
+// section itself.
+func (p *StreamParser) OnRef(fn func(Ref)) {
+	p.onRef = append(p.onRef, fn)
+}
+
+// Parse reads the document from r, calling the registered handlers, and
+// returns a document holding only its metadata block. It stops with an error
+// if the document is malformed, exceeds the parse limits, or the context of
+// the parse options is done.
+func (p *StreamParser) Parse(r io.Reader) (LegislativeDocument, error) {
+	_, span := StartSpan(p.opts.Context, "uslm.StreamParser.Parse")
+	run := beginParse("StreamParser.Parse")
+	doc, err := p.parse(r, run)
+	run.end(err)
+	EndSpan(span, doc, err)
+	return doc, err
+}
+
+func (p *StreamParser) parse(r io.Reader, run *parseRun) (LegislativeDocument, error) {
+	ctx := p.opts.Context
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	tap := &refTap{src: newDecoder(r, p.opts, run), onRef: p.onRef}
+	d := xml.NewTokenDecoder(tap)
+	_, doc, err := decodeRoot(d)
+	if err != nil {
+		return nil, err
+	}
+	run.phase(PhaseProlog)
+
+	// quoted counts the open quotedContent elements, whose sections belong
+	// to the text quoting them.
+	quoted := 0
+	for {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		tok, err := d.Token()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read document: %w", err)
+		}
+		switch t := tok.(type) {
+		case xml.StartElement:
+			switch {
+			case t.Name.Local == "quotedContent":
+				quoted++
+			case t.Name.Local == "meta":
+				meta := &Meta{}
+				if err := d.DecodeElement(meta, &t); err != nil {
+					return nil, fmt.Errorf("failed to parse meta: %w", err)
+				}
+				setDocumentMeta(doc, meta, nil)
+			case t.Name.Local == "amendMeta":
+				meta := &AmendMeta{}
+				if err := d.DecodeElement(meta, &t); err != nil {
+					return nil, fmt.Errorf("failed to parse amendMeta: %w", err)
+				}
+				setDocumentMeta(doc, nil, meta)
+			case t.Name.Local == "section" && quoted == 0 && len(p.onSection) > 0:
+				var s Section
+				if err := d.DecodeElement(&s, &t); err != nil {
+					return nil, fmt.Errorf("failed to parse section: %w", err)
+				}
+				for _, fn := range p.onSection {
+					fn(s)
+				}
+			case t.Name.Local == "action" && len(p.onAction) > 0:
+				var a Action
+				if err := d.DecodeElement(&a, &t); err != nil {
+					return nil, fmt.Errorf("failed to parse action: %w", err)
+				}
+				for _, fn := range p.onAction {
+					fn(a)
+				}
+			}
+		case xml.EndElement:
+			if t.Name.Local == "quotedContent" {
+				quoted--
+			}
+		}
+	}
+	run.phase(PhaseDecode)
+	return doc, nil
+}
+
+// refTap passes tokens through from src, copying those of each outermost
+// <ref> aside and decoding them into a Ref for the handlers when it ends.
+type refTap struct {
+	src   xml.TokenReader
+	onRef []func(Ref)
+
+	// ref holds the tokens of the ref being read, and depth the number of
+	// its elements still open.
+	ref   []xml.Token
+	depth int
+}
+
+func (t *refTap) Token() (xml.Token, error) {
+	tok, err := t.src.Token()
+	if err != nil || len(t.onRef) == 0 {
+		return tok, err
+	}
+	switch el := tok.(type) {
+	case xml.StartElement:
+		if t.depth > 0 || el.Name.Local == "ref" {
+			t.depth++
+		}
+	case xml.EndElement:
+		if t.depth > 0 {
+			t.depth--
+			if t.depth == 0 {
+				t.ref = append(t.ref, xml.CopyToken(tok))
+				err := t.emit()
+				t.ref = t.ref[:0]
+				return tok, err
+			}
+		}
+	}
+	if t.depth > 0 {
+		t.ref = append(t.ref, xml.CopyToken(tok))
+	}
+	return tok, nil
+}
+
+// emit decodes the buffered ref and calls the handlers.
+func (t *refTap) emit() error {
+	var ref Ref
+	if err := xml.NewTokenDecoder(&tokenReplay{tokens: t.ref}).Decode(&ref); err != nil {
+		return fmt.Errorf("failed to parse ref: %w", err)
+	}
+	for _, fn := range t.onRef {
+		fn(ref)
+	}
+	return nil
+}
@@ -0,0 +1,181 @@
+package uslm
+
+import "strings"
+
+// Substitute is an amendment in the nature of a substitute (AINS): a
+// committee's replacement for the whole text of a measure, as carried by a
+// reported version. Analyses usually want the substitute rather than the
+// text it strikes.
+type Substitute struct {
+	// Origin is the reference to the committee proposing the substitute,
+	// e.g. "#SSFR00", and Committee the committee it names, when the
+	// document's preface mentions it.
+	Origin    string     `json:"origin,omitempty"`
+	Committee *Committee `json:"committee,omitempty"`
+
+	// Main holds the substitute text. Original holds the text it strikes,
+	// or is nil when the version does not print it (as when the reported
+	// version refers readers to the introduced bill).
+	Main     *Main `json:"main"`
+	Original *Main `json:"original,omitempty"`
+}
+
+// Provisions returns the top-level provisions of the substitute text.
+func (s *Substitute) Provisions() []*Provision {
+	return provisionTree("", func(fn func(l *level) bool) {
+		walkMainLevels(s.Main, fn)
+	})
+}
+
+// OriginalProvisions returns the top-level provisions of the struck text.
+func (s *Substitute) OriginalProvisions() []*Provision {
+	return provisionTree("", func(fn func(l *level) bool) {
+		walkMainLevels(s.Original, fn)
+	})
+}
+
+// GetSubstitutes detects amendments in the nature of a substitute in a bill or
+// resolution and returns them in document order. A version reported by
+// several committees may carry one substitute from each. Three encodings are
+// recognized:
+//
+//   - a component of main's collection marked changed="added", paired with
+//     the preceding component marked changed="deleted" by the same
+//     committee;
+//   - a main marked changed="added" as a whole;
+//   - a main whose top-level titles and sections are each marked added or
+//     deleted, with at least one added.
+//
+// Amendments to individual provisions are not substitutes; see
+// GetProposedChanges for those.
+func GetSubstitutes(doc LegislativeDocument) []Substitute {
+	var main *Main
+	switch d := doc.(type) {
+	case *Bill:
+		main = d.Main
+	case *Resolution:
+		main = d.Main
+	}
+	if main == nil {
+		return nil
+	}
+	var committees []Committee
+	if c, ok := doc.(CommitteeDocument); ok {
+		committees = c.GetCommittees()
+	}
+	newSubstitute := func(origin string, m, original *Main) Substitute {
+		return Substitute{Origin: origin, Committee: committeeOf(committees, origin), Main: m, Original: original}
+	}
+
+	if main.Changed == ChangeAdded {
+		return []Substitute{newSubstitute(main.Origin, main, nil)}
+	}
+
+	var subs []Substitute
+	var struck []*Component
+	var components []Component
+	if main.Collection != nil {
+		components = main.Collection.Components
+	}
+	for i := range components {
+		c := &components[i]
+		switch {
+		case c.Main == nil:
+		case c.Changed == ChangeDeleted:
+			struck = append(struck, c)
+		case c.Changed == ChangeAdded:
+			var original *Main
+			for j, s := range struck {
+				if s.Origin == c.Origin {
+					original = s.Main
+					struck = append(struck[:j], struck[j+1:]...)
+					break
+				}
+			}
+			subs = append(subs, newSubstitute(c.Origin, c.Main, original))
+		}
+	}
+	if len(subs) > 0 {
+		return subs
+	}
+
+	if origin, ok := substitutedLevels(main); ok {
+		added, deleted := *main, *main
+		added.Titles, added.Sections, deleted.Titles, deleted.Sections = nil, nil, nil, nil
+		for _, t := range main.Titles {
+			if t.Changed == ChangeAdded {
+				added.Titles = append(added.Titles, t)
+			} else {
+				deleted.Titles = append(deleted.Titles, t)
+			}
+		}
+		for _, s := range main.Sections {
+			if s.Changed == ChangeAdded {
+				added.Sections = append(added.Sections, s)
+			} else {
+				deleted.Sections = append(deleted.Sections, s)
+			}
+		}
+		var original *Main
+		if len(deleted.Titles) > 0 || len(deleted.Sections) > 0 {
+			original = &deleted
+		}
+		return []Substitute{newSubstitute(origin, &added, original)}
+	}
+	return nil
+}
+
+// GetSubstitute returns the last substitute GetSubstitutes detects in doc,
+// which is the one reported last, or nil if there is none.
+func GetSubstitute(doc LegislativeDocument) *Substitute {
+	subs := GetSubstitutes(doc)
+	if len(subs) == 0 {
+		return nil
+	}
+	return &subs[len(subs)-1]
+}
+
+// substitutedLevels reports whether every top-level title and section of main
+// is marked added or deleted, with at least one added, and returns the origin
+// of the first added level.
+func substitutedLevels(main *Main) (string, bool) {
+	origin, added := "", false
+	mark := func(changed ChangeMarker, attrs Attributes) bool {
+		switch changed {
+		case ChangeAdded:
+			if !added {
+				origin, added = attrs.Get("origin"), true
+			}
+			return true
+		case ChangeDeleted:
+			return true
+		}
+		return false
+	}
+	for _, t := range main.Titles {
+		if !mark(t.Changed, t.Attrs) {
+			return "", false
+		}
+	}
+	for _, s := range main.Sections {
+		if !mark(s.Changed, s.Attrs) {
+			return "", false
+		}
+	}
+	return origin, added
+}
+
+// committeeOf returns the committee an origin reference such as "#SSFR00"
+// points to, or nil.
+func committeeOf(committees []Committee, origin string) *Committee {
+	id := strings.TrimPrefix(origin, "#")
+	if id == "" {
+		return nil
+	}
+	for i := range committees {
+		if committees[i].CommitteeID == id {
+			return &committees[i]
+		}
+	}
+	return nil
+}
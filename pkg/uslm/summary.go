@@ -0,0 +1,176 @@
+package uslm
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// Summary is a section-by-section outline of a document, suitable for briefing memos.
+type Summary struct {
+	DocumentNumber string         `json:"documentNumber,omitempty"`
+	DocumentType   string         `json:"documentType,omitempty"`
+	Title          string         `json:"title,omitempty"`
+	Entries        []SummaryEntry `json:"entries,omitempty"`
+}
+
+// SummaryEntry is a single line of the outline: a title division or a section.
+type SummaryEntry struct {
+	Element       string `json:"element"`
+	Identifier    string `json:"identifier,omitempty"`
+	Num           string `json:"num,omitempty"`
+	Heading       string `json:"heading,omitempty"`
+	FirstSentence string `json:"firstSentence,omitempty"`
+}
+
+// Summarize produces an outline of the document's titles and sections, giving
+// each section's number, heading, and the first sentence of its text. Where a
+// section has no content of its own, the first sentence is taken from its
+// chapeau or, failing that, from its first subsection or paragraph.
+func Summarize(doc LegislativeDocument) *Summary {
+	summary := &Summary{
+		DocumentNumber: doc.GetDocumentNumber(),
+		DocumentType:   doc.GetDocumentType(),
+		Title:          normalizeSpace(doc.GetTitle()),
+	}
+
+	current := -1
+	walkDocumentLevels(doc, func(l *level) bool {
+		switch l.element {
+		case "title":
+			summary.Entries = append(summary.Entries, summaryEntry(l))
+			current = -1
+			return true
+		case "section":
+			entry := summaryEntry(l)
+			entry.FirstSentence = firstSentence(levelText(l))
+			summary.Entries = append(summary.Entries, entry)
+			current = len(summary.Entries) - 1
+			return entry.FirstSentence == ""
+		}
+
+		// Descendants are only visited while the enclosing section lacks text.
+		if current < 0 {
+			return false
+		}
+		entry := &summary.Entries[current]
+		if entry.FirstSentence == "" {
+			entry.FirstSentence = firstSentence(levelText(l))
+		}
+		return entry.FirstSentence == ""
+	})
+
+	return summary
+}
+
+// summaryEntry returns an outline entry for the level without its first sentence.
+func summaryEntry(l *level) SummaryEntry {
+	entry := SummaryEntry{
+		Element:    l.element,
+		Identifier: *l.identifier,
+	}
+	if l.num != nil {
+		entry.Num = normalizeSpace(l.num.Text)
+	}
+	if l.heading != nil {
+		entry.Heading = l.heading.PlainText()
+	}
+	return entry
+}
+
+// levelText returns the level's content text, falling back to its chapeau.
+func levelText(l *level) string {
+	if l.content != nil {
+		if text := l.content.PlainText(); text != "" {
+			return text
+		}
+	}
+	if l.chapeau != nil {
+		return l.chapeau.PlainText()
+	}
+	return ""
+}
+
+// firstSentence returns the first sentence of text. A period ends a sentence
+// when it is followed by whitespace and an uppercase letter or opening quote,
+// and is not part of a common legislative abbreviation (e.g., "U.S.C.", "Sec.").
+// A colon introducing quoted matter (e.g., "the following: “(4) ...") also ends
+// the sentence, so amendatory text is not pulled into the summary.
+func firstSentence(text string) string {
+	text = normalizeSpace(text)
+	runes := []rune(text)
+	for i, r := range runes {
+		if (r != '.' && r != ':') || i+2 >= len(runes) || runes[i+1] != ' ' {
+			continue
+		}
+		next := runes[i+2]
+		if r == ':' {
+			if next == '“' || next == '"' {
+				return string(runes[:i+1])
+			}
+			continue
+		}
+		if !unicode.IsUpper(next) && next != '“' && next != '"' {
+			continue
+		}
+		if isAbbreviation(string(runes[:i+1])) {
+			continue
+		}
+		return string(runes[:i+1])
+	}
+	return text
+}
+
+// sentenceAbbreviations are words ending in a period that do not end a sentence.
+var sentenceAbbreviations = []string{
+	"U.S.C.", "U.S.", "Sec.", "sec.", "No.", "Nos.", "Stat.", "Pub.", "L.", "Res.", "Con.",
+	"J.", "H.", "S.", "R.", "Mr.", "Mrs.", "Ms.", "Dr.", "et seq.", "etc.", "i.e.", "e.g.", "v.", "Inc.", "Co.",
+}
+
+// isAbbreviation reports whether text ends with a known abbreviation or a single
+// capital letter followed by a period (an initial).
+func isAbbreviation(text string) bool {
+	for _, abbr := range sentenceAbbreviations {
+		if strings.HasSuffix(text, " "+abbr) || text == abbr {
+			return true
+		}
+	}
+	fields := strings.Fields(text)
+	last := []rune(fields[len(fields)-1])
+	return len(last) == 2 && unicode.IsUpper(last[0])
+}
+
+// Markdown renders the summary as a Markdown outline.
+func (s *Summary) Markdown() string {
+	var b strings.Builder
+
+	heading := strings.TrimSpace(strings.TrimSpace(s.DocumentType) + " " + s.DocumentNumber)
+	switch {
+	case heading != "" && s.Title != "":
+		fmt.Fprintf(&b, "# %s — %s\n", heading, s.Title)
+	case heading != "":
+		fmt.Fprintf(&b, "# %s\n", heading)
+	case s.Title != "":
+		fmt.Fprintf(&b, "# %s\n", s.Title)
+	}
+
+	for _, e := range s.Entries {
+		label := strings.TrimSpace(e.Num + " " + e.Heading)
+		switch e.Element {
+		case "title":
+			fmt.Fprintf(&b, "\n## %s\n", label)
+		default:
+			b.WriteString("\n")
+			if label != "" {
+				fmt.Fprintf(&b, "**%s**", label)
+				if e.FirstSentence != "" {
+					b.WriteString(" ")
+				}
+			}
+			b.WriteString(e.FirstSentence)
+			b.WriteString("\n")
+		}
+	}
+
+	return b.String()
+}
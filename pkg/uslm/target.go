@@ -0,0 +1,108 @@
+package uslm
+
+import (
+	"regexp"
+	"strings"
+)
+
+// TargetMeasure identifies the bill or resolution an amendment amends.
+type TargetMeasure struct {
+	Congress string `json:"congress"`
+
+	// Type is the measure type as used in citations and identifiers: hr, s,
+	// hres, sres, hjres, sjres, hconres, or sconres.
+	Type   string `json:"type"`
+	Number string `json:"number"`
+}
+
+// Identifier returns the measure's identifier, e.g. "/us/bill/116/hr/1865",
+// in the form MeasureIdentifier returns for the measure's own versions.
+func (t TargetMeasure) Identifier() string {
+	kind := "bill"
+	if strings.HasSuffix(t.Type, "res") {
+		kind = "resolution"
+	}
+	return "/us/" + kind + "/" + t.Congress + "/" + t.Type + "/" + t.Number
+}
+
+// Citation returns the measure's citation, e.g. "H.R. 1865" or
+// "S.J. Res. 4".
+func (t TargetMeasure) Citation() string {
+	return measureCitations[t.Type] + " " + t.Number
+}
+
+// measureCitations maps each measure type to its citation prefix.
+var measureCitations = map[string]string{
+	"hr": "H.R.", "hres": "H. Res.", "hjres": "H.J. Res.", "hconres": "H. Con. Res.",
+	"s": "S.", "sres": "S. Res.", "sjres": "S.J. Res.", "sconres": "S. Con. Res.",
+}
+
+var (
+	// amendedTitlePattern matches the dc:title of an amendment, e.g.
+	// "AMENDMENTS to 116 HR 1865".
+	amendedTitlePattern = regexp.MustCompile(`(?i)\bto\s+(\d+)\s+(HR|HRES|HJRES|HCONRES|S|SRES|SJRES|SCONRES)\s+(\d+)\b`)
+
+	// amendedMeasurePattern matches the measure named in an amending
+	// instruction, e.g. "the amendment of the Senate to the bill (H.R. 1)".
+	amendedMeasurePattern = regexp.MustCompile(`\b(?:bill|resolution)\s+\((H\.\s?R\.|H\.\s?Res\.|H\.\s?J\.\s?Res\.|H\.\s?Con\.\s?Res\.|S\.\s?Res\.|S\.\s?J\.\s?Res\.|S\.\s?Con\.\s?Res\.|S\.)\s?(\d+)\)`)
+)
+
+// targetMeasure finds the measure an amendment amends. It tries, in order,
+// the compact citations of amendMeta (e.g. "116hr1865eah"), its dc:title,
+// the slug line of amendPreface, and the first measure an instruction in
+// amendMain names; the last two take the Congress from amendMeta.
+func targetMeasure(meta *AmendMeta, preface *AmendPreface, main *AmendMain) *TargetMeasure {
+	if meta == nil {
+		return nil
+	}
+	for _, citation := range meta.CitableAs {
+		m := compactCitationPattern.FindStringSubmatch(strings.TrimSpace(citation))
+		if m != nil && measureCitations[m[2]] != "" {
+			return &TargetMeasure{Congress: m[1], Type: m[2], Number: m[3]}
+		}
+	}
+	if m := amendedTitlePattern.FindStringSubmatch(meta.DCTitle); m != nil {
+		return &TargetMeasure{Congress: m[1], Type: strings.ToLower(m[2]), Number: m[3]}
+	}
+
+	congress := strings.TrimSpace(meta.Congress)
+	if congress == "" {
+		return nil
+	}
+	if preface != nil {
+		if slug, err := ParseSlugLine(preface.SlugLine); err == nil {
+			return &TargetMeasure{Congress: congress, Type: slug.MeasureType(), Number: slug.Number}
+		}
+	}
+	if main != nil {
+		for i := range main.Sections {
+			text := strings.Join(sectionTexts(&main.Sections[i]), " ")
+			if m := amendedMeasurePattern.FindStringSubmatch(text); m != nil {
+				return &TargetMeasure{Congress: congress, Type: citationType(m[1]), Number: m[2]}
+			}
+		}
+	}
+	return nil
+}
+
+// sectionTexts returns the chapeau and content text of a section.
+func sectionTexts(s *Section) []string {
+	var texts []string
+	if s.Chapeau != nil {
+		texts = append(texts, s.Chapeau.PlainText())
+	}
+	if s.Content != nil {
+		texts = append(texts, s.Content.PlainText())
+	}
+	return texts
+}
+
+// citationType returns the measure type of a citation prefix such as
+// "H.J. Res." or "S.".
+func citationType(prefix string) string {
+	compact := strings.ToLower(strings.NewReplacer(".", "", " ", "").Replace(prefix))
+	if measureCitations[compact] == "" {
+		return ""
+	}
+	return compact
+}
@@ -0,0 +1,141 @@
+package uslm
+
+import (
+	"fmt"
+	"html/template"
+	"strings"
+)
+
+// TemplateFuncs returns functions for rendering documents inside Go
+// templates, so a web application can lay out provisions in its own markup:
+//
+//	uslmtext        the text of a provision or level (its chapeau and content),
+//	                of a heading, chapeau, or content element, or of a whole
+//	                document (as ExtractText)
+//	uslmnum         the number of a provision or level, e.g. "(a)"
+//	uslmheading     the heading of a provision or level
+//	uslmcite        a citation: "Section 301(a)(2)" for a provision, and
+//	                "42 U.S.C. 1395w" for a Ref or href into the U.S. Code
+//	uslmprovisions  a document's top-level provisions
+//	uslmsections    every section of a document in order, including those
+//	                within titles
+//	uslmchildren    the provisions nested in a provision
+//
+// For example:
+//
+//	{{range uslmsections .}}
+//	  <h2>{{uslmnum .}} {{uslmheading .}}</h2>
+//	  {{range uslmchildren .}}<p>{{uslmnum .}} {{uslmtext .}}</p>{{end}}
+//	{{end}}
+//
+// Text is returned as plain strings, so html/template escapes it. The map
+// converts directly to a text/template FuncMap. Functions given a value of an
+// unsupported type fail the template execution.
+func TemplateFuncs() template.FuncMap {
+	return template.FuncMap{
+		"uslmtext":       templateText,
+		"uslmnum":        templateNum,
+		"uslmheading":    templateHeading,
+		"uslmcite":       templateCite,
+		"uslmprovisions": Provisions,
+		"uslmsections":   templateSections,
+		"uslmchildren":   templateChildren,
+	}
+}
+
+func templateText(v interface{}) (string, error) {
+	switch x := v.(type) {
+	case nil:
+		return "", nil
+	case string:
+		return x, nil
+	case LegislativeDocument:
+		return ExtractText(x, DefaultNormalizeOptions()), nil
+	case *Provision:
+		return x.GetText(), nil
+	case interface{ PlainText() string }:
+		return x.PlainText(), nil
+	case ContentContainer:
+		return strings.TrimSpace(x.GetChapeau() + " " + x.GetContent()), nil
+	}
+	return "", fmt.Errorf("uslmtext: unsupported type %T", v)
+}
+
+func templateNum(v interface{}) (string, error) {
+	switch x := v.(type) {
+	case nil:
+		return "", nil
+	case *Num:
+		if x == nil {
+			return "", nil
+		}
+		return normalizeSpace(x.Text), nil
+	case Numbered:
+		return normalizeSpace(x.GetNum()), nil
+	}
+	return "", fmt.Errorf("uslmnum: unsupported type %T", v)
+}
+
+func templateHeading(v interface{}) (string, error) {
+	switch x := v.(type) {
+	case nil:
+		return "", nil
+	case *Heading:
+		if x == nil {
+			return "", nil
+		}
+		return x.PlainText(), nil
+	case Headed:
+		return x.GetHeading(), nil
+	}
+	return "", fmt.Errorf("uslmheading: unsupported type %T", v)
+}
+
+func templateCite(v interface{}) (string, error) {
+	switch x := v.(type) {
+	case nil:
+		return "", nil
+	case *Provision:
+		return x.PathString(), nil
+	case Ref:
+		return refCitation(x.Href, x.Text), nil
+	case *Ref:
+		if x == nil {
+			return "", nil
+		}
+		return refCitation(x.Href, x.Text), nil
+	case string:
+		return refCitation(x, x), nil
+	}
+	return "", fmt.Errorf("uslmcite: unsupported type %T", v)
+}
+
+// refCitation returns the U.S. Code citation for href, or text when href
+// does not point into the Code.
+func refCitation(href, text string) string {
+	if usc := normalizeUSCHref(href); usc != "" {
+		return uscLabel(usc)
+	}
+	return normalizeSpace(text)
+}
+
+func templateSections(doc LegislativeDocument) []*Provision {
+	var sections []*Provision
+	for _, top := range Provisions(doc) {
+		top.Walk(func(p *Provision) bool {
+			if p.Element == "section" {
+				sections = append(sections, p)
+				return false
+			}
+			return true
+		})
+	}
+	return sections
+}
+
+func templateChildren(p *Provision) []*Provision {
+	if p == nil {
+		return nil
+	}
+	return p.Children
+}
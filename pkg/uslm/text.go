@@ -0,0 +1,297 @@
+package uslm
+
+import (
+	"encoding/xml"
+	"io"
+	"strings"
+)
+
+// decodeOrdered decodes the element beginning with start into v while also
+// collecting its character data, including that of all descendants, in document
+// order. The parsed structs keep text and child elements in separate fields, so
+// this is the only point at which the reading order of mixed content is known.
+func decodeOrdered(d *xml.Decoder, start xml.StartElement, v interface{}) (string, error) {
+	text, _, err := decodeOrderedLang(d, start, v)
+	return text, err
+}
+
+// decodeOrderedLang is decodeOrdered, also returning the spans of the text
+// that the element or its descendants mark with xml:lang.
+func decodeOrderedLang(d *xml.Decoder, start xml.StartElement, v interface{}) (string, []langSpan, error) {
+	var text strings.Builder
+	var spans []langSpan
+	langs := []string{Attributes(start.Attr).Lang()}
+	tokens := []xml.Token{start.Copy()}
+	for depth := 1; depth > 0; {
+		tok, err := d.Token()
+		if err != nil {
+			return "", nil, err
+		}
+		switch t := tok.(type) {
+		case xml.StartElement:
+			depth++
+			if blockElements[t.Name.Local] {
+				text.WriteByte('\n')
+			}
+			lang := Attributes(t.Attr).Lang()
+			if lang == "" {
+				lang = langs[len(langs)-1]
+			}
+			langs = append(langs, lang)
+		case xml.EndElement:
+			depth--
+			if blockElements[t.Name.Local] {
+				text.WriteByte('\n')
+			}
+			langs = langs[:len(langs)-1]
+		case xml.CharData:
+			if lang := langs[len(langs)-1]; lang != "" {
+				spans = addLangSpan(spans, langSpan{start: text.Len(), end: text.Len() + len(t), lang: lang})
+			}
+			text.Write(t)
+		}
+		tokens = append(tokens, xml.CopyToken(tok))
+	}
+
+	r := &tokenReplay{tokens: tokens}
+	if err := xml.NewTokenDecoder(r).Decode(v); err != nil {
+		return "", nil, err
+	}
+	return text.String(), spans, nil
+}
+
+// blockElements are elements whose boundaries separate words even when the
+// source has no whitespace between them.
+var blockElements = map[string]bool{
+	"title": true, "subtitle": true, "part": true, "subpart": true, "chapter": true, "subchapter": true,
+	"division": true, "subdivision": true, "level": true, "section": true, "subsection": true,
+	"paragraph": true, "subparagraph": true, "clause": true, "subclause": true, "item": true,
+	"subitem": true, "subsubitem": true, "num": true, "heading": true, "subheading": true,
+	"chapeau": true, "content": true, "continuation": true, "p": true, "quotedContent": true,
+	"toc": true, "referenceItem": true, "designator": true, "label": true,
+}
+
+// tokenReplay is an xml.TokenReader over previously read tokens.
+type tokenReplay struct {
+	tokens []xml.Token
+}
+
+func (r *tokenReplay) Token() (xml.Token, error) {
+	if len(r.tokens) == 0 {
+		return nil, io.EOF
+	}
+	tok := r.tokens[0]
+	r.tokens = r.tokens[1:]
+	return tok, nil
+}
+
+// normalizeSpace collapses runs of whitespace to single spaces and trims the result.
+func normalizeSpace(s string) string {
+	return strings.Join(strings.Fields(s), " ")
+}
+
+// UnmarshalXML decodes the content while recording its text in reading order.
+func (c *Content) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	type plain Content
+	text, langs, err := decodeOrderedLang(d, start, (*plain)(c))
+	if err != nil {
+		return err
+	}
+	c.text, c.langs = text, langs
+	return nil
+}
+
+// PlainText returns the content's text, including the text of inline and nested
+// elements, in reading order with whitespace normalized. Reading order is only
+// known for content parsed from XML; otherwise the text of each kind of child
+// element is appended after the content's own text.
+func (c *Content) PlainText() string {
+	if c.text != "" {
+		return normalizeSpace(c.text)
+	}
+	parts := []string{c.Text}
+	for _, i := range c.Inline {
+		parts = append(parts, i.Text)
+	}
+	for _, i := range c.I {
+		parts = append(parts, i.Text)
+	}
+	for _, r := range c.Ref {
+		parts = append(parts, r.Text)
+	}
+	for _, s := range c.ShortTitle {
+		parts = append(parts, s.Text)
+	}
+	for _, q := range c.QuotedText {
+		parts = append(parts, q.Text)
+	}
+	for _, a := range c.AmendingAction {
+		parts = append(parts, a.Text)
+	}
+	for _, a := range c.DeletedText {
+		parts = append(parts, a.PlainText())
+	}
+	for _, a := range c.AddedText {
+		parts = append(parts, a.PlainText())
+	}
+	return normalizeSpace(strings.Join(parts, " "))
+}
+
+// UnmarshalXML decodes the chapeau while recording its text in reading order.
+func (c *Chapeau) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	type plain Chapeau
+	text, langs, err := decodeOrderedLang(d, start, (*plain)(c))
+	if err != nil {
+		return err
+	}
+	c.text, c.langs = text, langs
+	return nil
+}
+
+// PlainText returns the chapeau's text, including inline elements, in reading
+// order with whitespace normalized. See Content.PlainText.
+func (c *Chapeau) PlainText() string {
+	if c.text != "" {
+		return normalizeSpace(c.text)
+	}
+	parts := []string{c.Text}
+	for _, i := range c.Inline {
+		parts = append(parts, i.Text)
+	}
+	for _, r := range c.Ref {
+		parts = append(parts, r.Text)
+	}
+	for _, a := range c.AmendingAction {
+		parts = append(parts, a.Text)
+	}
+	return normalizeSpace(strings.Join(parts, " "))
+}
+
+// UnmarshalXML decodes the heading while recording its text in reading order.
+func (h *Heading) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	type plain Heading
+	text, langs, err := decodeOrderedLang(d, start, (*plain)(h))
+	if err != nil {
+		return err
+	}
+	h.text, h.langs = text, langs
+	return nil
+}
+
+// PlainText returns the heading's text, including inline elements such as small
+// caps runs, in reading order with whitespace normalized. See Content.PlainText.
+func (h *Heading) PlainText() string {
+	if h.text != "" {
+		return normalizeSpace(h.text)
+	}
+	parts := make([]string, 0, len(h.Inline)+1)
+	for _, i := range h.Inline {
+		parts = append(parts, i.Text)
+	}
+	parts = append(parts, h.Text)
+	return normalizeSpace(strings.Join(parts, ""))
+}
+
+// UnmarshalXML decodes the recital while recording its text in reading order.
+func (r *Recital) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	type plain Recital
+	text, err := decodeOrdered(d, start, (*plain)(r))
+	if err != nil {
+		return err
+	}
+	r.text = text
+	return nil
+}
+
+// PlainText returns the recital's text, including its <p> runs and the
+// numbered paragraphs of a "Whereas ... including—" list, in reading order
+// with whitespace normalized. Without a reading order (see
+// Content.PlainText), paragraphs contribute only their own number, chapeau,
+// and content.
+func (r *Recital) PlainText() string {
+	if r.text != "" {
+		return normalizeSpace(r.text)
+	}
+	parts := []string{r.Text}
+	for _, p := range r.P {
+		parts = append(parts, p.Text)
+	}
+	for i := range r.Paragraphs {
+		p := &r.Paragraphs[i]
+		if p.Num != nil {
+			parts = append(parts, p.Num.Text)
+		}
+		if p.Chapeau != nil {
+			parts = append(parts, p.Chapeau.PlainText())
+		}
+		if p.Content != nil {
+			parts = append(parts, p.Content.PlainText())
+		}
+	}
+	return normalizeSpace(strings.Join(parts, " "))
+}
+
+// UnmarshalXML decodes the date while recording its text in reading order.
+func (d *ActionDate) UnmarshalXML(dec *xml.Decoder, start xml.StartElement) error {
+	type plain ActionDate
+	text, err := decodeOrdered(dec, start, (*plain)(d))
+	if err != nil {
+		return err
+	}
+	d.text = text
+	return nil
+}
+
+// PlainText returns the printed date, including a small-caps month, in
+// reading order with whitespace normalized. See Heading.PlainText.
+func (d *ActionDate) PlainText() string {
+	if d.text != "" {
+		return normalizeSpace(d.text)
+	}
+	parts := make([]string, 0, len(d.Inline)+1)
+	for _, i := range d.Inline {
+		parts = append(parts, i.Text)
+	}
+	parts = append(parts, d.Text)
+	return normalizeSpace(strings.Join(parts, ""))
+}
+
+// UnmarshalXML decodes the added text while recording its text in reading order.
+func (a *AddedText) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	type plain AddedText
+	text, err := decodeOrdered(d, start, (*plain)(a))
+	if err != nil {
+		return err
+	}
+	a.text = text
+	return nil
+}
+
+// PlainText returns the inserted text, including that of nested references,
+// with whitespace normalized.
+func (a *AddedText) PlainText() string {
+	if a.text != "" {
+		return normalizeSpace(a.text)
+	}
+	return normalizeSpace(a.Text)
+}
+
+// UnmarshalXML decodes the deleted text while recording its text in reading order.
+func (t *DeletedText) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	type plain DeletedText
+	text, err := decodeOrdered(d, start, (*plain)(t))
+	if err != nil {
+		return err
+	}
+	t.text = text
+	return nil
+}
+
+// PlainText returns the struck text, including that of nested references,
+// with whitespace normalized.
+func (t *DeletedText) PlainText() string {
+	if t.text != "" {
+		return normalizeSpace(t.text)
+	}
+	return normalizeSpace(t.Text)
+}
@@ -0,0 +1,112 @@
+package uslm
+
+import (
+	"context"
+	"io"
+	"sync/atomic"
+)
+
+// Span attribute keys set on the spans the package records.
+const (
+	// AttrDocumentType is the document type returned by GetDocumentType,
+	// such as "Senate Bill".
+	AttrDocumentType = "uslm.document.type"
+
+	// AttrDocumentSize is the size of the input document in bytes.
+	AttrDocumentSize = "uslm.document.size"
+)
+
+// SpanAttribute is a key-value pair recorded on a span. Value is a string,
+// int64, or bool.
+type SpanAttribute struct {
+	Key   string
+	Value interface{}
+}
+
+// Span is a traced operation started by a Tracer.
+type Span interface {
+	// SetAttributes records attributes on the span.
+	SetAttributes(attrs ...SpanAttribute)
+
+	// RecordError marks the span as failed with err.
+	RecordError(err error)
+
+	// End completes the span.
+	End()
+}
+
+// Tracer starts spans around the package's major operations, so a pipeline
+// can trace where time goes per document. It is the subset of an
+// OpenTelemetry trace.Tracer the package needs: an adapter forwards Start to
+// the OTel tracer, converting attributes with attribute.String,
+// attribute.Int64, and attribute.Bool, and RecordError to span.RecordError
+// followed by span.SetStatus(codes.Error, ...). The package itself stays
+// free of the OTel dependency. Register a Tracer with SetTracer.
+type Tracer interface {
+	// Start starts a span named name as a child of any span in ctx and
+	// returns a context carrying the new span.
+	Start(ctx context.Context, name string, attrs ...SpanAttribute) (context.Context, Span)
+}
+
+// tracerHolder lets an interface value be stored atomically.
+type tracerHolder struct {
+	tracer Tracer
+}
+
+var packageTracer atomic.Pointer[tracerHolder]
+
+// SetTracer registers t to trace operations throughout the process, or
+// removes the current tracer when t is nil.
+func SetTracer(t Tracer) {
+	if t == nil {
+		packageTracer.Store(nil)
+		return
+	}
+	packageTracer.Store(&tracerHolder{tracer: t})
+}
+
+// StartSpan starts a span with the registered tracer. Without one it returns
+// ctx unchanged and a span that ignores every call, so callers need not
+// check. A nil ctx is treated as context.Background.
+func StartSpan(ctx context.Context, name string, attrs ...SpanAttribute) (context.Context, Span) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	h := packageTracer.Load()
+	if h == nil {
+		return ctx, noopSpan{}
+	}
+	return h.tracer.Start(ctx, name, attrs...)
+}
+
+// EndSpan ends span, recording the type of doc when it is non-nil and err
+// when it is non-nil.
+func EndSpan(span Span, doc LegislativeDocument, err error) {
+	if doc != nil {
+		span.SetAttributes(SpanAttribute{Key: AttrDocumentType, Value: doc.GetDocumentType()})
+	}
+	if err != nil {
+		span.RecordError(err)
+	}
+	span.End()
+}
+
+// noopSpan is returned by StartSpan when no tracer is registered.
+type noopSpan struct{}
+
+func (noopSpan) SetAttributes(...SpanAttribute) {}
+func (noopSpan) RecordError(error)              {}
+func (noopSpan) End()                           {}
+
+// byteCounter counts the bytes read through it, for the size attribute of
+// streamed documents.
+type byteCounter struct {
+	r io.Reader
+	n int64
+}
+
+func (c *byteCounter) Read(p []byte) (int, error) {
+	n, err := c.r.Read(p)
+	c.n += int64(n)
+	return n, err
+}
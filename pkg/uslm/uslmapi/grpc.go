@@ -0,0 +1,205 @@
+package uslmapi
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// GRPCPathPrefix is the URL path prefix for the gRPC methods, which are
+// served at GRPCPathPrefix + method name (e.g., "/uslm.v1.USLM/Parse").
+const GRPCPathPrefix = "/uslm.v1.USLM/"
+
+// gRPC status codes.
+const (
+	grpcOK                = 0
+	grpcCanceled          = 1
+	grpcInvalidArgument   = 3
+	grpcDeadlineExceeded  = 4
+	grpcNotFound          = 5
+	grpcResourceExhausted = 8
+	grpcUnimplemented     = 12
+	grpcInternal          = 13
+)
+
+// NewGRPCHandler returns an http.Handler that serves srv as the gRPC service
+// uslm.v1.USLM defined in uslm.proto, so that clients generated from that
+// file in any language can call it. gRPC runs over HTTP/2: serve the handler
+// over TLS, or over unencrypted HTTP/2 as cmd/uslmd does. Messages must not
+// be compressed.
+func NewGRPCHandler(srv Server) http.Handler {
+	return &grpcHandler{srv: srv}
+}
+
+type grpcHandler struct {
+	srv Server
+}
+
+func (h *grpcHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, "method must be POST", http.StatusMethodNotAllowed)
+		return
+	}
+	if ct := r.Header.Get("Content-Type"); ct != "application/grpc" && !strings.HasPrefix(ct, "application/grpc+proto") && !strings.HasPrefix(ct, "application/grpc;") {
+		http.Error(w, "content type must be application/grpc", http.StatusUnsupportedMediaType)
+		return
+	}
+
+	ctx := r.Context()
+	if timeout, ok := grpcTimeout(r.Header.Get("Grpc-Timeout")); ok {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	// The status follows the response message as trailers.
+	w.Header().Set("Content-Type", "application/grpc")
+	w.Header().Set("Trailer", "Grpc-Status, Grpc-Message")
+	w.WriteHeader(http.StatusOK)
+
+	var resp protoMessage
+	data, err := readGRPCMessage(http.MaxBytesReader(w, r.Body, maxRequestBytes))
+	if err == nil {
+		switch strings.TrimPrefix(r.URL.Path, GRPCPathPrefix) {
+		case "Parse":
+			resp, err = callGRPC(ctx, data, h.srv.Parse)
+		case "Convert":
+			resp, err = callGRPC(ctx, data, h.srv.Convert)
+		case "Validate":
+			resp, err = callGRPC(ctx, data, h.srv.Validate)
+		case "Diff":
+			resp, err = callGRPC(ctx, data, h.srv.Diff)
+		default:
+			err = &grpcError{code: grpcUnimplemented, message: "unknown method " + r.URL.Path}
+		}
+	}
+	if err == nil {
+		_, err = w.Write(grpcFrame(resp.marshalProto()))
+	}
+
+	code, message := grpcStatus(ctx, err)
+	w.Header().Set("Grpc-Status", strconv.Itoa(code))
+	if message != "" {
+		w.Header().Set("Grpc-Message", grpcEncodeMessage(message))
+	}
+}
+
+// callGRPC decodes a request of type Req from data and invokes method.
+func callGRPC[Req, Resp any, PReq interface {
+	*Req
+	protoMessage
+}, PResp interface {
+	*Resp
+	protoMessage
+}](ctx context.Context, data []byte, method func(context.Context, PReq) (PResp, error)) (protoMessage, error) {
+	req := PReq(new(Req))
+	if err := req.unmarshalProto(data); err != nil {
+		return nil, invalidArgument("failed to decode request: %v", err)
+	}
+	resp, err := method(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// grpcError is an error with a gRPC status code that no Code stands for.
+type grpcError struct {
+	code    int
+	message string
+}
+
+func (e *grpcError) Error() string { return e.message }
+
+// readGRPCMessage reads the single length-prefixed message of a unary call.
+func readGRPCMessage(r io.Reader) ([]byte, error) {
+	var prefix [5]byte
+	if _, err := io.ReadFull(r, prefix[:]); err != nil {
+		return nil, readError(err)
+	}
+	if prefix[0] != 0 {
+		return nil, &grpcError{code: grpcUnimplemented, message: "compressed messages are not supported"}
+	}
+	size := binary.BigEndian.Uint32(prefix[1:])
+	if size > maxRequestBytes {
+		return nil, readError(&http.MaxBytesError{Limit: maxRequestBytes})
+	}
+	data := make([]byte, size)
+	if _, err := io.ReadFull(r, data); err != nil {
+		return nil, readError(err)
+	}
+	return data, nil
+}
+
+// grpcFrame returns msg with the length prefix of the gRPC wire format.
+func grpcFrame(msg []byte) []byte {
+	frame := make([]byte, 5, 5+len(msg))
+	binary.BigEndian.PutUint32(frame[1:], uint32(len(msg)))
+	return append(frame, msg...)
+}
+
+// grpcStatus returns the gRPC status code and message for err, which may be
+// nil.
+func grpcStatus(ctx context.Context, err error) (int, string) {
+	var e *Error
+	var g *grpcError
+	switch {
+	case err == nil:
+		return grpcOK, ""
+	case errors.As(err, &g):
+		return g.code, g.message
+	case errors.Is(ctx.Err(), context.DeadlineExceeded):
+		return grpcDeadlineExceeded, err.Error()
+	case ctx.Err() != nil:
+		return grpcCanceled, err.Error()
+	case errors.As(err, &e):
+		switch e.Code {
+		case CodeInvalidArgument:
+			return grpcInvalidArgument, e.Message
+		case CodeNotFound:
+			return grpcNotFound, e.Message
+		case CodeResourceExhausted:
+			return grpcResourceExhausted, e.Message
+		}
+		return grpcInternal, e.Message
+	}
+	return grpcInternal, err.Error()
+}
+
+// grpcTimeout parses a Grpc-Timeout header: up to eight digits and a unit.
+func grpcTimeout(s string) (time.Duration, bool) {
+	if len(s) < 2 || len(s) > 9 {
+		return 0, false
+	}
+	n, err := strconv.ParseUint(s[:len(s)-1], 10, 32)
+	if err != nil {
+		return 0, false
+	}
+	units := map[byte]time.Duration{'H': time.Hour, 'M': time.Minute, 'S': time.Second, 'm': time.Millisecond, 'u': time.Microsecond, 'n': time.Nanosecond}
+	unit, ok := units[s[len(s)-1]]
+	if !ok {
+		return 0, false
+	}
+	return time.Duration(n) * unit, true
+}
+
+// grpcEncodeMessage percent-encodes a status message as the Grpc-Message
+// header requires.
+func grpcEncodeMessage(s string) string {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		if c := s[i]; c < ' ' || c > '~' || c == '%' {
+			fmt.Fprintf(&b, "%%%02X", c)
+		} else {
+			b.WriteByte(c)
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,176 @@
+package uslmapi
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// grpcServer serves NewGRPCHandler over HTTP/2, as gRPC requires.
+func grpcServer(t *testing.T) *httptest.Server {
+	t.Helper()
+	server := httptest.NewUnstartedServer(NewGRPCHandler(&Service{}))
+	server.EnableHTTP2 = true
+	server.StartTLS()
+	t.Cleanup(server.Close)
+	return server
+}
+
+// invoke calls method on server as a gRPC client would, decoding the
+// response message into resp, and returns the gRPC status and message.
+func invoke(t *testing.T, server *httptest.Server, method string, body []byte, resp protoMessage) (string, string) {
+	t.Helper()
+	req, err := http.NewRequest(http.MethodPost, server.URL+GRPCPathPrefix+method, bytes.NewReader(body))
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.Header.Set("Content-Type", "application/grpc+proto")
+	req.Header.Set("TE", "trailers")
+	r, err := server.Client().Do(req)
+	if err != nil {
+		t.Fatalf("failed to call %s: %v", method, err)
+	}
+	defer r.Body.Close()
+	if r.ProtoMajor != 2 || r.StatusCode != http.StatusOK || r.Header.Get("Content-Type") != "application/grpc" {
+		t.Fatalf("%s: %s %s, Content-Type %q", method, r.Proto, r.Status, r.Header.Get("Content-Type"))
+	}
+	data, err := io.ReadAll(r.Body)
+	if err != nil {
+		t.Fatalf("failed to read %s response: %v", method, err)
+	}
+	if len(data) > 0 {
+		if len(data) < 5 || data[0] != 0 || int(binary.BigEndian.Uint32(data[1:])) != len(data)-5 {
+			t.Fatalf("%s: malformed response frame", method)
+		}
+		if err := resp.unmarshalProto(data[5:]); err != nil {
+			t.Fatalf("failed to decode %s response: %v", method, err)
+		}
+	}
+	return r.Trailer.Get("Grpc-Status"), r.Trailer.Get("Grpc-Message")
+}
+
+// TestGRPCHandler checks that every method answers over gRPC as the
+// Service does when called directly.
+func TestGRPCHandler(t *testing.T) {
+	server := grpcServer(t)
+	ctx := context.Background()
+	svc := &Service{}
+	bill := readSample(t, "BILLS-114s32cds.xml")
+	amended := strings.Replace(bill, "Transnational Drug Trafficking Act", "Transnational Narcotics Trafficking Act", 1)
+
+	check := func(method string, req, got protoMessage, want interface{}, err error) {
+		t.Helper()
+		if err != nil {
+			t.Fatalf("%s: %v", method, err)
+		}
+		if status, msg := invoke(t, server, method, grpcFrame(req.marshalProto()), got); status != "0" {
+			t.Errorf("%s: status %s: %s", method, status, msg)
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("%s over gRPC:\n got %+v\nwant %+v", method, got, want)
+		}
+	}
+
+	parseReq := &ParseRequest{XML: bill}
+	parsed, err := svc.Parse(ctx, parseReq)
+	check("Parse", parseReq, &ParseResponse{}, parsed, err)
+	for _, format := range []Format{FormatJSONSnakeCase, FormatText} {
+		req := &ConvertRequest{XML: bill, Format: format}
+		converted, err := svc.Convert(ctx, req)
+		check("Convert", req, &ConvertResponse{}, converted, err)
+	}
+	for _, xml := range []string{bill, `<bill xmlns="http://schemas.gpo.gov/xml/uslm"><main><section id="a"/><section id="a"/></main></bill>`} {
+		req := &ValidateRequest{XML: xml}
+		validated, err := svc.Validate(ctx, req)
+		check("Validate", req, &ValidateResponse{}, validated, err)
+	}
+	renumbered := strings.Replace(bill, `<num value="3">SEC. 3. </num>`, `<num value="4">SEC. 4. </num>`, 1)
+	for _, req := range []*DiffRequest{
+		{OldXML: bill, NewXML: amended},
+		{OldXML: bill, NewXML: renumbered, Mode: DiffModeStructure},
+		{OldXML: bill, NewXML: amended, Output: DiffOutputJSONPatch},
+	} {
+		diffed, err := svc.Diff(ctx, req)
+		check("Diff", req, &DiffResponse{}, diffed, err)
+	}
+}
+
+func TestGRPCHandlerErrors(t *testing.T) {
+	server := grpcServer(t)
+	frame := func(m protoMessage) []byte { return grpcFrame(m.marshalProto()) }
+	compressed := frame(&ParseRequest{XML: "<bill/>"})
+	compressed[0] = 1
+
+	for _, tc := range []struct {
+		name, method string
+		body         []byte
+		status       string
+	}{
+		{"unknown method", "Render", frame(&ParseRequest{}), "12"},
+		{"missing xml", "Parse", frame(&ParseRequest{}), "3"},
+		{"invalid xml", "Parse", frame(&ParseRequest{XML: "<bill>"}), "3"},
+		{"unsupported format", "Convert", grpcFrame(append((&ConvertRequest{XML: "<bill/>"}).marshalProto(), 0x10, 9)), "3"},
+		{"malformed message", "Parse", grpcFrame([]byte{0x0a, 0x05, '<'}), "3"},
+		{"wrong wire type", "Parse", grpcFrame([]byte{0x08, 0x01}), "3"},
+		{"truncated frame", "Parse", frame(&ParseRequest{XML: "<bill/>"})[:8], "3"},
+		{"empty body", "Parse", nil, "3"},
+		{"compressed", "Parse", compressed, "12"},
+		{"oversized", "Parse", []byte{0, 0xff, 0xff, 0xff, 0xff}, "8"},
+	} {
+		status, msg := invoke(t, server, tc.method, tc.body, &ParseResponse{})
+		if status != tc.status || msg == "" {
+			t.Errorf("%s: status %s (%q), want %s", tc.name, status, msg, tc.status)
+		}
+	}
+
+	resp, err := server.Client().Post(server.URL+GRPCPathPrefix+"Parse", "application/json", strings.NewReader("{}"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusUnsupportedMediaType {
+		t.Errorf("expected 415 for a JSON body, got %d", resp.StatusCode)
+	}
+}
+
+func TestWire(t *testing.T) {
+	for _, tc := range []struct {
+		in, want protoMessage
+	}{
+		{&ParseResponse{DocumentType: "Senate Bill", Citations: []string{"S. 32", ""}, JSON: "{}"}, &ParseResponse{DocumentType: "Senate Bill", Citations: []string{"S. 32", ""}, JSON: "{}"}},
+		{&ConvertRequest{XML: "<bill/>", Format: FormatText}, &ConvertRequest{XML: "<bill/>", Format: FormatText}},
+		{&ConvertRequest{XML: "<bill/>"}, &ConvertRequest{XML: "<bill/>", Format: FormatUnspecified}},
+		{&ValidateResponse{Valid: true, Issues: []Issue{{Kind: "duplicateID", ID: "a", Message: "é"}}}, &ValidateResponse{Valid: true, Issues: []Issue{{Kind: "duplicateID", ID: "a", Message: "é"}}}},
+		{&DiffRequest{OldXML: "a", NewXML: "b", Output: DiffOutputJSONPatch}, &DiffRequest{OldXML: "a", NewXML: "b", Mode: DiffModeUnspecified, Output: DiffOutputJSONPatch}},
+		{&DiffResponse{Changes: []ProvisionChange{{Kind: ChangeKindRemoved, Element: "section", Identifier: "/us/bill/114/s/32/s3", OldText: "x"}}}, &DiffResponse{Changes: []ProvisionChange{{Kind: ChangeKindRemoved, Element: "section", Identifier: "/us/bill/114/s/32/s3", OldText: "x"}}}},
+		{&DiffResponse{Changes: []ProvisionChange{{Kind: ChangeKindRenumbered, OldText: "SEC. 3.", NewText: "SEC. 4."}}}, &DiffResponse{Changes: []ProvisionChange{{Kind: ChangeKindRenumbered, OldText: "SEC. 3.", NewText: "SEC. 4."}}}},
+	} {
+		got := reflect.New(reflect.TypeOf(tc.in).Elem()).Interface().(protoMessage)
+		if err := got.unmarshalProto(tc.in.marshalProto()); err != nil || !reflect.DeepEqual(got, tc.want) {
+			t.Errorf("round trip of %+v = %+v, %v", tc.in, got, err)
+		}
+	}
+
+	// The encoding is that of protoc: for ConvertRequest{xml: "<b/>",
+	// format: FORMAT_TEXT}, field 1 as bytes and field 2 as a varint.
+	if got, want := (&ConvertRequest{XML: "<b/>", Format: FormatText}).marshalProto(), []byte{0x0a, 4, '<', 'b', '/', '>', 0x10, 3}; !bytes.Equal(got, want) {
+		t.Errorf("ConvertRequest encodes as % x, want % x", got, want)
+	}
+	// Unknown fields of every wire type are skipped.
+	var req ParseRequest
+	unknown := []byte{0x10, 0x01, 0x19, 1, 2, 3, 4, 5, 6, 7, 8, 0x25, 1, 2, 3, 4, 0x2a, 1, 'x', 0x0a, 1, 'y'}
+	if err := req.unmarshalProto(unknown); err != nil || req.XML != "y" {
+		t.Errorf("ParseRequest with unknown fields = %+v, %v", req, err)
+	}
+	// An unknown enum number is kept for the service to reject.
+	var convert ConvertRequest
+	if err := convert.unmarshalProto([]byte{0x10, 9}); err != nil || convert.Format != "9" {
+		t.Errorf("unknown format = %q, %v", convert.Format, err)
+	}
+}
@@ -0,0 +1,107 @@
+package uslmapi
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+// PathPrefix is the URL path prefix for the API's methods, which are served
+// at PathPrefix + method name (e.g., "/v1/Parse").
+const PathPrefix = "/v1/"
+
+// maxRequestBytes bounds the size of a request body.
+const maxRequestBytes = 64 << 20
+
+// NewHandler returns an http.Handler that serves srv using JSON request and
+// response bodies over POST. Errors are returned as a JSON
+// Error with a status derived from its Code.
+func NewHandler(srv Server) http.Handler {
+	return &handler{srv: srv}
+}
+
+type handler struct {
+	srv Server
+}
+
+func (h *handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		writeError(w, &Error{Code: CodeInvalidArgument, Message: "method must be POST"}, http.StatusMethodNotAllowed)
+		return
+	}
+	if !strings.HasPrefix(r.URL.Path, PathPrefix) {
+		writeError(w, &Error{Code: CodeNotFound, Message: "unknown path " + r.URL.Path}, 0)
+		return
+	}
+
+	var resp interface{}
+	var err error
+	ctx := r.Context()
+	body := http.MaxBytesReader(w, r.Body, maxRequestBytes)
+	switch strings.TrimPrefix(r.URL.Path, PathPrefix) {
+	case "Parse":
+		resp, err = call(ctx, body, h.srv.Parse)
+	case "Convert":
+		resp, err = call(ctx, body, h.srv.Convert)
+	case "Validate":
+		resp, err = call(ctx, body, h.srv.Validate)
+	case "Diff":
+		resp, err = call(ctx, body, h.srv.Diff)
+	default:
+		err = &Error{Code: CodeNotFound, Message: "unknown method " + r.URL.Path}
+	}
+	if err != nil {
+		writeError(w, err, 0)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(resp)
+}
+
+// call decodes a request of type Req from body and invokes method.
+func call[Req, Resp any](ctx context.Context, body io.Reader, method func(context.Context, *Req) (*Resp, error)) (interface{}, error) {
+	var req Req
+	if err := json.NewDecoder(body).Decode(&req); err != nil {
+		return nil, readError(err)
+	}
+	return method(ctx, &req)
+}
+
+// readError returns the Error for a request body that could not be read or
+// decoded.
+func readError(err error) *Error {
+	if errors.As(err, new(*http.MaxBytesError)) {
+		return &Error{Code: CodeResourceExhausted, Message: fmt.Sprintf("request exceeds %d bytes", maxRequestBytes)}
+	}
+	return invalidArgument("failed to decode request: %v", err)
+}
+
+// writeError writes err as a JSON Error. A zero status is derived from the
+// error's code.
+func writeError(w http.ResponseWriter, err error, status int) {
+	var e *Error
+	if !errors.As(err, &e) {
+		e = &Error{Code: CodeInternal, Message: err.Error()}
+	}
+	if status == 0 {
+		switch e.Code {
+		case CodeInvalidArgument:
+			status = http.StatusBadRequest
+		case CodeNotFound:
+			status = http.StatusNotFound
+		case CodeResourceExhausted:
+			status = http.StatusRequestEntityTooLarge
+		default:
+			status = http.StatusInternalServerError
+		}
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(e)
+}
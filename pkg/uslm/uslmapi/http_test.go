@@ -0,0 +1,188 @@
+package uslmapi
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func readSample(t *testing.T, name string) string {
+	t.Helper()
+	data, err := os.ReadFile(filepath.Join("..", "..", "..", "bill-version-samples-september-2024", name))
+	if err != nil {
+		t.Fatalf("failed to read sample: %v", err)
+	}
+	return string(data)
+}
+
+// post calls method on server with req, decoding the response body into
+// resp, and returns the status code.
+func post(t *testing.T, server *httptest.Server, method string, req, resp interface{}) int {
+	t.Helper()
+	body, err := json.Marshal(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	r, err := http.Post(server.URL+PathPrefix+method, "application/json", bytes.NewReader(body))
+	if err != nil {
+		t.Fatalf("failed to call %s: %v", method, err)
+	}
+	defer r.Body.Close()
+	if ct := r.Header.Get("Content-Type"); ct != "application/json" {
+		t.Errorf("%s: Content-Type = %q", method, ct)
+	}
+	if err := json.NewDecoder(r.Body).Decode(resp); err != nil {
+		t.Fatalf("failed to decode %s response: %v", method, err)
+	}
+	return r.StatusCode
+}
+
+func TestHandler(t *testing.T) {
+	server := httptest.NewServer(NewHandler(&Service{}))
+	defer server.Close()
+	bill := readSample(t, "BILLS-114s32cds.xml")
+
+	var parsed ParseResponse
+	if status := post(t, server, "Parse", ParseRequest{XML: bill}, &parsed); status != http.StatusOK {
+		t.Fatalf("Parse status %d", status)
+	}
+	if parsed.DocumentType != "Senate Bill" || parsed.DocumentNumber != "32" || parsed.Congress != "114" {
+		t.Errorf("unexpected Parse response %s %s %s", parsed.DocumentType, parsed.DocumentNumber, parsed.Congress)
+	}
+	if !json.Valid([]byte(parsed.JSON)) {
+		t.Error("expected Parse to return the document as JSON")
+	}
+
+	for _, tc := range []struct {
+		format Format
+		want   string
+	}{
+		{FormatJSON, `"dcTitle"`},
+		{FormatJSONSnakeCase, `"dc_title"`},
+		{FormatText, "Transnational Drug Trafficking Act"},
+		{FormatSummaryMarkdown, "# "},
+	} {
+		var converted ConvertResponse
+		if status := post(t, server, "Convert", ConvertRequest{XML: bill, Format: tc.format}, &converted); status != http.StatusOK {
+			t.Errorf("Convert %s status %d", tc.format, status)
+			continue
+		}
+		if !strings.Contains(converted.Output, tc.want) {
+			t.Errorf("Convert %s output lacks %q", tc.format, tc.want)
+		}
+	}
+
+	var validated ValidateResponse
+	if status := post(t, server, "Validate", ValidateRequest{XML: bill}, &validated); status != http.StatusOK {
+		t.Fatalf("Validate status %d", status)
+	}
+	if !validated.Valid || len(validated.Issues) != 0 {
+		t.Errorf("expected the sample to validate, got %+v", validated)
+	}
+	if post(t, server, "Validate", ValidateRequest{XML: "<bill>"}, &validated); validated.Valid || len(validated.Issues) != 1 || validated.Issues[0].Kind != "parseError" {
+		t.Errorf("expected a parse error issue, got %+v", validated)
+	}
+
+	amended := strings.Replace(bill, "Transnational Drug Trafficking Act", "Transnational Narcotics Trafficking Act", 1)
+	var diffed DiffResponse
+	if status := post(t, server, "Diff", DiffRequest{OldXML: bill, NewXML: amended}, &diffed); status != http.StatusOK {
+		t.Fatalf("Diff status %d", status)
+	}
+	if len(diffed.Changes) != 1 || diffed.Changes[0].Kind != ChangeKindModified || !strings.Contains(diffed.Changes[0].NewText, "Narcotics") {
+		t.Errorf("unexpected Diff changes %+v", diffed.Changes)
+	}
+	var patched DiffResponse
+	if status := post(t, server, "Diff", DiffRequest{OldXML: bill, NewXML: amended, Output: DiffOutputJSONPatch}, &patched); status != http.StatusOK {
+		t.Fatalf("Diff JSON patch status %d", status)
+	}
+	if !strings.Contains(patched.JSONPatch, `"op":"replace"`) {
+		t.Errorf("unexpected JSON patch %s", patched.JSONPatch)
+	}
+}
+
+// TestDiffStructure checks that DiffModeStructure ignores edits to the text
+// but reports a renumbering.
+func TestDiffStructure(t *testing.T) {
+	server := httptest.NewServer(NewHandler(&Service{}))
+	defer server.Close()
+	bill := readSample(t, "BILLS-114s32cds.xml")
+
+	edited := strings.Replace(bill, "Transnational Drug Trafficking Act", "Transnational Narcotics Trafficking Act", 1)
+	var diffed DiffResponse
+	if status := post(t, server, "Diff", DiffRequest{OldXML: bill, NewXML: edited, Mode: DiffModeStructure}, &diffed); status != http.StatusOK {
+		t.Fatalf("Diff status %d", status)
+	}
+	if diffed.Changes == nil || len(diffed.Changes) != 0 {
+		t.Errorf("expected no structural changes for a text edit, got %+v", diffed.Changes)
+	}
+
+	renumbered := strings.Replace(bill, `<num value="3">SEC. 3. </num>`, `<num value="4">SEC. 4. </num>`, 1)
+	if status := post(t, server, "Diff", DiffRequest{OldXML: bill, NewXML: renumbered, Mode: DiffModeStructure}, &diffed); status != http.StatusOK {
+		t.Fatalf("Diff status %d", status)
+	}
+	want := []ProvisionChange{{
+		Kind:       ChangeKindRenumbered,
+		Element:    "section",
+		Identifier: "/us/bill/114/s/32/s3",
+		OldText:    "SEC. 3.",
+		NewText:    "SEC. 4.",
+	}}
+	if !reflect.DeepEqual(diffed.Changes, want) {
+		t.Errorf("unexpected structural changes:\n got %+v\nwant %+v", diffed.Changes, want)
+	}
+
+	var rejected Error
+	if status := post(t, server, "Diff", DiffRequest{OldXML: bill, NewXML: renumbered, Mode: DiffModeStructure, Output: DiffOutputJSONPatch}, &rejected); status != http.StatusBadRequest || rejected.Code != CodeInvalidArgument {
+		t.Errorf("expected a JSON patch of a structural diff to be refused, got %d %+v", status, rejected)
+	}
+}
+
+func TestHandlerErrors(t *testing.T) {
+	server := httptest.NewServer(NewHandler(&Service{}))
+	defer server.Close()
+
+	for _, tc := range []struct {
+		name   string
+		method string
+		path   string
+		body   string
+		status int
+		code   Code
+	}{
+		{"get", http.MethodGet, "Parse", "", http.StatusMethodNotAllowed, CodeInvalidArgument},
+		{"unknown method", http.MethodPost, "Render", "{}", http.StatusNotFound, CodeNotFound},
+		{"malformed request", http.MethodPost, "Parse", "{", http.StatusBadRequest, CodeInvalidArgument},
+		{"missing xml", http.MethodPost, "Parse", "{}", http.StatusBadRequest, CodeInvalidArgument},
+		{"invalid xml", http.MethodPost, "Parse", `{"xml": "<bill>"}`, http.StatusBadRequest, CodeInvalidArgument},
+		{"unsupported format", http.MethodPost, "Convert", `{"xml": "<bill/>", "format": "FORMAT_PDF"}`, http.StatusBadRequest, CodeInvalidArgument},
+		{"unsupported diff mode", http.MethodPost, "Diff", `{"mode": "DIFF_MODE_WORDS"}`, http.StatusBadRequest, CodeInvalidArgument},
+		{"oversized request", http.MethodPost, "Parse", strings.Repeat(" ", maxRequestBytes+1), http.StatusRequestEntityTooLarge, CodeResourceExhausted},
+	} {
+		req, err := http.NewRequest(tc.method, server.URL+PathPrefix+tc.path, strings.NewReader(tc.body))
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatalf("%s: %v", tc.name, err)
+		}
+		var body map[string]string
+		err = json.NewDecoder(resp.Body).Decode(&body)
+		resp.Body.Close()
+		if err != nil {
+			t.Fatalf("%s: failed to decode error: %v", tc.name, err)
+		}
+		if resp.StatusCode != tc.status {
+			t.Errorf("%s: status %d, want %d", tc.name, resp.StatusCode, tc.status)
+		}
+		if len(body) != 2 || body["code"] != string(tc.code) || body["message"] == "" {
+			t.Errorf("%s: unexpected error body %v", tc.name, body)
+		}
+	}
+}
@@ -0,0 +1,130 @@
+// Package uslmapi defines an API for parsing, converting, validating, and
+// comparing USLM documents, along with a reference implementation and
+// handlers serving it as gRPC and as JSON over HTTP.
+//
+// NewGRPCHandler serves the methods as the gRPC service uslm.v1.USLM defined
+// in uslm.proto, from which clients in any language can be generated. The
+// request and response types below are the Go form of its messages, encoded
+// by hand so that the package needs no protobuf or gRPC dependency.
+//
+// NewHandler serves the same methods to plain HTTP clients: each is called by
+// POSTing its request type as JSON to PathPrefix plus the method name, such
+// as "/v1/Parse", and answers with its response type as JSON or, on failure,
+// an Error.
+package uslmapi
+
+// ParseRequest is the request for Server.Parse.
+type ParseRequest struct {
+	XML string `json:"xml"`
+}
+
+// ParseResponse is the response from Server.Parse.
+type ParseResponse struct {
+	DocumentType   string   `json:"documentType"`
+	DocumentNumber string   `json:"documentNumber"`
+	Congress       string   `json:"congress"`
+	Session        string   `json:"session"`
+	Title          string   `json:"title"`
+	Stage          string   `json:"stage"`
+	Citations      []string `json:"citations"`
+	JSON           string   `json:"json"`
+}
+
+// Format is an output format for Server.Convert.
+type Format string
+
+const (
+	FormatUnspecified     Format = "FORMAT_UNSPECIFIED"
+	FormatJSON            Format = "FORMAT_JSON"
+	FormatJSONSnakeCase   Format = "FORMAT_JSON_SNAKE_CASE"
+	FormatText            Format = "FORMAT_TEXT"
+	FormatSummaryMarkdown Format = "FORMAT_SUMMARY_MARKDOWN"
+)
+
+// ConvertRequest is the request for Server.Convert.
+type ConvertRequest struct {
+	XML    string `json:"xml"`
+	Format Format `json:"format"`
+}
+
+// ConvertResponse is the response from Server.Convert.
+type ConvertResponse struct {
+	Output string `json:"output"`
+}
+
+// ValidateRequest is the request for Server.Validate.
+type ValidateRequest struct {
+	XML string `json:"xml"`
+}
+
+// Issue is a problem reported by Server.Validate.
+type Issue struct {
+	Kind       string `json:"kind"`
+	Element    string `json:"element,omitempty"`
+	ID         string `json:"id,omitempty"`
+	Identifier string `json:"identifier,omitempty"`
+	Message    string `json:"message"`
+}
+
+// ValidateResponse is the response from Server.Validate.
+type ValidateResponse struct {
+	Valid  bool    `json:"valid"`
+	Issues []Issue `json:"issues"`
+}
+
+// DiffMode selects what Server.Diff compares.
+type DiffMode string
+
+const (
+	DiffModeUnspecified DiffMode = "DIFF_MODE_UNSPECIFIED"
+	DiffModeFull        DiffMode = "DIFF_MODE_FULL"
+	DiffModeStructure   DiffMode = "DIFF_MODE_STRUCTURE"
+)
+
+// DiffOutput selects what Server.Diff reports.
+type DiffOutput string
+
+const (
+	DiffOutputUnspecified DiffOutput = "DIFF_OUTPUT_UNSPECIFIED"
+	DiffOutputChanges     DiffOutput = "DIFF_OUTPUT_CHANGES"
+	DiffOutputJSONPatch   DiffOutput = "DIFF_OUTPUT_JSON_PATCH"
+)
+
+// DiffRequest is the request for Server.Diff.
+type DiffRequest struct {
+	OldXML string     `json:"oldXml"`
+	NewXML string     `json:"newXml"`
+	Mode   DiffMode   `json:"mode,omitempty"`
+	Output DiffOutput `json:"output,omitempty"`
+}
+
+// ChangeKind classifies a ProvisionChange.
+type ChangeKind string
+
+const (
+	ChangeKindUnspecified ChangeKind = "CHANGE_KIND_UNSPECIFIED"
+	ChangeKindAdded       ChangeKind = "CHANGE_KIND_ADDED"
+	ChangeKindRemoved     ChangeKind = "CHANGE_KIND_REMOVED"
+	ChangeKindModified    ChangeKind = "CHANGE_KIND_MODIFIED"
+	ChangeKindRenumbered  ChangeKind = "CHANGE_KIND_RENUMBERED"
+)
+
+// ProvisionChange describes one provision that differs between versions.
+// In DiffModeStructure, OldText and NewText hold the provision's number and
+// heading instead of its text; for ChangeKindRenumbered they hold the old and
+// new numbers.
+type ProvisionChange struct {
+	Kind       ChangeKind `json:"kind"`
+	Element    string     `json:"element"`
+	Identifier string     `json:"identifier"`
+	OldText    string     `json:"oldText,omitempty"`
+	NewText    string     `json:"newText,omitempty"`
+}
+
+// DiffResponse is the response from Server.Diff.
+type DiffResponse struct {
+	Changes []ProvisionChange `json:"changes"`
+
+	// JSONPatch is the RFC 6902 patch, for DiffOutputJSONPatch.
+	JSONPatch string `json:"jsonPatch,omitempty"`
+}
@@ -0,0 +1,224 @@
+package uslmapi
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/usgpo/uslm/pkg/uslm"
+)
+
+// Server is the set of methods served by NewHandler.
+type Server interface {
+	Parse(ctx context.Context, req *ParseRequest) (*ParseResponse, error)
+	Convert(ctx context.Context, req *ConvertRequest) (*ConvertResponse, error)
+	Validate(ctx context.Context, req *ValidateRequest) (*ValidateResponse, error)
+	Diff(ctx context.Context, req *DiffRequest) (*DiffResponse, error)
+}
+
+// Code classifies errors returned by the service.
+type Code string
+
+const (
+	CodeInvalidArgument   Code = "invalid_argument"
+	CodeInternal          Code = "internal"
+	CodeNotFound          Code = "not_found"
+	CodeResourceExhausted Code = "resource_exhausted"
+)
+
+// Error is an error with a Code that NewHandler maps to an HTTP status and
+// NewGRPCHandler to a gRPC status. It is also the body of every failed JSON
+// response.
+type Error struct {
+	Code    Code   `json:"code"`
+	Message string `json:"message"`
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("%s: %s", e.Code, e.Message)
+}
+
+func invalidArgument(format string, args ...interface{}) *Error {
+	return &Error{Code: CodeInvalidArgument, Message: fmt.Sprintf(format, args...)}
+}
+
+// Service is the reference implementation of Server backed by package uslm.
+type Service struct{}
+
+// Ensure Service implements Server
+var _ Server = (*Service)(nil)
+
+// Parse reads a document and returns its identifying metadata and JSON form.
+func (s *Service) Parse(ctx context.Context, req *ParseRequest) (resp *ParseResponse, err error) {
+	ctx, span := startSpan(ctx, "Parse", len(req.XML))
+	var doc uslm.LegislativeDocument
+	defer func() { uslm.EndSpan(span, doc, err) }()
+
+	doc, err = parse(ctx, req.XML, "xml")
+	if err != nil {
+		return nil, err
+	}
+	data, err := uslm.ToJSON(doc)
+	if err != nil {
+		return nil, &Error{Code: CodeInternal, Message: err.Error()}
+	}
+	citations := doc.GetCitations()
+	if citations == nil {
+		citations = []string{}
+	}
+	return &ParseResponse{
+		DocumentType:   doc.GetDocumentType(),
+		DocumentNumber: doc.GetDocumentNumber(),
+		Congress:       doc.GetCongress(),
+		Session:        doc.GetSession(),
+		Title:          doc.GetTitle(),
+		Stage:          doc.GetStage(),
+		Citations:      citations,
+		JSON:           string(data),
+	}, nil
+}
+
+// Convert renders a document in the requested format.
+func (s *Service) Convert(ctx context.Context, req *ConvertRequest) (resp *ConvertResponse, err error) {
+	ctx, span := startSpan(ctx, "Convert", len(req.XML))
+	span.SetAttributes(uslm.SpanAttribute{Key: "uslm.convert.format", Value: string(req.Format)})
+	var doc uslm.LegislativeDocument
+	defer func() { uslm.EndSpan(span, doc, err) }()
+
+	doc, err = parse(ctx, req.XML, "xml")
+	if err != nil {
+		return nil, err
+	}
+
+	var out []byte
+	switch req.Format {
+	case FormatJSON, FormatUnspecified, "":
+		out, err = uslm.ToJSON(doc)
+	case FormatJSONSnakeCase:
+		out, err = uslm.ToJSONWithOptions(doc, uslm.JSONOptions{Naming: uslm.FieldNamingSnake, Indent: true})
+	case FormatText:
+		out = []byte(uslm.ExtractText(doc, uslm.DefaultNormalizeOptions()))
+	case FormatSummaryMarkdown:
+		out = []byte(uslm.Summarize(doc).Markdown())
+	default:
+		return nil, invalidArgument("unsupported format %q", req.Format)
+	}
+	if err != nil {
+		return nil, &Error{Code: CodeInternal, Message: err.Error()}
+	}
+	return &ConvertResponse{Output: string(out)}, nil
+}
+
+// Validate parses a document and reports identifier problems. A document that
+// fails to parse is reported as invalid rather than as an error.
+func (s *Service) Validate(ctx context.Context, req *ValidateRequest) (*ValidateResponse, error) {
+	ctx, span := startSpan(ctx, "Validate", len(req.XML))
+	doc, err := parse(ctx, req.XML, "xml")
+	defer uslm.EndSpan(span, doc, nil)
+	if err != nil {
+		return &ValidateResponse{Issues: []Issue{{Kind: "parseError", Message: err.Error()}}}, nil
+	}
+	resp := &ValidateResponse{Valid: true, Issues: []Issue{}}
+	for _, issue := range uslm.CheckIdentifiers(doc) {
+		resp.Valid = false
+		resp.Issues = append(resp.Issues, Issue{
+			Kind:       string(issue.Kind),
+			Element:    issue.Element,
+			ID:         issue.ID,
+			Identifier: issue.Identifier,
+			Message:    issue.Message,
+		})
+	}
+	return resp, nil
+}
+
+// Diff compares the provisions of two versions of a document with
+// uslm.DiffProvisions. In DiffModeStructure only levels, numbers, and headings
+// are compared, so a reorganization can be told apart from substantive edits
+// to the text; a provision whose number alone changed is reported as
+// ChangeKindRenumbered in either mode. With
+// DiffOutputJSONPatch it instead returns the RFC 6902 patch between the
+// documents' JSON forms.
+func (s *Service) Diff(ctx context.Context, req *DiffRequest) (resp *DiffResponse, err error) {
+	ctx, span := startSpan(ctx, "Diff", len(req.OldXML)+len(req.NewXML))
+	var newDoc uslm.LegislativeDocument
+	defer func() { uslm.EndSpan(span, newDoc, err) }()
+
+	var opts uslm.DiffOptions
+	switch req.Mode {
+	case DiffModeFull, DiffModeUnspecified, "":
+	case DiffModeStructure:
+		opts.Structure = true
+	default:
+		return nil, invalidArgument("unsupported diff mode %q", req.Mode)
+	}
+	switch req.Output {
+	case DiffOutputChanges, DiffOutputUnspecified, "":
+	case DiffOutputJSONPatch:
+		if req.Mode == DiffModeStructure {
+			return nil, invalidArgument("%s does not support %s", req.Output, req.Mode)
+		}
+	default:
+		return nil, invalidArgument("unsupported diff output %q", req.Output)
+	}
+
+	oldDoc, err := parse(ctx, req.OldXML, "oldXml")
+	if err != nil {
+		return nil, err
+	}
+	newDoc, err = parse(ctx, req.NewXML, "newXml")
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Output == DiffOutputJSONPatch {
+		ops, err := uslm.JSONPatch(oldDoc, newDoc)
+		if err != nil {
+			return nil, &Error{Code: CodeInternal, Message: err.Error()}
+		}
+		patch, err := json.Marshal(ops)
+		if err != nil {
+			return nil, &Error{Code: CodeInternal, Message: err.Error()}
+		}
+		return &DiffResponse{Changes: []ProvisionChange{}, JSONPatch: string(patch)}, nil
+	}
+	changes := []ProvisionChange{}
+	for _, c := range uslm.DiffProvisions(oldDoc, newDoc, opts) {
+		changes = append(changes, ProvisionChange{
+			Kind:       changeKinds[c.Kind],
+			Element:    c.Element,
+			Identifier: c.Identifier,
+			OldText:    c.OldText,
+			NewText:    c.NewText,
+		})
+	}
+	return &DiffResponse{Changes: changes}, nil
+}
+
+// changeKinds maps the kinds of uslm.DiffProvisions to those of the API.
+var changeKinds = map[uslm.ProvisionChangeKind]ChangeKind{
+	uslm.ProvisionAdded:      ChangeKindAdded,
+	uslm.ProvisionRemoved:    ChangeKindRemoved,
+	uslm.ProvisionModified:   ChangeKindModified,
+	uslm.ProvisionRenumbered: ChangeKindRenumbered,
+}
+
+// startSpan starts the span for the named method of the API, whose request
+// carries size bytes of XML.
+func startSpan(ctx context.Context, method string, size int) (context.Context, uslm.Span) {
+	return uslm.StartSpan(ctx, "uslmapi."+method, uslm.SpanAttribute{Key: uslm.AttrDocumentSize, Value: int64(size)})
+}
+
+// parse parses the XML in the named request field, tracing the parse as a
+// child of the span in ctx.
+func parse(ctx context.Context, data, field string) (uslm.LegislativeDocument, error) {
+	if len(bytes.TrimSpace([]byte(data))) == 0 {
+		return nil, invalidArgument("%s is required", field)
+	}
+	doc, err := uslm.ParseDocumentWithOptions([]byte(data), uslm.ParseOptions{Limits: uslm.DefaultLimits, Context: ctx})
+	if err != nil {
+		return nil, invalidArgument("%s: %v", field, err)
+	}
+	return doc, nil
+}
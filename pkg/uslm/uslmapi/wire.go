@@ -0,0 +1,400 @@
+package uslmapi
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"strconv"
+)
+
+// The gRPC handler carries the messages in the protocol buffer encoding of
+// uslm.proto. The encoding is written out here field by field, as the
+// package takes no protobuf dependency; the field numbers must match
+// uslm.proto. As in proto3, fields with zero values are omitted.
+
+// protoMessage is a message with a protocol buffer encoding.
+type protoMessage interface {
+	marshalProto() []byte
+	unmarshalProto(data []byte) error
+}
+
+// Wire types of the protocol buffer encoding.
+const (
+	wireVarint  = 0
+	wireFixed64 = 1
+	wireBytes   = 2
+	wireFixed32 = 5
+)
+
+func appendTag(b []byte, field, wire int) []byte {
+	return binary.AppendUvarint(b, uint64(field)<<3|uint64(wire))
+}
+
+func appendString(b []byte, field int, s string) []byte {
+	if s == "" {
+		return b
+	}
+	return appendBytes(b, field, []byte(s))
+}
+
+func appendBytes(b []byte, field int, v []byte) []byte {
+	b = appendTag(b, field, wireBytes)
+	b = binary.AppendUvarint(b, uint64(len(v)))
+	return append(b, v...)
+}
+
+func appendVarint(b []byte, field int, v uint64) []byte {
+	if v == 0 {
+		return b
+	}
+	b = appendTag(b, field, wireVarint)
+	return binary.AppendUvarint(b, v)
+}
+
+func appendBool(b []byte, field int, v bool) []byte {
+	if !v {
+		return b
+	}
+	return appendVarint(b, field, 1)
+}
+
+// protoField is a field read from an encoded message.
+type protoField struct {
+	num  int
+	wire int
+	v    uint64 // value of a varint field
+	b    []byte // value of a length-delimited field
+}
+
+var errTruncated = errors.New("truncated message")
+
+// readProto calls fn for each field of the encoded message data. Fields of
+// the fixed-width wire types are skipped, since no message uses them.
+func readProto(data []byte, fn func(f protoField) error) error {
+	for len(data) > 0 {
+		tag, n := binary.Uvarint(data)
+		if n <= 0 {
+			return errTruncated
+		}
+		data = data[n:]
+		f := protoField{num: int(tag >> 3), wire: int(tag & 7)}
+		switch f.wire {
+		case wireVarint:
+			if f.v, n = binary.Uvarint(data); n <= 0 {
+				return errTruncated
+			}
+		case wireBytes:
+			size, m := binary.Uvarint(data)
+			if m <= 0 || size > uint64(len(data)-m) {
+				return errTruncated
+			}
+			f.b = data[m : m+int(size)]
+			n = m + int(size)
+		case wireFixed64:
+			n = 8
+		case wireFixed32:
+			n = 4
+		default:
+			return fmt.Errorf("field %d has unsupported wire type %d", f.num, f.wire)
+		}
+		if n > len(data) {
+			return errTruncated
+		}
+		data = data[n:]
+		if f.num == 0 {
+			return errors.New("field number 0")
+		}
+		if f.wire == wireVarint || f.wire == wireBytes {
+			if err := fn(f); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (f protoField) check(wire int) error {
+	if f.wire != wire {
+		return fmt.Errorf("field %d has wire type %d, want %d", f.num, f.wire, wire)
+	}
+	return nil
+}
+
+func (f protoField) setString(s *string) error {
+	if err := f.check(wireBytes); err != nil {
+		return err
+	}
+	*s = string(f.b)
+	return nil
+}
+
+func (f protoField) setBool(v *bool) error {
+	if err := f.check(wireVarint); err != nil {
+		return err
+	}
+	*v = f.v != 0
+	return nil
+}
+
+// enumNumber returns the number of e, the index of its name in names. The
+// empty string and names not listed encode as 0, the unspecified value.
+func enumNumber[E ~string](names []E, e E) uint64 {
+	for i, name := range names {
+		if name == e {
+			return uint64(i)
+		}
+	}
+	return 0
+}
+
+// setEnum sets *e to the name of the field's number in names. A number not
+// listed is kept as its decimal string, as proto3 keeps unknown enum values,
+// for the service to reject.
+func setEnum[E ~string](f protoField, names []E, e *E) error {
+	if err := f.check(wireVarint); err != nil {
+		return err
+	}
+	if f.v < uint64(len(names)) {
+		*e = names[f.v]
+	} else {
+		*e = E(strconv.FormatUint(f.v, 10))
+	}
+	return nil
+}
+
+// Enum names in order of their numbers in uslm.proto.
+var (
+	formatNames     = []Format{FormatUnspecified, FormatJSON, FormatJSONSnakeCase, FormatText, FormatSummaryMarkdown}
+	diffModeNames   = []DiffMode{DiffModeUnspecified, DiffModeFull, DiffModeStructure}
+	diffOutputNames = []DiffOutput{DiffOutputUnspecified, DiffOutputChanges, DiffOutputJSONPatch}
+	changeKindNames = []ChangeKind{ChangeKindUnspecified, ChangeKindAdded, ChangeKindRemoved, ChangeKindModified, ChangeKindRenumbered}
+)
+
+func (m *ParseRequest) marshalProto() []byte {
+	return appendString(nil, 1, m.XML)
+}
+
+func (m *ParseRequest) unmarshalProto(data []byte) error {
+	return readProto(data, func(f protoField) error {
+		if f.num == 1 {
+			return f.setString(&m.XML)
+		}
+		return nil
+	})
+}
+
+func (m *ParseResponse) marshalProto() []byte {
+	b := appendString(nil, 1, m.DocumentType)
+	b = appendString(b, 2, m.DocumentNumber)
+	b = appendString(b, 3, m.Congress)
+	b = appendString(b, 4, m.Session)
+	b = appendString(b, 5, m.Title)
+	b = appendString(b, 6, m.Stage)
+	for _, c := range m.Citations {
+		b = appendBytes(b, 7, []byte(c))
+	}
+	return appendString(b, 8, m.JSON)
+}
+
+func (m *ParseResponse) unmarshalProto(data []byte) error {
+	m.Citations = []string{}
+	return readProto(data, func(f protoField) error {
+		switch f.num {
+		case 1:
+			return f.setString(&m.DocumentType)
+		case 2:
+			return f.setString(&m.DocumentNumber)
+		case 3:
+			return f.setString(&m.Congress)
+		case 4:
+			return f.setString(&m.Session)
+		case 5:
+			return f.setString(&m.Title)
+		case 6:
+			return f.setString(&m.Stage)
+		case 7:
+			var c string
+			err := f.setString(&c)
+			m.Citations = append(m.Citations, c)
+			return err
+		case 8:
+			return f.setString(&m.JSON)
+		}
+		return nil
+	})
+}
+
+func (m *ConvertRequest) marshalProto() []byte {
+	b := appendString(nil, 1, m.XML)
+	return appendVarint(b, 2, enumNumber(formatNames, m.Format))
+}
+
+func (m *ConvertRequest) unmarshalProto(data []byte) error {
+	m.Format = FormatUnspecified
+	return readProto(data, func(f protoField) error {
+		switch f.num {
+		case 1:
+			return f.setString(&m.XML)
+		case 2:
+			return setEnum(f, formatNames, &m.Format)
+		}
+		return nil
+	})
+}
+
+func (m *ConvertResponse) marshalProto() []byte {
+	return appendString(nil, 1, m.Output)
+}
+
+func (m *ConvertResponse) unmarshalProto(data []byte) error {
+	return readProto(data, func(f protoField) error {
+		if f.num == 1 {
+			return f.setString(&m.Output)
+		}
+		return nil
+	})
+}
+
+func (m *ValidateRequest) marshalProto() []byte {
+	return appendString(nil, 1, m.XML)
+}
+
+func (m *ValidateRequest) unmarshalProto(data []byte) error {
+	return readProto(data, func(f protoField) error {
+		if f.num == 1 {
+			return f.setString(&m.XML)
+		}
+		return nil
+	})
+}
+
+func (m *Issue) marshalProto() []byte {
+	b := appendString(nil, 1, m.Kind)
+	b = appendString(b, 2, m.Element)
+	b = appendString(b, 3, m.ID)
+	b = appendString(b, 4, m.Identifier)
+	return appendString(b, 5, m.Message)
+}
+
+func (m *Issue) unmarshalProto(data []byte) error {
+	return readProto(data, func(f protoField) error {
+		switch f.num {
+		case 1:
+			return f.setString(&m.Kind)
+		case 2:
+			return f.setString(&m.Element)
+		case 3:
+			return f.setString(&m.ID)
+		case 4:
+			return f.setString(&m.Identifier)
+		case 5:
+			return f.setString(&m.Message)
+		}
+		return nil
+	})
+}
+
+func (m *ValidateResponse) marshalProto() []byte {
+	b := appendBool(nil, 1, m.Valid)
+	for i := range m.Issues {
+		b = appendBytes(b, 2, m.Issues[i].marshalProto())
+	}
+	return b
+}
+
+func (m *ValidateResponse) unmarshalProto(data []byte) error {
+	m.Issues = []Issue{}
+	return readProto(data, func(f protoField) error {
+		switch f.num {
+		case 1:
+			return f.setBool(&m.Valid)
+		case 2:
+			var issue Issue
+			if err := f.check(wireBytes); err != nil {
+				return err
+			}
+			err := issue.unmarshalProto(f.b)
+			m.Issues = append(m.Issues, issue)
+			return err
+		}
+		return nil
+	})
+}
+
+func (m *DiffRequest) marshalProto() []byte {
+	b := appendString(nil, 1, m.OldXML)
+	b = appendString(b, 2, m.NewXML)
+	b = appendVarint(b, 3, enumNumber(diffModeNames, m.Mode))
+	return appendVarint(b, 4, enumNumber(diffOutputNames, m.Output))
+}
+
+func (m *DiffRequest) unmarshalProto(data []byte) error {
+	m.Mode, m.Output = DiffModeUnspecified, DiffOutputUnspecified
+	return readProto(data, func(f protoField) error {
+		switch f.num {
+		case 1:
+			return f.setString(&m.OldXML)
+		case 2:
+			return f.setString(&m.NewXML)
+		case 3:
+			return setEnum(f, diffModeNames, &m.Mode)
+		case 4:
+			return setEnum(f, diffOutputNames, &m.Output)
+		}
+		return nil
+	})
+}
+
+func (m *ProvisionChange) marshalProto() []byte {
+	b := appendVarint(nil, 1, enumNumber(changeKindNames, m.Kind))
+	b = appendString(b, 2, m.Element)
+	b = appendString(b, 3, m.Identifier)
+	b = appendString(b, 4, m.OldText)
+	return appendString(b, 5, m.NewText)
+}
+
+func (m *ProvisionChange) unmarshalProto(data []byte) error {
+	m.Kind = ChangeKindUnspecified
+	return readProto(data, func(f protoField) error {
+		switch f.num {
+		case 1:
+			return setEnum(f, changeKindNames, &m.Kind)
+		case 2:
+			return f.setString(&m.Element)
+		case 3:
+			return f.setString(&m.Identifier)
+		case 4:
+			return f.setString(&m.OldText)
+		case 5:
+			return f.setString(&m.NewText)
+		}
+		return nil
+	})
+}
+
+func (m *DiffResponse) marshalProto() []byte {
+	var b []byte
+	for i := range m.Changes {
+		b = appendBytes(b, 1, m.Changes[i].marshalProto())
+	}
+	return appendString(b, 2, m.JSONPatch)
+}
+
+func (m *DiffResponse) unmarshalProto(data []byte) error {
+	m.Changes = []ProvisionChange{}
+	return readProto(data, func(f protoField) error {
+		switch f.num {
+		case 1:
+			var c ProvisionChange
+			if err := f.check(wireBytes); err != nil {
+				return err
+			}
+			err := c.unmarshalProto(f.b)
+			m.Changes = append(m.Changes, c)
+			return err
+		case 2:
+			return f.setString(&m.JSONPatch)
+		}
+		return nil
+	})
+}
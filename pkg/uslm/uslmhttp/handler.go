@@ -0,0 +1,252 @@
+// Package uslmhttp exposes document operations over HTTP.
+//
+// Endpoints:
+//
+//	POST /parse                            parse an XML body and return the document
+//	POST /convert?to=json|xml|text|summary convert an XML (or JSON) body
+//	GET  /documents/{id}                   return a stored document
+//	GET  /documents/{id}/sections/{num}    return one section of a stored document
+//
+// Responses are JSON unless the Accept header prefers XML (application/xml
+// or text/xml). Errors are returned as {"error": "..."} with a matching status.
+package uslmhttp
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/usgpo/uslm/pkg/uslm"
+)
+
+// MaxBodyBytes bounds the size of request bodies.
+const MaxBodyBytes = 64 << 20
+
+// Handler returns an http.Handler serving the endpoints above. The
+// /documents endpoints respond 404 when store is nil.
+func Handler(store uslm.Store) http.Handler {
+	return &handler{store: store}
+}
+
+type handler struct {
+	store uslm.Store
+}
+
+func (h *handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	path := strings.Trim(r.URL.Path, "/")
+	parts := strings.Split(path, "/")
+
+	switch {
+	case path == "parse":
+		if requireMethod(w, r, http.MethodPost) {
+			h.parse(w, r)
+		}
+	case path == "convert":
+		if requireMethod(w, r, http.MethodPost) {
+			h.convert(w, r)
+		}
+	case parts[0] == "documents" && len(parts) == 2:
+		if requireMethod(w, r, http.MethodGet) {
+			h.document(w, r, parts[1])
+		}
+	case parts[0] == "documents" && len(parts) == 4 && parts[2] == "sections":
+		if requireMethod(w, r, http.MethodGet) {
+			h.section(w, r, parts[1], parts[3])
+		}
+	default:
+		writeError(w, http.StatusNotFound, "not found")
+	}
+}
+
+// parse handles POST /parse.
+func (h *handler) parse(w http.ResponseWriter, r *http.Request) {
+	doc, ok := readDocument(w, r)
+	if !ok {
+		return
+	}
+	writeDocument(w, r, doc)
+}
+
+// convert handles POST /convert.
+func (h *handler) convert(w http.ResponseWriter, r *http.Request) {
+	doc, ok := readDocument(w, r)
+	if !ok {
+		return
+	}
+
+	switch to := r.URL.Query().Get("to"); to {
+	case "json":
+		writeJSON(w, http.StatusOK, doc)
+	case "xml":
+		writeXML(w, doc)
+	case "text":
+		w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+		io.WriteString(w, uslm.ExtractText(doc, uslm.DefaultNormalizeOptions()))
+	case "summary":
+		w.Header().Set("Content-Type", "text/markdown; charset=utf-8")
+		io.WriteString(w, uslm.Summarize(doc).Markdown())
+	case "":
+		writeDocument(w, r, doc)
+	default:
+		writeError(w, http.StatusBadRequest, fmt.Sprintf("unsupported conversion %q", to))
+	}
+}
+
+// document handles GET /documents/{id}.
+func (h *handler) document(w http.ResponseWriter, r *http.Request, id string) {
+	if doc, ok := h.lookup(w, r, id); ok {
+		writeDocument(w, r, doc)
+	}
+}
+
+// section handles GET /documents/{id}/sections/{num}, matching num against
+// the section's normalized number value (e.g., "2" for "SEC. 2.").
+func (h *handler) section(w http.ResponseWriter, r *http.Request, id, num string) {
+	doc, ok := h.lookup(w, r, id)
+	if !ok {
+		return
+	}
+	for _, p := range uslm.Provisions(doc) {
+		var found *uslm.Section
+		p.Walk(func(p *uslm.Provision) bool {
+			if s, ok := p.Node.(*uslm.Section); ok && found == nil && p.GetNumValue() == num {
+				found = s
+			}
+			// Sections only nest inside titles.
+			return p.Element == "title"
+		})
+		if found != nil {
+			if prefersXML(r) {
+				writeXML(w, found)
+			} else {
+				writeJSON(w, http.StatusOK, found)
+			}
+			return
+		}
+	}
+	writeError(w, http.StatusNotFound, fmt.Sprintf("section %s not found in %s", num, id))
+}
+
+// lookup fetches id from the store, writing an error response on failure.
+func (h *handler) lookup(w http.ResponseWriter, r *http.Request, id string) (uslm.LegislativeDocument, bool) {
+	if h.store == nil {
+		writeError(w, http.StatusNotFound, "no document store configured")
+		return nil, false
+	}
+	doc, err := h.store.Get(r.Context(), id)
+	if errors.Is(err, uslm.ErrNotFound) {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("document %s not found", id))
+		return nil, false
+	}
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, fmt.Sprintf("failed to load document %s: %v", id, err))
+		return nil, false
+	}
+	return doc, true
+}
+
+// readDocument parses the request body. XML is the default; a JSON body
+// (Content-Type application/json) must name its document type with the
+// "type" query parameter (e.g., type=bill).
+func readDocument(w http.ResponseWriter, r *http.Request) (uslm.LegislativeDocument, bool) {
+	data, err := io.ReadAll(http.MaxBytesReader(w, r.Body, MaxBodyBytes))
+	if errors.As(err, new(*http.MaxBytesError)) {
+		writeError(w, http.StatusRequestEntityTooLarge, fmt.Sprintf("body exceeds %d bytes", MaxBodyBytes))
+		return nil, false
+	}
+	if err != nil {
+		writeError(w, http.StatusBadRequest, fmt.Sprintf("failed to read body: %v", err))
+		return nil, false
+	}
+
+	var doc uslm.LegislativeDocument
+	if mediaType(r.Header.Get("Content-Type")) == "application/json" {
+		doc, err = uslm.DocumentFromJSON(data, uslm.DocumentType(r.URL.Query().Get("type")))
+	} else {
+		doc, err = uslm.ParseDocumentWithOptions(data, uslm.ParseOptions{Limits: uslm.DefaultLimits, Context: r.Context()})
+	}
+	if errors.Is(err, uslm.ErrLimitExceeded) {
+		// The body is within MaxBodyBytes, well under DefaultLimits.MaxBytes,
+		// so the limit exceeded is one of nesting or attribute length.
+		writeError(w, http.StatusUnprocessableEntity, err.Error())
+		return nil, false
+	}
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return nil, false
+	}
+	return doc, true
+}
+
+// writeDocument writes doc in the representation the client prefers.
+func writeDocument(w http.ResponseWriter, r *http.Request, doc uslm.LegislativeDocument) {
+	if prefersXML(r) {
+		writeXML(w, doc)
+	} else {
+		writeJSON(w, http.StatusOK, doc)
+	}
+}
+
+// prefersXML reports whether the first JSON or XML media range in the
+// Accept header is XML.
+func prefersXML(r *http.Request) bool {
+	for _, accept := range strings.Split(r.Header.Get("Accept"), ",") {
+		switch mediaType(accept) {
+		case "application/xml", "text/xml":
+			return true
+		case "application/json":
+			return false
+		}
+	}
+	return false
+}
+
+// mediaType returns the lower-cased media type without parameters.
+func mediaType(value string) string {
+	if i := strings.IndexByte(value, ';'); i >= 0 {
+		value = value[:i]
+	}
+	return strings.ToLower(strings.TrimSpace(value))
+}
+
+func requireMethod(w http.ResponseWriter, r *http.Request, method string) bool {
+	if r.Method == method {
+		return true
+	}
+	w.Header().Set("Allow", method)
+	writeError(w, http.StatusMethodNotAllowed, "method must be "+method)
+	return false
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	uslm.EncodeJSON(w, v)
+}
+
+// writeXML writes a document or element as XML.
+func writeXML(w http.ResponseWriter, v interface{}) {
+	var data []byte
+	var err error
+	if doc, ok := v.(uslm.LegislativeDocument); ok {
+		data, err = uslm.MarshalDocumentToXML(doc)
+	} else if data, err = xml.MarshalIndent(v, "", "  "); err == nil {
+		data = append([]byte(xml.Header), data...)
+	}
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	w.Header().Set("Content-Type", "application/xml; charset=utf-8")
+	w.Write(data)
+}
+
+func writeError(w http.ResponseWriter, status int, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(map[string]string{"error": message})
+}
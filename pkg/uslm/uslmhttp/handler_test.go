@@ -0,0 +1,152 @@
+package uslmhttp
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/usgpo/uslm/pkg/uslm"
+)
+
+func readSample(t *testing.T, name string) string {
+	t.Helper()
+	data, err := os.ReadFile(filepath.Join("..", "..", "..", "bill-version-samples-september-2024", name))
+	if err != nil {
+		t.Fatalf("failed to read sample: %v", err)
+	}
+	return string(data)
+}
+
+// serve sends a request to h and returns the recorded response.
+func serve(h http.Handler, method, target string, body io.Reader, header map[string]string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, target, body)
+	for k, v := range header {
+		req.Header.Set(k, v)
+	}
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	return rec
+}
+
+// failingStore is a Store whose Get always fails.
+type failingStore struct{ uslm.Store }
+
+func (failingStore) Get(context.Context, string) (uslm.LegislativeDocument, error) {
+	return nil, errors.New("disk on fire")
+}
+
+// failingBody is a request body whose reads fail, as on a client disconnect.
+type failingBody struct{}
+
+func (failingBody) Read([]byte) (int, error) { return 0, errors.New("connection reset") }
+
+func TestHandlerRoutes(t *testing.T) {
+	bill := readSample(t, "BILLS-114s32cds.xml")
+	doc, err := uslm.ParseDocument([]byte(bill))
+	if err != nil {
+		t.Fatalf("failed to parse sample: %v", err)
+	}
+	store := uslm.NewMemoryStore()
+	store.Put(context.Background(), "BILLS-114s32cds", doc)
+	h := Handler(store)
+
+	for _, tc := range []struct {
+		name        string
+		method      string
+		target      string
+		body        string
+		header      map[string]string
+		status      int
+		contentType string
+		want        string
+	}{
+		{"parse", http.MethodPost, "/parse", bill, nil, http.StatusOK, "application/json", `"dcTitle"`},
+		{"parse as XML", http.MethodPost, "/parse", bill, map[string]string{"Accept": "text/html, application/xml;q=0.9"}, http.StatusOK, "application/xml; charset=utf-8", "<bill "},
+		{"parse preferring JSON", http.MethodPost, "/parse", bill, map[string]string{"Accept": "application/json, application/xml"}, http.StatusOK, "application/json", `"dcTitle"`},
+		{"convert to text", http.MethodPost, "/convert?to=text", bill, nil, http.StatusOK, "text/plain; charset=utf-8", "Transnational Drug Trafficking Act"},
+		{"convert to summary", http.MethodPost, "/convert?to=summary", bill, nil, http.StatusOK, "text/markdown; charset=utf-8", "# "},
+		{"convert to XML", http.MethodPost, "/convert?to=xml", bill, nil, http.StatusOK, "application/xml; charset=utf-8", "<bill "},
+		{"convert to JSON", http.MethodPost, "/convert?to=json", bill, map[string]string{"Accept": "application/xml"}, http.StatusOK, "application/json", `"dcTitle"`},
+		{"unsupported conversion", http.MethodPost, "/convert?to=pdf", bill, nil, http.StatusBadRequest, "application/json", `unsupported conversion`},
+		{"document", http.MethodGet, "/documents/BILLS-114s32cds", "", nil, http.StatusOK, "application/json", `"dcTitle"`},
+		{"section", http.MethodGet, "/documents/BILLS-114s32cds/sections/2", "", nil, http.StatusOK, "application/json", "POSSESSION"},
+		{"section as XML", http.MethodGet, "/documents/BILLS-114s32cds/sections/2", "", map[string]string{"Accept": "text/xml"}, http.StatusOK, "application/xml; charset=utf-8", "<section"},
+		{"missing section", http.MethodGet, "/documents/BILLS-114s32cds/sections/99", "", nil, http.StatusNotFound, "application/json", "section 99 not found"},
+		{"missing document", http.MethodGet, "/documents/BILLS-missing", "", nil, http.StatusNotFound, "application/json", "not found"},
+		{"wrong method", http.MethodGet, "/parse", "", nil, http.StatusMethodNotAllowed, "application/json", "method must be POST"},
+		{"unknown path", http.MethodGet, "/render", "", nil, http.StatusNotFound, "application/json", "not found"},
+	} {
+		rec := serve(h, tc.method, tc.target, strings.NewReader(tc.body), tc.header)
+		if rec.Code != tc.status {
+			t.Errorf("%s: status %d, want %d: %s", tc.name, rec.Code, tc.status, rec.Body)
+			continue
+		}
+		if ct := rec.Header().Get("Content-Type"); ct != tc.contentType {
+			t.Errorf("%s: Content-Type %q, want %q", tc.name, ct, tc.contentType)
+		}
+		if !strings.Contains(rec.Body.String(), tc.want) {
+			t.Errorf("%s: body lacks %q", tc.name, tc.want)
+		}
+	}
+	if rec := serve(h, http.MethodGet, "/parse", nil, nil); rec.Header().Get("Allow") != http.MethodPost {
+		t.Errorf("expected Allow: POST, got %q", rec.Header().Get("Allow"))
+	}
+
+	// A JSON body names its document type.
+	rec := serve(h, http.MethodPost, "/convert?to=json", strings.NewReader(bill), nil)
+	rec = serve(h, http.MethodPost, "/convert?to=text&type=bill", rec.Body, map[string]string{"Content-Type": "application/json; charset=utf-8"})
+	if rec.Code != http.StatusOK || !strings.Contains(rec.Body.String(), "Transnational Drug Trafficking Act") {
+		t.Errorf("JSON body: status %d: %s", rec.Code, rec.Body)
+	}
+
+	if rec := serve(Handler(nil), http.MethodGet, "/documents/BILLS-114s32cds", nil, nil); rec.Code != http.StatusNotFound {
+		t.Errorf("expected 404 without a store, got %d", rec.Code)
+	}
+	if rec := serve(Handler(failingStore{}), http.MethodGet, "/documents/BILLS-114s32cds", nil, nil); rec.Code != http.StatusInternalServerError {
+		t.Errorf("expected 500 for a failing store, got %d", rec.Code)
+	}
+}
+
+func TestHandlerBodyErrors(t *testing.T) {
+	h := Handler(nil)
+	nested := `<bill xmlns="http://schemas.gpo.gov/xml/uslm">` + strings.Repeat("<p>", uslm.DefaultLimits.MaxDepth) + strings.Repeat("</p>", uslm.DefaultLimits.MaxDepth) + `</bill>`
+	longAttr := `<bill xmlns="http://schemas.gpo.gov/xml/uslm" id="` + strings.Repeat("x", uslm.DefaultLimits.MaxAttrLength+1) + `"/>`
+
+	for _, tc := range []struct {
+		name   string
+		body   io.Reader
+		status int
+	}{
+		{"too large", io.LimitReader(repeatReader('x'), MaxBodyBytes+1), http.StatusRequestEntityTooLarge},
+		{"read failure", failingBody{}, http.StatusBadRequest},
+		{"malformed", strings.NewReader("<bill>"), http.StatusBadRequest},
+		{"too deep", strings.NewReader(nested), http.StatusUnprocessableEntity},
+		{"attribute too long", strings.NewReader(longAttr), http.StatusUnprocessableEntity},
+	} {
+		rec := serve(h, http.MethodPost, "/parse", tc.body, nil)
+		if rec.Code != tc.status {
+			t.Errorf("%s: status %d, want %d", tc.name, rec.Code, tc.status)
+		}
+		var body map[string]string
+		if err := json.Unmarshal(rec.Body.Bytes(), &body); err != nil || body["error"] == "" {
+			t.Errorf("%s: expected a JSON error, got %s", tc.name, rec.Body)
+		}
+	}
+}
+
+// repeatReader reads an endless run of one byte.
+type repeatReader byte
+
+func (r repeatReader) Read(p []byte) (int, error) {
+	for i := range p {
+		p[i] = byte(r)
+	}
+	return len(p), nil
+}
@@ -0,0 +1,144 @@
+package uslm
+
+// level is a uniform view of a single hierarchical element (title, section,
+// subsection, paragraph, subparagraph, clause, or subclause) used by the
+// document-wide traversals. Pointer fields refer into the underlying struct so
+// callers may modify them; node is the struct itself (e.g., *Section).
+type level struct {
+	element    string
+	id         *string
+	identifier *string
+	num        *Num
+	heading    *Heading
+	chapeau    *Chapeau
+	content    *Content
+	changed    *ChangeMarker
+	attrs      *Attributes
+	node       interface{}
+	index      int
+	depth      int
+	parent     *level
+}
+
+// numValue returns the level's normalized number value, or an empty string.
+func (l *level) numValue() string {
+	if l.num != nil {
+		return l.num.Value
+	}
+	return ""
+}
+
+// lang returns the xml:lang of the level's element, or of the nearest
+// enclosing level that has one, or an empty string.
+func (l *level) lang() string {
+	for ; l != nil; l = l.parent {
+		if l.attrs != nil {
+			if lang := l.attrs.Lang(); lang != "" {
+				return lang
+			}
+		}
+	}
+	return ""
+}
+
+// walkDocumentLevels visits every hierarchical level of the document in
+// document order, parents before children. Returning false from fn skips the
+// level's children.
+func walkDocumentLevels(doc LegislativeDocument, fn func(l *level) bool) {
+	switch d := doc.(type) {
+	case *Bill:
+		walkMainLevels(d.Main, fn)
+	case *Resolution:
+		walkMainLevels(d.Main, fn)
+	case *EngrossedAmendment:
+		if d.AmendMain != nil {
+			walkSectionLevels(d.AmendMain.Sections, nil, fn)
+		}
+	case *Amendment:
+		if d.AmendMain != nil {
+			walkSectionLevels(d.AmendMain.Sections, nil, fn)
+		}
+	case *GenericDocument:
+		if d.Content != nil {
+			walkTitleLevels(d.Content.Titles, fn)
+			walkSectionLevels(d.Content.Sections, nil, fn)
+		}
+		for i := range d.Appendices {
+			walkSectionLevels(d.Appendices[i].Sections, nil, fn)
+		}
+	}
+}
+
+func walkMainLevels(main *Main, fn func(l *level) bool) {
+	if main == nil {
+		return
+	}
+	walkTitleLevels(main.Titles, fn)
+	walkSectionLevels(main.Sections, nil, fn)
+}
+
+func walkTitleLevels(titles []Title, fn func(l *level) bool) {
+	for i := range titles {
+		t := &titles[i]
+		l := link(&level{element: "title", node: t, attrs: &t.Attrs, id: &t.ID, changed: &t.Changed, identifier: &t.Identifier, num: t.Num, heading: t.Heading}, i, nil)
+		if fn(l) {
+			walkSectionLevels(t.Sections, l, fn)
+		}
+	}
+}
+
+func walkSectionLevels(sections []Section, parent *level, fn func(l *level) bool) {
+	for i := range sections {
+		s := &sections[i]
+		l := link(&level{element: "section", node: s, attrs: &s.Attrs, id: &s.ID, changed: &s.Changed, identifier: &s.Identifier, num: s.Num, heading: s.Heading, chapeau: s.Chapeau, content: s.Content}, i, parent)
+		if !fn(l) {
+			continue
+		}
+		for j := range s.Subsections {
+			sub := &s.Subsections[j]
+			sl := link(&level{element: "subsection", node: sub, attrs: &sub.Attrs, id: &sub.ID, changed: &sub.Changed, identifier: &sub.Identifier, num: sub.Num, heading: sub.Heading, chapeau: sub.Chapeau, content: sub.Content}, j, l)
+			if fn(sl) {
+				walkParagraphLevels(sub.Paragraphs, sl, fn)
+			}
+		}
+		walkParagraphLevels(s.Paragraphs, l, fn)
+	}
+}
+
+func walkParagraphLevels(paragraphs []Paragraph, parent *level, fn func(l *level) bool) {
+	for i := range paragraphs {
+		p := &paragraphs[i]
+		pl := link(&level{element: "paragraph", node: p, attrs: &p.Attrs, id: &p.ID, changed: &p.Changed, identifier: &p.Identifier, num: p.Num, heading: p.Heading, chapeau: p.Chapeau, content: p.Content}, i, parent)
+		if !fn(pl) {
+			continue
+		}
+		for j := range p.Subparagraphs {
+			sp := &p.Subparagraphs[j]
+			spl := link(&level{element: "subparagraph", node: sp, attrs: &sp.Attrs, id: &sp.ID, changed: &sp.Changed, identifier: &sp.Identifier, num: sp.Num, chapeau: sp.Chapeau, content: sp.Content}, j, pl)
+			if !fn(spl) {
+				continue
+			}
+			for k := range sp.Clauses {
+				c := &sp.Clauses[k]
+				cl := link(&level{element: "clause", node: c, attrs: &c.Attrs, id: &c.ID, changed: &c.Changed, identifier: &c.Identifier, num: c.Num, content: c.Content}, k, spl)
+				if !fn(cl) {
+					continue
+				}
+				for m := range c.Subclauses {
+					sc := &c.Subclauses[m]
+					fn(link(&level{element: "subclause", node: sc, attrs: &sc.Attrs, id: &sc.ID, changed: &sc.Changed, identifier: &sc.Identifier, num: sc.Num, content: sc.Content}, m, cl))
+				}
+			}
+		}
+	}
+}
+
+// link sets the level's position and parent and returns it.
+func link(l *level, index int, parent *level) *level {
+	l.index = index
+	l.parent = parent
+	if parent != nil {
+		l.depth = parent.depth + 1
+	}
+	return l
+}
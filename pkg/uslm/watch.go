@@ -0,0 +1,178 @@
+package uslm
+
+import (
+	"context"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+)
+
+// WatchEventKind classifies a WatchEvent.
+type WatchEventKind string
+
+const (
+	WatchCreated  WatchEventKind = "created"
+	WatchModified WatchEventKind = "modified"
+	WatchRemoved  WatchEventKind = "removed"
+)
+
+// WatchEvent reports a new, changed, or removed file in a watched directory.
+type WatchEvent struct {
+	Kind WatchEventKind
+	Path string
+
+	// Hash is the file's ContentHash (empty for removals).
+	Hash string
+
+	// Document is the parsed file for creations and modifications, or nil if
+	// parsing failed, in which case Err is set.
+	Document LegislativeDocument
+	Err      error
+}
+
+// WatchOptions configures Watch.
+type WatchOptions struct {
+	// Interval between directory scans. Defaults to five seconds.
+	Interval time.Duration
+
+	// Pattern selects files by base name using filepath.Match syntax. If
+	// empty, files with an ".xml" extension in any case are selected.
+	Pattern string
+
+	// Recursive includes subdirectories.
+	Recursive bool
+
+	// Known maps paths to the ContentHash already processed, e.g., from a
+	// previous run. Files whose hash matches are not reported. If nil, every
+	// existing file is reported as created on the first scan.
+	Known map[string]string
+}
+
+// watchedFile is the state Watch keeps per file.
+type watchedFile struct {
+	modTime time.Time
+	size    int64
+	hash    string
+}
+
+// Watch polls dir for XML files and sends an event for every file that is
+// created, modified, or removed, parsing new content as it appears. A file
+// whose modification time or size changes but whose content does not is
+// not reported. The channel is closed when ctx is done.
+func Watch(ctx context.Context, dir string, opts WatchOptions) <-chan WatchEvent {
+	if opts.Interval <= 0 {
+		opts.Interval = 5 * time.Second
+	}
+
+	state := make(map[string]*watchedFile, len(opts.Known))
+	for path, hash := range opts.Known {
+		state[path] = &watchedFile{hash: hash}
+	}
+
+	events := make(chan WatchEvent)
+	go func() {
+		defer close(events)
+		ticker := time.NewTicker(opts.Interval)
+		defer ticker.Stop()
+		for {
+			if !scanWatchedDir(ctx, dir, opts, state, events) {
+				return
+			}
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+	return events
+}
+
+// scanWatchedDir compares dir against state, sending events for differences.
+// It returns false if ctx was cancelled.
+func scanWatchedDir(ctx context.Context, dir string, opts WatchOptions, state map[string]*watchedFile, events chan<- WatchEvent) bool {
+	send := func(e WatchEvent) bool {
+		select {
+		case events <- e:
+			return true
+		case <-ctx.Done():
+			return false
+		}
+	}
+
+	seen := make(map[string]bool)
+	for _, path := range matchingFiles(dir, opts.Pattern, opts.Recursive) {
+		seen[path] = true
+		info, err := os.Stat(path)
+		if err != nil {
+			continue
+		}
+		prev := state[path]
+		if prev != nil && prev.modTime.Equal(info.ModTime()) && prev.size == info.Size() {
+			continue
+		}
+
+		data, err := os.ReadFile(path)
+		if err != nil {
+			continue
+		}
+		hash := ContentHash(data)
+		if prev != nil && prev.hash == hash {
+			prev.modTime, prev.size = info.ModTime(), info.Size()
+			continue
+		}
+
+		kind := WatchCreated
+		if prev != nil {
+			kind = WatchModified
+		}
+		state[path] = &watchedFile{modTime: info.ModTime(), size: info.Size(), hash: hash}
+		doc, err := ParseDocument(data)
+		if err != nil {
+			doc = nil
+		}
+		if !send(WatchEvent{Kind: kind, Path: path, Hash: hash, Document: doc, Err: err}) {
+			return false
+		}
+	}
+
+	var removed []string
+	for path := range state {
+		if !seen[path] {
+			removed = append(removed, path)
+		}
+	}
+	sort.Strings(removed)
+	for _, path := range removed {
+		delete(state, path)
+		if !send(WatchEvent{Kind: WatchRemoved, Path: path}) {
+			return false
+		}
+	}
+	return ctx.Err() == nil
+}
+
+// matchingFiles returns the files in dir whose base names match pattern, in
+// lexical order, descending into subdirectories when recursive is set.
+// Entries that cannot be read are skipped.
+func matchingFiles(dir, pattern string, recursive bool) []string {
+	var paths []string
+	filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return nil
+		}
+		if d.IsDir() {
+			if path != dir && !recursive {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if matchPattern(pattern, d.Name()) {
+			paths = append(paths, path)
+		}
+		return nil
+	})
+	return paths
+}
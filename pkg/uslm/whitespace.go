@@ -0,0 +1,99 @@
+package uslm
+
+import (
+	"reflect"
+	"strings"
+)
+
+// WhitespaceMode selects how the parser treats whitespace in element text.
+type WhitespaceMode int
+
+const (
+	// WhitespacePreserve keeps text exactly as it appears in the source,
+	// including the newlines and indentation of pretty-printed XML, so the
+	// document can be written back out with full fidelity.
+	WhitespacePreserve WhitespaceMode = iota
+
+	// WhitespaceCollapse trims each text value and collapses every run of
+	// spaces, tabs, and newlines within it to a single space. Attribute
+	// values, and the reading-order text behind GetText and PlainText,
+	// are left as parsed.
+	WhitespaceCollapse
+)
+
+// collapseWhitespace applies WhitespaceCollapse to the text of every
+// element reachable from v, a pointer to a decoded document.
+func collapseWhitespace(v interface{}) {
+	c := &collapser{seen: map[uintptr]bool{}}
+	c.value(reflect.ValueOf(v))
+}
+
+// collapser walks a reflected document, collapsing its text.
+type collapser struct {
+	// seen holds the pointers already visited, so shared values are
+	// visited once.
+	seen map[uintptr]bool
+}
+
+// value collapses the text held by v and the values it refers to.
+func (c *collapser) value(v reflect.Value) {
+	switch v.Kind() {
+	case reflect.Pointer:
+		if v.IsNil() || c.seen[v.Pointer()] {
+			return
+		}
+		c.seen[v.Pointer()] = true
+		c.value(v.Elem())
+	case reflect.Interface:
+		if !v.IsNil() {
+			c.value(v.Elem())
+		}
+	case reflect.Slice:
+		for i := 0; i < v.Len(); i++ {
+			c.value(v.Index(i))
+		}
+	case reflect.Struct:
+		t := v.Type()
+		for i := 0; i < t.NumField(); i++ {
+			f, field := v.Field(i), t.Field(i)
+			if !field.IsExported() {
+				continue
+			}
+			if isTextField(field) {
+				collapseText(f)
+				continue
+			}
+			c.value(f)
+		}
+	}
+}
+
+// isTextField reports whether f holds element text when decoded: character
+// data, or the content of child elements decoded into strings.
+func isTextField(f reflect.StructField) bool {
+	k := f.Type.Kind()
+	if k == reflect.Slice {
+		k = f.Type.Elem().Kind()
+	}
+	if k != reflect.String {
+		return false
+	}
+	_, flags, _ := strings.Cut(f.Tag.Get("xml"), ",")
+	return strings.Contains(flags, "chardata") || isElementField(f)
+}
+
+// collapseText collapses the whitespace of a string or []string value.
+func collapseText(v reflect.Value) {
+	if !v.CanSet() {
+		return
+	}
+	if v.Kind() == reflect.Slice {
+		for i := 0; i < v.Len(); i++ {
+			collapseText(v.Index(i))
+		}
+		return
+	}
+	if s := v.String(); s != "" {
+		v.SetString(normalizeSpace(s))
+	}
+}
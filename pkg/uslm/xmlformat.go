@@ -0,0 +1,279 @@
+package uslm
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strings"
+	"unicode/utf8"
+)
+
+// XMLOptions configures MarshalDocumentToXMLWithOptions.
+type XMLOptions struct {
+	// Indent is written once per level of nesting before each element.
+	// Defaults to two spaces.
+	Indent string
+
+	// Compact writes the document without indentation or line breaks.
+	// Indent, WrapAttributes, and MaxLineLength are ignored.
+	Compact bool
+
+	// WrapAttributes writes each attribute of an element that has more than
+	// one on a line of its own, indented one level below the element.
+	WrapAttributes bool
+
+	// MaxLineLength, if positive, breaks lines longer than this many
+	// characters at the spaces between attributes and between words of
+	// text, indenting the continuation one level below the enclosing
+	// element. A break replaces a single space, which readers that
+	// normalize whitespace (as this package does) treat alike. Lines with
+	// no such space, and CDATA sections, are left long.
+	MaxLineLength int
+
+	// PreserveLexical restores the lexical forms of the source document:
+	// text that was written as a CDATA section is written as one again,
+	// text that was written as a named entity reference (&quot;, &apos;,
+	// or an entity the DOCTYPE declares) is written as that reference, and
+	// the DOCTYPE is kept. Each recorded spelling is restored as many times
+	// as it occurred in the source, at the first matching text in the
+	// output. Only character data is respelled, not attribute values.
+	// Documents not parsed from XML are unaffected.
+	PreserveLexical bool
+}
+
+// MarshalDocumentToXMLWithOptions is like MarshalDocumentToXML but applies
+// opts.
+func MarshalDocumentToXMLWithOptions(doc LegislativeDocument, opts XMLOptions) ([]byte, error) {
+	indent := opts.Indent
+	if indent == "" {
+		indent = "  "
+	}
+	if opts.Compact {
+		indent = ""
+	}
+
+	var data []byte
+	var err error
+	if indent == "  " {
+		data, err = MarshalDocumentToXML(doc)
+	} else {
+		data, err = marshalIndented(doc, indent)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if opts.PreserveLexical {
+		if forms := lexicalOf(doc); forms != nil {
+			if data, err = forms.restore(data); err != nil {
+				return nil, fmt.Errorf("failed to restore lexical forms: %w", err)
+			}
+		}
+	}
+	if !opts.Compact && (opts.WrapAttributes || opts.MaxLineLength > 0) {
+		if data, err = wrapXML(data, indent, opts.WrapAttributes, opts.MaxLineLength); err != nil {
+			return nil, fmt.Errorf("failed to wrap lines: %w", err)
+		}
+	}
+	return data, nil
+}
+
+// marshalIndented marshals doc as MarshalDocumentToXML does, with indent
+// for each level of nesting.
+func marshalIndented(doc LegislativeDocument, indent string) ([]byte, error) {
+	switch doc.(type) {
+	case *Bill, *Resolution, *EngrossedAmendment, *Amendment, *GenericDocument:
+	default:
+		return nil, fmt.Errorf("unsupported document type %T", doc)
+	}
+	data, err := xml.MarshalIndent(doc, "", indent)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal %s to XML: %w", DetectDocumentType(data), err)
+	}
+	return append([]byte(xml.Header), data...), nil
+}
+
+// lineWrapper rewrites marshaled XML with wrapped attributes and lines.
+type lineWrapper struct {
+	out     bytes.Buffer
+	indent  string
+	wrapAll bool
+	max     int
+	column  int // characters on the current output line
+	depth   int // elements open
+}
+
+// wrapXML wraps the attributes and long lines of data, which is indented
+// with indent.
+func wrapXML(data []byte, indent string, wrapAll bool, max int) ([]byte, error) {
+	w := &lineWrapper{indent: indent, wrapAll: wrapAll, max: max}
+	body := data
+	if bytes.HasPrefix(body, []byte(xml.Header)) {
+		w.out.WriteString(xml.Header)
+		body = body[len(xml.Header):]
+	}
+
+	d := xml.NewDecoder(bytes.NewReader(body))
+	// Entity references restored by PreserveLexical are declared only in
+	// the DOCTYPE, which a Decoder does not read.
+	d.Strict = false
+	var offset int64
+	for {
+		tok, err := d.RawToken()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		end := d.InputOffset()
+		raw := string(body[offset:end])
+		offset = end
+
+		switch tok.(type) {
+		case xml.StartElement:
+			w.startTag(raw)
+			if !strings.HasSuffix(raw, "/>") {
+				w.depth++
+			}
+		case xml.EndElement:
+			w.depth--
+			w.write(raw)
+		case xml.CharData:
+			if strings.HasPrefix(raw, "<![CDATA[") || strings.TrimSpace(raw) == "" {
+				w.write(raw)
+			} else {
+				w.text(raw, endTagWidth(body[offset:]))
+			}
+		default:
+			w.write(raw)
+		}
+	}
+	w.write(string(body[offset:]))
+	return w.out.Bytes(), nil
+}
+
+// write appends s as is.
+func (w *lineWrapper) write(s string) {
+	w.out.WriteString(s)
+	if i := strings.LastIndexByte(s, '\n'); i >= 0 {
+		w.column = utf8.RuneCountInString(s[i+1:])
+	} else {
+		w.column += utf8.RuneCountInString(s)
+	}
+}
+
+// lineBreak starts a continuation line indented below the element at the
+// given depth.
+func (w *lineWrapper) lineBreak(depth int) {
+	w.write("\n" + strings.Repeat(w.indent, depth+1))
+}
+
+// startTag writes a start tag, putting its attributes on lines of their own
+// when wrapping all attributes, and otherwise breaking between attributes
+// where the line would run long.
+func (w *lineWrapper) startTag(raw string) {
+	parts := tagParts(raw)
+	wrapAll := w.wrapAll && len(parts) > 3
+	for i, part := range parts {
+		switch {
+		case i == 0 || i == len(parts)-1:
+			w.write(part)
+		case wrapAll:
+			w.lineBreak(w.depth)
+			w.write(part)
+		default:
+			if w.max > 0 && w.column+1+w.width(part, parts[i+1:]) > w.max && w.column > 0 {
+				w.lineBreak(w.depth)
+			} else {
+				w.write(" ")
+			}
+			w.write(part)
+		}
+	}
+}
+
+// width returns the width of an attribute part, counting the tag's closing
+// delimiter when it is the last attribute.
+func (w *lineWrapper) width(part string, rest []string) int {
+	n := utf8.RuneCountInString(part)
+	if len(rest) == 1 {
+		n += utf8.RuneCountInString(rest[0])
+	}
+	return n
+}
+
+// text writes character data, breaking it at spaces where the line would
+// run past the maximum length. tail is the width of an end tag following
+// the text on the same line.
+func (w *lineWrapper) text(raw string, tail int) {
+	if w.max <= 0 {
+		w.write(raw)
+		return
+	}
+	words := strings.Split(raw, " ")
+	for i, word := range words {
+		width := utf8.RuneCountInString(word)
+		if i == len(words)-1 {
+			width += tail
+		}
+		if i > 0 {
+			if w.column+1+width > w.max && w.column > 0 && word != "" {
+				w.lineBreak(w.depth - 1)
+			} else {
+				w.write(" ")
+			}
+		}
+		w.write(word)
+	}
+}
+
+// endTagWidth returns the width of the end tag at the start of rest, or 0.
+func endTagWidth(rest []byte) int {
+	if !bytes.HasPrefix(rest, []byte("</")) {
+		return 0
+	}
+	if i := bytes.IndexByte(rest, '>'); i >= 0 {
+		return utf8.RuneCount(rest[:i+1])
+	}
+	return 0
+}
+
+// tagParts splits a start tag into "<name", its attributes (name="value"),
+// and the closing ">" or "/>".
+func tagParts(raw string) []string {
+	end := ">"
+	body := strings.TrimSuffix(raw, ">")
+	if strings.HasSuffix(body, "/") {
+		end = "/>"
+		body = strings.TrimSuffix(body, "/")
+	}
+	var parts []string
+	var quote byte
+	start := -1
+	for i := 0; i < len(body); i++ {
+		c := body[i]
+		switch {
+		case quote != 0:
+			if c == quote {
+				quote = 0
+			}
+		case c == '"' || c == '\'':
+			quote = c
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			if start >= 0 {
+				parts = append(parts, body[start:i])
+				start = -1
+			}
+			continue
+		}
+		if start < 0 {
+			start = i
+		}
+	}
+	if start >= 0 {
+		parts = append(parts, body[start:])
+	}
+	return append(parts, end)
+}